@@ -35,7 +35,7 @@ var defaultAcceptTimeout = 5 * time.Second
 func acceptAndIncrementCtr(rpc ChannelAcceptor, req *ChannelAcceptRequest,
 	ctr *uint32, success chan struct{}) {
 	result := rpc.Accept(req)
-	if !result {
+	if !result.Accept {
 		return
 	}
 
@@ -85,7 +85,11 @@ func TestRPCMultipleAcceptClients(t *testing.T) {
 
 	// demultiplexReq is a closure used to abstract the RPCAcceptor's request
 	// and response logic.
-	demultiplexReq := func(req *ChannelAcceptRequest) bool {
+	demultiplexReq := func(
+		req *ChannelAcceptRequest) *ChannelAcceptResponse {
+
+		reject := &ChannelAcceptResponse{}
+
 		respChan := make(chan lnrpc.ChannelAcceptResponse, 1)
 
 		newRequest := &requestInfo{
@@ -97,26 +101,29 @@ func TestRPCMultipleAcceptClients(t *testing.T) {
 		select {
 		case requests <- newRequest:
 		case <-quit:
-			return false
+			return reject
 		}
 
 		// Receive the response and verify that the PendingChanId matches
 		// the ID found in the ChannelAcceptRequest. If no response has been
-		// received in defaultAcceptTimeout, then return false.
+		// received in defaultAcceptTimeout, then reject the channel.
 		select {
 		case resp := <-respChan:
 			pendingID := req.OpenChanMsg.PendingChannelID
 			if !bytes.Equal(pendingID[:], resp.PendingChanId) {
 				errChan <- struct{}{}
-				return false
+				return reject
 			}
 
-			return resp.Accept
+			return &ChannelAcceptResponse{
+				Accept:   resp.Accept,
+				ZeroConf: resp.ZeroConf,
+			}
 		case <-time.After(defaultAcceptTimeout):
 			errChan <- struct{}{}
-			return false
+			return reject
 		case <-quit:
-			return false
+			return reject
 		}
 	}
 
@@ -152,3 +159,172 @@ func TestRPCMultipleAcceptClients(t *testing.T) {
 		}
 	}
 }
+
+// staticAcceptor is a ChannelAcceptor that always returns the same response.
+type staticAcceptor struct {
+	resp ChannelAcceptResponse
+}
+
+func (s *staticAcceptor) Accept(*ChannelAcceptRequest) *ChannelAcceptResponse {
+	resp := s.resp
+	return &resp
+}
+
+// TestChainedAcceptorZeroConf tests that the ChainedAcceptor only accepts a
+// channel if all of its acceptors do, and only marks it as zero-conf if all of
+// them agree on it.
+func TestChainedAcceptorZeroConf(t *testing.T) {
+	t.Parallel()
+
+	var (
+		reject   = ChannelAcceptResponse{}
+		accept   = ChannelAcceptResponse{Accept: true}
+		zeroConf = ChannelAcceptResponse{Accept: true, ZeroConf: true}
+	)
+
+	tests := []struct {
+		name      string
+		responses []ChannelAcceptResponse
+		expected  ChannelAcceptResponse
+	}{
+		{
+			name:     "no acceptors",
+			expected: accept,
+		},
+		{
+			name:      "all accept",
+			responses: []ChannelAcceptResponse{accept, accept},
+			expected:  accept,
+		},
+		{
+			name:      "one rejects",
+			responses: []ChannelAcceptResponse{zeroConf, reject},
+			expected:  reject,
+		},
+		{
+			name:      "all zero-conf",
+			responses: []ChannelAcceptResponse{zeroConf, zeroConf},
+			expected:  zeroConf,
+		},
+		{
+			name:      "one not zero-conf",
+			responses: []ChannelAcceptResponse{zeroConf, accept},
+			expected:  accept,
+		},
+		{
+			name: "rejected zero-conf",
+			responses: []ChannelAcceptResponse{
+				{ZeroConf: true},
+			},
+			expected: reject,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			chained := NewChainedAcceptor()
+			for _, resp := range test.responses {
+				chained.AddAcceptor(&staticAcceptor{resp: resp})
+			}
+
+			resp := chained.Accept(&ChannelAcceptRequest{
+				Node:        randKey(t),
+				OpenChanMsg: &lnwire.OpenChannel{},
+			})
+			if *resp != test.expected {
+				t.Fatalf("expected response %+v, got %+v",
+					test.expected, *resp)
+			}
+		})
+	}
+}
+
+// TestChainedAcceptorParams tests that the ChainedAcceptor merges the channel
+// parameters overridden by its acceptors, and passes on the reason of the
+// first acceptor that rejects a channel.
+func TestChainedAcceptorParams(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		responses []ChannelAcceptResponse
+		expected  ChannelAcceptResponse
+	}{
+		{
+			name: "disjoint parameters",
+			responses: []ChannelAcceptResponse{
+				{Accept: true, CSVDelay: 144, MinHTLC: 1000},
+				{Accept: true, Reserve: 5000, MaxHTLCs: 30},
+				{Accept: true, MinAcceptDepth: 6},
+			},
+			expected: ChannelAcceptResponse{
+				Accept:         true,
+				CSVDelay:       144,
+				Reserve:        5000,
+				MaxHTLCs:       30,
+				MinHTLC:        1000,
+				MinAcceptDepth: 6,
+			},
+		},
+		{
+			name: "equal parameters",
+			responses: []ChannelAcceptResponse{
+				{Accept: true, CSVDelay: 144},
+				{Accept: true, CSVDelay: 144},
+			},
+			expected: ChannelAcceptResponse{
+				Accept:   true,
+				CSVDelay: 144,
+			},
+		},
+		{
+			name: "conflicting parameters",
+			responses: []ChannelAcceptResponse{
+				{Accept: true, MaxHTLCs: 30},
+				{Accept: true, MaxHTLCs: 50},
+			},
+			expected: ChannelAcceptResponse{
+				Error: "conflicting max htlcs of 30 and 50",
+			},
+		},
+		{
+			name: "rejection reason",
+			responses: []ChannelAcceptResponse{
+				{Accept: true, CSVDelay: 144},
+				{Error: "channel too small"},
+			},
+			expected: ChannelAcceptResponse{
+				Error: "channel too small",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			chained := NewChainedAcceptor()
+			for _, resp := range test.responses {
+				chained.AddAcceptor(&staticAcceptor{resp: resp})
+			}
+
+			resp := chained.Accept(&ChannelAcceptRequest{
+				Node:        randKey(t),
+				OpenChanMsg: &lnwire.OpenChannel{},
+			})
+
+			// The order in which the acceptors are evaluated is
+			// random, so the values of a conflict may be swapped.
+			expected := test.expected
+			if resp.Error == "conflicting max htlcs of 50 and 30" {
+				expected.Error = resp.Error
+			}
+			if *resp != expected {
+				t.Fatalf("expected response %+v, got %+v",
+					expected, *resp)
+			}
+		})
+	}
+}
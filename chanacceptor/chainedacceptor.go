@@ -1,8 +1,12 @@
 package chanacceptor
 
 import (
+	"fmt"
 	"sync"
 	"sync/atomic"
+
+	"github.com/BTCGPU/lnd/lnwire"
+	btcutil "github.com/btgsuite/btgutil"
 )
 
 // ChainedAcceptor represents a conjunction of ChannelAcceptor results.
@@ -43,23 +47,116 @@ func (c *ChainedAcceptor) RemoveAcceptor(id uint64) {
 }
 
 // Accept evaluates the results of all ChannelAcceptors in the acceptors map
-// and returns the conjunction of all these predicates.
+// and returns the conjunction of all these predicates. The channel is only
+// zero-conf if there is at least one acceptor and all of them agree on it.
+// The parameters overridden by the acceptors are merged, and the channel is
+// rejected if two acceptors override the same parameter differently.
 //
 // NOTE: Part of the ChannelAcceptor interface.
-func (c *ChainedAcceptor) Accept(req *ChannelAcceptRequest) bool {
-	result := true
+func (c *ChainedAcceptor) Accept(
+	req *ChannelAcceptRequest) *ChannelAcceptResponse {
+
+	result := &ChannelAcceptResponse{
+		Accept: true,
+	}
 
 	c.acceptorsMtx.RLock()
+	zeroConf := len(c.acceptors) > 0
 	for _, acceptor := range c.acceptors {
 		// We call Accept first in case any acceptor (perhaps an RPCAcceptor)
 		// wishes to be notified about ChannelAcceptRequest.
-		result = acceptor.Accept(req) && result
+		resp := acceptor.Accept(req)
+		zeroConf = resp.ZeroConf && zeroConf
+
+		// Once the channel is rejected, we only keep the reason of the
+		// first acceptor that rejected it.
+		switch {
+		case !result.Accept:
+			continue
+
+		case !resp.Accept:
+			result = &ChannelAcceptResponse{
+				Error: resp.Error,
+			}
+			continue
+		}
+
+		if err := mergeParams(result, resp); err != nil {
+			result = &ChannelAcceptResponse{
+				Error: err.Error(),
+			}
+		}
 	}
 	c.acceptorsMtx.RUnlock()
 
+	result.ZeroConf = result.Accept && zeroConf
+
 	return result
 }
 
+// mergeParams merges the channel parameters overridden by resp into result.
+// An error is returned if both override a parameter with different values.
+func mergeParams(result, resp *ChannelAcceptResponse) error {
+	csvDelay, err := mergeParam(
+		"csv delay", uint64(result.CSVDelay), uint64(resp.CSVDelay),
+	)
+	if err != nil {
+		return err
+	}
+
+	reserve, err := mergeParam(
+		"reserve", uint64(result.Reserve), uint64(resp.Reserve),
+	)
+	if err != nil {
+		return err
+	}
+
+	maxHTLCs, err := mergeParam(
+		"max htlcs", uint64(result.MaxHTLCs), uint64(resp.MaxHTLCs),
+	)
+	if err != nil {
+		return err
+	}
+
+	minHTLC, err := mergeParam(
+		"min htlc", uint64(result.MinHTLC), uint64(resp.MinHTLC),
+	)
+	if err != nil {
+		return err
+	}
+
+	minAcceptDepth, err := mergeParam(
+		"min accept depth", uint64(result.MinAcceptDepth),
+		uint64(resp.MinAcceptDepth),
+	)
+	if err != nil {
+		return err
+	}
+
+	result.CSVDelay = uint16(csvDelay)
+	result.Reserve = btcutil.Amount(reserve)
+	result.MaxHTLCs = uint16(maxHTLCs)
+	result.MinHTLC = lnwire.MilliSatoshi(minHTLC)
+	result.MinAcceptDepth = uint16(minAcceptDepth)
+
+	return nil
+}
+
+// mergeParam returns the value of a channel parameter that is overridden by
+// two acceptors, where zero means that the parameter isn't overridden.
+func mergeParam(name string, a, b uint64) (uint64, error) {
+	switch {
+	case b == 0 || a == b:
+		return a, nil
+
+	case a == 0:
+		return b, nil
+
+	default:
+		return 0, fmt.Errorf("conflicting %v of %v and %v", name, a, b)
+	}
+}
+
 // A compile-time constraint to ensure ChainedAcceptor implements the
 // ChannelAcceptor interface.
 var _ ChannelAcceptor = (*ChainedAcceptor)(nil)
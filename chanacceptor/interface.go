@@ -3,6 +3,7 @@ package chanacceptor
 import (
 	"github.com/BTCGPU/lnd/lnwire"
 	"github.com/btgsuite/btgd/btcec"
+	btcutil "github.com/btgsuite/btgutil"
 )
 
 // ChannelAcceptRequest is a struct containing the requesting node's public key
@@ -18,8 +19,46 @@ type ChannelAcceptRequest struct {
 	OpenChanMsg *lnwire.OpenChannel
 }
 
+// ChannelAcceptResponse is the decision of a ChannelAcceptor on a
+// ChannelAcceptRequest. An accepted channel may come with parameters that
+// override the ones we require from the initiator by default. A zero value
+// leaves the default parameter in place.
+type ChannelAcceptResponse struct {
+	// Accept is true if the channel should be accepted.
+	Accept bool
+
+	// Error is the reason for rejecting the channel, which is sent to the
+	// initiator. If empty, a generic error is sent instead.
+	Error string
+
+	// ZeroConf is true if the channel may be used before its funding
+	// transaction confirms. This should only be set for initiators that
+	// are trusted not to double spend the funding transaction.
+	ZeroConf bool
+
+	// CSVDelay is the number of blocks the initiator has to wait before
+	// it can sweep its funds after a unilateral close.
+	CSVDelay uint16
+
+	// Reserve is the amount that the initiator has to keep on its side of
+	// the channel.
+	Reserve btcutil.Amount
+
+	// MaxHTLCs is the maximum number of HTLCs that the initiator may
+	// offer us at once.
+	MaxHTLCs uint16
+
+	// MinHTLC is the smallest HTLC that the initiator may offer us.
+	MinHTLC lnwire.MilliSatoshi
+
+	// MinAcceptDepth is the number of confirmations of the funding
+	// transaction that are required before the channel is used. It is
+	// ignored for zero-conf channels.
+	MinAcceptDepth uint16
+}
+
 // ChannelAcceptor is an interface that represents a predicate on the data
 // contained in ChannelAcceptRequest.
 type ChannelAcceptor interface {
-	Accept(req *ChannelAcceptRequest) bool
+	Accept(req *ChannelAcceptRequest) *ChannelAcceptResponse
 }
@@ -235,6 +235,12 @@ func TestSinglePackUnpack(t *testing.T) {
 			valid:   true,
 		},
 
+		// The new anchor version, should pack/unpack with no problem.
+		{
+			version: AnchorsCommitVersion,
+			valid:   true,
+		},
+
 		// A non-default version, atm this should result in a failure.
 		{
 			version: 99,
@@ -1,6 +1,7 @@
 package lnd
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/BTCGPU/lnd/htlcswitch"
@@ -25,6 +26,13 @@ var (
 	// ErrInvalidState is returned when the closing state machine receives
 	// a message while it is in an unknown state.
 	ErrInvalidState = fmt.Errorf("invalid state")
+
+	// errUpfrontShutdownScriptMismatch is returned when the remote peer
+	// provides a script to cooperatively close out to which does not
+	// match the upfront shutdown script it committed to when the channel
+	// was opened.
+	errUpfrontShutdownScriptMismatch = fmt.Errorf("shutdown script does " +
+		"not match upfront shutdown script")
 )
 
 // closeState represents all the possible states the channel closer state
@@ -282,6 +290,16 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 				"instead have %v", spew.Sdump(msg))
 		}
 
+		// If the remote node opened the channel with an upfront
+		// shutdown script, we'll ensure that they're paying out to it.
+		err := maybeMatchScript(
+			c.cfg.channel.RemoteUpfrontShutdownScript(),
+			shutDownMsg.Address,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+
 		// Next, we'll note the other party's preference for their
 		// delivery address. We'll use this when we craft the closure
 		// transaction.
@@ -332,6 +350,16 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 				"instead have %v", spew.Sdump(msg))
 		}
 
+		// As above, the remote node must pay out to its upfront
+		// shutdown script if it committed to one.
+		err := maybeMatchScript(
+			c.cfg.channel.RemoteUpfrontShutdownScript(),
+			shutDownMsg.Address,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+
 		// Now that we know this is a valid shutdown message, we'll
 		// record their preferred delivery closing script.
 		c.remoteDeliveryScript = shutDownMsg.Address
@@ -616,3 +644,22 @@ func calcCompromiseFee(chanPoint wire.OutPoint,
 		return remoteFee
 	}
 }
+
+// maybeMatchScript checks that the shutdown script provided by the remote
+// peer matches the upfront shutdown script it committed to when the channel
+// was opened. If no upfront shutdown script was set, any script is accepted.
+func maybeMatchScript(upfrontScript,
+	peerScript lnwire.DeliveryAddress) error {
+
+	// If no upfront shutdown script was set, the peer may pay out to any
+	// script.
+	if len(upfrontScript) == 0 {
+		return nil
+	}
+
+	if !bytes.Equal(upfrontScript, peerScript) {
+		return errUpfrontShutdownScriptMismatch
+	}
+
+	return nil
+}
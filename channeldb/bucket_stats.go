@@ -0,0 +1,80 @@
+package channeldb
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
+)
+
+// BucketStats summarizes the contents of a top-level bucket of the database.
+type BucketStats struct {
+	// Name is the name of the top-level bucket.
+	Name []byte
+
+	// NumKeys is the number of key/value pairs stored within the bucket,
+	// including those of all nested buckets.
+	NumKeys uint64
+
+	// NumBuckets is the number of buckets nested within the bucket.
+	NumBuckets uint64
+
+	// KeyBytes is the total size of all keys within the bucket, including
+	// the keys of nested buckets.
+	KeyBytes uint64
+
+	// ValueBytes is the total size of all values within the bucket.
+	ValueBytes uint64
+}
+
+// BucketStats walks the entire database and returns the statistics of each
+// top-level bucket, which gives an indication of what is taking up space
+// within the database. The sizes don't include the overhead of the storage
+// engine, such as page headers and free pages.
+func (d *DB) BucketStats() ([]BucketStats, error) {
+	var stats []BucketStats
+	err := d.View(func(tx kvdb.Tx) error {
+		stats = nil
+
+		return kvdb.Walk(tx, func(path [][]byte, k, v []byte,
+			_ uint64) error {
+
+			// Each top-level bucket starts a new entry, everything
+			// else is accounted towards the latest one.
+			if len(path) == 0 {
+				name := make([]byte, len(k))
+				copy(name, k)
+
+				stats = append(stats, BucketStats{Name: name})
+				return nil
+			}
+
+			s := &stats[len(stats)-1]
+			s.KeyBytes += uint64(len(k))
+			if v == nil {
+				s.NumBuckets++
+				return nil
+			}
+
+			s.NumKeys++
+			s.ValueBytes += uint64(len(v))
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// FileSize returns the size of the database file on disk in bytes.
+func (d *DB) FileSize() (int64, error) {
+	info, err := os.Stat(filepath.Join(d.dbPath, dbName))
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Size(), nil
+}
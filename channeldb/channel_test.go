@@ -344,6 +344,96 @@ func TestOpenChannelPutGetDelete(t *testing.T) {
 	}
 }
 
+// TestOptionalShutdown tests the reading and writing of channels with and
+// without optional upfront shutdown scripts.
+func TestOptionalShutdown(t *testing.T) {
+	t.Parallel()
+
+	local := lnwire.DeliveryAddress([]byte("local shutdown script"))
+	remote := lnwire.DeliveryAddress([]byte("remote shutdown script"))
+
+	tests := []struct {
+		name           string
+		localShutdown  lnwire.DeliveryAddress
+		remoteShutdown lnwire.DeliveryAddress
+	}{
+		{
+			name:           "no shutdown scripts",
+			localShutdown:  nil,
+			remoteShutdown: nil,
+		},
+		{
+			name:           "local shutdown script",
+			localShutdown:  local,
+			remoteShutdown: nil,
+		},
+		{
+			name:           "remote shutdown script",
+			localShutdown:  nil,
+			remoteShutdown: remote,
+		},
+		{
+			name:           "both scripts set",
+			localShutdown:  local,
+			remoteShutdown: remote,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			cdb, cleanUp, err := makeTestDB()
+			if err != nil {
+				t.Fatalf("unable to make test database: %v",
+					err)
+			}
+			defer cleanUp()
+
+			// Create the test channel state, then set the optional
+			// shutdown scripts before syncing to disk.
+			state, err := createTestChannelState(cdb)
+			if err != nil {
+				t.Fatalf("unable to create channel state: %v",
+					err)
+			}
+			state.LocalShutdownScript = test.localShutdown
+			state.RemoteShutdownScript = test.remoteShutdown
+
+			addr := &net.TCPAddr{
+				IP:   net.ParseIP("127.0.0.1"),
+				Port: 18556,
+			}
+			if err := state.SyncPending(addr, 101); err != nil {
+				t.Fatalf("unable to save and serialize "+
+					"channel state: %v", err)
+			}
+
+			openChannels, err := cdb.FetchOpenChannels(
+				state.IdentityPub,
+			)
+			if err != nil {
+				t.Fatalf("unable to fetch open channel: %v",
+					err)
+			}
+
+			channel := openChannels[0]
+			if !reflect.DeepEqual(
+				channel.LocalShutdownScript, test.localShutdown,
+			) {
+				t.Fatalf("unexpected local shutdown script: "+
+					"%x", channel.LocalShutdownScript)
+			}
+			if !reflect.DeepEqual(
+				channel.RemoteShutdownScript, test.remoteShutdown,
+			) {
+				t.Fatalf("unexpected remote shutdown script: "+
+					"%x", channel.RemoteShutdownScript)
+			}
+		})
+	}
+}
+
 func assertCommitmentEqual(t *testing.T, a, b *ChannelCommitment) {
 	if !reflect.DeepEqual(a, b) {
 		_, _, line, _ := runtime.Caller(1)
@@ -1038,3 +1128,78 @@ func TestRefreshShortChanID(t *testing.T) {
 			pendingChannel.Packager.(*ChannelPackager).source)
 	}
 }
+
+// TestZeroConfAlias tests that the alias of a zero-conf channel is persisted,
+// and that its forwarding packages stay keyed by the alias once the channel
+// confirms under its real short channel ID.
+func TestZeroConfAlias(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	alias := lnwire.ShortChannelID{
+		BlockHeight: 16000000,
+		TxIndex:     1,
+		TxPosition:  2,
+	}
+	state.ZeroConfAlias = alias
+	if !state.IsZeroConf() {
+		t.Fatalf("expected channel to be zero-conf")
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18557,
+	}
+	if err := state.SyncPending(addr, 101); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	// The channel is used under its alias before it confirms.
+	if err := state.MarkAsOpen(alias); err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+
+	// Once the funding transaction confirms, the real short channel ID is
+	// stored, while the packager must remain keyed by the alias.
+	chanOpenLoc := lnwire.ShortChannelID{
+		BlockHeight: 105,
+		TxIndex:     10,
+		TxPosition:  15,
+	}
+	if err := state.MarkAsOpen(chanOpenLoc); err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+	if state.Packager.(*ChannelPackager).source != alias {
+		t.Fatalf("channel packager source changed: want %v, got %v",
+			alias, state.Packager.(*ChannelPackager).source)
+	}
+
+	openChannels, err := cdb.FetchOpenChannels(state.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch open channel: %v", err)
+	}
+
+	channel := openChannels[0]
+	if channel.ZeroConfAlias != alias {
+		t.Fatalf("expected alias %v, got %v", alias,
+			channel.ZeroConfAlias)
+	}
+	if channel.ShortChanID() != chanOpenLoc {
+		t.Fatalf("expected short_chan_id %v, got %v", chanOpenLoc,
+			channel.ShortChanID())
+	}
+	if channel.Packager.(*ChannelPackager).source != alias {
+		t.Fatalf("channel packager source changed: want %v, got %v",
+			alias, channel.Packager.(*ChannelPackager).source)
+	}
+}
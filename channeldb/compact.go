@@ -0,0 +1,144 @@
+package channeldb
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
+)
+
+const (
+	// compactTempSuffix is the suffix of the file the database is
+	// compacted into before it is swapped in.
+	compactTempSuffix = ".compact"
+
+	// compactBackupSuffix is the suffix of the file that holds a copy of
+	// the database as it was before the last compaction.
+	compactBackupSuffix = ".backup"
+
+	// compactTxMaxSize is the maximum number of bytes that are written to
+	// the compacted database within a single transaction.
+	compactTxMaxSize = 64 * 1024 * 1024
+)
+
+// compactDB compacts the database at the given path by copying its contents
+// into a fresh file, which is then atomically swapped in. Before the swap, a
+// backup of the original database is stored next to it, so it can be restored
+// manually should the compacted database turn out to be unusable.
+func compactDB(path string, noFreelistSync bool) error {
+	srcInfo, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Compacting database %v (%v bytes), this may take a while",
+		path, srcInfo.Size())
+
+	// Remove any leftovers of a previous compaction that was interrupted
+	// before the swap.
+	tempPath := path + compactTempSuffix
+	if err := os.Remove(tempPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := copyDB(tempPath, path, noFreelistSync); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+
+	// Now that we have a compacted copy, we'll keep a backup of the
+	// original before replacing it. A hard link is used if possible, as
+	// the original file isn't modified anymore.
+	backupPath := path + compactBackupSuffix
+	if err := os.Remove(backupPath); err != nil && !os.IsNotExist(err) {
+		os.Remove(tempPath)
+		return err
+	}
+	if err := os.Link(path, backupPath); err != nil {
+		if err := copyFile(backupPath, path); err != nil {
+			os.Remove(tempPath)
+			return err
+		}
+	}
+
+	// Renaming the compacted copy replaces the original atomically.
+	if err := os.Rename(tempPath, path); err != nil {
+		return err
+	}
+	if err := syncDir(filepath.Dir(path)); err != nil {
+		log.Warnf("Unable to sync database directory: %v", err)
+	}
+
+	dstInfo, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Compacted database %v from %v to %v bytes, backup of "+
+		"original stored at %v", path, srcInfo.Size(), dstInfo.Size(),
+		backupPath)
+
+	return nil
+}
+
+// copyDB copies the contents of the database at srcPath into a new database
+// at dstPath.
+func copyDB(dstPath, srcPath string, noFreelistSync bool) error {
+	src, err := kvdb.Open(kvdb.BoltBackendName, srcPath, noFreelistSync)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := kvdb.Create(kvdb.BoltBackendName, dstPath, noFreelistSync)
+	if err != nil {
+		return err
+	}
+
+	if err := kvdb.Compact(dst, src, compactTxMaxSize); err != nil {
+		dst.Close()
+		return err
+	}
+
+	return dst.Close()
+}
+
+// copyFile copies the file at srcPath to dstPath and syncs it to disk.
+func copyFile(dstPath, srcPath string) error {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(
+		dstPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600,
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return err
+	}
+	if err := dst.Sync(); err != nil {
+		dst.Close()
+		return err
+	}
+
+	return dst.Close()
+}
+
+// syncDir syncs the given directory to disk, making sure renames within it
+// are persisted.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
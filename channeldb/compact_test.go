@@ -0,0 +1,110 @@
+package channeldb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/BTCGPU/lnd/lnwire"
+)
+
+// TestAutoCompact asserts that compacting the database on startup preserves
+// its contents and keeps a backup of the original database.
+func TestAutoCompact(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := Open(tempDir)
+	if err != nil {
+		t.Fatalf("unable to open db: %v", err)
+	}
+
+	// Add a couple of invoices, so there's some data to compact.
+	const numInvoices = 10
+	var invoices []*Invoice
+	for i := 0; i < numInvoices; i++ {
+		invoice, err := randInvoice(lnwire.MilliSatoshi(i + 1))
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+		hash := invoice.Terms.PaymentPreimage.Hash()
+		if _, err := db.AddInvoice(invoice, hash); err != nil {
+			t.Fatalf("unable to add invoice: %v", err)
+		}
+
+		invoices = append(invoices, invoice)
+	}
+
+	stats, err := db.BucketStats()
+	if err != nil {
+		t.Fatalf("unable to fetch bucket stats: %v", err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatalf("unable to close db: %v", err)
+	}
+
+	// Now, reopen the database with compaction enabled.
+	db, err = Open(tempDir, OptionAutoCompact(true))
+	if err != nil {
+		t.Fatalf("unable to open db: %v", err)
+	}
+	defer db.Close()
+
+	// A backup of the original database should have been created, and no
+	// temporary files should be left behind.
+	path := filepath.Join(tempDir, dbName)
+	if _, err := os.Stat(path + compactBackupSuffix); err != nil {
+		t.Fatalf("expected backup to exist: %v", err)
+	}
+	if _, err := os.Stat(path + compactTempSuffix); !os.IsNotExist(err) {
+		t.Fatalf("expected temp file to be removed: %v", err)
+	}
+
+	// The compacted database should have the same contents.
+	compactedStats, err := db.BucketStats()
+	if err != nil {
+		t.Fatalf("unable to fetch bucket stats: %v", err)
+	}
+	if len(compactedStats) != len(stats) {
+		t.Fatalf("expected %v buckets, got %v", len(stats),
+			len(compactedStats))
+	}
+	for i, s := range stats {
+		c := compactedStats[i]
+		if !reflect.DeepEqual(s, c) {
+			t.Fatalf("bucket stats mismatch: expected %v, got %v",
+				s, c)
+		}
+	}
+
+	for _, invoice := range invoices {
+		hash := invoice.Terms.PaymentPreimage.Hash()
+		if _, err := db.LookupInvoice(hash); err != nil {
+			t.Fatalf("unable to find invoice: %v", err)
+		}
+	}
+
+	// Finally, the bucket sequences should have been preserved, so the
+	// next invoice is added with the next add index.
+	invoice, err := randInvoice(1000)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	addIndex, err := db.AddInvoice(
+		invoice, invoice.Terms.PaymentPreimage.Hash(),
+	)
+	if err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+	if addIndex != numInvoices+1 {
+		t.Fatalf("expected add index %v, got %v", numInvoices+1,
+			addIndex)
+	}
+}
@@ -6,8 +6,8 @@ import (
 	"sort"
 	"time"
 
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/lnwire"
-	"github.com/coreos/bbolt"
 )
 
 var (
@@ -111,7 +111,7 @@ func (f *ForwardingLog) AddForwardingEvents(events []ForwardingEvent) error {
 
 	var timestamp [8]byte
 
-	return f.db.Batch(func(tx *bbolt.Tx) error {
+	return f.db.Batch(func(tx kvdb.Tx) error {
 		// First, we'll fetch the bucket that stores our time series
 		// log.
 		logBucket, err := tx.CreateBucketIfNotExists(
@@ -204,7 +204,7 @@ func (f *ForwardingLog) Query(q ForwardingEventQuery) (ForwardingLogTimeSlice, e
 	recordsToSkip := q.IndexOffset
 	recordOffset := q.IndexOffset
 
-	err := f.db.View(func(tx *bbolt.Tx) error {
+	err := f.db.View(func(tx kvdb.Tx) error {
 		// If the bucket wasn't found, then there aren't any events to
 		// be returned.
 		logBucket := tx.Bucket(forwardingLogBucket)
@@ -6,15 +6,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/BTCGPU/lnd/lntypes"
 	"github.com/BTCGPU/lnd/lnwire"
+	"github.com/BTCGPU/lnd/record"
 	"github.com/davecgh/go-spew/spew"
 )
 
 func randInvoice(value lnwire.MilliSatoshi) (*Invoice, error) {
-	var pre [32]byte
+	var pre, payAddr [32]byte
 	if _, err := rand.Read(pre[:]); err != nil {
 		return nil, err
 	}
+	if _, err := rand.Read(payAddr[:]); err != nil {
+		return nil, err
+	}
 
 	i := &Invoice{
 		// Use single second precision to avoid false positive test
@@ -23,6 +28,13 @@ func randInvoice(value lnwire.MilliSatoshi) (*Invoice, error) {
 		Terms: ContractTerm{
 			PaymentPreimage: pre,
 			Value:           value,
+			PaymentAddr:     payAddr,
+			Features: lnwire.NewFeatureVector(
+				lnwire.NewRawFeatureVector(
+					lnwire.TLVOnionPayloadOptional,
+					lnwire.PaymentAddrRequired,
+				), lnwire.GlobalFeatures,
+			),
 		},
 		Htlcs:  map[CircuitKey]*InvoiceHTLC{},
 		Expiry: 4000,
@@ -692,3 +704,166 @@ func getUpdateInvoice(amt lnwire.MilliSatoshi) InvoiceUpdateCallback {
 		return update, nil
 	}
 }
+
+// TestCustomRecords tests that custom records are properly recorded in the
+// invoice database.
+func TestCustomRecords(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	testInvoice := &Invoice{
+		Htlcs: map[CircuitKey]*InvoiceHTLC{},
+	}
+	testInvoice.Terms.Value = lnwire.NewMSatFromSatoshis(10000)
+
+	var paymentHash lntypes.Hash
+	if _, err := db.AddInvoice(testInvoice, paymentHash); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	// Accept an htlc with custom records on this invoice.
+	key := CircuitKey{ChanID: lnwire.NewShortChanIDFromInt(1), HtlcID: 4}
+
+	records := record.CustomSet{
+		100000: []byte{},
+		100001: []byte{1, 2},
+	}
+
+	_, err = db.UpdateInvoice(paymentHash,
+		func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+			return &InvoiceUpdateDesc{
+				State: ContractAccepted,
+				Htlcs: map[CircuitKey]*HtlcAcceptDesc{
+					key: {
+						Amt:           500,
+						CustomRecords: records,
+					},
+				},
+			}, nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to add invoice htlc: %v", err)
+	}
+
+	// Retrieve the invoice from the database and check that the custom
+	// records were stored.
+	dbInvoice, err := db.LookupInvoice(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to lookup invoice: %v", err)
+	}
+
+	if !reflect.DeepEqual(records, dbInvoice.Htlcs[key].CustomRecords) {
+		t.Fatalf("invalid custom records, expected %v got %v",
+			spew.Sdump(records),
+			spew.Sdump(dbInvoice.Htlcs[key].CustomRecords))
+	}
+}
+
+// TestFetchAllInvoicesWithPaymentHash tests that all invoices are returned
+// along with their payment hashes, and that only open and accepted invoices
+// are returned if pending invoices are requested.
+func TestFetchAllInvoicesWithPaymentHash(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// An empty database shouldn't return an error.
+	_, err = db.FetchAllInvoicesWithPaymentHash(true)
+	if err != nil && err != ErrNoInvoicesCreated {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+
+	// We'll add one invoice for each of the possible invoice states.
+	states := []ContractState{
+		ContractOpen, ContractAccepted, ContractSettled,
+		ContractCanceled,
+	}
+
+	amt := lnwire.NewMSatFromSatoshis(1000)
+	updateState := func(state ContractState) InvoiceUpdateCallback {
+		return func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+			update := &InvoiceUpdateDesc{
+				State:    state,
+				Preimage: invoice.Terms.PaymentPreimage,
+			}
+			if state != ContractCanceled {
+				update.Htlcs = map[CircuitKey]*HtlcAcceptDesc{
+					{}: {Amt: amt},
+				}
+			}
+
+			return update, nil
+		}
+	}
+
+	hashes := make(map[lntypes.Hash]ContractState)
+	for _, state := range states {
+		invoice, err := randInvoice(amt)
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+
+		payHash := invoice.Terms.PaymentPreimage.Hash()
+		if _, err := db.AddInvoice(invoice, payHash); err != nil {
+			t.Fatalf("unable to add invoice: %v", err)
+		}
+		hashes[payHash] = state
+
+		if state == ContractOpen {
+			continue
+		}
+
+		_, err = db.UpdateInvoice(payHash, updateState(state))
+		if err != nil {
+			t.Fatalf("unable to update invoice: %v", err)
+		}
+	}
+
+	// All invoices should be returned with their matching payment hash if
+	// we don't restrict the query to pending invoices.
+	invoices, err := db.FetchAllInvoicesWithPaymentHash(false)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	if len(invoices) != len(states) {
+		t.Fatalf("expected %v invoices, got %v", len(states),
+			len(invoices))
+	}
+	for _, invoice := range invoices {
+		state, ok := hashes[invoice.PaymentHash]
+		if !ok {
+			t.Fatalf("unknown payment hash %v", invoice.PaymentHash)
+		}
+		if invoice.Invoice.Terms.State != state {
+			t.Fatalf("expected state %v for invoice %v, got %v",
+				state, invoice.PaymentHash,
+				invoice.Invoice.Terms.State)
+		}
+	}
+
+	// Only the open and accepted invoice should be returned as pending.
+	invoices, err = db.FetchAllInvoicesWithPaymentHash(true)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	if len(invoices) != 2 {
+		t.Fatalf("expected 2 pending invoices, got %v", len(invoices))
+	}
+	for _, invoice := range invoices {
+		state := invoice.Invoice.Terms.State
+		if state != ContractOpen && state != ContractAccepted {
+			t.Fatalf("unexpected pending invoice in state %v",
+				state)
+		}
+	}
+}
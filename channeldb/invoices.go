@@ -8,11 +8,13 @@ import (
 	"io"
 	"time"
 
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
+	"github.com/BTCGPU/lnd/htlcswitch/hop"
 	"github.com/BTCGPU/lnd/lntypes"
 	"github.com/BTCGPU/lnd/lnwire"
+	"github.com/BTCGPU/lnd/record"
 	"github.com/BTCGPU/lnd/tlv"
 	"github.com/btgsuite/btgd/wire"
-	"github.com/coreos/bbolt"
 )
 
 var (
@@ -20,6 +22,10 @@ var (
 	// preimage for this invoice is not yet known.
 	UnknownPreimage lntypes.Preimage
 
+	// BlankPayAddr is an all-zeroes payment address that indicates that
+	// the invoice doesn't require htlcs to carry a payment address.
+	BlankPayAddr [32]byte
+
 	// invoiceBucket is the name of the bucket within the database that
 	// stores all data related to invoices no matter their final state.
 	// Within the invoice bucket, each invoice is keyed by its invoice ID
@@ -104,6 +110,7 @@ const (
 	resolveTimeType  tlv.Type = 11
 	expiryHeightType tlv.Type = 13
 	stateType        tlv.Type = 15
+	mppTotalAmtType  tlv.Type = 17
 )
 
 // ContractState describes the state the invoice is in.
@@ -154,6 +161,19 @@ type ContractTerm struct {
 	// which can be satisfied by the above preimage.
 	Value lnwire.MilliSatoshi
 
+	// PaymentAddr is a randomly generated value that must be included in
+	// the final hop's onion payload of all htlcs paying to this invoice.
+	// It prevents intermediate nodes from probing the recipient with
+	// payments to the same payment hash. An all-zero payment address
+	// indicates that the invoice doesn't have one.
+	PaymentAddr [32]byte
+
+	// Features is the feature vector advertised in the payment request of
+	// the invoice. Htlcs are only required to carry the payment address
+	// if the invoice requires payment addresses. A nil feature vector
+	// indicates that the invoice doesn't advertise any features.
+	Features *lnwire.FeatureVector
+
 	// State describes the state the invoice is in.
 	State ContractState
 }
@@ -270,6 +290,15 @@ type InvoiceHTLC struct {
 	// Expiry is the expiry height of this htlc.
 	Expiry uint32
 
+	// MppTotalAmt is a field for mpp that indicates the expected total
+	// amount of the htlc set. It is zero for htlcs that were not part of a
+	// multi-path payment.
+	MppTotalAmt lnwire.MilliSatoshi
+
+	// CustomRecords contains the custom key/value pairs that accompanied
+	// the htlc.
+	CustomRecords record.CustomSet
+
 	// State indicates the state the invoice htlc is currently in. A
 	// canceled htlc isn't just removed from the invoice htlcs map, because
 	// we need AcceptHeight to properly cancel the htlc back.
@@ -286,6 +315,14 @@ type HtlcAcceptDesc struct {
 
 	// Expiry is the expiry height of this htlc.
 	Expiry uint32
+
+	// MppTotalAmt is the total amount of the htlc set as signaled by the
+	// sender in the mpp record. It is zero for non-mpp htlcs.
+	MppTotalAmt lnwire.MilliSatoshi
+
+	// CustomRecords contains the custom key/value pairs that accompanied
+	// the htlc.
+	CustomRecords record.CustomSet
 }
 
 // InvoiceUpdateDesc describes the changes that should be applied to the
@@ -338,7 +375,7 @@ func (d *DB) AddInvoice(newInvoice *Invoice, paymentHash lntypes.Hash) (
 	}
 
 	var invoiceAddIndex uint64
-	err := d.Update(func(tx *bbolt.Tx) error {
+	err := d.Update(func(tx kvdb.Tx) error {
 		invoices, err := tx.CreateBucketIfNotExists(invoiceBucket)
 		if err != nil {
 			return err
@@ -416,7 +453,7 @@ func (d *DB) InvoicesAddedSince(sinceAddIndex uint64) ([]Invoice, error) {
 	var startIndex [8]byte
 	byteOrder.PutUint64(startIndex[:], sinceAddIndex)
 
-	err := d.DB.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		invoices := tx.Bucket(invoiceBucket)
 		if invoices == nil {
 			return ErrNoInvoicesCreated
@@ -471,7 +508,7 @@ func (d *DB) InvoicesAddedSince(sinceAddIndex uint64) ([]Invoice, error) {
 // terms of the payment.
 func (d *DB) LookupInvoice(paymentHash [32]byte) (Invoice, error) {
 	var invoice Invoice
-	err := d.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		invoices := tx.Bucket(invoiceBucket)
 		if invoices == nil {
 			return ErrNoInvoicesCreated
@@ -511,7 +548,7 @@ func (d *DB) LookupInvoice(paymentHash [32]byte) (Invoice, error) {
 func (d *DB) FetchAllInvoices(pendingOnly bool) ([]Invoice, error) {
 	var invoices []Invoice
 
-	err := d.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		invoiceB := tx.Bucket(invoiceBucket)
 		if invoiceB == nil {
 			return ErrNoInvoicesCreated
@@ -549,6 +586,78 @@ func (d *DB) FetchAllInvoices(pendingOnly bool) ([]Invoice, error) {
 	return invoices, nil
 }
 
+// InvoiceWithPaymentHash is used to store an invoice and its corresponding
+// payment hash.
+type InvoiceWithPaymentHash struct {
+	// Invoice is the invoice as stored in the database.
+	Invoice Invoice
+
+	// PaymentHash is the payment hash of the invoice.
+	PaymentHash lntypes.Hash
+}
+
+// FetchAllInvoicesWithPaymentHash returns all invoices along with their
+// payment hashes. If the pendingOnly param is true, then only invoices that
+// are open or accepted are returned, skipping all invoices that are settled or
+// canceled.
+func (d *DB) FetchAllInvoicesWithPaymentHash(pendingOnly bool) (
+	[]InvoiceWithPaymentHash, error) {
+
+	var result []InvoiceWithPaymentHash
+
+	err := d.View(func(tx kvdb.Tx) error {
+		invoices := tx.Bucket(invoiceBucket)
+		if invoices == nil {
+			return ErrNoInvoicesCreated
+		}
+
+		invoiceIndex := invoices.Bucket(invoiceIndexBucket)
+		if invoiceIndex == nil {
+			// Mask the error if there's no invoice index as that
+			// simply means there are no invoices added yet to the
+			// DB. In this case we simply return an empty list.
+			return nil
+		}
+
+		return invoiceIndex.ForEach(func(k, v []byte) error {
+			// Skip the special numInvoicesKey as that does not
+			// point to a valid invoice.
+			if bytes.Equal(k, numInvoicesKey) {
+				return nil
+			}
+
+			if v == nil {
+				return nil
+			}
+
+			invoice, err := fetchInvoice(v, invoices)
+			if err != nil {
+				return err
+			}
+
+			if pendingOnly &&
+				invoice.Terms.State != ContractOpen &&
+				invoice.Terms.State != ContractAccepted {
+
+				return nil
+			}
+
+			invoiceWithPaymentHash := InvoiceWithPaymentHash{
+				Invoice: invoice,
+			}
+			copy(invoiceWithPaymentHash.PaymentHash[:], k)
+			result = append(result, invoiceWithPaymentHash)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // InvoiceQuery represents a query to the invoice database. The query allows a
 // caller to retrieve all invoices starting from a particular add index and
 // limit the number of results returned.
@@ -602,7 +711,7 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 		InvoiceQuery: q,
 	}
 
-	err := d.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		// If the bucket wasn't found, then there aren't any invoices
 		// within the database yet, so we can simply exit.
 		invoices := tx.Bucket(invoiceBucket)
@@ -616,7 +725,7 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 
 		// keyForIndex is a helper closure that retrieves the invoice
 		// key for the given add index of an invoice.
-		keyForIndex := func(c *bbolt.Cursor, index uint64) []byte {
+		keyForIndex := func(c kvdb.Cursor, index uint64) []byte {
 			var keyIndex [8]byte
 			byteOrder.PutUint64(keyIndex[:], index)
 			_, invoiceKey := c.Seek(keyIndex[:])
@@ -625,7 +734,7 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 
 		// nextKey is a helper closure to determine what the next
 		// invoice key is when iterating over the invoice add index.
-		nextKey := func(c *bbolt.Cursor) ([]byte, []byte) {
+		nextKey := func(c kvdb.Cursor) ([]byte, []byte) {
 			if q.Reversed {
 				return c.Prev()
 			}
@@ -731,7 +840,7 @@ func (d *DB) UpdateInvoice(paymentHash lntypes.Hash,
 	callback InvoiceUpdateCallback) (*Invoice, error) {
 
 	var updatedInvoice *Invoice
-	err := d.Update(func(tx *bbolt.Tx) error {
+	err := d.Update(func(tx kvdb.Tx) error {
 		invoices, err := tx.CreateBucketIfNotExists(invoiceBucket)
 		if err != nil {
 			return err
@@ -786,7 +895,7 @@ func (d *DB) InvoicesSettledSince(sinceSettleIndex uint64) ([]Invoice, error) {
 	var startIndex [8]byte
 	byteOrder.PutUint64(startIndex[:], sinceSettleIndex)
 
-	err := d.DB.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		invoices := tx.Bucket(invoiceBucket)
 		if invoices == nil {
 			return ErrNoInvoicesCreated
@@ -828,7 +937,7 @@ func (d *DB) InvoicesSettledSince(sinceSettleIndex uint64) ([]Invoice, error) {
 	return settledInvoices, nil
 }
 
-func putInvoice(invoices, invoiceIndex, addIndex *bbolt.Bucket,
+func putInvoice(invoices, invoiceIndex, addIndex kvdb.Bucket,
 	i *Invoice, invoiceNum uint32, paymentHash lntypes.Hash) (
 	uint64, error) {
 
@@ -938,6 +1047,18 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 		return err
 	}
 
+	if _, err := w.Write(i.Terms.PaymentAddr[:]); err != nil {
+		return err
+	}
+
+	features := lnwire.NewRawFeatureVector()
+	if i.Terms.Features != nil {
+		features = i.Terms.Features.RawFeatureVector
+	}
+	if err := features.Encode(w); err != nil {
+		return err
+	}
+
 	if err := binary.Write(w, byteOrder, i.Terms.State); err != nil {
 		return err
 	}
@@ -969,8 +1090,18 @@ func serializeHtlcs(w io.Writer, htlcs map[CircuitKey]*InvoiceHTLC) error {
 		acceptTime := uint64(htlc.AcceptTime.UnixNano())
 		resolveTime := uint64(htlc.ResolveTime.UnixNano())
 		state := uint8(htlc.State)
+		mppTotalAmt := uint64(htlc.MppTotalAmt)
 
-		tlvStream, err := tlv.NewStream(
+		// Convert the custom records to tlv.Record types that are ready
+		// for serialization.
+		customRecords, err := tlv.MapToRecords(htlc.CustomRecords)
+		if err != nil {
+			return err
+		}
+
+		// Because the custom records are in the custom type range, they
+		// are always sorted after the fixed records of the htlc.
+		records := []tlv.Record{
 			tlv.MakePrimitiveRecord(chanIDType, &chanID),
 			tlv.MakePrimitiveRecord(htlcIDType, &key.HtlcID),
 			tlv.MakePrimitiveRecord(amtType, &amt),
@@ -981,7 +1112,11 @@ func serializeHtlcs(w io.Writer, htlcs map[CircuitKey]*InvoiceHTLC) error {
 			tlv.MakePrimitiveRecord(resolveTimeType, &resolveTime),
 			tlv.MakePrimitiveRecord(expiryHeightType, &htlc.Expiry),
 			tlv.MakePrimitiveRecord(stateType, &state),
-		)
+			tlv.MakePrimitiveRecord(mppTotalAmtType, &mppTotalAmt),
+		}
+		records = append(records, customRecords...)
+
+		tlvStream, err := tlv.NewStream(records...)
 		if err != nil {
 			return err
 		}
@@ -1006,7 +1141,7 @@ func serializeHtlcs(w io.Writer, htlcs map[CircuitKey]*InvoiceHTLC) error {
 	return nil
 }
 
-func fetchInvoice(invoiceNum []byte, invoices *bbolt.Bucket) (Invoice, error) {
+func fetchInvoice(invoiceNum []byte, invoices kvdb.Bucket) (Invoice, error) {
 	invoiceBytes := invoices.Get(invoiceNum)
 	if invoiceBytes == nil {
 		return Invoice{}, ErrInvoiceNotFound
@@ -1071,6 +1206,20 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 	}
 	invoice.Terms.Value = lnwire.MilliSatoshi(byteOrder.Uint64(scratch[:]))
 
+	if _, err := io.ReadFull(r, invoice.Terms.PaymentAddr[:]); err != nil {
+		return invoice, err
+	}
+
+	features := lnwire.NewRawFeatureVector()
+	if err := features.Decode(r); err != nil {
+		return invoice, err
+	}
+	if features.SerializeSize() > 0 {
+		invoice.Terms.Features = lnwire.NewFeatureVector(
+			features, lnwire.GlobalFeatures,
+		)
+	}
+
 	if err := binary.Read(r, byteOrder, &invoice.Terms.State); err != nil {
 		return invoice, err
 	}
@@ -1122,7 +1271,7 @@ func deserializeHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC, error) {
 			chanID                  uint64
 			state                   uint8
 			acceptTime, resolveTime uint64
-			amt                     uint64
+			amt, mppTotalAmt        uint64
 		)
 		tlvStream, err := tlv.NewStream(
 			tlv.MakePrimitiveRecord(chanIDType, &chanID),
@@ -1135,12 +1284,22 @@ func deserializeHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC, error) {
 			tlv.MakePrimitiveRecord(resolveTimeType, &resolveTime),
 			tlv.MakePrimitiveRecord(expiryHeightType, &htlc.Expiry),
 			tlv.MakePrimitiveRecord(stateType, &state),
+			tlv.MakePrimitiveRecord(mppTotalAmtType, &mppTotalAmt),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := tlvStream.Decode(streamReader); err != nil {
+		// Custom records are stored along with the htlc, and may use
+		// even types. Those are accepted, while any other unknown even
+		// type is still rejected.
+		parsedTypes, err := tlvStream.DecodeWithParsedTypesLenient(
+			streamReader,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err := hop.CheckRequiredTypes(parsedTypes); err != nil {
 			return nil, err
 		}
 
@@ -1149,6 +1308,15 @@ func deserializeHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC, error) {
 		htlc.ResolveTime = time.Unix(0, int64(resolveTime))
 		htlc.State = HtlcState(state)
 		htlc.Amt = lnwire.MilliSatoshi(amt)
+		htlc.MppTotalAmt = lnwire.MilliSatoshi(mppTotalAmt)
+
+		// Reconstruct the custom records from the parsed types map
+		// returned by the tlv parser. The field is left unset for htlcs
+		// that didn't carry any custom records.
+		customRecords := hop.NewCustomRecords(parsedTypes)
+		if len(customRecords) > 0 {
+			htlc.CustomRecords = customRecords
+		}
 
 		htlcs[key] = &htlc
 	}
@@ -1190,7 +1358,7 @@ func copyInvoice(src *Invoice) *Invoice {
 
 // updateInvoice fetches the invoice, obtains the update descriptor from the
 // callback and applies the updates in a single db transaction.
-func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex *bbolt.Bucket,
+func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex kvdb.Bucket,
 	invoiceNum []byte, callback InvoiceUpdateCallback) (*Invoice, error) {
 
 	invoice, err := fetchInvoice(invoiceNum, invoices)
@@ -1241,10 +1409,12 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex *bbolt.Bucke
 			return nil, fmt.Errorf("htlc %v already exists", key)
 		}
 		htlc = &InvoiceHTLC{
-			Amt:          htlcUpdate.Amt,
-			Expiry:       htlcUpdate.Expiry,
-			AcceptHeight: uint32(htlcUpdate.AcceptHeight),
-			AcceptTime:   now,
+			Amt:           htlcUpdate.Amt,
+			MppTotalAmt:   htlcUpdate.MppTotalAmt,
+			Expiry:        htlcUpdate.Expiry,
+			AcceptHeight:  uint32(htlcUpdate.AcceptHeight),
+			AcceptTime:    now,
+			CustomRecords: htlcUpdate.CustomRecords,
 		}
 		if preUpdateState == ContractSettled {
 			htlc.State = HtlcStateSettled
@@ -1295,7 +1465,7 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex *bbolt.Bucke
 	return &invoice, nil
 }
 
-func setSettleFields(settleIndex *bbolt.Bucket, invoiceNum []byte,
+func setSettleFields(settleIndex kvdb.Bucket, invoiceNum []byte,
 	invoice *Invoice, now time.Time) error {
 
 	// Now that we know the invoice hasn't already been settled, we'll
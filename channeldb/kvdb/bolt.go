@@ -0,0 +1,424 @@
+package kvdb
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/coreos/bbolt"
+)
+
+const (
+	// BoltBackendName is the name of the default bbolt backend driver.
+	BoltBackendName = "bdb"
+
+	// boltFilePermission is the file permission bbolt databases are
+	// created with.
+	boltFilePermission = 0600
+)
+
+func init() {
+	driver := Driver{
+		DbType: BoltBackendName,
+		Create: createBoltBackend,
+		Open:   openBoltBackend,
+	}
+	if err := RegisterDriver(driver); err != nil {
+		panic(fmt.Sprintf("failed to register database driver '%s': %v",
+			BoltBackendName, err))
+	}
+}
+
+// parseBoltArgs parses the arguments of the bbolt driver. The driver expects
+// the path to the database file, optionally followed by a boolean that
+// indicates whether the freelist should not be synced to disk.
+func parseBoltArgs(args ...interface{}) (string, bool, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return "", false, ErrInvalidArguments
+	}
+
+	path, ok := args[0].(string)
+	if !ok {
+		return "", false, ErrInvalidArguments
+	}
+
+	var noFreelistSync bool
+	if len(args) == 2 {
+		noFreelistSync, ok = args[1].(bool)
+		if !ok {
+			return "", false, ErrInvalidArguments
+		}
+	}
+
+	return path, noFreelistSync, nil
+}
+
+// createBoltBackend opens the bbolt database at the given path, creating it
+// if it doesn't exist yet.
+func createBoltBackend(args ...interface{}) (Backend, error) {
+	path, noFreelistSync, err := parseBoltArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBoltBackend(path, noFreelistSync)
+}
+
+// openBoltBackend opens the existing bbolt database at the given path.
+func openBoltBackend(args ...interface{}) (Backend, error) {
+	path, noFreelistSync, err := parseBoltArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, ErrDbDoesNotExist
+	}
+
+	return newBoltBackend(path, noFreelistSync)
+}
+
+// newBoltBackend opens the bbolt database at the given path.
+func newBoltBackend(path string, noFreelistSync bool) (Backend, error) {
+	// Specify bbolt freelist options to reduce heap pressure in case the
+	// freelist grows to be very large.
+	options := &bbolt.Options{
+		NoFreelistSync: noFreelistSync,
+		FreelistType:   bbolt.FreelistMapType,
+	}
+
+	db, err := bbolt.Open(path, boltFilePermission, options)
+	if err != nil {
+		return nil, convertBoltErr(err)
+	}
+
+	return &boltBackend{db: db}, nil
+}
+
+// convertBoltErr converts the errors returned by bbolt into the backend
+// agnostic errors of this package.
+func convertBoltErr(err error) error {
+	switch err {
+	case bbolt.ErrDatabaseNotOpen:
+		return ErrDatabaseNotOpen
+	case bbolt.ErrTxClosed:
+		return ErrTxClosed
+	case bbolt.ErrTxNotWritable:
+		return ErrTxNotWritable
+	case bbolt.ErrBucketNotFound:
+		return ErrBucketNotFound
+	case bbolt.ErrBucketExists:
+		return ErrBucketExists
+	case bbolt.ErrBucketNameRequired:
+		return ErrBucketNameRequired
+	case bbolt.ErrKeyRequired:
+		return ErrKeyRequired
+	case bbolt.ErrKeyTooLarge:
+		return ErrKeyTooLarge
+	case bbolt.ErrValueTooLarge:
+		return ErrValueTooLarge
+	case bbolt.ErrIncompatibleValue:
+		return ErrIncompatibleValue
+	default:
+		return err
+	}
+}
+
+// boltBackend is the Backend implementation backed by a bbolt database.
+type boltBackend struct {
+	db *bbolt.DB
+}
+
+// A compile time check to ensure boltBackend implements the Backend
+// interface.
+var _ Backend = (*boltBackend)(nil)
+
+// Begin starts a new transaction.
+//
+// NOTE: This is part of the Backend interface.
+func (b *boltBackend) Begin(writable bool) (Tx, error) {
+	tx, err := b.db.Begin(writable)
+	if err != nil {
+		return nil, convertBoltErr(err)
+	}
+
+	return &boltTx{tx: tx}, nil
+}
+
+// View executes the passed function within a read-only transaction.
+//
+// NOTE: This is part of the Backend interface.
+func (b *boltBackend) View(f func(tx Tx) error) error {
+	return convertBoltErr(b.db.View(func(tx *bbolt.Tx) error {
+		return f(&boltTx{tx: tx})
+	}))
+}
+
+// Update executes the passed function within a read-write transaction.
+//
+// NOTE: This is part of the Backend interface.
+func (b *boltBackend) Update(f func(tx Tx) error) error {
+	return convertBoltErr(b.db.Update(func(tx *bbolt.Tx) error {
+		return f(&boltTx{tx: tx})
+	}))
+}
+
+// Batch executes the passed function within a read-write transaction that
+// may be shared with other concurrent calls to Batch.
+//
+// NOTE: This is part of the Backend interface.
+func (b *boltBackend) Batch(f func(tx Tx) error) error {
+	return convertBoltErr(b.db.Batch(func(tx *bbolt.Tx) error {
+		return f(&boltTx{tx: tx})
+	}))
+}
+
+// Close closes the underlying bbolt database.
+//
+// NOTE: This is part of the Backend interface.
+func (b *boltBackend) Close() error {
+	return convertBoltErr(b.db.Close())
+}
+
+// boltTx wraps a bbolt transaction.
+type boltTx struct {
+	tx *bbolt.Tx
+}
+
+// A compile time check to ensure boltTx implements the Tx interface.
+var _ Tx = (*boltTx)(nil)
+
+// Bucket retrieves the top-level bucket with the given name.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) Bucket(name []byte) Bucket {
+	return wrapBoltBucket(t.tx.Bucket(name))
+}
+
+// CreateBucket creates a new top-level bucket with the given name.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) CreateBucket(name []byte) (Bucket, error) {
+	bucket, err := t.tx.CreateBucket(name)
+	if err != nil {
+		return nil, convertBoltErr(err)
+	}
+
+	return wrapBoltBucket(bucket), nil
+}
+
+// CreateBucketIfNotExists creates a new top-level bucket with the given name
+// if it doesn't exist yet.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	bucket, err := t.tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, convertBoltErr(err)
+	}
+
+	return wrapBoltBucket(bucket), nil
+}
+
+// DeleteBucket deletes the top-level bucket with the given name.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) DeleteBucket(name []byte) error {
+	return convertBoltErr(t.tx.DeleteBucket(name))
+}
+
+// ForEach executes the passed function for each top-level bucket.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) ForEach(f func(name []byte, b Bucket) error) error {
+	return convertBoltErr(t.tx.ForEach(
+		func(name []byte, b *bbolt.Bucket) error {
+			return f(name, wrapBoltBucket(b))
+		},
+	))
+}
+
+// Writable returns whether the transaction can modify the database.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) Writable() bool {
+	return t.tx.Writable()
+}
+
+// Commit writes all changes of the transaction to the database.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) Commit() error {
+	return convertBoltErr(t.tx.Commit())
+}
+
+// Rollback closes the transaction and discards all of its changes.
+//
+// NOTE: This is part of the Tx interface.
+func (t *boltTx) Rollback() error {
+	return convertBoltErr(t.tx.Rollback())
+}
+
+// boltBucket wraps a bbolt bucket.
+type boltBucket struct {
+	bucket *bbolt.Bucket
+}
+
+// A compile time check to ensure boltBucket implements the Bucket interface.
+var _ Bucket = (*boltBucket)(nil)
+
+// wrapBoltBucket wraps the given bbolt bucket. A nil bucket results in a nil
+// interface value, so that callers can compare the result against nil.
+func wrapBoltBucket(bucket *bbolt.Bucket) Bucket {
+	if bucket == nil {
+		return nil
+	}
+
+	return &boltBucket{bucket: bucket}
+}
+
+// Bucket retrieves the nested bucket with the given key.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) Bucket(key []byte) Bucket {
+	return wrapBoltBucket(b.bucket.Bucket(key))
+}
+
+// CreateBucket creates a new nested bucket with the given key.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) CreateBucket(key []byte) (Bucket, error) {
+	bucket, err := b.bucket.CreateBucket(key)
+	if err != nil {
+		return nil, convertBoltErr(err)
+	}
+
+	return wrapBoltBucket(bucket), nil
+}
+
+// CreateBucketIfNotExists creates a new nested bucket with the given key if it
+// doesn't exist yet.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) CreateBucketIfNotExists(key []byte) (Bucket, error) {
+	bucket, err := b.bucket.CreateBucketIfNotExists(key)
+	if err != nil {
+		return nil, convertBoltErr(err)
+	}
+
+	return wrapBoltBucket(bucket), nil
+}
+
+// DeleteBucket deletes the nested bucket with the given key.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) DeleteBucket(key []byte) error {
+	return convertBoltErr(b.bucket.DeleteBucket(key))
+}
+
+// Get returns the value for the given key.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) Get(key []byte) []byte {
+	return b.bucket.Get(key)
+}
+
+// Put sets the value for the given key.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) Put(key, value []byte) error {
+	return convertBoltErr(b.bucket.Put(key, value))
+}
+
+// Delete removes the given key.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) Delete(key []byte) error {
+	return convertBoltErr(b.bucket.Delete(key))
+}
+
+// ForEach executes the passed function for each key/value pair in the bucket.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) ForEach(f func(k, v []byte) error) error {
+	return convertBoltErr(b.bucket.ForEach(f))
+}
+
+// Cursor returns a new cursor that can be used to iterate over the bucket.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) Cursor() Cursor {
+	return &boltCursor{cursor: b.bucket.Cursor()}
+}
+
+// NextSequence returns an autoincrementing integer for the bucket.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) NextSequence() (uint64, error) {
+	seq, err := b.bucket.NextSequence()
+	return seq, convertBoltErr(err)
+}
+
+// Sequence returns the current sequence number of the bucket.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) Sequence() uint64 {
+	return b.bucket.Sequence()
+}
+
+// SetSequence updates the sequence number of the bucket.
+//
+// NOTE: This is part of the Bucket interface.
+func (b *boltBucket) SetSequence(v uint64) error {
+	return convertBoltErr(b.bucket.SetSequence(v))
+}
+
+// boltCursor wraps a bbolt cursor.
+type boltCursor struct {
+	cursor *bbolt.Cursor
+}
+
+// A compile time check to ensure boltCursor implements the Cursor interface.
+var _ Cursor = (*boltCursor)(nil)
+
+// First moves the cursor to the first item in the bucket.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *boltCursor) First() ([]byte, []byte) {
+	return c.cursor.First()
+}
+
+// Last moves the cursor to the last item in the bucket.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *boltCursor) Last() ([]byte, []byte) {
+	return c.cursor.Last()
+}
+
+// Next moves the cursor to the next item in the bucket.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *boltCursor) Next() ([]byte, []byte) {
+	return c.cursor.Next()
+}
+
+// Prev moves the cursor to the previous item in the bucket.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *boltCursor) Prev() ([]byte, []byte) {
+	return c.cursor.Prev()
+}
+
+// Seek moves the cursor to the given key, or the next key if it does not
+// exist.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *boltCursor) Seek(seek []byte) ([]byte, []byte) {
+	return c.cursor.Seek(seek)
+}
+
+// Delete removes the key/value pair the cursor is pointing at.
+//
+// NOTE: This is part of the Cursor interface.
+func (c *boltCursor) Delete() error {
+	return convertBoltErr(c.cursor.Delete())
+}
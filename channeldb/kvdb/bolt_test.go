@@ -0,0 +1,259 @@
+package kvdb
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// makeTestBoltBackend creates a bbolt backend within a temporary directory.
+// The returned function closes the backend and removes the directory.
+func makeTestBoltBackend(t *testing.T) (Backend, string, func()) {
+	t.Helper()
+
+	tempDir, err := ioutil.TempDir("", "kvdb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	path := filepath.Join(tempDir, "test.db")
+
+	db, err := Create(BoltBackendName, path)
+	if err != nil {
+		os.RemoveAll(tempDir)
+		t.Fatalf("unable to create backend: %v", err)
+	}
+
+	return db, path, func() {
+		db.Close()
+		os.RemoveAll(tempDir)
+	}
+}
+
+// TestBoltOpen asserts that the bbolt driver only opens existing databases
+// through Open, and rejects unknown drivers and arguments.
+func TestBoltOpen(t *testing.T) {
+	t.Parallel()
+
+	db, path, cleanUp := makeTestBoltBackend(t)
+	defer cleanUp()
+
+	_, err := Open(BoltBackendName, path+".missing")
+	if err != ErrDbDoesNotExist {
+		t.Fatalf("expected ErrDbDoesNotExist, got: %v", err)
+	}
+
+	_, err = Open("unknown", path)
+	if err != ErrDbUnknownType {
+		t.Fatalf("expected ErrDbUnknownType, got: %v", err)
+	}
+
+	_, err = Create(BoltBackendName, 1)
+	if err != ErrInvalidArguments {
+		t.Fatalf("expected ErrInvalidArguments, got: %v", err)
+	}
+
+	// Data written to the database should be available after reopening
+	// it with Open.
+	err = db.Update(func(tx Tx) error {
+		bucket, err := tx.CreateBucket([]byte("bucket"))
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte("key"), []byte("value"))
+	})
+	if err != nil {
+		t.Fatalf("unable to write to db: %v", err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatalf("unable to close db: %v", err)
+	}
+
+	db, err = Open(BoltBackendName, path, true)
+	if err != nil {
+		t.Fatalf("unable to open db: %v", err)
+	}
+	defer db.Close()
+
+	err = db.View(func(tx Tx) error {
+		bucket := tx.Bucket([]byte("bucket"))
+		if bucket == nil {
+			return errors.New("bucket not found")
+		}
+
+		value := bucket.Get([]byte("key"))
+		if !bytes.Equal(value, []byte("value")) {
+			return errors.New("unexpected value")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read from db: %v", err)
+	}
+}
+
+// TestBoltBuckets asserts that the bbolt backend behaves as expected when
+// creating, retrieving and deleting buckets, and that its errors are converted
+// into the errors of this package.
+func TestBoltBuckets(t *testing.T) {
+	t.Parallel()
+
+	db, _, cleanUp := makeTestBoltBackend(t)
+	defer cleanUp()
+
+	err := db.Update(func(tx Tx) error {
+		// Missing buckets must result in a nil interface value, so
+		// callers can compare them against nil.
+		if tx.Bucket([]byte("top")) != nil {
+			return errors.New("expected missing bucket")
+		}
+		err := tx.DeleteBucket([]byte("top"))
+		if err != ErrBucketNotFound {
+			return errors.New("expected ErrBucketNotFound")
+		}
+
+		top, err := tx.CreateBucket([]byte("top"))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucket([]byte("top"))
+		if err != ErrBucketExists {
+			return errors.New("expected ErrBucketExists")
+		}
+
+		if top.Bucket([]byte("nested")) != nil {
+			return errors.New("expected missing nested bucket")
+		}
+		nested, err := top.CreateBucketIfNotExists([]byte("nested"))
+		if err != nil {
+			return err
+		}
+		err = nested.Put(nil, []byte("value"))
+		if err != ErrKeyRequired {
+			return errors.New("expected ErrKeyRequired")
+		}
+
+		var names [][]byte
+		err = tx.ForEach(func(name []byte, b Bucket) error {
+			if b == nil {
+				return errors.New("expected bucket")
+			}
+			names = append(names, name)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if len(names) != 1 || !bytes.Equal(names[0], []byte("top")) {
+			return errors.New("unexpected top-level buckets")
+		}
+
+		return top.DeleteBucket([]byte("nested"))
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Read-only transactions must not be able to modify the database.
+	err = db.View(func(tx Tx) error {
+		if tx.Writable() {
+			return errors.New("expected read-only tx")
+		}
+
+		_, err := tx.CreateBucket([]byte("other"))
+		return err
+	})
+	if err != ErrTxNotWritable {
+		t.Fatalf("expected ErrTxNotWritable, got: %v", err)
+	}
+}
+
+// TestBoltCursorAndSequence asserts that cursors iterate over buckets in order
+// and that bucket sequences are persisted.
+func TestBoltCursorAndSequence(t *testing.T) {
+	t.Parallel()
+
+	db, _, cleanUp := makeTestBoltBackend(t)
+	defer cleanUp()
+
+	keys := [][]byte{{1}, {2}, {3}}
+	err := db.Update(func(tx Tx) error {
+		bucket, err := tx.CreateBucket([]byte("bucket"))
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			seq, err := bucket.NextSequence()
+			if err != nil {
+				return err
+			}
+			if seq != uint64(key[0]) {
+				return errors.New("unexpected sequence")
+			}
+
+			if err := bucket.Put(key, key); err != nil {
+				return err
+			}
+		}
+
+		return bucket.SetSequence(10)
+	})
+	if err != nil {
+		t.Fatalf("unable to populate db: %v", err)
+	}
+
+	// A failing update must be rolled back.
+	errFail := errors.New("fail")
+	err = db.Update(func(tx Tx) error {
+		bucket := tx.Bucket([]byte("bucket"))
+		if err := bucket.Put([]byte{4}, []byte{4}); err != nil {
+			return err
+		}
+
+		return errFail
+	})
+	if err != errFail {
+		t.Fatalf("expected errFail, got: %v", err)
+	}
+
+	tx, err := db.Begin(false)
+	if err != nil {
+		t.Fatalf("unable to begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	bucket := tx.Bucket([]byte("bucket"))
+	if bucket.Sequence() != 10 {
+		t.Fatalf("expected sequence 10, got %v", bucket.Sequence())
+	}
+
+	var i int
+	c := bucket.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if i >= len(keys) {
+			t.Fatalf("unexpected key %x", k)
+		}
+		if !bytes.Equal(k, keys[i]) || !bytes.Equal(v, keys[i]) {
+			t.Fatalf("expected key %x, got %x", keys[i], k)
+		}
+		i++
+	}
+	if i != len(keys) {
+		t.Fatalf("expected %v keys, got %v", len(keys), i)
+	}
+
+	if k, _ := c.Last(); !bytes.Equal(k, keys[2]) {
+		t.Fatalf("expected last key %x, got %x", keys[2], k)
+	}
+	if k, _ := c.Prev(); !bytes.Equal(k, keys[1]) {
+		t.Fatalf("expected previous key %x, got %x", keys[1], k)
+	}
+	if k, _ := c.Seek([]byte{2, 0}); !bytes.Equal(k, keys[2]) {
+		t.Fatalf("expected seeked key %x, got %x", keys[2], k)
+	}
+}
@@ -0,0 +1,126 @@
+package kvdb
+
+// WalkFunc is the function called for each key/value pair and nested bucket
+// visited by Walk. The path holds the keys of all buckets leading up to the
+// current item, starting with the top-level bucket. Buckets are passed with a
+// nil value and their sequence number, values with a zero sequence.
+type WalkFunc func(path [][]byte, k, v []byte, seq uint64) error
+
+// Walk visits all buckets and key/value pairs within the transaction in
+// depth-first, byte-sorted order, calling the passed function for each of
+// them. Top-level buckets are passed with an empty path.
+func Walk(tx Tx, fn WalkFunc) error {
+	return tx.ForEach(func(name []byte, b Bucket) error {
+		return walkBucket(b, nil, name, fn)
+	})
+}
+
+// walkBucket visits the given bucket and recursively all of its contents.
+func walkBucket(b Bucket, path [][]byte, name []byte, fn WalkFunc) error {
+	if err := fn(path, name, nil, b.Sequence()); err != nil {
+		return err
+	}
+
+	// The path is copied, so that the slices handed to the callback for
+	// sibling buckets don't share their backing array.
+	nestedPath := make([][]byte, len(path)+1)
+	copy(nestedPath, path)
+	nestedPath[len(path)] = name
+
+	return b.ForEach(func(k, v []byte) error {
+		if v == nil {
+			return walkBucket(b.Bucket(k), nestedPath, k, fn)
+		}
+
+		return fn(nestedPath, k, v, 0)
+	})
+}
+
+// Compact copies all buckets, key/value pairs and bucket sequences from the
+// source backend into the destination backend, which is expected to be empty.
+// Since the source isn't modified, the copy only occupies as much space as its
+// live data, making this a way to compact a database. If txMaxSize is
+// non-zero, the writes to the destination are split up into multiple
+// transactions of roughly that many bytes, in order to limit the memory used
+// for large databases.
+func Compact(dst, src Backend, txMaxSize int64) error {
+	tx, err := dst.Begin(true)
+	if err != nil {
+		return err
+	}
+
+	// The destination transaction is replaced while copying, so we'll make
+	// sure to roll back whichever one is open if we fail.
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var size int64
+	err = src.View(func(srcTx Tx) error {
+		return Walk(srcTx, func(path [][]byte, k, v []byte,
+			seq uint64) error {
+
+			// If this item would push the transaction over the
+			// size limit, commit it and start a new one.
+			itemSize := int64(len(k) + len(v))
+			if txMaxSize != 0 && size+itemSize > txMaxSize {
+				if err := tx.Commit(); err != nil {
+					tx = nil
+					return err
+				}
+
+				tx, err = dst.Begin(true)
+				if err != nil {
+					tx = nil
+					return err
+				}
+				size = 0
+			}
+			size += itemSize
+
+			// Top-level items are always buckets.
+			if len(path) == 0 {
+				b, err := tx.CreateBucket(k)
+				if err != nil {
+					return err
+				}
+
+				return b.SetSequence(seq)
+			}
+
+			// Otherwise, we'll need to look up the parent bucket
+			// within the current destination transaction.
+			parent := tx.Bucket(path[0])
+			for _, key := range path[1:] {
+				if parent == nil {
+					break
+				}
+				parent = parent.Bucket(key)
+			}
+			if parent == nil {
+				return ErrBucketNotFound
+			}
+
+			if v == nil {
+				b, err := parent.CreateBucket(k)
+				if err != nil {
+					return err
+				}
+
+				return b.SetSequence(seq)
+			}
+
+			return parent.Put(k, v)
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	tx = nil
+
+	return err
+}
@@ -0,0 +1,94 @@
+package kvdb
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+// TestCompact asserts that Compact copies all buckets, values and sequences,
+// also when the copy is split up into multiple transactions.
+func TestCompact(t *testing.T) {
+	t.Parallel()
+
+	src, _, cleanUpSrc := makeTestBoltBackend(t)
+	defer cleanUpSrc()
+
+	err := src.Update(func(tx Tx) error {
+		for i := 0; i < 3; i++ {
+			top, err := tx.CreateBucket([]byte{byte(i)})
+			if err != nil {
+				return err
+			}
+			if err := top.SetSequence(uint64(i + 10)); err != nil {
+				return err
+			}
+
+			nested, err := top.CreateBucket([]byte("nested"))
+			if err != nil {
+				return err
+			}
+			if err := nested.SetSequence(uint64(i + 20)); err != nil {
+				return err
+			}
+
+			for j := 0; j < 50; j++ {
+				k := []byte(fmt.Sprintf("key-%d", j))
+				v := bytes.Repeat([]byte{byte(j)}, 100)
+				if err := top.Put(k, v); err != nil {
+					return err
+				}
+				if err := nested.Put(k, v); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to populate db: %v", err)
+	}
+
+	// dump returns all items of the backend in the order they are walked.
+	dump := func(db Backend) []string {
+		var items []string
+		err := db.View(func(tx Tx) error {
+			return Walk(tx, func(path [][]byte, k, v []byte,
+				seq uint64) error {
+
+				items = append(items, fmt.Sprintf("%x/%x=%x@%d",
+					bytes.Join(path, []byte("/")), k, v, seq))
+				return nil
+			})
+		})
+		if err != nil {
+			t.Fatalf("unable to walk db: %v", err)
+		}
+
+		return items
+	}
+	expected := dump(src)
+
+	for _, txMaxSize := range []int64{0, 1000} {
+		dst, _, cleanUpDst := makeTestBoltBackend(t)
+
+		if err := Compact(dst, src, txMaxSize); err != nil {
+			t.Fatalf("unable to compact db: %v", err)
+		}
+
+		items := dump(dst)
+		if len(items) != len(expected) {
+			t.Fatalf("expected %v items, got %v", len(expected),
+				len(items))
+		}
+		for i := range items {
+			if items[i] != expected[i] {
+				t.Fatalf("expected item %v, got %v",
+					expected[i], items[i])
+			}
+		}
+
+		cleanUpDst()
+	}
+}
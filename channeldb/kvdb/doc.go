@@ -0,0 +1,6 @@
+// Package kvdb defines the transactional key-value interface that lnd's
+// databases are built on. Stores only interact with the Backend, Tx, Bucket
+// and Cursor interfaces, so the storage engine can be swapped out by
+// registering a different driver. A bbolt driver is always registered under
+// BoltBackendName and serves as the default backend.
+package kvdb
@@ -0,0 +1,137 @@
+package kvdb
+
+import (
+	"errors"
+)
+
+// Errors that can occur during driver registration and database creation.
+var (
+	// ErrDbTypeRegistered is returned when two different database drivers
+	// attempt to register with the same database type.
+	ErrDbTypeRegistered = errors.New("database type already registered")
+
+	// ErrDbUnknownType is returned when there is no driver registered for
+	// the specified database type.
+	ErrDbUnknownType = errors.New("unknown database type")
+
+	// ErrDbDoesNotExist is returned when open is called for a database
+	// that does not exist.
+	ErrDbDoesNotExist = errors.New("database does not exist")
+
+	// ErrDatabaseNotOpen is returned when a database instance is accessed
+	// before it is opened or after it is closed.
+	ErrDatabaseNotOpen = errors.New("database not open")
+
+	// ErrInvalidArguments is returned when a driver is passed arguments it
+	// doesn't understand.
+	ErrInvalidArguments = errors.New("invalid database arguments")
+)
+
+// Errors that can occur within transactions.
+var (
+	// ErrTxClosed is returned when committing or rolling back a
+	// transaction that has already been closed.
+	ErrTxClosed = errors.New("tx closed")
+
+	// ErrTxNotWritable is returned when an operation that requires write
+	// access to the database is attempted within a read-only transaction.
+	ErrTxNotWritable = errors.New("tx not writable")
+
+	// ErrBucketNotFound is returned when trying to access a bucket that
+	// has not been created yet.
+	ErrBucketNotFound = errors.New("bucket not found")
+
+	// ErrBucketExists is returned when creating a bucket that already
+	// exists.
+	ErrBucketExists = errors.New("bucket already exists")
+
+	// ErrBucketNameRequired is returned when creating a bucket with a
+	// blank name.
+	ErrBucketNameRequired = errors.New("bucket name required")
+
+	// ErrKeyRequired is returned when inserting a zero-length key.
+	ErrKeyRequired = errors.New("key required")
+
+	// ErrKeyTooLarge is returned when inserting a key that is larger than
+	// the backend supports.
+	ErrKeyTooLarge = errors.New("key too large")
+
+	// ErrValueTooLarge is returned when inserting a value that is larger
+	// than the backend supports.
+	ErrValueTooLarge = errors.New("value too large")
+
+	// ErrIncompatibleValue is returned when trying to create or delete a
+	// bucket on an existing non-bucket key or when trying to create or
+	// delete a non-bucket key on an existing bucket key.
+	ErrIncompatibleValue = errors.New("incompatible value")
+)
+
+// Driver defines a structure for backend drivers to use when they register
+// themselves as a backend which implements the Backend interface.
+type Driver struct {
+	// DbType is the identifier used to uniquely identify a specific
+	// database driver. There can be only one driver with the same name.
+	DbType string
+
+	// Create is the function that will be invoked with all user-specified
+	// arguments to open the database, creating it if it doesn't exist
+	// yet.
+	Create func(args ...interface{}) (Backend, error)
+
+	// Open is the function that will be invoked with all user-specified
+	// arguments to open the database. This function must return
+	// ErrDbDoesNotExist if the database has not already been created.
+	Open func(args ...interface{}) (Backend, error)
+}
+
+// drivers holds all of the registered database backends.
+var drivers = make(map[string]*Driver)
+
+// RegisterDriver adds a backend database driver to the available interfaces.
+// ErrDbTypeRegistered will be returned if the database type for the driver has
+// already been registered.
+func RegisterDriver(driver Driver) error {
+	if _, exists := drivers[driver.DbType]; exists {
+		return ErrDbTypeRegistered
+	}
+
+	drivers[driver.DbType] = &driver
+	return nil
+}
+
+// SupportedDrivers returns a slice of strings that represent the database
+// drivers that have been registered and are therefore supported.
+func SupportedDrivers() []string {
+	supportedDBs := make([]string, 0, len(drivers))
+	for _, drv := range drivers {
+		supportedDBs = append(supportedDBs, drv.DbType)
+	}
+
+	return supportedDBs
+}
+
+// Create opens a database of the specified type, creating it if it doesn't
+// exist yet. The arguments are specific to the database type driver.
+//
+// ErrDbUnknownType will be returned if the database type is not registered.
+func Create(dbType string, args ...interface{}) (Backend, error) {
+	drv, exists := drivers[dbType]
+	if !exists {
+		return nil, ErrDbUnknownType
+	}
+
+	return drv.Create(args...)
+}
+
+// Open opens an existing database of the specified type. The arguments are
+// specific to the database type driver.
+//
+// ErrDbUnknownType will be returned if the database type is not registered.
+func Open(dbType string, args ...interface{}) (Backend, error) {
+	drv, exists := drivers[dbType]
+	if !exists {
+		return nil, ErrDbUnknownType
+	}
+
+	return drv.Open(args...)
+}
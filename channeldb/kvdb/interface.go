@@ -0,0 +1,152 @@
+package kvdb
+
+// Backend is a transactional key-value store that is organized in nested
+// buckets. It is the interface all of lnd's databases are built on, which
+// allows the underlying storage engine to be swapped out without touching the
+// logic of the stores themselves.
+type Backend interface {
+	// Begin starts a new transaction. Multiple read-only transactions can
+	// be used concurrently, but only one read-write transaction can be
+	// active at a time. The transaction MUST be closed by calling either
+	// Commit or Rollback.
+	Begin(writable bool) (Tx, error)
+
+	// View executes the passed function within the context of a managed
+	// read-only transaction. Any error returned from the function is
+	// returned from View.
+	View(f func(tx Tx) error) error
+
+	// Update executes the passed function within the context of a
+	// read-write managed transaction. If the function returns nil, the
+	// transaction is committed, otherwise it is rolled back and the error
+	// is returned from Update.
+	Update(f func(tx Tx) error) error
+
+	// Batch is similar to Update, but allows backends to combine multiple
+	// concurrent calls into a single transaction. Because of this, the
+	// passed function may be called multiple times and must be
+	// idempotent. Backends that don't support batching execute the
+	// function as a regular update.
+	Batch(f func(tx Tx) error) error
+
+	// Close releases all database resources. All transactions must be
+	// closed before closing the database.
+	Close() error
+}
+
+// Tx is a transaction on a Backend. Read-only transactions can be used to
+// retrieve values and iterate over buckets, read-write transactions can
+// additionally create, modify and delete buckets and values.
+type Tx interface {
+	// Bucket retrieves the top-level bucket with the given name. Nil is
+	// returned if the bucket does not exist.
+	Bucket(name []byte) Bucket
+
+	// CreateBucket creates a new top-level bucket with the given name.
+	// ErrBucketExists is returned if the bucket already exists.
+	CreateBucket(name []byte) (Bucket, error)
+
+	// CreateBucketIfNotExists creates a new top-level bucket with the
+	// given name if it doesn't exist yet, and returns it.
+	CreateBucketIfNotExists(name []byte) (Bucket, error)
+
+	// DeleteBucket deletes the top-level bucket with the given name.
+	// ErrBucketNotFound is returned if the bucket does not exist.
+	DeleteBucket(name []byte) error
+
+	// ForEach executes the passed function for each top-level bucket. If
+	// the function returns an error, the iteration is stopped and the
+	// error is returned.
+	ForEach(f func(name []byte, b Bucket) error) error
+
+	// Writable returns whether the transaction can be used to modify the
+	// database.
+	Writable() bool
+
+	// Commit writes all changes of the transaction to the database.
+	Commit() error
+
+	// Rollback closes the transaction and discards all of its changes.
+	Rollback() error
+}
+
+// Bucket is a collection of key/value pairs and nested buckets within a
+// transaction. Keys are iterated over in byte-sorted order.
+type Bucket interface {
+	// Bucket retrieves the nested bucket with the given key. Nil is
+	// returned if the bucket does not exist.
+	Bucket(key []byte) Bucket
+
+	// CreateBucket creates a new nested bucket with the given key.
+	// ErrBucketExists is returned if the bucket already exists.
+	CreateBucket(key []byte) (Bucket, error)
+
+	// CreateBucketIfNotExists creates a new nested bucket with the given
+	// key if it doesn't exist yet, and returns it.
+	CreateBucketIfNotExists(key []byte) (Bucket, error)
+
+	// DeleteBucket deletes the nested bucket with the given key.
+	// ErrBucketNotFound is returned if the bucket does not exist.
+	DeleteBucket(key []byte) error
+
+	// Get returns the value for the given key, or nil if the key does not
+	// exist or refers to a nested bucket. The returned value is only valid
+	// for the lifetime of the transaction.
+	Get(key []byte) []byte
+
+	// Put sets the value for the given key, overwriting any existing
+	// value.
+	Put(key, value []byte) error
+
+	// Delete removes the given key. Deleting a key that does not exist is
+	// not an error.
+	Delete(key []byte) error
+
+	// ForEach executes the passed function for each key/value pair in the
+	// bucket. Nested buckets are passed with a nil value. The bucket must
+	// not be modified while iterating over it.
+	ForEach(f func(k, v []byte) error) error
+
+	// Cursor returns a new cursor that can be used to iterate over the
+	// bucket.
+	Cursor() Cursor
+
+	// NextSequence returns an autoincrementing integer for the bucket.
+	NextSequence() (uint64, error)
+
+	// Sequence returns the current sequence number of the bucket without
+	// incrementing it.
+	Sequence() uint64
+
+	// SetSequence updates the sequence number of the bucket.
+	SetSequence(v uint64) error
+}
+
+// Cursor iterates over the key/value pairs of a bucket in byte-sorted order.
+// Nested buckets are returned with a nil value. All returned keys and values
+// are only valid for the lifetime of the transaction.
+type Cursor interface {
+	// First moves the cursor to the first item in the bucket and returns
+	// its key and value.
+	First() (key, value []byte)
+
+	// Last moves the cursor to the last item in the bucket and returns its
+	// key and value.
+	Last() (key, value []byte)
+
+	// Next moves the cursor to the next item in the bucket and returns its
+	// key and value. A nil key is returned once the end is reached.
+	Next() (key, value []byte)
+
+	// Prev moves the cursor to the previous item in the bucket and returns
+	// its key and value. A nil key is returned once the start is reached.
+	Prev() (key, value []byte)
+
+	// Seek moves the cursor to the given key, or the next key if it does
+	// not exist, and returns its key and value.
+	Seek(seek []byte) (key, value []byte)
+
+	// Delete removes the key/value pair the cursor is currently pointing
+	// at from the bucket.
+	Delete() error
+}
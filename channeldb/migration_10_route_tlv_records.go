@@ -4,14 +4,14 @@ import (
 	"bytes"
 	"io"
 
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/routing/route"
-	"github.com/coreos/bbolt"
 )
 
 // migrateRouteSerialization migrates the way we serialize routes across the
 // entire database. At the time of writing of this migration, this includes our
 // payment attempts, as well as the payment results in mission control.
-func migrateRouteSerialization(tx *bbolt.Tx) error {
+func migrateRouteSerialization(tx kvdb.Tx) error {
 	// First, we'll do all the payment attempts.
 	rootPaymentBucket := tx.Bucket(paymentsRootBucket)
 	if rootPaymentBucket == nil {
@@ -85,7 +85,7 @@ func migrateRouteSerialization(tx *bbolt.Tx) error {
 
 	resultsKey := []byte("missioncontrol-results")
 	err = tx.DeleteBucket(resultsKey)
-	if err != nil && err != bbolt.ErrBucketNotFound {
+	if err != nil && err != kvdb.ErrBucketNotFound {
 		return err
 	}
 
@@ -96,7 +96,7 @@ func migrateRouteSerialization(tx *bbolt.Tx) error {
 
 // migrateAttemptEncoding migrates payment attempts using the legacy format to
 // the new format.
-func migrateAttemptEncoding(tx *bbolt.Tx, payHashBucket *bbolt.Bucket) error {
+func migrateAttemptEncoding(tx kvdb.Tx, payHashBucket kvdb.Bucket) error {
 	payAttemptBytes := payHashBucket.Get(paymentAttemptInfoKey)
 	if payAttemptBytes == nil {
 		return nil
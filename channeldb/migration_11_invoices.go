@@ -6,17 +6,17 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/lnwire"
 	"github.com/BTCGPU/lnd/zpay32"
 	bitcoinCfg "github.com/btgsuite/btgd/chaincfg"
 	"github.com/btgsuite/btgd/wire"
-	"github.com/coreos/bbolt"
 	litecoinCfg "github.com/ltcsuite/ltcd/chaincfg"
 )
 
 // migrateInvoices adds invoice htlcs and a separate cltv delta field to the
 // invoices.
-func migrateInvoices(tx *bbolt.Tx) error {
+func migrateInvoices(tx kvdb.Tx) error {
 	log.Infof("Migrating invoices to new invoice format")
 
 	invoiceB := tx.Bucket(invoiceBucket)
@@ -96,7 +96,7 @@ func migrateInvoices(tx *bbolt.Tx) error {
 		// Serialize the invoice in the new format and use it to replace
 		// the old invoice in the database.
 		var buf bytes.Buffer
-		if err := serializeInvoice(&buf, &invoice); err != nil {
+		if err := serializeInvoiceV11(&buf, &invoice); err != nil {
 			return err
 		}
 
@@ -6,10 +6,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/zpay32"
 	"github.com/btgsuite/btgd/btcec"
 	bitcoinCfg "github.com/btgsuite/btgd/chaincfg"
-	"github.com/coreos/bbolt"
 	litecoinCfg "github.com/ltcsuite/ltcd/chaincfg"
 )
 
@@ -26,7 +26,7 @@ var (
 
 // beforeMigrationFuncV11 insert the test invoices in the database.
 func beforeMigrationFuncV11(t *testing.T, d *DB, invoices []Invoice) {
-	err := d.Update(func(tx *bbolt.Tx) error {
+	err := d.Update(func(tx kvdb.Tx) error {
 		invoicesBucket, err := tx.CreateBucketIfNotExists(
 			invoiceBucket,
 		)
@@ -61,6 +61,38 @@ func beforeMigrationFuncV11(t *testing.T, d *DB, invoices []Invoice) {
 	}
 }
 
+// fetchInvoicesV11 fetches all invoices from the database, expecting them to
+// be stored in the format of db version 11.
+func fetchInvoicesV11(d *DB) ([]Invoice, error) {
+	var invoices []Invoice
+	err := d.View(func(tx kvdb.Tx) error {
+		invoicesBucket := tx.Bucket(invoiceBucket)
+		if invoicesBucket == nil {
+			return ErrNoInvoicesCreated
+		}
+
+		return invoicesBucket.ForEach(func(k, v []byte) error {
+			if v == nil {
+				return nil
+			}
+
+			r := bytes.NewReader(v)
+			invoice, err := deserializeInvoiceV11(r)
+			if err != nil {
+				return err
+			}
+			invoices = append(invoices, invoice)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return invoices, nil
+}
+
 // TestMigrateInvoices checks that invoices are migrated correctly.
 func TestMigrateInvoices(t *testing.T) {
 	t.Parallel()
@@ -97,7 +129,7 @@ func TestMigrateInvoices(t *testing.T) {
 			t.Fatal("migration 'invoices' wasn't applied")
 		}
 
-		dbInvoices, err := d.FetchAllInvoices(false)
+		dbInvoices, err := fetchInvoicesV11(d)
 		if err != nil {
 			t.Fatalf("unable to fetch invoices: %v", err)
 		}
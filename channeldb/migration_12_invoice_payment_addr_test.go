@@ -0,0 +1,120 @@
+package channeldb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
+)
+
+// beforeMigrationFuncV12 inserts the test invoices in the database in the
+// format of db version 11.
+func beforeMigrationFuncV12(t *testing.T, d *DB, invoices []Invoice) {
+	err := d.Update(func(tx kvdb.Tx) error {
+		invoicesBucket, err := tx.CreateBucketIfNotExists(
+			invoiceBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		for i, invoice := range invoices {
+			var invoiceKey [4]byte
+			byteOrder.PutUint32(invoiceKey[:], uint32(i+1))
+
+			var buf bytes.Buffer
+			err := serializeInvoiceV11(&buf, &invoice) // nolint:scopelint
+			if err != nil {
+				return err
+			}
+
+			err = invoicesBucket.Put(invoiceKey[:], buf.Bytes())
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestMigrateInvoicePaymentAddr checks that invoices are migrated to the
+// format that includes the payment address and features, without altering any
+// of their other fields.
+func TestMigrateInvoicePaymentAddr(t *testing.T) {
+	t.Parallel()
+
+	invoice, err := randInvoice(1000)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	invoice.Terms.State = ContractAccepted
+	invoice.Htlcs = map[CircuitKey]*InvoiceHTLC{
+		{HtlcID: 1}: {
+			Amt:           500,
+			Expiry:        100,
+			State:         HtlcStateAccepted,
+			CustomRecords: make(map[uint64][]byte),
+		},
+	}
+	invoices := []Invoice{*invoice}
+
+	afterMigrationFunc := func(d *DB) {
+		dbInvoices, err := d.FetchAllInvoices(false)
+		if err != nil {
+			t.Fatalf("unable to fetch invoices: %v", err)
+		}
+
+		if len(dbInvoices) != len(invoices) {
+			t.Fatalf("expected %d invoices, got %d", len(invoices),
+				len(dbInvoices))
+		}
+
+		// The migrated invoices don't require a payment address.
+		dbInvoice := dbInvoices[0]
+		if dbInvoice.Terms.PaymentAddr != BlankPayAddr {
+			t.Fatalf("expected zero payment addr, got %x",
+				dbInvoice.Terms.PaymentAddr)
+		}
+		if dbInvoice.Terms.Features != nil {
+			t.Fatalf("expected no features, got %v",
+				dbInvoice.Terms.Features)
+		}
+
+		expected := invoices[0]
+		if dbInvoice.Terms.PaymentPreimage !=
+			expected.Terms.PaymentPreimage {
+
+			t.Fatal("incorrect payment preimage")
+		}
+		if dbInvoice.Terms.Value != expected.Terms.Value {
+			t.Fatal("incorrect value")
+		}
+		if dbInvoice.Terms.State != expected.Terms.State {
+			t.Fatal("incorrect state")
+		}
+		if !bytes.Equal(dbInvoice.Memo, expected.Memo) {
+			t.Fatal("incorrect memo")
+		}
+		if !bytes.Equal(
+			dbInvoice.PaymentRequest, expected.PaymentRequest,
+		) {
+			t.Fatal("incorrect payment request")
+		}
+		if dbInvoice.Expiry != expected.Expiry {
+			t.Fatal("incorrect expiry")
+		}
+		if len(dbInvoice.Htlcs) != 1 {
+			t.Fatalf("expected 1 htlc, got %d", len(dbInvoice.Htlcs))
+		}
+	}
+
+	applyMigration(t,
+		func(d *DB) { beforeMigrationFuncV12(t, d, invoices) },
+		afterMigrationFunc,
+		migrateInvoicePaymentAddr,
+		false)
+}
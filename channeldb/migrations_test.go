@@ -10,11 +10,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/lntypes"
 	"github.com/BTCGPU/lnd/lnwire"
 	"github.com/BTCGPU/lnd/routing/route"
 	btcutil "github.com/btgsuite/btgutil"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
 )
@@ -60,7 +60,7 @@ func TestPaymentStatusesMigration(t *testing.T) {
 		// locally-sourced payment should end up with an InFlight
 		// status, while the other should remain unchanged, which
 		// defaults to Grounded.
-		err = d.Update(func(tx *bbolt.Tx) error {
+		err = d.Update(func(tx kvdb.Tx) error {
 			circuits, err := tx.CreateBucketIfNotExists(
 				[]byte("circuit-adds"),
 			)
@@ -387,7 +387,7 @@ func TestMigrateOptionalChannelCloseSummaryFields(t *testing.T) {
 			// Get the old serialization format for this test's
 			// close summary, and it to the closed channel bucket.
 			old := test.oldSerialization(test.closeSummary)
-			err = d.Update(func(tx *bbolt.Tx) error {
+			err = d.Update(func(tx kvdb.Tx) error {
 				closedChanBucket, err := tx.CreateBucketIfNotExists(
 					closedChannelBucket,
 				)
@@ -423,7 +423,7 @@ func TestMigrateOptionalChannelCloseSummaryFields(t *testing.T) {
 			newSerialization := b.Bytes()
 
 			var dbSummary []byte
-			err = d.View(func(tx *bbolt.Tx) error {
+			err = d.View(func(tx kvdb.Tx) error {
 				closedChanBucket := tx.Bucket(closedChannelBucket)
 				if closedChanBucket == nil {
 					return errors.New("unable to find bucket")
@@ -501,7 +501,7 @@ func TestMigrateGossipMessageStoreKeys(t *testing.T) {
 			t.Fatalf("unable to serialize message: %v", err)
 		}
 
-		err := db.Update(func(tx *bbolt.Tx) error {
+		err := db.Update(func(tx kvdb.Tx) error {
 			messageStore, err := tx.CreateBucketIfNotExists(
 				messageStoreBucket,
 			)
@@ -530,7 +530,7 @@ func TestMigrateGossipMessageStoreKeys(t *testing.T) {
 		}
 
 		var rawMsg []byte
-		err = db.View(func(tx *bbolt.Tx) error {
+		err = db.View(func(tx kvdb.Tx) error {
 			messageStore := tx.Bucket(messageStoreBucket)
 			if messageStore == nil {
 				return errors.New("message store bucket not " +
@@ -702,7 +702,7 @@ func TestOutgoingPaymentsMigration(t *testing.T) {
 
 		// Finally, check that the payment sequence number is updated
 		// to reflect the migrated payments.
-		err = d.View(func(tx *bbolt.Tx) error {
+		err = d.View(func(tx kvdb.Tx) error {
 			payments := tx.Bucket(paymentsRootBucket)
 			if payments == nil {
 				return fmt.Errorf("payments bucket not found")
@@ -782,7 +782,7 @@ func TestPaymentRouteSerialization(t *testing.T) {
 	// We'll first add a series of fake payments, using the existing legacy
 	// serialization format.
 	beforeMigrationFunc := func(d *DB) {
-		err := d.Update(func(tx *bbolt.Tx) error {
+		err := d.Update(func(tx kvdb.Tx) error {
 			paymentsBucket, err := tx.CreateBucket(
 				paymentsRootBucket,
 			)
@@ -834,7 +834,7 @@ func TestPaymentRouteSerialization(t *testing.T) {
 				// the proper bucket. If this is the duplicate
 				// payment, then we'll grab the dup bucket,
 				// otherwise, we'll use the top level bucket.
-				var payHashBucket *bbolt.Bucket
+				var payHashBucket kvdb.Bucket
 				if i < numPayments-1 {
 					payHashBucket, err = paymentsBucket.CreateBucket(
 						payInfo.PaymentHash[:],
@@ -26,6 +26,11 @@ type Options struct {
 	// freelist to disk, resulting in improved performance at the expense of
 	// increased startup time.
 	NoFreelistSync bool
+
+	// AutoCompact, if true, compacts the database when it is opened by
+	// copying its contents into a fresh file. This returns the space of
+	// deleted data to the operating system.
+	AutoCompact bool
 }
 
 // DefaultOptions returns an Options populated with default values.
@@ -60,3 +65,10 @@ func OptionSetSyncFreelist(b bool) OptionModifier {
 		o.NoFreelistSync = !b
 	}
 }
+
+// OptionAutoCompact sets whether the database is compacted when it is opened.
+func OptionAutoCompact(b bool) OptionModifier {
+	return func(o *Options) {
+		o.AutoCompact = b
+	}
+}
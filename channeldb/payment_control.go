@@ -6,9 +6,9 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/lntypes"
 	"github.com/BTCGPU/lnd/routing/route"
-	"github.com/coreos/bbolt"
 )
 
 var (
@@ -35,6 +35,14 @@ var (
 	// existing state of a payment.
 	ErrUnknownPaymentStatus = errors.New("unknown payment status")
 
+	// ErrAttemptNotFound is returned when we attempt to settle or fail an
+	// HTLC attempt that was never registered for the payment.
+	ErrAttemptNotFound = errors.New("htlc attempt not found")
+
+	// ErrAttemptAlreadyResolved is returned when we attempt to settle or
+	// fail an HTLC attempt that was already settled or failed.
+	ErrAttemptAlreadyResolved = errors.New("htlc attempt already resolved")
+
 	// errNoAttemptInfo is returned when no attempt info is stored yet.
 	errNoAttemptInfo = errors.New("unable to find attempt info for " +
 		"inflight payment")
@@ -66,7 +74,7 @@ func (p *PaymentControl) InitPayment(paymentHash lntypes.Hash,
 	infoBytes := b.Bytes()
 
 	var updateErr error
-	err := p.db.Batch(func(tx *bbolt.Tx) error {
+	err := p.db.Batch(func(tx kvdb.Tx) error {
 		// Reset the update error, to avoid carrying over an error
 		// from a previous execution of the batched db transaction.
 		updateErr = nil
@@ -133,6 +141,13 @@ func (p *PaymentControl) InitPayment(paymentHash lntypes.Hash,
 			return err
 		}
 
+		// The same goes for the individual HTLC attempts of the
+		// earlier payment.
+		err = bucket.DeleteBucket(paymentHtlcsBucket)
+		if err != nil && err != kvdb.ErrBucketNotFound {
+			return err
+		}
+
 		// Also delete any lingering failure info now that we are
 		// re-attempting.
 		return bucket.Delete(paymentFailInfoKey)
@@ -157,7 +172,7 @@ func (p *PaymentControl) RegisterAttempt(paymentHash lntypes.Hash,
 	attemptBytes := a.Bytes()
 
 	var updateErr error
-	err := p.db.Batch(func(tx *bbolt.Tx) error {
+	err := p.db.Batch(func(tx kvdb.Tx) error {
 		// Reset the update error, to avoid carrying over an error
 		// from a previous execution of the batched db transaction.
 		updateErr = nil
@@ -177,8 +192,130 @@ func (p *PaymentControl) RegisterAttempt(paymentHash lntypes.Hash,
 			return nil
 		}
 
-		// Add the payment attempt to the payments bucket.
-		return bucket.Put(paymentAttemptInfoKey, attemptBytes)
+		// Add the payment attempt to the payments bucket. This always
+		// holds the last attempt made.
+		err = bucket.Put(paymentAttemptInfoKey, attemptBytes)
+		if err != nil {
+			return err
+		}
+
+		// Also store the attempt in its own bucket within the htlcs
+		// bucket, such that we can keep track of several attempts in
+		// flight at the same time.
+		htlcsBucket, err := bucket.CreateBucketIfNotExists(
+			paymentHtlcsBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		htlcBucket, err := htlcsBucket.CreateBucketIfNotExists(
+			htlcBucketKey(attempt.PaymentID),
+		)
+		if err != nil {
+			return err
+		}
+
+		return htlcBucket.Put(htlcAttemptInfoKey, attemptBytes)
+	})
+	if err != nil {
+		return err
+	}
+
+	return updateErr
+}
+
+// SettleAttempt marks the given HTLC attempt of an in-flight payment as
+// settled, recording the preimage it was settled with. The attempt is also
+// stored as the payment's current attempt, such that it is the one reported
+// for a succeeded payment.
+func (p *PaymentControl) SettleAttempt(paymentHash lntypes.Hash,
+	attemptID uint64, settleInfo *HTLCSettleInfo) error {
+
+	var b bytes.Buffer
+	if err := serializeHTLCSettleInfo(&b, settleInfo); err != nil {
+		return err
+	}
+	settleBytes := b.Bytes()
+
+	return p.updateHtlcKey(
+		paymentHash, attemptID, htlcSettleInfoKey, settleBytes, true,
+	)
+}
+
+// FailAttempt marks the given HTLC attempt of an in-flight payment as failed.
+func (p *PaymentControl) FailAttempt(paymentHash lntypes.Hash,
+	attemptID uint64, failInfo *HTLCFailInfo) error {
+
+	var b bytes.Buffer
+	if err := serializeHTLCFailInfo(&b, failInfo); err != nil {
+		return err
+	}
+	failBytes := b.Bytes()
+
+	return p.updateHtlcKey(
+		paymentHash, attemptID, htlcFailInfoKey, failBytes, false,
+	)
+}
+
+// updateHtlcKey is a helper method that writes the given value under the key
+// of an unresolved HTLC attempt of an in-flight payment. If setAttempt is
+// true, the HTLC's attempt info is also stored as the payment's last attempt.
+func (p *PaymentControl) updateHtlcKey(paymentHash lntypes.Hash,
+	attemptID uint64, key, value []byte, setAttempt bool) error {
+
+	var updateErr error
+	err := p.db.Batch(func(tx kvdb.Tx) error {
+		// Reset the update error, to avoid carrying over an error
+		// from a previous execution of the batched db transaction.
+		updateErr = nil
+
+		bucket, err := fetchPaymentBucket(tx, paymentHash)
+		if err == ErrPaymentNotInitiated {
+			updateErr = ErrPaymentNotInitiated
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		// We can only update attempts of payments that are still
+		// in-flight.
+		if err := ensureInFlight(bucket); err != nil {
+			updateErr = err
+			return nil
+		}
+
+		htlcsBucket := bucket.Bucket(paymentHtlcsBucket)
+		if htlcsBucket == nil {
+			updateErr = ErrAttemptNotFound
+			return nil
+		}
+
+		htlcBucket := htlcsBucket.Bucket(htlcBucketKey(attemptID))
+		if htlcBucket == nil {
+			updateErr = ErrAttemptNotFound
+			return nil
+		}
+
+		// Make sure the attempt hasn't already been resolved.
+		if htlcBucket.Get(htlcSettleInfoKey) != nil ||
+			htlcBucket.Get(htlcFailInfoKey) != nil {
+
+			updateErr = ErrAttemptAlreadyResolved
+			return nil
+		}
+
+		if setAttempt {
+			err := bucket.Put(
+				paymentAttemptInfoKey,
+				htlcBucket.Get(htlcAttemptInfoKey),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return htlcBucket.Put(key, value)
 	})
 	if err != nil {
 		return err
@@ -198,7 +335,7 @@ func (p *PaymentControl) Success(paymentHash lntypes.Hash,
 		updateErr error
 		route     *route.Route
 	)
-	err := p.db.Batch(func(tx *bbolt.Tx) error {
+	err := p.db.Batch(func(tx kvdb.Tx) error {
 		// Reset the update error, to avoid carrying over an error
 		// from a previous execution of the batched db transaction.
 		updateErr = nil
@@ -252,7 +389,7 @@ func (p *PaymentControl) Fail(paymentHash lntypes.Hash,
 		updateErr error
 		route     *route.Route
 	)
-	err := p.db.Batch(func(tx *bbolt.Tx) error {
+	err := p.db.Batch(func(tx kvdb.Tx) error {
 		// Reset the update error, to avoid carrying over an error
 		// from a previous execution of the batched db transaction.
 		updateErr = nil
@@ -301,7 +438,7 @@ func (p *PaymentControl) FetchPayment(paymentHash lntypes.Hash) (
 	*Payment, error) {
 
 	var payment *Payment
-	err := p.db.View(func(tx *bbolt.Tx) error {
+	err := p.db.View(func(tx kvdb.Tx) error {
 		bucket, err := fetchPaymentBucket(tx, paymentHash)
 		if err != nil {
 			return err
@@ -320,8 +457,8 @@ func (p *PaymentControl) FetchPayment(paymentHash lntypes.Hash) (
 
 // createPaymentBucket creates or fetches the sub-bucket assigned to this
 // payment hash.
-func createPaymentBucket(tx *bbolt.Tx, paymentHash lntypes.Hash) (
-	*bbolt.Bucket, error) {
+func createPaymentBucket(tx kvdb.Tx, paymentHash lntypes.Hash) (
+	kvdb.Bucket, error) {
 
 	payments, err := tx.CreateBucketIfNotExists(paymentsRootBucket)
 	if err != nil {
@@ -333,8 +470,8 @@ func createPaymentBucket(tx *bbolt.Tx, paymentHash lntypes.Hash) (
 
 // fetchPaymentBucket fetches the sub-bucket assigned to this payment hash. If
 // the bucket does not exist, it returns ErrPaymentNotInitiated.
-func fetchPaymentBucket(tx *bbolt.Tx, paymentHash lntypes.Hash) (
-	*bbolt.Bucket, error) {
+func fetchPaymentBucket(tx kvdb.Tx, paymentHash lntypes.Hash) (
+	kvdb.Bucket, error) {
 
 	payments := tx.Bucket(paymentsRootBucket)
 	if payments == nil {
@@ -350,9 +487,17 @@ func fetchPaymentBucket(tx *bbolt.Tx, paymentHash lntypes.Hash) (
 
 }
 
+// htlcBucketKey returns the key of the sub-bucket within the htlcs bucket that
+// is used for the HTLC attempt with the given ID.
+func htlcBucketKey(attemptID uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, attemptID)
+	return b
+}
+
 // nextPaymentSequence returns the next sequence number to store for a new
 // payment.
-func nextPaymentSequence(tx *bbolt.Tx) ([]byte, error) {
+func nextPaymentSequence(tx kvdb.Tx) ([]byte, error) {
 	payments, err := tx.CreateBucketIfNotExists(paymentsRootBucket)
 	if err != nil {
 		return nil, err
@@ -370,7 +515,7 @@ func nextPaymentSequence(tx *bbolt.Tx) ([]byte, error) {
 
 // fetchPaymentStatus fetches the payment status of the payment. If the payment
 // isn't found, it will default to "StatusUnknown".
-func fetchPaymentStatus(bucket *bbolt.Bucket) PaymentStatus {
+func fetchPaymentStatus(bucket kvdb.Bucket) PaymentStatus {
 	if bucket.Get(paymentSettleInfoKey) != nil {
 		return StatusSucceeded
 	}
@@ -389,7 +534,7 @@ func fetchPaymentStatus(bucket *bbolt.Bucket) PaymentStatus {
 // ensureInFlight checks whether the payment found in the given bucket has
 // status InFlight, and returns an error otherwise. This should be used to
 // ensure we only mark in-flight payments as succeeded or failed.
-func ensureInFlight(bucket *bbolt.Bucket) error {
+func ensureInFlight(bucket kvdb.Bucket) error {
 	paymentStatus := fetchPaymentStatus(bucket)
 
 	switch {
@@ -417,7 +562,7 @@ func ensureInFlight(bucket *bbolt.Bucket) error {
 }
 
 // fetchPaymentAttempt fetches the payment attempt from the bucket.
-func fetchPaymentAttempt(bucket *bbolt.Bucket) (*PaymentAttemptInfo, error) {
+func fetchPaymentAttempt(bucket kvdb.Bucket) (*PaymentAttemptInfo, error) {
 	attemptData := bucket.Get(paymentAttemptInfoKey)
 	if attemptData == nil {
 		return nil, errNoAttemptInfo
@@ -437,12 +582,18 @@ type InFlightPayment struct {
 	//
 	// NOTE: Might be nil.
 	Attempt *PaymentAttemptInfo
+
+	// Attempts contains information about all HTLC attempts for this
+	// payment that are neither settled nor failed yet. For multi-path
+	// payments there can be more than one of these in flight at the same
+	// time.
+	Attempts []PaymentAttemptInfo
 }
 
 // FetchInFlightPayments returns all payments with status InFlight.
 func (p *PaymentControl) FetchInFlightPayments() ([]*InFlightPayment, error) {
 	var inFlights []*InFlightPayment
-	err := p.db.View(func(tx *bbolt.Tx) error {
+	err := p.db.View(func(tx kvdb.Tx) error {
 		payments := tx.Bucket(paymentsRootBucket)
 		if payments == nil {
 			return nil
@@ -485,6 +636,35 @@ func (p *PaymentControl) FetchInFlightPayments() ([]*InFlightPayment, error) {
 				return err
 			}
 
+			// Gather all unresolved HTLC attempts. Payments made
+			// by older versions won't have any HTLC attempts
+			// stored, in which case we fall back to the single
+			// attempt found above.
+			htlcsBucket := bucket.Bucket(paymentHtlcsBucket)
+			switch {
+			case htlcsBucket != nil:
+				htlcs, err := fetchHtlcAttempts(htlcsBucket)
+				if err != nil {
+					return err
+				}
+
+				for _, h := range htlcs {
+					if h.Settle != nil || h.Failure != nil {
+						continue
+					}
+
+					inFlight.Attempts = append(
+						inFlight.Attempts,
+						h.PaymentAttemptInfo,
+					)
+				}
+
+			case inFlight.Attempt != nil:
+				inFlight.Attempts = []PaymentAttemptInfo{
+					*inFlight.Attempt,
+				}
+			}
+
 			inFlights = append(inFlights, inFlight)
 			return nil
 		})
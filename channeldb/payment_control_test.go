@@ -10,10 +10,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/lntypes"
 	"github.com/BTCGPU/lnd/routing/route"
 	"github.com/btcsuite/fastsha256"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 )
 
@@ -391,7 +391,7 @@ func assertPaymentStatus(t *testing.T, db *DB,
 	t.Helper()
 
 	var paymentStatus = StatusUnknown
-	err := db.View(func(tx *bbolt.Tx) error {
+	err := db.View(func(tx kvdb.Tx) error {
 		payments := tx.Bucket(paymentsRootBucket)
 		if payments == nil {
 			return nil
@@ -416,7 +416,7 @@ func assertPaymentStatus(t *testing.T, db *DB,
 	}
 }
 
-func checkPaymentCreationInfo(bucket *bbolt.Bucket, c *PaymentCreationInfo) error {
+func checkPaymentCreationInfo(bucket kvdb.Bucket, c *PaymentCreationInfo) error {
 	b := bucket.Get(paymentCreationInfoKey)
 	switch {
 	case b == nil && c == nil:
@@ -440,7 +440,7 @@ func checkPaymentCreationInfo(bucket *bbolt.Bucket, c *PaymentCreationInfo) erro
 	return nil
 }
 
-func checkPaymentAttemptInfo(bucket *bbolt.Bucket, a *PaymentAttemptInfo) error {
+func checkPaymentAttemptInfo(bucket kvdb.Bucket, a *PaymentAttemptInfo) error {
 	b := bucket.Get(paymentAttemptInfoKey)
 	switch {
 	case b == nil && a == nil:
@@ -460,7 +460,7 @@ func checkPaymentAttemptInfo(bucket *bbolt.Bucket, a *PaymentAttemptInfo) error
 	return assertRouteEqual(&a.Route, &a2.Route)
 }
 
-func checkSettleInfo(bucket *bbolt.Bucket, preimg lntypes.Preimage) error {
+func checkSettleInfo(bucket kvdb.Bucket, preimg lntypes.Preimage) error {
 	zero := lntypes.Preimage{}
 	b := bucket.Get(paymentSettleInfoKey)
 	switch {
@@ -482,7 +482,7 @@ func checkSettleInfo(bucket *bbolt.Bucket, preimg lntypes.Preimage) error {
 	return nil
 }
 
-func checkFailInfo(bucket *bbolt.Bucket, failReason *FailureReason) error {
+func checkFailInfo(bucket kvdb.Bucket, failReason *FailureReason) error {
 	b := bucket.Get(paymentFailInfoKey)
 	switch {
 	case b == nil && failReason == nil:
@@ -508,7 +508,7 @@ func assertPaymentInfo(t *testing.T, db *DB, hash lntypes.Hash,
 
 	t.Helper()
 
-	err := db.View(func(tx *bbolt.Tx) error {
+	err := db.View(func(tx kvdb.Tx) error {
 		payments := tx.Bucket(paymentsRootBucket)
 		if payments == nil && c == nil {
 			return nil
@@ -548,3 +548,175 @@ func assertPaymentInfo(t *testing.T, db *DB, hash lntypes.Hash,
 	}
 
 }
+
+// TestPaymentControlMultiShard checks the ability of payment control to keep
+// track of several HTLC attempts for the same payment, and to settle and fail
+// them individually.
+func TestPaymentControlMultiShard(t *testing.T) {
+	t.Parallel()
+
+	db, err := initDB()
+	if err != nil {
+		t.Fatalf("unable to init db: %v", err)
+	}
+
+	pControl := NewPaymentControl(db)
+
+	info, attempt, preimg, err := genInfo()
+	if err != nil {
+		t.Fatalf("unable to generate htlc message: %v", err)
+	}
+
+	err = pControl.InitPayment(info.PaymentHash, info)
+	if err != nil {
+		t.Fatalf("unable to send htlc message: %v", err)
+	}
+
+	// Settling an attempt that was never registered should fail.
+	err = pControl.SettleAttempt(
+		info.PaymentHash, attempt.PaymentID,
+		&HTLCSettleInfo{Preimage: preimg},
+	)
+	if err != ErrAttemptNotFound {
+		t.Fatalf("expected ErrAttemptNotFound, got: %v", err)
+	}
+
+	// Register three attempts for the payment.
+	var attempts []*PaymentAttemptInfo
+	for i := uint64(0); i < 3; i++ {
+		a := *attempt
+		a.PaymentID = i + 1
+
+		err = pControl.RegisterAttempt(info.PaymentHash, &a)
+		if err != nil {
+			t.Fatalf("unable to register attempt: %v", err)
+		}
+
+		attempts = append(attempts, &a)
+	}
+
+	// All three attempts should be reported as in flight.
+	assertInFlightAttempts(t, pControl, 1, 2, 3)
+
+	// Fail the first attempt, which should leave the payment in flight.
+	failTime := time.Unix(0, time.Now().UnixNano())
+	err = pControl.FailAttempt(
+		info.PaymentHash, attempts[0].PaymentID,
+		&HTLCFailInfo{FailTime: failTime},
+	)
+	if err != nil {
+		t.Fatalf("unable to fail attempt: %v", err)
+	}
+	assertPaymentStatus(t, db, info.PaymentHash, StatusInFlight)
+	assertInFlightAttempts(t, pControl, 2, 3)
+
+	// Failing it again should not be allowed.
+	err = pControl.FailAttempt(
+		info.PaymentHash, attempts[0].PaymentID,
+		&HTLCFailInfo{FailTime: failTime},
+	)
+	if err != ErrAttemptAlreadyResolved {
+		t.Fatalf("expected ErrAttemptAlreadyResolved, got: %v", err)
+	}
+
+	// Settle the two remaining attempts.
+	settleTime := time.Unix(0, time.Now().UnixNano())
+	for _, a := range attempts[1:] {
+		err = pControl.SettleAttempt(
+			info.PaymentHash, a.PaymentID,
+			&HTLCSettleInfo{
+				Preimage:   preimg,
+				SettleTime: settleTime,
+			},
+		)
+		if err != nil {
+			t.Fatalf("unable to settle attempt: %v", err)
+		}
+	}
+	assertInFlightAttempts(t, pControl)
+
+	// Settling the individual attempts doesn't complete the payment.
+	assertPaymentStatus(t, db, info.PaymentHash, StatusInFlight)
+
+	if _, err := pControl.Success(info.PaymentHash, preimg); err != nil {
+		t.Fatalf("unable to succeed payment: %v", err)
+	}
+	assertPaymentStatus(t, db, info.PaymentHash, StatusSucceeded)
+
+	// Finally, the outcome of each individual attempt should be found
+	// when fetching the payment.
+	payment, err := pControl.FetchPayment(info.PaymentHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment: %v", err)
+	}
+
+	if len(payment.HTLCs) != 3 {
+		t.Fatalf("expected 3 htlcs, got %d", len(payment.HTLCs))
+	}
+
+	for i, htlc := range payment.HTLCs {
+		if htlc.PaymentID != attempts[i].PaymentID {
+			t.Fatalf("expected attempt id %d, got %d",
+				attempts[i].PaymentID, htlc.PaymentID)
+		}
+
+		err := assertRouteEqual(&attempts[i].Route, &htlc.Route)
+		if err != nil {
+			t.Fatalf("unexpected route: %v", err)
+		}
+
+		if i == 0 {
+			if htlc.Settle != nil || htlc.Failure == nil {
+				t.Fatalf("expected first htlc to be failed")
+			}
+			if !htlc.Failure.FailTime.Equal(failTime) {
+				t.Fatalf("expected fail time %v, got %v",
+					failTime, htlc.Failure.FailTime)
+			}
+			continue
+		}
+
+		if htlc.Failure != nil || htlc.Settle == nil {
+			t.Fatalf("expected htlc %d to be settled", i)
+		}
+		if htlc.Settle.Preimage != preimg {
+			t.Fatalf("expected preimage %v, got %v", preimg,
+				htlc.Settle.Preimage)
+		}
+		if !htlc.Settle.SettleTime.Equal(settleTime) {
+			t.Fatalf("expected settle time %v, got %v",
+				settleTime, htlc.Settle.SettleTime)
+		}
+	}
+}
+
+// assertInFlightAttempts asserts that the single in-flight payment has exactly
+// the unresolved attempts with the given IDs.
+func assertInFlightAttempts(t *testing.T, p *PaymentControl,
+	attemptIDs ...uint64) {
+
+	t.Helper()
+
+	inFlights, err := p.FetchInFlightPayments()
+	if err != nil {
+		t.Fatalf("unable to fetch in-flight payments: %v", err)
+	}
+
+	if len(inFlights) != 1 {
+		t.Fatalf("expected 1 in-flight payment, got %d",
+			len(inFlights))
+	}
+
+	attempts := inFlights[0].Attempts
+	if len(attempts) != len(attemptIDs) {
+		t.Fatalf("expected %d in-flight attempts, got %d",
+			len(attemptIDs), len(attempts))
+	}
+
+	for i, id := range attemptIDs {
+		if attempts[i].PaymentID != id {
+			t.Fatalf("expected attempt id %d, got %d", id,
+				attempts[i].PaymentID)
+		}
+	}
+}
@@ -9,13 +9,14 @@ import (
 	"sort"
 	"time"
 
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/lntypes"
 	"github.com/BTCGPU/lnd/lnwire"
+	"github.com/BTCGPU/lnd/record"
 	"github.com/BTCGPU/lnd/routing/route"
 	"github.com/BTCGPU/lnd/tlv"
 	"github.com/btgsuite/btgd/btcec"
 	"github.com/btgsuite/btgd/wire"
-	"github.com/coreos/bbolt"
 )
 
 var (
@@ -35,6 +36,17 @@ var (
 	//      |        |--settle-info-key: <settle info>
 	//      |        |--fail-info-key: <fail info>
 	//      |        |
+	//      |        |--payment-htlcs-bucket
+	//      |        |        |
+	//      |        |        |-- <attempt-id>
+	//      |        |        |       |--htlc-attempt-info-key: <attempt info>
+	//      |        |        |       |--htlc-settle-info-key: <(optional) settle info>
+	//      |        |        |       |--htlc-fail-info-key: <(optional) fail info>
+	//      |        |        |
+	//      |        |        |-- <attempt-id>
+	//      |        |        |       |
+	//      |        |       ...     ...
+	//      |        |
 	//      |        |--duplicate-bucket (only for old, completed payments)
 	//      |                 |
 	//      |                 |-- <seq-num>
@@ -82,6 +94,24 @@ var (
 	// paymentFailInfoKey is a key used in the payment's sub-bucket to
 	// store information about the reason a payment failed.
 	paymentFailInfoKey = []byte("payment-fail-info")
+
+	// paymentHtlcsBucket is the name of a sub-bucket within the payment
+	// hash bucket, that holds one bucket for every HTLC attempt made for
+	// the payment, keyed by the attempt ID. Multi-path payments can have
+	// several of these attempts in flight at the same time.
+	paymentHtlcsBucket = []byte("payment-htlcs-bucket")
+
+	// htlcAttemptInfoKey is a key used in an HTLC's sub-bucket to store
+	// the info about the attempt.
+	htlcAttemptInfoKey = []byte("htlc-attempt-info")
+
+	// htlcSettleInfoKey is a key used in an HTLC's sub-bucket to store the
+	// settle info, if any.
+	htlcSettleInfoKey = []byte("htlc-settle-info")
+
+	// htlcFailInfoKey is a key used in an HTLC's sub-bucket to store the
+	// failure info, if any.
+	htlcFailInfoKey = []byte("htlc-fail-info")
 )
 
 // FailureReason encodes the reason a payment ultimately failed.
@@ -216,6 +246,43 @@ type PaymentAttemptInfo struct {
 	Route route.Route
 }
 
+// HTLCSettleInfo encapsulates the information that augments an HTLC attempt
+// in the event that the HTLC is successful.
+type HTLCSettleInfo struct {
+	// Preimage is the preimage of a successful HTLC. This serves as a proof
+	// of payment.
+	Preimage lntypes.Preimage
+
+	// SettleTime is the time at which this HTLC was settled.
+	SettleTime time.Time
+}
+
+// HTLCFailInfo encapsulates the information that augments an HTLC attempt in
+// the event that the HTLC fails.
+type HTLCFailInfo struct {
+	// FailTime is the time at which this HTLC was failed.
+	FailTime time.Time
+}
+
+// HTLCAttempt contains information about a specific HTLC attempt for a given
+// payment. It contains the PaymentAttemptInfo, as well as information about
+// its final outcome, if any.
+type HTLCAttempt struct {
+	PaymentAttemptInfo
+
+	// Settle is the preimage of a successful payment. This serves as a
+	// proof of payment. It will only be non-nil for settled HTLCs.
+	//
+	// NOTE: Can be nil if the HTLC is not settled.
+	Settle *HTLCSettleInfo
+
+	// Fail is a failure reason code indicating the reason the HTLC failed.
+	// It is only non-nil for failed HTLCs.
+	//
+	// NOTE: Can be nil if the HTLC is not failed.
+	Failure *HTLCFailInfo
+}
+
 // Payment is a wrapper around a payment's PaymentCreationInfo,
 // PaymentAttemptInfo, and preimage. All payments will have the
 // PaymentCreationInfo set, the PaymentAttemptInfo will be set only if at least
@@ -238,6 +305,11 @@ type Payment struct {
 	// NOTE: Can be nil if no attempt is yet made.
 	Attempt *PaymentAttemptInfo
 
+	// HTLCs holds the information about all HTLC attempts made for this
+	// payment, in the order they were registered. A multi-path payment
+	// will have several of these settled once it succeeds.
+	HTLCs []HTLCAttempt
+
 	// PaymentPreimage is the preimage of a successful payment. This serves
 	// as a proof of payment. It will only be non-nil for settled payments.
 	//
@@ -255,7 +327,7 @@ type Payment struct {
 func (db *DB) FetchPayments() ([]*Payment, error) {
 	var payments []*Payment
 
-	err := db.View(func(tx *bbolt.Tx) error {
+	err := db.View(func(tx kvdb.Tx) error {
 		paymentsBucket := tx.Bucket(paymentsRootBucket)
 		if paymentsBucket == nil {
 			return nil
@@ -317,7 +389,7 @@ func (db *DB) FetchPayments() ([]*Payment, error) {
 	return payments, nil
 }
 
-func fetchPayment(bucket *bbolt.Bucket) (*Payment, error) {
+func fetchPayment(bucket kvdb.Bucket) (*Payment, error) {
 	var (
 		err error
 		p   = &Payment{}
@@ -356,6 +428,15 @@ func fetchPayment(bucket *bbolt.Bucket) (*Payment, error) {
 		}
 	}
 
+	// Get all the HTLC attempts made for this payment, if any.
+	htlcsBucket := bucket.Bucket(paymentHtlcsBucket)
+	if htlcsBucket != nil {
+		p.HTLCs, err = fetchHtlcAttempts(htlcsBucket)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Get the payment preimage. This is only found for
 	// completed payments.
 	b = bucket.Get(paymentSettleInfoKey)
@@ -375,9 +456,77 @@ func fetchPayment(bucket *bbolt.Bucket) (*Payment, error) {
 	return p, nil
 }
 
+// fetchHtlcAttempts retrieves all HTLC attempts stored in the given htlcs
+// bucket, sorted by their attempt ID.
+func fetchHtlcAttempts(bucket kvdb.Bucket) ([]HTLCAttempt, error) {
+	var htlcs []HTLCAttempt
+
+	// Since the attempt IDs are big endian encoded, iterating the bucket
+	// yields the attempts in the order they were registered.
+	err := bucket.ForEach(func(k, _ []byte) error {
+		htlcBucket := bucket.Bucket(k)
+		if htlcBucket == nil {
+			return fmt.Errorf("non bucket element in htlcs bucket")
+		}
+
+		htlc, err := fetchHtlcAttempt(htlcBucket)
+		if err != nil {
+			return err
+		}
+
+		htlcs = append(htlcs, *htlc)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return htlcs, nil
+}
+
+// fetchHtlcAttempt reads the attempt info along with the optional settle and
+// fail info from the given HTLC bucket.
+func fetchHtlcAttempt(bucket kvdb.Bucket) (*HTLCAttempt, error) {
+	b := bucket.Get(htlcAttemptInfoKey)
+	if b == nil {
+		return nil, errNoAttemptInfo
+	}
+
+	attempt, err := deserializePaymentAttemptInfo(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+
+	htlc := &HTLCAttempt{
+		PaymentAttemptInfo: *attempt,
+	}
+
+	b = bucket.Get(htlcSettleInfoKey)
+	if b != nil {
+		htlc.Settle, err = deserializeHTLCSettleInfo(
+			bytes.NewReader(b),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	b = bucket.Get(htlcFailInfoKey)
+	if b != nil {
+		htlc.Failure, err = deserializeHTLCFailInfo(
+			bytes.NewReader(b),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return htlc, nil
+}
+
 // DeletePayments deletes all completed and failed payments from the DB.
 func (db *DB) DeletePayments() error {
-	return db.Update(func(tx *bbolt.Tx) error {
+	return db.Update(func(tx kvdb.Tx) error {
 		payments := tx.Bucket(paymentsRootBucket)
 		if payments == nil {
 			return nil
@@ -506,6 +655,63 @@ func deserializePaymentAttemptInfo(r io.Reader) (*PaymentAttemptInfo, error) {
 	return a, nil
 }
 
+func serializeHTLCSettleInfo(w io.Writer, s *HTLCSettleInfo) error {
+	if _, err := w.Write(s.Preimage[:]); err != nil {
+		return err
+	}
+
+	return serializeTime(w, s.SettleTime)
+}
+
+func deserializeHTLCSettleInfo(r io.Reader) (*HTLCSettleInfo, error) {
+	s := &HTLCSettleInfo{}
+	if _, err := io.ReadFull(r, s.Preimage[:]); err != nil {
+		return nil, err
+	}
+
+	var err error
+	s.SettleTime, err = deserializeTime(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func serializeHTLCFailInfo(w io.Writer, f *HTLCFailInfo) error {
+	return serializeTime(w, f.FailTime)
+}
+
+func deserializeHTLCFailInfo(r io.Reader) (*HTLCFailInfo, error) {
+	f := &HTLCFailInfo{}
+
+	var err error
+	f.FailTime, err = deserializeTime(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// serializeTime writes the given time as its unix nano timestamp.
+func serializeTime(w io.Writer, t time.Time) error {
+	var scratch [8]byte
+	byteOrder.PutUint64(scratch[:], uint64(t.UnixNano()))
+	_, err := w.Write(scratch[:])
+	return err
+}
+
+// deserializeTime reads a unix nano timestamp written by serializeTime.
+func deserializeTime(r io.Reader) (time.Time, error) {
+	var scratch [8]byte
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(0, int64(byteOrder.Uint64(scratch[:]))), nil
+}
+
 func serializeHop(w io.Writer, h *route.Hop) error {
 	if err := WriteElements(w,
 		h.PubKeyBytes[:], h.ChannelID, h.OutgoingTimeLock,
@@ -525,10 +731,19 @@ func serializeHop(w io.Writer, h *route.Hop) error {
 		return WriteElements(w, uint32(0))
 	}
 
+	// Gather all non-primitive TLV records so that they can be serialized
+	// as a single blob. The MPP record is stored alongside any custom
+	// records and split out again on deserialization.
+	var records []tlv.Record
+	if h.MPP != nil {
+		records = append(records, h.MPP.Record())
+	}
+	records = append(records, h.TLVRecords...)
+
 	// Otherwise, we'll transform our slice of records into a map of the
 	// raw bytes, then serialize them in-line with a length (number of
 	// elements) prefix.
-	mapRecords, err := tlv.RecordsToMap(h.TLVRecords)
+	mapRecords, err := tlv.RecordsToMap(records)
 	if err != nil {
 		return err
 	}
@@ -604,6 +819,26 @@ func deserializeHop(r io.Reader) (*route.Hop, error) {
 		tlvMap[tlvType] = rawRecordBytes
 	}
 
+	// If the MPP type is present, remove it from the generic TLV map and
+	// parse it back into a proper MPP struct.
+	mppType := uint64(record.MPPOnionType)
+	if mppBytes, ok := tlvMap[mppType]; ok {
+		delete(tlvMap, mppType)
+
+		var (
+			mpp = &record.MPP{}
+			buf [8]byte
+		)
+		err := record.MPPDecoder(
+			bytes.NewReader(mppBytes), mpp, &buf,
+			uint64(len(mppBytes)),
+		)
+		if err != nil {
+			return nil, err
+		}
+		h.MPP = mpp
+	}
+
 	tlvRecords, err := tlv.MapToRecords(tlvMap)
 	if err != nil {
 		return nil, err
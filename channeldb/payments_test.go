@@ -11,6 +11,7 @@ import (
 
 	"github.com/BTCGPU/lnd/lntypes"
 	"github.com/BTCGPU/lnd/lnwire"
+	"github.com/BTCGPU/lnd/record"
 	"github.com/BTCGPU/lnd/routing/route"
 	"github.com/BTCGPU/lnd/tlv"
 	"github.com/btgsuite/btgd/btcec"
@@ -28,6 +29,7 @@ var (
 		ChannelID:        12345,
 		OutgoingTimeLock: 111,
 		AmtToForward:     555,
+		MPP:              record.NewMPP(100, [32]byte{0x42}),
 		TLVRecords: []tlv.Record{
 			tlv.MakeStaticRecord(1, nil, 3, tlvEncoder, nil),
 			tlv.MakeStaticRecord(2, nil, 3, tlvEncoder, nil),
@@ -0,0 +1,154 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
+	"github.com/BTCGPU/lnd/lntypes"
+	"github.com/BTCGPU/lnd/lnwire"
+)
+
+var (
+	// rebalanceLogBucket is the bucket that we'll use to store the
+	// rebalance log. The rebalance log contains all circular payments that
+	// were sent to rebalance our channels. Each key within the bucket is
+	// the payment hash of the rebalance, and the value the serialized
+	// rebalance event.
+	rebalanceLogBucket = []byte("rebalance-log")
+)
+
+// RebalanceLog returns an instance of the RebalanceLog object backed by the
+// target database instance.
+func (d *DB) RebalanceLog() *RebalanceLog {
+	return &RebalanceLog{
+		db: d,
+	}
+}
+
+// RebalanceLog is a database that logs the circular payments sent by the
+// daemon to shift liquidity between its own channels. As these payments are
+// regular payments to ourselves, the log allows callers to tell them apart
+// from other payments, and to account for the fees paid to rebalance channels
+// separately from the fees earned by forwarding payments.
+type RebalanceLog struct {
+	db *DB
+}
+
+// RebalanceEvent describes a single attempt to rebalance liquidity from one
+// of our channels to another one.
+type RebalanceEvent struct {
+	// PaymentHash is the hash of the circular payment that was sent to
+	// rebalance the channels.
+	PaymentHash lntypes.Hash
+
+	// Timestamp is the time at which the rebalance completed.
+	Timestamp time.Time
+
+	// OutgoingChanID is the channel that the payment left through, which
+	// has its local balance decreased by the rebalance.
+	OutgoingChanID lnwire.ShortChannelID
+
+	// IncomingChanID is the channel that the payment came back through,
+	// which has its local balance increased by the rebalance. It is zero
+	// if the rebalance failed before a route back was found.
+	IncomingChanID lnwire.ShortChannelID
+
+	// Amount is the amount that was shifted between the channels,
+	// excluding fees.
+	Amount lnwire.MilliSatoshi
+
+	// Fee is the total amount of fees paid for the rebalance. It is zero
+	// if the rebalance failed.
+	Fee lnwire.MilliSatoshi
+
+	// Succeeded indicates whether the circular payment settled.
+	Succeeded bool
+}
+
+// encodeRebalanceEvent writes out the target rebalance event to the passed
+// io.Writer, using the expected DB format. Note that the payment hash isn't
+// serialized as this will be the key value within the bucket.
+func encodeRebalanceEvent(w io.Writer, e *RebalanceEvent) error {
+	return WriteElements(
+		w, uint64(e.Timestamp.UnixNano()), e.OutgoingChanID,
+		e.IncomingChanID, e.Amount, e.Fee, e.Succeeded,
+	)
+}
+
+// decodeRebalanceEvent attempts to decode the raw bytes of a serialized
+// rebalance event into the target RebalanceEvent. Note that the payment hash
+// won't be decoded, as the caller is expected to set this due to the bucket
+// structure of the rebalance log.
+func decodeRebalanceEvent(r io.Reader, e *RebalanceEvent) error {
+	var timestamp uint64
+	err := ReadElements(
+		r, &timestamp, &e.OutgoingChanID, &e.IncomingChanID,
+		&e.Amount, &e.Fee, &e.Succeeded,
+	)
+	if err != nil {
+		return err
+	}
+
+	e.Timestamp = time.Unix(0, int64(timestamp))
+
+	return nil
+}
+
+// AddRebalance adds a rebalance event to the log. An existing event for the
+// same payment hash is overwritten.
+func (l *RebalanceLog) AddRebalance(event *RebalanceEvent) error {
+	var b bytes.Buffer
+	if err := encodeRebalanceEvent(&b, event); err != nil {
+		return err
+	}
+
+	return l.db.Update(func(tx kvdb.Tx) error {
+		logBucket, err := tx.CreateBucketIfNotExists(
+			rebalanceLogBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		return logBucket.Put(event.PaymentHash[:], b.Bytes())
+	})
+}
+
+// FetchRebalances returns all rebalance events in the log, ordered by their
+// timestamp.
+func (l *RebalanceLog) FetchRebalances() ([]RebalanceEvent, error) {
+	var events []RebalanceEvent
+	err := l.db.View(func(tx kvdb.Tx) error {
+		// If the bucket wasn't found, then there aren't any events to
+		// be returned.
+		logBucket := tx.Bucket(rebalanceLogBucket)
+		if logBucket == nil {
+			return nil
+		}
+
+		return logBucket.ForEach(func(k, v []byte) error {
+			var event RebalanceEvent
+			err := decodeRebalanceEvent(bytes.NewReader(v), &event)
+			if err != nil {
+				return err
+			}
+			copy(event.PaymentHash[:], k)
+
+			events = append(events, event)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Timestamp.Before(events[j].Timestamp)
+	})
+
+	return events, nil
+}
@@ -0,0 +1,74 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/BTCGPU/lnd/lntypes"
+	"github.com/BTCGPU/lnd/lnwire"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestRebalanceLog tests that rebalance events can be stored, updated and
+// fetched from the rebalance log in the order of their timestamps.
+func TestRebalanceLog(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+	log := db.RebalanceLog()
+
+	// An empty log must not return any events.
+	events, err := log.FetchRebalances()
+	if err != nil {
+		t.Fatalf("unable to fetch rebalances: %v", err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("expected no rebalances, got %v", len(events))
+	}
+
+	failed := RebalanceEvent{
+		PaymentHash:    lntypes.Hash{1},
+		Timestamp:      time.Unix(2000, 0),
+		OutgoingChanID: lnwire.NewShortChanIDFromInt(1),
+		Amount:         100000,
+	}
+	settled := RebalanceEvent{
+		PaymentHash:    lntypes.Hash{2},
+		Timestamp:      time.Unix(1000, 0),
+		OutgoingChanID: lnwire.NewShortChanIDFromInt(2),
+		IncomingChanID: lnwire.NewShortChanIDFromInt(3),
+		Amount:         200000,
+		Fee:            1200,
+		Succeeded:      true,
+	}
+
+	for _, event := range []RebalanceEvent{failed, settled} {
+		event := event
+		if err := log.AddRebalance(&event); err != nil {
+			t.Fatalf("unable to add rebalance: %v", err)
+		}
+	}
+
+	// Adding an event for the same payment hash again must overwrite the
+	// prior one.
+	failed.Timestamp = time.Unix(3000, 0)
+	if err := log.AddRebalance(&failed); err != nil {
+		t.Fatalf("unable to add rebalance: %v", err)
+	}
+
+	events, err = log.FetchRebalances()
+	if err != nil {
+		t.Fatalf("unable to fetch rebalances: %v", err)
+	}
+
+	expected := []RebalanceEvent{settled, failed}
+	if !reflect.DeepEqual(events, expected) {
+		t.Fatalf("unexpected rebalances: expected %v, got %v",
+			spew.Sdump(expected), spew.Sdump(events))
+	}
+}
@@ -0,0 +1,118 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+	"math"
+
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
+	"github.com/BTCGPU/lnd/lnwire"
+)
+
+var (
+	// rebalanceTargetBucket is the bucket that we'll use to store the
+	// rebalance targets that were set at runtime. Each key within the
+	// bucket is the short channel id of the channel that the target
+	// applies to, and the value the serialized target. The default target
+	// is stored under the zero short channel id.
+	rebalanceTargetBucket = []byte("rebalance-targets")
+)
+
+// RebalanceTarget is the range of local balance ratios that is acceptable for
+// a channel, outside of which the channel is rebalanced.
+type RebalanceTarget struct {
+	// MinLocalRatio is the lowest acceptable share of the channel
+	// capacity that is on our side of the channel.
+	MinLocalRatio float64
+
+	// MaxLocalRatio is the highest acceptable share of the channel
+	// capacity that is on our side of the channel.
+	MaxLocalRatio float64
+}
+
+// encodeRebalanceTarget writes out the target rebalance target to the passed
+// io.Writer, using the expected DB format.
+func encodeRebalanceTarget(w io.Writer, t *RebalanceTarget) error {
+	return WriteElements(
+		w, math.Float64bits(t.MinLocalRatio),
+		math.Float64bits(t.MaxLocalRatio),
+	)
+}
+
+// decodeRebalanceTarget attempts to decode the raw bytes of a serialized
+// rebalance target into the target RebalanceTarget.
+func decodeRebalanceTarget(r io.Reader, t *RebalanceTarget) error {
+	var minRatio, maxRatio uint64
+	if err := ReadElements(r, &minRatio, &maxRatio); err != nil {
+		return err
+	}
+
+	t.MinLocalRatio = math.Float64frombits(minRatio)
+	t.MaxLocalRatio = math.Float64frombits(maxRatio)
+
+	return nil
+}
+
+// PutRebalanceTarget stores the rebalance target of the given channel,
+// overwriting any prior target of the channel. The zero short channel id
+// denotes the default target of all channels without a target of their own.
+func (l *RebalanceLog) PutRebalanceTarget(chanID lnwire.ShortChannelID,
+	target *RebalanceTarget) error {
+
+	var b bytes.Buffer
+	if err := encodeRebalanceTarget(&b, target); err != nil {
+		return err
+	}
+
+	var key [8]byte
+	byteOrder.PutUint64(key[:], chanID.ToUint64())
+
+	return l.db.Update(func(tx kvdb.Tx) error {
+		targetBucket, err := tx.CreateBucketIfNotExists(
+			rebalanceTargetBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		return targetBucket.Put(key[:], b.Bytes())
+	})
+}
+
+// FetchRebalanceTargets returns all stored rebalance targets, keyed by the
+// short channel id of the channel they apply to.
+func (l *RebalanceLog) FetchRebalanceTargets() (
+	map[lnwire.ShortChannelID]RebalanceTarget, error) {
+
+	targets := make(map[lnwire.ShortChannelID]RebalanceTarget)
+	err := l.db.View(func(tx kvdb.Tx) error {
+		// If the bucket wasn't found, then there aren't any targets to
+		// be returned.
+		targetBucket := tx.Bucket(rebalanceTargetBucket)
+		if targetBucket == nil {
+			return nil
+		}
+
+		return targetBucket.ForEach(func(k, v []byte) error {
+			var target RebalanceTarget
+			err := decodeRebalanceTarget(
+				bytes.NewReader(v), &target,
+			)
+			if err != nil {
+				return err
+			}
+
+			chanID := lnwire.NewShortChanIDFromInt(
+				byteOrder.Uint64(k),
+			)
+			targets[chanID] = target
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return targets, nil
+}
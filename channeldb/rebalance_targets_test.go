@@ -0,0 +1,61 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/BTCGPU/lnd/lnwire"
+)
+
+// TestRebalanceTargets tests that rebalance targets can be stored, updated and
+// fetched, including the default target under the zero short channel id.
+func TestRebalanceTargets(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+	log := db.RebalanceLog()
+
+	// Without any stored targets, an empty set must be returned.
+	targets, err := log.FetchRebalanceTargets()
+	if err != nil {
+		t.Fatalf("unable to fetch targets: %v", err)
+	}
+	if len(targets) != 0 {
+		t.Fatalf("expected no targets, got %v", len(targets))
+	}
+
+	defaultID := lnwire.ShortChannelID{}
+	chanID := lnwire.NewShortChanIDFromInt(1234)
+	expected := map[lnwire.ShortChannelID]RebalanceTarget{
+		defaultID: {MinLocalRatio: 0.1, MaxLocalRatio: 0.9},
+		chanID:    {MinLocalRatio: 0.4, MaxLocalRatio: 0.6},
+	}
+
+	// Store a different target for the channel first, which must be
+	// overwritten by the one stored after it.
+	err = log.PutRebalanceTarget(
+		chanID, &RebalanceTarget{MinLocalRatio: 0, MaxLocalRatio: 1},
+	)
+	if err != nil {
+		t.Fatalf("unable to put target: %v", err)
+	}
+	for id, target := range expected {
+		target := target
+		if err := log.PutRebalanceTarget(id, &target); err != nil {
+			t.Fatalf("unable to put target: %v", err)
+		}
+	}
+
+	targets, err = log.FetchRebalanceTargets()
+	if err != nil {
+		t.Fatalf("unable to fetch targets: %v", err)
+	}
+	if !reflect.DeepEqual(targets, expected) {
+		t.Fatalf("unexpected targets: expected %v, got %v", expected,
+			targets)
+	}
+}
@@ -91,6 +91,9 @@ func (c *chanDBRestorer) openChannelShell(backup chanbackup.Single) (
 	case chanbackup.TweaklessCommitVersion:
 		chanType = channeldb.SingleFunderTweakless
 
+	case chanbackup.AnchorsCommitVersion:
+		chanType = channeldb.SingleFunderAnchors
+
 	default:
 		return nil, fmt.Errorf("unknown Single version: %v", err)
 	}
@@ -0,0 +1,352 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BTCGPU/lnd/lnrpc"
+	"github.com/BTCGPU/lnd/macaroons"
+	btcutil "github.com/btgsuite/btgutil"
+	"github.com/urfave/cli"
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+var bakeMacaroonCommand = cli.Command{
+	Name:     "bakemacaroon",
+	Category: "Macaroons",
+	Usage: "Bakes a new macaroon with the provided list of permissions " +
+		"and restrictions.",
+	ArgsUsage: "[--save_to=] [--timeout=] [--ip_address=] " +
+		"[--allow_method=] [--spend_limit=] [--spend_window=] " +
+		"[--root_key_id=] permissions...",
+	Description: `
+	Bake a new macaroon that grants the provided permissions and
+	optionally adds restrictions (timeout, IP address, allowed methods,
+	spend limit) to it.
+
+	The new macaroon can either be shown on command line in hex serialized
+	format or it can be saved directly to a file using the --save_to
+	argument.
+
+	A permission is a tuple of an entity and an action, separated by a
+	colon. Multiple operations can be added as arguments, for example:
+
+	lncli bakemacaroon info:read invoices:write
+
+	All valid permissions can be listed with the listpermissions command.
+
+	Macaroons can be baked under a specific root key ID using the
+	--root_key_id argument. All macaroons baked under the same root key ID
+	can be revoked at once by deleting the ID with deletemacaroonid.
+
+	The macaroon can be restricted to a set of RPC methods by specifying
+	their full URIs with the --allow_method argument, once for each method:
+
+	lncli bakemacaroon --allow_method=/lnrpc.Lightning/GetInfo info:read
+
+	The total amount that can be spent with the macaroon through payments
+	and on-chain sends can be capped with the --spend_limit argument. The
+	limit applies to any window of --spend_window seconds.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "save_to",
+			Usage: "save the created macaroon to this file",
+		},
+		cli.Int64Flag{
+			Name:  "timeout",
+			Usage: "the number of seconds the macaroon will be valid",
+		},
+		cli.StringFlag{
+			Name:  "ip_address",
+			Usage: "the IP address the macaroon will be bound to",
+		},
+		cli.StringSliceFlag{
+			Name: "allow_method",
+			Usage: "the full URI of an RPC method the macaroon " +
+				"may call, can be specified multiple times",
+		},
+		cli.Int64Flag{
+			Name: "spend_limit",
+			Usage: "the maximum number of satoshis that can be " +
+				"spent with the macaroon within the spend " +
+				"window",
+		},
+		cli.Int64Flag{
+			Name: "spend_window",
+			Usage: "the number of seconds the spend limit " +
+				"applies to",
+			Value: 86400,
+		},
+		cli.Uint64Flag{
+			Name: "root_key_id",
+			Usage: "the numerical root key ID used to create the " +
+				"macaroon",
+		},
+	},
+	Action: actionDecorator(bakeMacaroon),
+}
+
+func bakeMacaroon(ctx *cli.Context) error {
+	// Show command help if no arguments.
+	if ctx.NArg() == 0 {
+		return cli.ShowCommandHelp(ctx, "bakemacaroon")
+	}
+	args := ctx.Args()
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		savePath          string
+		timeout           int64
+		ipAddress         net.IP
+		allowedMethods    []string
+		spendLimit        int64
+		spendWindow       int64
+		parsedPermissions []*lnrpc.MacaroonPermission
+	)
+
+	if ctx.String("save_to") != "" {
+		savePath = cleanAndExpandPath(ctx.String("save_to"))
+	}
+
+	if ctx.IsSet("timeout") {
+		timeout = ctx.Int64("timeout")
+		if timeout <= 0 {
+			return fmt.Errorf("timeout must be greater than 0")
+		}
+	}
+
+	if ctx.IsSet("ip_address") {
+		ipAddress = net.ParseIP(ctx.String("ip_address"))
+		if ipAddress == nil {
+			return fmt.Errorf("unable to parse ip_address: %s",
+				ctx.String("ip_address"))
+		}
+	}
+
+	allowedMethods = ctx.StringSlice("allow_method")
+
+	if ctx.IsSet("spend_limit") {
+		spendLimit = ctx.Int64("spend_limit")
+		if spendLimit < 0 {
+			return fmt.Errorf("spend_limit must not be negative")
+		}
+
+		spendWindow = ctx.Int64("spend_window")
+		if spendWindow <= 0 {
+			return fmt.Errorf("spend_window must be greater than 0")
+		}
+	}
+
+	// A permission should be an entity and an action separated by a
+	// colon.
+	for _, permission := range args {
+		tuple := strings.Split(permission, ":")
+		if len(tuple) != 2 {
+			return fmt.Errorf("unable to parse permission tuple: %s",
+				permission)
+		}
+		entity, action := tuple[0], tuple[1]
+		if entity == "" {
+			return fmt.Errorf("invalid permission [%s]. entity "+
+				"cannot be empty", permission)
+		}
+		if action == "" {
+			return fmt.Errorf("invalid permission [%s]. action "+
+				"cannot be empty", permission)
+		}
+
+		// Now we can assume that we have a formally valid entity and
+		// action tuple. The rest of the validation happens server
+		// side.
+		parsedPermissions = append(
+			parsedPermissions, &lnrpc.MacaroonPermission{
+				Entity: entity,
+				Action: action,
+			},
+		)
+	}
+
+	// Now we have gathered all the input we need and can do the actual
+	// RPC call.
+	req := &lnrpc.BakeMacaroonRequest{
+		Permissions: parsedPermissions,
+		RootKeyId:   ctx.Uint64("root_key_id"),
+	}
+	resp, err := client.BakeMacaroon(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	// Now we should have gotten a valid macaroon. Unmarshal it so we can
+	// add first-party caveats (if necessary) to it.
+	macBytes, err := hex.DecodeString(resp.Macaroon)
+	if err != nil {
+		return err
+	}
+	unmarshalMac := &macaroon.Macaroon{}
+	if err = unmarshalMac.UnmarshalBinary(macBytes); err != nil {
+		return err
+	}
+
+	// Now apply the desired constraints to the macaroon. This will always
+	// create a new macaroon object, even if no constraints are added.
+	var macConstraints []macaroons.Constraint
+	if timeout > 0 {
+		macConstraints = append(
+			macConstraints, macaroons.TimeoutConstraint(timeout),
+		)
+	}
+	if ipAddress != nil {
+		macConstraints = append(
+			macConstraints,
+			macaroons.IPLockConstraint(ipAddress.String()),
+		)
+	}
+	if len(allowedMethods) > 0 {
+		macConstraints = append(
+			macConstraints,
+			macaroons.MethodConstraint(allowedMethods...),
+		)
+	}
+	if ctx.IsSet("spend_limit") {
+		macConstraints = append(
+			macConstraints, macaroons.SpendLimitConstraint(
+				btcutil.Amount(spendLimit),
+				time.Duration(spendWindow)*time.Second,
+			),
+		)
+	}
+	constrainedMac, err := macaroons.AddConstraints(
+		unmarshalMac, macConstraints...,
+	)
+	if err != nil {
+		return err
+	}
+	macBytes, err = constrainedMac.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	// Now we can output the result. We either write it binary serialized
+	// to a file or write to the standard output using hex encoding.
+	if savePath != "" {
+		err = ioutil.WriteFile(savePath, macBytes, 0644)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Macaroon saved to %s\n", savePath)
+	} else {
+		fmt.Printf("%s\n", hex.EncodeToString(macBytes))
+	}
+
+	return nil
+}
+
+var listPermissionsCommand = cli.Command{
+	Name:     "listpermissions",
+	Category: "Macaroons",
+	Usage: "Lists all RPC method URIs and the macaroon permissions they " +
+		"require to be invoked.",
+	Action: actionDecorator(listPermissions),
+}
+
+func listPermissions(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	request := &lnrpc.ListPermissionsRequest{}
+	response, err := client.ListPermissions(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(response)
+
+	return nil
+}
+
+var listMacaroonIDsCommand = cli.Command{
+	Name:     "listmacaroonids",
+	Category: "Macaroons",
+	Usage:    "List all macaroons root key IDs in use.",
+	Action:   actionDecorator(listMacaroonIDs),
+}
+
+func listMacaroonIDs(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListMacaroonIDsRequest{}
+	resp, err := client.ListMacaroonIDs(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var deleteMacaroonIDCommand = cli.Command{
+	Name:      "deletemacaroonid",
+	Category:  "Macaroons",
+	Usage:     "Delete a specific macaroon ID.",
+	ArgsUsage: "root_key_id",
+	Description: `
+	Remove a macaroon ID using the specified root key ID. For example:
+
+	lncli deletemacaroonid 1
+
+	WARNING
+	When the ID is deleted, all macaroons created from that root key will
+	be invalidated.
+
+	Note that the default root key ID 0 cannot be deleted.
+	`,
+	Action: actionDecorator(deleteMacaroonID),
+}
+
+func deleteMacaroonID(ctx *cli.Context) error {
+	// Validate args length. Only one argument is allowed.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "deletemacaroonid")
+	}
+
+	rootKeyIDString := ctx.Args().First()
+
+	// Convert string into uint64.
+	rootKeyID, err := strconv.ParseUint(rootKeyIDString, 10, 64)
+	if err != nil {
+		return fmt.Errorf("root key ID must be a positive integer")
+	}
+
+	// Check that the ID is not the default root key ID 0.
+	if rootKeyID == 0 {
+		return fmt.Errorf("deleting the default root key ID 0 is not " +
+			"allowed")
+	}
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.DeleteMacaroonIDRequest{
+		RootKeyId: rootKeyID,
+	}
+	resp, err := client.DeleteMacaroonID(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
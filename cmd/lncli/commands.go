@@ -4,6 +4,8 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -20,6 +22,9 @@ import (
 
 	"github.com/BTCGPU/lnd/lnrpc"
 	"github.com/BTCGPU/lnd/lnrpc/routerrpc"
+	"github.com/BTCGPU/lnd/lntypes"
+	"github.com/BTCGPU/lnd/record"
+	"github.com/BTCGPU/lnd/routing/route"
 	"github.com/BTCGPU/lnd/walletunlocker"
 	"github.com/btgsuite/btgd/chaincfg/chainhash"
 	"github.com/btgsuite/btgd/wire"
@@ -590,7 +595,13 @@ var openChannelCommand = cli.Command{
 	a channelPoint (txid:vout) of the funding output is returned.
 
 	One can manually set the fee to be used for the funding transaction via either
-	the --conf_target or --sat_per_byte arguments. This is optional.`,
+	the --conf_target or --sat_per_byte arguments. This is optional.
+
+	If --psbt is set, the wallet doesn't fund the channel. Instead, the funding
+	address and amount are printed once the peer accepted the channel. The
+	funding transaction must then be assembled and signed outside of lnd, and
+	passed back as a finalized PSBT through the fundingstatestep command
+	within 10 minutes.`,
 	ArgsUsage: "node-key local-amt push-amt",
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -658,6 +669,26 @@ var openChannelCommand = cli.Command{
 				"transaction must satisfy",
 			Value: 1,
 		},
+		cli.BoolFlag{
+			Name: "psbt",
+			Usage: "(optional) fund the channel through a PSBT that " +
+				"is assembled and signed outside of lnd, instead " +
+				"of funding it from the wallet",
+		},
+		cli.StringFlag{
+			Name: "close_address",
+			Usage: "(optional) an address that the funds of our " +
+				"side of the channel are paid out to on " +
+				"cooperative close. It is committed to when " +
+				"opening the channel, so the peer must " +
+				"support upfront shutdown scripts",
+		},
+		cli.BoolFlag{
+			Name: "zero_conf",
+			Usage: "(optional) use the channel before the " +
+				"funding transaction confirms, if the peer " +
+				"trusts us not to double spend it",
+		},
 	},
 	Action: actionDecorator(openChannel),
 }
@@ -685,6 +716,9 @@ func openChannel(ctx *cli.Context) error {
 		RemoteCsvDelay:   uint32(ctx.Uint64("remote_csv_delay")),
 		MinConfs:         minConfs,
 		SpendUnconfirmed: minConfs == 0,
+		PsbtFunding:      ctx.Bool("psbt"),
+		CloseAddress:     ctx.String("close_address"),
+		ZeroConf:         ctx.Bool("zero_conf"),
 	}
 
 	switch {
@@ -767,6 +801,20 @@ func openChannel(ctx *cli.Context) error {
 		}
 
 		switch update := resp.Update.(type) {
+		case *lnrpc.OpenStatusUpdate_PsbtFund:
+			psbtFund := update.PsbtFund
+			printJSON(struct {
+				PendingChanID  string `json:"pending_chan_id"`
+				FundingAddress string `json:"funding_address"`
+				FundingAmount  int64  `json:"funding_amount"`
+			}{
+				PendingChanID: hex.EncodeToString(
+					psbtFund.PendingChanId,
+				),
+				FundingAddress: psbtFund.FundingAddress,
+				FundingAmount:  psbtFund.FundingAmount,
+			})
+
 		case *lnrpc.OpenStatusUpdate_ChanPending:
 			txid, err := chainhash.NewHash(update.ChanPending.Txid)
 			if err != nil {
@@ -820,6 +868,195 @@ func openChannel(ctx *cli.Context) error {
 	}
 }
 
+var fundingStateStepCommand = cli.Command{
+	Name:     "fundingstatestep",
+	Category: "Channels",
+	Usage:    "Pass the signed funding PSBT of a pending channel.",
+	Description: `
+	Continue the funding workflow of a channel that was opened with the
+	--psbt flag of the openchannel command. The PSBT must be finalized, and
+	must create an output that pays the exact funding amount to the funding
+	address printed by openchannel.
+
+	The funding transaction is published by lnd once the remote peer's
+	signature for our commitment transaction has been stored, it must not
+	be published by any other means before that.`,
+	ArgsUsage: "pending-chan-id signed-psbt",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "pending_chan_id",
+			Usage: "the hex encoded pending channel ID",
+		},
+		cli.StringFlag{
+			Name:  "psbt",
+			Usage: "the base64 encoded finalized funding PSBT",
+		},
+	},
+	Action: actionDecorator(fundingStateStep),
+}
+
+func fundingStateStep(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	args := ctx.Args()
+
+	// Show command help if no arguments provided
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "fundingstatestep")
+		return nil
+	}
+
+	var pendingChanIDStr string
+	switch {
+	case ctx.IsSet("pending_chan_id"):
+		pendingChanIDStr = ctx.String("pending_chan_id")
+	case args.Present():
+		pendingChanIDStr = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("pending channel ID argument missing")
+	}
+	pendingChanID, err := hex.DecodeString(pendingChanIDStr)
+	if err != nil {
+		return fmt.Errorf("unable to decode pending channel ID: %v",
+			err)
+	}
+
+	var psbtStr string
+	switch {
+	case ctx.IsSet("psbt"):
+		psbtStr = ctx.String("psbt")
+	case args.Present():
+		psbtStr = args.First()
+	default:
+		return fmt.Errorf("psbt argument missing")
+	}
+	packet, err := base64.StdEncoding.DecodeString(psbtStr)
+	if err != nil {
+		return fmt.Errorf("unable to decode psbt: %v", err)
+	}
+
+	req := &lnrpc.FundingStateStepRequest{
+		Trigger: &lnrpc.FundingStateStepRequest_PsbtVerify{
+			PsbtVerify: &lnrpc.FundingPsbtVerify{
+				PendingChanId: pendingChanID,
+				SignedPsbt:    packet,
+			},
+		},
+	}
+	resp, err := client.FundingStateStep(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var batchOpenChannelCommand = cli.Command{
+	Name:     "batchopenchannel",
+	Category: "Channels",
+	Usage: "Open multiple channels to connected peers with a single " +
+		"funding transaction.",
+	Description: `
+	Attempt to open a new channel to each of the given connected peers, all
+	funded by a single on-chain transaction that creates one output per
+	channel. The funding transaction is only published once every peer has
+	signed our commitment transaction, and if any of the channels can't be
+	opened, none of them are.
+
+	The channels are passed as a JSON array of objects, for example:
+	'[{"node_pubkey": "<hex pubkey>", "local_funding_amount": 500000},
+	  {"node_pubkey": "<hex pubkey>", "local_funding_amount": 200000,
+	   "push_sat": 1000, "private": true}]'
+
+	Each object may also set min_htlc_msat, remote_csv_delay and
+	close_address.`,
+	ArgsUsage: "channels-json",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"transaction *should* confirm in, will be " +
+				"used for fee estimation",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_byte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/byte that should be used when crafting " +
+				"the transaction",
+		},
+	},
+	Action: actionDecorator(batchOpenChannel),
+}
+
+// batchChannelJSON is the JSON representation of a channel that is opened as
+// part of a batch.
+type batchChannelJSON struct {
+	NodePubkey         string `json:"node_pubkey"`
+	LocalFundingAmount int64  `json:"local_funding_amount"`
+	PushSat            int64  `json:"push_sat"`
+	Private            bool   `json:"private"`
+	MinHtlcMsat        int64  `json:"min_htlc_msat"`
+	RemoteCsvDelay     uint32 `json:"remote_csv_delay"`
+	CloseAddress       string `json:"close_address"`
+}
+
+func batchOpenChannel(ctx *cli.Context) error {
+	// Show command help if no arguments provided
+	if ctx.NArg() == 0 {
+		cli.ShowCommandHelp(ctx, "batchopenchannel")
+		return nil
+	}
+
+	var jsonChannels []batchChannelJSON
+	err := json.Unmarshal([]byte(ctx.Args().First()), &jsonChannels)
+	if err != nil {
+		return fmt.Errorf("unable to decode channels: %v", err)
+	}
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
+		return fmt.Errorf("either conf_target or sat_per_byte should be " +
+			"set, but not both")
+	}
+
+	req := &lnrpc.BatchOpenChannelRequest{
+		TargetConf: int32(ctx.Int64("conf_target")),
+		SatPerByte: ctx.Int64("sat_per_byte"),
+	}
+	for _, c := range jsonChannels {
+		nodePubkey, err := hex.DecodeString(c.NodePubkey)
+		if err != nil {
+			return fmt.Errorf("unable to decode node public key: "+
+				"%v", err)
+		}
+
+		req.Channels = append(req.Channels, &lnrpc.BatchOpenChannel{
+			NodePubkey:         nodePubkey,
+			LocalFundingAmount: c.LocalFundingAmount,
+			PushSat:            c.PushSat,
+			Private:            c.Private,
+			MinHtlcMsat:        c.MinHtlcMsat,
+			RemoteCsvDelay:     c.RemoteCsvDelay,
+			CloseAddress:       c.CloseAddress,
+		})
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.BatchOpenChannel(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // TODO(roasbeef): also allow short relative channel ID.
 
 var closeChannelCommand = cli.Command{
@@ -1320,6 +1557,40 @@ func listPeers(ctx *cli.Context) error {
 	return nil
 }
 
+var subscribePeerEventsCommand = cli.Command{
+	Name:     "subscribepeerevents",
+	Category: "Peers",
+	Usage:    "Subscribe to peer online and offline events.",
+	Description: `
+	Opens a stream that prints an event every time one of our peers comes
+	online or goes offline.
+	`,
+	Action: actionDecorator(subscribePeerEvents),
+}
+
+func subscribePeerEvents(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.PeerEventSubscription{}
+	stream, err := client.SubscribePeerEvents(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		printRespJSON(event)
+	}
+}
+
 var createCommand = cli.Command{
 	Name:     "create",
 	Category: "Startup",
@@ -2046,6 +2317,69 @@ var cltvLimitFlag = cli.UintFlag{
 		"this payment",
 }
 
+var lastHopFlag = cli.StringFlag{
+	Name: "last_hop",
+	Usage: "pubkey of the last hop (penultimate node in the path) " +
+		"to route through for this payment",
+}
+
+var ignoreNodeFlag = cli.StringSliceFlag{
+	Name: "ignore_node",
+	Usage: "pubkey of a node that must not be used by the route, can " +
+		"be specified multiple times",
+}
+
+var ignorePairFlag = cli.StringSliceFlag{
+	Name: "ignore_pair",
+	Usage: "a directed node pair <from_pubkey>:<to_pubkey> whose " +
+		"channels must not be used by the route, can be specified " +
+		"multiple times",
+}
+
+// parseIgnoreFlags parses the nodes and directed node pairs that path finding
+// must avoid.
+func parseIgnoreFlags(ctx *cli.Context) ([][]byte, []*lnrpc.NodePair,
+	error) {
+
+	var ignoredNodes [][]byte
+	for _, node := range ctx.StringSlice(ignoreNodeFlag.Name) {
+		vertex, err := route.NewVertexFromStr(node)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid node to ignore "+
+				"%v: %v", node, err)
+		}
+		ignoredNodes = append(ignoredNodes, vertex[:])
+	}
+
+	var ignoredPairs []*lnrpc.NodePair
+	for _, pair := range ctx.StringSlice(ignorePairFlag.Name) {
+		nodes := strings.Split(pair, ":")
+		if len(nodes) != 2 {
+			return nil, nil, fmt.Errorf("invalid node pair to "+
+				"ignore %v, expected <from_pubkey>:<to_pubkey>",
+				pair)
+		}
+
+		from, err := route.NewVertexFromStr(nodes[0])
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid node pair to "+
+				"ignore %v: %v", pair, err)
+		}
+		to, err := route.NewVertexFromStr(nodes[1])
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid node pair to "+
+				"ignore %v: %v", pair, err)
+		}
+
+		ignoredPairs = append(ignoredPairs, &lnrpc.NodePair{
+			From: from[:],
+			To:   to[:],
+		})
+	}
+
+	return ignoredNodes, ignoredPairs, nil
+}
+
 // paymentFlags returns common flags for sendpayment and payinvoice.
 func paymentFlags() []cli.Flag {
 	return []cli.Flag{
@@ -2071,6 +2405,9 @@ func paymentFlags() []cli.Flag {
 				"use for the first hop of the payment",
 			Value: 0,
 		},
+		lastHopFlag,
+		ignoreNodeFlag,
+		ignorePairFlag,
 		cli.BoolFlag{
 			Name:  "force, f",
 			Usage: "will skip payment request confirmation",
@@ -2102,6 +2439,11 @@ var sendPaymentCommand = cli.Command{
 	it'll use the hash of all zeroes. This mode allows one to quickly test
 	payment connectivity without having to create an invoice at the
 	destination.
+
+	The --keysend flag sends a spontaneous payment to a destination that
+	accepts keysend payments. A random preimage is generated and passed to
+	the destination within the payment, so no payment hash is required.
+	Only the destination and the amount need to be specified.
 	`,
 	ArgsUsage: "dest amt payment_hash final_cltv_delta | --pay_req=[payment request]",
 	Flags: append(paymentFlags(),
@@ -2126,6 +2468,12 @@ var sendPaymentCommand = cli.Command{
 			Name:  "final_cltv_delta",
 			Usage: "the number of blocks the last hop has to reveal the preimage",
 		},
+		cli.BoolFlag{
+			Name: "keysend",
+			Usage: "will generate a preimage and encode it in the " +
+				"onion payload, so that the destination can " +
+				"settle the payment without an invoice",
+		},
 	),
 	Action: sendPayment,
 }
@@ -2228,6 +2576,38 @@ func sendPayment(ctx *cli.Context) error {
 		Amt:  amount,
 	}
 
+	if ctx.Bool("keysend") {
+		if ctx.Bool("debug_send") {
+			return fmt.Errorf("debug send cannot be combined with " +
+				"keysend")
+		}
+		if ctx.IsSet("payment_hash") || args.Present() {
+			return fmt.Errorf("do not provide a payment hash with " +
+				"keysend")
+		}
+
+		// Generate a random preimage and hand it to the destination in
+		// a custom record, such that it can settle the payment without
+		// having created an invoice for it.
+		var preimage lntypes.Preimage
+		if _, err := rand.Read(preimage[:]); err != nil {
+			return err
+		}
+
+		req.DestCustomRecords = map[uint64][]byte{
+			uint64(record.KeySendType): preimage[:],
+		}
+
+		rHash := preimage.Hash()
+		req.PaymentHash = rHash[:]
+
+		if ctx.IsSet("final_cltv_delta") {
+			req.FinalCltvDelta = int32(ctx.Int64("final_cltv_delta"))
+		}
+
+		return sendPaymentRequest(ctx, req)
+	}
+
 	if ctx.Bool("debug_send") && (ctx.IsSet("payment_hash") || args.Present()) {
 		return fmt.Errorf("do not provide a payment hash with debug send")
 	} else if !ctx.Bool("debug_send") {
@@ -2281,6 +2661,21 @@ func sendPaymentRequest(ctx *cli.Context, req *lnrpc.SendRequest) error {
 	req.FeeLimit = feeLimit
 
 	req.OutgoingChanId = ctx.Uint64("outgoing_chan_id")
+	if ctx.IsSet(lastHopFlag.Name) {
+		lastHop, err := route.NewVertexFromStr(
+			ctx.String(lastHopFlag.Name),
+		)
+		if err != nil {
+			return err
+		}
+		req.LastHopPubkey = lastHop[:]
+	}
+
+	req.IgnoredNodes, req.IgnoredPairs, err = parseIgnoreFlags(ctx)
+	if err != nil {
+		return err
+	}
+
 	req.CltvLimit = uint32(ctx.Int(cltvLimitFlag.Name))
 
 	amt := req.Amt
@@ -3001,6 +3396,14 @@ var queryRoutesCommand = cli.Command{
 			Name:  "use_mc",
 			Usage: "use mission control probabilities",
 		},
+		cli.Uint64Flag{
+			Name: "outgoing_chan_id",
+			Usage: "(optional) the channel id of the channel " +
+				"that must be taken to the first hop",
+		},
+		lastHopFlag,
+		ignoreNodeFlag,
+		ignorePairFlag,
 		cltvLimitFlag,
 	},
 	Action: actionDecorator(queryRoutes),
@@ -3046,6 +3449,22 @@ func queryRoutes(ctx *cli.Context) error {
 		return err
 	}
 
+	var lastHop []byte
+	if ctx.IsSet(lastHopFlag.Name) {
+		lastHopVertex, err := route.NewVertexFromStr(
+			ctx.String(lastHopFlag.Name),
+		)
+		if err != nil {
+			return err
+		}
+		lastHop = lastHopVertex[:]
+	}
+
+	ignoredNodes, ignoredPairs, err := parseIgnoreFlags(ctx)
+	if err != nil {
+		return err
+	}
+
 	req := &lnrpc.QueryRoutesRequest{
 		PubKey:            dest,
 		Amt:               amt,
@@ -3053,6 +3472,10 @@ func queryRoutes(ctx *cli.Context) error {
 		FinalCltvDelta:    int32(ctx.Int("final_cltv_delta")),
 		UseMissionControl: ctx.Bool("use_mc"),
 		CltvLimit:         uint32(ctx.Uint64(cltvLimitFlag.Name)),
+		OutgoingChanId:    ctx.Uint64("outgoing_chan_id"),
+		LastHopPubkey:     lastHop,
+		IgnoredNodes:      ignoredNodes,
+		IgnoredPairs:      ignoredPairs,
 	}
 
 	route, err := client.QueryRoutes(ctxb, req)
@@ -3128,6 +3551,32 @@ func debugLevel(ctx *cli.Context) error {
 	return nil
 }
 
+var dbStatsCommand = cli.Command{
+	Name:  "dbstats",
+	Usage: "Show the size of the channel database and its buckets.",
+	Description: `
+	Show the size of the channel database file on disk, along with the
+	number of keys and the number of bytes stored within each of its
+	top-level buckets. The difference between the file size and the data
+	size can be reclaimed by restarting lnd with --auto-compact-db.`,
+	Action: actionDecorator(dbStats),
+}
+
+func dbStats(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.DatabaseStatsRequest{}
+	resp, err := client.DatabaseStats(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var decodePayReqCommand = cli.Command{
 	Name:        "decodepayreq",
 	Category:    "Payments",
@@ -0,0 +1,154 @@
+// +build feepolicyrpc
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/BTCGPU/lnd/lnrpc/feepolicyrpc"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/urfave/cli"
+)
+
+// feePolicyCommands will return the set of commands to enable for
+// feepolicyrpc builds.
+func feePolicyCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:     "feepolicy",
+			Category: "Fee Policy",
+			Usage:    "Interact with the fee policy manager.",
+			Subcommands: []cli.Command{
+				listFeeRulesCommand,
+				setFeeRulesCommand,
+				reloadFeeRulesCommand,
+			},
+		},
+	}
+}
+
+func getFeePolicyClient(ctx *cli.Context) (feepolicyrpc.FeePolicyClient,
+	func()) {
+
+	conn := getClientConn(ctx, false)
+
+	cleanUp := func() {
+		conn.Close()
+	}
+
+	return feepolicyrpc.NewFeePolicyClient(conn), cleanUp
+}
+
+var listFeeRulesCommand = cli.Command{
+	Name:  "rules",
+	Usage: "List the active fee rules and the fees of managed channels.",
+	Description: `
+	List the fee rules that are in effect, along with the currently
+	advertised fees and the target fees of all channels whose fees are
+	managed by a rule.`,
+	Action: actionDecorator(listFeeRules),
+}
+
+func listFeeRules(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getFeePolicyClient(ctx)
+	defer cleanUp()
+
+	req := &feepolicyrpc.ListRulesRequest{}
+
+	resp, err := client.ListRules(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var setFeeRulesCommand = cli.Command{
+	Name:      "setrules",
+	Usage:     "Replace the fee rules of all channels.",
+	ArgsUsage: "rules_json",
+	Description: `
+	Replace the fee rules of all channels with the given rules, which are
+	saved to the rules file of the daemon and put into effect right away.
+
+	The rules are given as a json object in the format that the rules
+	command prints, for example:
+
+	'{"default_rule": {"fee_rate_ppm": 100}, "channel_rules":
+	[{"chan_id": 1234, "fee_rate_ppm": 50}]}'
+
+	Alternatively, the rules can be read from a file.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "rules_file",
+			Usage: "the path of a file holding the json rules",
+		},
+	},
+	Action: actionDecorator(setFeeRules),
+}
+
+func setFeeRules(ctx *cli.Context) error {
+	ctxb := context.Background()
+
+	var jsonRules string
+	switch {
+	case ctx.IsSet("rules_file"):
+		rulesFile, err := ioutil.ReadFile(ctx.String("rules_file"))
+		if err != nil {
+			return fmt.Errorf("unable to read rules file: %v", err)
+		}
+		jsonRules = string(rulesFile)
+
+	case ctx.NArg() == 1:
+		jsonRules = ctx.Args().First()
+
+	default:
+		return cli.ShowCommandHelp(ctx, "setrules")
+	}
+
+	req := &feepolicyrpc.SetRulesRequest{}
+	if err := jsonpb.UnmarshalString(jsonRules, req); err != nil {
+		return fmt.Errorf("unable to parse rules: %v", err)
+	}
+
+	client, cleanUp := getFeePolicyClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SetRules(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var reloadFeeRulesCommand = cli.Command{
+	Name:  "reload",
+	Usage: "Reload the fee rules from the rules file.",
+	Description: `
+	Read the fee rules from the rules file of the daemon again and put
+	them into effect, which allows editing the file without restarting
+	the daemon. The reloaded rules are printed.`,
+	Action: actionDecorator(reloadFeeRules),
+}
+
+func reloadFeeRules(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getFeePolicyClient(ctx)
+	defer cleanUp()
+
+	req := &feepolicyrpc.ReloadRulesRequest{}
+
+	resp, err := client.ReloadRules(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
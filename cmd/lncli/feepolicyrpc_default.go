@@ -0,0 +1,10 @@
+// +build !feepolicyrpc
+
+package main
+
+import "github.com/urfave/cli"
+
+// feePolicyCommands will return nil for non-feepolicyrpc builds.
+func feePolicyCommands() []cli.Command {
+	return nil
+}
@@ -264,10 +264,13 @@ func main() {
 		connectCommand,
 		disconnectCommand,
 		openChannelCommand,
+		batchOpenChannelCommand,
+		fundingStateStepCommand,
 		closeChannelCommand,
 		closeAllChannelsCommand,
 		abandonChannelCommand,
 		listPeersCommand,
+		subscribePeerEventsCommand,
 		walletBalanceCommand,
 		channelBalanceCommand,
 		getInfoCommand,
@@ -287,6 +290,7 @@ func main() {
 		queryRoutesCommand,
 		getNetworkInfoCommand,
 		debugLevelCommand,
+		dbStatsCommand,
 		decodePayReqCommand,
 		listChainTxnsCommand,
 		stopCommand,
@@ -298,6 +302,10 @@ func main() {
 		exportChanBackupCommand,
 		verifyChanBackupCommand,
 		restoreChanBackupCommand,
+		bakeMacaroonCommand,
+		listPermissionsCommand,
+		listMacaroonIDsCommand,
+		deleteMacaroonIDCommand,
 	}
 
 	// Add any extra commands determined by build flags.
@@ -307,6 +315,8 @@ func main() {
 	app.Commands = append(app.Commands, walletCommands()...)
 	app.Commands = append(app.Commands, watchtowerCommands()...)
 	app.Commands = append(app.Commands, wtclientCommands()...)
+	app.Commands = append(app.Commands, rebalancerCommands()...)
+	app.Commands = append(app.Commands, feePolicyCommands()...)
 
 	if err := app.Run(os.Args); err != nil {
 		fatal(err)
@@ -0,0 +1,172 @@
+// +build rebalancerrpc
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/BTCGPU/lnd/lnrpc/rebalancerrpc"
+	"github.com/urfave/cli"
+)
+
+// rebalancerCommands will return the set of commands to enable for
+// rebalancerrpc builds.
+func rebalancerCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:     "rebalancer",
+			Category: "Rebalancer",
+			Usage:    "Interact with the channel rebalancer.",
+			Subcommands: []cli.Command{
+				setRebalanceTargetCommand,
+				listRebalanceTargetsCommand,
+				rebalanceHistoryCommand,
+			},
+		},
+	}
+}
+
+func getRebalancerClient(ctx *cli.Context) (rebalancerrpc.RebalancerClient,
+	func()) {
+
+	conn := getClientConn(ctx, false)
+
+	cleanUp := func() {
+		conn.Close()
+	}
+
+	return rebalancerrpc.NewRebalancerClient(conn), cleanUp
+}
+
+var setRebalanceTargetCommand = cli.Command{
+	Name:      "settarget",
+	Usage:     "Set the range of local balance ratios of a channel.",
+	ArgsUsage: "min_local_ratio max_local_ratio",
+	Description: `
+	Set the range of the share of a channel's capacity that should be on
+	our side. Channels whose local balance is outside of this range are
+	rebalanced towards the middle of the range. If no channel is specified,
+	the default range that applies to all channels without a range of their
+	own is set. Ranges are kept across restarts, and a default range set
+	this way takes precedence over the one in the config.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "chan_id",
+			Usage: "(optional) the short channel id of the " +
+				"channel to set the range for",
+		},
+		cli.Float64Flag{
+			Name: "min_local_ratio",
+			Usage: "the lowest acceptable share of the channel " +
+				"capacity that is on our side",
+		},
+		cli.Float64Flag{
+			Name: "max_local_ratio",
+			Usage: "the highest acceptable share of the channel " +
+				"capacity that is on our side",
+		},
+	},
+	Action: actionDecorator(setRebalanceTarget),
+}
+
+func setRebalanceTarget(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getRebalancerClient(ctx)
+	defer cleanUp()
+
+	var (
+		minRatio, maxRatio float64
+		err                error
+	)
+
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("min_local_ratio"):
+		minRatio = ctx.Float64("min_local_ratio")
+	case args.Present():
+		minRatio, err = strconv.ParseFloat(args.First(), 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode min_local_ratio: "+
+				"%v", err)
+		}
+		args = args.Tail()
+	default:
+		return fmt.Errorf("min_local_ratio argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("max_local_ratio"):
+		maxRatio = ctx.Float64("max_local_ratio")
+	case args.Present():
+		maxRatio, err = strconv.ParseFloat(args.First(), 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode max_local_ratio: "+
+				"%v", err)
+		}
+	default:
+		return fmt.Errorf("max_local_ratio argument missing")
+	}
+
+	req := &rebalancerrpc.SetTargetRequest{
+		Target: &rebalancerrpc.Target{
+			ChanId:        ctx.Uint64("chan_id"),
+			MinLocalRatio: minRatio,
+			MaxLocalRatio: maxRatio,
+		},
+	}
+
+	resp, err := client.SetTarget(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listRebalanceTargetsCommand = cli.Command{
+	Name:   "targets",
+	Usage:  "List the local balance ratio ranges of all channels.",
+	Action: actionDecorator(listRebalanceTargets),
+}
+
+func listRebalanceTargets(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getRebalancerClient(ctx)
+	defer cleanUp()
+
+	req := &rebalancerrpc.ListTargetsRequest{}
+
+	resp, err := client.ListTargets(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var rebalanceHistoryCommand = cli.Command{
+	Name:   "history",
+	Usage:  "List all rebalances along with the total fees paid.",
+	Action: actionDecorator(rebalanceHistory),
+}
+
+func rebalanceHistory(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getRebalancerClient(ctx)
+	defer cleanUp()
+
+	req := &rebalancerrpc.RebalanceHistoryRequest{}
+
+	resp, err := client.RebalanceHistory(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,10 @@
+// +build !rebalancerrpc
+
+package main
+
+import "github.com/urfave/cli"
+
+// rebalancerCommands will return nil for non-rebalancerrpc builds.
+func rebalancerCommands() []cli.Command {
+	return nil
+}
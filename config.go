@@ -100,6 +100,13 @@ const (
 	// push us in the broadcast window.
 	defaultFinalCltvRejectDelta = DefaultIncomingBroadcastDelta + 3
 
+	// defaultHoldExpiryDelta defines the number of blocks before the expiry
+	// of the htlcs paying to an accepted hold invoice at which we cancel
+	// the invoice. This must be larger than the incoming broadcast delta,
+	// as we would otherwise force close the channel before canceling back.
+	// We leave a couple of blocks for the cancellation to reach our peer.
+	defaultHoldExpiryDelta = DefaultIncomingBroadcastDelta + 2
+
 	// DefaultOutgoingBroadcastDelta defines the number of blocks before the
 	// expiry of an outgoing htlc at which we force close the channel. We
 	// are not in a hurry to force close, because there is nothing to claim
@@ -235,6 +242,7 @@ type config struct {
 	ConfigFile      string   `short:"C" long:"configfile" description:"Path to configuration file"`
 	DataDir         string   `short:"b" long:"datadir" description:"The directory to store lnd's data within"`
 	SyncFreelist    bool     `long:"sync-freelist" description:"Whether the databases used within lnd should sync their freelist to disk. This is disabled by default resulting in improved memory performance during operation, but with an increase in startup time."`
+	AutoCompactDB   bool     `long:"auto-compact-db" description:"Whether the channel database should be compacted on startup. The database is copied into a fresh file that is swapped in atomically, returning the space of deleted data to the operating system. A backup of the original database is kept as channel.db.backup. This requires free disk space of up to the size of the database and can take a while for large databases."`
 	TLSCertPath     string   `long:"tlscertpath" description:"Path to write the TLS certificate for lnd's RPC and REST services"`
 	TLSKeyPath      string   `long:"tlskeypath" description:"Path to write the TLS private key for lnd's RPC and REST services"`
 	TLSExtraIPs     []string `long:"tlsextraip" description:"Adds an extra ip to the generated certificate"`
@@ -243,6 +251,7 @@ type config struct {
 	AdminMacPath    string   `long:"adminmacaroonpath" description:"Path to write the admin macaroon for lnd's RPC and REST services if it doesn't exist"`
 	ReadMacPath     string   `long:"readonlymacaroonpath" description:"Path to write the read-only macaroon for lnd's RPC and REST services if it doesn't exist"`
 	InvoiceMacPath  string   `long:"invoicemacaroonpath" description:"Path to the invoice-only macaroon for lnd's RPC and REST services if it doesn't exist"`
+	UpgradeAdminMac bool     `long:"upgradeadminmacaroon" description:"Re-bake the admin macaroon on startup if it was created by an earlier version and lacks some of the current admin permissions"`
 	LogDir          string   `long:"logdir" description:"Directory to log output."`
 	MaxLogFiles     int      `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)"`
 	MaxLogFileSize  int      `long:"maxlogfilesize" description:"Maximum logfile size in MB"`
@@ -305,6 +314,9 @@ type config struct {
 	Alias       string `long:"alias" description:"The node alias. Used as a moniker by peers and intelligence services"`
 	Color       string `long:"color" description:"The color of the node in hex format (i.e. '#3399FF'). Used to customize node appearance in intelligence services"`
 	MinChanSize int64  `long:"minchansize" description:"The smallest channel size (in satoshis) that we should accept. Incoming channels smaller than this will be rejected"`
+	MaxChanSize int64  `long:"maxchansize" description:"The largest channel size (in satoshis) that we should open or accept. Channels above the soft-limit of 16777215 satoshis are only allowed if wumbochans is set and the peer signals support for them as well. Defaults to the soft-limit, or to 10 BTC if wumbochans is set."`
+
+	WumboChans bool `long:"wumbochans" description:"If true, lnd will signal support for channels above the soft-limit of 16777215 satoshis, and open or accept them with peers that signal support for them as well, up to maxchansize."`
 
 	NumGraphSyncPeers      int           `long:"numgraphsyncpeers" description:"The number of peers that we should receive new graph updates from. This option can be tuned to save bandwidth for light clients or routing nodes."`
 	HistoricalSyncInterval time.Duration `long:"historicalsyncinterval" description:"The polling interval between historical graph sync attempts. Each historical graph sync attempt ensures we reconcile with the remote peer's graph from the genesis block."`
@@ -313,8 +325,18 @@ type config struct {
 
 	RejectPush bool `long:"rejectpush" description:"If true, lnd will not accept channel opening requests with non-zero push amounts. This should prevent accidental pushes to merchant nodes."`
 
+	ZeroConfPeers []string `long:"zeroconfpeer" description:"The hex encoded public key of a peer that is trusted not to double spend the funding transactions of the channels it opens with us. Channels with this peer are used before their funding transaction confirms. Can be specified multiple times."`
+
+	CloseAddress string `long:"closeaddress" description:"The default address that the funds of our side of a channel are paid out to on cooperative close. It is committed to when opening or accepting channels with peers that support upfront shutdown scripts, and can be overridden for each channel that we open."`
+
 	RejectHTLC bool `long:"rejecthtlc" description:"If true, lnd will not forward any HTLCs that are meant as onward payments. This option will still allow lnd to send HTLCs and receive HTLCs but lnd won't be used as a hop."`
 
+	AcceptKeySend bool `long:"accept-keysend" description:"If true, spontaneous payments through keysend will be accepted. An invoice is created on the fly for each keysend payment that is received."`
+
+	RequirePaymentAddr bool `long:"requirepaymentaddr" description:"If true, the invoices we create require payers to include the payment address of the invoice in their payments, which keeps intermediate nodes from probing them. Payers that don't support payment addresses can't pay these invoices."`
+
+	HoldExpiryDelta uint32 `long:"hold-expiry-delta" description:"The number of blocks before the expiry of the htlcs paying to an accepted hold invoice at which the invoice is canceled. This prevents a force close of the channels the htlcs are on when the hold invoice isn't settled in time."`
+
 	StaggerInitialReconnect bool `long:"stagger-initial-reconnect" description:"If true, will apply a randomized staggering between 0s and 30s when reconnecting to persistent peers on startup. The first 10 reconnections will be attempted instantly, regardless of the flag's value"`
 
 	MaxOutgoingCltvExpiry uint32 `long:"max-cltv-expiry" description:"The maximum number of blocks funds could be locked up for when forwarding payments."`
@@ -335,7 +357,13 @@ type config struct {
 
 	Watchtower *lncfg.Watchtower `group:"watchtower" namespace:"watchtower"`
 
+	Rebalancer *lncfg.Rebalancer `group:"rebalancer" namespace:"rebalancer"`
+
+	FeePolicy *lncfg.FeePolicy `group:"feepolicy" namespace:"feepolicy"`
+
 	LegacyProtocol *lncfg.LegacyProtocol `group:"legacyprotocol" namespace:"legacyprotocol"`
+
+	ExperimentalProtocol *lncfg.ExperimentalProtocol `group:"experimentalprotocol" namespace:"experimentalprotocol"`
 }
 
 // loadConfig initializes and parses the config using a config file and command
@@ -436,8 +464,21 @@ func loadConfig() (*config, error) {
 		Watchtower: &lncfg.Watchtower{
 			TowerDir: defaultTowerDir,
 		},
+		Rebalancer: &lncfg.Rebalancer{
+			Interval:      lncfg.DefaultRebalanceInterval,
+			MinLocalRatio: lncfg.DefaultRebalanceMinLocalRatio,
+			MaxLocalRatio: lncfg.DefaultRebalanceMaxLocalRatio,
+			MaxAmount:     lncfg.DefaultRebalanceMaxAmount,
+			MaxFee:        lncfg.DefaultRebalanceMaxFee,
+		},
+		FeePolicy: &lncfg.FeePolicy{
+			Interval:          lncfg.DefaultFeePolicyInterval,
+			MinUpdateInterval: lncfg.DefaultFeePolicyMinUpdateInterval,
+			MinRelativeChange: lncfg.DefaultFeePolicyMinRelativeChange,
+		},
 		MaxOutgoingCltvExpiry:   htlcswitch.DefaultMaxOutgoingCltvExpiry,
 		MaxChannelFeeAllocation: htlcswitch.DefaultMaxLinkFeeAllocation,
+		HoldExpiryDelta:         defaultHoldExpiryDelta,
 	}
 
 	// Pre-parse the command line options to pick up an alternative config
@@ -543,6 +584,7 @@ func loadConfig() (*config, error) {
 	cfg.LitecoindMode.Dir = cleanAndExpandPath(cfg.LitecoindMode.Dir)
 	cfg.Tor.PrivateKeyPath = cleanAndExpandPath(cfg.Tor.PrivateKeyPath)
 	cfg.Watchtower.TowerDir = cleanAndExpandPath(cfg.Watchtower.TowerDir)
+	cfg.FeePolicy.RulesFile = cleanAndExpandPath(cfg.FeePolicy.RulesFile)
 
 	// Ensure that the user didn't attempt to specify negative values for
 	// any of the autopilot params.
@@ -603,6 +645,14 @@ func loadConfig() (*config, error) {
 			cfg.MaxChannelFeeAllocation)
 	}
 
+	// Hold invoices must be canceled before we would go to chain to claim
+	// their htlcs.
+	if cfg.HoldExpiryDelta <= DefaultIncomingBroadcastDelta {
+		return nil, fmt.Errorf("invalid hold expiry delta: %v, must "+
+			"be greater than %v", cfg.HoldExpiryDelta,
+			DefaultIncomingBroadcastDelta)
+	}
+
 	// Validate the Tor config parameters.
 	socks, err := lncfg.ParseAddressString(
 		cfg.Tor.SOCKS, strconv.Itoa(defaultTorSOCKSPort),
@@ -774,6 +824,7 @@ func loadConfig() (*config, error) {
 		// primary chain.
 		registeredChains.RegisterPrimaryChain(litecoinChain)
 		MaxFundingAmount = maxLtcFundingAmount
+		MaxFundingAmountWumbo = maxLtcFundingAmountWumbo
 		MaxPaymentMSat = maxLtcPaymentMSat
 
 	case cfg.Bitcoin.Active:
@@ -898,6 +949,32 @@ func loadConfig() (*config, error) {
 		cfg.Autopilot.MaxChannelSize = int64(MaxFundingAmount)
 	}
 
+	// Default to the maximum channel size that applies to the active chain
+	// if none was set. Channels above the soft-limit are only permitted
+	// if support for large channels has been enabled.
+	if cfg.MaxChanSize == 0 {
+		cfg.MaxChanSize = int64(MaxFundingAmount)
+		if cfg.WumboChans {
+			cfg.MaxChanSize = int64(MaxFundingAmountWumbo)
+		}
+	}
+	if cfg.MaxChanSize < cfg.MinChanSize {
+		return nil, fmt.Errorf("maxchansize (%v) must not be below "+
+			"minchansize (%v)", cfg.MaxChanSize, cfg.MinChanSize)
+	}
+	if !cfg.WumboChans && cfg.MaxChanSize > int64(MaxFundingAmount) {
+		return nil, fmt.Errorf("maxchansize (%v) is above the "+
+			"soft-limit for channel size (%v), which requires "+
+			"wumbochans", cfg.MaxChanSize, int64(MaxFundingAmount))
+	}
+
+	// Payment addresses are carried in the tlv onion payload, so they
+	// can't be required if we're using the legacy onion format.
+	if cfg.RequirePaymentAddr && cfg.LegacyProtocol.LegacyOnion() {
+		return nil, errors.New("requirepaymentaddr can't be set " +
+			"together with the legacy onion format")
+	}
+
 	// Validate profile port number.
 	if cfg.Profile != "" {
 		profilePort, err := strconv.Atoi(cfg.Profile)
@@ -1086,11 +1163,14 @@ func loadConfig() (*config, error) {
 			"minbackoff")
 	}
 
-	// Validate the subconfigs for workers, caches, and the tower client.
+	// Validate the subconfigs for workers, caches, the tower client, the
+	// rebalancer and the fee policy manager.
 	err = lncfg.Validate(
 		cfg.Workers,
 		cfg.Caches,
 		cfg.WtClient,
+		cfg.Rebalancer,
+		cfg.FeePolicy,
 	)
 	if err != nil {
 		return nil, err
@@ -34,7 +34,9 @@ func TestChainArbitratorRepublishCommitment(t *testing.T) {
 	const numChans = 10
 	var channels []*channeldb.OpenChannel
 	for i := 0; i < numChans; i++ {
-		lChannel, _, cleanup, err := lnwallet.CreateTestChannels(true)
+		lChannel, _, cleanup, err := lnwallet.CreateTestChannels(
+			channeldb.SingleFunderTweakless,
+		)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -9,9 +9,12 @@ import (
 
 	"github.com/BTCGPU/lnd/chainntnfs"
 	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/input"
 	"github.com/BTCGPU/lnd/lntypes"
 	"github.com/BTCGPU/lnd/lnwallet"
 	"github.com/BTCGPU/lnd/lnwire"
+	"github.com/BTCGPU/lnd/sweep"
+	"github.com/btgsuite/btgd/blockchain"
 	"github.com/btgsuite/btgd/wire"
 	btcutil "github.com/btgsuite/btgutil"
 	"github.com/davecgh/go-spew/spew"
@@ -24,6 +27,12 @@ var (
 		"process of being force closed")
 )
 
+const (
+	// anchorSweepConfTarget is the conf target used when sweeping our
+	// anchor to bump the fee of our broadcast commitment transaction.
+	anchorSweepConfTarget = 6
+)
+
 // WitnessSubscription represents an intent to be notified once new witnesses
 // are discovered by various active contract resolvers. A contract resolver may
 // use this to be notified of when it can satisfy an incoming contract after we
@@ -707,6 +716,48 @@ func (t transitionTrigger) String() string {
 	}
 }
 
+// sweepAnchor offers our anchor output on the broadcast commitment to the
+// sweeper. The sweeper will attach wallet inputs as needed to pay for the fee
+// of the commitment transaction through CPFP, until the commitment confirms.
+func (c *ChannelArbitrator) sweepAnchor(
+	closeSummary *lnwallet.LocalForceCloseSummary,
+	heightHint uint32) error {
+
+	anchor := closeSummary.AnchorResolution
+	if anchor == nil {
+		return nil
+	}
+
+	// The fee already paid by the commitment is everything that isn't
+	// accounted for by its outputs.
+	commitTx := closeSummary.CloseTx
+	commitFee := closeSummary.ChanSnapshot.Capacity
+	for _, txOut := range commitTx.TxOut {
+		commitFee -= btcutil.Amount(txOut.Value)
+	}
+	commitWeight := blockchain.GetTransactionWeight(
+		btcutil.NewTx(commitTx),
+	)
+
+	log.Infof("ChannelArbitrator(%v): offering anchor %v to sweeper "+
+		"to bump commitment with fee=%v, weight=%v", c.cfg.ChanPoint,
+		anchor.CommitAnchor, commitFee, commitWeight)
+
+	inp := input.MakeBaseInput(
+		&anchor.CommitAnchor, input.CommitmentAnchor,
+		&anchor.AnchorSignDescriptor, heightHint,
+		&input.TxInfo{
+			Fee:    commitFee,
+			Weight: commitWeight,
+		},
+	)
+
+	_, err := c.cfg.Sweeper.SweepInput(
+		&inp, sweep.FeePreference{ConfTarget: anchorSweepConfTarget},
+	)
+	return err
+}
+
 // stateStep is a help method that examines our internal state, and attempts
 // the appropriate state transition if necessary. The next state we transition
 // to is returned, Additionally, if the next transition results in a commitment
@@ -873,6 +924,16 @@ func (c *ChannelArbitrator) stateStep(
 			}
 		}
 
+		// If the channel uses anchor outputs, we'll offer our anchor to
+		// the sweeper, such that it can bump the fee of the commitment
+		// through CPFP. A failure here isn't fatal, as the commitment
+		// may still confirm with the fee it pays.
+		err = c.sweepAnchor(closeSummary, triggerHeight)
+		if err != nil {
+			log.Errorf("ChannelArbitrator(%v): unable to sweep "+
+				"anchor: %v", c.cfg.ChanPoint, err)
+		}
+
 		// We go to the StateCommitmentBroadcasted state, where we'll
 		// be waiting for the commitment to be confirmed.
 		nextState = StateCommitmentBroadcasted
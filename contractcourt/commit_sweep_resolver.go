@@ -7,6 +7,7 @@ import (
 	"github.com/BTCGPU/lnd/input"
 	"github.com/BTCGPU/lnd/lnwallet"
 	"github.com/BTCGPU/lnd/sweep"
+	"github.com/btgsuite/btgd/txscript"
 	"github.com/btgsuite/btgd/wire"
 )
 
@@ -84,30 +85,42 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 		return nil, errResolverShuttingDown
 	}
 
-	// We're dealing with our commitment transaction if the delay on the
-	// resolution isn't zero.
-	isLocalCommitTx := c.commitResolution.MaturityDelay != 0
+	// We're dealing with our commitment transaction if the output's
+	// witness script starts with the revocation clause. On the remote
+	// commitment, our output is either a regular P2WKH output, or a simple
+	// signature spend behind a CSV delay of one block for anchor channels.
+	witnessScript := c.commitResolution.SelfOutputSignDesc.WitnessScript
+	isLocalCommitTx := len(witnessScript) > 0 &&
+		witnessScript[0] == txscript.OP_IF
 
 	if !isLocalCommitTx {
-		// There're two types of commitments, those that have tweaks
-		// for the remote key (us in this case), and those that don't.
-		// We'll rely on the presence of the commitment tweak to to
-		// discern which type of commitment this is.
+		// There're three types of commitments, those that have tweaks
+		// for the remote key (us in this case), those that don't, and
+		// those that lock our output for a single block as used by
+		// anchor channels. We'll rely on the maturity delay and the
+		// presence of the commitment tweak to discern which type of
+		// commitment this is.
 		var witnessType input.WitnessType
-		if c.commitResolution.SelfOutputSignDesc.SingleTweak == nil {
+		switch {
+		case c.commitResolution.MaturityDelay != 0:
+			witnessType = input.CommitmentToRemoteConfirmed
+
+		case c.commitResolution.SelfOutputSignDesc.SingleTweak == nil:
 			witnessType = input.CommitSpendNoDelayTweakless
-		} else {
+
+		default:
 			witnessType = input.CommitmentNoDelay
 		}
 
 		// We'll craft an input with all the information required for
 		// the sweeper to create a fully valid sweeping transaction to
 		// recover these coins.
-		inp := input.MakeBaseInput(
+		inp := input.NewCsvInput(
 			&c.commitResolution.SelfOutPoint,
 			witnessType,
 			&c.commitResolution.SelfOutputSignDesc,
 			c.broadcastHeight,
+			c.commitResolution.MaturityDelay,
 		)
 
 		// With our input constructed, we'll now offer it to the
@@ -115,7 +128,7 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 		log.Infof("%T(%v): sweeping commit output", c, c.chanPoint)
 
 		feePref := sweep.FeePreference{ConfTarget: commitOutputConfTarget}
-		resultChan, err := c.Sweeper.SweepInput(&inp, feePref)
+		resultChan, err := c.Sweeper.SweepInput(inp, feePref)
 		if err != nil {
 			log.Errorf("%T(%v): unable to sweep input: %v",
 				c, c.chanPoint, err)
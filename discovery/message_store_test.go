@@ -9,9 +9,9 @@ import (
 	"testing"
 
 	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/lnwire"
 	"github.com/btgsuite/btgd/btcec"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 )
 
@@ -236,7 +236,7 @@ func TestMessageStoreUnsupportedMessage(t *testing.T) {
 	if _, err := lnwire.WriteMessage(&rawMsg, unsupportedMsg, 0); err != nil {
 		t.Fatalf("unable to serialize message: %v", err)
 	}
-	err = msgStore.db.Update(func(tx *bbolt.Tx) error {
+	err = msgStore.db.Update(func(tx kvdb.Tx) error {
 		messageStore := tx.Bucket(messageStoreBucket)
 		return messageStore.Put(msgKey, rawMsg.Bytes())
 	})
@@ -62,6 +62,12 @@ func (p *mockPeer) LocalGlobalFeatures() *lnwire.FeatureVector {
 func (p *mockPeer) RemoteGlobalFeatures() *lnwire.FeatureVector {
 	return nil
 }
+func (p *mockPeer) LocalFeatures() *lnwire.FeatureVector {
+	return nil
+}
+func (p *mockPeer) RemoteFeatures() *lnwire.FeatureVector {
+	return nil
+}
 
 // mockMessageStore is an in-memory implementation of the MessageStore interface
 // used for the gossiper's unit tests.
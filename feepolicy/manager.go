@@ -0,0 +1,501 @@
+package feepolicy
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/lnwire"
+	"github.com/BTCGPU/lnd/routing"
+	"github.com/BTCGPU/lnd/ticker"
+	"github.com/btgsuite/btgd/wire"
+	btcutil "github.com/btgsuite/btgutil"
+)
+
+const (
+	// forwardingQueryBatch is the maximum number of forwarding events that
+	// are fetched from the forwarding log at once.
+	forwardingQueryBatch = 1000
+)
+
+var (
+	// ErrNoRulesFile is returned when the rules are reloaded, but the
+	// manager wasn't configured with a rules file.
+	ErrNoRulesFile = errors.New("no fee rules file configured")
+)
+
+// ForwardingLog gives access to the payments that were forwarded through our
+// channels.
+type ForwardingLog interface {
+	// Query returns the forwarding events within the time slice of the
+	// query.
+	Query(q channeldb.ForwardingEventQuery) (
+		channeldb.ForwardingLogTimeSlice, error)
+}
+
+// Config houses all the dependencies of the fee policy manager.
+type Config struct {
+	// FetchChannels returns all of our open channels.
+	FetchChannels func() ([]*channeldb.OpenChannel, error)
+
+	// ForAllOutgoingChannels iterates over the edges of our channels
+	// along with the policies that we currently advertise for them.
+	ForAllOutgoingChannels func(cb func(*channeldb.ChannelEdgeInfo,
+		*channeldb.ChannelEdgePolicy) error) error
+
+	// UpdatePolicy applies a new policy to the given channels and
+	// announces it to the network.
+	UpdatePolicy func(routing.ChannelPolicy, ...wire.OutPoint) error
+
+	// ForwardingLog is used to determine how much was forwarded through
+	// our channels recently.
+	ForwardingLog ForwardingLog
+
+	// Ticker determines how often the fees of our channels are
+	// re-evaluated.
+	Ticker ticker.Ticker
+
+	// Now returns the current time.
+	Now func() time.Time
+
+	// Rules are the fee rules of our channels that are in effect when
+	// the manager starts.
+	Rules *Rules
+
+	// RulesFile is the path of the json file that the rules are reloaded
+	// from, and that rules set at runtime are saved to. If empty, rules
+	// set at runtime only last until the manager is stopped.
+	RulesFile string
+
+	// MinUpdateInterval is the minimum time between two policy updates of
+	// the same channel, which keeps us from flooding the network with
+	// channel updates.
+	MinUpdateInterval time.Duration
+
+	// MinRelativeChange is the minimum relative change of the fee rate of
+	// a channel that causes a policy update. Smaller changes are held
+	// back until they add up.
+	MinRelativeChange float64
+}
+
+// ChannelFee describes the fees of a channel whose fees are managed.
+type ChannelFee struct {
+	// ChanID is the short channel id of the channel.
+	ChanID lnwire.ShortChannelID
+
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// BaseFee is the base fee that we currently advertise.
+	BaseFee lnwire.MilliSatoshi
+
+	// FeeRate is the fee rate in parts per million that we currently
+	// advertise.
+	FeeRate uint32
+
+	// TargetBaseFee is the base fee that the rule of the channel results
+	// in.
+	TargetBaseFee lnwire.MilliSatoshi
+
+	// TargetFeeRate is the fee rate that the rule of the channel results
+	// in.
+	TargetFeeRate uint32
+
+	// LastUpdate is the time of the last policy update of the channel.
+	LastUpdate time.Time
+
+	// timeLockDelta and maxHTLC are carried over from the current policy
+	// when the fees are updated.
+	timeLockDelta uint16
+	maxHTLC       lnwire.MilliSatoshi
+}
+
+// Manager periodically evaluates the fee rules of our channels, and updates
+// the policies of the channels whose fees deviate from the ones their rules
+// result in. Policy updates are rate limited per channel. The rules can be
+// replaced at runtime, after which the fees are evaluated right away.
+type Manager struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// rules are the fee rules that are in effect. They are never modified
+	// in place, but replaced as a whole.
+	rules *Rules
+
+	// rulesMtx guards rules, and serializes writes to the rules file.
+	rulesMtx sync.RWMutex
+
+	// rulesUpdated is signaled when the rules are replaced, to evaluate
+	// the fees without waiting for the next tick.
+	rulesUpdated chan struct{}
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// New creates a new fee policy manager from the given config.
+func New(cfg *Config) (*Manager, error) {
+	if err := cfg.Rules.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Manager{
+		cfg:          cfg,
+		rules:        cfg.Rules,
+		rulesUpdated: make(chan struct{}, 1),
+		quit:         make(chan struct{}),
+	}, nil
+}
+
+// Start starts the fee policy manager.
+func (m *Manager) Start() error {
+	m.started.Do(func() {
+		log.Info("Fee policy manager starting")
+
+		m.cfg.Ticker.Resume()
+
+		m.wg.Add(1)
+		go m.updateLoop()
+	})
+
+	return nil
+}
+
+// Stop signals the fee policy manager for a graceful shutdown.
+func (m *Manager) Stop() error {
+	m.stopped.Do(func() {
+		log.Info("Fee policy manager shutting down")
+
+		close(m.quit)
+		m.wg.Wait()
+
+		m.cfg.Ticker.Stop()
+	})
+
+	return nil
+}
+
+// Rules returns the fee rules that are in effect.
+func (m *Manager) Rules() *Rules {
+	m.rulesMtx.RLock()
+	defer m.rulesMtx.RUnlock()
+
+	return m.rules
+}
+
+// SetRules replaces the fee rules of all channels. If the manager has a rules
+// file, the rules are saved to it first, such that they're kept across
+// restarts.
+func (m *Manager) SetRules(rules *Rules) error {
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+
+	m.rulesMtx.Lock()
+	defer m.rulesMtx.Unlock()
+
+	if m.cfg.RulesFile != "" {
+		if err := SaveRules(m.cfg.RulesFile, rules); err != nil {
+			return fmt.Errorf("unable to save fee rules: %v", err)
+		}
+	}
+
+	m.replaceRules(rules)
+
+	return nil
+}
+
+// ReloadRules reads the fee rules from the rules file again and puts them into
+// effect, which allows editing the file without restarting.
+func (m *Manager) ReloadRules() (*Rules, error) {
+	if m.cfg.RulesFile == "" {
+		return nil, ErrNoRulesFile
+	}
+
+	m.rulesMtx.Lock()
+	defer m.rulesMtx.Unlock()
+
+	rules, err := LoadRules(m.cfg.RulesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	m.replaceRules(rules)
+
+	return rules, nil
+}
+
+// replaceRules puts the given rules into effect and triggers the evaluation of
+// the fees of our channels.
+//
+// NOTE: This MUST be called with the rulesMtx held.
+func (m *Manager) replaceRules(rules *Rules) {
+	m.rules = rules
+
+	log.Infof("Fee rules replaced, %v channel rules, default rule set: %v",
+		len(rules.Channels), rules.Default != nil)
+
+	select {
+	case m.rulesUpdated <- struct{}{}:
+	default:
+	}
+}
+
+// updateLoop re-evaluates the fees of our channels every time the ticker
+// fires, and whenever the rules are replaced.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Manager) updateLoop() {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case <-m.cfg.Ticker.Ticks():
+		case <-m.rulesUpdated:
+		case <-m.quit:
+			return
+		}
+
+		if err := m.updateFees(); err != nil {
+			log.Errorf("Unable to update channel fees: %v", err)
+		}
+	}
+}
+
+// updateFees updates the policies of all channels whose fees deviate enough
+// from their target fees, unless they were updated too recently. A channel
+// whose policy can't be updated doesn't hold back the updates of the others.
+func (m *Manager) updateFees() error {
+	fees, err := m.ChannelFees()
+	if err != nil {
+		return err
+	}
+
+	now := m.cfg.Now()
+	for _, fee := range fees {
+		if !m.needsUpdate(fee) {
+			continue
+		}
+
+		if now.Sub(fee.LastUpdate) < m.cfg.MinUpdateInterval {
+			log.Debugf("Holding back fee update of channel %v, "+
+				"last update was at %v", fee.ChanID,
+				fee.LastUpdate)
+			continue
+		}
+
+		log.Infof("Updating fees of channel %v from base_fee=%v, "+
+			"fee_rate=%v to base_fee=%v, fee_rate=%v", fee.ChanID,
+			fee.BaseFee, fee.FeeRate, fee.TargetBaseFee,
+			fee.TargetFeeRate)
+
+		err := m.cfg.UpdatePolicy(routing.ChannelPolicy{
+			FeeSchema: routing.FeeSchema{
+				BaseFee: fee.TargetBaseFee,
+				FeeRate: fee.TargetFeeRate,
+			},
+			TimeLockDelta: uint32(fee.timeLockDelta),
+			MaxHTLC:       fee.maxHTLC,
+		}, fee.ChanPoint)
+		if err != nil {
+			log.Errorf("Unable to update fees of channel %v: %v",
+				fee.ChanID, err)
+		}
+	}
+
+	return nil
+}
+
+// needsUpdate returns whether the advertised fees of a channel deviate enough
+// from its target fees to warrant a policy update.
+func (m *Manager) needsUpdate(fee *ChannelFee) bool {
+	if fee.BaseFee != fee.TargetBaseFee {
+		return true
+	}
+
+	switch {
+	case fee.FeeRate == fee.TargetFeeRate:
+		return false
+
+	case fee.FeeRate == 0:
+		return true
+	}
+
+	change := math.Abs(float64(fee.TargetFeeRate)-float64(fee.FeeRate)) /
+		float64(fee.FeeRate)
+
+	return change >= m.cfg.MinRelativeChange
+}
+
+// ChannelFees returns the current and target fees of all channels whose fees
+// are managed by a rule.
+func (m *Manager) ChannelFees() ([]*ChannelFee, error) {
+	channels, err := m.cfg.FetchChannels()
+	if err != nil {
+		return nil, err
+	}
+	openChannels := make(map[wire.OutPoint]*channeldb.OpenChannel)
+	for _, channel := range channels {
+		openChannels[channel.FundingOutpoint] = channel
+	}
+
+	// Collect the channels whose fees are managed along with their
+	// currently advertised policies.
+	rules := m.Rules()
+	type managedChannel struct {
+		fee     *ChannelFee
+		rule    *Rule
+		channel *channeldb.OpenChannel
+	}
+	var managed []*managedChannel
+	err = m.cfg.ForAllOutgoingChannels(func(info *channeldb.ChannelEdgeInfo,
+		edge *channeldb.ChannelEdgePolicy) error {
+
+		if edge == nil {
+			return nil
+		}
+
+		chanID := lnwire.NewShortChanIDFromInt(info.ChannelID)
+		rule := rules.ruleOf(chanID)
+		if rule == nil {
+			return nil
+		}
+
+		channel, ok := openChannels[info.ChannelPoint]
+		if !ok {
+			return nil
+		}
+
+		managed = append(managed, &managedChannel{
+			fee: &ChannelFee{
+				ChanID:    chanID,
+				ChanPoint: info.ChannelPoint,
+				BaseFee:   edge.FeeBaseMSat,
+				FeeRate: uint32(
+					edge.FeeProportionalMillionths,
+				),
+				LastUpdate:    edge.LastUpdate,
+				timeLockDelta: edge.TimeLockDelta,
+				maxHTLC:       edge.MaxHTLC,
+			},
+			rule:    rule,
+			channel: channel,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The forwarded volumes are fetched once per distinct window, as
+	// rules commonly share the same window.
+	now := m.cfg.Now()
+	volumes := make(
+		map[time.Duration]map[lnwire.ShortChannelID]btcutil.Amount,
+	)
+
+	fees := make([]*ChannelFee, 0, len(managed))
+	for _, c := range managed {
+		var volume btcutil.Amount
+		if c.rule.Forwarding != nil {
+			window := c.rule.Forwarding.Window
+			if _, ok := volumes[window]; !ok {
+				volumes[window], err = m.forwardedVolumes(
+					now.Add(-window), now,
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
+			volume = volumes[window][c.fee.ChanID]
+		}
+
+		var localRatio float64
+		if c.channel.Capacity != 0 {
+			capacity := lnwire.NewMSatFromSatoshis(
+				c.channel.Capacity,
+			)
+			localRatio = float64(
+				c.channel.LocalCommitment.LocalBalance,
+			) / float64(capacity)
+		}
+
+		c.fee.TargetBaseFee = c.rule.BaseFee
+		c.fee.TargetFeeRate = c.rule.feeRate(localRatio, volume, now)
+
+		fees = append(fees, c.fee)
+	}
+
+	return fees, nil
+}
+
+// forwardedVolumes returns the amounts that left through each of our channels
+// within the given time slice.
+func (m *Manager) forwardedVolumes(start, end time.Time) (
+	map[lnwire.ShortChannelID]btcutil.Amount, error) {
+
+	volumes := make(map[lnwire.ShortChannelID]btcutil.Amount)
+
+	query := channeldb.ForwardingEventQuery{
+		StartTime:    start,
+		EndTime:      end,
+		NumMaxEvents: forwardingQueryBatch,
+	}
+	for {
+		timeSlice, err := m.cfg.ForwardingLog.Query(query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range timeSlice.ForwardingEvents {
+			amt := event.AmtOut.ToSatoshis()
+			volumes[event.OutgoingChanID] += amt
+		}
+
+		if len(timeSlice.ForwardingEvents) < forwardingQueryBatch {
+			return volumes, nil
+		}
+		query.IndexOffset = timeSlice.LastIndexOffset
+	}
+}
+
+// feeRate returns the fee rate that the rule results in for a channel with the
+// given local balance ratio and forwarded volume at the given time.
+func (r *Rule) feeRate(localRatio float64, volume btcutil.Amount,
+	now time.Time) uint32 {
+
+	feeRate := float64(r.FeeRate)
+
+	if r.Balance != nil {
+		feeRate *= r.Balance.multiplier(localRatio)
+	}
+
+	if r.Forwarding != nil && volume >= r.Forwarding.MinVolume {
+		feeRate *= r.Forwarding.Multiplier
+	}
+
+	for _, schedule := range r.Schedules {
+		if schedule.active(now) {
+			feeRate *= schedule.Multiplier
+		}
+	}
+
+	feeRate = math.Round(feeRate)
+	if r.MaxFeeRate != 0 && feeRate > float64(r.MaxFeeRate) {
+		feeRate = float64(r.MaxFeeRate)
+	}
+	if feeRate < float64(r.MinFeeRate) {
+		feeRate = float64(r.MinFeeRate)
+	}
+	if feeRate > math.MaxUint32 {
+		feeRate = math.MaxUint32
+	}
+
+	return uint32(feeRate)
+}
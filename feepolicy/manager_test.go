@@ -0,0 +1,425 @@
+package feepolicy
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/lnwire"
+	"github.com/BTCGPU/lnd/routing"
+	"github.com/BTCGPU/lnd/ticker"
+	"github.com/btgsuite/btgd/wire"
+	btcutil "github.com/btgsuite/btgutil"
+)
+
+const testTimeout = 5 * time.Second
+
+// mockForwardingLog is an in-memory implementation of the ForwardingLog
+// interface.
+type mockForwardingLog struct {
+	events []channeldb.ForwardingEvent
+}
+
+func (m *mockForwardingLog) Query(q channeldb.ForwardingEventQuery) (
+	channeldb.ForwardingLogTimeSlice, error) {
+
+	resp := channeldb.ForwardingLogTimeSlice{
+		ForwardingEventQuery: q,
+	}
+	for _, event := range m.events {
+		if event.Timestamp.Before(q.StartTime) ||
+			event.Timestamp.After(q.EndTime) {
+
+			continue
+		}
+		resp.ForwardingEvents = append(resp.ForwardingEvents, event)
+	}
+
+	return resp, nil
+}
+
+// testChannel is a channel along with the policy that we advertise for it.
+type testChannel struct {
+	channel *channeldb.OpenChannel
+	info    *channeldb.ChannelEdgeInfo
+	edge    *channeldb.ChannelEdgePolicy
+}
+
+// newTestChannel returns a channel with the given short channel id, capacity
+// and local balance, whose fee rate was last updated at the given time.
+func newTestChannel(chanID uint64, capacity, local btcutil.Amount,
+	feeRate uint32, lastUpdate time.Time) *testChannel {
+
+	chanPoint := wire.OutPoint{Index: uint32(chanID)}
+
+	return &testChannel{
+		channel: &channeldb.OpenChannel{
+			ShortChannelID:  lnwire.NewShortChanIDFromInt(chanID),
+			FundingOutpoint: chanPoint,
+			Capacity:        capacity,
+			LocalCommitment: channeldb.ChannelCommitment{
+				LocalBalance: lnwire.NewMSatFromSatoshis(local),
+			},
+		},
+		info: &channeldb.ChannelEdgeInfo{
+			ChannelID:    chanID,
+			ChannelPoint: chanPoint,
+		},
+		edge: &channeldb.ChannelEdgePolicy{
+			ChannelID:                 chanID,
+			LastUpdate:                lastUpdate,
+			TimeLockDelta:             40,
+			MaxHTLC:                   1000000,
+			FeeProportionalMillionths: lnwire.MilliSatoshi(feeRate),
+		},
+	}
+}
+
+// policyUpdate is a policy update requested by the manager.
+type policyUpdate struct {
+	policy     routing.ChannelPolicy
+	chanPoints []wire.OutPoint
+}
+
+// TestManagerUpdateFees asserts that the manager only updates the policies of
+// channels with a rule whose fee rate deviates enough from the target fee
+// rate, and that updates of recently updated channels are held back.
+func TestManagerUpdateFees(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	channels := []*testChannel{
+		// This channel is updated, as its fee rate of 100 is far
+		// from its target of 300.
+		newTestChannel(1, 100000, 0, 100, now.Add(-2*time.Hour)),
+
+		// This channel was updated too recently to be updated again.
+		newTestChannel(2, 100000, 0, 100, now.Add(-time.Minute)),
+
+		// The target of this channel only deviates by 5 percent from
+		// its fee rate, which is below the minimum relative change.
+		newTestChannel(3, 100000, 100000, 95, now.Add(-2*time.Hour)),
+
+		// This channel is updated, as the amount forwarded through it
+		// within the last hour doubles its target fee rate.
+		newTestChannel(4, 100000, 100000, 100, now.Add(-2*time.Hour)),
+
+		// The fees of this channel aren't managed.
+		newTestChannel(5, 100000, 0, 100, now.Add(-2*time.Hour)),
+	}
+
+	volume := lnwire.NewMSatFromSatoshis(5000)
+	forwardingLog := &mockForwardingLog{
+		events: []channeldb.ForwardingEvent{
+			{
+				Timestamp:      now.Add(-30 * time.Minute),
+				OutgoingChanID: lnwire.NewShortChanIDFromInt(4),
+				AmtOut:         volume,
+			},
+			{
+				Timestamp:      now.Add(-2 * time.Hour),
+				OutgoingChanID: lnwire.NewShortChanIDFromInt(3),
+				AmtOut:         volume,
+			},
+		},
+	}
+
+	rule := &Rule{
+		FeeRate: 100,
+		Balance: &BalanceRule{
+			EmptyMultiplier: 3,
+			FullMultiplier:  1,
+		},
+		Forwarding: &ForwardingRule{
+			Window:     time.Hour,
+			MinVolume:  5000,
+			Multiplier: 2,
+		},
+	}
+
+	updates := make(chan *policyUpdate, len(channels))
+	forceTicker := ticker.NewForce(time.Hour)
+
+	manager, err := New(&Config{
+		FetchChannels: func() ([]*channeldb.OpenChannel, error) {
+			var openChannels []*channeldb.OpenChannel
+			for _, c := range channels {
+				openChannels = append(openChannels, c.channel)
+			}
+			return openChannels, nil
+		},
+		ForAllOutgoingChannels: func(cb func(*channeldb.ChannelEdgeInfo,
+			*channeldb.ChannelEdgePolicy) error) error {
+
+			for _, c := range channels {
+				if err := cb(c.info, c.edge); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		UpdatePolicy: func(policy routing.ChannelPolicy,
+			chanPoints ...wire.OutPoint) error {
+
+			updates <- &policyUpdate{
+				policy:     policy,
+				chanPoints: chanPoints,
+			}
+			return nil
+		},
+		ForwardingLog: forwardingLog,
+		Ticker:        forceTicker,
+		Now: func() time.Time {
+			return now
+		},
+		Rules: &Rules{
+			Channels: map[lnwire.ShortChannelID]*Rule{
+				lnwire.NewShortChanIDFromInt(1): rule,
+				lnwire.NewShortChanIDFromInt(2): rule,
+				lnwire.NewShortChanIDFromInt(3): rule,
+				lnwire.NewShortChanIDFromInt(4): rule,
+			},
+		},
+		MinUpdateInterval: time.Hour,
+		MinRelativeChange: 0.1,
+	})
+	if err != nil {
+		t.Fatalf("unable to create manager: %v", err)
+	}
+
+	fees, err := manager.ChannelFees()
+	if err != nil {
+		t.Fatalf("unable to fetch channel fees: %v", err)
+	}
+	if len(fees) != 4 {
+		t.Fatalf("expected fees of 4 channels, got %v", len(fees))
+	}
+
+	if err := manager.Start(); err != nil {
+		t.Fatalf("unable to start manager: %v", err)
+	}
+	defer manager.Stop()
+
+	select {
+	case forceTicker.Force <- time.Now():
+	case <-time.After(testTimeout):
+		t.Fatalf("manager didn't accept tick")
+	}
+
+	expectedUpdates := []struct {
+		chanID  uint32
+		feeRate uint32
+	}{
+		{chanID: 1, feeRate: 300},
+		{chanID: 4, feeRate: 200},
+	}
+	for _, expected := range expectedUpdates {
+		var update *policyUpdate
+		select {
+		case update = <-updates:
+		case <-time.After(testTimeout):
+			t.Fatalf("expected update of channel %v",
+				expected.chanID)
+		}
+
+		if len(update.chanPoints) != 1 ||
+			update.chanPoints[0].Index != expected.chanID {
+
+			t.Fatalf("expected update of channel %v, got %v",
+				expected.chanID, update.chanPoints)
+		}
+		if update.policy.FeeRate != expected.feeRate {
+			t.Fatalf("expected fee rate %v, got %v",
+				expected.feeRate, update.policy.FeeRate)
+		}
+		if update.policy.TimeLockDelta != 40 ||
+			update.policy.MaxHTLC != 1000000 {
+
+			t.Fatalf("expected time lock delta and max htlc to "+
+				"be carried over, got %+v", update.policy)
+		}
+	}
+
+	select {
+	case update := <-updates:
+		t.Fatalf("unexpected update of channels %v",
+			update.chanPoints)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// TestManagerSetRules asserts that rules set at runtime are saved to the rules
+// file and evaluated right away, that edits of the rules file are picked up on
+// reload, and that a failed policy update doesn't hold back the updates of the
+// other channels.
+func TestManagerSetRules(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "feepolicy")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	rulesFile := filepath.Join(tempDir, "rules.json")
+
+	channels := []*testChannel{
+		newTestChannel(1, 100000, 0, 100, now.Add(-2*time.Hour)),
+		newTestChannel(2, 100000, 0, 100, now.Add(-2*time.Hour)),
+	}
+
+	// The policy of the first channel can't be updated.
+	updates := make(chan *policyUpdate, len(channels))
+	updatePolicy := func(policy routing.ChannelPolicy,
+		chanPoints ...wire.OutPoint) error {
+
+		updates <- &policyUpdate{
+			policy:     policy,
+			chanPoints: chanPoints,
+		}
+		if chanPoints[0].Index == 1 {
+			return errors.New("unable to update policy")
+		}
+		return nil
+	}
+
+	cfg := &Config{
+		FetchChannels: func() ([]*channeldb.OpenChannel, error) {
+			var openChannels []*channeldb.OpenChannel
+			for _, c := range channels {
+				openChannels = append(openChannels, c.channel)
+			}
+			return openChannels, nil
+		},
+		ForAllOutgoingChannels: func(cb func(*channeldb.ChannelEdgeInfo,
+			*channeldb.ChannelEdgePolicy) error) error {
+
+			for _, c := range channels {
+				if err := cb(c.info, c.edge); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		UpdatePolicy:  updatePolicy,
+		ForwardingLog: &mockForwardingLog{},
+		Ticker:        ticker.NewForce(time.Hour),
+		Now: func() time.Time {
+			return now
+		},
+		Rules:             &Rules{},
+		RulesFile:         rulesFile,
+		MinUpdateInterval: time.Hour,
+		MinRelativeChange: 0.1,
+	}
+	manager, err := New(cfg)
+	if err != nil {
+		t.Fatalf("unable to create manager: %v", err)
+	}
+	if err := manager.Start(); err != nil {
+		t.Fatalf("unable to start manager: %v", err)
+	}
+	defer manager.Stop()
+
+	// Invalid rules must be rejected, and leave the rules file alone.
+	err = manager.SetRules(&Rules{
+		Default: &Rule{MinFeeRate: 200, MaxFeeRate: 100},
+	})
+	if err == nil {
+		t.Fatalf("expected invalid rules to be rejected")
+	}
+	if _, err := os.Stat(rulesFile); !os.IsNotExist(err) {
+		t.Fatalf("expected no rules file, got %v", err)
+	}
+
+	// Setting the rules evaluates the fees without waiting for a tick.
+	// Although the update of the first channel fails, the second channel
+	// is still updated.
+	rules := &Rules{
+		Default: &Rule{FeeRate: 300},
+	}
+	if err := manager.SetRules(rules); err != nil {
+		t.Fatalf("unable to set rules: %v", err)
+	}
+	if manager.Rules() != rules {
+		t.Fatalf("expected rules to be replaced")
+	}
+
+	for _, chanID := range []uint32{1, 2} {
+		var update *policyUpdate
+		select {
+		case update = <-updates:
+		case <-time.After(testTimeout):
+			t.Fatalf("expected update of channel %v", chanID)
+		}
+
+		if update.chanPoints[0].Index != chanID {
+			t.Fatalf("expected update of channel %v, got %v",
+				chanID, update.chanPoints)
+		}
+		if update.policy.FeeRate != 300 {
+			t.Fatalf("expected fee rate 300, got %v",
+				update.policy.FeeRate)
+		}
+	}
+
+	saved, err := LoadRules(rulesFile)
+	if err != nil {
+		t.Fatalf("unable to load saved rules: %v", err)
+	}
+	if saved.Default == nil || saved.Default.FeeRate != 300 {
+		t.Fatalf("expected saved default fee rate 300, got %+v",
+			saved.Default)
+	}
+
+	// Edit the rules file, and reload it.
+	edited := &Rules{
+		Channels: map[lnwire.ShortChannelID]*Rule{
+			lnwire.NewShortChanIDFromInt(2): {FeeRate: 500},
+		},
+	}
+	if err := SaveRules(rulesFile, edited); err != nil {
+		t.Fatalf("unable to save rules: %v", err)
+	}
+
+	reloaded, err := manager.ReloadRules()
+	if err != nil {
+		t.Fatalf("unable to reload rules: %v", err)
+	}
+	if manager.Rules() != reloaded {
+		t.Fatalf("expected rules to be replaced")
+	}
+	if reloaded.Default != nil || len(reloaded.Channels) != 1 {
+		t.Fatalf("expected edited rules, got %+v", reloaded)
+	}
+
+	select {
+	case update := <-updates:
+		if update.chanPoints[0].Index != 2 ||
+			update.policy.FeeRate != 500 {
+
+			t.Fatalf("expected update of channel 2 to fee "+
+				"rate 500, got %v: %+v", update.chanPoints,
+				update.policy)
+		}
+	case <-time.After(testTimeout):
+		t.Fatalf("expected update of channel 2")
+	}
+
+	// Without a rules file, there's nothing to reload.
+	noFileCfg := *cfg
+	noFileCfg.RulesFile = ""
+	noFileManager, err := New(&noFileCfg)
+	if err != nil {
+		t.Fatalf("unable to create manager: %v", err)
+	}
+	if _, err := noFileManager.ReloadRules(); err != ErrNoRulesFile {
+		t.Fatalf("expected no rules file error, got %v", err)
+	}
+}
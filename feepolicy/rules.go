@@ -0,0 +1,465 @@
+package feepolicy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BTCGPU/lnd/lnwire"
+	btcutil "github.com/btgsuite/btgutil"
+)
+
+var (
+	// ErrInvalidMultiplier is returned when a rule contains a fee rate
+	// multiplier that isn't positive.
+	ErrInvalidMultiplier = errors.New("fee rate multipliers must be " +
+		"positive")
+
+	// ErrInvalidFeeRateRange is returned when the maximum fee rate of a
+	// rule is below its minimum fee rate.
+	ErrInvalidFeeRateRange = errors.New("max fee rate must not be below " +
+		"min fee rate")
+)
+
+// Rule declares how the forwarding fees of a channel are derived. The fee rate
+// starts out at FeeRate, is scaled by the multipliers of the balance,
+// forwarding and schedule rules that apply, and is finally clamped to the
+// range [MinFeeRate, MaxFeeRate].
+type Rule struct {
+	// BaseFee is the base fee that is charged for every forwarded HTLC.
+	BaseFee lnwire.MilliSatoshi
+
+	// FeeRate is the fee rate in parts per million before any of the
+	// multipliers are applied.
+	FeeRate uint32
+
+	// MinFeeRate is the lowest fee rate in parts per million that the
+	// rule results in.
+	MinFeeRate uint32
+
+	// MaxFeeRate is the highest fee rate in parts per million that the
+	// rule results in. A value of zero means no maximum.
+	MaxFeeRate uint32
+
+	// Balance optionally scales the fee rate with the local balance of
+	// the channel.
+	Balance *BalanceRule
+
+	// Forwarding optionally raises the fee rate while the channel is
+	// forwarding a lot of payments.
+	Forwarding *ForwardingRule
+
+	// Schedules optionally scale the fee rate during certain times of the
+	// day.
+	Schedules []Schedule
+}
+
+// BalanceRule scales the fee rate of a channel with the share of its capacity
+// that is on our side. The multiplier is interpolated linearly between
+// EmptyMultiplier, which applies if none of the capacity is on our side, and
+// FullMultiplier, which applies if all of it is.
+type BalanceRule struct {
+	// EmptyMultiplier is the multiplier of a channel without any local
+	// balance.
+	EmptyMultiplier float64
+
+	// FullMultiplier is the multiplier of a channel whose whole capacity
+	// is on our side.
+	FullMultiplier float64
+}
+
+// multiplier returns the fee rate multiplier for the given local balance
+// ratio.
+func (b *BalanceRule) multiplier(localRatio float64) float64 {
+	return b.EmptyMultiplier +
+		(b.FullMultiplier-b.EmptyMultiplier)*localRatio
+}
+
+// ForwardingRule raises the fee rate of a channel once the amount forwarded
+// through it within the trailing window reaches MinVolume.
+type ForwardingRule struct {
+	// Window is the duration over which the forwarded amount is summed
+	// up.
+	Window time.Duration
+
+	// MinVolume is the amount that needs to leave through the channel
+	// within the window for the rule to apply.
+	MinVolume btcutil.Amount
+
+	// Multiplier is the fee rate multiplier that applies while the
+	// forwarded amount is at or above MinVolume.
+	Multiplier float64
+}
+
+// Schedule scales the fee rate of a channel during a time of the day. Start
+// and End are offsets from midnight UTC. A schedule whose end lies before its
+// start wraps around midnight.
+type Schedule struct {
+	// Start is the offset from midnight UTC at which the schedule starts.
+	Start time.Duration
+
+	// End is the offset from midnight UTC at which the schedule ends.
+	End time.Duration
+
+	// Multiplier is the fee rate multiplier that applies while the
+	// schedule is active.
+	Multiplier float64
+}
+
+// active returns whether the schedule applies at the given time.
+func (s Schedule) active(now time.Time) bool {
+	now = now.UTC()
+	midnight := time.Date(
+		now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC,
+	)
+	offset := now.Sub(midnight)
+
+	if s.Start < s.End {
+		return offset >= s.Start && offset < s.End
+	}
+
+	return offset >= s.Start || offset < s.End
+}
+
+// Validate checks that the rule is well formed.
+func (r *Rule) Validate() error {
+	if r.MaxFeeRate != 0 && r.MaxFeeRate < r.MinFeeRate {
+		return ErrInvalidFeeRateRange
+	}
+
+	if r.Balance != nil && (r.Balance.EmptyMultiplier <= 0 ||
+		r.Balance.FullMultiplier <= 0) {
+
+		return ErrInvalidMultiplier
+	}
+
+	if r.Forwarding != nil {
+		if r.Forwarding.Multiplier <= 0 {
+			return ErrInvalidMultiplier
+		}
+		if r.Forwarding.Window <= 0 {
+			return errors.New("forwarding window must be positive")
+		}
+	}
+
+	for _, schedule := range r.Schedules {
+		if schedule.Multiplier <= 0 {
+			return ErrInvalidMultiplier
+		}
+		if schedule.Start < 0 || schedule.Start >= 24*time.Hour ||
+			schedule.End < 0 || schedule.End >= 24*time.Hour {
+
+			return errors.New("schedule times must be within a day")
+		}
+		if schedule.Start == schedule.End {
+			return errors.New("schedule must not be empty")
+		}
+	}
+
+	return nil
+}
+
+// Rules holds the fee rules of all channels.
+type Rules struct {
+	// Default is the rule of all channels that don't have a rule of their
+	// own. If it is nil, the fees of those channels aren't managed.
+	Default *Rule
+
+	// Channels holds the rules of channels that override the default
+	// rule, keyed by short channel id.
+	Channels map[lnwire.ShortChannelID]*Rule
+}
+
+// Validate checks that all rules are well formed.
+func (r *Rules) Validate() error {
+	if r.Default != nil {
+		if err := r.Default.Validate(); err != nil {
+			return fmt.Errorf("default rule: %v", err)
+		}
+	}
+
+	for chanID, rule := range r.Channels {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("rule of channel %v: %v", chanID, err)
+		}
+	}
+
+	return nil
+}
+
+// ruleOf returns the rule that applies to the given channel, or nil if its
+// fees aren't managed.
+func (r *Rules) ruleOf(chanID lnwire.ShortChannelID) *Rule {
+	if rule, ok := r.Channels[chanID]; ok {
+		return rule
+	}
+
+	return r.Default
+}
+
+// jsonRule is the json encoding of a Rule within a rules file.
+type jsonRule struct {
+	ChanID     uint64              `json:"chan_id,omitempty"`
+	BaseFee    uint64              `json:"base_fee_msat"`
+	FeeRate    uint32              `json:"fee_rate_ppm"`
+	MinFeeRate uint32              `json:"min_fee_rate_ppm"`
+	MaxFeeRate uint32              `json:"max_fee_rate_ppm"`
+	Balance    *jsonBalanceRule    `json:"balance,omitempty"`
+	Forwarding *jsonForwardingRule `json:"forwarding,omitempty"`
+	Schedules  []jsonSchedule      `json:"schedules,omitempty"`
+}
+
+// jsonBalanceRule is the json encoding of a BalanceRule.
+type jsonBalanceRule struct {
+	EmptyMultiplier float64 `json:"empty_multiplier"`
+	FullMultiplier  float64 `json:"full_multiplier"`
+}
+
+// jsonForwardingRule is the json encoding of a ForwardingRule. The window is
+// given as a duration string such as "1h".
+type jsonForwardingRule struct {
+	Window     string  `json:"window"`
+	MinVolume  int64   `json:"min_volume_sat"`
+	Multiplier float64 `json:"multiplier"`
+}
+
+// jsonSchedule is the json encoding of a Schedule. The start and end are given
+// as times of the day in the format "15:04".
+type jsonSchedule struct {
+	Start      string  `json:"start"`
+	End        string  `json:"end"`
+	Multiplier float64 `json:"multiplier"`
+}
+
+// jsonRules is the json encoding of a rules file.
+type jsonRules struct {
+	Default  *jsonRule  `json:"default,omitempty"`
+	Channels []jsonRule `json:"channels,omitempty"`
+}
+
+// ParseTimeOfDay parses a time of the day in the format "15:04" into an offset
+// from midnight.
+func ParseTimeOfDay(s string) (time.Duration, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM",
+			s)
+	}
+
+	hours, err := strconv.ParseUint(parts[0], 10, 8)
+	if err != nil || hours > 23 {
+		return 0, fmt.Errorf("invalid hour in time of day %q", s)
+	}
+	minutes, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil || minutes > 59 {
+		return 0, fmt.Errorf("invalid minute in time of day %q", s)
+	}
+
+	return time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute, nil
+}
+
+// FormatTimeOfDay formats an offset from midnight as a time of the day in the
+// format "15:04".
+func FormatTimeOfDay(offset time.Duration) string {
+	hours := offset / time.Hour
+	minutes := (offset % time.Hour) / time.Minute
+
+	return fmt.Sprintf("%02d:%02d", hours, minutes)
+}
+
+// toRule converts the json encoding of a rule into a Rule.
+func (j *jsonRule) toRule() (*Rule, error) {
+	rule := &Rule{
+		BaseFee:    lnwire.MilliSatoshi(j.BaseFee),
+		FeeRate:    j.FeeRate,
+		MinFeeRate: j.MinFeeRate,
+		MaxFeeRate: j.MaxFeeRate,
+	}
+
+	if j.Balance != nil {
+		rule.Balance = &BalanceRule{
+			EmptyMultiplier: j.Balance.EmptyMultiplier,
+			FullMultiplier:  j.Balance.FullMultiplier,
+		}
+	}
+
+	if j.Forwarding != nil {
+		window, err := time.ParseDuration(j.Forwarding.Window)
+		if err != nil {
+			return nil, fmt.Errorf("invalid forwarding window: %v",
+				err)
+		}
+
+		rule.Forwarding = &ForwardingRule{
+			Window:     window,
+			MinVolume:  btcutil.Amount(j.Forwarding.MinVolume),
+			Multiplier: j.Forwarding.Multiplier,
+		}
+	}
+
+	for _, s := range j.Schedules {
+		start, err := ParseTimeOfDay(s.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := ParseTimeOfDay(s.End)
+		if err != nil {
+			return nil, err
+		}
+
+		rule.Schedules = append(rule.Schedules, Schedule{
+			Start:      start,
+			End:        end,
+			Multiplier: s.Multiplier,
+		})
+	}
+
+	return rule, nil
+}
+
+// newJSONRule converts a rule of the given channel into its json encoding.
+func newJSONRule(chanID lnwire.ShortChannelID, rule *Rule) jsonRule {
+	j := jsonRule{
+		ChanID:     chanID.ToUint64(),
+		BaseFee:    uint64(rule.BaseFee),
+		FeeRate:    rule.FeeRate,
+		MinFeeRate: rule.MinFeeRate,
+		MaxFeeRate: rule.MaxFeeRate,
+	}
+
+	if rule.Balance != nil {
+		j.Balance = &jsonBalanceRule{
+			EmptyMultiplier: rule.Balance.EmptyMultiplier,
+			FullMultiplier:  rule.Balance.FullMultiplier,
+		}
+	}
+
+	if rule.Forwarding != nil {
+		j.Forwarding = &jsonForwardingRule{
+			Window:     rule.Forwarding.Window.String(),
+			MinVolume:  int64(rule.Forwarding.MinVolume),
+			Multiplier: rule.Forwarding.Multiplier,
+		}
+	}
+
+	for _, s := range rule.Schedules {
+		j.Schedules = append(j.Schedules, jsonSchedule{
+			Start:      FormatTimeOfDay(s.Start),
+			End:        FormatTimeOfDay(s.End),
+			Multiplier: s.Multiplier,
+		})
+	}
+
+	return j
+}
+
+// ParseRules decodes and validates the json encoded fee rules read from r.
+func ParseRules(r io.Reader) (*Rules, error) {
+	var encoded jsonRules
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&encoded); err != nil {
+		return nil, fmt.Errorf("unable to decode fee rules: %v", err)
+	}
+
+	rules := &Rules{
+		Channels: make(map[lnwire.ShortChannelID]*Rule),
+	}
+
+	if encoded.Default != nil {
+		rule, err := encoded.Default.toRule()
+		if err != nil {
+			return nil, fmt.Errorf("default rule: %v", err)
+		}
+		rules.Default = rule
+	}
+
+	for i := range encoded.Channels {
+		chanID := lnwire.NewShortChanIDFromInt(
+			encoded.Channels[i].ChanID,
+		)
+		if chanID.ToUint64() == 0 {
+			return nil, errors.New("channel rules must specify a " +
+				"chan_id")
+		}
+		if _, ok := rules.Channels[chanID]; ok {
+			return nil, fmt.Errorf("duplicate rule for channel %v",
+				chanID)
+		}
+
+		rule, err := encoded.Channels[i].toRule()
+		if err != nil {
+			return nil, fmt.Errorf("rule of channel %v: %v",
+				chanID, err)
+		}
+		rules.Channels[chanID] = rule
+	}
+
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// WriteRules writes the json encoding of the given fee rules to w, in the
+// format that ParseRules reads.
+func WriteRules(w io.Writer, rules *Rules) error {
+	var encoded jsonRules
+	if rules.Default != nil {
+		rule := newJSONRule(lnwire.ShortChannelID{}, rules.Default)
+		encoded.Default = &rule
+	}
+	for chanID, rule := range rules.Channels {
+		encoded.Channels = append(
+			encoded.Channels, newJSONRule(chanID, rule),
+		)
+	}
+	sort.Slice(encoded.Channels, func(i, j int) bool {
+		return encoded.Channels[i].ChanID < encoded.Channels[j].ChanID
+	})
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "\t")
+	return encoder.Encode(&encoded)
+}
+
+// SaveRules writes the fee rules to the json file at the given path. The file
+// is replaced atomically, such that it always holds a complete set of rules.
+func SaveRules(path string, rules *Rules) error {
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), "feerules")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+
+	if err := WriteRules(tempFile, rules); err != nil {
+		tempFile.Close()
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), path)
+}
+
+// LoadRules reads the fee rules from the json file at the given path.
+func LoadRules(path string) (*Rules, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseRules(f)
+}
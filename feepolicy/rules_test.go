@@ -0,0 +1,282 @@
+package feepolicy
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BTCGPU/lnd/lnwire"
+	btcutil "github.com/btgsuite/btgutil"
+)
+
+// TestParseRules asserts that a rules file is decoded into the expected rules,
+// and that malformed rules are rejected.
+func TestParseRules(t *testing.T) {
+	t.Parallel()
+
+	const rulesFile = `{
+		"default": {
+			"base_fee_msat": 1000,
+			"fee_rate_ppm": 100,
+			"max_fee_rate_ppm": 1000,
+			"balance": {
+				"empty_multiplier": 4,
+				"full_multiplier": 0.5
+			}
+		},
+		"channels": [{
+			"chan_id": 1234,
+			"fee_rate_ppm": 50,
+			"forwarding": {
+				"window": "1h",
+				"min_volume_sat": 100000,
+				"multiplier": 2
+			},
+			"schedules": [{
+				"start": "22:00",
+				"end": "06:30",
+				"multiplier": 0.5
+			}]
+		}]
+	}`
+
+	rules, err := ParseRules(strings.NewReader(rulesFile))
+	if err != nil {
+		t.Fatalf("unable to parse rules: %v", err)
+	}
+
+	if rules.Default == nil || rules.Default.BaseFee != 1000 ||
+		rules.Default.FeeRate != 100 ||
+		rules.Default.MaxFeeRate != 1000 ||
+		*rules.Default.Balance != (BalanceRule{4, 0.5}) {
+
+		t.Fatalf("unexpected default rule: %+v", rules.Default)
+	}
+
+	rule := rules.ruleOf(lnwire.NewShortChanIDFromInt(1234))
+	if rule == nil || rule.FeeRate != 50 {
+		t.Fatalf("unexpected channel rule: %+v", rule)
+	}
+	expectedForwarding := ForwardingRule{
+		Window:     time.Hour,
+		MinVolume:  100000,
+		Multiplier: 2,
+	}
+	if *rule.Forwarding != expectedForwarding {
+		t.Fatalf("expected forwarding rule %+v, got %+v",
+			expectedForwarding, *rule.Forwarding)
+	}
+	expectedSchedule := Schedule{
+		Start:      22 * time.Hour,
+		End:        6*time.Hour + 30*time.Minute,
+		Multiplier: 0.5,
+	}
+	if len(rule.Schedules) != 1 || rule.Schedules[0] != expectedSchedule {
+		t.Fatalf("expected schedule %+v, got %+v", expectedSchedule,
+			rule.Schedules)
+	}
+
+	if rules.ruleOf(lnwire.NewShortChanIDFromInt(1)) != rules.Default {
+		t.Fatalf("expected default rule for channel without rule")
+	}
+
+	invalidRules := []string{
+		`{"default": {"unknown": 1}}`,
+		`{"channels": [{"fee_rate_ppm": 1}]}`,
+		`{"channels": [{"chan_id": 1}, {"chan_id": 1}]}`,
+		`{"default": {"min_fee_rate_ppm": 10, "max_fee_rate_ppm": 5}}`,
+		`{"default": {"balance": {"empty_multiplier": 0,
+			"full_multiplier": 1}}}`,
+		`{"default": {"forwarding": {"window": "0s",
+			"multiplier": 2}}}`,
+		`{"default": {"schedules": [{"start": "24:00", "end": "01:00",
+			"multiplier": 2}]}}`,
+		`{"default": {"schedules": [{"start": "01:00", "end": "01:00",
+			"multiplier": 2}]}}`,
+	}
+	for _, invalid := range invalidRules {
+		_, err := ParseRules(strings.NewReader(invalid))
+		if err == nil {
+			t.Fatalf("expected rules %v to be rejected", invalid)
+		}
+	}
+}
+
+// TestWriteRules asserts that rules that are written are read back unchanged,
+// both directly and through a rules file.
+func TestWriteRules(t *testing.T) {
+	t.Parallel()
+
+	rules := &Rules{
+		Default: &Rule{
+			BaseFee:    1000,
+			FeeRate:    100,
+			MaxFeeRate: 1000,
+			Balance: &BalanceRule{
+				EmptyMultiplier: 4,
+				FullMultiplier:  0.5,
+			},
+		},
+		Channels: map[lnwire.ShortChannelID]*Rule{
+			lnwire.NewShortChanIDFromInt(1234): {
+				FeeRate:    50,
+				MinFeeRate: 10,
+				Forwarding: &ForwardingRule{
+					Window:     90 * time.Minute,
+					MinVolume:  100000,
+					Multiplier: 2,
+				},
+				Schedules: []Schedule{{
+					Start:      22 * time.Hour,
+					End:        6 * time.Hour,
+					Multiplier: 0.5,
+				}},
+			},
+			lnwire.NewShortChanIDFromInt(5678): {
+				FeeRate: 200,
+			},
+		},
+	}
+
+	var b bytes.Buffer
+	if err := WriteRules(&b, rules); err != nil {
+		t.Fatalf("unable to write rules: %v", err)
+	}
+	parsed, err := ParseRules(&b)
+	if err != nil {
+		t.Fatalf("unable to parse written rules: %v", err)
+	}
+	if !reflect.DeepEqual(parsed, rules) {
+		t.Fatalf("expected rules %+v, got %+v", rules, parsed)
+	}
+
+	tempDir, err := ioutil.TempDir("", "feepolicy")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	path := filepath.Join(tempDir, "rules.json")
+	if err := SaveRules(path, rules); err != nil {
+		t.Fatalf("unable to save rules: %v", err)
+	}
+	loaded, err := LoadRules(path)
+	if err != nil {
+		t.Fatalf("unable to load saved rules: %v", err)
+	}
+	if !reflect.DeepEqual(loaded, rules) {
+		t.Fatalf("expected rules %+v, got %+v", rules, loaded)
+	}
+
+	// Only the rules file itself may be left behind.
+	files, err := ioutil.ReadDir(tempDir)
+	if err != nil {
+		t.Fatalf("unable to read temp dir: %v", err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected only the rules file, got %v files",
+			len(files))
+	}
+}
+
+// TestRuleFeeRate asserts that the multipliers of a rule are applied and that
+// the resulting fee rate is clamped to the range of the rule.
+func TestRuleFeeRate(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rule := &Rule{
+		FeeRate:    100,
+		MinFeeRate: 10,
+		MaxFeeRate: 500,
+		Balance: &BalanceRule{
+			EmptyMultiplier: 3,
+			FullMultiplier:  1,
+		},
+		Forwarding: &ForwardingRule{
+			Window:     time.Hour,
+			MinVolume:  1000,
+			Multiplier: 2,
+		},
+		Schedules: []Schedule{{
+			Start:      22 * time.Hour,
+			End:        2 * time.Hour,
+			Multiplier: 0.5,
+		}},
+	}
+
+	tests := []struct {
+		name       string
+		localRatio float64
+		volume     btcutil.Amount
+		now        time.Time
+		feeRate    uint32
+	}{
+		{
+			name:       "full local balance",
+			localRatio: 1,
+			now:        day.Add(12 * time.Hour),
+			feeRate:    100,
+		},
+		{
+			name:       "half local balance",
+			localRatio: 0.5,
+			now:        day.Add(12 * time.Hour),
+			feeRate:    200,
+		},
+		{
+			name:       "forwarding threshold reached",
+			localRatio: 0.5,
+			volume:     1000,
+			now:        day.Add(12 * time.Hour),
+			feeRate:    400,
+		},
+		{
+			name:       "clamped to max fee rate",
+			localRatio: 0,
+			volume:     1000,
+			now:        day.Add(12 * time.Hour),
+			feeRate:    500,
+		},
+		{
+			name:       "schedule before midnight",
+			localRatio: 1,
+			now:        day.Add(23 * time.Hour),
+			feeRate:    50,
+		},
+		{
+			name:       "schedule after midnight",
+			localRatio: 1,
+			now:        day.Add(time.Hour),
+			feeRate:    50,
+		},
+		{
+			name:       "schedule ended",
+			localRatio: 1,
+			now:        day.Add(2 * time.Hour),
+			feeRate:    100,
+		},
+	}
+
+	for _, test := range tests {
+		feeRate := rule.feeRate(test.localRatio, test.volume, test.now)
+		if feeRate != test.feeRate {
+			t.Fatalf("%v: expected fee rate %v, got %v", test.name,
+				test.feeRate, feeRate)
+		}
+	}
+
+	// The minimum fee rate must be enforced as well.
+	rule.Schedules[0].Multiplier = 0.01
+	feeRate := rule.feeRate(1, 0, day.Add(23*time.Hour))
+	if feeRate != rule.MinFeeRate {
+		t.Fatalf("expected fee rate %v, got %v", rule.MinFeeRate,
+			feeRate)
+	}
+}
@@ -2,7 +2,9 @@ package lnd
 
 import (
 	"bytes"
+	"crypto/rand"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"sync"
 	"time"
@@ -10,6 +12,7 @@ import (
 	"github.com/BTCGPU/lnd/chainntnfs"
 	"github.com/BTCGPU/lnd/chanacceptor"
 	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/discovery"
 	"github.com/BTCGPU/lnd/htlcswitch"
 	"github.com/BTCGPU/lnd/input"
@@ -21,9 +24,9 @@ import (
 	"github.com/BTCGPU/lnd/routing"
 	"github.com/btgsuite/btgd/btcec"
 	"github.com/btgsuite/btgd/chaincfg/chainhash"
+	"github.com/btgsuite/btgd/txscript"
 	"github.com/btgsuite/btgd/wire"
 	btcutil "github.com/btgsuite/btgutil"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
 	"golang.org/x/crypto/salsa20"
@@ -52,6 +55,17 @@ const (
 	// channels that aren't initiated by us. 2016 blocks is ~2 weeks.
 	maxWaitNumBlocksFundingConf = 2016
 
+	// minZeroConfAliasHeight and maxZeroConfAliasHeight bound the block
+	// height of the aliases of zero-conf channels. The range lies far
+	// beyond the chain tip, but within the 3 bytes that encode the block
+	// height of a short channel ID.
+	minZeroConfAliasHeight = 16000000
+	maxZeroConfAliasHeight = 16777215
+
+	// maxZeroConfAliasAttempts is the number of random aliases that we
+	// try before giving up on finding one that isn't in use yet.
+	maxZeroConfAliasAttempts = 10
+
 	// minChanFundingSize is the smallest channel that we'll allow to be
 	// created over the RPC interface.
 	minChanFundingSize = btcutil.Amount(20000)
@@ -67,6 +81,17 @@ const (
 	// currently accepted on the Litecoin chain within the Lightning
 	// Protocol.
 	maxLtcFundingAmount = MaxBtcFundingAmount * btcToLtcConversionRate
+
+	// MaxBtcFundingAmountWumbo is the default maximum channel size on the
+	// Bitcoin chain if large channels are enabled. Channels above
+	// MaxBtcFundingAmount are only opened with peers that signal support
+	// for them as well.
+	MaxBtcFundingAmountWumbo = btcutil.Amount(1000000000)
+
+	// maxLtcFundingAmountWumbo is the default maximum channel size on the
+	// Litecoin chain if large channels are enabled.
+	maxLtcFundingAmountWumbo = MaxBtcFundingAmountWumbo *
+		btcToLtcConversionRate
 )
 
 var (
@@ -81,6 +106,11 @@ var (
 	// TODO(roasbeef): add command line param to modify
 	MaxFundingAmount = MaxBtcFundingAmount
 
+	// MaxFundingAmountWumbo is the default maximum channel size if large
+	// channels are enabled. Like MaxFundingAmount, it depends on which
+	// chain is active.
+	MaxFundingAmountWumbo = MaxBtcFundingAmountWumbo
+
 	// ErrFundingManagerShuttingDown is an error returned when attempting to
 	// process a funding request/message but the funding manager has already
 	// been signaled to shut down.
@@ -92,6 +122,17 @@ var (
 	// blocks pass without confirmation.
 	ErrConfirmationTimeout = errors.New("timeout waiting for funding " +
 		"confirmation")
+
+	// ErrBatchCancelled is returned to the callers of all channels in a
+	// funding batch once the funding flow of one of them fails, as the
+	// shared funding transaction can no longer be published.
+	ErrBatchCancelled = errors.New("funding batch cancelled")
+
+	// ErrBatchFeeRateMismatch is returned when the channels of a funding
+	// batch ask for different fee rates, as they share a single funding
+	// transaction.
+	ErrBatchFeeRateMismatch = errors.New("all channels of a funding " +
+		"batch must use the same fee rate")
 )
 
 // reservationWithCtx encapsulates a pending channel reservation. This wrapper
@@ -118,6 +159,50 @@ type reservationWithCtx struct {
 
 	updates chan *lnrpc.OpenStatusUpdate
 	err     chan error
+
+	// batch is set if the channel is funded by a funding transaction that
+	// is shared with other channels.
+	batch *fundingBatch
+
+	// zeroConf is true if we initiated the channel and want to use it
+	// before its funding transaction confirms, given that the responder
+	// allows it.
+	zeroConf bool
+}
+
+// fundingBatch tracks a set of channels that are funded by a single, shared
+// funding transaction. The funding transaction is only published once every
+// peer in the batch has sent its signature for our commitment transaction. If
+// the funding flow of any channel in the batch fails, all of them are
+// cancelled.
+//
+// NOTE: All fields are protected by the funding manager's resMtx.
+type fundingBatch struct {
+	// numChannels is the number of channels in the batch.
+	numChannels int
+
+	// feeRate is the fee rate used for the shared funding transaction.
+	feeRate lnwallet.SatPerKWeight
+
+	// channels holds the reservation of every channel in the batch,
+	// indexed by its pending channel ID.
+	channels map[[32]byte]*reservationWithCtx
+
+	// accepted is the set of channels that were accepted by the remote
+	// peer.
+	accepted map[[32]byte]struct{}
+
+	// commitSigs holds the remote peer's signature for our commitment
+	// transaction of every channel whose FundingSigned message has been
+	// received.
+	commitSigs map[[32]byte]lnwire.Sig
+
+	// fundingTx is the shared funding transaction. It is created once all
+	// peers have accepted their channel.
+	fundingTx *wire.MsgTx
+
+	// cancelled is set once the batch has been cancelled.
+	cancelled bool
 }
 
 // isLocked checks the reservation's timestamp to determine whether it is locked.
@@ -145,6 +230,18 @@ func (r *reservationWithCtx) updateTimestamp() {
 type initFundingMsg struct {
 	peer lnpeer.Peer
 	*openChanReq
+
+	// batch is set if the channel is opened as part of a batch of channels
+	// that share a single funding transaction.
+	batch *fundingBatch
+}
+
+// initBatchFundingMsg is sent by an outside subsystem to the funding manager
+// in order to open a set of channels that are funded by a single funding
+// transaction. Each request is opened with the peer at the same index.
+type initBatchFundingMsg struct {
+	peers []lnpeer.Peer
+	reqs  []*openChanReq
 }
 
 // fundingOpenMsg couples an lnwire.OpenChannel message with the peer who sent
@@ -179,6 +276,15 @@ type fundingSignedMsg struct {
 	peer lnpeer.Peer
 }
 
+// fundingPsbtMsg carries the finalized PSBT of the funding transaction of a
+// pending channel that is funded through a PSBT. This allows the funding
+// manager to continue the funding workflow with the remote peer.
+type fundingPsbtMsg struct {
+	pendingChanID [32]byte
+	packet        []byte
+	err           chan error
+}
+
 // fundingLockedMsg couples an lnwire.FundingLocked message with the peer who
 // sent the message. This allows the funding manager to finalize the funding
 // process and announce the existence of the new channel.
@@ -292,6 +398,11 @@ type fundingConfig struct {
 	// contract breach.
 	RequiredRemoteDelay func(btcutil.Amount) uint16
 
+	// MaxRemoteDelay is the largest CSV delay that we require for the
+	// remote party. A channel acceptor can't override the CSV delay of a
+	// channel beyond it.
+	MaxRemoteDelay uint16
+
 	// RequiredRemoteChanReserve is a function closure that, given the
 	// channel capacity and dust limit, will return an appropriate amount
 	// for the remote peer's required channel reserve that is to be adhered
@@ -338,10 +449,22 @@ type fundingConfig struct {
 	// allow for each peer.
 	MaxPendingChannels int
 
+	// MaxChanSize is the largest channel size that we'll open or accept.
+	// Channels above MaxFundingAmount are only allowed with peers that
+	// signal support for large channels, as do we.
+	MaxChanSize btcutil.Amount
+
 	// RejectPush is set true if the fundingmanager should reject any
 	// incoming channels having a non-zero push amount.
 	RejectPush bool
 
+	// DefaultUpfrontShutdown is the script that the funds of our side of a
+	// channel are paid to on cooperative close, if no other script was
+	// requested when opening it. We only commit to it with peers that
+	// support upfront shutdown scripts. If empty, we don't commit to any
+	// script by default.
+	DefaultUpfrontShutdown lnwire.DeliveryAddress
+
 	// NotifyOpenChannelEvent informs the ChannelNotifier when channels
 	// transition from pending open to open.
 	NotifyOpenChannelEvent func(wire.OutPoint)
@@ -350,6 +473,17 @@ type fundingConfig struct {
 	// and on the requesting node's public key that returns a bool which tells
 	// the funding manager whether or not to accept the channel.
 	OpenChannelPredicate chanacceptor.ChannelAcceptor
+
+	// ZeroConfPeers is the set of peers that are trusted not to double
+	// spend the funding transactions of the channels they open with us.
+	// Channels with these peers are used before their funding transaction
+	// confirms.
+	ZeroConfPeers map[[33]byte]struct{}
+
+	// ShortChanIDInUse returns true if a link of the switch can already be
+	// reached through the given short channel ID, which rules it out as
+	// the alias of a new zero-conf channel.
+	ShortChanIDInUse func(lnwire.ShortChannelID) bool
 }
 
 // fundingManager acts as an orchestrator/bridge between the wallet's
@@ -637,6 +771,15 @@ func (f *fundingManager) CancelPeerReservations(nodePub [33]byte) {
 
 	fndgLog.Debugf("Cancelling all reservations for peer %x", nodePub[:])
 
+	// Any batch that one of the reservations is part of is cancelled
+	// along with it, once the resMtx has been released.
+	var batches []*fundingBatch
+	defer func() {
+		for _, batch := range batches {
+			f.cancelBatch(batch)
+		}
+	}()
+
 	f.resMtx.Lock()
 	defer f.resMtx.Unlock()
 
@@ -660,6 +803,10 @@ func (f *fundingManager) CancelPeerReservations(nodePub [33]byte) {
 
 		resCtx.err <- fmt.Errorf("peer disconnected")
 		delete(nodeReservations, pendingID)
+
+		if resCtx.batch != nil {
+			batches = append(batches, resCtx.batch)
+		}
 	}
 
 	// Finally, we'll delete the node itself from the set of reservations.
@@ -742,6 +889,10 @@ func (f *fundingManager) reservationCoordinator() {
 				f.handleFundingCreated(fmsg)
 			case *fundingSignedMsg:
 				f.handleFundingSigned(fmsg)
+			case *fundingPsbtMsg:
+				f.handleFundingPsbt(fmsg)
+			case *initBatchFundingMsg:
+				f.handleInitBatchFundingMsg(fmsg)
 			case *fundingLockedMsg:
 				f.wg.Add(1)
 				go f.handleFundingLocked(fmsg)
@@ -778,9 +929,17 @@ func (f *fundingManager) advanceFundingState(channel *channeldb.OpenChannel,
 	defer f.wg.Done()
 
 	// If the channel is still pending we must wait for the funding
-	// transaction to confirm.
+	// transaction to confirm, unless it is a zero-conf channel. Those are
+	// marked open under their alias right away.
 	if channel.IsPending {
-		err := f.advancePendingChannelState(channel, pendingChanID)
+		var err error
+		if channel.IsZeroConf() {
+			err = f.markChannelOpen(channel, channel.ZeroConfAlias)
+		} else {
+			err = f.advancePendingChannelState(
+				channel, pendingChanID,
+			)
+		}
 		if err != nil {
 			fndgLog.Errorf("Unable to advance pending state of "+
 				"ChannelPoint(%v): %v",
@@ -880,6 +1039,14 @@ func (f *fundingManager) stateStep(channel *channeldb.OpenChannel,
 	// fundingLocked was sent to peer, but the channel was not added to the
 	// router graph and the channel announcement was not sent.
 	case fundingLockedSent:
+		// A zero-conf channel is used under its alias until its funding
+		// transaction confirms. Only then it is added to the router
+		// graph, under its real short channel ID.
+		alias := channel.ZeroConfAlias
+		if channel.IsZeroConf() && *shortChanID == alias {
+			return f.handleZeroConfConfirmation(channel)
+		}
+
 		err := f.addToRouterGraph(channel, shortChanID)
 		if err != nil {
 			return fmt.Errorf("failed adding to "+
@@ -970,23 +1137,9 @@ func (f *fundingManager) advancePendingChannelState(
 		// since the channel was initiated reaches
 		// maxWaitNumBlocksFundingConf and we are not the
 		// channel initiator.
-		ch := channel
-		localBalance := ch.LocalCommitment.LocalBalance.ToSatoshis()
-		closeInfo := &channeldb.ChannelCloseSummary{
-			ChainHash:               ch.ChainHash,
-			ChanPoint:               ch.FundingOutpoint,
-			RemotePub:               ch.IdentityPub,
-			Capacity:                ch.Capacity,
-			SettledBalance:          localBalance,
-			CloseType:               channeldb.FundingCanceled,
-			RemoteCurrentRevocation: ch.RemoteCurrentRevocation,
-			RemoteNextRevocation:    ch.RemoteNextRevocation,
-			LocalChanConfig:         ch.LocalChanCfg,
-		}
-
-		if err := ch.CloseChannel(closeInfo); err != nil {
+		if err := cancelPendingChannel(channel); err != nil {
 			return fmt.Errorf("failed closing channel "+
-				"%v: %v", ch.FundingOutpoint, err)
+				"%v: %v", channel.FundingOutpoint, err)
 		}
 
 		timeoutErr := fmt.Errorf("timeout waiting for funding tx "+
@@ -1000,7 +1153,7 @@ func (f *fundingManager) advancePendingChannelState(
 
 			peerChan := make(chan lnpeer.Peer, 1)
 			var peerKey [33]byte
-			copy(peerKey[:], ch.IdentityPub.SerializeCompressed())
+			copy(peerKey[:], channel.IdentityPub.SerializeCompressed())
 
 			f.cfg.NotifyWhenOnline(peerKey, peerChan)
 
@@ -1037,6 +1190,25 @@ func (f *fundingManager) advancePendingChannelState(
 	return nil
 }
 
+// cancelPendingChannel marks a pending channel whose funding transaction will
+// never confirm as closed, which removes it from the set of pending channels.
+func cancelPendingChannel(ch *channeldb.OpenChannel) error {
+	localBalance := ch.LocalCommitment.LocalBalance.ToSatoshis()
+	closeInfo := &channeldb.ChannelCloseSummary{
+		ChainHash:               ch.ChainHash,
+		ChanPoint:               ch.FundingOutpoint,
+		RemotePub:               ch.IdentityPub,
+		Capacity:                ch.Capacity,
+		SettledBalance:          localBalance,
+		CloseType:               channeldb.FundingCanceled,
+		RemoteCurrentRevocation: ch.RemoteCurrentRevocation,
+		RemoteNextRevocation:    ch.RemoteNextRevocation,
+		LocalChanConfig:         ch.LocalChanCfg,
+	}
+
+	return ch.CloseChannel(closeInfo)
+}
+
 // handlePendingChannels responds to a request for details concerning all
 // currently pending channels waiting for the final phase of the funding
 // workflow (funding txn confirmation).
@@ -1139,8 +1311,9 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	}
 
 	// We'll reject any request to create a channel that's above the
-	// current soft-limit for channel size.
-	if msg.FundingAmount > MaxFundingAmount {
+	// current soft-limit for channel size, unless both sides signal
+	// support for large channels. In that case our own maximum applies.
+	if msg.FundingAmount > f.maxChanSize(fmsg.peer) {
 		f.failFundingFlow(
 			fmsg.peer, fmsg.msg.PendingChannelID,
 			lnwire.ErrChanTooLarge,
@@ -1168,6 +1341,15 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// If the initiator committed to an upfront shutdown script, it must be
+	// one of the standard script types that a closing transaction can pay
+	// out to.
+	err = validateUpfrontShutdown(msg.UpfrontShutdownScript)
+	if err != nil {
+		f.failFundingFlow(fmsg.peer, fmsg.msg.PendingChannelID, err)
+		return
+	}
+
 	// Send the OpenChannel request to the ChannelAcceptor to determine whether
 	// this node will accept the channel.
 	chanReq := &chanacceptor.ChannelAcceptRequest{
@@ -1175,14 +1357,39 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		OpenChanMsg: fmsg.msg,
 	}
 
-	if !f.cfg.OpenChannelPredicate.Accept(chanReq) {
+	acceptResp := f.cfg.OpenChannelPredicate.Accept(chanReq)
+	if !acceptResp.Accept {
+		// If the channel acceptor gave a reason for the rejection, we
+		// pass it on to the initiator.
+		rejectErr := fmt.Errorf("open channel request rejected")
+		if acceptResp.Error != "" {
+			rejectErr = lnwallet.ErrChanRejected(acceptResp.Error)
+		}
+		f.failFundingFlow(
+			fmsg.peer, fmsg.msg.PendingChannelID, rejectErr,
+		)
+		return
+	}
+
+	// The channel acceptor may have overridden the parameters that we
+	// require from the initiator, which must still be sound.
+	err = f.validateAcceptorParams(acceptResp, msg)
+	if err != nil {
+		fndgLog.Errorf("Invalid channel acceptor parameters for "+
+			"pendingId=%x: %v", msg.PendingChannelID, err)
 		f.failFundingFlow(
 			fmsg.peer, fmsg.msg.PendingChannelID,
-			fmt.Errorf("open channel request rejected"),
+			lnwallet.ErrChanRejected(fmt.Sprintf("invalid "+
+				"channel parameters: %v", err)),
 		)
 		return
 	}
 
+	// The channel is used before its funding transaction confirms if the
+	// initiator is trusted not to double spend it, either by our
+	// configuration or by the channel acceptor.
+	zeroConf := acceptResp.ZeroConf || f.isZeroConfPeer(fmsg.peer)
+
 	fndgLog.Infof("Recv'd fundingRequest(amt=%v, push=%v, delay=%v, "+
 		"pendingId=%x) from peer(%x)", amt, msg.PushAmount,
 		msg.CsvDelay, msg.PendingChannelID,
@@ -1205,6 +1412,13 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		lnwire.StaticRemoteKeyOptional,
 	)
 	tweaklessCommitment := localTweakless && remoteTweakless
+
+	// In the same manner, we'll use anchor commitments if both sides
+	// signal support for them.
+	anchorCommitment := tweaklessCommitment && hasAnchorFeatures(
+		fmsg.peer.LocalGlobalFeatures(),
+		fmsg.peer.RemoteGlobalFeatures(),
+	)
 	chainHash := chainhash.Hash(msg.ChainHash)
 	req := &lnwallet.InitFundingReserveMsg{
 		ChainHash:        &chainHash,
@@ -1218,6 +1432,7 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		Flags:            msg.ChannelFlags,
 		MinConfs:         1,
 		Tweakless:        tweaklessCommitment,
+		Anchors:          anchorCommitment,
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -1227,12 +1442,36 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// We'll commit to our default upfront shutdown script, as long as the
+	// initiator supports upfront shutdown scripts.
+	shutdown, err := f.upfrontShutdownScript(fmsg.peer, nil)
+	if err != nil {
+		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		return
+	}
+	reservation.SetOurUpfrontShutdown(shutdown)
+
 	// As we're the responder, we get to specify the number of confirmations
 	// that we require before both of us consider the channel open. We'll
 	// use our mapping to derive the proper number of confirmations based on
 	// the amount of the channel, and also if any funds are being pushed to
 	// us.
 	numConfsReq := f.cfg.NumRequiredConfs(msg.FundingAmount, msg.PushAmount)
+	if acceptResp.MinAcceptDepth != 0 {
+		numConfsReq = acceptResp.MinAcceptDepth
+	}
+
+	// A zero-conf channel doesn't require any confirmations. Until its
+	// funding transaction confirms, it is identified by a random alias.
+	if zeroConf {
+		alias, err := f.newZeroConfAlias()
+		if err != nil {
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+			return
+		}
+		reservation.SetZeroConfAlias(alias)
+		numConfsReq = 0
+	}
 	reservation.SetNumConfsRequired(numConfsReq)
 
 	// We'll also validate and apply all the constraints the initiating
@@ -1257,12 +1496,25 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		fmsg.msg.PendingChannelID, amt, msg.PushAmount,
 		tweaklessCommitment)
 
-	// Generate our required constraints for the remote party.
+	// Generate our required constraints for the remote party, unless the
+	// channel acceptor overrode them for this channel.
 	remoteCsvDelay := f.cfg.RequiredRemoteDelay(amt)
+	if acceptResp.CSVDelay != 0 {
+		remoteCsvDelay = acceptResp.CSVDelay
+	}
 	chanReserve := f.cfg.RequiredRemoteChanReserve(amt, msg.DustLimit)
+	if acceptResp.Reserve != 0 {
+		chanReserve = acceptResp.Reserve
+	}
 	maxValue := f.cfg.RequiredRemoteMaxValue(amt)
 	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(amt)
+	if acceptResp.MaxHTLCs != 0 {
+		maxHtlcs = acceptResp.MaxHTLCs
+	}
 	minHtlc := f.cfg.DefaultRoutingPolicy.MinHTLC
+	if acceptResp.MinHTLC != 0 {
+		minHtlc = acceptResp.MinHTLC
+	}
 
 	// Once the reservation has been created successfully, we add it to
 	// this peer's map of pending reservations to track this particular
@@ -1290,6 +1542,7 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	remoteContribution := &lnwallet.ChannelContribution{
 		FundingAmount:        amt,
 		FirstCommitmentPoint: msg.FirstCommitmentPoint,
+		UpfrontShutdown:      msg.UpfrontShutdownScript,
 		ChannelConfig: &channeldb.ChannelConfig{
 			ChannelConstraints: channeldb.ChannelConstraints{
 				DustLimit:        msg.DustLimit,
@@ -1332,20 +1585,21 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	// contribution in the next message of the workflow.
 	ourContribution := reservation.OurContribution()
 	fundingAccept := lnwire.AcceptChannel{
-		PendingChannelID:     msg.PendingChannelID,
-		DustLimit:            ourContribution.DustLimit,
-		MaxValueInFlight:     maxValue,
-		ChannelReserve:       chanReserve,
-		MinAcceptDepth:       uint32(numConfsReq),
-		HtlcMinimum:          minHtlc,
-		CsvDelay:             remoteCsvDelay,
-		MaxAcceptedHTLCs:     maxHtlcs,
-		FundingKey:           ourContribution.MultiSigKey.PubKey,
-		RevocationPoint:      ourContribution.RevocationBasePoint.PubKey,
-		PaymentPoint:         ourContribution.PaymentBasePoint.PubKey,
-		DelayedPaymentPoint:  ourContribution.DelayBasePoint.PubKey,
-		HtlcPoint:            ourContribution.HtlcBasePoint.PubKey,
-		FirstCommitmentPoint: ourContribution.FirstCommitmentPoint,
+		PendingChannelID:      msg.PendingChannelID,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      maxValue,
+		ChannelReserve:        chanReserve,
+		MinAcceptDepth:        uint32(numConfsReq),
+		HtlcMinimum:           minHtlc,
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourContribution.RevocationBasePoint.PubKey,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		UpfrontShutdownScript: ourContribution.UpfrontShutdown,
 	}
 	if err := fmsg.peer.SendMessage(false, &fundingAccept); err != nil {
 		fndgLog.Errorf("unable to send funding response to peer: %v", err)
@@ -1398,10 +1652,37 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 		return
 	}
 
+	// A responder that doesn't require any confirmations trusts us not to
+	// double spend the funding transaction, and uses the channel right
+	// away. Both of us must agree on that, so we fail the flow unless we
+	// asked for a zero-conf channel. If we did, but the responder requires
+	// confirmations, the channel is opened like any other.
+	numConfsReq := uint16(msg.MinAcceptDepth)
+	switch {
+	case numConfsReq == 0 && !resCtx.zeroConf:
+		err := lnwallet.ErrZeroConfNotRequested()
+		fndgLog.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		return
+
+	case numConfsReq == 0:
+		alias, err := f.newZeroConfAlias()
+		if err != nil {
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+			return
+		}
+		resCtx.reservation.SetZeroConfAlias(alias)
+
+	case resCtx.zeroConf:
+		fndgLog.Infof("Peer requires %v confirmations for "+
+			"pendingID(%x), not using it as a zero-conf channel",
+			numConfsReq, pendingChanID[:])
+	}
+
 	// We'll also specify the responder's preference for the number of
 	// required confirmations, and also the set of channel constraints
 	// they've specified for commitment states we can create.
-	resCtx.reservation.SetNumConfsRequired(uint16(msg.MinAcceptDepth))
+	resCtx.reservation.SetNumConfsRequired(numConfsReq)
 	channelConstraints := &channeldb.ChannelConstraints{
 		DustLimit:        msg.DustLimit,
 		ChanReserve:      msg.ChannelReserve,
@@ -1417,6 +1698,15 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 		return
 	}
 
+	// If the responder committed to an upfront shutdown script, it must be
+	// one of the standard script types that a closing transaction can pay
+	// out to.
+	err = validateUpfrontShutdown(msg.UpfrontShutdownScript)
+	if err != nil {
+		f.failFundingFlow(fmsg.peer, fmsg.msg.PendingChannelID, err)
+		return
+	}
+
 	// As they've accepted our channel constraints, we'll regenerate them
 	// here so we can properly commit their accepted constraints to the
 	// reservation.
@@ -1430,6 +1720,7 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 	// the funding transaction.
 	remoteContribution := &lnwallet.ChannelContribution{
 		FirstCommitmentPoint: msg.FirstCommitmentPoint,
+		UpfrontShutdown:      msg.UpfrontShutdownScript,
 		ChannelConfig: &channeldb.ChannelConfig{
 			ChannelConstraints: channeldb.ChannelConstraints{
 				DustLimit:        msg.DustLimit,
@@ -1469,6 +1760,160 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 	fndgLog.Debugf("Remote party accepted commitment constraints: %v",
 		spew.Sdump(remoteContribution.ChannelConfig.ChannelConstraints))
 
+	// If the channel is part of a batch, the shared funding transaction
+	// can only be created once every peer in the batch has accepted its
+	// channel.
+	if resCtx.batch != nil {
+		f.handleBatchAccept(resCtx.batch, pendingChanID)
+		return
+	}
+
+	// If the funding transaction is assembled outside of the wallet, we
+	// now know the funding output it must create. We'll hand it to the
+	// caller, and continue the workflow once the finalized PSBT is passed
+	// back to us.
+	if resCtx.reservation.IsExternallyFunded() {
+		err := f.sendPsbtFundingTemplate(resCtx, pendingChanID)
+		if err != nil {
+			fndgLog.Errorf("Unable to send psbt funding template "+
+				"for pendingID(%x): %v", pendingChanID[:], err)
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		}
+		return
+	}
+
+	f.sendFundingCreated(resCtx, pendingChanID)
+}
+
+// sendPsbtFundingTemplate sends the funding output of a reservation that is
+// funded through a PSBT to the caller that initiated the funding workflow.
+func (f *fundingManager) sendPsbtFundingTemplate(resCtx *reservationWithCtx,
+	pendingChanID [32]byte) error {
+
+	fundingOutput, err := resCtx.reservation.FundingOutput()
+	if err != nil {
+		return err
+	}
+
+	// The funding output is a P2WSH output, so the witness program starts
+	// after the version byte and the data push.
+	fundingAddr, err := btcutil.NewAddressWitnessScriptHash(
+		fundingOutput.PkScript[2:], &f.cfg.Wallet.Cfg.NetParams,
+	)
+	if err != nil {
+		return err
+	}
+
+	fndgLog.Infof("Waiting for psbt funding of pendingID(%x) paying %v "+
+		"to %v", pendingChanID[:], btcutil.Amount(fundingOutput.Value),
+		fundingAddr)
+
+	upd := &lnrpc.OpenStatusUpdate{
+		Update: &lnrpc.OpenStatusUpdate_PsbtFund{
+			PsbtFund: &lnrpc.ReadyForPsbtFunding{
+				PendingChanId:  pendingChanID[:],
+				FundingAddress: fundingAddr.EncodeAddress(),
+				FundingAmount:  fundingOutput.Value,
+			},
+		},
+	}
+
+	select {
+	case resCtx.updates <- upd:
+		return nil
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+}
+
+// VerifyPsbt hands the finalized PSBT of the funding transaction to the
+// pending channel with the given pending channel ID, which must have been
+// opened with PSBT funding. If the PSBT creates the expected funding output,
+// the funding workflow with the remote peer is continued. The funding
+// transaction is only published once the remote peer's signature for our
+// commitment transaction has been stored.
+func (f *fundingManager) VerifyPsbt(pendingChanID [32]byte,
+	packet []byte) error {
+
+	errChan := make(chan error, 1)
+	msg := &fundingPsbtMsg{
+		pendingChanID: pendingChanID,
+		packet:        packet,
+		err:           errChan,
+	}
+
+	select {
+	case f.fundingMsgs <- msg:
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+}
+
+// handleFundingPsbt processes the finalized PSBT of a pending channel that is
+// funded through a PSBT. If the PSBT is accepted by the reservation, the
+// funding workflow continues by sending the funding outpoint and our
+// commitment signature to the remote peer.
+func (f *fundingManager) handleFundingPsbt(fmsg *fundingPsbtMsg) {
+	pendingChanID := fmsg.pendingChanID
+
+	// The caller only knows the pending channel ID, so we'll look through
+	// the reservations of all peers.
+	var resCtx *reservationWithCtx
+	f.resMtx.RLock()
+	for _, pendingReservations := range f.activeReservations {
+		if ctx, ok := pendingReservations[pendingChanID]; ok {
+			resCtx = ctx
+			break
+		}
+	}
+	f.resMtx.RUnlock()
+
+	if resCtx == nil {
+		fmsg.err <- fmt.Errorf("unknown pending channel %x",
+			pendingChanID[:])
+		return
+	}
+	if resCtx.batch != nil || !resCtx.reservation.IsExternallyFunded() {
+		fmsg.err <- fmt.Errorf("pending channel %x is not funded "+
+			"through a psbt", pendingChanID[:])
+		return
+	}
+
+	// A PSBT that is rejected by the reservation doesn't fail the funding
+	// workflow, the caller may still pass a corrected one before the
+	// reservation times out.
+	err := resCtx.reservation.ProcessPsbt(fmsg.packet)
+	if err != nil {
+		fndgLog.Errorf("Unable to process psbt for pendingID(%x): %v",
+			pendingChanID[:], err)
+		fmsg.err <- err
+		return
+	}
+
+	// Update the timestamp once the PSBT has been accepted.
+	defer resCtx.updateTimestamp()
+
+	fndgLog.Infof("Accepted psbt funding for pendingID(%x)",
+		pendingChanID[:])
+
+	f.sendFundingCreated(resCtx, pendingChanID)
+	fmsg.err <- nil
+}
+
+// sendFundingCreated sends the funding outpoint and our signature for the
+// remote peer's version of the commitment transaction to the remote peer. It
+// must only be called once the funding transaction of the reservation is
+// known.
+func (f *fundingManager) sendFundingCreated(resCtx *reservationWithCtx,
+	pendingChanID [32]byte) {
+
 	// Now that we have their contribution, we can extract, then send over
 	// both the funding out point and our signature for their version of
 	// the commitment transaction to the remote peer.
@@ -1496,21 +1941,109 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 	fndgLog.Infof("Generated ChannelPoint(%v) for pendingID(%x)", outPoint,
 		pendingChanID[:])
 
+	commitSig, err := lnwire.NewSigFromRawSignature(sig)
+	if err != nil {
+		fndgLog.Errorf("Unable to parse signature: %v", err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return
+	}
+
 	fundingCreated := &lnwire.FundingCreated{
 		PendingChannelID: pendingChanID,
 		FundingPoint:     *outPoint,
+		CommitSig:        commitSig,
 	}
-	fundingCreated.CommitSig, err = lnwire.NewSigFromRawSignature(sig)
+	if err := resCtx.peer.SendMessage(false, fundingCreated); err != nil {
+		fndgLog.Errorf("Unable to send funding complete message: %v", err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return
+	}
+}
+
+// handleBatchAccept records that the remote peer accepted a channel of the
+// batch. Once every peer in the batch has accepted its channel, the wallet
+// creates and signs the shared funding transaction, which is handed to the
+// reservation of every channel before their FundingCreated messages are sent.
+func (f *fundingManager) handleBatchAccept(batch *fundingBatch,
+	pendingChanID [32]byte) {
+
+	f.resMtx.Lock()
+	batch.accepted[pendingChanID] = struct{}{}
+	ready := !batch.cancelled && len(batch.accepted) == batch.numChannels
+	channels := make(pendingChannels, len(batch.channels))
+	for chanID, resCtx := range batch.channels {
+		channels[chanID] = resCtx
+	}
+	f.resMtx.Unlock()
+
+	if !ready {
+		fndgLog.Debugf("Waiting for remaining channels of batch to be "+
+			"accepted, pendingID(%x) accepted", pendingChanID[:])
+		return
+	}
+
+	// Every peer has accepted, so we now know all the funding outputs the
+	// shared funding transaction must create.
+	outputs := make([]*wire.TxOut, 0, len(channels))
+	for chanID, resCtx := range channels {
+		fundingOutput, err := resCtx.reservation.FundingOutput()
+		if err != nil {
+			f.failFundingFlow(resCtx.peer, chanID, err)
+			return
+		}
+		outputs = append(outputs, fundingOutput)
+	}
+
+	resCtx := channels[pendingChanID]
+	authoredTx, err := f.cfg.Wallet.CreateSimpleTx(
+		outputs, batch.feeRate, false,
+	)
 	if err != nil {
-		fndgLog.Errorf("Unable to parse signature: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		fndgLog.Errorf("Unable to create batch funding tx: %v", err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
 		return
 	}
-	if err := fmsg.peer.SendMessage(false, fundingCreated); err != nil {
-		fndgLog.Errorf("Unable to send funding complete message: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+	fundingTx := authoredTx.Tx
+
+	// The funding transaction isn't published until every peer has signed
+	// our commitment, so we'll lock its inputs in the meantime to make
+	// sure they aren't spent by any other transaction.
+	for _, txIn := range fundingTx.TxIn {
+		f.cfg.Wallet.LockOutpoint(txIn.PreviousOutPoint)
+	}
+
+	// If the batch was cancelled while the transaction was being created,
+	// nobody else will release its inputs, so we'll do it here.
+	f.resMtx.Lock()
+	cancelled := batch.cancelled
+	if !cancelled {
+		batch.fundingTx = fundingTx
+	}
+	f.resMtx.Unlock()
+
+	if cancelled {
+		for _, txIn := range fundingTx.TxIn {
+			f.cfg.Wallet.UnlockOutpoint(txIn.PreviousOutPoint)
+		}
 		return
 	}
+
+	fndgLog.Infof("Created batch funding tx %v for %v channels",
+		fundingTx.TxHash(), len(channels))
+
+	for chanID, resCtx := range channels {
+		err := resCtx.reservation.ProcessFundingTx(fundingTx.Copy())
+		if err != nil {
+			fndgLog.Errorf("Unable to process batch funding tx for "+
+				"pendingID(%x): %v", chanID[:], err)
+			f.failFundingFlow(resCtx.peer, chanID, err)
+			return
+		}
+	}
+
+	for chanID, resCtx := range channels {
+		f.sendFundingCreated(resCtx, chanID)
+	}
 }
 
 // processFundingCreated queues a funding complete message coupled with the
@@ -1704,22 +2237,15 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		return
 	}
 
-	// Create an entry in the local discovery map so we can ensure that we
-	// process the channel confirmation fully before we receive a funding
-	// locked message.
-	fundingPoint := resCtx.reservation.FundingOutpoint()
-	permChanID := lnwire.NewChanIDFromOutPoint(fundingPoint)
-	f.localDiscoveryMtx.Lock()
-	f.localDiscoverySignals[permChanID] = make(chan struct{})
-	f.localDiscoveryMtx.Unlock()
+	// If the channel is part of a batch, we'll hold on to the signature
+	// until every peer in the batch has signed its commitment, as the
+	// shared funding transaction can only be published afterwards.
+	if resCtx.batch != nil {
+		f.handleBatchSigned(resCtx.batch, pendingChanID, fmsg.msg.CommitSig)
+		return
+	}
 
-	// The remote peer has responded with a signature for our commitment
-	// transaction. We'll verify the signature for validity, then commit
-	// the state to disk as we can now open the channel.
-	commitSig := fmsg.msg.CommitSig.ToSignatureBytes()
-	completeChan, err := resCtx.reservation.CompleteReservation(
-		nil, commitSig,
-	)
+	completeChan, err := f.completeReservation(resCtx, fmsg.msg.CommitSig)
 	if err != nil {
 		fndgLog.Errorf("Unable to complete reservation sign "+
 			"complete: %v", err)
@@ -1748,6 +2274,54 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		// delete from the DB?
 	}
 
+	f.watchPendingChannel(resCtx, pendingChanID, completeChan)
+}
+
+// completeReservation verifies the remote peer's signature for our version of
+// the commitment transaction, and commits the channel to disk as pending.
+func (f *fundingManager) completeReservation(resCtx *reservationWithCtx,
+	commitSig lnwire.Sig) (*channeldb.OpenChannel, error) {
+
+	// Create an entry in the local discovery map so we can ensure that we
+	// process the channel confirmation fully before we receive a funding
+	// locked message.
+	fundingPoint := resCtx.reservation.FundingOutpoint()
+	permChanID := lnwire.NewChanIDFromOutPoint(fundingPoint)
+	f.localDiscoveryMtx.Lock()
+	f.localDiscoverySignals[permChanID] = make(chan struct{})
+	f.localDiscoveryMtx.Unlock()
+
+	// The remote peer has responded with a signature for our commitment
+	// transaction. We'll verify the signature for validity, then commit
+	// the state to disk as we can now open the channel.
+	completeChan, err := resCtx.reservation.CompleteReservation(
+		nil, commitSig.ToSignatureBytes(),
+	)
+	if err != nil {
+		f.deleteLocalDiscoverySignal(permChanID)
+		return nil, err
+	}
+
+	return completeChan, nil
+}
+
+// deleteLocalDiscoverySignal removes the local discovery signal of a channel
+// whose funding flow failed, as the channel will never be confirmed.
+func (f *fundingManager) deleteLocalDiscoverySignal(chanID lnwire.ChannelID) {
+	f.localDiscoveryMtx.Lock()
+	delete(f.localDiscoverySignals, chanID)
+	f.localDiscoveryMtx.Unlock()
+}
+
+// watchPendingChannel hands a channel whose funding transaction has been
+// broadcast to the ChainArbitrator, notifies the caller that the channel is
+// pending, and waits for the funding transaction to confirm.
+func (f *fundingManager) watchPendingChannel(resCtx *reservationWithCtx,
+	pendingChanID [32]byte, completeChan *channeldb.OpenChannel) {
+
+	peerKey := resCtx.peer.IdentityKey()
+	fundingPoint := completeChan.FundingOutpoint
+
 	// Now that we have a finalized reservation for this funding flow,
 	// we'll send the to be active channel to the ChainArbitrator so it can
 	// watch for any on-chain actions before the channel has fully
@@ -1786,6 +2360,81 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 	go f.advanceFundingState(completeChan, pendingChanID, resCtx.updates)
 }
 
+// handleBatchSigned records the remote peer's signature for our commitment
+// transaction of a channel in the batch. Once every peer in the batch has sent
+// its signature, all channels are committed to disk and the shared funding
+// transaction is published.
+func (f *fundingManager) handleBatchSigned(batch *fundingBatch,
+	pendingChanID [32]byte, commitSig lnwire.Sig) {
+
+	f.resMtx.Lock()
+	batch.commitSigs[pendingChanID] = commitSig
+	ready := !batch.cancelled && len(batch.commitSigs) == batch.numChannels
+	channels := make(pendingChannels, len(batch.channels))
+	for chanID, resCtx := range batch.channels {
+		channels[chanID] = resCtx
+	}
+	fundingTx := batch.fundingTx
+	f.resMtx.Unlock()
+
+	if !ready {
+		fndgLog.Debugf("Waiting for remaining signatures of batch, "+
+			"pendingID(%x) signed", pendingChanID[:])
+		return
+	}
+
+	// Every peer has signed, so we can commit all channels to disk. If any
+	// of them fails, the channels that were already committed are
+	// canceled, as the funding transaction is never published.
+	completed := make(map[[32]byte]*channeldb.OpenChannel, len(channels))
+	for chanID, resCtx := range channels {
+		completeChan, err := f.completeReservation(
+			resCtx, batch.commitSigs[chanID],
+		)
+		if err != nil {
+			fndgLog.Errorf("Unable to complete reservation of batch "+
+				"channel pendingID(%x): %v", chanID[:], err)
+
+			for doneID, ch := range completed {
+				if err := cancelPendingChannel(ch); err != nil {
+					fndgLog.Errorf("Unable to cancel pending "+
+						"ChannelPoint(%v): %v",
+						ch.FundingOutpoint, err)
+				}
+				f.deleteLocalDiscoverySignal(
+					lnwire.NewChanIDFromOutPoint(
+						&ch.FundingOutpoint,
+					),
+				)
+				channels[doneID].err <- ErrBatchCancelled
+			}
+
+			f.failFundingFlow(resCtx.peer, chanID, err)
+			return
+		}
+
+		// The channel is now marked IsPending in the database, and we
+		// can delete it from our set of active reservations.
+		f.deleteReservationCtx(resCtx.peer.IdentityKey(), chanID)
+		completed[chanID] = completeChan
+	}
+
+	fndgLog.Infof("Broadcasting batch funding tx for %v channels: %v",
+		len(completed), spew.Sdump(fundingTx))
+
+	err := f.cfg.PublishTransaction(fundingTx)
+	if err != nil {
+		fndgLog.Errorf("Unable to broadcast batch funding tx %v: %v",
+			fundingTx.TxHash(), err)
+		// Like for a single channel, we'll watch the channels
+		// regardless, and retry the broadcast at startup.
+	}
+
+	for chanID, completeChan := range completed {
+		f.watchPendingChannel(channels[chanID], chanID, completeChan)
+	}
+}
+
 // confirmedChannel wraps a confirmed funding transaction, as well as the short
 // channel ID which identifies that channel into a single struct. We'll use
 // this to pass around the final state of a channel after it has been
@@ -1882,7 +2531,13 @@ func (f *fundingManager) waitForFundingConfirmation(
 			err)
 		return
 	}
+	// Zero-conf channels don't require any confirmations to be used, but
+	// we still need the funding transaction to confirm once to learn the
+	// real short channel ID.
 	numConfs := uint32(completeChan.NumConfsRequired)
+	if numConfs == 0 {
+		numConfs = 1
+	}
 	confNtfn, err := f.cfg.Notifier.RegisterConfirmationsNtfn(
 		&txid, fundingScript, numConfs,
 		completeChan.FundingBroadcastHeight,
@@ -2008,15 +2663,11 @@ func (f *fundingManager) waitForTimeout(completeChan *channeldb.OpenChannel,
 	}
 }
 
-// handleFundingConfirmation marks a channel as open in the database, and set
-// the channelOpeningState markedOpen. In addition it will report the now
-// decided short channel ID to the switch, and close the local discovery signal
-// for this channel.
+// handleFundingConfirmation validates the confirmed funding transaction of a
+// channel, and marks the channel as open under its short channel ID.
 func (f *fundingManager) handleFundingConfirmation(
 	completeChan *channeldb.OpenChannel,
 	confChannel *confirmedChannel) error {
-	fundingPoint := completeChan.FundingOutpoint
-	chanID := lnwire.NewChanIDFromOutPoint(&fundingPoint)
 
 	// TODO(roasbeef): ideally persistent state update for chan above
 	// should be abstracted
@@ -2030,23 +2681,37 @@ func (f *fundingManager) handleFundingConfirmation(
 		return fmt.Errorf("unable to validate channel: %v", err)
 	}
 
-	// The funding transaction now being confirmed, we add this channel to
-	// the fundingManager's internal persistent state machine that we use
-	// to track the remaining process of the channel opening. This is
-	// useful to resume the opening process in case of restarts. We set the
-	// opening state before we mark the channel opened in the database,
-	// such that we can receover from one of the db writes failing.
-	err = f.saveChannelOpeningState(
-		&fundingPoint, markedOpen, &confChannel.shortChanID,
+	return f.markChannelOpen(completeChan, confChannel.shortChanID)
+}
+
+// markChannelOpen marks a channel as open in the database, and set the
+// channelOpeningState markedOpen. In addition it will report the now decided
+// short channel ID to the switch, and close the local discovery signal for
+// this channel. For zero-conf channels, the short channel ID is the alias of
+// the channel.
+func (f *fundingManager) markChannelOpen(completeChan *channeldb.OpenChannel,
+	shortChanID lnwire.ShortChannelID) error {
+
+	fundingPoint := completeChan.FundingOutpoint
+	chanID := lnwire.NewChanIDFromOutPoint(&fundingPoint)
+
+	// We add this channel to the fundingManager's internal persistent
+	// state machine that we use to track the remaining process of the
+	// channel opening. This is useful to resume the opening process in
+	// case of restarts. We set the opening state before we mark the
+	// channel opened in the database, such that we can receover from one
+	// of the db writes failing.
+	err := f.saveChannelOpeningState(
+		&fundingPoint, markedOpen, &shortChanID,
 	)
 	if err != nil {
 		return fmt.Errorf("error setting channel state to markedOpen: %v",
 			err)
 	}
 
-	// Now that the channel has been fully confirmed and we successfully
-	// saved the opening state, we'll mark it as open within the database.
-	err = completeChan.MarkAsOpen(confChannel.shortChanID)
+	// Now that we successfully saved the opening state, we'll mark the
+	// channel as open within the database.
+	err = completeChan.MarkAsOpen(shortChanID)
 	if err != nil {
 		return fmt.Errorf("error setting channel pending flag to false: "+
 			"%v", err)
@@ -2077,6 +2742,73 @@ func (f *fundingManager) handleFundingConfirmation(
 	return nil
 }
 
+// handleZeroConfConfirmation waits for the funding transaction of a zero-conf
+// channel that is already in use to confirm. Once it does, the channel is
+// marked open under its real short channel ID, and the switch is instructed
+// to load it. The alias of the channel stays valid for HTLCs that were
+// forwarded before.
+func (f *fundingManager) handleZeroConfConfirmation(
+	completeChan *channeldb.OpenChannel) error {
+
+	confChan := make(chan *confirmedChannel)
+	cancelChan := make(chan struct{})
+	defer close(cancelChan)
+
+	// Unlike a pending channel, a zero-conf channel is never canceled if
+	// its funding transaction doesn't confirm in time, as it may already
+	// carry funds that were routed through it.
+	f.wg.Add(1)
+	go f.waitForFundingConfirmation(completeChan, cancelChan, confChan)
+
+	var confChannel *confirmedChannel
+	select {
+	case c, ok := <-confChan:
+		if !ok {
+			return fmt.Errorf("waiting for funding confirmation " +
+				"failed")
+		}
+		confChannel = c
+
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+
+	fundingPoint := completeChan.FundingOutpoint
+	fndgLog.Debugf("Zero-conf ChannelPoint(%v) with alias %v is now "+
+		"confirmed (shortChanID=%v)", fundingPoint,
+		completeChan.ZeroConfAlias, confChannel.shortChanID)
+
+	err := f.cfg.Wallet.ValidateChannel(completeChan, confChannel.fundingTx)
+	if err != nil {
+		return fmt.Errorf("unable to validate channel: %v", err)
+	}
+
+	// We mark the channel open under its real short channel ID before we
+	// update its opening state. Should we go down in between, we'll wait
+	// for the confirmation again on restart, which is harmless.
+	err = completeChan.MarkAsOpen(confChannel.shortChanID)
+	if err != nil {
+		return fmt.Errorf("error setting short chan id: %v", err)
+	}
+
+	err = f.saveChannelOpeningState(
+		&fundingPoint, fundingLockedSent, &confChannel.shortChanID,
+	)
+	if err != nil {
+		return fmt.Errorf("error setting channel state to "+
+			"fundingLockedSent: %v", err)
+	}
+
+	// The link of the channel is still active under the alias, so we'll
+	// instruct the switch to load the real short chan id from disk.
+	err = f.cfg.ReportShortChanID(fundingPoint)
+	if err != nil {
+		fndgLog.Errorf("unable to report short chan id: %v", err)
+	}
+
+	return nil
+}
+
 // sendFundingLocked creates and sends the fundingLocked message.
 // This should be called after the funding transaction has been confirmed,
 // and the channelState is 'markedOpen'.
@@ -2744,6 +3476,25 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		msg.pushAmt, msg.chainHash, peerKey.SerializeCompressed(),
 		ourDustLimit, msg.minConfs)
 
+	// Ensure that the channel isn't larger than what we're allowed to
+	// open with this peer, which depends on whether both of us signal
+	// support for large channels.
+	if maxChanSize := f.maxChanSize(msg.peer); localAmt > maxChanSize {
+		msg.err <- fmt.Errorf("funding amount is too large, the max "+
+			"channel size with peer %x is: %v",
+			peerKey.SerializeCompressed(), maxChanSize)
+		return
+	}
+
+	// Determine the upfront shutdown script that we'll commit to, if any.
+	// A script that was explicitly requested can only be committed to if
+	// the peer supports upfront shutdown scripts.
+	shutdown, err := f.upfrontShutdownScript(msg.peer, msg.shutdownScript)
+	if err != nil {
+		msg.err <- err
+		return
+	}
+
 	// First, we'll query the fee estimator for a fee that should get the
 	// commitment transaction confirmed by the next few blocks (conf target
 	// of 3). We target the near blocks here to ensure that we'll be able
@@ -2777,6 +3528,10 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		lnwire.StaticRemoteKeyOptional,
 	)
 	tweaklessCommitment := localTweakless && remoteTweakless
+	anchorCommitment := tweaklessCommitment && hasAnchorFeatures(
+		msg.peer.LocalGlobalFeatures(),
+		msg.peer.RemoteGlobalFeatures(),
+	)
 	req := &lnwallet.InitFundingReserveMsg{
 		ChainHash:        &msg.chainHash,
 		NodeID:           peerKey,
@@ -2790,6 +3545,8 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		Flags:            channelFlags,
 		MinConfs:         msg.minConfs,
 		Tweakless:        tweaklessCommitment,
+		Anchors:          anchorCommitment,
+		ExternalFunding:  msg.psbtFunding || msg.batch != nil,
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -2797,6 +3554,7 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		msg.err <- err
 		return
 	}
+	reservation.SetOurUpfrontShutdown(shutdown)
 
 	// Now that we have successfully reserved funds for this channel in the
 	// wallet, we can fetch the final channel capacity. This is done at
@@ -2840,8 +3598,13 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		peer:           msg.peer,
 		updates:        msg.updates,
 		err:            msg.err,
+		batch:          msg.batch,
+		zeroConf:       msg.zeroConf || f.isZeroConfPeer(msg.peer),
 	}
 	f.activeReservations[peerIDKey][chanID] = resCtx
+	if msg.batch != nil {
+		msg.batch.channels[chanID] = resCtx
+	}
 	f.resMtx.Unlock()
 
 	// Update the timestamp once the initFundingMsg has been handled.
@@ -2862,24 +3625,25 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		"tweakless=%v", msg.peer.Address(), chanID, tweaklessCommitment)
 
 	fundingOpen := lnwire.OpenChannel{
-		ChainHash:            *f.cfg.Wallet.Cfg.NetParams.GenesisHash,
-		PendingChannelID:     chanID,
-		FundingAmount:        capacity,
-		PushAmount:           msg.pushAmt,
-		DustLimit:            ourContribution.DustLimit,
-		MaxValueInFlight:     maxValue,
-		ChannelReserve:       chanReserve,
-		HtlcMinimum:          minHtlc,
-		FeePerKiloWeight:     uint32(commitFeePerKw),
-		CsvDelay:             remoteCsvDelay,
-		MaxAcceptedHTLCs:     maxHtlcs,
-		FundingKey:           ourContribution.MultiSigKey.PubKey,
-		RevocationPoint:      ourContribution.RevocationBasePoint.PubKey,
-		PaymentPoint:         ourContribution.PaymentBasePoint.PubKey,
-		HtlcPoint:            ourContribution.HtlcBasePoint.PubKey,
-		DelayedPaymentPoint:  ourContribution.DelayBasePoint.PubKey,
-		FirstCommitmentPoint: ourContribution.FirstCommitmentPoint,
-		ChannelFlags:         channelFlags,
+		ChainHash:             *f.cfg.Wallet.Cfg.NetParams.GenesisHash,
+		PendingChannelID:      chanID,
+		FundingAmount:         capacity,
+		PushAmount:            msg.pushAmt,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      maxValue,
+		ChannelReserve:        chanReserve,
+		HtlcMinimum:           minHtlc,
+		FeePerKiloWeight:      uint32(commitFeePerKw),
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourContribution.RevocationBasePoint.PubKey,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		ChannelFlags:          channelFlags,
+		UpfrontShutdownScript: ourContribution.UpfrontShutdown,
 	}
 	if err := msg.peer.SendMessage(false, &fundingOpen); err != nil {
 		e := fmt.Errorf("Unable to send funding request message: %v",
@@ -2897,6 +3661,78 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 	}
 }
 
+// initBatchFundingWorkflow sends a message to the funding manager instructing
+// it to open a channel with each of the target peers, all funded by a single
+// funding transaction. The progress of each channel is reported over the
+// update and error channels of its request.
+func (f *fundingManager) initBatchFundingWorkflow(peers []lnpeer.Peer,
+	reqs []*openChanReq) {
+
+	msg := &initBatchFundingMsg{
+		peers: peers,
+		reqs:  reqs,
+	}
+
+	select {
+	case f.fundingMsgs <- msg:
+	case <-f.quit:
+	}
+}
+
+// handleInitBatchFundingMsg starts the funding workflow of every channel in a
+// batch. The reservations of the channels don't select any coins, instead the
+// wallet funds all of them with a single transaction once every peer has
+// accepted its channel.
+func (f *fundingManager) handleInitBatchFundingMsg(msg *initBatchFundingMsg) {
+	// The channels share a single funding transaction, so they must agree
+	// on its fee rate.
+	for _, req := range msg.reqs[1:] {
+		if req.fundingFeePerKw == msg.reqs[0].fundingFeePerKw {
+			continue
+		}
+
+		fndgLog.Errorf("Unable to start funding batch: fee rates %v "+
+			"and %v differ", msg.reqs[0].fundingFeePerKw,
+			req.fundingFeePerKw)
+		for _, req := range msg.reqs {
+			req.err <- ErrBatchFeeRateMismatch
+		}
+		return
+	}
+
+	batch := &fundingBatch{
+		numChannels: len(msg.reqs),
+		feeRate:     msg.reqs[0].fundingFeePerKw,
+		channels:    make(pendingChannels, len(msg.reqs)),
+		accepted:    make(map[[32]byte]struct{}),
+		commitSigs:  make(map[[32]byte]lnwire.Sig),
+	}
+
+	for i, req := range msg.reqs {
+		f.handleInitFundingMsg(&initFundingMsg{
+			peer:        msg.peers[i],
+			openChanReq: req,
+			batch:       batch,
+		})
+
+		// If the workflow of this channel failed to start, its error
+		// has already been sent to the caller. As the batch can't be
+		// completed anymore, we'll cancel the channels started so far
+		// and skip the remaining ones.
+		f.resMtx.RLock()
+		failed := batch.cancelled || len(batch.channels) != i+1
+		f.resMtx.RUnlock()
+
+		if failed {
+			for _, req := range msg.reqs[i+1:] {
+				req.err <- ErrBatchCancelled
+			}
+			f.cancelBatch(batch)
+			return
+		}
+	}
+}
+
 // waitUntilChannelOpen is designed to prevent other lnd subsystems from
 // sending new update messages to a channel before the channel is fully
 // opened.
@@ -3002,6 +3838,16 @@ func (f *fundingManager) cancelReservationCtx(peerKey *btcec.PublicKey,
 	fndgLog.Infof("Cancelling funding reservation for node_key=%x, "+
 		"chan_id=%x", peerKey.SerializeCompressed(), pendingChanID[:])
 
+	// If the reservation is part of a batch, the other channels of the
+	// batch can't be funded anymore either. They're cancelled once the
+	// resMtx has been released.
+	var batch *fundingBatch
+	defer func() {
+		if batch != nil {
+			f.cancelBatch(batch)
+		}
+	}()
+
 	peerIDKey := newSerializedKey(peerKey)
 	f.resMtx.Lock()
 	defer f.resMtx.Unlock()
@@ -3031,9 +3877,49 @@ func (f *fundingManager) cancelReservationCtx(peerKey *btcec.PublicKey,
 	if len(nodeReservations) == 0 {
 		delete(f.activeReservations, peerIDKey)
 	}
+
+	batch = ctx.batch
 	return ctx, nil
 }
 
+// cancelBatch fails the funding flow of all channels of the batch that are
+// still pending, and releases the inputs of the shared funding transaction if
+// it has already been created.
+//
+// NOTE: This method MUST NOT be called with the resMtx held.
+func (f *fundingManager) cancelBatch(batch *fundingBatch) {
+	f.resMtx.Lock()
+	if batch.cancelled {
+		f.resMtx.Unlock()
+		return
+	}
+	batch.cancelled = true
+
+	channels := make(pendingChannels, len(batch.channels))
+	for chanID, resCtx := range batch.channels {
+		channels[chanID] = resCtx
+	}
+	fundingTx := batch.fundingTx
+	f.resMtx.Unlock()
+
+	fndgLog.Infof("Cancelling funding batch of %v channels",
+		batch.numChannels)
+
+	if fundingTx != nil {
+		for _, txIn := range fundingTx.TxIn {
+			f.cfg.Wallet.UnlockOutpoint(txIn.PreviousOutPoint)
+		}
+	}
+
+	for chanID, resCtx := range channels {
+		if !f.IsPendingChannel(chanID, resCtx.peer.IdentityKey()) {
+			continue
+		}
+
+		f.failFundingFlow(resCtx.peer, chanID, ErrBatchCancelled)
+	}
+}
+
 // deleteReservationCtx deletes the reservation uniquely identified by the
 // target public key of the peer, and the specified pending channel ID.
 func (f *fundingManager) deleteReservationCtx(peerKey *btcec.PublicKey,
@@ -3101,11 +3987,285 @@ func copyPubKey(pub *btcec.PublicKey) *btcec.PublicKey {
 	}
 }
 
+// hasAnchorFeatures returns true if both the local and remote feature vectors
+// signal support for anchor commitments.
+func hasAnchorFeatures(local, remote *lnwire.FeatureVector) bool {
+	return local.HasFeature(lnwire.AnchorsOptional) &&
+		remote.HasFeature(lnwire.AnchorsOptional)
+}
+
+// hasWumboFeatures returns true if both the local and remote feature vectors
+// signal support for channels above the soft-limit for channel size.
+func hasWumboFeatures(local, remote *lnwire.FeatureVector) bool {
+	return local.HasFeature(lnwire.WumboChannelsOptional) &&
+		remote.HasFeature(lnwire.WumboChannelsOptional)
+}
+
+// validateAcceptorParams checks that the channel parameters overridden by a
+// channel acceptor for the given channel opening request are sound.
+func (f *fundingManager) validateAcceptorParams(
+	resp *chanacceptor.ChannelAcceptResponse,
+	msg *lnwire.OpenChannel) error {
+
+	if resp.CSVDelay > f.cfg.MaxRemoteDelay {
+		return lnwallet.ErrCsvDelayTooLarge(
+			resp.CSVDelay, f.cfg.MaxRemoteDelay,
+		)
+	}
+
+	if uint32(resp.MinAcceptDepth) > chainntnfs.MaxNumConfs {
+		return lnwallet.ErrNumConfsTooLarge(
+			uint32(resp.MinAcceptDepth), chainntnfs.MaxNumConfs,
+		)
+	}
+
+	maxHtlcs := uint16(input.MaxHTLCNumber / 2)
+	if resp.MaxHTLCs > maxHtlcs {
+		return lnwallet.ErrMaxHtlcNumTooLarge(resp.MaxHTLCs, maxHtlcs)
+	}
+
+	// The reserve can't be below the dust limit of the initiator, as it
+	// would otherwise be unable to enforce it.
+	if resp.Reserve != 0 && resp.Reserve < msg.DustLimit {
+		return lnwallet.ErrChanReserveTooSmall(
+			resp.Reserve, msg.DustLimit,
+		)
+	}
+	if resp.Reserve >= msg.FundingAmount {
+		return lnwallet.ErrChanReserveTooLarge(
+			resp.Reserve, msg.FundingAmount,
+		)
+	}
+
+	// The minimum htlc must be below both the capacity of the channel and
+	// the value that the initiator may have in flight, as the channel
+	// could never carry an htlc otherwise.
+	maxMinHtlc := lnwire.NewMSatFromSatoshis(msg.FundingAmount)
+	maxValue := f.cfg.RequiredRemoteMaxValue(msg.FundingAmount)
+	if maxValue < maxMinHtlc {
+		maxMinHtlc = maxValue
+	}
+	if resp.MinHTLC != 0 && resp.MinHTLC >= maxMinHtlc {
+		return lnwallet.ErrMinHtlcTooLarge(resp.MinHTLC, maxMinHtlc-1)
+	}
+
+	return nil
+}
+
+// isZeroConfPeer returns true if the given peer is trusted not to double
+// spend the funding transactions of the channels it opens with us.
+func (f *fundingManager) isZeroConfPeer(peer lnpeer.Peer) bool {
+	_, ok := f.cfg.ZeroConfPeers[newSerializedKey(peer.IdentityKey())]
+	return ok
+}
+
+// newZeroConfAlias returns a short channel ID that identifies a zero-conf
+// channel until its funding transaction confirms. Random aliases are drawn
+// until one is found that isn't used by any of our channels or links yet.
+func (f *fundingManager) newZeroConfAlias() (lnwire.ShortChannelID, error) {
+	inUse, err := f.zeroConfAliases()
+	if err != nil {
+		return lnwire.ShortChannelID{}, err
+	}
+
+	for i := 0; i < maxZeroConfAliasAttempts; i++ {
+		alias, err := randomZeroConfAlias()
+		if err != nil {
+			return lnwire.ShortChannelID{}, err
+		}
+
+		if _, ok := inUse[alias]; ok {
+			continue
+		}
+		if f.cfg.ShortChanIDInUse(alias) {
+			continue
+		}
+
+		return alias, nil
+	}
+
+	return lnwire.ShortChannelID{}, fmt.Errorf("unable to find unused "+
+		"zero-conf alias after %v attempts", maxZeroConfAliasAttempts)
+}
+
+// zeroConfAliases returns the aliases of all of our zero-conf channels,
+// including the ones that are still being negotiated.
+func (f *fundingManager) zeroConfAliases() (
+	map[lnwire.ShortChannelID]struct{}, error) {
+
+	channels, err := f.cfg.Wallet.Cfg.Database.FetchAllChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := make(map[lnwire.ShortChannelID]struct{})
+	for _, channel := range channels {
+		if channel.IsZeroConf() {
+			aliases[channel.ZeroConfAlias] = struct{}{}
+		}
+	}
+
+	f.resMtx.RLock()
+	defer f.resMtx.RUnlock()
+
+	for _, pendingChans := range f.activeReservations {
+		for _, resCtx := range pendingChans {
+			alias := resCtx.reservation.ZeroConfAlias()
+			if alias != (lnwire.ShortChannelID{}) {
+				aliases[alias] = struct{}{}
+			}
+		}
+	}
+
+	return aliases, nil
+}
+
+// randomZeroConfAlias returns a random short channel ID that identifies a
+// zero-conf channel until its funding transaction confirms. The block height
+// of the alias lies far beyond the chain tip, such that it can't collide with
+// the short channel ID of a confirmed channel.
+func randomZeroConfAlias() (lnwire.ShortChannelID, error) {
+	var b [10]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return lnwire.ShortChannelID{}, err
+	}
+
+	height := binary.BigEndian.Uint32(b[:4]) % (maxZeroConfAliasHeight -
+		minZeroConfAliasHeight)
+
+	return lnwire.ShortChannelID{
+		BlockHeight: minZeroConfAliasHeight + height,
+		TxIndex:     binary.BigEndian.Uint32(b[4:8]) & 0xffffff,
+		TxPosition:  binary.BigEndian.Uint16(b[8:]),
+	}, nil
+}
+
+// maxChanSize returns the largest channel that we'll open or accept with the
+// given peer. Unless both of us signal support for large channels, the
+// soft-limit for channel size applies as well. Support is signaled in the init
+// message, where some peers only set the bit in the global features, which we
+// accept as well.
+func (f *fundingManager) maxChanSize(peer lnpeer.Peer) btcutil.Amount {
+	maxChanSize := f.cfg.MaxChanSize
+
+	local := peer.LocalFeatures()
+	remote := peer.RemoteFeatures()
+	wumbo := hasWumboFeatures(local, remote) ||
+		hasWumboFeatures(local, peer.RemoteGlobalFeatures())
+	if !wumbo && maxChanSize > MaxFundingAmount {
+		maxChanSize = MaxFundingAmount
+	}
+
+	return maxChanSize
+}
+
+// hasUpfrontShutdownFeatures returns true if both the local and remote feature
+// vectors signal support for upfront shutdown scripts.
+func hasUpfrontShutdownFeatures(local, remote *lnwire.FeatureVector) bool {
+	return local.HasFeature(lnwire.UpfrontShutdownScriptOptional) &&
+		remote.HasFeature(lnwire.UpfrontShutdownScriptOptional)
+}
+
+// upfrontShutdownScript returns the upfront shutdown script that we commit to
+// when opening a channel with the given peer. The requested script, if any,
+// takes precedence over our default one. As the peer must enforce the script,
+// a requested script results in an error if the peer doesn't support upfront
+// shutdown scripts, while the default script is silently omitted. Support is
+// signaled in the init message, where some peers only set the bit in the
+// global features, which we accept as well.
+func (f *fundingManager) upfrontShutdownScript(peer lnpeer.Peer,
+	requested lnwire.DeliveryAddress) (lnwire.DeliveryAddress, error) {
+
+	local := peer.LocalFeatures()
+	supported := hasUpfrontShutdownFeatures(local, peer.RemoteFeatures()) ||
+		hasUpfrontShutdownFeatures(local, peer.RemoteGlobalFeatures())
+
+	switch {
+	case len(requested) > 0 && !supported:
+		return nil, fmt.Errorf("peer %x does not support upfront "+
+			"shutdown scripts",
+			peer.IdentityKey().SerializeCompressed())
+
+	case len(requested) > 0:
+		return requested, nil
+
+	case supported:
+		return f.cfg.DefaultUpfrontShutdown, nil
+
+	default:
+		return nil, nil
+	}
+}
+
+// validateUpfrontShutdown ensures that an upfront shutdown script committed to
+// by the remote peer is either empty, or pays to one of the script types that
+// a cooperative close may pay out to.
+func validateUpfrontShutdown(script lnwire.DeliveryAddress) error {
+	if len(script) == 0 {
+		return nil
+	}
+
+	switch txscript.GetScriptClass(script) {
+	case txscript.PubKeyHashTy, txscript.ScriptHashTy,
+		txscript.WitnessV0PubKeyHashTy, txscript.WitnessV0ScriptHashTy:
+
+		return nil
+
+	default:
+		return lnwallet.ErrInvalidUpfrontShutdown(script)
+	}
+}
+
+// parseUpfrontShutdownAddress returns the script paying to the given address,
+// for use as an upfront shutdown script. The address must be valid for the
+// active network. If the address is empty, no script is returned.
+func parseUpfrontShutdownAddress(
+	address string) (lnwire.DeliveryAddress, error) {
+
+	if address == "" {
+		return nil, nil
+	}
+
+	addr, err := btcutil.DecodeAddress(address, activeNetParams.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	if !addr.IsForNet(activeNetParams.Params) {
+		return nil, fmt.Errorf("address: %v is not valid for this "+
+			"network: %v", addr.String(),
+			activeNetParams.Params.Name)
+	}
+
+	return txscript.PayToAddrScript(addr)
+}
+
+// parseZeroConfPeers returns the set of peers with the given hex encoded
+// public keys, which are trusted to open zero-conf channels with us.
+func parseZeroConfPeers(peers []string) (map[[33]byte]struct{}, error) {
+	zeroConfPeers := make(map[[33]byte]struct{}, len(peers))
+	for _, peer := range peers {
+		pubKeyBytes, err := hex.DecodeString(peer)
+		if err != nil {
+			return nil, err
+		}
+
+		pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+		if err != nil {
+			return nil, err
+		}
+
+		zeroConfPeers[newSerializedKey(pubKey)] = struct{}{}
+	}
+
+	return zeroConfPeers, nil
+}
+
 // saveChannelOpeningState saves the channelOpeningState for the provided
 // chanPoint to the channelOpeningStateBucket.
 func (f *fundingManager) saveChannelOpeningState(chanPoint *wire.OutPoint,
 	state channelOpeningState, shortChanID *lnwire.ShortChannelID) error {
-	return f.cfg.Wallet.Cfg.Database.Update(func(tx *bbolt.Tx) error {
+	return f.cfg.Wallet.Cfg.Database.Update(func(tx kvdb.Tx) error {
 
 		bucket, err := tx.CreateBucketIfNotExists(channelOpeningStateBucket)
 		if err != nil {
@@ -3135,7 +4295,7 @@ func (f *fundingManager) getChannelOpeningState(chanPoint *wire.OutPoint) (
 
 	var state channelOpeningState
 	var shortChanID lnwire.ShortChannelID
-	err := f.cfg.Wallet.Cfg.Database.View(func(tx *bbolt.Tx) error {
+	err := f.cfg.Wallet.Cfg.Database.View(func(tx kvdb.Tx) error {
 
 		bucket := tx.Bucket(channelOpeningStateBucket)
 		if bucket == nil {
@@ -3167,7 +4327,7 @@ func (f *fundingManager) getChannelOpeningState(chanPoint *wire.OutPoint) (
 
 // deleteChannelOpeningState removes any state for chanPoint from the database.
 func (f *fundingManager) deleteChannelOpeningState(chanPoint *wire.OutPoint) error {
-	return f.cfg.Wallet.Cfg.Database.Update(func(tx *bbolt.Tx) error {
+	return f.cfg.Wallet.Cfg.Database.Update(func(tx kvdb.Tx) error {
 		bucket := tx.Bucket(channelOpeningStateBucket)
 		if bucket == nil {
 			return fmt.Errorf("Bucket not found")
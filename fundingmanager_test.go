@@ -19,6 +19,7 @@ import (
 	"github.com/btgsuite/btgd/btcec"
 	"github.com/btgsuite/btgd/chaincfg"
 	"github.com/btgsuite/btgd/chaincfg/chainhash"
+	"github.com/btgsuite/btgd/txscript"
 	"github.com/btgsuite/btgd/wire"
 	btcutil "github.com/btgsuite/btgutil"
 
@@ -151,6 +152,14 @@ type testNode struct {
 
 	remotePeer  *testNode
 	sendMessage func(lnwire.Message) error
+
+	// features is the set of global features the node signals to its
+	// remote peer.
+	features *lnwire.RawFeatureVector
+
+	// initFeatures is the set of connection-local features the node
+	// signals to its remote peer in the init message.
+	initFeatures *lnwire.RawFeatureVector
 }
 
 var _ lnpeer.Peer = (*testNode)(nil)
@@ -183,12 +192,30 @@ func (n *testNode) QuitSignal() <-chan struct{} {
 	return n.shutdownChannel
 }
 
+// LocalGlobalFeatures returns the features that the node on the other end of
+// the connection, which holds this testNode as its peer, signals.
 func (n *testNode) LocalGlobalFeatures() *lnwire.FeatureVector {
-	return lnwire.NewFeatureVector(nil, nil)
+	return lnwire.NewFeatureVector(
+		n.remotePeer.features, lnwire.GlobalFeatures,
+	)
 }
 
+// RemoteGlobalFeatures returns the features that this testNode signals.
 func (n *testNode) RemoteGlobalFeatures() *lnwire.FeatureVector {
-	return lnwire.NewFeatureVector(nil, nil)
+	return lnwire.NewFeatureVector(n.features, lnwire.GlobalFeatures)
+}
+
+// LocalFeatures returns the init features that the node on the other end of
+// the connection, which holds this testNode as its peer, signals.
+func (n *testNode) LocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(
+		n.remotePeer.initFeatures, lnwire.LocalFeatures,
+	)
+}
+
+// RemoteFeatures returns the init features that this testNode signals.
+func (n *testNode) RemoteFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(n.initFeatures, lnwire.LocalFeatures)
 }
 
 func (n *testNode) AddNewChannel(channel *channeldb.OpenChannel,
@@ -344,6 +371,7 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		RequiredRemoteDelay: func(amt btcutil.Amount) uint16 {
 			return 4
 		},
+		MaxRemoteDelay: maxBtcRemoteDelay,
 		RequiredRemoteChanReserve: func(chanAmt,
 			dustLimit btcutil.Amount) btcutil.Amount {
 
@@ -373,9 +401,13 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		},
 		ZombieSweeperInterval:  1 * time.Hour,
 		ReservationTimeout:     1 * time.Nanosecond,
+		MaxChanSize:            MaxFundingAmount,
 		MaxPendingChannels:     DefaultMaxPendingChannels,
 		NotifyOpenChannelEvent: func(wire.OutPoint) {},
 		OpenChannelPredicate:   chainedAcceptor,
+		ShortChanIDInUse: func(lnwire.ShortChannelID) bool {
+			return false
+		},
 	}
 
 	for _, op := range options {
@@ -401,6 +433,8 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		testDir:         tempTestDir,
 		shutdownChannel: shutdownChan,
 		addr:            addr,
+		features:        lnwire.NewRawFeatureVector(),
+		initFeatures:    lnwire.NewRawFeatureVector(),
 	}
 
 	f.cfg.NotifyWhenOnline = func(peer [33]byte,
@@ -472,7 +506,10 @@ func recreateAliceFundingManager(t *testing.T, alice *testNode) {
 		},
 		ZombieSweeperInterval: oldCfg.ZombieSweeperInterval,
 		ReservationTimeout:    oldCfg.ReservationTimeout,
+		MaxChanSize:           oldCfg.MaxChanSize,
+		MaxRemoteDelay:        oldCfg.MaxRemoteDelay,
 		OpenChannelPredicate:  chainedAcceptor,
+		ShortChanIDInUse:      oldCfg.ShortChanIDInUse,
 	})
 	if err != nil {
 		t.Fatalf("failed recreating aliceFundingManager: %v", err)
@@ -729,6 +766,8 @@ func assertFundingMsgSent(t *testing.T, msgChan chan lnwire.Message,
 		ok      bool
 	)
 	switch msgType {
+	case "OpenChannel":
+		sentMsg, ok = msg.(*lnwire.OpenChannel)
 	case "AcceptChannel":
 		sentMsg, ok = msg.(*lnwire.AcceptChannel)
 	case "FundingCreated":
@@ -2947,3 +2986,1325 @@ func TestFundingManagerFundAll(t *testing.T) {
 		}
 	}
 }
+
+// startBatchOpen starts the funding workflow of a batch of channels from Alice
+// to Bob with the given amounts, and takes it to the point where Alice has
+// sent a FundingCreated message for each channel. The requests of the
+// channels are returned along with the FundingCreated messages.
+func startBatchOpen(t *testing.T, alice, bob *testNode,
+	amts []btcutil.Amount) ([]*openChanReq, []*lnwire.FundingCreated) {
+
+	t.Helper()
+
+	peers := make([]lnpeer.Peer, 0, len(amts))
+	reqs := make([]*openChanReq, 0, len(amts))
+	for _, amt := range amts {
+		peers = append(peers, bob)
+		reqs = append(reqs, &openChanReq{
+			targetPubkey:    bob.privKey.PubKey(),
+			chainHash:       *activeNetParams.GenesisHash,
+			localFundingAmt: amt,
+			fundingFeePerKw: 1000,
+			private:         true,
+			updates:         make(chan *lnrpc.OpenStatusUpdate, 3),
+			err:             make(chan error, 1),
+		})
+	}
+
+	alice.fundingMgr.initBatchFundingWorkflow(peers, reqs)
+
+	// Alice should have sent an OpenChannel message for each channel, and
+	// Bob should answer each of them with an AcceptChannel message.
+	accepts := make([]*lnwire.AcceptChannel, 0, len(amts))
+	for i := range amts {
+		var aliceMsg lnwire.Message
+		select {
+		case aliceMsg = <-alice.msgChan:
+		case err := <-reqs[i].err:
+			t.Fatalf("error init funding workflow: %v", err)
+		case <-time.After(time.Second * 5):
+			t.Fatalf("alice did not send OpenChannel message")
+		}
+
+		openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel)
+		if !ok {
+			t.Fatalf("expected OpenChannel to be sent from "+
+				"alice, instead got %T", aliceMsg)
+		}
+
+		bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+		accept := assertFundingMsgSent(
+			t, bob.msgChan, "AcceptChannel",
+		).(*lnwire.AcceptChannel)
+		accepts = append(accepts, accept)
+	}
+
+	assertNumPendingReservations(t, alice, bobPubKey, len(amts))
+
+	// Alice shouldn't send any FundingCreated message until every channel
+	// of the batch has been accepted.
+	for i, accept := range accepts {
+		alice.fundingMgr.processFundingAccept(accept, bob)
+
+		if i == len(accepts)-1 {
+			break
+		}
+
+		select {
+		case msg := <-alice.msgChan:
+			t.Fatalf("alice sent %T before all channels were "+
+				"accepted", msg)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	fundingCreated := make([]*lnwire.FundingCreated, 0, len(amts))
+	for range amts {
+		msg := assertFundingMsgSent(
+			t, alice.msgChan, "FundingCreated",
+		).(*lnwire.FundingCreated)
+		fundingCreated = append(fundingCreated, msg)
+	}
+
+	return reqs, fundingCreated
+}
+
+// TestFundingManagerBatchOpen checks that a batch of channels is funded by a
+// single transaction, which is only published once every channel's commitment
+// has been signed by the remote peer.
+func TestFundingManagerBatchOpen(t *testing.T) {
+	t.Parallel()
+
+	amts := []btcutil.Amount{500000, 300000}
+
+	// Bob must accept every channel of the batch while they're pending.
+	alice, bob := setupFundingManagers(
+		t, func(cfg *fundingConfig) {
+			cfg.MaxPendingChannels = len(amts)
+		},
+	)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	reqs, fundingCreated := startBatchOpen(t, alice, bob, amts)
+
+	// Both channels must be funded by distinct outputs of the same
+	// transaction.
+	txid := fundingCreated[0].FundingPoint.Hash
+	outputs := make(map[uint32]struct{})
+	for _, msg := range fundingCreated {
+		if msg.FundingPoint.Hash != txid {
+			t.Fatalf("expected funding txid %v, got %v", txid,
+				msg.FundingPoint.Hash)
+		}
+		outputs[msg.FundingPoint.Index] = struct{}{}
+	}
+	if len(outputs) != len(amts) {
+		t.Fatalf("expected %v distinct funding outputs, got %v",
+			len(amts), len(outputs))
+	}
+
+	fundingSigned := make([]*lnwire.FundingSigned, 0, len(amts))
+	for _, msg := range fundingCreated {
+		bob.fundingMgr.processFundingCreated(msg, alice)
+		signed := assertFundingMsgSent(
+			t, bob.msgChan, "FundingSigned",
+		).(*lnwire.FundingSigned)
+		fundingSigned = append(fundingSigned, signed)
+	}
+
+	// The funding transaction must not be published before the last
+	// signature has been received.
+	alice.fundingMgr.processFundingSigned(fundingSigned[0], bob)
+	select {
+	case <-alice.publTxChan:
+		t.Fatalf("funding tx published before all channels were " +
+			"signed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	alice.fundingMgr.processFundingSigned(fundingSigned[1], bob)
+
+	var publ *wire.MsgTx
+	select {
+	case publ = <-alice.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding tx")
+	}
+	if publ.TxHash() != txid {
+		t.Fatalf("expected funding tx %v to be published, got %v",
+			txid, publ.TxHash())
+	}
+	if len(publ.TxOut) != len(amts) {
+		t.Fatalf("expected %v outputs, got %v", len(amts),
+			len(publ.TxOut))
+	}
+
+	// Every channel of the batch should now be pending.
+	for _, req := range reqs {
+		select {
+		case update := <-req.updates:
+			_, ok := update.Update.(*lnrpc.OpenStatusUpdate_ChanPending)
+			if !ok {
+				t.Fatalf("expected ChanPending update, got %T",
+					update.Update)
+			}
+		case err := <-req.err:
+			t.Fatalf("unexpected funding error: %v", err)
+		case <-time.After(time.Second * 5):
+			t.Fatalf("alice did not send ChanPending update")
+		}
+	}
+
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+	assertNumPendingReservations(t, bob, alicePubKey, 0)
+}
+
+// TestFundingManagerBatchOpenCancel checks that all channels of a batch are
+// canceled, and that the funding transaction isn't published, if the funding
+// flow of any of them fails.
+func TestFundingManagerBatchOpenCancel(t *testing.T) {
+	t.Parallel()
+
+	amts := []btcutil.Amount{500000, 300000}
+
+	// Bob must accept every channel of the batch while they're pending.
+	alice, bob := setupFundingManagers(
+		t, func(cfg *fundingConfig) {
+			cfg.MaxPendingChannels = len(amts)
+		},
+	)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	reqs, fundingCreated := startBatchOpen(t, alice, bob, amts)
+
+	// Bob signs the first channel, but fails the second one.
+	bob.fundingMgr.processFundingCreated(fundingCreated[0], alice)
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+	alice.fundingMgr.processFundingSigned(fundingSigned, bob)
+
+	alice.fundingMgr.processFundingError(&lnwire.Error{
+		ChanID: fundingCreated[1].PendingChannelID,
+		Data:   []byte("funding failed"),
+	}, bobPubKey)
+
+	// Alice should tell Bob that the first channel was canceled, and both
+	// channels should have failed.
+	assertErrorSent(t, alice.msgChan)
+	for _, req := range reqs {
+		select {
+		case <-req.err:
+		case <-time.After(time.Second * 5):
+			t.Fatalf("funding flow not failed")
+		}
+	}
+
+	select {
+	case <-alice.publTxChan:
+		t.Fatalf("funding tx of canceled batch published")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+}
+
+// TestFundingManagerBatchOpenFeeRateMismatch checks that a batch of channels
+// asking for different fee rates is rejected, as they share a single funding
+// transaction.
+func TestFundingManagerBatchOpenFeeRateMismatch(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	peers := []lnpeer.Peer{bob, bob}
+	reqs := make([]*openChanReq, 0, len(peers))
+	for _, feeRate := range []lnwallet.SatPerKWeight{1000, 2000} {
+		reqs = append(reqs, &openChanReq{
+			targetPubkey:    bob.privKey.PubKey(),
+			chainHash:       *activeNetParams.GenesisHash,
+			localFundingAmt: 500000,
+			fundingFeePerKw: feeRate,
+			private:         true,
+			updates:         make(chan *lnrpc.OpenStatusUpdate, 3),
+			err:             make(chan error, 1),
+		})
+	}
+
+	alice.fundingMgr.initBatchFundingWorkflow(peers, reqs)
+
+	for _, req := range reqs {
+		select {
+		case err := <-req.err:
+			if err != ErrBatchFeeRateMismatch {
+				t.Fatalf("expected ErrBatchFeeRateMismatch, "+
+					"got %v", err)
+			}
+		case <-time.After(time.Second * 5):
+			t.Fatalf("batch with mixed fee rates not rejected")
+		}
+	}
+
+	select {
+	case msg := <-alice.msgChan:
+		t.Fatalf("alice sent %T for rejected batch", msg)
+	case <-time.After(100 * time.Millisecond):
+	}
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+}
+
+// TestFundingManagerBatchOpenInvalidSig checks that a batch is canceled if
+// one of the remote signatures is invalid, and that no local discovery
+// signals are left behind for its channels.
+func TestFundingManagerBatchOpenInvalidSig(t *testing.T) {
+	t.Parallel()
+
+	amts := []btcutil.Amount{500000, 300000}
+
+	// Bob must accept every channel of the batch while they're pending.
+	alice, bob := setupFundingManagers(
+		t, func(cfg *fundingConfig) {
+			cfg.MaxPendingChannels = len(amts)
+		},
+	)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	reqs, fundingCreated := startBatchOpen(t, alice, bob, amts)
+
+	fundingSigned := make([]*lnwire.FundingSigned, 0, len(amts))
+	for _, msg := range fundingCreated {
+		bob.fundingMgr.processFundingCreated(msg, alice)
+		signed := assertFundingMsgSent(
+			t, bob.msgChan, "FundingSigned",
+		).(*lnwire.FundingSigned)
+		fundingSigned = append(fundingSigned, signed)
+	}
+
+	// Corrupt the signature of the second channel, such that completing
+	// its reservation fails once every signature has been received.
+	fundingSigned[1].CommitSig[10] ^= 0xff
+
+	for _, msg := range fundingSigned {
+		alice.fundingMgr.processFundingSigned(msg, bob)
+	}
+
+	// Every channel should fail, while Alice tells Bob about the failed
+	// channels.
+	for _, req := range reqs {
+		var failed bool
+		for !failed {
+			select {
+			case <-req.err:
+				failed = true
+
+			case msg := <-alice.msgChan:
+				if _, ok := msg.(*lnwire.Error); !ok {
+					t.Fatalf("expected Error to be sent "+
+						"from alice, instead got %T",
+						msg)
+				}
+
+			case <-time.After(time.Second * 5):
+				t.Fatalf("funding flow not failed")
+			}
+		}
+	}
+
+	select {
+	case <-alice.publTxChan:
+		t.Fatalf("funding tx of canceled batch published")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+
+	alice.fundingMgr.localDiscoveryMtx.Lock()
+	numSignals := len(alice.fundingMgr.localDiscoverySignals)
+	alice.fundingMgr.localDiscoveryMtx.Unlock()
+	if numSignals != 0 {
+		t.Fatalf("expected no local discovery signals, got %v",
+			numSignals)
+	}
+}
+
+// serializeTestPsbt serializes the given transaction as a PSBT. If finalize
+// is set, every input carries its witness as final script witness.
+func serializeTestPsbt(t *testing.T, tx *wire.MsgTx, finalize bool) []byte {
+	t.Helper()
+
+	var b bytes.Buffer
+	writeField := func(keyType byte, value []byte) {
+		err := wire.WriteVarBytes(&b, 0, []byte{keyType})
+		if err != nil {
+			t.Fatalf("unable to write psbt key: %v", err)
+		}
+		if err := wire.WriteVarBytes(&b, 0, value); err != nil {
+			t.Fatalf("unable to write psbt value: %v", err)
+		}
+	}
+	writeSeparator := func() {
+		b.WriteByte(0x00)
+	}
+
+	var rawTx bytes.Buffer
+	if err := tx.SerializeNoWitness(&rawTx); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+
+	b.Write([]byte{0x70, 0x73, 0x62, 0x74, 0xff})
+	writeField(0x00, rawTx.Bytes())
+	writeSeparator()
+
+	for _, txIn := range tx.TxIn {
+		if finalize {
+			var witness bytes.Buffer
+			err := wire.WriteVarInt(
+				&witness, 0, uint64(len(txIn.Witness)),
+			)
+			if err != nil {
+				t.Fatalf("unable to write witness: %v", err)
+			}
+			for _, item := range txIn.Witness {
+				err := wire.WriteVarBytes(&witness, 0, item)
+				if err != nil {
+					t.Fatalf("unable to write witness: %v",
+						err)
+				}
+			}
+			writeField(0x08, witness.Bytes())
+		}
+		writeSeparator()
+	}
+	for range tx.TxOut {
+		writeSeparator()
+	}
+
+	return b.Bytes()
+}
+
+// startPsbtOpen starts the funding workflow of a PSBT funded channel from
+// Alice to Bob, and takes it to the point where Alice hands the funding output
+// to the caller. The request of the channel is returned along with the
+// funding output.
+func startPsbtOpen(t *testing.T, alice, bob *testNode,
+	localFundingAmt btcutil.Amount) (*openChanReq,
+	*lnrpc.ReadyForPsbtFunding) {
+
+	t.Helper()
+
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: localFundingAmt,
+		fundingFeePerKw: 1000,
+		private:         true,
+		psbtFunding:     true,
+		updates:         make(chan *lnrpc.OpenStatusUpdate, 3),
+		err:             make(chan error, 1),
+	}
+
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	var aliceMsg lnwire.Message
+	select {
+	case aliceMsg = <-alice.msgChan:
+	case err := <-initReq.err:
+		t.Fatalf("error init funding workflow: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenChannel message")
+	}
+	openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel)
+	if !ok {
+		t.Fatalf("expected OpenChannel to be sent from alice, "+
+			"instead got %T", aliceMsg)
+	}
+
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+
+	// Instead of sending FundingCreated, Alice should hand the funding
+	// output to the caller.
+	var psbtFund *lnrpc.ReadyForPsbtFunding
+	select {
+	case update := <-initReq.updates:
+		upd, ok := update.Update.(*lnrpc.OpenStatusUpdate_PsbtFund)
+		if !ok {
+			t.Fatalf("expected PsbtFund update, got %T",
+				update.Update)
+		}
+		psbtFund = upd.PsbtFund
+	case err := <-initReq.err:
+		t.Fatalf("unexpected funding error: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send PsbtFund update")
+	}
+
+	select {
+	case msg := <-alice.msgChan:
+		t.Fatalf("alice sent %T before the psbt was verified", msg)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if psbtFund.FundingAmount != int64(localFundingAmt) {
+		t.Fatalf("expected funding amount %v, got %v",
+			localFundingAmt, psbtFund.FundingAmount)
+	}
+
+	return initReq, psbtFund
+}
+
+// newTestPsbtFundingTx returns a transaction that pays the given amount to
+// the funding address of a PSBT funded channel.
+func newTestPsbtFundingTx(t *testing.T, fundingAddr string,
+	amt int64) *wire.MsgTx {
+
+	t.Helper()
+
+	addr, err := btcutil.DecodeAddress(
+		fundingAddr, activeNetParams.Params,
+	)
+	if err != nil {
+		t.Fatalf("unable to decode funding address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create funding script: %v", err)
+	}
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: 1},
+		Witness:          wire.TxWitness{{0x01}, {0x02}},
+	})
+	tx.AddTxOut(&wire.TxOut{
+		PkScript: pkScript,
+		Value:    amt,
+	})
+
+	return tx
+}
+
+// TestFundingManagerPsbtFunding checks that a channel can be funded by a PSBT
+// that is assembled outside of the wallet, that invalid PSBTs are rejected
+// without failing the funding flow, and that the funding transaction is only
+// published once the remote peer's signature has been received.
+func TestFundingManagerPsbtFunding(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	var pendingChanID [32]byte
+	initReq, psbtFund := startPsbtOpen(t, alice, bob, 500000)
+	copy(pendingChanID[:], psbtFund.PendingChanId)
+
+	// A PSBT whose inputs aren't finalized, or that doesn't pay the exact
+	// channel capacity to the funding output, must be rejected.
+	fundingTx := newTestPsbtFundingTx(
+		t, psbtFund.FundingAddress, psbtFund.FundingAmount,
+	)
+	err := alice.fundingMgr.VerifyPsbt(
+		pendingChanID, serializeTestPsbt(t, fundingTx, false),
+	)
+	if err != lnwallet.ErrPsbtNotFinalized {
+		t.Fatalf("expected ErrPsbtNotFinalized, got %v", err)
+	}
+
+	wrongTx := newTestPsbtFundingTx(
+		t, psbtFund.FundingAddress, psbtFund.FundingAmount-1,
+	)
+	err = alice.fundingMgr.VerifyPsbt(
+		pendingChanID, serializeTestPsbt(t, wrongTx, true),
+	)
+	if err == nil {
+		t.Fatalf("expected psbt with wrong funding amount to be " +
+			"rejected")
+	}
+	assertErrorNotSent(t, alice.msgChan)
+
+	// The valid PSBT should continue the funding flow. Alice sends the
+	// FundingCreated message before VerifyPsbt returns, so it's called
+	// in a goroutine.
+	verifyErr := make(chan error, 1)
+	go func() {
+		verifyErr <- alice.fundingMgr.VerifyPsbt(
+			pendingChanID, serializeTestPsbt(t, fundingTx, true),
+		)
+	}()
+
+	fundingCreated := assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+
+	select {
+	case err := <-verifyErr:
+		if err != nil {
+			t.Fatalf("unable to verify psbt: %v", err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("psbt not verified")
+	}
+	if fundingCreated.FundingPoint.Hash != fundingTx.TxHash() {
+		t.Fatalf("expected funding txid %v, got %v",
+			fundingTx.TxHash(), fundingCreated.FundingPoint.Hash)
+	}
+
+	// The same channel can't be funded twice.
+	err = alice.fundingMgr.VerifyPsbt(
+		pendingChanID, serializeTestPsbt(t, fundingTx, true),
+	)
+	if err == nil {
+		t.Fatalf("expected second psbt to be rejected")
+	}
+
+	bob.fundingMgr.processFundingCreated(fundingCreated, alice)
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+
+	// Nothing must have been published before Bob's signature is
+	// received.
+	select {
+	case <-alice.publTxChan:
+		t.Fatalf("funding tx published before it was signed")
+	default:
+	}
+
+	alice.fundingMgr.processFundingSigned(fundingSigned, bob)
+
+	select {
+	case update := <-initReq.updates:
+		_, ok := update.Update.(*lnrpc.OpenStatusUpdate_ChanPending)
+		if !ok {
+			t.Fatalf("expected ChanPending update, got %T",
+				update.Update)
+		}
+	case err := <-initReq.err:
+		t.Fatalf("unexpected funding error: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send ChanPending update")
+	}
+
+	select {
+	case publ := <-alice.publTxChan:
+		if publ.TxHash() != fundingTx.TxHash() {
+			t.Fatalf("expected funding tx %v to be published, "+
+				"got %v", fundingTx.TxHash(), publ.TxHash())
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding tx")
+	}
+
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+	assertNumPendingReservations(t, bob, alicePubKey, 0)
+}
+
+// TestFundingManagerPsbtFundingTimeout checks that a PSBT funded channel is
+// canceled if the PSBT isn't passed in time, and that it can't be funded
+// afterwards.
+func TestFundingManagerPsbtFundingTimeout(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	var pendingChanID [32]byte
+	initReq, psbtFund := startPsbtOpen(t, alice, bob, 500000)
+	copy(pendingChanID[:], psbtFund.PendingChanId)
+
+	// Make sure Alice's reservation times out and then run her zombie
+	// sweeper.
+	time.Sleep(1 * time.Millisecond)
+	go alice.fundingMgr.pruneZombieReservations()
+
+	// Alice should tell Bob that the channel was canceled, and fail the
+	// funding flow.
+	assertErrorSent(t, alice.msgChan)
+	select {
+	case <-initReq.err:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("funding flow not failed")
+	}
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+
+	// A PSBT that is passed after the reservation timed out must be
+	// rejected, and nothing must be published.
+	fundingTx := newTestPsbtFundingTx(
+		t, psbtFund.FundingAddress, psbtFund.FundingAmount,
+	)
+	err := alice.fundingMgr.VerifyPsbt(
+		pendingChanID, serializeTestPsbt(t, fundingTx, true),
+	)
+	if err == nil {
+		t.Fatalf("expected psbt of canceled channel to be rejected")
+	}
+	assertErrorNotSent(t, alice.msgChan)
+
+	select {
+	case <-alice.publTxChan:
+		t.Fatalf("funding tx of canceled channel published")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// initWumboFunding makes the sender start the funding workflow of a private
+// channel of the given size with the receiver.
+func initWumboFunding(sender, receiver *testNode,
+	amt btcutil.Amount) *openChanReq {
+
+	initReq := &openChanReq{
+		targetPubkey:    receiver.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: amt,
+		private:         true,
+		updates:         make(chan *lnrpc.OpenStatusUpdate),
+		err:             make(chan error, 1),
+	}
+	sender.fundingMgr.initFundingWorkflow(receiver, initReq)
+
+	return initReq
+}
+
+// assertChanTooLarge asserts that the funding workflow started by the sender
+// fails because the channel is too large.
+func assertChanTooLarge(t *testing.T, sender *testNode,
+	initReq *openChanReq) {
+
+	t.Helper()
+
+	select {
+	case err := <-initReq.err:
+		if !strings.Contains(err.Error(), "too large") {
+			t.Fatalf("expected channel too large error, got: %v",
+				err)
+		}
+	case <-sender.msgChan:
+		t.Fatalf("OpenChannel should not have been sent")
+	case <-time.After(time.Second * 5):
+		t.Fatalf("funding workflow did not fail")
+	}
+}
+
+// assertChanRejectedTooLarge asserts that the receiver rejects the given
+// channel opening request because the channel is too large.
+func assertChanRejectedTooLarge(t *testing.T, receiver *testNode,
+	openChannelReq *lnwire.OpenChannel) {
+
+	t.Helper()
+
+	receiver.fundingMgr.processFundingOpen(
+		openChannelReq, receiver.remotePeer,
+	)
+	errMsg := assertFundingMsgSent(
+		t, receiver.msgChan, "Error",
+	).(*lnwire.Error)
+	if !strings.Contains(errMsg.Error(), "channel too large") {
+		t.Fatalf("expected channel too large error, got \"%v\"",
+			errMsg.Error())
+	}
+}
+
+// TestFundingManagerWumbo tests that channels above the soft-limit for channel
+// size are only opened and accepted if both peers signal support for large
+// channels in their init features, and that the maximum channel size still
+// applies if they do.
+func TestFundingManagerWumbo(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(
+		t, func(cfg *fundingConfig) {
+			cfg.MaxChanSize = MaxFundingAmountWumbo
+		},
+	)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	wumboAmt := MaxFundingAmount + 1
+
+	// As long as only Alice signals support for large channels, she must
+	// refuse to open one with Bob.
+	alice.initFeatures.Set(lnwire.WumboChannelsOptional)
+	assertChanTooLarge(t, alice, initWumboFunding(alice, bob, wumboAmt))
+
+	// Once Bob signals support for large channels as well, Alice still
+	// refuses to exceed her maximum channel size.
+	bob.initFeatures.Set(lnwire.WumboChannelsOptional)
+	assertChanTooLarge(
+		t, alice, initWumboFunding(
+			alice, bob, MaxFundingAmountWumbo+1,
+		),
+	)
+
+	// A channel above the soft-limit, but within the maximum channel size
+	// is opened.
+	initWumboFunding(alice, bob, wumboAmt)
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+
+	// If Bob didn't signal support for large channels, he must reject
+	// the channel.
+	bob.initFeatures.Unset(lnwire.WumboChannelsOptional)
+	assertChanRejectedTooLarge(t, bob, openChannelReq)
+
+	// Bob only signals support for large channels through his init
+	// features, so setting the bit in his global features has no effect.
+	bob.features.Set(lnwire.WumboChannelsOptional)
+	assertChanRejectedTooLarge(t, bob, openChannelReq)
+
+	// Since he does, he accepts it. This also holds if Alice only signals
+	// support for large channels in her global features, as some peers
+	// do.
+	bob.initFeatures.Set(lnwire.WumboChannelsOptional)
+	alice.initFeatures.Unset(lnwire.WumboChannelsOptional)
+	alice.features.Set(lnwire.WumboChannelsOptional)
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	assertFundingMsgSent(t, bob.msgChan, "AcceptChannel")
+}
+
+// TestFundingManagerWumboAsymmetric tests that the soft-limit for channel
+// size still applies in both directions if only one of the peers has large
+// channels enabled.
+func TestFundingManagerWumboAsymmetric(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Alice has large channels enabled, while Bob runs with the default
+	// configuration.
+	alice.fundingMgr.cfg.MaxChanSize = MaxFundingAmountWumbo
+	alice.initFeatures.Set(lnwire.WumboChannelsOptional)
+	alice.features.Set(lnwire.WumboChannelsOptional)
+
+	wumboAmt := MaxFundingAmount + 1
+
+	// Alice must refuse to open a channel above the soft-limit with Bob.
+	assertChanTooLarge(t, alice, initWumboFunding(alice, bob, wumboAmt))
+
+	// Bob must refuse to open a channel above the soft-limit with Alice,
+	// even though she signals support for large channels.
+	assertChanTooLarge(t, bob, initWumboFunding(bob, alice, wumboAmt))
+
+	// A channel of exactly the soft-limit is opened by Alice.
+	initWumboFunding(alice, bob, MaxFundingAmount)
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+
+	// Bob must reject the channel if it exceeds the soft-limit, and
+	// accept it otherwise.
+	wumboReq := *openChannelReq
+	wumboReq.FundingAmount = wumboAmt
+	assertChanRejectedTooLarge(t, bob, &wumboReq)
+
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	assertFundingMsgSent(t, bob.msgChan, "AcceptChannel")
+}
+
+// TestFundingManagerUpfrontShutdown tests that upfront shutdown scripts are
+// only committed to if both peers signal support for them in their init
+// features, that the default script is used if no script was requested, and
+// that invalid remote scripts are rejected.
+func TestFundingManagerUpfrontShutdown(t *testing.T) {
+	t.Parallel()
+
+	p2wpkh := append([]byte{0x00, 0x14}, bytes.Repeat([]byte{0x01}, 20)...)
+	p2wsh := append([]byte{0x00, 0x20}, bytes.Repeat([]byte{0x02}, 32)...)
+
+	alice, bob := setupFundingManagers(
+		t, func(cfg *fundingConfig) {
+			cfg.DefaultUpfrontShutdown = p2wsh
+			cfg.MaxPendingChannels = 2
+		},
+	)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// initFunding makes Alice start the funding workflow of a channel with
+	// Bob, committing to the given upfront shutdown script.
+	initFunding := func(script lnwire.DeliveryAddress) *openChanReq {
+		initReq := &openChanReq{
+			targetPubkey:    bob.privKey.PubKey(),
+			chainHash:       *activeNetParams.GenesisHash,
+			localFundingAmt: 500000,
+			private:         true,
+			shutdownScript:  script,
+			updates:         make(chan *lnrpc.OpenStatusUpdate),
+			err:             make(chan error, 1),
+		}
+		alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+		return initReq
+	}
+
+	// As Bob doesn't signal support for upfront shutdown scripts, Alice
+	// must refuse to commit to a requested script.
+	alice.initFeatures.Set(lnwire.UpfrontShutdownScriptOptional)
+	initReq := initFunding(p2wpkh)
+	select {
+	case err := <-initReq.err:
+		if !strings.Contains(err.Error(), "upfront shutdown") {
+			t.Fatalf("expected upfront shutdown error, got: %v",
+				err)
+		}
+	case <-alice.msgChan:
+		t.Fatalf("alice should not have sent OpenChannel")
+	case <-time.After(time.Second * 5):
+		t.Fatalf("funding workflow did not fail")
+	}
+
+	// Without a requested script, she omits her default script instead.
+	initFunding(nil)
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	if openChannelReq.UpfrontShutdownScript != nil {
+		t.Fatalf("expected no upfront shutdown script, got %x",
+			openChannelReq.UpfrontShutdownScript)
+	}
+
+	// Once Bob signals support in his init features as well, the
+	// requested script takes precedence over the default one.
+	bob.initFeatures.Set(lnwire.UpfrontShutdownScriptOptional)
+	initFunding(p2wpkh)
+	openChannelReq = assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	if !bytes.Equal(openChannelReq.UpfrontShutdownScript, p2wpkh) {
+		t.Fatalf("expected upfront shutdown script %x, got %x",
+			p2wpkh, openChannelReq.UpfrontShutdownScript)
+	}
+
+	// Bob accepts the channel, committing to his default script.
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	if !bytes.Equal(acceptChannelResponse.UpfrontShutdownScript, p2wsh) {
+		t.Fatalf("expected upfront shutdown script %x, got %x",
+			p2wsh, acceptChannelResponse.UpfrontShutdownScript)
+	}
+
+	// Some peers only signal support in their global features, which is
+	// accepted as well.
+	bob.initFeatures.Unset(lnwire.UpfrontShutdownScriptOptional)
+	bob.features.Set(lnwire.UpfrontShutdownScriptOptional)
+	initFunding(nil)
+	openChannelReq = assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	if !bytes.Equal(openChannelReq.UpfrontShutdownScript, p2wsh) {
+		t.Fatalf("expected upfront shutdown script %x, got %x",
+			p2wsh, openChannelReq.UpfrontShutdownScript)
+	}
+
+	// A script that doesn't pay to a standard output type is rejected.
+	initFunding(nil)
+	openChannelReq = assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	openChannelReq.UpfrontShutdownScript = []byte{txscript.OP_TRUE}
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	errMsg := assertFundingMsgSent(t, bob.msgChan, "Error").(*lnwire.Error)
+	if !strings.Contains(errMsg.Error(), "invalid upfront shutdown") {
+		t.Fatalf("expected invalid upfront shutdown error, got \"%v\"",
+			errMsg.Error())
+	}
+}
+
+// TestFundingManagerZeroConf tests that a channel with a trusted peer is used
+// under an alias before its funding transaction confirms, and that it is
+// moved to its real short channel ID once it does.
+func TestFundingManagerZeroConf(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t, func(cfg *fundingConfig) {
+		cfg.ZeroConfPeers = map[[33]byte]struct{}{
+			newSerializedKey(alicePubKey): {},
+			newSerializedKey(bobPubKey):   {},
+		}
+	})
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// We will consume the channel updates as we go, so no buffering is
+	// needed.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+
+	// Run through the process of opening the channel, up until the funding
+	// transaction is broadcasted.
+	localAmt := btcutil.Amount(500000)
+	fundingOutPoint, fundingTx := openChannel(
+		t, alice, bob, localAmt, 0, 1, updateChan, true,
+	)
+
+	// Without any confirmation, both send FundingLocked right away.
+	fundingLockedAlice := assertFundingMsgSent(
+		t, alice.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+	fundingLockedBob := assertFundingMsgSent(
+		t, bob.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+	assertFundingLockedSent(t, alice, bob, fundingOutPoint)
+
+	// Exchange the fundingLocked messages. Both add the channel to their
+	// peer under their alias.
+	alice.fundingMgr.processFundingLocked(fundingLockedBob, bob)
+	bob.fundingMgr.processFundingLocked(fundingLockedAlice, alice)
+
+	for _, node := range []*testNode{alice, bob} {
+		var channel *channeldb.OpenChannel
+		select {
+		case c := <-node.newChannels:
+			channel = c.channel
+			close(c.err)
+		case <-time.After(time.Second * 5):
+			t.Fatalf("channel not sent to peer")
+		}
+
+		if !channel.IsZeroConf() {
+			t.Fatalf("expected zero-conf channel")
+		}
+		if channel.NumConfsRequired != 0 {
+			t.Fatalf("expected no confirmations to be required, "+
+				"got %v", channel.NumConfsRequired)
+		}
+		alias := channel.ZeroConfAlias
+		if alias.BlockHeight < minZeroConfAliasHeight {
+			t.Fatalf("invalid alias %v", alias)
+		}
+		if channel.ShortChanID() != alias {
+			t.Fatalf("expected short chan id %v, got %v", alias,
+				channel.ShortChanID())
+		}
+	}
+
+	// Notify that transaction was mined.
+	shortChanID := lnwire.ShortChannelID{
+		BlockHeight: fundingBroadcastHeight + 1,
+		TxIndex:     3,
+	}
+	conf := &chainntnfs.TxConfirmation{
+		Tx:          fundingTx,
+		BlockHeight: shortChanID.BlockHeight,
+		TxIndex:     shortChanID.TxIndex,
+	}
+	alice.mockNotifier.oneConfChannel <- conf
+	bob.mockNotifier.oneConfChannel <- conf
+
+	// Only now the channel is added to the router graph, under its real
+	// short channel ID.
+	assertChannelAnnouncements(t, alice, bob, localAmt)
+	assertAddedToRouterGraph(t, alice, bob, fundingOutPoint)
+	waitForOpenUpdate(t, updateChan)
+
+	chanID := lnwire.NewChanIDFromOutPoint(fundingOutPoint)
+	for _, node := range []*testNode{alice, bob} {
+		channel, err := node.fundingMgr.cfg.FindChannel(chanID)
+		if err != nil {
+			t.Fatalf("unable to find channel: %v", err)
+		}
+		if channel.ShortChanID() != shortChanID {
+			t.Fatalf("expected short chan id %v, got %v",
+				shortChanID, channel.ShortChanID())
+		}
+		if !channel.IsZeroConf() {
+			t.Fatalf("expected alias to be kept")
+		}
+	}
+
+	// Notify that six confirmations has been reached on funding
+	// transaction, after which the channel is announced.
+	alice.mockNotifier.sixConfChannel <- conf
+	bob.mockNotifier.sixConfChannel <- conf
+
+	assertAnnouncementSignatures(t, alice, bob)
+	assertNoChannelState(t, alice, bob, fundingOutPoint)
+}
+
+// openZeroConfChannel makes Alice open a channel with Bob, up until Bob
+// answers with an AcceptChannel message, which Alice handles. The request of
+// Alice is returned along with the pending channel ID.
+func openZeroConfChannel(t *testing.T, alice, bob *testNode) (*openChanReq,
+	[32]byte) {
+
+	t.Helper()
+
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		private:         true,
+		updates:         make(chan *lnrpc.OpenStatusUpdate),
+		err:             make(chan error, 1),
+	}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+
+	return initReq, openChannelReq.PendingChannelID
+}
+
+// TestFundingManagerZeroConfNotRequested tests that the initiator fails the
+// funding flow if the responder doesn't require any confirmations for a
+// channel that the initiator didn't request to be a zero-conf channel.
+func TestFundingManagerZeroConfNotRequested(t *testing.T) {
+	t.Parallel()
+
+	// Only Bob trusts Alice, so he doesn't require any confirmations,
+	// while Alice doesn't ask for a zero-conf channel.
+	alice, bob := setupFundingManagers(t, func(cfg *fundingConfig) {
+		cfg.ZeroConfPeers = map[[33]byte]struct{}{
+			newSerializedKey(alicePubKey): {},
+		}
+	})
+	defer tearDownFundingManagers(t, alice, bob)
+
+	initReq, _ := openZeroConfChannel(t, alice, bob)
+
+	expectedErr := lnwallet.ErrZeroConfNotRequested()
+	errMsg := assertFundingMsgSent(
+		t, alice.msgChan, "Error",
+	).(*lnwire.Error)
+	if string(errMsg.Data) != expectedErr.Error() {
+		t.Fatalf("expected zero-conf error, got \"%v\"",
+			string(errMsg.Data))
+	}
+
+	select {
+	case err := <-initReq.err:
+		if err.Error() != expectedErr.Error() {
+			t.Fatalf("expected zero-conf error, got %v", err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("funding workflow did not fail")
+	}
+
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+}
+
+// TestFundingManagerZeroConfNotGranted tests that a channel that the initiator
+// requested to be a zero-conf channel is funded like any other if the
+// responder requires confirmations.
+func TestFundingManagerZeroConfNotGranted(t *testing.T) {
+	t.Parallel()
+
+	// Only Alice trusts Bob, so she asks for a zero-conf channel, while
+	// Bob requires confirmations.
+	alice, bob := setupFundingManagers(t, func(cfg *fundingConfig) {
+		cfg.ZeroConfPeers = map[[33]byte]struct{}{
+			newSerializedKey(bobPubKey): {},
+		}
+	})
+	defer tearDownFundingManagers(t, alice, bob)
+
+	_, pendingChanID := openZeroConfChannel(t, alice, bob)
+	assertFundingMsgSent(t, alice.msgChan, "FundingCreated")
+
+	resCtx, err := alice.fundingMgr.getReservationCtx(
+		bobPubKey, pendingChanID,
+	)
+	if err != nil {
+		t.Fatalf("unable to find reservation: %v", err)
+	}
+	if resCtx.reservation.ZeroConfAlias() != (lnwire.ShortChannelID{}) {
+		t.Fatalf("expected no zero-conf alias")
+	}
+}
+
+// TestFundingManagerZeroConfAlias tests that the alias of a zero-conf channel
+// is drawn again as long as it is in use, and that we give up after a number
+// of attempts.
+func TestFundingManagerZeroConfAlias(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// The first aliases that are drawn are already in use.
+	var rejected []lnwire.ShortChannelID
+	alice.fundingMgr.cfg.ShortChanIDInUse = func(
+		alias lnwire.ShortChannelID) bool {
+
+		if len(rejected) < 3 {
+			rejected = append(rejected, alias)
+			return true
+		}
+		return false
+	}
+
+	alias, err := alice.fundingMgr.newZeroConfAlias()
+	if err != nil {
+		t.Fatalf("unable to get alias: %v", err)
+	}
+	if len(rejected) != 3 {
+		t.Fatalf("expected 3 rejected aliases, got %v", len(rejected))
+	}
+	for _, rejectedAlias := range rejected {
+		if alias == rejectedAlias {
+			t.Fatalf("alias %v is in use", alias)
+		}
+	}
+	if alias.BlockHeight < minZeroConfAliasHeight ||
+		alias.BlockHeight > maxZeroConfAliasHeight {
+
+		t.Fatalf("invalid alias %v", alias)
+	}
+
+	// If all aliases are in use, we give up.
+	alice.fundingMgr.cfg.ShortChanIDInUse = func(
+		lnwire.ShortChannelID) bool {
+
+		return true
+	}
+	if _, err := alice.fundingMgr.newZeroConfAlias(); err == nil {
+		t.Fatalf("expected alias to be unavailable")
+	}
+}
+
+// TestFundingManagerAcceptorParams tests that the reason of a channel acceptor
+// for rejecting a channel is sent to the initiator, and that the parameters it
+// overrides are required from the initiator in the AcceptChannel message.
+func TestFundingManagerAcceptorParams(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	var acceptResp *chanacceptor.ChannelAcceptResponse
+	acceptFunc := func(
+		_ *chanacceptor.ChannelAcceptRequest,
+	) *chanacceptor.ChannelAcceptResponse {
+
+		return acceptResp
+	}
+	predicate := bob.fundingMgr.cfg.OpenChannelPredicate
+	predicate.(*chanacceptor.ChainedAcceptor).AddAcceptor(
+		chanacceptor.NewRPCAcceptor(acceptFunc),
+	)
+
+	// openChannel makes Alice send an OpenChannel message to Bob, which he
+	// handles.
+	openChannel := func() {
+		initReq := &openChanReq{
+			targetPubkey:    bob.privKey.PubKey(),
+			chainHash:       *activeNetParams.GenesisHash,
+			localFundingAmt: 500000,
+			private:         true,
+			updates:         make(chan *lnrpc.OpenStatusUpdate),
+			err:             make(chan error, 1),
+		}
+		alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+		openChannelReq := assertFundingMsgSent(
+			t, alice.msgChan, "OpenChannel",
+		).(*lnwire.OpenChannel)
+		bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	}
+
+	// Bob rejects the channel, giving a reason that is sent to Alice.
+	acceptResp = &chanacceptor.ChannelAcceptResponse{
+		Error: "channel too small",
+	}
+	openChannel()
+	errMsg := assertFundingMsgSent(t, bob.msgChan, "Error").(*lnwire.Error)
+	if string(errMsg.Data) != "channel too small" {
+		t.Fatalf("expected rejection reason, got \"%v\"",
+			string(errMsg.Data))
+	}
+
+	// Parameters that aren't sound are rejected, and the reason is sent
+	// to Alice. The minimum htlc is bounded by the value that Alice may
+	// have in flight, which is below the capacity of the channel.
+	maxValue := bob.fundingMgr.cfg.RequiredRemoteMaxValue(500000)
+	invalidResps := []struct {
+		resp *chanacceptor.ChannelAcceptResponse
+		err  error
+	}{
+		{
+			resp: &chanacceptor.ChannelAcceptResponse{
+				Accept:  true,
+				Reserve: 100,
+			},
+			err: lnwallet.ErrChanReserveTooSmall(
+				100, lnwallet.DefaultDustLimit(),
+			),
+		},
+		{
+			resp: &chanacceptor.ChannelAcceptResponse{
+				Accept:   true,
+				CSVDelay: maxBtcRemoteDelay + 1,
+			},
+			err: lnwallet.ErrCsvDelayTooLarge(
+				maxBtcRemoteDelay+1, maxBtcRemoteDelay,
+			),
+		},
+		{
+			resp: &chanacceptor.ChannelAcceptResponse{
+				Accept:  true,
+				MinHTLC: maxValue,
+			},
+			err: lnwallet.ErrMinHtlcTooLarge(maxValue, maxValue-1),
+		},
+	}
+	for _, invalid := range invalidResps {
+		acceptResp = invalid.resp
+		openChannel()
+		errMsg = assertFundingMsgSent(
+			t, bob.msgChan, "Error",
+		).(*lnwire.Error)
+
+		expected := "invalid channel parameters: " +
+			invalid.err.Error()
+		if string(errMsg.Data) != expected {
+			t.Fatalf("expected error \"%v\", got \"%v\"",
+				expected, string(errMsg.Data))
+		}
+	}
+
+	// Bob accepts the channel with his own parameters, which he requires
+	// from Alice instead of his defaults.
+	acceptResp = &chanacceptor.ChannelAcceptResponse{
+		Accept:         true,
+		CSVDelay:       200,
+		Reserve:        20000,
+		MaxHTLCs:       20,
+		MinHTLC:        3000,
+		MinAcceptDepth: 5,
+	}
+	openChannel()
+	acceptChannel := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+
+	if acceptChannel.CsvDelay != 200 {
+		t.Fatalf("expected csv delay 200, got %v",
+			acceptChannel.CsvDelay)
+	}
+	if acceptChannel.ChannelReserve != 20000 {
+		t.Fatalf("expected reserve 20000, got %v",
+			acceptChannel.ChannelReserve)
+	}
+	if acceptChannel.MaxAcceptedHTLCs != 20 {
+		t.Fatalf("expected max htlcs 20, got %v",
+			acceptChannel.MaxAcceptedHTLCs)
+	}
+	if acceptChannel.HtlcMinimum != 3000 {
+		t.Fatalf("expected min htlc 3000, got %v",
+			acceptChannel.HtlcMinimum)
+	}
+	if acceptChannel.MinAcceptDepth != 5 {
+		t.Fatalf("expected min accept depth 5, got %v",
+			acceptChannel.MinAcceptDepth)
+	}
+}
@@ -10,16 +10,13 @@ import (
 
 	sphinx "github.com/BTCGPU/lightning-onion"
 	"github.com/BTCGPU/lnd/chainntnfs"
-	"github.com/coreos/bbolt"
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 )
 
 const (
 	// defaultDbDirectory is the default directory where our decayed log
 	// will store our (sharedHash, CLTV) key-value pairs.
 	defaultDbDirectory = "sharedhashes"
-
-	// dbPermissions sets the database permissions to user write-and-readable.
-	dbPermissions = 0600
 )
 
 var (
@@ -56,7 +53,7 @@ type DecayedLog struct {
 
 	dbPath string
 
-	db *bbolt.DB
+	db kvdb.Backend
 
 	notifier chainntnfs.ChainNotifier
 
@@ -92,7 +89,8 @@ func (d *DecayedLog) Start() error {
 
 	// Open the boltdb for use.
 	var err error
-	if d.db, err = bbolt.Open(d.dbPath, dbPermissions, nil); err != nil {
+	d.db, err = kvdb.Create(kvdb.BoltBackendName, d.dbPath)
+	if err != nil {
 		return fmt.Errorf("Could not open boltdb: %v", err)
 	}
 
@@ -119,7 +117,7 @@ func (d *DecayedLog) Start() error {
 // initBuckets initializes the primary buckets used by the decayed log, namely
 // the shared hash bucket, and batch replay
 func (d *DecayedLog) initBuckets() error {
-	return d.db.Update(func(tx *bbolt.Tx) error {
+	return d.db.Update(func(tx kvdb.Tx) error {
 		_, err := tx.CreateBucketIfNotExists(sharedHashBucket)
 		if err != nil {
 			return ErrDecayedLogInit
@@ -196,7 +194,7 @@ func (d *DecayedLog) garbageCollector(epochClient *chainntnfs.BlockEpochEvent) {
 func (d *DecayedLog) gcExpiredHashes(height uint32) (uint32, error) {
 	var numExpiredHashes uint32
 
-	err := d.db.Batch(func(tx *bbolt.Tx) error {
+	err := d.db.Batch(func(tx kvdb.Tx) error {
 		numExpiredHashes = 0
 
 		// Grab the shared hash bucket
@@ -246,7 +244,7 @@ func (d *DecayedLog) gcExpiredHashes(height uint32) (uint32, error) {
 // Delete removes a <shared secret hash, CLTV> key-pair from the
 // sharedHashBucket.
 func (d *DecayedLog) Delete(hash *sphinx.HashPrefix) error {
-	return d.db.Batch(func(tx *bbolt.Tx) error {
+	return d.db.Batch(func(tx kvdb.Tx) error {
 		sharedHashes := tx.Bucket(sharedHashBucket)
 		if sharedHashes == nil {
 			return ErrDecayedLogCorrupted
@@ -261,7 +259,7 @@ func (d *DecayedLog) Delete(hash *sphinx.HashPrefix) error {
 func (d *DecayedLog) Get(hash *sphinx.HashPrefix) (uint32, error) {
 	var value uint32
 
-	err := d.db.View(func(tx *bbolt.Tx) error {
+	err := d.db.View(func(tx kvdb.Tx) error {
 		// Grab the shared hash bucket which stores the mapping from
 		// truncated sha-256 hashes of shared secrets to CLTV's.
 		sharedHashes := tx.Bucket(sharedHashBucket)
@@ -294,7 +292,7 @@ func (d *DecayedLog) Put(hash *sphinx.HashPrefix, cltv uint32) error {
 	var scratch [4]byte
 	binary.BigEndian.PutUint32(scratch[:], cltv)
 
-	return d.db.Batch(func(tx *bbolt.Tx) error {
+	return d.db.Batch(func(tx kvdb.Tx) error {
 		sharedHashes := tx.Bucket(sharedHashBucket)
 		if sharedHashes == nil {
 			return ErrDecayedLogCorrupted
@@ -327,7 +325,7 @@ func (d *DecayedLog) PutBatch(b *sphinx.Batch) (*sphinx.ReplaySet, error) {
 	// to generate the complete replay set. If this batch was previously
 	// processed, the replay set will be deserialized from disk.
 	var replays *sphinx.ReplaySet
-	if err := d.db.Batch(func(tx *bbolt.Tx) error {
+	if err := d.db.Batch(func(tx kvdb.Tx) error {
 		sharedHashes := tx.Bucket(sharedHashBucket)
 		if sharedHashes == nil {
 			return ErrDecayedLogCorrupted
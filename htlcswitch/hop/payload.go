@@ -6,6 +6,7 @@ import (
 	"io"
 
 	sphinx "github.com/BTCGPU/lightning-onion"
+	"github.com/BTCGPU/lnd/lntypes"
 	"github.com/BTCGPU/lnd/lnwire"
 	"github.com/BTCGPU/lnd/record"
 	"github.com/BTCGPU/lnd/tlv"
@@ -50,6 +51,19 @@ type Payload struct {
 	// FwdInfo holds the basic parameters required for HTLC forwarding, e.g.
 	// amount, cltv, and next hop.
 	FwdInfo ForwardingInfo
+
+	// MPP holds the info provided in an option_mpp record when parsed from
+	// a TLV onion payload.
+	MPP *record.MPP
+
+	// KeySend holds the preimage provided in a key send record when parsed
+	// from a TLV onion payload. It allows the final hop to settle a
+	// spontaneous payment for which no invoice was created.
+	KeySend *lntypes.Preimage
+
+	// customRecords are user-defined records in the custom type range that
+	// were included in the payload.
+	customRecords record.CustomSet
 }
 
 // NewLegacyPayload builds a Payload from the amount, cltv, and next hop
@@ -71,25 +85,35 @@ func NewLegacyPayload(f *sphinx.HopData) *Payload {
 // should correspond to the bytes encapsulated in a TLV onion payload.
 func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 	var (
-		cid  uint64
-		amt  uint64
-		cltv uint32
+		cid     uint64
+		amt     uint64
+		cltv    uint32
+		mpp     = &record.MPP{}
+		keySend [32]byte
 	)
 
 	tlvStream, err := tlv.NewStream(
 		record.NewAmtToFwdRecord(&amt),
 		record.NewLockTimeRecord(&cltv),
 		record.NewNextHopIDRecord(&cid),
+		mpp.Record(),
+		record.NewKeySendRecord(&keySend),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	parsedTypes, err := tlvStream.DecodeWithParsedTypes(r)
+	parsedTypes, err := tlvStream.DecodeWithParsedTypesLenient(r)
 	if err != nil {
 		return nil, err
 	}
 
+	// We are required to understand all even types outside of the custom
+	// range, as those aren't passed on to a higher level application.
+	if err := CheckRequiredTypes(parsedTypes); err != nil {
+		return nil, err
+	}
+
 	nextHop := lnwire.NewShortChanIDFromInt(cid)
 
 	// Validate whether the sender properly included or omitted tlv records
@@ -99,6 +123,22 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		return nil, err
 	}
 
+	// If no MPP field was parsed, set the MPP field on the resulting
+	// payload to nil.
+	if _, ok := parsedTypes[record.MPPOnionType]; !ok {
+		mpp = nil
+	}
+
+	// Only set the key send preimage if the record was included.
+	var preimage *lntypes.Preimage
+	if _, ok := parsedTypes[record.KeySendType]; ok {
+		p := lntypes.Preimage(keySend)
+		preimage = &p
+	}
+
+	// Filter out the custom records.
+	customRecords := NewCustomRecords(parsedTypes)
+
 	return &Payload{
 		FwdInfo: ForwardingInfo{
 			Network:         BitcoinNetwork,
@@ -106,20 +146,91 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 			AmountToForward: lnwire.MilliSatoshi(amt),
 			OutgoingCTLV:    cltv,
 		},
+		MPP:           mpp,
+		KeySend:       preimage,
+		customRecords: customRecords,
 	}, nil
 }
 
+// NewCustomRecords filters the types parsed from the tlv stream for custom
+// records.
+func NewCustomRecords(parsedTypes tlv.TypeMap) record.CustomSet {
+	customRecords := make(record.CustomSet)
+	for t, parseResult := range parsedTypes {
+		if parseResult == nil || t < record.CustomTypeStart {
+			continue
+		}
+		customRecords[uint64(t)] = parseResult
+	}
+	return customRecords
+}
+
+// CheckRequiredTypes returns an ErrUnknownRequiredType error if the types
+// parsed from a tlv stream that was decoded leniently contain an unknown even
+// type outside of the custom range. Even custom records are accepted, as it is
+// up to a higher level application to interpret them.
+func CheckRequiredTypes(parsedTypes tlv.TypeMap) error {
+	if t := minUnknownRequiredType(parsedTypes); t != nil {
+		return tlv.ErrUnknownRequiredType(*t)
+	}
+
+	return nil
+}
+
+// minUnknownRequiredType returns the lowest even type outside of the custom
+// range that was parsed from the tlv stream, but isn't known to us. Returning
+// the lowest type keeps the resulting failure deterministic. Nil is returned
+// if there is no such type.
+func minUnknownRequiredType(parsedTypes tlv.TypeMap) *tlv.Type {
+	var minType *tlv.Type
+	for t, parseResult := range parsedTypes {
+		// Known types have no value in the map, and custom records are
+		// always accepted, as a higher level application may
+		// understand them.
+		if parseResult == nil || t%2 != 0 ||
+			t >= record.CustomTypeStart {
+
+			continue
+		}
+
+		if minType == nil || t < *minType {
+			t := t
+			minType = &t
+		}
+	}
+
+	return minType
+}
+
 // ForwardingInfo returns the basic parameters required for HTLC forwarding,
 // e.g. amount, cltv, and next hop.
 func (h *Payload) ForwardingInfo() ForwardingInfo {
 	return h.FwdInfo
 }
 
+// MultiPath returns the record corresponding the option_mpp parsed from the
+// onion payload.
+func (h *Payload) MultiPath() *record.MPP {
+	return h.MPP
+}
+
+// KeySendPreimage returns the preimage of a spontaneous key send payment
+// parsed from the onion payload, or nil if none was provided.
+func (h *Payload) KeySendPreimage() *lntypes.Preimage {
+	return h.KeySend
+}
+
+// CustomRecords returns the custom tlv type records that were parsed from the
+// payload.
+func (h *Payload) CustomRecords() record.CustomSet {
+	return h.customRecords
+}
+
 // ValidateParsedPayloadTypes checks the types parsed from a hop payload to
 // ensure that the proper fields are either included or omitted. The finalHop
 // boolean should be true if the payload was parsed for an exit hop. The
 // requirements for this method are described in BOLT 04.
-func ValidateParsedPayloadTypes(parsedTypes tlv.TypeSet,
+func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
 	nextHop lnwire.ShortChannelID) error {
 
 	isFinalHop := nextHop == Exit
@@ -127,6 +238,8 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeSet,
 	_, hasAmt := parsedTypes[record.AmtOnionType]
 	_, hasLockTime := parsedTypes[record.LockTimeOnionType]
 	_, hasNextHop := parsedTypes[record.NextHopOnionType]
+	_, hasMPP := parsedTypes[record.MPPOnionType]
+	_, hasKeySend := parsedTypes[record.KeySendType]
 
 	switch {
 
@@ -155,6 +268,22 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeSet,
 			Omitted:  false,
 			FinalHop: true,
 		}
+
+	// Intermediate nodes should never receive MPP fields.
+	case !isFinalHop && hasMPP:
+		return ErrInvalidPayload{
+			Type:     record.MPPOnionType,
+			Omitted:  false,
+			FinalHop: isFinalHop,
+		}
+
+	// Likewise, a key send preimage is only meant for the final hop.
+	case !isFinalHop && hasKeySend:
+		return ErrInvalidPayload{
+			Type:     record.KeySendType,
+			Omitted:  false,
+			FinalHop: isFinalHop,
+		}
 	}
 
 	return nil
@@ -7,12 +7,16 @@ import (
 
 	"github.com/BTCGPU/lnd/htlcswitch/hop"
 	"github.com/BTCGPU/lnd/record"
+	"github.com/BTCGPU/lnd/tlv"
 )
 
 type decodePayloadTest struct {
-	name    string
-	payload []byte
-	expErr  error
+	name              string
+	payload           []byte
+	expErr            error
+	shouldHaveMPP     bool
+	shouldHaveKeySend bool
+	expCustomRecords  map[uint64][]byte
 }
 
 var decodePayloadTests = []decodePayloadTest{
@@ -67,6 +71,134 @@ var decodePayloadTests = []decodePayloadTest{
 			FinalHop: true,
 		},
 	},
+	{
+		name: "intermediate hop with mpp",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// next hop id
+			0x06, 0x08,
+			0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			// mpp
+			0x08, 0x21,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x08,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:     record.MPPOnionType,
+			Omitted:  false,
+			FinalHop: false,
+		},
+	},
+	{
+		name: "valid final hop with mpp",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// mpp
+			0x08, 0x21,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x08,
+		},
+		expErr:        nil,
+		shouldHaveMPP: true,
+	},
+	{
+		name: "intermediate hop with key send",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// next hop id
+			0x06, 0x08,
+			0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			// key send
+			0xff, 0x00, 0x00, 0x00, 0x01, 0x46, 0xc6, 0x61, 0x6c,
+			0x20,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:     record.KeySendType,
+			Omitted:  false,
+			FinalHop: false,
+		},
+	},
+	{
+		name: "valid final hop with key send",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// key send
+			0xff, 0x00, 0x00, 0x00, 0x01, 0x46, 0xc6, 0x61, 0x6c,
+			0x20,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+		},
+		expErr:            nil,
+		shouldHaveKeySend: true,
+	},
+	{
+		name: "valid final hop with custom records",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// unknown odd type below the custom range
+			0x0b, 0x01, 0x01,
+			// custom record
+			0xfe, 0x00, 0x01, 0x00, 0x01, 0x02, 0x10, 0x11,
+		},
+		expErr: nil,
+		expCustomRecords: map[uint64][]byte{
+			65537: {0x10, 0x11},
+		},
+	},
+	{
+		name: "final hop with unknown required type",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// unknown even type below the custom range
+			0x0a, 0x01, 0x01,
+		},
+		expErr: tlv.ErrUnknownRequiredType(0x0a),
+	},
+	{
+		name: "valid final hop with even custom record",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// custom record
+			0xfe, 0x00, 0x01, 0x00, 0x00, 0x01, 0x10,
+		},
+		expErr: nil,
+		expCustomRecords: map[uint64][]byte{
+			65536: {0x10},
+		},
+	},
 }
 
 // TestDecodeHopPayloadRecordValidation asserts that parsing the payloads in the
@@ -81,9 +213,52 @@ func TestDecodeHopPayloadRecordValidation(t *testing.T) {
 }
 
 func testDecodeHopPayloadValidation(t *testing.T, test decodePayloadTest) {
-	_, err := hop.NewPayloadFromReader(bytes.NewReader(test.payload))
+	p, err := hop.NewPayloadFromReader(bytes.NewReader(test.payload))
 	if !reflect.DeepEqual(test.expErr, err) {
 		t.Fatalf("expected error mismatch, want: %v, got: %v",
 			test.expErr, err)
 	}
+	if err != nil {
+		return
+	}
+
+	// Assert MPP fields if we expect them.
+	if test.shouldHaveMPP {
+		if p.MPP == nil {
+			t.Fatalf("payload should have MPP record")
+		}
+		if p.MPP.TotalMsat() != 8 {
+			t.Fatalf("invalid total msat")
+		}
+		if p.MPP.PaymentAddr() != [32]byte{} {
+			t.Fatalf("invalid payment addr")
+		}
+	} else if p.MPP != nil {
+		t.Fatalf("unexpected MPP payload")
+	}
+
+	// Assert the key send preimage if we expect it.
+	if test.shouldHaveKeySend {
+		if p.KeySend == nil {
+			t.Fatalf("payload should have key send record")
+		}
+		if p.KeySend[31] != 0x01 {
+			t.Fatalf("invalid key send preimage")
+		}
+	} else if p.KeySend != nil {
+		t.Fatalf("unexpected key send payload")
+	}
+
+	// Only records in the custom range should have been kept.
+	customRecords := p.CustomRecords()
+	if len(customRecords) != len(test.expCustomRecords) {
+		t.Fatalf("expected %v custom records, got %v",
+			len(test.expCustomRecords), len(customRecords))
+	}
+	for typ, value := range test.expCustomRecords {
+		if !bytes.Equal(customRecords[typ], value) {
+			t.Fatalf("custom record %v mismatch, want: %x, got: %x",
+				typ, value, customRecords[typ])
+		}
+	}
 }
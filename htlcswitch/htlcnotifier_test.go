@@ -0,0 +1,172 @@
+package htlcswitch
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/htlcswitch/hop"
+	"github.com/BTCGPU/lnd/lnwire"
+)
+
+// TestHtlcNotifier tests that the htlc notifier delivers all types of htlc
+// events to its subscribers.
+func TestHtlcNotifier(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	notifier := NewHtlcNotifier(func() time.Time {
+		return now
+	})
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start htlc notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	client, err := notifier.SubscribeHtlcEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer client.Cancel()
+
+	key := HtlcKey{
+		IncomingCircuit: channeldb.CircuitKey{
+			ChanID: lnwire.NewShortChanIDFromInt(1),
+			HtlcID: 2,
+		},
+		OutgoingCircuit: channeldb.CircuitKey{
+			ChanID: lnwire.NewShortChanIDFromInt(3),
+			HtlcID: 4,
+		},
+	}
+	info := HtlcInfo{
+		IncomingTimeLock: 100,
+		OutgoingTimeLock: 60,
+		IncomingAmt:      1100,
+		OutgoingAmt:      1000,
+	}
+
+	notifier.NotifyForwardingEvent(key, info, HtlcEventTypeForward)
+	notifier.NotifyLinkFailEvent(
+		key, info, HtlcEventTypeForward,
+		lnwire.CodeTemporaryChannelFailure, "link failed", false,
+	)
+	notifier.NotifyForwardingFailEvent(key, HtlcEventTypeForward)
+	notifier.NotifySettleEvent(key, HtlcEventTypeForward)
+
+	expectedEvents := []interface{}{
+		&ForwardingEvent{
+			HtlcKey:       key,
+			HtlcInfo:      info,
+			HtlcEventType: HtlcEventTypeForward,
+			Timestamp:     now,
+		},
+		&LinkFailEvent{
+			HtlcKey:       key,
+			HtlcInfo:      info,
+			HtlcEventType: HtlcEventTypeForward,
+			FailureCode:   lnwire.CodeTemporaryChannelFailure,
+			FailureDetail: "link failed",
+			Incoming:      false,
+			Timestamp:     now,
+		},
+		&ForwardingFailEvent{
+			HtlcKey:       key,
+			HtlcEventType: HtlcEventTypeForward,
+			Timestamp:     now,
+		},
+		&SettleEvent{
+			HtlcKey:       key,
+			HtlcEventType: HtlcEventTypeForward,
+			Timestamp:     now,
+		},
+	}
+
+	for _, expected := range expectedEvents {
+		select {
+		case event := <-client.Updates():
+			if !reflect.DeepEqual(event, expected) {
+				t.Fatalf("expected event: %v, got: %v",
+					expected, event)
+			}
+
+		case <-time.After(time.Second):
+			t.Fatalf("expected event: %v not received", expected)
+		}
+	}
+}
+
+// TestHtlcEventType tests that the event type of an htlc packet is derived
+// from its incoming and outgoing channels, and that the payment id of local
+// sends is not exposed in their htlc key.
+func TestHtlcEventType(t *testing.T) {
+	t.Parallel()
+
+	chanID := lnwire.NewShortChanIDFromInt(1)
+
+	tests := []struct {
+		name          string
+		pkt           *htlcPacket
+		expectedType  HtlcEventType
+		expectedInKey channeldb.CircuitKey
+	}{
+		{
+			name: "send",
+			pkt: &htlcPacket{
+				incomingChanID: hop.Source,
+				incomingHTLCID: 10,
+				outgoingChanID: chanID,
+			},
+			expectedType: HtlcEventTypeSend,
+			expectedInKey: channeldb.CircuitKey{
+				ChanID: hop.Source,
+			},
+		},
+		{
+			name: "receive",
+			pkt: &htlcPacket{
+				incomingChanID: chanID,
+				incomingHTLCID: 10,
+				outgoingChanID: hop.Exit,
+			},
+			expectedType: HtlcEventTypeReceive,
+			expectedInKey: channeldb.CircuitKey{
+				ChanID: chanID,
+				HtlcID: 10,
+			},
+		},
+		{
+			name: "forward",
+			pkt: &htlcPacket{
+				incomingChanID: chanID,
+				incomingHTLCID: 10,
+				outgoingChanID: lnwire.NewShortChanIDFromInt(2),
+			},
+			expectedType: HtlcEventTypeForward,
+			expectedInKey: channeldb.CircuitKey{
+				ChanID: chanID,
+				HtlcID: 10,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			eventType := getEventType(test.pkt)
+			if eventType != test.expectedType {
+				t.Fatalf("expected event type: %v, got: %v",
+					test.expectedType, eventType)
+			}
+
+			key := newHtlcKey(test.pkt)
+			if key.IncomingCircuit != test.expectedInKey {
+				t.Fatalf("expected incoming circuit: %v, "+
+					"got: %v", test.expectedInKey,
+					key.IncomingCircuit)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package htlcswitch
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/BTCGPU/lnd/lntypes"
+	"github.com/BTCGPU/lnd/lnwire"
+)
+
+// ErrFwdNotHeld is returned when an intercepted forward is resolved after it
+// was already resolved, or after its hold timeout expired.
+var ErrFwdNotHeld = errors.New("forward is not held")
+
+// InterceptedPacket contains the relevant information for the interceptor
+// about an htlc that is about to be forwarded.
+type InterceptedPacket struct {
+	// IncomingCircuit contains the incoming channel and htlc id of the
+	// packet.
+	IncomingCircuit CircuitKey
+
+	// OutgoingChanID is the channel the htlc was requested to be
+	// forwarded over.
+	OutgoingChanID lnwire.ShortChannelID
+
+	// Hash is the payment hash of the htlc.
+	Hash lntypes.Hash
+
+	// OutgoingExpiry is the absolute block height at which the outgoing
+	// htlc expires.
+	OutgoingExpiry uint32
+
+	// OutgoingAmount is the amount to forward.
+	OutgoingAmount lnwire.MilliSatoshi
+
+	// IncomingExpiry is the absolute block height at which the incoming
+	// htlc expires.
+	IncomingExpiry uint32
+
+	// IncomingAmount is the amount of the incoming htlc.
+	IncomingAmount lnwire.MilliSatoshi
+}
+
+// InterceptedForward is passed to the ForwardInterceptor for every htlc that
+// is about to be forwarded. It exposes the information about the htlc and
+// the means to resolve it once it has been held.
+type InterceptedForward interface {
+	// Packet returns the intercepted packet.
+	Packet() InterceptedPacket
+
+	// Resume notifies the switch to continue forwarding the htlc as if
+	// it was never intercepted.
+	Resume() error
+
+	// Settle settles the incoming htlc with the given preimage, without
+	// forwarding it.
+	Settle(lntypes.Preimage) error
+
+	// Fail fails the incoming htlc back to the sender, without
+	// forwarding it.
+	Fail() error
+}
+
+// ForwardInterceptor is a function that is invoked by the switch for every
+// htlc that is about to be forwarded. If it returns true, the htlc is held by
+// the switch until it is resolved through the InterceptedForward, or until
+// the hold timeout of the switch expires. The function is called from the
+// switch's main event loop, so it must not block.
+type ForwardInterceptor func(InterceptedForward) bool
+
+// interceptedForward implements the InterceptedForward interface for a
+// forwarded add packet that is held by the switch.
+type interceptedForward struct {
+	htlc       *lnwire.UpdateAddHTLC
+	packet     *htlcPacket
+	htlcSwitch *Switch
+
+	// timeout fails the forward back once the hold timeout expires.
+	timeout *time.Timer
+}
+
+// A compile time check to ensure interceptedForward implements the
+// InterceptedForward interface.
+var _ InterceptedForward = (*interceptedForward)(nil)
+
+// Packet returns the intercepted htlc packet.
+func (f *interceptedForward) Packet() InterceptedPacket {
+	return InterceptedPacket{
+		IncomingCircuit: f.packet.inKey(),
+		OutgoingChanID:  f.packet.outgoingChanID,
+		Hash:            f.htlc.PaymentHash,
+		OutgoingExpiry:  f.packet.outgoingTimeout,
+		OutgoingAmount:  f.packet.amount,
+		IncomingExpiry:  f.packet.incomingTimeout,
+		IncomingAmount:  f.packet.incomingAmount,
+	}
+}
+
+// Resume sends the held packet back through the switch, which forwards it
+// without consulting the interceptor again. The packet is routed
+// asynchronously, so that the caller doesn't block on the switch while it may
+// be offering another packet to the interceptor.
+func (f *interceptedForward) Resume() error {
+	if !f.htlcSwitch.releaseHeldForward(f) {
+		return ErrFwdNotHeld
+	}
+
+	go func() {
+		err := f.htlcSwitch.route(f.packet)
+		if err != nil {
+			log.Debugf("Unable to forward resumed htlc %v: %v",
+				f.packet.inKey(), err)
+		}
+	}()
+
+	return nil
+}
+
+// Fail fails the incoming htlc back with a temporary channel failure.
+func (f *interceptedForward) Fail() error {
+	if !f.htlcSwitch.releaseHeldForward(f) {
+		return ErrFwdNotHeld
+	}
+
+	return f.fail()
+}
+
+// fail fails the incoming htlc back without checking whether it is still
+// held.
+func (f *interceptedForward) fail() error {
+	reason, err := f.packet.obfuscator.EncryptFirstHop(
+		lnwire.NewTemporaryChannelFailure(nil),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to encrypt failure reason: %v", err)
+	}
+
+	return f.resolve(&lnwire.UpdateFailHTLC{
+		Reason: reason,
+	})
+}
+
+// Settle settles the incoming htlc with the given preimage, which must match
+// the payment hash of the htlc.
+func (f *interceptedForward) Settle(preimage lntypes.Preimage) error {
+	if !preimage.Matches(f.htlc.PaymentHash) {
+		return fmt.Errorf("preimage %v does not match payment hash %v",
+			preimage, f.htlc.PaymentHash)
+	}
+
+	if !f.htlcSwitch.releaseHeldForward(f) {
+		return ErrFwdNotHeld
+	}
+
+	return f.resolve(&lnwire.UpdateFulfillHTLC{
+		PaymentPreimage: preimage,
+	})
+}
+
+// resolve delivers the given settle or fail message to the incoming link of
+// the held packet. The link will then remove the htlc from the incoming
+// channel and clean up the circuit, as it does for any other resolution that
+// originates from the switch.
+func (f *interceptedForward) resolve(message lnwire.Message) error {
+	pkt := &htlcPacket{
+		incomingChanID: f.packet.incomingChanID,
+		incomingHTLCID: f.packet.incomingHTLCID,
+		outgoingChanID: f.packet.outgoingChanID,
+		sourceRef:      f.packet.sourceRef,
+		circuit:        f.packet.circuit,
+		obfuscator:     f.packet.obfuscator,
+		htlc:           message,
+		hasSource:      true,
+		isResolution:   true,
+	}
+
+	return f.htlcSwitch.mailOrchestrator.Deliver(pkt.incomingChanID, pkt)
+}
@@ -21,13 +21,14 @@ type InvoiceDatabase interface {
 	// invoice is a debug invoice, then this method is a noop as debug
 	// invoices are never fully settled. The return value describes how the
 	// htlc should be resolved. If the htlc cannot be resolved immediately,
-	// the resolution is sent on the passed in hodlChan later. The eob
-	// field passes the entire onion hop payload into the invoice registry
-	// for decoding purposes.
+	// the resolution is sent on the passed in hodlChan later. The payload
+	// passes the decoded onion hop payload into the invoice registry so
+	// that any additional records, such as the mpp record, can be
+	// inspected.
 	NotifyExitHopHtlc(payHash lntypes.Hash, paidAmount lnwire.MilliSatoshi,
 		expiry uint32, currentHeight int32,
 		circuitKey channeldb.CircuitKey, hodlChan chan<- interface{},
-		eob []byte) (*invoices.HodlEvent, error)
+		payload invoices.Payload) (*invoices.HodlEvent, error)
 
 	// CancelInvoice attempts to cancel the invoice corresponding to the
 	// passed payment hash.
@@ -95,6 +96,12 @@ type ChannelLink interface {
 	// transaction changes location within the chain.
 	UpdateShortChanID() (lnwire.ShortChannelID, error)
 
+	// ZeroConfAlias returns the alias short channel ID that identified
+	// the link before its funding transaction confirmed, if the channel
+	// is a zero-conf channel. Otherwise, the zero short channel ID is
+	// returned.
+	ZeroConfAlias() lnwire.ShortChannelID
+
 	// UpdateForwardingPolicy updates the forwarding policy for the target
 	// ChannelLink. Once updated, the link will use the new forwarding
 	// policy to govern if it an incoming HTLC should be forwarded or not.
@@ -180,3 +187,36 @@ type TowerClient interface {
 	// isTweakless should be true.
 	BackupState(*lnwire.ChannelID, *lnwallet.BreachRetribution, bool) error
 }
+
+// htlcNotifier is an interface which represents the input side of the
+// HtlcNotifier which htlc events are piped through. This interface is intended
+// to allow for mocking of the htlcNotifier in tests, so is unexported because
+// it is not needed outside of the htlcSwitch package.
+type htlcNotifier interface {
+	// NotifyForwardingEvent notifies the HtlcNotifier than a htlc has been
+	// forwarded.
+	NotifyForwardingEvent(key HtlcKey, info HtlcInfo,
+		eventType HtlcEventType)
+
+	// NotifyLinkFailEvent notifies that a htlc has failed on our
+	// incoming or outgoing link. The incoming bool is true for failures on
+	// incoming links, and false for failures on outgoing links.
+	NotifyLinkFailEvent(key HtlcKey, info HtlcInfo,
+		eventType HtlcEventType, failureCode lnwire.FailCode,
+		failureDetail string, incoming bool)
+
+	// NotifyForwardingFailEvent notifies the HtlcNotifier that a htlc we
+	// forwarded has failed down the line.
+	NotifyForwardingFailEvent(key HtlcKey, eventType HtlcEventType)
+
+	// NotifySettleEvent notifies the HtlcNotifier that a htlc that we
+	// committed to as part of a forward or a receive to our node has been
+	// settled.
+	NotifySettleEvent(key HtlcKey, eventType HtlcEventType)
+}
+
+// InterceptableHtlcForwarder is the interface to set the interceptor.
+type InterceptableHtlcForwarder interface {
+	// SetInterceptor sets a ForwardInterceptor.
+	SetInterceptor(interceptor ForwardInterceptor)
+}
@@ -271,6 +271,10 @@ type ChannelLinkConfig struct {
 	// NotifyInactiveChannel allows the switch to tell the ChannelNotifier
 	// when channels become inactive.
 	NotifyInactiveChannel func(wire.OutPoint)
+
+	// HtlcNotifier is an instance of a htlcNotifier which we will pipe htlc
+	// events through.
+	HtlcNotifier htlcNotifier
 }
 
 // channelLink is the service which drives a channel's commitment update
@@ -1239,10 +1243,7 @@ func (l *channelLink) processHodlEvent(hodlEvent invoices.HodlEvent,
 		htlc.pd.Amount, uint32(hodlEvent.AcceptHeight),
 	)
 
-	l.sendHTLCError(
-		htlc.pd.HtlcIndex, failure, htlc.obfuscator,
-		htlc.pd.SourceRef,
-	)
+	l.sendHTLCError(htlc.pd, failure, htlc.obfuscator, true)
 	return nil
 }
 
@@ -1301,6 +1302,10 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 			default:
 				l.warnf("Unable to handle downstream add HTLC: %v", err)
 
+				// Keep the reason we were unable to add the htlc,
+				// so that we can report it as a link failure.
+				failureDetail := err.Error()
+
 				var (
 					localFailure = false
 					reason       lnwire.OpaqueReason
@@ -1340,6 +1345,14 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 					}
 				}
 
+				// Notify the htlc notifier that we were unable
+				// to add the htlc to our outgoing channel.
+				l.cfg.HtlcNotifier.NotifyLinkFailEvent(
+					newHtlcKey(pkt), newHtlcInfo(pkt),
+					getEventType(pkt), failure.Code(),
+					failureDetail, false,
+				)
+
 				failPkt := &htlcPacket{
 					incomingChanID: pkt.incomingChanID,
 					incomingHTLCID: pkt.incomingHTLCID,
@@ -1385,6 +1398,12 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 
 		l.cfg.Peer.SendMessage(false, htlc)
 
+		// Now that the htlc has been added to our outgoing channel,
+		// we'll notify the htlc notifier of the forward.
+		l.cfg.HtlcNotifier.NotifyForwardingEvent(
+			newHtlcKey(pkt), newHtlcInfo(pkt), getEventType(pkt),
+		)
+
 	case *lnwire.UpdateFulfillHTLC:
 		// If hodl.SettleOutgoing mode is active, we exit early to
 		// simulate arbitrary delays between the switch adding the
@@ -1832,8 +1851,11 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 		}
 
 		// If we have a tower client, we'll proceed in backing up the
-		// state that was just revoked.
-		if l.cfg.TowerClient != nil {
+		// state that was just revoked. Towers don't yet know how to
+		// construct justice transactions for anchor channels, so
+		// those are skipped.
+		chanType := l.channel.State().ChanType
+		if l.cfg.TowerClient != nil && !chanType.HasAnchors() {
 			state := l.channel.State()
 			breachInfo, err := lnwallet.NewBreachRetribution(
 				state, state.RemoteCommitment.CommitHeight-1, 0,
@@ -1844,7 +1866,6 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 				return
 			}
 
-			chanType := l.channel.State().ChanType
 			isTweakless := chanType == channeldb.SingleFunderTweakless
 
 			chanID := l.ChanID()
@@ -2070,6 +2091,7 @@ func (l *channelLink) ShortChanID() lnwire.ShortChannelID {
 // NOTE: Part of the ChannelLink interface.
 func (l *channelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	chanID := l.ChanID()
+	oldSid := l.ShortChanID()
 
 	// Refresh the channel state's short channel ID by loading it from disk.
 	// This ensures that the channel state accurately reflects the updated
@@ -2101,13 +2123,25 @@ func (l *channelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	}()
 
 	// Now that the short channel ID has been properly updated, we can begin
-	// garbage collecting any forwarding packages we create.
-	l.wg.Add(1)
-	go l.fwdPkgGarbager()
+	// garbage collecting any forwarding packages we create. Links of
+	// zero-conf channels were live before, so they already do.
+	if oldSid == hop.Source {
+		l.wg.Add(1)
+		go l.fwdPkgGarbager()
+	}
 
 	return sid, nil
 }
 
+// ZeroConfAlias returns the alias short channel ID that identified the link
+// before its funding transaction confirmed, if the channel is a zero-conf
+// channel. Otherwise, the zero short channel ID is returned.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) ZeroConfAlias() lnwire.ShortChannelID {
+	return l.channel.State().ZeroConfAlias
+}
+
 // ChanID returns the channel ID for the channel link. The channel ID is a more
 // compact representation of a channel's full outpoint.
 //
@@ -2615,8 +2649,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// If we're unable to process the onion blob than we
 			// should send the malformed htlc error to payment
 			// sender.
-			l.sendMalformedHTLCError(pd.HtlcIndex, failureCode,
-				onionBlob[:], pd.SourceRef)
+			l.sendMalformedHTLCError(pd, failureCode, onionBlob[:])
 			needUpdate = true
 
 			log.Errorf("unable to decode onion hop "+
@@ -2633,9 +2666,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// If we're unable to process the onion blob than we
 			// should send the malformed htlc error to payment
 			// sender.
-			l.sendMalformedHTLCError(
-				pd.HtlcIndex, failureCode, onionBlob[:], pd.SourceRef,
-			)
+			l.sendMalformedHTLCError(pd, failureCode, onionBlob[:])
 			needUpdate = true
 
 			log.Errorf("unable to decode onion "+
@@ -2645,16 +2676,15 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 
 		heightNow := l.cfg.Switch.BestHeight()
 
-		fwdInfo, err := chanIterator.ForwardingInstructions()
+		pld, err := chanIterator.HopPayload()
 		if err != nil {
 			// If we're unable to process the onion payload, or we
 			// we received malformed TLV stream, then we should
 			// send an error back to the caller so the HTLC can be
 			// canceled.
 			l.sendHTLCError(
-				pd.HtlcIndex,
-				lnwire.NewInvalidOnionVersion(onionBlob[:]),
-				obfuscator, pd.SourceRef,
+				pd, lnwire.NewInvalidOnionVersion(onionBlob[:]),
+				obfuscator, false,
 			)
 			needUpdate = true
 
@@ -2663,11 +2693,12 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			continue
 		}
 
+		fwdInfo := pld.ForwardingInfo()
+
 		switch fwdInfo.NextHop {
 		case hop.Exit:
 			updated, err := l.processExitHop(
-				pd, obfuscator, fwdInfo, heightNow,
-				chanIterator.ExtraOnionBlob(),
+				pd, obfuscator, fwdInfo, heightNow, pld,
 			)
 			if err != nil {
 				l.fail(LinkFailureError{code: ErrInternalError},
@@ -2773,9 +2804,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					)
 				}
 
-				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
-				)
+				l.sendHTLCError(pd, failure, obfuscator, false)
 				needUpdate = true
 				continue
 			}
@@ -2840,7 +2869,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 // returns a boolean indicating whether the commitment tx needs an update.
 func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 	obfuscator hop.ErrorEncrypter, fwdInfo hop.ForwardingInfo,
-	heightNow uint32, eob []byte) (bool, error) {
+	heightNow uint32, payload invoices.Payload) (bool, error) {
 
 	// If hodl.ExitSettle is requested, we will not validate the final hop's
 	// ADD, nor will we settle the corresponding invoice or respond with the
@@ -2861,7 +2890,7 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 			pd.Amount, fwdInfo.AmountToForward)
 
 		failure := lnwire.NewFinalIncorrectHtlcAmount(pd.Amount)
-		l.sendHTLCError(pd.HtlcIndex, failure, obfuscator, pd.SourceRef)
+		l.sendHTLCError(pd, failure, obfuscator, true)
 
 		return true, nil
 	}
@@ -2874,7 +2903,7 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 			pd.RHash[:], pd.Timeout, fwdInfo.OutgoingCTLV)
 
 		failure := lnwire.NewFinalIncorrectCltvExpiry(pd.Timeout)
-		l.sendHTLCError(pd.HtlcIndex, failure, obfuscator, pd.SourceRef)
+		l.sendHTLCError(pd, failure, obfuscator, true)
 
 		return true, nil
 	}
@@ -2891,7 +2920,7 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 
 	event, err := l.cfg.Registry.NotifyExitHopHtlc(
 		invoiceHash, pd.Amount, pd.Timeout, int32(heightNow),
-		circuitKey, l.hodlQueue.ChanIn(), eob,
+		circuitKey, l.hodlQueue.ChanIn(), payload,
 	)
 
 	switch err {
@@ -2899,7 +2928,7 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 	// Cancel htlc if we don't have an invoice for it.
 	case channeldb.ErrInvoiceNotFound:
 		failure := lnwire.NewFailIncorrectDetails(pd.Amount, heightNow)
-		l.sendHTLCError(pd.HtlcIndex, failure, obfuscator, pd.SourceRef)
+		l.sendHTLCError(pd, failure, obfuscator, true)
 
 		return true, nil
 
@@ -2963,6 +2992,17 @@ func (l *channelLink) settleHTLC(preimage lntypes.Preimage, htlcIndex uint64,
 		PaymentPreimage: preimage,
 	})
 
+	// Once we have successfully settled the htlc, notify a settle event.
+	l.cfg.HtlcNotifier.NotifySettleEvent(
+		HtlcKey{
+			IncomingCircuit: channeldb.CircuitKey{
+				ChanID: l.ShortChanID(),
+				HtlcID: htlcIndex,
+			},
+		},
+		HtlcEventTypeReceive,
+	)
+
 	return nil
 }
 
@@ -3008,8 +3048,8 @@ func (l *channelLink) handleBatchFwdErrs(errChan chan error) {
 
 // sendHTLCError functions cancels HTLC and send cancel message back to the
 // peer from which HTLC was received.
-func (l *channelLink) sendHTLCError(htlcIndex uint64, failure lnwire.FailureMessage,
-	e hop.ErrorEncrypter, sourceRef *channeldb.AddRef) {
+func (l *channelLink) sendHTLCError(pd *lnwallet.PaymentDescriptor,
+	failure lnwire.FailureMessage, e hop.ErrorEncrypter, isReceive bool) {
 
 	reason, err := e.EncryptFirstHop(failure)
 	if err != nil {
@@ -3017,7 +3057,7 @@ func (l *channelLink) sendHTLCError(htlcIndex uint64, failure lnwire.FailureMess
 		return
 	}
 
-	err = l.channel.FailHTLC(htlcIndex, reason, sourceRef, nil, nil)
+	err = l.channel.FailHTLC(pd.HtlcIndex, reason, pd.SourceRef, nil, nil)
 	if err != nil {
 		log.Errorf("unable cancel htlc: %v", err)
 		return
@@ -3025,18 +3065,33 @@ func (l *channelLink) sendHTLCError(htlcIndex uint64, failure lnwire.FailureMess
 
 	l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailHTLC{
 		ChanID: l.ChanID(),
-		ID:     htlcIndex,
+		ID:     pd.HtlcIndex,
 		Reason: reason,
 	})
+
+	// Notify a link failure on our incoming link. Outgoing htlc information
+	// is not available at this point, because we have not added the htlc
+	// to an outgoing channel, so it is excluded.
+	eventType := HtlcEventTypeForward
+	if isReceive {
+		eventType = HtlcEventTypeReceive
+	}
+
+	l.cfg.HtlcNotifier.NotifyLinkFailEvent(
+		l.incomingHtlcKey(pd), l.incomingHtlcInfo(pd), eventType,
+		failure.Code(), failure.Error(), true,
+	)
 }
 
 // sendMalformedHTLCError helper function which sends the malformed HTLC update
 // to the payment sender.
-func (l *channelLink) sendMalformedHTLCError(htlcIndex uint64,
-	code lnwire.FailCode, onionBlob []byte, sourceRef *channeldb.AddRef) {
+func (l *channelLink) sendMalformedHTLCError(pd *lnwallet.PaymentDescriptor,
+	code lnwire.FailCode, onionBlob []byte) {
 
 	shaOnionBlob := sha256.Sum256(onionBlob)
-	err := l.channel.MalformedFailHTLC(htlcIndex, code, shaOnionBlob, sourceRef)
+	err := l.channel.MalformedFailHTLC(
+		pd.HtlcIndex, code, shaOnionBlob, pd.SourceRef,
+	)
 	if err != nil {
 		log.Errorf("unable cancel htlc: %v", err)
 		return
@@ -3044,10 +3099,42 @@ func (l *channelLink) sendMalformedHTLCError(htlcIndex uint64,
 
 	l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailMalformedHTLC{
 		ChanID:       l.ChanID(),
-		ID:           htlcIndex,
+		ID:           pd.HtlcIndex,
 		ShaOnionBlob: shaOnionBlob,
 		FailureCode:  code,
 	})
+
+	// Notify a link failure on our incoming link. As we were unable to
+	// decode the onion, we can't tell whether we were the final hop, so
+	// we'll report it as a forward.
+	l.cfg.HtlcNotifier.NotifyLinkFailEvent(
+		l.incomingHtlcKey(pd), l.incomingHtlcInfo(pd),
+		HtlcEventTypeForward, code, code.String(), true,
+	)
+}
+
+// incomingHtlcKey returns the htlc key of an htlc that was added to our
+// incoming channel, for which no outgoing htlc exists.
+func (l *channelLink) incomingHtlcKey(
+	pd *lnwallet.PaymentDescriptor) HtlcKey {
+
+	return HtlcKey{
+		IncomingCircuit: channeldb.CircuitKey{
+			ChanID: l.ShortChanID(),
+			HtlcID: pd.HtlcIndex,
+		},
+	}
+}
+
+// incomingHtlcInfo returns the htlc info of an htlc that was added to our
+// incoming channel, for which no outgoing htlc exists.
+func (l *channelLink) incomingHtlcInfo(
+	pd *lnwallet.PaymentDescriptor) HtlcInfo {
+
+	return HtlcInfo{
+		IncomingTimeLock: pd.Timeout,
+		IncomingAmt:      pd.Amount,
+	}
 }
 
 // fail is a function which is used to encapsulate the action necessary for
@@ -175,6 +175,9 @@ func initSwitchWithDB(startingHeight uint32, db *channeldb.DB) (*Switch, error)
 		FwdEventTicker: ticker.NewForce(DefaultFwdEventInterval),
 		LogEventTicker: ticker.NewForce(DefaultLogInterval),
 		AckEventTicker: ticker.NewForce(DefaultAckInterval),
+		HtlcNotifier:   &mockHTLCNotifier{},
+
+		InterceptHoldTimeout: DefaultInterceptHoldTimeout,
 	}
 
 	return New(cfg, startingHeight)
@@ -272,12 +275,12 @@ func newMockHopIterator(hops ...hop.ForwardingInfo) hop.Iterator {
 	return &mockHopIterator{hops: hops}
 }
 
-func (r *mockHopIterator) ForwardingInstructions() (
-	hop.ForwardingInfo, error) {
-
+func (r *mockHopIterator) HopPayload() (*hop.Payload, error) {
 	h := r.hops[0]
 	r.hops = r.hops[1:]
-	return h, nil
+	return &hop.Payload{
+		FwdInfo: h,
+	}, nil
 }
 
 func (r *mockHopIterator) ExtraOnionBlob() []byte {
@@ -605,6 +608,14 @@ func (s *mockServer) RemoteGlobalFeatures() *lnwire.FeatureVector {
 	return nil
 }
 
+func (s *mockServer) LocalFeatures() *lnwire.FeatureVector {
+	return nil
+}
+
+func (s *mockServer) RemoteFeatures() *lnwire.FeatureVector {
+	return nil
+}
+
 func (s *mockServer) Stop() error {
 	if !atomic.CompareAndSwapInt32(&s.shutdown, 0, 1) {
 		return nil
@@ -625,6 +636,12 @@ type mockChannelLink struct {
 
 	shortChanID lnwire.ShortChannelID
 
+	// liveShortChanID is the short channel ID that is loaded by the next
+	// call to UpdateShortChanID.
+	liveShortChanID lnwire.ShortChannelID
+
+	zeroConfAlias lnwire.ShortChannelID
+
 	chanID lnwire.ChannelID
 
 	peer lnpeer.Peer
@@ -678,11 +695,12 @@ func newMockChannelLink(htlcSwitch *Switch, chanID lnwire.ChannelID,
 ) *mockChannelLink {
 
 	return &mockChannelLink{
-		htlcSwitch:  htlcSwitch,
-		chanID:      chanID,
-		shortChanID: shortChanID,
-		peer:        peer,
-		eligible:    eligible,
+		htlcSwitch:      htlcSwitch,
+		chanID:          chanID,
+		shortChanID:     shortChanID,
+		liveShortChanID: shortChanID,
+		peer:            peer,
+		eligible:        eligible,
 	}
 }
 
@@ -730,11 +748,13 @@ func (f *mockChannelLink) Peer() lnpeer.Peer                            { return
 func (f *mockChannelLink) ChannelPoint() *wire.OutPoint                 { return &wire.OutPoint{} }
 func (f *mockChannelLink) Stop()                                        {}
 func (f *mockChannelLink) EligibleToForward() bool                      { return f.eligible }
-func (f *mockChannelLink) setLiveShortChanID(sid lnwire.ShortChannelID) { f.shortChanID = sid }
+func (f *mockChannelLink) setLiveShortChanID(sid lnwire.ShortChannelID) { f.liveShortChanID = sid }
 func (f *mockChannelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	f.eligible = true
+	f.shortChanID = f.liveShortChanID
 	return f.shortChanID, nil
 }
+func (f *mockChannelLink) ZeroConfAlias() lnwire.ShortChannelID { return f.zeroConfAlias }
 
 var _ ChannelLink = (*mockChannelLink)(nil)
 
@@ -777,9 +797,16 @@ func newMockRegistry(minDelta uint32) *mockInvoiceRegistry {
 		panic(err)
 	}
 
-	finalCltvRejectDelta := int32(5)
-
-	registry := invoices.NewRegistry(cdb, finalCltvRejectDelta)
+	expiryWatcher := invoices.NewInvoiceExpiryWatcher(
+		0, &mockNotifier{
+			epochChan: make(chan *chainntnfs.BlockEpoch),
+		},
+	)
+	registry := invoices.NewRegistry(
+		cdb, expiryWatcher, &invoices.RegistryConfig{
+			FinalCltvRejectDelta: 5,
+		},
+	)
 	registry.Start()
 
 	return &mockInvoiceRegistry{
@@ -801,10 +828,11 @@ func (i *mockInvoiceRegistry) SettleHodlInvoice(preimage lntypes.Preimage) error
 func (i *mockInvoiceRegistry) NotifyExitHopHtlc(rhash lntypes.Hash,
 	amt lnwire.MilliSatoshi, expiry uint32, currentHeight int32,
 	circuitKey channeldb.CircuitKey, hodlChan chan<- interface{},
-	eob []byte) (*invoices.HodlEvent, error) {
+	payload invoices.Payload) (*invoices.HodlEvent, error) {
 
 	event, err := i.registry.NotifyExitHopHtlc(
-		rhash, amt, expiry, currentHeight, circuitKey, hodlChan, eob,
+		rhash, amt, expiry, currentHeight, circuitKey, hodlChan,
+		payload,
 	)
 	if err != nil {
 		return nil, err
@@ -993,3 +1021,22 @@ func (m *mockOnionErrorDecryptor) DecryptError(encryptedData []byte) (
 		Message:   m.message,
 	}, m.err
 }
+
+type mockHTLCNotifier struct{}
+
+func (h *mockHTLCNotifier) NotifyForwardingEvent(key HtlcKey, info HtlcInfo,
+	eventType HtlcEventType) {
+}
+
+func (h *mockHTLCNotifier) NotifyLinkFailEvent(key HtlcKey, info HtlcInfo,
+	eventType HtlcEventType, failureCode lnwire.FailCode,
+	failureDetail string, incoming bool) {
+}
+
+func (h *mockHTLCNotifier) NotifyForwardingFailEvent(key HtlcKey,
+	eventType HtlcEventType) {
+}
+
+func (h *mockHTLCNotifier) NotifySettleEvent(key HtlcKey,
+	eventType HtlcEventType) {
+}
@@ -74,6 +74,11 @@ type htlcPacket struct {
 	// hop.
 	isResolution bool
 
+	// intercepted is set to true once an add packet has been offered to
+	// the switch's forward interceptor, so that it isn't intercepted again
+	// when the interceptor resumes it.
+	intercepted bool
+
 	// circuit holds a reference to an Add's circuit which is persisted in
 	// the switch during successful forwarding.
 	circuit *PaymentCircuit
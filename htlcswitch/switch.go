@@ -10,6 +10,7 @@ import (
 
 	"github.com/BTCGPU/lnd/chainntnfs"
 	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/contractcourt"
 	"github.com/BTCGPU/lnd/htlcswitch/hop"
 	"github.com/BTCGPU/lnd/lntypes"
@@ -18,7 +19,6 @@ import (
 	"github.com/BTCGPU/lnd/ticker"
 	"github.com/btgsuite/btgd/wire"
 	btcutil "github.com/btgsuite/btgutil"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 )
 
@@ -34,6 +34,10 @@ const (
 	// DefaultAckInterval is the duration between attempts to ack any settle
 	// fails in a forwarding package.
 	DefaultAckInterval = 15 * time.Second
+
+	// DefaultInterceptHoldTimeout is the default maximum duration that an
+	// htlc is held by the forward interceptor before it is failed back.
+	DefaultInterceptHoldTimeout = time.Minute
 )
 
 var (
@@ -166,6 +170,16 @@ type Config struct {
 	// RejectHTLC is a flag that instructs the htlcswitch to reject any
 	// HTLCs that are not from the source hop.
 	RejectHTLC bool
+
+	// HtlcNotifier is an instance of a htlcNotifier which we will pipe htlc
+	// events through.
+	HtlcNotifier htlcNotifier
+
+	// InterceptHoldTimeout is the maximum duration that an htlc is held
+	// by the forward interceptor. Htlcs that aren't resolved in time are
+	// failed back, so that they can't linger until they expire and force
+	// a channel closure.
+	InterceptHoldTimeout time.Duration
 }
 
 // Switch is the central messaging bus for all incoming/outgoing HTLCs.
@@ -229,6 +243,13 @@ type Switch struct {
 	// ChannelLink
 	forwardingIndex map[lnwire.ShortChannelID]ChannelLink
 
+	// zeroConfAliases maps the confirmed short channel IDs of the live
+	// links of zero-conf channels to the aliases that identified them
+	// before their funding transactions confirmed. The aliases remain in
+	// the forwardingIndex, such that HTLCs that were forwarded through the
+	// alias can still be resolved.
+	zeroConfAliases map[lnwire.ShortChannelID]lnwire.ShortChannelID
+
 	// interfaceIndex maps the compressed public key of a peer to all the
 	// channels that the switch maintains with that peer.
 	interfaceIndex map[[33]byte]map[lnwire.ChannelID]ChannelLink
@@ -263,6 +284,19 @@ type Switch struct {
 	// ack in the forwarding package of the outgoing link. This was added to
 	// make pipelining settles more efficient.
 	pendingSettleFails []channeldb.SettleFailRef
+
+	// interceptorMtx is a mutex that protects the forward interceptor and
+	// the htlcs that it holds.
+	interceptorMtx sync.Mutex
+
+	// forwardInterceptor is an optional handler that is offered every
+	// htlc that is about to be forwarded, and which may decide to hold
+	// it.
+	forwardInterceptor ForwardInterceptor
+
+	// heldForwards is the set of htlcs that are held by the forward
+	// interceptor, keyed by their incoming circuit key.
+	heldForwards map[CircuitKey]*interceptedForward
 }
 
 // New creates the new instance of htlc switch.
@@ -282,6 +316,8 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 		linkIndex:         make(map[lnwire.ChannelID]ChannelLink),
 		mailOrchestrator:  newMailOrchestrator(),
 		forwardingIndex:   make(map[lnwire.ShortChannelID]ChannelLink),
+		zeroConfAliases:   make(map[lnwire.ShortChannelID]lnwire.ShortChannelID),
+		heldForwards:      make(map[CircuitKey]*interceptedForward),
 		interfaceIndex:    make(map[[33]byte]map[lnwire.ChannelID]ChannelLink),
 		pendingLinkIndex:  make(map[lnwire.ChannelID]ChannelLink),
 		networkResults:    newNetworkResultStore(cfg.DB),
@@ -625,9 +661,36 @@ func (s *Switch) ForwardPackets(linkQuit chan struct{},
 		numSent++
 	}
 
-	// Lastly, for any packets that failed, this implies that they were
-	// left in a half added state, which can happen when recovering from
-	// failures.
+	// Packets that failed were left in a half added state, which can
+	// happen when recovering from failures. This includes htlcs that were
+	// held by the forward interceptor when we restarted. If an interceptor
+	// is set, these are offered to it again instead of being failed.
+	if len(failedPackets) > 0 && s.hasInterceptor() {
+		var unresolved []*htlcPacket
+		for _, packet := range failedPackets {
+			circuit := s.circuits.LookupCircuit(packet.inKey())
+			if circuit == nil || !circuit.LoadedFromDisk ||
+				circuit.HasKeystone() {
+
+				unresolved = append(unresolved, packet)
+				continue
+			}
+
+			log.Debugf("Replaying incomplete forward %v to "+
+				"interceptor", packet.inKey())
+
+			packet.circuit = circuit
+			err := s.routeAsync(packet, fwdChan, linkQuit)
+			if err != nil {
+				return errChan
+			}
+			numSent++
+		}
+		failedPackets = unresolved
+	}
+
+	// Lastly, fail the remaining packets that were left in a half added
+	// state.
 	if len(failedPackets) > 0 {
 		var failure lnwire.FailureMessage
 		update, err := s.cfg.FetchLastChannelUpdate(
@@ -683,6 +746,128 @@ func (s *Switch) proxyFwdErrs(num *int, wg *sync.WaitGroup,
 	}
 }
 
+// SetInterceptor sets the ForwardInterceptor that is offered every htlc that
+// is about to be forwarded. Passing nil removes the current interceptor. The
+// htlcs that are still held by the previous interceptor are failed back.
+//
+// NOTE: Held htlcs are only kept in memory. Their circuits are committed but
+// never opened, so if the switch restarts before they are resolved, the
+// incoming links reforward them and they are offered to the interceptor that
+// is set by then. Without an interceptor, they are failed back.
+func (s *Switch) SetInterceptor(interceptor ForwardInterceptor) {
+	s.interceptorMtx.Lock()
+	defer s.interceptorMtx.Unlock()
+
+	s.forwardInterceptor = interceptor
+
+	for inKey, fwd := range s.heldForwards {
+		log.Debugf("Failing back htlc %v held by previous "+
+			"interceptor", inKey)
+
+		s.releaseForward(fwd)
+		if err := fwd.fail(); err != nil {
+			log.Errorf("Unable to fail back held htlc %v: %v",
+				inKey, err)
+		}
+	}
+}
+
+// interceptForward offers a forwarded add packet to the forward interceptor,
+// if one is set. It returns true if the interceptor holds the packet.
+func (s *Switch) interceptForward(packet *htlcPacket,
+	htlc *lnwire.UpdateAddHTLC) bool {
+
+	// A packet that was resumed by the interceptor is forwarded without
+	// offering it again.
+	if packet.intercepted {
+		return false
+	}
+
+	// The interceptor is called with the mutex held, which ensures that
+	// it isn't offered any htlcs once it has been removed.
+	s.interceptorMtx.Lock()
+	defer s.interceptorMtx.Unlock()
+
+	if s.forwardInterceptor == nil {
+		return false
+	}
+
+	// An htlc that is reforwarded while it is held, e.g. because its
+	// incoming link restarted, remains held by the interceptor.
+	inKey := packet.inKey()
+	if _, ok := s.heldForwards[inKey]; ok {
+		log.Debugf("Htlc %v is already held by interceptor", inKey)
+		return true
+	}
+
+	packet.intercepted = true
+
+	fwd := &interceptedForward{
+		htlc:       htlc,
+		packet:     packet,
+		htlcSwitch: s,
+	}
+
+	// The forward is tracked before it is offered, as the interceptor may
+	// resolve it right away.
+	s.heldForwards[inKey] = fwd
+	if !s.forwardInterceptor(fwd) {
+		delete(s.heldForwards, inKey)
+		return false
+	}
+
+	fwd.timeout = time.AfterFunc(s.cfg.InterceptHoldTimeout, func() {
+		if !s.releaseHeldForward(fwd) {
+			return
+		}
+
+		log.Debugf("Failing back htlc %v after hold timeout", inKey)
+
+		if err := fwd.fail(); err != nil {
+			log.Errorf("Unable to fail back htlc %v: %v", inKey,
+				err)
+		}
+	})
+
+	return true
+}
+
+// releaseHeldForward removes the forward from the set of held forwards. It
+// returns false if the forward isn't held anymore, because it was already
+// resolved or timed out.
+func (s *Switch) releaseHeldForward(fwd *interceptedForward) bool {
+	s.interceptorMtx.Lock()
+	defer s.interceptorMtx.Unlock()
+
+	if s.heldForwards[fwd.packet.inKey()] != fwd {
+		return false
+	}
+	s.releaseForward(fwd)
+
+	return true
+}
+
+// releaseForward removes a held forward and stops its hold timeout.
+//
+// NOTE: The interceptorMtx MUST be held when calling this method.
+func (s *Switch) releaseForward(fwd *interceptedForward) {
+	delete(s.heldForwards, fwd.packet.inKey())
+
+	// The timeout isn't set yet while the forward is offered to the
+	// interceptor.
+	if fwd.timeout != nil {
+		fwd.timeout.Stop()
+	}
+}
+
+// hasInterceptor returns whether a forward interceptor is set.
+func (s *Switch) hasInterceptor() bool {
+	s.interceptorMtx.Lock()
+	defer s.interceptorMtx.Unlock()
+
+	return s.forwardInterceptor != nil
+}
+
 // route sends a single htlcPacket through the switch and synchronously awaits a
 // response.
 func (s *Switch) route(packet *htlcPacket) error {
@@ -1005,6 +1190,13 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			return s.handleLocalDispatch(packet)
 		}
 
+		// Offer the htlc to the forward interceptor, if one is set. If
+		// the interceptor decides to hold the htlc, it becomes
+		// responsible for resolving it.
+		if s.interceptForward(packet, htlc) {
+			return nil
+		}
+
 		s.indexMtx.RLock()
 		targetLink, err := s.getLinkByShortID(packet.outgoingChanID)
 		if err != nil {
@@ -1133,7 +1325,23 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			return err
 		}
 
+		// Now that the circuit is closed, we'll notify the htlc
+		// notifier of the outcome of the htlc. Failures that have their
+		// source set were failed locally by the outgoing link, which
+		// has already reported them.
 		fail, isFail := htlc.(*lnwire.UpdateFailHTLC)
+		switch {
+		case isFail && !packet.hasSource:
+			s.cfg.HtlcNotifier.NotifyForwardingFailEvent(
+				newHtlcKey(packet), getEventType(packet),
+			)
+
+		case !isFail:
+			s.cfg.HtlcNotifier.NotifySettleEvent(
+				newHtlcKey(packet), getEventType(packet),
+			)
+		}
+
 		if isFail && !packet.hasSource {
 			switch {
 			// No message to encrypt, locally sourced payment.
@@ -1234,6 +1442,13 @@ func (s *Switch) failAddPacket(packet *htlcPacket,
 
 	log.Error(failErr)
 
+	// Notify the htlc notifier that we were unable to add the htlc to an
+	// outgoing link.
+	s.cfg.HtlcNotifier.NotifyLinkFailEvent(
+		newHtlcKey(packet), newHtlcInfo(packet), getEventType(packet),
+		failure.Code(), failErr.Error(), false,
+	)
+
 	failPkt := &htlcPacket{
 		sourceRef:      packet.sourceRef,
 		incomingChanID: packet.incomingChanID,
@@ -1295,6 +1510,23 @@ func (s *Switch) closeCircuit(pkt *htlcPacket) (*PaymentCircuit, error) {
 	// Otherwise, this is packet was received from the remote party.  Use
 	// circuit map to find the incoming link to receive the settle/fail.
 	circuit, err := s.circuits.CloseCircuit(pkt.outKey())
+
+	// If the HTLC was forwarded through a zero-conf channel before its
+	// funding transaction confirmed, its circuit is keyed by the alias of
+	// the channel.
+	if err == ErrUnknownCircuit {
+		s.indexMtx.RLock()
+		alias, ok := s.zeroConfAliases[pkt.outgoingChanID]
+		s.indexMtx.RUnlock()
+
+		if ok {
+			circuit, err = s.circuits.CloseCircuit(CircuitKey{
+				ChanID: alias,
+				HtlcID: pkt.outgoingHTLCID,
+			})
+		}
+	}
+
 	switch err {
 
 	// Open circuit successfully closed.
@@ -1349,7 +1581,7 @@ func (s *Switch) closeCircuit(pkt *htlcPacket) (*PaymentCircuit, error) {
 // we're the originator of the payment, so the link stops attempting to
 // re-broadcast.
 func (s *Switch) ackSettleFail(settleFailRefs ...channeldb.SettleFailRef) error {
-	return s.cfg.DB.Batch(func(tx *bbolt.Tx) error {
+	return s.cfg.DB.Batch(func(tx kvdb.Tx) error {
 		return s.cfg.SwitchPackager.AckSettleFails(tx, settleFailRefs...)
 	})
 }
@@ -1793,7 +2025,7 @@ func (s *Switch) reforwardResponses() error {
 func (s *Switch) loadChannelFwdPkgs(source lnwire.ShortChannelID) ([]*channeldb.FwdPkg, error) {
 
 	var fwdPkgs []*channeldb.FwdPkg
-	if err := s.cfg.DB.Update(func(tx *bbolt.Tx) error {
+	if err := s.cfg.DB.Update(func(tx kvdb.Tx) error {
 		var err error
 		fwdPkgs, err = s.cfg.SwitchPackager.LoadChannelFwdPkgs(
 			tx, source,
@@ -1968,6 +2200,7 @@ func (s *Switch) AddLink(link ChannelLink) error {
 		s.mailOrchestrator.BindLiveShortChanID(
 			mailbox, chanID, shortChanID,
 		)
+		s.bindZeroConfAlias(mailbox, link)
 	}
 
 	return nil
@@ -1983,6 +2216,15 @@ func (s *Switch) addLiveLink(link ChannelLink) {
 	s.linkIndex[link.ChanID()] = link
 	s.forwardingIndex[link.ShortChanID()] = link
 
+	// If the link belongs to a zero-conf channel whose funding transaction
+	// has since confirmed, we'll keep it reachable through its alias.
+	shortChanID := link.ShortChanID()
+	alias := link.ZeroConfAlias()
+	if alias != hop.Source && alias != shortChanID {
+		s.forwardingIndex[alias] = link
+		s.zeroConfAliases[shortChanID] = alias
+	}
+
 	// Next we'll add the link to the interface index so we can
 	// quickly look up all the channels for a particular node.
 	peerPub := link.Peer().PubKey()
@@ -2028,6 +2270,17 @@ func (s *Switch) getLinkByShortID(chanID lnwire.ShortChannelID) (ChannelLink, er
 	return link, nil
 }
 
+// HasShortChanID returns true if a link can be reached through the given short
+// channel ID, either as its own short channel ID or as the alias of a
+// zero-conf channel.
+func (s *Switch) HasShortChanID(chanID lnwire.ShortChannelID) bool {
+	s.indexMtx.RLock()
+	defer s.indexMtx.RUnlock()
+
+	_, err := s.getLinkByShortID(chanID)
+	return err == nil
+}
+
 // HasActiveLink returns true if the given channel ID has a link in the link
 // index AND the link is eligible to forward.
 func (s *Switch) HasActiveLink(chanID lnwire.ChannelID) bool {
@@ -2069,6 +2322,10 @@ func (s *Switch) removeLink(chanID lnwire.ChannelID) ChannelLink {
 	delete(s.pendingLinkIndex, link.ChanID())
 	delete(s.linkIndex, link.ChanID())
 	delete(s.forwardingIndex, link.ShortChanID())
+	if alias, ok := s.zeroConfAliases[link.ShortChanID()]; ok {
+		delete(s.forwardingIndex, alias)
+		delete(s.zeroConfAliases, link.ShortChanID())
+	}
 
 	// If the link has been added to the peer index, then we'll move to
 	// delete the entry within the index.
@@ -2087,14 +2344,22 @@ func (s *Switch) removeLink(chanID lnwire.ChannelID) ChannelLink {
 }
 
 // UpdateShortChanID updates the short chan ID for an existing channel. This is
-// required in the case of a re-org and re-confirmation or a channel, or in the
-// case that a link was added to the switch before its short chan ID was known.
+// required in the case of a re-org and re-confirmation or a channel, in the
+// case that a link was added to the switch before its short chan ID was known,
+// or when the funding transaction of a zero-conf channel confirms.
 func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	s.indexMtx.Lock()
 	defer s.indexMtx.Unlock()
 
-	// Locate the target link in the pending link index. If no such link
-	// exists, then we will ignore the request.
+	// The links of zero-conf channels are live under their alias before
+	// the funding transaction confirms, so we'll swap in their confirmed
+	// short channel ID.
+	if link, ok := s.linkIndex[chanID]; ok {
+		return s.updateLiveShortChanID(link)
+	}
+
+	// Otherwise, locate the target link in the pending link index. If no
+	// such link exists, then we will ignore the request.
 	link, ok := s.pendingLinkIndex[chanID]
 	if !ok {
 		return fmt.Errorf("link %v not found", chanID)
@@ -2134,6 +2399,59 @@ func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	return nil
 }
 
+// updateLiveShortChanID updates the short chan ID of a live link, which happens
+// once the funding transaction of a zero-conf channel confirms. The link
+// remains reachable through its previous short chan ID, which is its alias.
+//
+// NOTE: This MUST be called with the indexMtx held.
+func (s *Switch) updateLiveShortChanID(link ChannelLink) error {
+	chanID := link.ChanID()
+	oldShortChanID := link.ShortChanID()
+
+	shortChanID, err := link.UpdateShortChanID()
+	if err != nil {
+		return err
+	}
+
+	if shortChanID == hop.Source {
+		return fmt.Errorf("refusing trivial short_chan_id for "+
+			"chan_id=%v live link", chanID)
+	}
+	if shortChanID == oldShortChanID {
+		return nil
+	}
+
+	log.Infof("Updated short_chan_id for live ChannelLink(%v): old=%v, "+
+		"new=%v", chanID, oldShortChanID, shortChanID)
+
+	// Only the alias of a zero-conf channel is kept in the forwarding
+	// index, any other previous short chan ID is outdated.
+	if oldShortChanID != link.ZeroConfAlias() {
+		delete(s.forwardingIndex, oldShortChanID)
+	}
+	delete(s.zeroConfAliases, oldShortChanID)
+	s.addLiveLink(link)
+
+	mailbox := s.mailOrchestrator.GetOrCreateMailBox(chanID)
+	s.mailOrchestrator.BindLiveShortChanID(mailbox, chanID, shortChanID)
+
+	return nil
+}
+
+// bindZeroConfAlias binds the alias of a zero-conf link whose funding
+// transaction has confirmed to its mailbox, such that packets destined for the
+// alias are still delivered to the link.
+//
+// NOTE: This MUST be called with the indexMtx held.
+func (s *Switch) bindZeroConfAlias(mailbox MailBox, link ChannelLink) {
+	alias, ok := s.zeroConfAliases[link.ShortChanID()]
+	if !ok {
+		return
+	}
+
+	s.mailOrchestrator.BindLiveShortChanID(mailbox, link.ChanID(), alias)
+}
+
 // GetLinksByInterface fetches all the links connected to a particular node
 // identified by the serialized compressed form of its public key.
 func (s *Switch) GetLinksByInterface(hop [33]byte) ([]ChannelLink, error) {
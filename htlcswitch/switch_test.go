@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"os"
 	"testing"
 	"time"
 
@@ -353,6 +354,141 @@ func TestSwitchForward(t *testing.T) {
 	}
 }
 
+// TestSwitchZeroConfAlias tests that the link of a zero-conf channel remains
+// reachable through its alias once its confirmed short channel ID is swapped
+// in, and that HTLCs forwarded through the alias can still be settled.
+func TestSwitchZeroConfAlias(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+	bobAlias := lnwire.ShortChannelID{BlockHeight: 16000000, TxIndex: 1}
+
+	// Bob's channel is a zero-conf channel, so its link is live under its
+	// alias.
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobAlias, bobPeer, true,
+	)
+	bobChannelLink.zeroConfAlias = bobAlias
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	// Forward an HTLC from Alice to Bob through the alias.
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+	packet := &htlcPacket{
+		incomingChanID: aliceChannelLink.ShortChanID(),
+		incomingHTLCID: 0,
+		outgoingChanID: bobAlias,
+		obfuscator:     NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: rhash,
+			Amount:      1,
+		},
+	}
+	if err := s.forward(packet); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-bobChannelLink.packets:
+		if err := bobChannelLink.completeCircuit(packet); err != nil {
+			t.Fatalf("unable to complete payment circuit: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("request was not propagated to destination")
+	}
+
+	// Now the funding transaction of Bob's channel confirms, and its
+	// confirmed short channel ID is swapped in.
+	bobChannelLink.setLiveShortChanID(bobChanID)
+	if err := s.UpdateShortChanID(chanID2); err != nil {
+		t.Fatalf("unable to update bob short_chan_id: %v", err)
+	}
+
+	// Bob's link must be reachable through both short channel IDs.
+	for _, sid := range []lnwire.ShortChannelID{bobAlias, bobChanID} {
+		s.indexMtx.RLock()
+		link, err := s.getLinkByShortID(sid)
+		s.indexMtx.RUnlock()
+		if err != nil || link != bobChannelLink {
+			t.Fatalf("bob link not found by short_chan_id=%v", sid)
+		}
+		if !s.HasShortChanID(sid) {
+			t.Fatalf("short_chan_id=%v not in use", sid)
+		}
+	}
+
+	// Bob settles the HTLC under his confirmed short channel ID, which
+	// must still close the circuit that was opened through the alias.
+	packet = &htlcPacket{
+		outgoingChanID: bobChanID,
+		outgoingHTLCID: 0,
+		amount:         1,
+		htlc: &lnwire.UpdateFulfillHTLC{
+			PaymentPreimage: preimage,
+		},
+	}
+	if err := s.forward(packet); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		if err := aliceChannelLink.deleteCircuit(pkt); err != nil {
+			t.Fatalf("unable to remove circuit: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("settle was not propagated to alice")
+	}
+
+	if s.circuits.NumOpen() != 0 {
+		t.Fatal("wrong amount of circuits")
+	}
+
+	// Once Bob's link is removed, neither short channel ID may resolve
+	// to it anymore.
+	s.RemoveLink(chanID2)
+	for _, sid := range []lnwire.ShortChannelID{bobAlias, bobChanID} {
+		s.indexMtx.RLock()
+		_, err := s.getLinkByShortID(sid)
+		s.indexMtx.RUnlock()
+		if err != ErrChannelLinkNotFound {
+			t.Fatalf("expected no link for short_chan_id=%v", sid)
+		}
+		if s.HasShortChanID(sid) {
+			t.Fatalf("short_chan_id=%v still in use", sid)
+		}
+	}
+}
+
 func TestSwitchForwardFailAfterFullAdd(t *testing.T) {
 	t.Parallel()
 
@@ -2373,3 +2509,393 @@ func TestInvalidFailure(t *testing.T) {
 		t.Fatal("err wasn't received")
 	}
 }
+
+// TestSwitchHoldForward asserts that forwarded htlcs that are held by the
+// forward interceptor can be resumed, failed or settled.
+func TestSwitchHoldForward(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	// Hold all forwarded htlcs, and hand them to the test.
+	forwards := make(chan InterceptedForward, 1)
+	s.SetInterceptor(func(fwd InterceptedForward) bool {
+		forwards <- fwd
+		return true
+	})
+
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+
+	// forwardHeld forwards a new htlc from alice to bob, and asserts that
+	// it is held by the interceptor.
+	forwardHeld := func(htlcID uint64) InterceptedForward {
+		packet := &htlcPacket{
+			incomingChanID: aliceChannelLink.ShortChanID(),
+			incomingHTLCID: htlcID,
+			outgoingChanID: bobChannelLink.ShortChanID(),
+			obfuscator:     NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: rhash,
+				Amount:      1,
+			},
+		}
+		if err := s.forward(packet); err != nil {
+			t.Fatal(err)
+		}
+
+		var fwd InterceptedForward
+		select {
+		case fwd = <-forwards:
+		case <-time.After(time.Second):
+			t.Fatal("htlc was not intercepted")
+		}
+
+		if fwd.Packet().IncomingCircuit != packet.inKey() {
+			t.Fatalf("unexpected incoming circuit: %v",
+				fwd.Packet().IncomingCircuit)
+		}
+
+		select {
+		case <-bobChannelLink.packets:
+			t.Fatal("held htlc was forwarded")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		return fwd
+	}
+
+	// A resumed htlc should be forwarded to bob without being intercepted
+	// again.
+	fwd := forwardHeld(0)
+	if err := fwd.Resume(); err != nil {
+		t.Fatalf("unable to resume htlc: %v", err)
+	}
+	select {
+	case pkt := <-bobChannelLink.packets:
+		if err := bobChannelLink.completeCircuit(pkt); err != nil {
+			t.Fatalf("unable to complete payment circuit: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("resumed htlc was not forwarded")
+	}
+
+	// A failed htlc should be failed back to alice.
+	fwd = forwardHeld(1)
+	if err := fwd.Fail(); err != nil {
+		t.Fatalf("unable to fail htlc: %v", err)
+	}
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		if _, ok := pkt.htlc.(*lnwire.UpdateFailHTLC); !ok {
+			t.Fatalf("expected fail htlc, got %T", pkt.htlc)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed htlc was not sent back")
+	}
+
+	// Settling with a wrong preimage should be rejected, while the right
+	// preimage should settle the htlc back to alice.
+	fwd = forwardHeld(2)
+	if err := fwd.Settle(lntypes.Preimage{}); err == nil {
+		t.Fatal("expected settle with wrong preimage to fail")
+	}
+	if err := fwd.Settle(lntypes.Preimage(preimage)); err != nil {
+		t.Fatalf("unable to settle htlc: %v", err)
+	}
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		settle, ok := pkt.htlc.(*lnwire.UpdateFulfillHTLC)
+		if !ok {
+			t.Fatalf("expected settle htlc, got %T", pkt.htlc)
+		}
+		if settle.PaymentPreimage != preimage {
+			t.Fatal("unexpected preimage")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("settled htlc was not sent back")
+	}
+}
+
+// TestSwitchHoldForwardTimeout asserts that held htlcs are failed back once
+// the hold timeout expires, and when the interceptor is removed.
+func TestSwitchHoldForwardTimeout(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	s.cfg.InterceptHoldTimeout = 100 * time.Millisecond
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	forwards := make(chan InterceptedForward, 1)
+	s.SetInterceptor(func(fwd InterceptedForward) bool {
+		forwards <- fwd
+		return true
+	})
+
+	rhash := fastsha256.Sum256([]byte{1})
+
+	// forwardHeld forwards a new htlc from alice to bob, and returns it
+	// once it is held by the interceptor.
+	forwardHeld := func(htlcID uint64) InterceptedForward {
+		packet := &htlcPacket{
+			incomingChanID: aliceChannelLink.ShortChanID(),
+			incomingHTLCID: htlcID,
+			outgoingChanID: bobChannelLink.ShortChanID(),
+			obfuscator:     NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: rhash,
+				Amount:      1,
+			},
+		}
+		if err := s.forward(packet); err != nil {
+			t.Fatal(err)
+		}
+
+		select {
+		case fwd := <-forwards:
+			return fwd
+		case <-time.After(time.Second):
+			t.Fatal("htlc was not intercepted")
+		}
+
+		return nil
+	}
+
+	// assertFailedBack asserts that alice receives a fail for her htlc.
+	assertFailedBack := func() {
+		select {
+		case pkt := <-aliceChannelLink.packets:
+			if _, ok := pkt.htlc.(*lnwire.UpdateFailHTLC); !ok {
+				t.Fatalf("expected fail htlc, got %T", pkt.htlc)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("held htlc was not failed back")
+		}
+	}
+
+	// An htlc that isn't resolved in time should be failed back, after
+	// which it can't be resolved anymore.
+	fwd := forwardHeld(0)
+	assertFailedBack()
+	if err := fwd.Resume(); err != ErrFwdNotHeld {
+		t.Fatalf("expected ErrFwdNotHeld, got %v", err)
+	}
+
+	// Removing the interceptor should fail back the htlcs that it holds.
+	s.cfg.InterceptHoldTimeout = time.Minute
+	fwd = forwardHeld(1)
+	s.SetInterceptor(nil)
+	assertFailedBack()
+	if err := fwd.Fail(); err != ErrFwdNotHeld {
+		t.Fatalf("expected ErrFwdNotHeld, got %v", err)
+	}
+}
+
+// TestSwitchHoldForwardRestart asserts that an htlc that is held when the
+// switch restarts is offered to the interceptor again once the incoming link
+// reforwards it.
+func TestSwitchHoldForwardRestart(t *testing.T) {
+	t.Parallel()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	tempPath, err := ioutil.TempDir("", "circuitdb")
+	if err != nil {
+		t.Fatalf("unable to temporary path: %v", err)
+	}
+	defer os.RemoveAll(tempPath)
+
+	cdb, err := channeldb.Open(tempPath)
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+
+	// startSwitch starts a switch with links to alice and bob, whose
+	// interceptor holds all htlcs and hands them to the test.
+	startSwitch := func(cdb *channeldb.DB) (*Switch, *mockChannelLink,
+		chan InterceptedForward) {
+
+		s, err := initSwitchWithDB(testStartingHeight, cdb)
+		if err != nil {
+			t.Fatalf("unable to init switch: %v", err)
+		}
+		if err := s.Start(); err != nil {
+			t.Fatalf("unable to start switch: %v", err)
+		}
+
+		aliceChannelLink := newMockChannelLink(
+			s, chanID1, aliceChanID, alicePeer, true,
+		)
+		bobChannelLink := newMockChannelLink(
+			s, chanID2, bobChanID, bobPeer, true,
+		)
+		if err := s.AddLink(aliceChannelLink); err != nil {
+			t.Fatalf("unable to add alice link: %v", err)
+		}
+		if err := s.AddLink(bobChannelLink); err != nil {
+			t.Fatalf("unable to add bob link: %v", err)
+		}
+
+		forwards := make(chan InterceptedForward, 1)
+		s.SetInterceptor(func(fwd InterceptedForward) bool {
+			forwards <- fwd
+			return true
+		})
+
+		return s, bobChannelLink, forwards
+	}
+
+	rhash := fastsha256.Sum256([]byte{1})
+	newPacket := func() *htlcPacket {
+		return &htlcPacket{
+			incomingChanID: aliceChanID,
+			incomingHTLCID: 0,
+			outgoingChanID: bobChanID,
+			obfuscator:     NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: rhash,
+				Amount:      1,
+			},
+		}
+	}
+
+	// forwardHeld forwards the htlc from alice's link, and asserts that
+	// it is held by the interceptor.
+	forwardHeld := func(s *Switch,
+		forwards chan InterceptedForward) InterceptedForward {
+
+		packet := newPacket()
+		errChan := s.ForwardPackets(nil, packet)
+		for err := range errChan {
+			if err != nil {
+				t.Fatalf("unable to forward htlc: %v", err)
+			}
+		}
+
+		select {
+		case fwd := <-forwards:
+			if fwd.Packet().IncomingCircuit != packet.inKey() {
+				t.Fatalf("unexpected incoming circuit: %v",
+					fwd.Packet().IncomingCircuit)
+			}
+			return fwd
+
+		case <-time.After(time.Second):
+			t.Fatal("htlc was not intercepted")
+		}
+
+		return nil
+	}
+
+	s, _, forwards := startSwitch(cdb)
+	defer s.Stop()
+	forwardHeld(s, forwards)
+
+	// Restart the switch while the htlc is held.
+	if err := s.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if err := cdb.Close(); err != nil {
+		t.Fatal(err)
+	}
+	cdb2, err := channeldb.Open(tempPath)
+	if err != nil {
+		t.Fatalf("unable to reopen channeldb: %v", err)
+	}
+	defer cdb2.Close()
+
+	s2, bobChannelLink, forwards := startSwitch(cdb2)
+	defer s2.Stop()
+
+	if s2.circuits.NumPending() != 1 {
+		t.Fatalf("wrong amount of half circuits")
+	}
+
+	// The reforwarded htlc should be offered to the interceptor instead
+	// of being failed, and be forwarded to bob once it is resumed.
+	fwd := forwardHeld(s2, forwards)
+	if err := fwd.Resume(); err != nil {
+		t.Fatalf("unable to resume htlc: %v", err)
+	}
+	select {
+	case pkt := <-bobChannelLink.packets:
+		if _, ok := pkt.htlc.(*lnwire.UpdateAddHTLC); !ok {
+			t.Fatalf("expected add htlc, got %T", pkt.htlc)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("resumed htlc was not forwarded")
+	}
+}
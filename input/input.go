@@ -3,6 +3,7 @@ package input
 import (
 	"github.com/btgsuite/btgd/txscript"
 	"github.com/btgsuite/btgd/wire"
+	btcutil "github.com/btgsuite/btgutil"
 )
 
 // Input represents an abstract UTXO which is to be spent using a sweeping
@@ -41,13 +42,32 @@ type Input interface {
 	// HeightHint returns the minimum height at which a confirmed spending
 	// tx can occur.
 	HeightHint() uint32
+
+	// UnconfParent returns information about a possibly unconfirmed parent
+	// tx. This is used to bump the fee of the parent through CPFP when
+	// sweeping the input.
+	UnconfParent() *TxInfo
+}
+
+// TxInfo describes properties of a parent tx that are relevant for CPFP.
+type TxInfo struct {
+	// Fee is the fee of the tx.
+	Fee btcutil.Amount
+
+	// Weight is the weight of the tx.
+	Weight int64
 }
 
 type inputKit struct {
-	outpoint    wire.OutPoint
-	witnessType WitnessType
-	signDesc    SignDescriptor
-	heightHint  uint32
+	outpoint        wire.OutPoint
+	witnessType     WitnessType
+	signDesc        SignDescriptor
+	heightHint      uint32
+	blockToMaturity uint32
+
+	// unconfParent contains information about a potential unconfirmed
+	// parent transaction.
+	unconfParent *TxInfo
 }
 
 // OutPoint returns the breached output's identifier that is to be included as
@@ -74,6 +94,18 @@ func (i *inputKit) HeightHint() uint32 {
 	return i.heightHint
 }
 
+// BlocksToMaturity returns the relative timelock, as a number of blocks, that
+// must be built on top of the confirmation height before the output can be
+// spent. For non-CSV locked inputs this is always zero.
+func (i *inputKit) BlocksToMaturity() uint32 {
+	return i.blockToMaturity
+}
+
+// UnconfParent returns information about a possibly unconfirmed parent tx.
+func (i *inputKit) UnconfParent() *TxInfo {
+	return i.unconfParent
+}
+
 // BaseInput contains all the information needed to sweep a basic output
 // (CSV/CLTV/no time lock)
 type BaseInput struct {
@@ -81,16 +113,20 @@ type BaseInput struct {
 }
 
 // MakeBaseInput assembles a new BaseInput that can be used to construct a
-// sweep transaction.
+// sweep transaction. If the input spends from a transaction that may still be
+// unconfirmed, unconfParent should describe that transaction so the sweeper
+// can bump its fee through CPFP.
 func MakeBaseInput(outpoint *wire.OutPoint, witnessType WitnessType,
-	signDescriptor *SignDescriptor, heightHint uint32) BaseInput {
+	signDescriptor *SignDescriptor, heightHint uint32,
+	unconfParent *TxInfo) BaseInput {
 
 	return BaseInput{
 		inputKit{
-			outpoint:    *outpoint,
-			witnessType: witnessType,
-			signDesc:    *signDescriptor,
-			heightHint:  heightHint,
+			outpoint:     *outpoint,
+			witnessType:  witnessType,
+			signDesc:     *signDescriptor,
+			heightHint:   heightHint,
+			unconfParent: unconfParent,
 		},
 	}
 }
@@ -101,12 +137,29 @@ func NewBaseInput(outpoint *wire.OutPoint, witnessType WitnessType,
 	signDescriptor *SignDescriptor, heightHint uint32) *BaseInput {
 
 	input := MakeBaseInput(
-		outpoint, witnessType, signDescriptor, heightHint,
+		outpoint, witnessType, signDescriptor, heightHint, nil,
 	)
 
 	return &input
 }
 
+// NewCsvInput assembles a new csv-locked input that can be used to construct a
+// sweep transaction.
+func NewCsvInput(outpoint *wire.OutPoint, witnessType WitnessType,
+	signDescriptor *SignDescriptor, heightHint uint32,
+	blockToMaturity uint32) *BaseInput {
+
+	return &BaseInput{
+		inputKit{
+			outpoint:        *outpoint,
+			witnessType:     witnessType,
+			signDesc:        *signDescriptor,
+			heightHint:      heightHint,
+			blockToMaturity: blockToMaturity,
+		},
+	}
+}
+
 // CraftInputScript returns a valid set of input scripts allowing this output
 // to be spent. The returned input scripts should target the input at location
 // txIndex within the passed transaction. The input scripts generated by this
@@ -121,13 +174,6 @@ func (bi *BaseInput) CraftInputScript(signer Signer, txn *wire.MsgTx,
 	return witnessFunc(txn, hashCache, txinIdx)
 }
 
-// BlocksToMaturity returns the relative timelock, as a number of blocks, that
-// must be built on top of the confirmation height before the output can be
-// spent. For non-CSV locked inputs this is always zero.
-func (bi *BaseInput) BlocksToMaturity() uint32 {
-	return 0
-}
-
 // HtlcSucceedInput constitutes a sweep input that needs a pre-image. The input
 // is expected to reside on the commitment tx of the remote party and should
 // not be a second level tx output.
@@ -138,17 +184,19 @@ type HtlcSucceedInput struct {
 }
 
 // MakeHtlcSucceedInput assembles a new redeem input that can be used to
-// construct a sweep transaction.
+// construct a sweep transaction. For anchor channels, the htlc output is
+// additionally locked by a relative timelock of blocksToMaturity blocks.
 func MakeHtlcSucceedInput(outpoint *wire.OutPoint,
-	signDescriptor *SignDescriptor,
-	preimage []byte, heightHint uint32) HtlcSucceedInput {
+	signDescriptor *SignDescriptor, preimage []byte, heightHint,
+	blocksToMaturity uint32) HtlcSucceedInput {
 
 	return HtlcSucceedInput{
 		inputKit: inputKit{
-			outpoint:    *outpoint,
-			witnessType: HtlcAcceptedRemoteSuccess,
-			signDesc:    *signDescriptor,
-			heightHint:  heightHint,
+			outpoint:        *outpoint,
+			witnessType:     HtlcAcceptedRemoteSuccess,
+			signDesc:        *signDescriptor,
+			heightHint:      heightHint,
+			blockToMaturity: blocksToMaturity,
 		},
 		preimage: preimage,
 	}
@@ -177,13 +225,6 @@ func (h *HtlcSucceedInput) CraftInputScript(signer Signer, txn *wire.MsgTx,
 	}, nil
 }
 
-// BlocksToMaturity returns the relative timelock, as a number of blocks, that
-// must be built on top of the confirmation height before the output can be
-// spent.
-func (h *HtlcSucceedInput) BlocksToMaturity() uint32 {
-	return 0
-}
-
 // Compile-time constraints to ensure each input struct implement the Input
 // interface.
 var _ Input = (*BaseInput)(nil)
@@ -147,7 +147,7 @@ func TestHTLCSenderSpendValidation(t *testing.T) {
 
 	// Generate the raw HTLC redemption scripts, and its p2wsh counterpart.
 	htlcWitnessScript, err := SenderHTLCScript(aliceLocalKey, bobLocalKey,
-		revocationKey, paymentHash[:])
+		revocationKey, paymentHash[:], false)
 	if err != nil {
 		t.Fatalf("unable to create htlc sender script: %v", err)
 	}
@@ -402,7 +402,7 @@ func TestHTLCReceiverSpendValidation(t *testing.T) {
 
 	// Generate the raw HTLC redemption scripts, and its p2wsh counterpart.
 	htlcWitnessScript, err := ReceiverHTLCScript(cltvTimeout, aliceLocalKey,
-		bobLocalKey, revocationKey, paymentHash[:])
+		bobLocalKey, revocationKey, paymentHash[:], false)
 	if err != nil {
 		t.Fatalf("unable to create htlc sender script: %v", err)
 	}
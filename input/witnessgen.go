@@ -84,6 +84,15 @@ const (
 	// type, but it omits the tweak that randomizes the key we need to
 	// spend with a channel peer supplied set of randomness.
 	CommitSpendNoDelayTweakless = 12
+
+	// CommitmentToRemoteConfirmed is a witness that allows us to spend our
+	// output on the counterparty's commitment transaction after a
+	// confirmation, as is the case for channels with anchor outputs.
+	CommitmentToRemoteConfirmed WitnessType = 13
+
+	// CommitmentAnchor is a witness that allows us to spend our anchor on
+	// the commitment transaction.
+	CommitmentAnchor WitnessType = 14
 )
 
 // Stirng returns a human readable version of the target WitnessType.
@@ -98,6 +107,12 @@ func (wt WitnessType) String() string {
 	case CommitSpendNoDelayTweakless:
 		return "CommitmentNoDelayTweakless"
 
+	case CommitmentToRemoteConfirmed:
+		return "CommitmentToRemoteConfirmed"
+
+	case CommitmentAnchor:
+		return "CommitmentAnchor"
+
 	case CommitmentRevoke:
 		return "CommitmentRevoke"
 
@@ -180,6 +195,28 @@ func (wt WitnessType) GenWitnessFunc(signer Signer,
 				Witness: witness,
 			}, nil
 
+		case CommitmentToRemoteConfirmed:
+			witness, err := CommitSpendToRemoteConfirmed(
+				signer, desc, tx,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return &Script{
+				Witness: witness,
+			}, nil
+
+		case CommitmentAnchor:
+			witness, err := CommitSpendAnchor(signer, desc, tx)
+			if err != nil {
+				return nil, err
+			}
+
+			return &Script{
+				Witness: witness,
+			}, nil
+
 		case CommitmentRevoke:
 			witness, err := CommitSpendRevoke(signer, desc, tx)
 			if err != nil {
@@ -0,0 +1,22 @@
+package invoices
+
+import (
+	"github.com/BTCGPU/lnd/lntypes"
+	"github.com/BTCGPU/lnd/record"
+)
+
+// Payload abstracts access to any additional fields provided in the final
+// hop's TLV onion payload.
+type Payload interface {
+	// MultiPath returns the record corresponding the option_mpp parsed from
+	// the onion payload.
+	MultiPath() *record.MPP
+
+	// KeySendPreimage returns the preimage of a spontaneous key send
+	// payment parsed from the onion payload, if any.
+	KeySendPreimage() *lntypes.Preimage
+
+	// CustomRecords returns the custom tlv type records that were parsed
+	// from the onion payload.
+	CustomRecords() record.CustomSet
+}
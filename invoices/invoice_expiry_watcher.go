@@ -0,0 +1,291 @@
+package invoices
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+
+	"github.com/BTCGPU/lnd/chainntnfs"
+	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/lntypes"
+	"github.com/BTCGPU/lnd/queue"
+)
+
+// invoiceExpiry holds the payment hash of an invoice along with the point at
+// which it expires. Open invoices expire at a point in time, while accepted
+// hold invoices expire at a block height.
+type invoiceExpiry struct {
+	// paymentHash is the payment hash of the invoice.
+	paymentHash lntypes.Hash
+
+	// expiry is either the unix time in nanoseconds or the block height at
+	// which the invoice expires, depending on byHeight.
+	expiry int64
+
+	// byHeight indicates that expiry is a block height rather than a
+	// timestamp.
+	byHeight bool
+}
+
+// expiryHeap is a min-heap of invoice expiries, ordered by their expiry. All
+// expiries within a heap must be of the same kind.
+type expiryHeap []invoiceExpiry
+
+// Len returns the number of expiries in the heap.
+//
+// NOTE: This is part of the heap.Interface implementation.
+func (h expiryHeap) Len() int { return len(h) }
+
+// Less returns whether the expiry with index i should sort before the expiry
+// with index j.
+//
+// NOTE: This is part of the heap.Interface implementation.
+func (h expiryHeap) Less(i, j int) bool { return h[i].expiry < h[j].expiry }
+
+// Swap swaps the expiries at the passed indices in the heap.
+//
+// NOTE: This is part of the heap.Interface implementation.
+func (h expiryHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+// Push adds an expiry to the end of the heap.
+//
+// NOTE: This is part of the heap.Interface implementation.
+func (h *expiryHeap) Push(x interface{}) {
+	*h = append(*h, x.(invoiceExpiry))
+}
+
+// Pop removes the last expiry from the heap and returns it.
+//
+// NOTE: This is part of the heap.Interface implementation.
+func (h *expiryHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+
+	return x
+}
+
+// InvoiceExpiryWatcher cancels invoices that can no longer be paid. Open
+// invoices are canceled once their expiry time has passed. Accepted hold
+// invoices are canceled once the earliest expiring of their htlcs gets within
+// holdExpiryDelta blocks of its expiry height, so that the htlcs are canceled
+// back before they would cause a force close of their channels.
+type InvoiceExpiryWatcher struct {
+	// holdExpiryDelta is the number of blocks before the expiry of the
+	// htlcs of an accepted hold invoice at which the invoice is canceled.
+	holdExpiryDelta uint32
+
+	// notifier is used to receive block epochs, which drive the
+	// cancellation of accepted hold invoices.
+	notifier chainntnfs.ChainNotifier
+
+	// cancelInvoice is the function that is called to cancel an expired
+	// invoice. If cancelAccepted is false, accepted invoices are left
+	// untouched.
+	cancelInvoice func(hash lntypes.Hash, cancelAccepted bool) error
+
+	// timeQueue holds the expiries of open invoices, ordered by time.
+	timeQueue expiryHeap
+
+	// heightQueue holds the expiries of accepted hold invoices, ordered
+	// by block height.
+	heightQueue expiryHeap
+
+	// newExpiries is used to hand new expiries to the main goroutine. It
+	// is unbounded so that callers never block on a watcher that is busy
+	// canceling invoices.
+	newExpiries *queue.ConcurrentQueue
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewInvoiceExpiryWatcher creates a new invoice expiry watcher that cancels
+// accepted hold invoices holdExpiryDelta blocks before their htlcs expire.
+func NewInvoiceExpiryWatcher(holdExpiryDelta uint32,
+	notifier chainntnfs.ChainNotifier) *InvoiceExpiryWatcher {
+
+	return &InvoiceExpiryWatcher{
+		holdExpiryDelta: holdExpiryDelta,
+		notifier:        notifier,
+		newExpiries:     queue.NewConcurrentQueue(20),
+		quit:            make(chan struct{}),
+	}
+}
+
+// Start starts the watcher, which will use the passed function to cancel
+// expired invoices.
+func (ew *InvoiceExpiryWatcher) Start(
+	cancelInvoice func(lntypes.Hash, bool) error) error {
+
+	blockEpochs, err := ew.notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return err
+	}
+
+	ew.cancelInvoice = cancelInvoice
+	ew.newExpiries.Start()
+
+	ew.wg.Add(1)
+	go ew.expiryLoop(blockEpochs)
+
+	return nil
+}
+
+// Stop signals the watcher for a graceful shutdown.
+func (ew *InvoiceExpiryWatcher) Stop() {
+	close(ew.quit)
+
+	ew.wg.Wait()
+	ew.newExpiries.Stop()
+}
+
+// AddInvoice adds an invoice to the watcher. Open invoices are tracked by
+// their expiry time and accepted invoices by the expiry height of their
+// earliest expiring htlc. Invoices in any other state are ignored, as are open
+// invoices without an expiry.
+func (ew *InvoiceExpiryWatcher) AddInvoice(hash lntypes.Hash,
+	invoice *channeldb.Invoice) {
+
+	var expiry invoiceExpiry
+	switch invoice.Terms.State {
+	case channeldb.ContractOpen:
+		if invoice.Expiry == 0 {
+			return
+		}
+
+		expiry = invoiceExpiry{
+			paymentHash: hash,
+			expiry: invoice.CreationDate.Add(
+				invoice.Expiry,
+			).UnixNano(),
+		}
+
+	case channeldb.ContractAccepted:
+		// The invoice must be canceled before the earliest expiring
+		// htlc gets too close to its expiry.
+		var minExpiry uint32
+		for _, htlc := range invoice.Htlcs {
+			if htlc.State != channeldb.HtlcStateAccepted {
+				continue
+			}
+
+			if minExpiry == 0 || htlc.Expiry < minExpiry {
+				minExpiry = htlc.Expiry
+			}
+		}
+		if minExpiry == 0 {
+			return
+		}
+
+		cancelHeight := int64(minExpiry) - int64(ew.holdExpiryDelta)
+		expiry = invoiceExpiry{
+			paymentHash: hash,
+			expiry:      cancelHeight,
+			byHeight:    true,
+		}
+
+	default:
+		return
+	}
+
+	select {
+	case ew.newExpiries.ChanIn() <- expiry:
+	case <-ew.quit:
+	}
+}
+
+// expiryLoop is the main goroutine of the watcher. It cancels invoices as
+// their expiry time passes or their expiry height is reached.
+//
+// NOTE: This MUST be run as a goroutine.
+func (ew *InvoiceExpiryWatcher) expiryLoop(
+	blockEpochs *chainntnfs.BlockEpochEvent) {
+
+	defer ew.wg.Done()
+	defer blockEpochs.Cancel()
+
+	// bestHeight is the height of the last block we were notified of. We
+	// don't cancel invoices by height until we know the current height.
+	bestHeight := int64(-1)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		ew.cancelExpired(bestHeight)
+
+		// Make sure the timer fires once the next open invoice
+		// expires, draining it first if it already fired.
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		var nextExpiry <-chan time.Time
+		if len(ew.timeQueue) > 0 {
+			timer.Reset(time.Until(
+				time.Unix(0, ew.timeQueue[0].expiry),
+			))
+			nextExpiry = timer.C
+		}
+
+		select {
+		case <-nextExpiry:
+
+		case item := <-ew.newExpiries.ChanOut():
+			expiry := item.(invoiceExpiry)
+			if expiry.byHeight {
+				heap.Push(&ew.heightQueue, expiry)
+			} else {
+				heap.Push(&ew.timeQueue, expiry)
+			}
+
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return
+			}
+			bestHeight = int64(epoch.Height)
+
+		case <-ew.quit:
+			return
+		}
+	}
+}
+
+// cancelExpired cancels all open invoices whose expiry time has passed and
+// all accepted hold invoices whose expiry height has been reached.
+func (ew *InvoiceExpiryWatcher) cancelExpired(bestHeight int64) {
+	now := time.Now().UnixNano()
+	for len(ew.timeQueue) > 0 && ew.timeQueue[0].expiry <= now {
+		expiry := heap.Pop(&ew.timeQueue).(invoiceExpiry)
+		ew.cancel(expiry.paymentHash, false)
+	}
+
+	if bestHeight < 0 {
+		return
+	}
+	for len(ew.heightQueue) > 0 && ew.heightQueue[0].expiry <= bestHeight {
+		expiry := heap.Pop(&ew.heightQueue).(invoiceExpiry)
+		ew.cancel(expiry.paymentHash, true)
+	}
+}
+
+// cancel cancels the invoice with the given payment hash. Invoices that have
+// been settled in the mean time are left untouched.
+func (ew *InvoiceExpiryWatcher) cancel(hash lntypes.Hash,
+	cancelAccepted bool) {
+
+	err := ew.cancelInvoice(hash, cancelAccepted)
+	switch {
+	case err == channeldb.ErrInvoiceAlreadySettled:
+		log.Debugf("Invoice(%v): not canceling expired invoice, "+
+			"already settled", hash)
+
+	case err != nil:
+		log.Errorf("Invoice(%v): unable to cancel expired invoice: %v",
+			hash, err)
+	}
+}
@@ -0,0 +1,180 @@
+package invoices
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BTCGPU/lnd/chainntnfs"
+	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/lntypes"
+)
+
+// cancelRequest is a call to the cancel function of the expiry watcher.
+type cancelRequest struct {
+	hash           lntypes.Hash
+	cancelAccepted bool
+}
+
+// startTestExpiryWatcher starts an expiry watcher that sends all cancel
+// requests on the returned channel.
+func startTestExpiryWatcher(t *testing.T, notifier *mockChainNotifier) (
+	*InvoiceExpiryWatcher, chan cancelRequest) {
+
+	cancels := make(chan cancelRequest, 10)
+	cancelInvoice := func(hash lntypes.Hash, cancelAccepted bool) error {
+		cancels <- cancelRequest{
+			hash:           hash,
+			cancelAccepted: cancelAccepted,
+		}
+		return nil
+	}
+
+	watcher := newTestExpiryWatcher(notifier)
+	err := watcher.Start(cancelInvoice)
+	if err != nil {
+		t.Fatalf("unable to start expiry watcher: %v", err)
+	}
+
+	return watcher, cancels
+}
+
+// assertCanceled asserts that the invoice with the given hash is canceled.
+func assertCanceled(t *testing.T, cancels chan cancelRequest,
+	hash lntypes.Hash, cancelAccepted bool) {
+
+	t.Helper()
+
+	select {
+	case req := <-cancels:
+		if req.hash != hash {
+			t.Fatalf("expected invoice %v to be canceled, got %v",
+				hash, req.hash)
+		}
+		if req.cancelAccepted != cancelAccepted {
+			t.Fatalf("expected cancelAccepted=%v", cancelAccepted)
+		}
+
+	case <-time.After(testTimeout):
+		t.Fatalf("invoice %v not canceled", hash)
+	}
+}
+
+// assertNotCanceled asserts that no invoice is canceled for a short while.
+func assertNotCanceled(t *testing.T, cancels chan cancelRequest) {
+	t.Helper()
+
+	select {
+	case req := <-cancels:
+		t.Fatalf("unexpected cancel of invoice %v", req.hash)
+
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// TestInvoiceExpiryWatcherTime tests that open invoices are canceled once
+// their expiry time has passed.
+func TestInvoiceExpiryWatcherTime(t *testing.T) {
+	t.Parallel()
+
+	watcher, cancels := startTestExpiryWatcher(t, newMockChainNotifier())
+	defer watcher.Stop()
+
+	newInvoice := func(creationDate time.Time,
+		expiry time.Duration) *channeldb.Invoice {
+
+		return &channeldb.Invoice{
+			CreationDate: creationDate,
+			Expiry:       expiry,
+			Terms: channeldb.ContractTerm{
+				State: channeldb.ContractOpen,
+			},
+		}
+	}
+
+	// Invoices without an expiry or with an expiry in the far future must
+	// not be canceled.
+	watcher.AddInvoice(lntypes.Hash{1}, newInvoice(time.Now(), 0))
+	watcher.AddInvoice(lntypes.Hash{2}, newInvoice(time.Now(), time.Hour))
+	assertNotCanceled(t, cancels)
+
+	// An invoice that already expired is canceled right away, while
+	// leaving accepted invoices untouched.
+	expired := newInvoice(time.Now().Add(-time.Hour), time.Minute)
+	watcher.AddInvoice(lntypes.Hash{3}, expired)
+	assertCanceled(t, cancels, lntypes.Hash{3}, false)
+
+	// An invoice that expires shortly is canceled once it expires.
+	watcher.AddInvoice(
+		lntypes.Hash{4}, newInvoice(time.Now(), 200*time.Millisecond),
+	)
+	assertCanceled(t, cancels, lntypes.Hash{4}, false)
+	assertNotCanceled(t, cancels)
+}
+
+// TestInvoiceExpiryWatcherHeight tests that accepted hold invoices are
+// canceled once their earliest expiring htlc gets within the hold expiry delta
+// of its expiry height.
+func TestInvoiceExpiryWatcherHeight(t *testing.T) {
+	t.Parallel()
+
+	notifier := newMockChainNotifier()
+	watcher, cancels := startTestExpiryWatcher(t, notifier)
+	defer watcher.Stop()
+
+	sendBlock := func(height int32) {
+		select {
+		case notifier.epochChan <- &chainntnfs.BlockEpoch{
+			Height: height,
+		}:
+		case <-time.After(testTimeout):
+			t.Fatalf("block not delivered")
+		}
+	}
+
+	// Add an accepted invoice whose earliest accepted htlc expires at
+	// height 100. Canceled htlcs must not be taken into account.
+	invoice := &channeldb.Invoice{
+		Terms: channeldb.ContractTerm{
+			State: channeldb.ContractAccepted,
+		},
+		Htlcs: map[channeldb.CircuitKey]*channeldb.InvoiceHTLC{
+			getCircuitKey(0): {
+				Expiry: 110,
+				State:  channeldb.HtlcStateAccepted,
+			},
+			getCircuitKey(1): {
+				Expiry: 100,
+				State:  channeldb.HtlcStateAccepted,
+			},
+			getCircuitKey(2): {
+				Expiry: 90,
+				State:  channeldb.HtlcStateCanceled,
+			},
+		},
+	}
+	watcher.AddInvoice(hash, invoice)
+
+	// Settled invoices aren't tracked at all.
+	invoice = &channeldb.Invoice{
+		Terms: channeldb.ContractTerm{
+			State: channeldb.ContractSettled,
+		},
+		Htlcs: map[channeldb.CircuitKey]*channeldb.InvoiceHTLC{
+			getCircuitKey(3): {
+				Expiry: 50,
+				State:  channeldb.HtlcStateSettled,
+			},
+		},
+	}
+	watcher.AddInvoice(lntypes.Hash{1}, invoice)
+
+	// The invoice must not be canceled before the htlc is within the hold
+	// expiry delta of its expiry.
+	expiryHeight := int32(100 - testHoldExpiryDelta)
+	sendBlock(expiryHeight - 1)
+	assertNotCanceled(t, cancels)
+
+	sendBlock(expiryHeight)
+	assertCanceled(t, cancels, hash, true)
+	assertNotCanceled(t, cancels)
+}
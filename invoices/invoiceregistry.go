@@ -2,13 +2,16 @@ package invoices
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/BTCGPU/lnd/channeldb"
 	"github.com/BTCGPU/lnd/lntypes"
 	"github.com/BTCGPU/lnd/lnwire"
 	"github.com/BTCGPU/lnd/queue"
+	"github.com/BTCGPU/lnd/record"
 	"github.com/davecgh/go-spew/spew"
 )
 
@@ -29,6 +32,12 @@ var (
 	errNoUpdate = errors.New("no update needed")
 )
 
+const (
+	// DefaultHtlcHoldDuration defines the default for how long mpp htlcs
+	// are held while waiting for the other set members to arrive.
+	DefaultHtlcHoldDuration = 120 * time.Second
+)
+
 // HodlEvent describes how an htlc should be resolved. If HodlEvent.Preimage is
 // set, the event indicates a settle event. If Preimage is nil, it is a cancel
 // event.
@@ -44,6 +53,22 @@ type HodlEvent struct {
 	AcceptHeight int32
 }
 
+// RegistryConfig contains the configuration parameters for the invoice
+// registry.
+type RegistryConfig struct {
+	// FinalCltvRejectDelta defines the number of blocks before the expiry
+	// of the htlc where we no longer settle it as an exit hop and instead
+	// cancel it back. Normally this value should be lower than the cltv
+	// expiry of any invoice we create and the code effectuating this should
+	// not be hit.
+	FinalCltvRejectDelta int32
+
+	// AcceptKeySend indicates whether we want to accept spontaneous key
+	// send payments. For these payments, an invoice is created on the fly
+	// when the htlc arrives.
+	AcceptKeySend bool
+}
+
 // InvoiceRegistry is a central registry of all the outstanding invoices
 // created by the daemon. The registry is a thin wrapper around a map in order
 // to ensure that all updates/reads are thread safe.
@@ -72,12 +97,15 @@ type InvoiceRegistry struct {
 	// subscriber. This is used to unsubscribe from all hashes efficiently.
 	hodlReverseSubscriptions map[chan<- interface{}]map[channeldb.CircuitKey]struct{}
 
-	// finalCltvRejectDelta defines the number of blocks before the expiry
-	// of the htlc where we no longer settle it as an exit hop and instead
-	// cancel it back. Normally this value should be lower than the cltv
-	// expiry of any invoice we create and the code effectuating this should
-	// not be hit.
-	finalCltvRejectDelta int32
+	cfg *RegistryConfig
+
+	// expiryWatcher cancels invoices that expire or whose htlcs are about
+	// to expire.
+	expiryWatcher *InvoiceExpiryWatcher
+
+	// htlcHoldDuration defines for how long mpp htlcs are held while
+	// waiting for the other set members to arrive.
+	htlcHoldDuration time.Duration
 
 	wg   sync.WaitGroup
 	quit chan struct{}
@@ -86,8 +114,10 @@ type InvoiceRegistry struct {
 // NewRegistry creates a new invoice registry. The invoice registry
 // wraps the persistent on-disk invoice storage with an additional in-memory
 // layer. The in-memory layer is in place such that debug invoices can be added
-// which are volatile yet available system wide within the daemon.
-func NewRegistry(cdb *channeldb.DB, finalCltvRejectDelta int32) *InvoiceRegistry {
+// which are volatile yet available system wide within the daemon. The passed
+// expiry watcher is started and stopped along with the registry.
+func NewRegistry(cdb *channeldb.DB, expiryWatcher *InvoiceExpiryWatcher,
+	cfg *RegistryConfig) *InvoiceRegistry {
 
 	return &InvoiceRegistry{
 		cdb:                       cdb,
@@ -98,27 +128,67 @@ func NewRegistry(cdb *channeldb.DB, finalCltvRejectDelta int32) *InvoiceRegistry
 		invoiceEvents:             make(chan interface{}, 100),
 		hodlSubscriptions:         make(map[channeldb.CircuitKey]map[chan<- interface{}]struct{}),
 		hodlReverseSubscriptions:  make(map[chan<- interface{}]map[channeldb.CircuitKey]struct{}),
-		finalCltvRejectDelta:      finalCltvRejectDelta,
+		cfg:                       cfg,
+		expiryWatcher:             expiryWatcher,
+		htlcHoldDuration:          DefaultHtlcHoldDuration,
 		quit:                      make(chan struct{}),
 	}
 }
 
 // Start starts the registry and all goroutines it needs to carry out its task.
 func (i *InvoiceRegistry) Start() error {
+	err := i.expiryWatcher.Start(i.cancelInvoice)
+	if err != nil {
+		return err
+	}
+
 	i.wg.Add(1)
 
 	go i.invoiceEventNotifier()
 
+	// Hand all invoices that are still pending to the expiry watcher, so
+	// that invoices which expired while we were offline are canceled.
+	if err := i.watchPendingInvoices(); err != nil {
+		i.Stop()
+		return err
+	}
+
 	return nil
 }
 
 // Stop signals the registry for a graceful shutdown.
 func (i *InvoiceRegistry) Stop() {
+	i.expiryWatcher.Stop()
+
 	close(i.quit)
 
 	i.wg.Wait()
 }
 
+// watchPendingInvoices adds all open and accepted invoices in the database to
+// the expiry watcher.
+func (i *InvoiceRegistry) watchPendingInvoices() error {
+	invoices, err := i.cdb.FetchAllInvoicesWithPaymentHash(true)
+	switch {
+	case err == channeldb.ErrNoInvoicesCreated:
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	for idx := range invoices {
+		i.expiryWatcher.AddInvoice(
+			invoices[idx].PaymentHash, &invoices[idx].Invoice,
+		)
+	}
+
+	log.Debugf("Added %v pending invoices to the expiry watcher",
+		len(invoices))
+
+	return nil
+}
+
 // invoiceEvent represents a new event that has modified on invoice on disk.
 // Only two event types are currently supported: newly created invoices, and
 // instance where invoices are settled.
@@ -400,6 +470,9 @@ func (i *InvoiceRegistry) AddInvoice(invoice *channeldb.Invoice,
 	// notify the clients of this new invoice.
 	i.notifyClients(paymentHash, invoice, channeldb.ContractOpen)
 
+	// Make sure the invoice is canceled once it expires.
+	i.expiryWatcher.AddInvoice(paymentHash, invoice)
+
 	return addIndex, nil
 }
 
@@ -426,17 +499,50 @@ func (i *InvoiceRegistry) LookupInvoice(rHash lntypes.Hash) (channeldb.Invoice,
 // to be taken on the htlc (settle or cancel). The caller needs to ensure that
 // the channel is either buffered or received on from another goroutine to
 // prevent deadlock.
+//
+// If the payload carries an mpp record, the htlc is held in the accepted state
+// until the full set of htlcs paying to the invoice has arrived. The htlcs of a
+// set that doesn't complete within the hold duration are canceled back.
 func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 	amtPaid lnwire.MilliSatoshi, expiry uint32, currentHeight int32,
 	circuitKey channeldb.CircuitKey, hodlChan chan<- interface{},
-	eob []byte) (*HodlEvent, error) {
+	payload Payload) (*HodlEvent, error) {
 
 	i.Lock()
 	defer i.Unlock()
 
+	// Extract the mpp record, key send preimage and custom records from
+	// the payload, if any. The payload is nil when the htlc is resolved
+	// on-chain.
+	var (
+		mpp           *record.MPP
+		keySend       *lntypes.Preimage
+		customRecords record.CustomSet
+	)
+	if payload != nil {
+		mpp = payload.MultiPath()
+		keySend = payload.KeySendPreimage()
+		customRecords = payload.CustomRecords()
+	}
+
 	debugLog := func(s string) {
-		log.Debugf("Invoice(%x): %v, amt=%v, expiry=%v, circuit=%v",
-			rHash[:], s, amtPaid, expiry, circuitKey)
+		log.Debugf("Invoice(%x): %v, amt=%v, expiry=%v, circuit=%v, "+
+			"mpp=%v", rHash[:], s, amtPaid, expiry, circuitKey, mpp)
+	}
+
+	// If this is a spontaneous key send payment, create an invoice for it
+	// on the fly. From there on, the htlc is processed like a payment to
+	// a regular invoice.
+	if keySend != nil && i.cfg.AcceptKeySend {
+		err := i.processKeySend(rHash, amtPaid, *keySend, mpp)
+		if err != nil {
+			debugLog(fmt.Sprintf("key send error: %v", err))
+
+			return &HodlEvent{
+				CircuitKey:   circuitKey,
+				AcceptHeight: currentHeight,
+			}, nil
+		}
 	}
 
 	// Default is to not update subscribers after the invoice update.
@@ -472,6 +578,34 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 			return nil, errNoUpdate
 		}
 
+		// If the invoice requires a payment address, only htlcs that
+		// carry the matching address in their mpp record are accepted.
+		// This prevents intermediate nodes from probing the invoice
+		// with payments to the same hash.
+		payAddr := inv.Terms.PaymentAddr
+		if requiresPaymentAddr(inv) &&
+			(mpp == nil || mpp.PaymentAddr() != payAddr) {
+
+			debugLog("payment address mismatch")
+			return nil, errNoUpdate
+		}
+
+		// Htlcs that are part of a multi-path payment follow a
+		// separate set of rules.
+		if mpp != nil {
+			update, setComplete, err := i.updateMppInvoice(
+				inv, mpp, amtPaid, expiry, currentHeight,
+				circuitKey, customRecords, debugLog,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			updateSubscribers = setComplete
+
+			return update, nil
+		}
+
 		// If an invoice amount is specified, check that enough
 		// is paid. Also check this for duplicate payments if
 		// the invoice is already settled or accepted.
@@ -481,12 +615,7 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 		}
 
 		// The invoice is still open. Check the expiry.
-		if expiry < uint32(currentHeight+i.finalCltvRejectDelta) {
-			debugLog("expiry too soon")
-			return nil, errNoUpdate
-		}
-
-		if expiry < uint32(currentHeight+inv.FinalCltvDelta) {
+		if !i.checkExpiry(inv, expiry, currentHeight) {
 			debugLog("expiry too soon")
 			return nil, errNoUpdate
 		}
@@ -494,9 +623,10 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 		// Record HTLC in the invoice database.
 		newHtlcs := map[channeldb.CircuitKey]*channeldb.HtlcAcceptDesc{
 			circuitKey: {
-				Amt:          amtPaid,
-				Expiry:       expiry,
-				AcceptHeight: currentHeight,
+				Amt:           amtPaid,
+				Expiry:        expiry,
+				AcceptHeight:  currentHeight,
+				CustomRecords: customRecords,
 			},
 		}
 
@@ -547,6 +677,25 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 
 	if updateSubscribers {
 		i.notifyClients(rHash, invoice, invoice.Terms.State)
+
+		// If this htlc completed an mpp set, the other htlcs of the
+		// set have been settled along with it. Notify the links and
+		// resolvers that are waiting for them.
+		if invoice.Terms.State == channeldb.ContractSettled {
+			for key, htlc := range invoice.Htlcs {
+				if key == circuitKey ||
+					htlc.State != channeldb.HtlcStateSettled {
+
+					continue
+				}
+
+				i.notifyHodlSubscribers(HodlEvent{
+					CircuitKey:   key,
+					Preimage:     &invoice.Terms.PaymentPreimage,
+					AcceptHeight: int32(htlc.AcceptHeight),
+				})
+			}
+		}
 	}
 
 	// Inspect latest htlc state on the invoice.
@@ -582,6 +731,22 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 
 	case channeldb.HtlcStateAccepted:
 		i.hodlSubscribe(hodlChan, circuitKey)
+
+		// An accepted htlc on an invoice that is still open is part
+		// of an incomplete mpp set. Make sure it is released if the
+		// set doesn't complete in time. This also covers htlcs that
+		// are replayed after a restart.
+		if invoice.Terms.State == channeldb.ContractOpen {
+			i.startHtlcTimer(rHash, circuitKey)
+		}
+
+		// An accepted invoice is waiting for the preimage of a hold
+		// invoice. Make sure it is canceled before its htlcs get close
+		// to their expiry.
+		if invoice.Terms.State == channeldb.ContractAccepted {
+			i.expiryWatcher.AddInvoice(rHash, invoice)
+		}
+
 		return nil, nil
 
 	default:
@@ -589,6 +754,239 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 	}
 }
 
+// processKeySend adds an invoice for a spontaneous key send payment, so that
+// the htlc can be settled with the preimage that the sender provided. If an
+// invoice for the payment hash already exists, it is left untouched. The
+// caller must hold the registry lock.
+func (i *InvoiceRegistry) processKeySend(rHash lntypes.Hash,
+	amtPaid lnwire.MilliSatoshi, preimage lntypes.Preimage,
+	mpp *record.MPP) error {
+
+	// Key send payments carry the full amount in a single htlc.
+	if mpp != nil {
+		return errors.New("no mpp allowed for key send")
+	}
+
+	// Only create an invoice if we are able to settle it with the
+	// preimage provided by the sender.
+	if !preimage.Matches(rHash) {
+		return errors.New("invalid key send preimage")
+	}
+
+	// The invoice is created for the amount that is paid. There is no
+	// invoice cltv delta that the sender was told about, so we only
+	// require the minimum delta that we need to settle htlcs safely.
+	invoice := &channeldb.Invoice{
+		CreationDate:   time.Now(),
+		FinalCltvDelta: i.cfg.FinalCltvRejectDelta,
+		Terms: channeldb.ContractTerm{
+			Value:           amtPaid,
+			PaymentPreimage: preimage,
+		},
+	}
+
+	_, err := i.cdb.AddInvoice(invoice, rHash)
+
+	// A duplicate invoice means that this is either a replay of the htlc
+	// or the sender reused the preimage. In both cases, the existing
+	// invoice determines how the htlc is resolved.
+	if err == channeldb.ErrDuplicateInvoice {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("Invoice(%v): added for key send payment of %v", rHash,
+		amtPaid)
+
+	i.notifyClients(rHash, invoice, channeldb.ContractOpen)
+
+	return nil
+}
+
+// requiresPaymentAddr returns whether the features of the invoice require htlcs
+// paying to it to carry its payment address.
+func requiresPaymentAddr(inv *channeldb.Invoice) bool {
+	return inv.Terms.PaymentAddr != channeldb.BlankPayAddr &&
+		inv.Terms.Features != nil &&
+		inv.Terms.Features.IsSet(lnwire.PaymentAddrRequired)
+}
+
+// checkExpiry returns whether the htlc expiry is far enough in the future to
+// accept the htlc as a payment to the invoice.
+func (i *InvoiceRegistry) checkExpiry(inv *channeldb.Invoice, expiry uint32,
+	currentHeight int32) bool {
+
+	if expiry < uint32(currentHeight+i.cfg.FinalCltvRejectDelta) {
+		return false
+	}
+
+	return expiry >= uint32(currentHeight+inv.FinalCltvDelta)
+}
+
+// updateMppInvoice computes the invoice update for an htlc that is part of a
+// multi-path payment. The htlc is added to the set if it is consistent with
+// the htlcs that arrived before. As long as the set is incomplete, the invoice
+// stays open. Once the set total is reached, the invoice is settled or moved
+// to the accepted state in case of a hold invoice. The returned boolean
+// indicates whether the set was completed by this htlc.
+func (i *InvoiceRegistry) updateMppInvoice(inv *channeldb.Invoice,
+	mpp *record.MPP, amtPaid lnwire.MilliSatoshi, expiry uint32,
+	currentHeight int32, circuitKey channeldb.CircuitKey,
+	customRecords record.CustomSet, debugLog func(string)) (
+	*channeldb.InvoiceUpdateDesc, bool, error) {
+
+	// Once the set is complete, the invoice moves out of the open state
+	// and no more mpp htlcs are accepted.
+	if inv.Terms.State != channeldb.ContractOpen {
+		debugLog("mpp htlc for invoice that is not open")
+		return nil, false, errNoUpdate
+	}
+
+	// The total amount signaled by the sender must be enough to pay the
+	// invoice.
+	if inv.Terms.Value > 0 && mpp.TotalMsat() < inv.Terms.Value {
+		debugLog("mpp total too low")
+		return nil, false, errNoUpdate
+	}
+
+	// Check that the htlc agrees on the set total with the htlcs that are
+	// already being held and compute the new set total.
+	setTotal := amtPaid
+	for _, htlc := range inv.Htlcs {
+		if htlc.State != channeldb.HtlcStateAccepted {
+			continue
+		}
+
+		if htlc.MppTotalAmt != mpp.TotalMsat() {
+			debugLog("mpp total mismatch")
+			return nil, false, errNoUpdate
+		}
+
+		setTotal += htlc.Amt
+	}
+
+	// Don't accept htlcs that would push the set over its total.
+	if setTotal > mpp.TotalMsat() {
+		debugLog("mpp set overpaid")
+		return nil, false, errNoUpdate
+	}
+
+	if !i.checkExpiry(inv, expiry, currentHeight) {
+		debugLog("expiry too soon")
+		return nil, false, errNoUpdate
+	}
+
+	update := channeldb.InvoiceUpdateDesc{
+		State: channeldb.ContractOpen,
+		Htlcs: map[channeldb.CircuitKey]*channeldb.HtlcAcceptDesc{
+			circuitKey: {
+				Amt:           amtPaid,
+				MppTotalAmt:   mpp.TotalMsat(),
+				Expiry:        expiry,
+				AcceptHeight:  currentHeight,
+				CustomRecords: customRecords,
+			},
+		},
+	}
+
+	// If the set isn't complete yet, we'll hold on to the htlc and keep
+	// the invoice open.
+	if setTotal < mpp.TotalMsat() {
+		debugLog("mpp htlc accepted, waiting for set to complete")
+		return &update, false, nil
+	}
+
+	// The set is complete. Check to see if we can settle or this is an
+	// hold invoice and we need to wait for the preimage.
+	holdInvoice := inv.Terms.PaymentPreimage == channeldb.UnknownPreimage
+	if holdInvoice {
+		debugLog("mpp set complete, accepted")
+		update.State = channeldb.ContractAccepted
+	} else {
+		debugLog("mpp set complete, settled")
+		update.Preimage = inv.Terms.PaymentPreimage
+		update.State = channeldb.ContractSettled
+	}
+
+	return &update, true, nil
+}
+
+// startHtlcTimer launches a goroutine that cancels the given htlc back if it is
+// still part of an incomplete mpp set once the hold duration has passed.
+func (i *InvoiceRegistry) startHtlcTimer(hash lntypes.Hash,
+	key channeldb.CircuitKey) {
+
+	timeout := time.After(i.htlcHoldDuration)
+
+	i.wg.Add(1)
+	go func() {
+		defer i.wg.Done()
+
+		select {
+		case <-timeout:
+		case <-i.quit:
+			return
+		}
+
+		if err := i.cancelSetHtlc(hash, key); err != nil {
+			log.Warnf("Invoice(%v): unable to cancel htlc %v of "+
+				"incomplete set: %v", hash, key, err)
+		}
+	}()
+}
+
+// cancelSetHtlc cancels a single accepted htlc of an incomplete mpp set. If
+// the set has completed in the mean time, this is a noop.
+func (i *InvoiceRegistry) cancelSetHtlc(hash lntypes.Hash,
+	key channeldb.CircuitKey) error {
+
+	i.Lock()
+	defer i.Unlock()
+
+	updateInvoice := func(inv *channeldb.Invoice) (
+		*channeldb.InvoiceUpdateDesc, error) {
+
+		// Only htlcs held for an open invoice are part of an
+		// incomplete set.
+		if inv.Terms.State != channeldb.ContractOpen {
+			return nil, errNoUpdate
+		}
+
+		htlc, ok := inv.Htlcs[key]
+		if !ok || htlc.State != channeldb.HtlcStateAccepted {
+			return nil, errNoUpdate
+		}
+
+		return &channeldb.InvoiceUpdateDesc{
+			State: channeldb.ContractOpen,
+			Htlcs: map[channeldb.CircuitKey]*channeldb.HtlcAcceptDesc{
+				key: nil,
+			},
+		}, nil
+	}
+
+	invoice, err := i.cdb.UpdateInvoice(hash, updateInvoice)
+	switch {
+	case err == errNoUpdate:
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	log.Debugf("Invoice(%v): canceled htlc %v of incomplete set", hash,
+		key)
+
+	i.notifyHodlSubscribers(HodlEvent{
+		CircuitKey:   key,
+		AcceptHeight: int32(invoice.Htlcs[key].AcceptHeight),
+	})
+
+	return nil
+}
+
 // SettleHodlInvoice sets the preimage of a hodl invoice.
 func (i *InvoiceRegistry) SettleHodlInvoice(preimage lntypes.Preimage) error {
 	i.Lock()
@@ -647,6 +1045,17 @@ func (i *InvoiceRegistry) SettleHodlInvoice(preimage lntypes.Preimage) error {
 // CancelInvoice attempts to cancel the invoice corresponding to the passed
 // payment hash.
 func (i *InvoiceRegistry) CancelInvoice(payHash lntypes.Hash) error {
+	return i.cancelInvoice(payHash, true)
+}
+
+// cancelInvoice attempts to cancel the invoice corresponding to the passed
+// payment hash. If cancelAccepted is false, an invoice in the accepted state
+// is left untouched. This is used when an invoice expires, as an accepted
+// hold invoice is waiting for its preimage and should only be canceled once
+// its htlcs are about to expire.
+func (i *InvoiceRegistry) cancelInvoice(payHash lntypes.Hash,
+	cancelAccepted bool) error {
+
 	i.Lock()
 	defer i.Unlock()
 
@@ -660,6 +1069,10 @@ func (i *InvoiceRegistry) CancelInvoice(payHash lntypes.Hash) error {
 			return nil, channeldb.ErrInvoiceAlreadySettled
 		case channeldb.ContractCanceled:
 			return nil, channeldb.ErrInvoiceAlreadyCanceled
+		case channeldb.ContractAccepted:
+			if !cancelAccepted {
+				return nil, errNoUpdate
+			}
 		}
 
 		// Mark individual held htlcs as canceled.
@@ -698,6 +1111,11 @@ func (i *InvoiceRegistry) CancelInvoice(payHash lntypes.Hash) error {
 		log.Debugf("Invoice(%v): already canceled", payHash)
 		return nil
 	}
+	if err == errNoUpdate {
+		log.Debugf("Invoice(%v): not canceling accepted invoice",
+			payHash)
+		return nil
+	}
 	if err != nil {
 		return err
 	}
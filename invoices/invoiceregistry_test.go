@@ -3,12 +3,15 @@ package invoices
 import (
 	"io/ioutil"
 	"os"
+	"reflect"
 	"testing"
 	"time"
 
+	"github.com/BTCGPU/lnd/chainntnfs"
 	"github.com/BTCGPU/lnd/channeldb"
 	"github.com/BTCGPU/lnd/lntypes"
 	"github.com/BTCGPU/lnd/lnwire"
+	"github.com/BTCGPU/lnd/record"
 )
 
 var (
@@ -28,6 +31,8 @@ var (
 	testFinalCltvRejectDelta = int32(4)
 
 	testCurrentHeight = int32(1)
+
+	testHoldExpiryDelta = uint32(2)
 )
 
 var (
@@ -46,7 +51,12 @@ func newTestContext(t *testing.T) (*InvoiceRegistry, func()) {
 	}
 
 	// Instantiate and start the invoice registry.
-	registry := NewRegistry(cdb, testFinalCltvRejectDelta)
+	registry := NewRegistry(
+		cdb, newTestExpiryWatcher(newMockChainNotifier()),
+		&RegistryConfig{
+			FinalCltvRejectDelta: testFinalCltvRejectDelta,
+		},
+	)
 
 	err = registry.Start()
 	if err != nil {
@@ -359,7 +369,12 @@ func TestSettleHoldInvoice(t *testing.T) {
 	defer cleanup()
 
 	// Instantiate and start the invoice registry.
-	registry := NewRegistry(cdb, testFinalCltvRejectDelta)
+	registry := NewRegistry(
+		cdb, newTestExpiryWatcher(newMockChainNotifier()),
+		&RegistryConfig{
+			FinalCltvRejectDelta: testFinalCltvRejectDelta,
+		},
+	)
 
 	err = registry.Start()
 	if err != nil {
@@ -534,7 +549,12 @@ func TestCancelHoldInvoice(t *testing.T) {
 	defer cleanup()
 
 	// Instantiate and start the invoice registry.
-	registry := NewRegistry(cdb, testFinalCltvRejectDelta)
+	registry := NewRegistry(
+		cdb, newTestExpiryWatcher(newMockChainNotifier()),
+		&RegistryConfig{
+			FinalCltvRejectDelta: testFinalCltvRejectDelta,
+		},
+	)
 
 	err = registry.Start()
 	if err != nil {
@@ -645,3 +665,570 @@ func TestUnknownInvoice(t *testing.T) {
 		t.Fatal("expected invoice not found error")
 	}
 }
+
+// mockPayload implements the Payload interface for testing.
+type mockPayload struct {
+	mpp           *record.MPP
+	keySend       *lntypes.Preimage
+	customRecords record.CustomSet
+}
+
+// MultiPath returns the mpp record of the payload.
+func (p *mockPayload) MultiPath() *record.MPP {
+	return p.mpp
+}
+
+// KeySendPreimage returns the key send preimage of the payload.
+func (p *mockPayload) KeySendPreimage() *lntypes.Preimage {
+	return p.keySend
+}
+
+// CustomRecords returns the custom records of the payload.
+func (p *mockPayload) CustomRecords() record.CustomSet {
+	return p.customRecords
+}
+
+// TestMppPayment tests settling of an invoice with multiple partial payments.
+// It covers the case where there is a mpp timeout before the whole invoice is
+// paid and the case where the invoice is settled in time.
+func TestMppPayment(t *testing.T) {
+	defer timeout(t)()
+
+	registry, cleanup := newTestContext(t)
+	defer cleanup()
+
+	// Release incomplete sets quickly.
+	registry.htlcHoldDuration = 100 * time.Millisecond
+
+	// Add the invoice.
+	_, err := registry.AddInvoice(testInvoice, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mppPayload := &mockPayload{
+		mpp: record.NewMPP(testInvoice.Terms.Value, [32]byte{}),
+	}
+
+	hodlChan1 := make(chan interface{}, 1)
+
+	// Send htlc 1 for half the invoice amount. The set is incomplete, so
+	// the htlc should be held.
+	event, err := registry.NotifyExitHopHtlc(
+		hash, testInvoice.Terms.Value/2, testHtlcExpiry,
+		testCurrentHeight, getCircuitKey(10), hodlChan1, mppPayload,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event != nil {
+		t.Fatal("expected no direct resolution")
+	}
+
+	// Without the other half arriving, htlc 1 should be canceled back
+	// after the hold duration.
+	select {
+	case event := <-hodlChan1:
+		hodlEvent := event.(HodlEvent)
+		if hodlEvent.Preimage != nil {
+			t.Fatal("expected cancel event")
+		}
+		if hodlEvent.CircuitKey != getCircuitKey(10) {
+			t.Fatal("cancel event for wrong htlc")
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("timeout waiting for htlc cancel")
+	}
+
+	// Now make sure the set doesn't time out before it completes.
+	registry.htlcHoldDuration = testTimeout
+
+	// Send htlc 2.
+	hodlChan2 := make(chan interface{}, 1)
+	event, err = registry.NotifyExitHopHtlc(
+		hash, testInvoice.Terms.Value/2, testHtlcExpiry,
+		testCurrentHeight, getCircuitKey(11), hodlChan2, mppPayload,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event != nil {
+		t.Fatal("expected no direct resolution")
+	}
+
+	// An htlc that signals a different total shouldn't be accepted as
+	// part of the set.
+	wrongPayload := &mockPayload{
+		mpp: record.NewMPP(testInvoice.Terms.Value*2, [32]byte{}),
+	}
+	event, err = registry.NotifyExitHopHtlc(
+		hash, testInvoice.Terms.Value/2, testHtlcExpiry,
+		testCurrentHeight, getCircuitKey(12), nil, wrongPayload,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event == nil || event.Preimage != nil {
+		t.Fatal("expected cancel event")
+	}
+
+	// Send htlc 3, which completes the set.
+	hodlChan3 := make(chan interface{}, 1)
+	event, err = registry.NotifyExitHopHtlc(
+		hash, testInvoice.Terms.Value/2, testHtlcExpiry,
+		testCurrentHeight, getCircuitKey(13), hodlChan3, mppPayload,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event == nil || event.Preimage == nil {
+		t.Fatal("expected settle event")
+	}
+
+	// Htlc 2 should be settled along with it.
+	select {
+	case event := <-hodlChan2:
+		hodlEvent := event.(HodlEvent)
+		if hodlEvent.Preimage == nil {
+			t.Fatal("expected settle event")
+		}
+		if hodlEvent.CircuitKey != getCircuitKey(11) {
+			t.Fatal("settle event for wrong htlc")
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("timeout waiting for htlc settle")
+	}
+
+	// Check that the invoice is settled with the amount of the set.
+	inv, err := registry.LookupInvoice(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.Terms.State != channeldb.ContractSettled {
+		t.Fatal("expected invoice to be settled")
+	}
+	if inv.AmtPaid != testInvoice.Terms.Value {
+		t.Fatalf("amount incorrect, expected %v but got %v",
+			testInvoice.Terms.Value, inv.AmtPaid)
+	}
+
+	expectedStates := map[uint64]channeldb.HtlcState{
+		10: channeldb.HtlcStateCanceled,
+		11: channeldb.HtlcStateSettled,
+		13: channeldb.HtlcStateSettled,
+	}
+	for key, htlc := range inv.Htlcs {
+		expState, ok := expectedStates[key.HtlcID]
+		if !ok {
+			t.Fatalf("unexpected htlc %v", key)
+		}
+		if htlc.State != expState {
+			t.Fatalf("expected htlc %v in state %v, got %v", key,
+				expState, htlc.State)
+		}
+	}
+}
+
+// TestPaymentAddr tests that htlcs paying to an invoice that requires a payment
+// address are only accepted if they carry the correct one.
+func TestPaymentAddr(t *testing.T) {
+	defer timeout(t)()
+
+	registry, cleanup := newTestContext(t)
+	defer cleanup()
+
+	payAddr := [32]byte{1, 2, 3}
+
+	invoice := *testInvoice
+	invoice.Terms.PaymentAddr = payAddr
+	invoice.Terms.Features = lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadRequired,
+			lnwire.PaymentAddrRequired,
+		), lnwire.GlobalFeatures,
+	)
+	_, err := registry.AddInvoice(&invoice, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Htlcs without a payment address or with a different one must be
+	// canceled.
+	payloads := []*mockPayload{
+		{},
+		{mpp: record.NewMPP(testInvoice.Terms.Value, [32]byte{})},
+		{mpp: record.NewMPP(testInvoice.Terms.Value, [32]byte{4})},
+	}
+	for i, payload := range payloads {
+		event, err := registry.NotifyExitHopHtlc(
+			hash, testInvoice.Terms.Value, testHtlcExpiry,
+			testCurrentHeight, getCircuitKey(uint64(i)), nil,
+			payload,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if event == nil || event.Preimage != nil {
+			t.Fatalf("expected cancel event for payload %v", i)
+		}
+	}
+
+	// An htlc that carries the correct payment address settles the
+	// invoice.
+	payload := &mockPayload{
+		mpp: record.NewMPP(testInvoice.Terms.Value, payAddr),
+	}
+	event, err := registry.NotifyExitHopHtlc(
+		hash, testInvoice.Terms.Value, testHtlcExpiry,
+		testCurrentHeight, getCircuitKey(10), nil, payload,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event == nil || event.Preimage == nil {
+		t.Fatal("expected settle event")
+	}
+
+	inv, err := registry.LookupInvoice(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.Terms.State != channeldb.ContractSettled {
+		t.Fatal("expected invoice to be settled")
+	}
+}
+
+// TestPaymentAddrOptional tests that htlcs without a payment address are
+// accepted if the invoice only signals support for payment addresses, so that
+// payers that don't support them can still pay the invoice.
+func TestPaymentAddrOptional(t *testing.T) {
+	defer timeout(t)()
+
+	registry, cleanup := newTestContext(t)
+	defer cleanup()
+
+	invoice := *testInvoice
+	invoice.Terms.PaymentAddr = [32]byte{1, 2, 3}
+	invoice.Terms.Features = lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadOptional,
+			lnwire.PaymentAddrOptional,
+		), lnwire.GlobalFeatures,
+	)
+	_, err := registry.AddInvoice(&invoice, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event, err := registry.NotifyExitHopHtlc(
+		hash, testInvoice.Terms.Value, testHtlcExpiry,
+		testCurrentHeight, getCircuitKey(0), nil, &mockPayload{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event == nil || event.Preimage == nil {
+		t.Fatal("expected settle event")
+	}
+
+	inv, err := registry.LookupInvoice(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.Terms.State != channeldb.ContractSettled {
+		t.Fatal("expected invoice to be settled")
+	}
+}
+
+// TestKeySend tests receiving a spontaneous payment with key send accepted and
+// rejected.
+func TestKeySend(t *testing.T) {
+	t.Run("enabled", func(t *testing.T) {
+		testKeySend(t, true)
+	})
+	t.Run("disabled", func(t *testing.T) {
+		testKeySend(t, false)
+	})
+}
+
+func testKeySend(t *testing.T, keySendEnabled bool) {
+	defer timeout(t)()
+
+	registry, cleanup := newTestContext(t)
+	defer cleanup()
+
+	registry.cfg.AcceptKeySend = keySendEnabled
+
+	allSubscriptions := registry.SubscribeNotifications(0, 0)
+	defer allSubscriptions.Cancel()
+
+	hodlChan := make(chan interface{}, 1)
+
+	amt := lnwire.MilliSatoshi(1000)
+	expiry := uint32(testCurrentHeight + 20)
+
+	// Create key send payload with a preimage that doesn't match the
+	// payment hash.
+	invalidPreimage := lntypes.Preimage{1, 2, 3}
+	invalidKeySendPayload := &mockPayload{
+		keySend: &invalidPreimage,
+	}
+
+	event, err := registry.NotifyExitHopHtlc(
+		hash, amt, expiry, testCurrentHeight, getCircuitKey(10),
+		hodlChan, invalidKeySendPayload,
+	)
+
+	// Without key send, there is no invoice to pay. With key send, the
+	// htlc must be canceled because the preimage is invalid.
+	if !keySendEnabled {
+		if err != channeldb.ErrInvoiceNotFound {
+			t.Fatal("expected invoice not found error")
+		}
+	} else {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if event.Preimage != nil {
+			t.Fatal("expected invalid key send htlc to be canceled")
+		}
+	}
+
+	// Try to settle invoice with a valid key send htlc. The htlc also
+	// carries a custom record that should be stored with the invoice.
+	customRecords := record.CustomSet{
+		record.CustomTypeStart + 1: []byte{1, 2, 3},
+	}
+	keySendPayload := &mockPayload{
+		keySend:       &preimage,
+		customRecords: customRecords,
+	}
+
+	event, err = registry.NotifyExitHopHtlc(
+		hash, amt, expiry, testCurrentHeight, getCircuitKey(11),
+		hodlChan, keySendPayload,
+	)
+
+	if !keySendEnabled {
+		if err != channeldb.ErrInvoiceNotFound {
+			t.Fatal("expected invoice not found error")
+		}
+		return
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Preimage == nil || *event.Preimage != preimage {
+		t.Fatal("expected valid key send htlc to be settled")
+	}
+
+	// We expect a new invoice notification to be sent out.
+	select {
+	case newInvoice := <-allSubscriptions.NewInvoices:
+		if newInvoice.Terms.State != channeldb.ContractOpen {
+			t.Fatalf("expected state ContractOpen, but got %v",
+				newInvoice.Terms.State)
+		}
+		if newInvoice.Terms.Value != amt {
+			t.Fatalf("expected invoice value %v, but got %v", amt,
+				newInvoice.Terms.Value)
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("no update received")
+	}
+
+	// We expect a settled notification to be sent out.
+	select {
+	case settledInvoice := <-allSubscriptions.SettledInvoices:
+		if settledInvoice.Terms.State != channeldb.ContractSettled {
+			t.Fatalf("expected state ContractSettled, but got %v",
+				settledInvoice.Terms.State)
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("no update received")
+	}
+
+	// Check that the custom records were stored with the htlc.
+	inv, err := registry.LookupInvoice(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	htlc, ok := inv.Htlcs[getCircuitKey(11)]
+	if !ok {
+		t.Fatal("expected htlc to be recorded")
+	}
+	if !reflect.DeepEqual(htlc.CustomRecords, customRecords) {
+		t.Fatalf("expected custom records %v, got %v", customRecords,
+			htlc.CustomRecords)
+	}
+}
+
+// TestInvoiceExpiry tests that open invoices are canceled once they expire,
+// including invoices that expired while the registry wasn't running.
+func TestInvoiceExpiry(t *testing.T) {
+	defer timeout(t)()
+
+	cdb, cleanup, err := newDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	newExpiredInvoice := func(
+		preimage lntypes.Preimage) *channeldb.Invoice {
+
+		return &channeldb.Invoice{
+			CreationDate: time.Now().Add(-time.Hour),
+			Expiry:       time.Minute,
+			Terms: channeldb.ContractTerm{
+				PaymentPreimage: preimage,
+				Value:           lnwire.MilliSatoshi(100000),
+			},
+		}
+	}
+
+	// Add an expired invoice before the registry is started.
+	offlinePreimage := lntypes.Preimage{2}
+	offlineHash := offlinePreimage.Hash()
+	_, err = cdb.AddInvoice(newExpiredInvoice(offlinePreimage), offlineHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	registry := NewRegistry(
+		cdb, newTestExpiryWatcher(newMockChainNotifier()),
+		&RegistryConfig{
+			FinalCltvRejectDelta: testFinalCltvRejectDelta,
+		},
+	)
+
+	err = registry.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer registry.Stop()
+
+	// assertCanceled waits for the invoice with the given hash to be
+	// canceled.
+	assertCanceled := func(hash lntypes.Hash) {
+		subscription, err := registry.SubscribeSingleInvoice(hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer subscription.Cancel()
+
+		for update := range subscription.Updates {
+			if update.Terms.State == channeldb.ContractCanceled {
+				return
+			}
+		}
+	}
+
+	// The invoice that expired while the registry was offline should be
+	// canceled on startup.
+	assertCanceled(offlineHash)
+
+	// An expired invoice that is added to the registry should be canceled
+	// as well.
+	_, err = registry.AddInvoice(newExpiredInvoice(preimage), hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertCanceled(hash)
+}
+
+// TestHoldInvoiceExpiry tests that an accepted hold invoice is canceled once
+// its htlc gets within the hold expiry delta of its expiry height, and that
+// the htlc is canceled back.
+func TestHoldInvoiceExpiry(t *testing.T) {
+	defer timeout(t)()
+
+	cdb, cleanup, err := newDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	notifier := newMockChainNotifier()
+	registry := NewRegistry(
+		cdb, newTestExpiryWatcher(notifier),
+		&RegistryConfig{
+			FinalCltvRejectDelta: testFinalCltvRejectDelta,
+		},
+	)
+
+	err = registry.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer registry.Stop()
+
+	subscription, err := registry.SubscribeSingleInvoice(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer subscription.Cancel()
+
+	// Add a hold invoice that expires far in the future, so that only the
+	// htlc expiry can cause it to be canceled.
+	invoice := &channeldb.Invoice{
+		CreationDate: time.Now(),
+		Expiry:       time.Hour,
+		Terms: channeldb.ContractTerm{
+			PaymentPreimage: channeldb.UnknownPreimage,
+			Value:           lnwire.MilliSatoshi(100000),
+		},
+	}
+	if _, err := registry.AddInvoice(invoice, hash); err != nil {
+		t.Fatal(err)
+	}
+
+	hodlChan := make(chan interface{}, 1)
+	event, err := registry.NotifyExitHopHtlc(
+		hash, invoice.Terms.Value, testHtlcExpiry, testCurrentHeight,
+		getCircuitKey(0), hodlChan, nil,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event != nil {
+		t.Fatalf("expected htlc to be held")
+	}
+
+	// Wait for the invoice to be accepted.
+	for update := range subscription.Updates {
+		if update.Terms.State == channeldb.ContractAccepted {
+			break
+		}
+	}
+
+	// A block right before the hold expiry delta is reached shouldn't
+	// cancel the invoice.
+	expiryHeight := int32(testHtlcExpiry - testHoldExpiryDelta)
+	notifier.epochChan <- &chainntnfs.BlockEpoch{Height: expiryHeight - 1}
+
+	select {
+	case <-hodlChan:
+		t.Fatalf("unexpected htlc resolution")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Once the hold expiry delta is reached, the invoice is canceled and
+	// the htlc canceled back.
+	notifier.epochChan <- &chainntnfs.BlockEpoch{Height: expiryHeight}
+
+	resolution := (<-hodlChan).(HodlEvent)
+	if resolution.Preimage != nil {
+		t.Fatalf("expected htlc to be canceled")
+	}
+	if resolution.CircuitKey != getCircuitKey(0) {
+		t.Fatalf("unexpected circuit key %v", resolution.CircuitKey)
+	}
+
+	update := <-subscription.Updates
+	if update.Terms.State != channeldb.ContractCanceled {
+		t.Fatalf("expected state ContractCanceled, but got %v",
+			update.Terms.State)
+	}
+}
@@ -5,6 +5,10 @@ import (
 	"runtime/pprof"
 	"testing"
 	"time"
+
+	"github.com/BTCGPU/lnd/chainntnfs"
+	"github.com/btgsuite/btgd/chaincfg/chainhash"
+	"github.com/btgsuite/btgd/wire"
 )
 
 // timeout implements a test level timeout.
@@ -24,3 +28,51 @@ func timeout(t *testing.T) func() {
 		close(done)
 	}
 }
+
+// mockChainNotifier is a chain notifier that delivers the block epochs that
+// are sent on its epochChan.
+type mockChainNotifier struct {
+	epochChan chan *chainntnfs.BlockEpoch
+}
+
+func newMockChainNotifier() *mockChainNotifier {
+	return &mockChainNotifier{
+		epochChan: make(chan *chainntnfs.BlockEpoch),
+	}
+}
+
+func (m *mockChainNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
+	_ []byte, numConfs, heightHint uint32) (*chainntnfs.ConfirmationEvent,
+	error) {
+
+	return nil, nil
+}
+
+func (m *mockChainNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
+	_ []byte, heightHint uint32) (*chainntnfs.SpendEvent, error) {
+
+	return nil, nil
+}
+
+func (m *mockChainNotifier) RegisterBlockEpochNtfn(
+	bestBlock *chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	return &chainntnfs.BlockEpochEvent{
+		Epochs: m.epochChan,
+		Cancel: func() {},
+	}, nil
+}
+
+func (m *mockChainNotifier) Start() error {
+	return nil
+}
+
+func (m *mockChainNotifier) Stop() error {
+	return nil
+}
+
+// newTestExpiryWatcher creates an expiry watcher that receives its blocks from
+// the given notifier.
+func newTestExpiryWatcher(notifier *mockChainNotifier) *InvoiceExpiryWatcher {
+	return NewInvoiceExpiryWatcher(testHoldExpiryDelta, notifier)
+}
@@ -0,0 +1,72 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultFeePolicyInterval is the default interval at which the fee
+	// rules of our channels are evaluated.
+	DefaultFeePolicyInterval = 10 * time.Minute
+
+	// DefaultFeePolicyMinUpdateInterval is the default minimum time
+	// between two fee updates of the same channel.
+	DefaultFeePolicyMinUpdateInterval = time.Hour
+
+	// DefaultFeePolicyMinRelativeChange is the default minimum relative
+	// change of the fee rate of a channel that causes a fee update.
+	DefaultFeePolicyMinRelativeChange = 0.1
+)
+
+// FeePolicy holds the configuration options for the daemon's fee policy
+// manager.
+type FeePolicy struct {
+	// Active determines whether the fees of our channels should be
+	// managed according to the rules file.
+	Active bool `long:"active" description:"Whether the daemon should automatically update the fees of channels according to the rules in the rules file."`
+
+	// RulesFile is the path of the json file that holds the fee rules.
+	RulesFile string `long:"rulesfile" description:"The path of the json file that holds the fee rules of the channels."`
+
+	// Interval is the interval at which the fee rules are evaluated.
+	Interval time.Duration `long:"interval" description:"The interval at which the fee rules of the channels are evaluated."`
+
+	// MinUpdateInterval is the minimum time between two fee updates of the
+	// same channel.
+	MinUpdateInterval time.Duration `long:"minupdateinterval" description:"The minimum time between two fee updates of the same channel. Limits the number of channel updates that are broadcast to the network."`
+
+	// MinRelativeChange is the minimum relative change of the fee rate of
+	// a channel that causes a fee update.
+	MinRelativeChange float64 `long:"minrelativechange" description:"The minimum relative change of the fee rate of a channel that causes a fee update, e.g. 0.1 for 10%."`
+}
+
+// Validate ensures the user has provided a valid configuration.
+//
+// NOTE: Part of the Validator interface.
+func (f *FeePolicy) Validate() error {
+	if !f.Active {
+		return nil
+	}
+
+	if f.RulesFile == "" {
+		return fmt.Errorf("fee policy rules file must be set")
+	}
+	if f.Interval <= 0 {
+		return fmt.Errorf("fee policy interval must be positive")
+	}
+	if f.MinUpdateInterval < 0 {
+		return fmt.Errorf("fee policy min update interval must not " +
+			"be negative")
+	}
+	if f.MinRelativeChange < 0 {
+		return fmt.Errorf("fee policy min relative change must not " +
+			"be negative")
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure FeePolicy implements the Validator
+// interface.
+var _ Validator = (*FeePolicy)(nil)
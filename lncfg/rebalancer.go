@@ -0,0 +1,87 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultRebalanceInterval is the default interval at which the
+	// rebalancer checks the balances of our channels.
+	DefaultRebalanceInterval = 10 * time.Minute
+
+	// DefaultRebalanceMinLocalRatio is the default lowest acceptable share
+	// of the capacity of a channel that is on our side.
+	DefaultRebalanceMinLocalRatio = 0.2
+
+	// DefaultRebalanceMaxLocalRatio is the default highest acceptable share
+	// of the capacity of a channel that is on our side.
+	DefaultRebalanceMaxLocalRatio = 0.8
+
+	// DefaultRebalanceMaxAmount is the default maximum amount in satoshis
+	// that is moved by a single rebalance.
+	DefaultRebalanceMaxAmount = 1000000
+
+	// DefaultRebalanceMaxFee is the default maximum fee in satoshis that
+	// is paid for a single rebalance.
+	DefaultRebalanceMaxFee = 100
+)
+
+// Rebalancer holds the configuration options for the daemon's channel
+// rebalancer.
+type Rebalancer struct {
+	// Active determines whether the rebalancer should keep the balances of
+	// our channels within their target range.
+	Active bool `long:"active" description:"Whether the daemon should automatically rebalance channels whose local balance is out of the target range by sending circular payments."`
+
+	// Interval is the interval at which the channel balances are checked.
+	Interval time.Duration `long:"interval" description:"The interval at which the balances of the channels are checked."`
+
+	// MinLocalRatio is the lowest acceptable share of the capacity of a
+	// channel that is on our side.
+	MinLocalRatio float64 `long:"minlocalratio" description:"The lowest acceptable share of the capacity of a channel that is on our side. Channels below it are rebalanced. Valid values are within [0, 1)."`
+
+	// MaxLocalRatio is the highest acceptable share of the capacity of a
+	// channel that is on our side.
+	MaxLocalRatio float64 `long:"maxlocalratio" description:"The highest acceptable share of the capacity of a channel that is on our side. Channels above it are rebalanced. Valid values are within (0, 1]."`
+
+	// MaxAmount is the maximum amount in satoshis that is moved by a
+	// single rebalance.
+	MaxAmount int64 `long:"maxamount" description:"The maximum amount in satoshis that is moved by a single rebalance."`
+
+	// MaxFee is the maximum fee in satoshis that is paid for a single
+	// rebalance.
+	MaxFee int64 `long:"maxfee" description:"The maximum fee in satoshis that is paid for a single rebalance."`
+}
+
+// Validate ensures the user has provided a valid configuration.
+//
+// NOTE: Part of the Validator interface.
+func (r *Rebalancer) Validate() error {
+	if !r.Active {
+		return nil
+	}
+
+	if r.Interval <= 0 {
+		return fmt.Errorf("rebalance interval must be positive")
+	}
+	if r.MinLocalRatio < 0 || r.MaxLocalRatio > 1 ||
+		r.MinLocalRatio >= r.MaxLocalRatio {
+
+		return fmt.Errorf("rebalance local ratios must satisfy "+
+			"0 <= minlocalratio (%v) < maxlocalratio (%v) <= 1",
+			r.MinLocalRatio, r.MaxLocalRatio)
+	}
+	if r.MaxAmount <= 0 {
+		return fmt.Errorf("rebalance max amount must be positive")
+	}
+	if r.MaxFee < 0 {
+		return fmt.Errorf("rebalance max fee must not be negative")
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure Rebalancer implements the Validator
+// interface.
+var _ Validator = (*Rebalancer)(nil)
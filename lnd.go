@@ -30,6 +30,7 @@ import (
 	_ "net/http/pprof"
 
 	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -191,6 +192,7 @@ func Main(lisCfg ListenerCfg) error {
 		channeldb.OptionSetRejectCacheSize(cfg.Caches.RejectCacheSize),
 		channeldb.OptionSetChannelCacheSize(cfg.Caches.ChannelCacheSize),
 		channeldb.OptionSetSyncFreelist(cfg.SyncFreelist),
+		channeldb.OptionAutoCompact(cfg.AutoCompactDB),
 	)
 	if err != nil {
 		err := fmt.Errorf("Unable to open channeldb: %v", err)
@@ -338,6 +340,7 @@ func Main(lisCfg ListenerCfg) error {
 		// Create the macaroon authentication/authorization service.
 		macaroonService, err = macaroons.NewService(
 			networkDir, macaroons.IPLockChecker,
+			macaroons.MethodChecker,
 		)
 		if err != nil {
 			err := fmt.Errorf("Unable to set up macaroon "+
@@ -369,6 +372,20 @@ func Main(lisCfg ListenerCfg) error {
 				ltndLog.Error(err)
 				return err
 			}
+		} else if cfg.UpgradeAdminMac && fileExists(cfg.AdminMacPath) {
+			// Admin macaroons created by earlier versions lack
+			// some of the current permissions, such as the one
+			// needed to bake new macaroons, so we'll re-bake them
+			// if the user asked us to.
+			err = upgradeAdminMacaroon(
+				ctx, macaroonService, cfg.AdminMacPath,
+			)
+			if err != nil {
+				err := fmt.Errorf("Unable to upgrade admin "+
+					"macaroon: %v", err)
+				ltndLog.Error(err)
+				return err
+			}
 		}
 	}
 
@@ -922,9 +939,26 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	}
 
 	// Generate the admin macaroon and write it to a file.
-	adminPermissions := append(readPermissions, writePermissions...)
+	return genAdminMacaroon(ctx, svc, admFile)
+}
+
+// adminPermissions returns the permissions of the admin macaroon, which grants
+// both read and write access to all entities.
+func adminPermissions() []bakery.Op {
+	perms := make(
+		[]bakery.Op, 0, len(readPermissions)+len(writePermissions),
+	)
+	perms = append(perms, readPermissions...)
+	return append(perms, writePermissions...)
+}
+
+// genAdminMacaroon bakes an admin macaroon under the default root key and
+// writes it to the given file.
+func genAdminMacaroon(ctx context.Context, svc *macaroons.Service,
+	admFile string) error {
+
 	admMacaroon, err := svc.Oven.NewMacaroon(
-		ctx, bakery.LatestVersion, nil, adminPermissions...,
+		ctx, bakery.LatestVersion, nil, adminPermissions()...,
 	)
 	if err != nil {
 		return err
@@ -933,11 +967,45 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	if err != nil {
 		return err
 	}
-	if err = ioutil.WriteFile(admFile, admBytes, 0600); err != nil {
+
+	return ioutil.WriteFile(admFile, admBytes, 0600)
+}
+
+// upgradeAdminMacaroon re-bakes the admin macaroon at the given path if it was
+// created by an earlier version and therefore lacks some of the current admin
+// permissions, such as macaroon:generate. Only macaroons that grant write
+// access to both on-chain and off-chain funds are considered admin macaroons,
+// anything else at the path is left untouched. The old admin macaroon remains
+// valid, as it shares the default root key with the new one.
+func upgradeAdminMacaroon(ctx context.Context, svc *macaroons.Service,
+	admFile string) error {
+
+	admBytes, err := ioutil.ReadFile(admFile)
+	if err != nil {
+		return err
+	}
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(admBytes); err != nil {
 		return err
 	}
 
-	return nil
+	authChecker := svc.Checker.Auth(macaroon.Slice{mac})
+	if _, err := authChecker.Allow(ctx, adminPermissions()...); err == nil {
+		return nil
+	}
+
+	legacyPermissions := []bakery.Op{
+		{Entity: "onchain", Action: "write"},
+		{Entity: "offchain", Action: "write"},
+	}
+	if _, err := authChecker.Allow(ctx, legacyPermissions...); err != nil {
+		return nil
+	}
+
+	ltndLog.Warnf("Replacing admin macaroon %v with one that includes "+
+		"all current admin permissions", admFile)
+
+	return genAdminMacaroon(ctx, svc, admFile)
 }
 
 // WalletUnlockParams holds the variables used to parameterize the unlocking of
@@ -58,4 +58,12 @@ type Peer interface {
 	// this interface to gate their behavior off the set of negotiated
 	// feature bits.
 	RemoteGlobalFeatures() *lnwire.FeatureVector
+
+	// LocalFeatures returns the set of connection-local features that has
+	// been sent to the remote peer in the init message.
+	LocalFeatures() *lnwire.FeatureVector
+
+	// RemoteFeatures returns the set of connection-local features that
+	// has been received from the remote peer in the init message.
+	RemoteFeatures() *lnwire.FeatureVector
 }
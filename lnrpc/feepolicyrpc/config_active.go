@@ -0,0 +1,21 @@
+// +build feepolicyrpc
+
+package feepolicyrpc
+
+import (
+	"github.com/BTCGPU/lnd/feepolicy"
+)
+
+// Config is the primary configuration struct for the fee policy RPC server.
+// It contains all the items required for the rpc server to carry out its
+// duties. The fields with struct tags are meant to be parsed as normal
+// configuration options, while if able to be populated, the latter fields MUST
+// also be specified.
+type Config struct {
+	// Active indicates if the fee policy manager is enabled.
+	Active bool
+
+	// FeePolicyManager is the active fee policy manager whose rules are
+	// exposed via RPC.
+	FeePolicyManager *feepolicy.Manager
+}
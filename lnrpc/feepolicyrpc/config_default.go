@@ -0,0 +1,6 @@
+// +build !feepolicyrpc
+
+package feepolicyrpc
+
+// Config is empty for non-feepolicyrpc builds.
+type Config struct{}
@@ -0,0 +1,864 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: feepolicyrpc/feepolicy.proto
+
+package feepolicyrpc
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type BalanceRule struct {
+	/// The fee rate multiplier of a channel without any local balance.
+	EmptyMultiplier float64 `protobuf:"fixed64,1,opt,name=empty_multiplier,json=emptyMultiplier,proto3" json:"empty_multiplier,omitempty"`
+	/// The fee rate multiplier of a channel whose capacity is all local.
+	FullMultiplier       float64  `protobuf:"fixed64,2,opt,name=full_multiplier,json=fullMultiplier,proto3" json:"full_multiplier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BalanceRule) Reset()         { *m = BalanceRule{} }
+func (m *BalanceRule) String() string { return proto.CompactTextString(m) }
+func (*BalanceRule) ProtoMessage()    {}
+func (*BalanceRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3cd6a3537bc150e, []int{0}
+}
+
+func (m *BalanceRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceRule.Unmarshal(m, b)
+}
+func (m *BalanceRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceRule.Marshal(b, m, deterministic)
+}
+func (m *BalanceRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceRule.Merge(m, src)
+}
+func (m *BalanceRule) XXX_Size() int {
+	return xxx_messageInfo_BalanceRule.Size(m)
+}
+func (m *BalanceRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceRule proto.InternalMessageInfo
+
+func (m *BalanceRule) GetEmptyMultiplier() float64 {
+	if m != nil {
+		return m.EmptyMultiplier
+	}
+	return 0
+}
+
+func (m *BalanceRule) GetFullMultiplier() float64 {
+	if m != nil {
+		return m.FullMultiplier
+	}
+	return 0
+}
+
+type ForwardingRule struct {
+	/// The window over which the forwarded amount is summed up, in seconds.
+	WindowSeconds uint64 `protobuf:"varint,1,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	//*
+	//The amount that needs to leave through the channel within the window for
+	//the rule to apply.
+	MinVolumeSat int64 `protobuf:"varint,2,opt,name=min_volume_sat,json=minVolumeSat,proto3" json:"min_volume_sat,omitempty"`
+	/// The fee rate multiplier that applies once the rule is triggered.
+	Multiplier           float64  `protobuf:"fixed64,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForwardingRule) Reset()         { *m = ForwardingRule{} }
+func (m *ForwardingRule) String() string { return proto.CompactTextString(m) }
+func (*ForwardingRule) ProtoMessage()    {}
+func (*ForwardingRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3cd6a3537bc150e, []int{1}
+}
+
+func (m *ForwardingRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingRule.Unmarshal(m, b)
+}
+func (m *ForwardingRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingRule.Marshal(b, m, deterministic)
+}
+func (m *ForwardingRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingRule.Merge(m, src)
+}
+func (m *ForwardingRule) XXX_Size() int {
+	return xxx_messageInfo_ForwardingRule.Size(m)
+}
+func (m *ForwardingRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardingRule proto.InternalMessageInfo
+
+func (m *ForwardingRule) GetWindowSeconds() uint64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
+func (m *ForwardingRule) GetMinVolumeSat() int64 {
+	if m != nil {
+		return m.MinVolumeSat
+	}
+	return 0
+}
+
+func (m *ForwardingRule) GetMultiplier() float64 {
+	if m != nil {
+		return m.Multiplier
+	}
+	return 0
+}
+
+type Schedule struct {
+	/// The time of the day at which the schedule starts, as HH:MM in UTC.
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	/// The time of the day at which the schedule ends, as HH:MM in UTC.
+	End string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	/// The fee rate multiplier that applies while the schedule is active.
+	Multiplier           float64  `protobuf:"fixed64,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3cd6a3537bc150e, []int{2}
+}
+
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Schedule.Unmarshal(m, b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return xxx_messageInfo_Schedule.Size(m)
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *Schedule) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+func (m *Schedule) GetMultiplier() float64 {
+	if m != nil {
+		return m.Multiplier
+	}
+	return 0
+}
+
+type Rule struct {
+	//*
+	//The short channel id of the channel that the rule applies to, or zero for
+	//the default rule.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	/// The base fee that is charged for every forwarded HTLC.
+	BaseFeeMsat uint64 `protobuf:"varint,2,opt,name=base_fee_msat,json=baseFeeMsat,proto3" json:"base_fee_msat,omitempty"`
+	/// The fee rate in parts per million before the multipliers are applied.
+	FeeRatePpm uint32 `protobuf:"varint,3,opt,name=fee_rate_ppm,json=feeRatePpm,proto3" json:"fee_rate_ppm,omitempty"`
+	/// The lowest fee rate that the rule results in.
+	MinFeeRatePpm uint32 `protobuf:"varint,4,opt,name=min_fee_rate_ppm,json=minFeeRatePpm,proto3" json:"min_fee_rate_ppm,omitempty"`
+	/// The highest fee rate that the rule results in, or zero if unbounded.
+	MaxFeeRatePpm uint32 `protobuf:"varint,5,opt,name=max_fee_rate_ppm,json=maxFeeRatePpm,proto3" json:"max_fee_rate_ppm,omitempty"`
+	/// The rule that scales the fee rate with the local balance, if any.
+	Balance *BalanceRule `protobuf:"bytes,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	/// The rule that raises the fee rate on high forwarding volume, if any.
+	Forwarding *ForwardingRule `protobuf:"bytes,7,opt,name=forwarding,proto3" json:"forwarding,omitempty"`
+	/// The schedules that scale the fee rate during times of the day.
+	Schedules            []*Schedule `protobuf:"bytes,8,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Rule) Reset()         { *m = Rule{} }
+func (m *Rule) String() string { return proto.CompactTextString(m) }
+func (*Rule) ProtoMessage()    {}
+func (*Rule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3cd6a3537bc150e, []int{3}
+}
+
+func (m *Rule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rule.Unmarshal(m, b)
+}
+func (m *Rule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rule.Marshal(b, m, deterministic)
+}
+func (m *Rule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rule.Merge(m, src)
+}
+func (m *Rule) XXX_Size() int {
+	return xxx_messageInfo_Rule.Size(m)
+}
+func (m *Rule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rule proto.InternalMessageInfo
+
+func (m *Rule) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *Rule) GetBaseFeeMsat() uint64 {
+	if m != nil {
+		return m.BaseFeeMsat
+	}
+	return 0
+}
+
+func (m *Rule) GetFeeRatePpm() uint32 {
+	if m != nil {
+		return m.FeeRatePpm
+	}
+	return 0
+}
+
+func (m *Rule) GetMinFeeRatePpm() uint32 {
+	if m != nil {
+		return m.MinFeeRatePpm
+	}
+	return 0
+}
+
+func (m *Rule) GetMaxFeeRatePpm() uint32 {
+	if m != nil {
+		return m.MaxFeeRatePpm
+	}
+	return 0
+}
+
+func (m *Rule) GetBalance() *BalanceRule {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *Rule) GetForwarding() *ForwardingRule {
+	if m != nil {
+		return m.Forwarding
+	}
+	return nil
+}
+
+func (m *Rule) GetSchedules() []*Schedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+type ChannelFee struct {
+	/// The short channel id of the channel.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	/// The funding outpoint of the channel.
+	ChannelPoint string `protobuf:"bytes,2,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	/// The base fee that is currently advertised.
+	BaseFeeMsat uint64 `protobuf:"varint,3,opt,name=base_fee_msat,json=baseFeeMsat,proto3" json:"base_fee_msat,omitempty"`
+	/// The fee rate that is currently advertised.
+	FeeRatePpm uint32 `protobuf:"varint,4,opt,name=fee_rate_ppm,json=feeRatePpm,proto3" json:"fee_rate_ppm,omitempty"`
+	/// The base fee that the rule of the channel results in.
+	TargetBaseFeeMsat uint64 `protobuf:"varint,5,opt,name=target_base_fee_msat,json=targetBaseFeeMsat,proto3" json:"target_base_fee_msat,omitempty"`
+	/// The fee rate that the rule of the channel results in.
+	TargetFeeRatePpm uint32 `protobuf:"varint,6,opt,name=target_fee_rate_ppm,json=targetFeeRatePpm,proto3" json:"target_fee_rate_ppm,omitempty"`
+	/// The time of the last policy update, in seconds since the epoch.
+	LastUpdate           uint64   `protobuf:"varint,7,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelFee) Reset()         { *m = ChannelFee{} }
+func (m *ChannelFee) String() string { return proto.CompactTextString(m) }
+func (*ChannelFee) ProtoMessage()    {}
+func (*ChannelFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3cd6a3537bc150e, []int{4}
+}
+
+func (m *ChannelFee) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelFee.Unmarshal(m, b)
+}
+func (m *ChannelFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelFee.Marshal(b, m, deterministic)
+}
+func (m *ChannelFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFee.Merge(m, src)
+}
+func (m *ChannelFee) XXX_Size() int {
+	return xxx_messageInfo_ChannelFee.Size(m)
+}
+func (m *ChannelFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelFee proto.InternalMessageInfo
+
+func (m *ChannelFee) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *ChannelFee) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
+}
+
+func (m *ChannelFee) GetBaseFeeMsat() uint64 {
+	if m != nil {
+		return m.BaseFeeMsat
+	}
+	return 0
+}
+
+func (m *ChannelFee) GetFeeRatePpm() uint32 {
+	if m != nil {
+		return m.FeeRatePpm
+	}
+	return 0
+}
+
+func (m *ChannelFee) GetTargetBaseFeeMsat() uint64 {
+	if m != nil {
+		return m.TargetBaseFeeMsat
+	}
+	return 0
+}
+
+func (m *ChannelFee) GetTargetFeeRatePpm() uint32 {
+	if m != nil {
+		return m.TargetFeeRatePpm
+	}
+	return 0
+}
+
+func (m *ChannelFee) GetLastUpdate() uint64 {
+	if m != nil {
+		return m.LastUpdate
+	}
+	return 0
+}
+
+type ListRulesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRulesRequest) Reset()         { *m = ListRulesRequest{} }
+func (m *ListRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRulesRequest) ProtoMessage()    {}
+func (*ListRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3cd6a3537bc150e, []int{5}
+}
+
+func (m *ListRulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRulesRequest.Unmarshal(m, b)
+}
+func (m *ListRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRulesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRulesRequest.Merge(m, src)
+}
+func (m *ListRulesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRulesRequest.Size(m)
+}
+func (m *ListRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRulesRequest proto.InternalMessageInfo
+
+type ListRulesResponse struct {
+	/// The rule of all channels without a rule of their own, if any.
+	DefaultRule *Rule `protobuf:"bytes,1,opt,name=default_rule,json=defaultRule,proto3" json:"default_rule,omitempty"`
+	/// The rules of the channels that override the default rule.
+	ChannelRules []*Rule `protobuf:"bytes,2,rep,name=channel_rules,json=channelRules,proto3" json:"channel_rules,omitempty"`
+	/// The fees of all channels whose fees are managed by a rule.
+	ChannelFees          []*ChannelFee `protobuf:"bytes,3,rep,name=channel_fees,json=channelFees,proto3" json:"channel_fees,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListRulesResponse) Reset()         { *m = ListRulesResponse{} }
+func (m *ListRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRulesResponse) ProtoMessage()    {}
+func (*ListRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3cd6a3537bc150e, []int{6}
+}
+
+func (m *ListRulesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRulesResponse.Unmarshal(m, b)
+}
+func (m *ListRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRulesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRulesResponse.Merge(m, src)
+}
+func (m *ListRulesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRulesResponse.Size(m)
+}
+func (m *ListRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRulesResponse proto.InternalMessageInfo
+
+func (m *ListRulesResponse) GetDefaultRule() *Rule {
+	if m != nil {
+		return m.DefaultRule
+	}
+	return nil
+}
+
+func (m *ListRulesResponse) GetChannelRules() []*Rule {
+	if m != nil {
+		return m.ChannelRules
+	}
+	return nil
+}
+
+func (m *ListRulesResponse) GetChannelFees() []*ChannelFee {
+	if m != nil {
+		return m.ChannelFees
+	}
+	return nil
+}
+
+type SetRulesRequest struct {
+	//*
+	//The rule of all channels without a rule of their own. If unset, the fees
+	//of those channels aren't managed.
+	DefaultRule *Rule `protobuf:"bytes,1,opt,name=default_rule,json=defaultRule,proto3" json:"default_rule,omitempty"`
+	//*
+	//The rules of the channels that override the default rule. Each of them
+	//must specify the short channel id of its channel.
+	ChannelRules         []*Rule  `protobuf:"bytes,2,rep,name=channel_rules,json=channelRules,proto3" json:"channel_rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetRulesRequest) Reset()         { *m = SetRulesRequest{} }
+func (m *SetRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRulesRequest) ProtoMessage()    {}
+func (*SetRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3cd6a3537bc150e, []int{7}
+}
+
+func (m *SetRulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRulesRequest.Unmarshal(m, b)
+}
+func (m *SetRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRulesRequest.Marshal(b, m, deterministic)
+}
+func (m *SetRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRulesRequest.Merge(m, src)
+}
+func (m *SetRulesRequest) XXX_Size() int {
+	return xxx_messageInfo_SetRulesRequest.Size(m)
+}
+func (m *SetRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRulesRequest proto.InternalMessageInfo
+
+func (m *SetRulesRequest) GetDefaultRule() *Rule {
+	if m != nil {
+		return m.DefaultRule
+	}
+	return nil
+}
+
+func (m *SetRulesRequest) GetChannelRules() []*Rule {
+	if m != nil {
+		return m.ChannelRules
+	}
+	return nil
+}
+
+type SetRulesResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetRulesResponse) Reset()         { *m = SetRulesResponse{} }
+func (m *SetRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*SetRulesResponse) ProtoMessage()    {}
+func (*SetRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3cd6a3537bc150e, []int{8}
+}
+
+func (m *SetRulesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRulesResponse.Unmarshal(m, b)
+}
+func (m *SetRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRulesResponse.Marshal(b, m, deterministic)
+}
+func (m *SetRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRulesResponse.Merge(m, src)
+}
+func (m *SetRulesResponse) XXX_Size() int {
+	return xxx_messageInfo_SetRulesResponse.Size(m)
+}
+func (m *SetRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRulesResponse proto.InternalMessageInfo
+
+type ReloadRulesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReloadRulesRequest) Reset()         { *m = ReloadRulesRequest{} }
+func (m *ReloadRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*ReloadRulesRequest) ProtoMessage()    {}
+func (*ReloadRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3cd6a3537bc150e, []int{9}
+}
+
+func (m *ReloadRulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReloadRulesRequest.Unmarshal(m, b)
+}
+func (m *ReloadRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReloadRulesRequest.Marshal(b, m, deterministic)
+}
+func (m *ReloadRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadRulesRequest.Merge(m, src)
+}
+func (m *ReloadRulesRequest) XXX_Size() int {
+	return xxx_messageInfo_ReloadRulesRequest.Size(m)
+}
+func (m *ReloadRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReloadRulesRequest proto.InternalMessageInfo
+
+type ReloadRulesResponse struct {
+	/// The rule of all channels without a rule of their own, if any.
+	DefaultRule *Rule `protobuf:"bytes,1,opt,name=default_rule,json=defaultRule,proto3" json:"default_rule,omitempty"`
+	/// The rules of the channels that override the default rule.
+	ChannelRules         []*Rule  `protobuf:"bytes,2,rep,name=channel_rules,json=channelRules,proto3" json:"channel_rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReloadRulesResponse) Reset()         { *m = ReloadRulesResponse{} }
+func (m *ReloadRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*ReloadRulesResponse) ProtoMessage()    {}
+func (*ReloadRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3cd6a3537bc150e, []int{10}
+}
+
+func (m *ReloadRulesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReloadRulesResponse.Unmarshal(m, b)
+}
+func (m *ReloadRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReloadRulesResponse.Marshal(b, m, deterministic)
+}
+func (m *ReloadRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadRulesResponse.Merge(m, src)
+}
+func (m *ReloadRulesResponse) XXX_Size() int {
+	return xxx_messageInfo_ReloadRulesResponse.Size(m)
+}
+func (m *ReloadRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadRulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReloadRulesResponse proto.InternalMessageInfo
+
+func (m *ReloadRulesResponse) GetDefaultRule() *Rule {
+	if m != nil {
+		return m.DefaultRule
+	}
+	return nil
+}
+
+func (m *ReloadRulesResponse) GetChannelRules() []*Rule {
+	if m != nil {
+		return m.ChannelRules
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BalanceRule)(nil), "feepolicyrpc.BalanceRule")
+	proto.RegisterType((*ForwardingRule)(nil), "feepolicyrpc.ForwardingRule")
+	proto.RegisterType((*Schedule)(nil), "feepolicyrpc.Schedule")
+	proto.RegisterType((*Rule)(nil), "feepolicyrpc.Rule")
+	proto.RegisterType((*ChannelFee)(nil), "feepolicyrpc.ChannelFee")
+	proto.RegisterType((*ListRulesRequest)(nil), "feepolicyrpc.ListRulesRequest")
+	proto.RegisterType((*ListRulesResponse)(nil), "feepolicyrpc.ListRulesResponse")
+	proto.RegisterType((*SetRulesRequest)(nil), "feepolicyrpc.SetRulesRequest")
+	proto.RegisterType((*SetRulesResponse)(nil), "feepolicyrpc.SetRulesResponse")
+	proto.RegisterType((*ReloadRulesRequest)(nil), "feepolicyrpc.ReloadRulesRequest")
+	proto.RegisterType((*ReloadRulesResponse)(nil), "feepolicyrpc.ReloadRulesResponse")
+}
+
+func init() { proto.RegisterFile("feepolicyrpc/feepolicy.proto", fileDescriptor_f3cd6a3537bc150e) }
+
+var fileDescriptor_f3cd6a3537bc150e = []byte{
+	// 704 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0x96, 0x93, 0x34, 0x6d, 0xc6, 0x49, 0x9a, 0x6e, 0xab, 0xff, 0xcf, 0x5f, 0xf5, 0x6f, 0x83,
+	0x01, 0x35, 0x20, 0x91, 0x48, 0x29, 0x88, 0x03, 0x9c, 0x52, 0x29, 0xa8, 0x52, 0x2b, 0x45, 0x1b,
+	0xca, 0x81, 0x8b, 0xb5, 0xb1, 0x27, 0x8d, 0x25, 0x7b, 0x6d, 0xbc, 0x6b, 0xda, 0x1e, 0x38, 0x70,
+	0xe0, 0x01, 0x78, 0x23, 0x1e, 0x85, 0xc7, 0xe0, 0x88, 0xbc, 0x76, 0x12, 0x6f, 0xab, 0xb6, 0x37,
+	0xb8, 0x79, 0xbe, 0xf9, 0x66, 0x66, 0xe7, 0xfb, 0xd6, 0x5a, 0xd8, 0x9b, 0x21, 0x46, 0xa1, 0xef,
+	0x39, 0xd7, 0x71, 0xe4, 0xf4, 0x97, 0x41, 0x2f, 0x8a, 0x43, 0x19, 0x92, 0x7a, 0x31, 0x6b, 0x31,
+	0x30, 0x87, 0xcc, 0x67, 0xdc, 0x41, 0x9a, 0xf8, 0x48, 0x9e, 0x41, 0x0b, 0x83, 0x48, 0x5e, 0xdb,
+	0x41, 0xe2, 0x4b, 0x2f, 0xf2, 0x3d, 0x8c, 0xdb, 0x46, 0xc7, 0xe8, 0x1a, 0x74, 0x53, 0xe1, 0x67,
+	0x4b, 0x98, 0x1c, 0xc2, 0xe6, 0x2c, 0xf1, 0xfd, 0x22, 0xb3, 0xa4, 0x98, 0xcd, 0x14, 0x5e, 0x11,
+	0xad, 0x2f, 0xd0, 0x1c, 0x85, 0xf1, 0x25, 0x8b, 0x5d, 0x8f, 0x5f, 0xa8, 0x29, 0x4f, 0xa1, 0x79,
+	0xe9, 0x71, 0x37, 0xbc, 0xb4, 0x05, 0x3a, 0x21, 0x77, 0x85, 0x9a, 0x51, 0xa1, 0x8d, 0x0c, 0x9d,
+	0x64, 0x20, 0x79, 0x02, 0xcd, 0xc0, 0xe3, 0xf6, 0xe7, 0xd0, 0x4f, 0x02, 0xb4, 0x05, 0x93, 0x6a,
+	0x40, 0x99, 0xd6, 0x03, 0x8f, 0x7f, 0x50, 0xe0, 0x84, 0x49, 0xb2, 0x0f, 0x50, 0x38, 0x42, 0x59,
+	0x1d, 0xa1, 0x80, 0x58, 0x14, 0x36, 0x26, 0xce, 0x1c, 0xdd, 0x74, 0xf0, 0x0e, 0xac, 0x09, 0xc9,
+	0x62, 0xa9, 0xe6, 0xd5, 0x68, 0x16, 0x90, 0x16, 0x94, 0x91, 0xbb, 0xaa, 0x79, 0x8d, 0xa6, 0x9f,
+	0x0f, 0xf6, 0xfc, 0x59, 0x82, 0x8a, 0xda, 0xe4, 0x5f, 0x58, 0x77, 0xe6, 0x8c, 0xdb, 0x9e, 0x9b,
+	0xaf, 0x50, 0x4d, 0xc3, 0x13, 0x97, 0x58, 0xd0, 0x98, 0x32, 0x81, 0xf6, 0x0c, 0xd1, 0x0e, 0x16,
+	0x47, 0xaf, 0x50, 0x33, 0x05, 0x47, 0x88, 0x67, 0x82, 0x49, 0xd2, 0x81, 0xd4, 0x0b, 0x3b, 0x66,
+	0x12, 0xed, 0x28, 0x0a, 0xd4, 0x9c, 0x06, 0x85, 0x19, 0x22, 0x65, 0x12, 0xc7, 0x51, 0x40, 0x0e,
+	0xa1, 0x95, 0x2a, 0xa0, 0xb1, 0x2a, 0x8a, 0xd5, 0x08, 0x3c, 0x3e, 0xd2, 0x89, 0xec, 0x4a, 0x27,
+	0xae, 0xe5, 0x44, 0x76, 0x55, 0x20, 0x1e, 0xc1, 0xfa, 0x34, 0xf3, 0xbb, 0x5d, 0xed, 0x18, 0x5d,
+	0x73, 0xf0, 0x5f, 0xaf, 0x78, 0x1f, 0x7a, 0x85, 0xcb, 0x40, 0x17, 0x4c, 0xf2, 0x16, 0x60, 0xb6,
+	0x74, 0xb0, 0xbd, 0xae, 0xea, 0xf6, 0xf4, 0x3a, 0xdd, 0x61, 0x5a, 0xe0, 0x93, 0x97, 0x50, 0x13,
+	0xb9, 0x01, 0xa2, 0xbd, 0xd1, 0x29, 0x77, 0xcd, 0xc1, 0x3f, 0x7a, 0xf1, 0xc2, 0x1f, 0xba, 0x22,
+	0x5a, 0xdf, 0x4b, 0x00, 0xc7, 0x73, 0xc6, 0x39, 0xfa, 0x23, 0xbc, 0x47, 0xe8, 0xc7, 0xd0, 0x70,
+	0x32, 0x9a, 0x1d, 0x85, 0x1e, 0x97, 0xb9, 0x8d, 0xf5, 0x1c, 0x1c, 0xa7, 0xd8, 0x6d, 0x37, 0xca,
+	0x0f, 0xbb, 0x51, 0xb9, 0xe5, 0x46, 0x1f, 0x76, 0x24, 0x8b, 0x2f, 0x50, 0xda, 0x7a, 0xb3, 0x35,
+	0xd5, 0x6c, 0x2b, 0xcb, 0x0d, 0x0b, 0x2d, 0x5f, 0xc0, 0x76, 0x5e, 0xa0, 0x75, 0xae, 0xaa, 0xce,
+	0xad, 0x2c, 0x55, 0xf0, 0xe6, 0x00, 0x4c, 0x9f, 0x09, 0x69, 0x27, 0x91, 0xcb, 0x24, 0x2a, 0x9d,
+	0x2b, 0x14, 0x52, 0xe8, 0x5c, 0x21, 0x16, 0x81, 0xd6, 0xa9, 0x27, 0x64, 0xaa, 0xb0, 0xa0, 0xf8,
+	0x29, 0x41, 0x21, 0xad, 0x1f, 0x06, 0x6c, 0x15, 0x40, 0x11, 0x85, 0x5c, 0x20, 0x79, 0x05, 0x75,
+	0x17, 0x67, 0x2c, 0xf1, 0xa5, 0x1d, 0x27, 0x3e, 0x2a, 0xcd, 0xcc, 0x01, 0xd1, 0x65, 0x57, 0x4e,
+	0x99, 0x39, 0x2f, 0x0d, 0xc8, 0xeb, 0x95, 0x98, 0xb1, 0xb2, 0xab, 0xd4, 0x29, 0xdf, 0x51, 0xb7,
+	0x10, 0x58, 0xcd, 0x25, 0x6f, 0x60, 0x11, 0xa7, 0xab, 0x8a, 0x76, 0x59, 0xd5, 0xb5, 0xf5, 0xba,
+	0x95, 0x9d, 0xd4, 0x74, 0x96, 0xdf, 0xc2, 0xfa, 0x6a, 0xc0, 0xe6, 0x04, 0xb5, 0xb5, 0xfe, 0xf4,
+	0x02, 0xa9, 0xb4, 0x13, 0xd4, 0x45, 0xb4, 0x76, 0x80, 0x50, 0xf4, 0x43, 0xe6, 0x6a, 0x82, 0x7f,
+	0x33, 0x60, 0x5b, 0x83, 0xff, 0x8e, 0xe4, 0x83, 0x5f, 0x06, 0xd4, 0x46, 0x88, 0x63, 0xc5, 0x21,
+	0xa7, 0x50, 0x5b, 0xde, 0x02, 0xb2, 0xaf, 0x17, 0xdf, 0xbc, 0x33, 0xbb, 0x07, 0x77, 0xe6, 0xf3,
+	0x5d, 0x4e, 0x60, 0x63, 0xa1, 0x06, 0xf9, 0xff, 0xc6, 0xbf, 0xaa, 0x1b, 0xb5, 0xbb, 0x7f, 0x57,
+	0x3a, 0x6f, 0x45, 0xc1, 0x2c, 0xa8, 0x45, 0x3a, 0x37, 0xf6, 0xba, 0xa5, 0xef, 0xee, 0xa3, 0x7b,
+	0x18, 0x59, 0xcf, 0xe1, 0xf3, 0x8f, 0xdd, 0x0b, 0x4f, 0xce, 0x93, 0x69, 0xcf, 0x09, 0x83, 0xfe,
+	0xf0, 0xfd, 0xf1, 0xbb, 0xf1, 0x79, 0xdf, 0xe7, 0x6e, 0xdf, 0xe7, 0xda, 0x8b, 0x17, 0x47, 0xce,
+	0xb4, 0xaa, 0x5e, 0xbd, 0xa3, 0xdf, 0x03, 0x00, 0x6c, 0x44, 0x51, 0x74, 0x15, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// FeePolicyClient is the client API for FeePolicy service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type FeePolicyClient interface {
+	//*
+	//ListRules returns the fee rules that are in effect, along with the current
+	//and target fees of all channels whose fees are managed by a rule.
+	ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error)
+	//*
+	//SetRules replaces the fee rules of all channels. The rules are saved to the
+	//rules file, such that they're kept across restarts, and the fees of our
+	//channels are evaluated against them right away.
+	SetRules(ctx context.Context, in *SetRulesRequest, opts ...grpc.CallOption) (*SetRulesResponse, error)
+	//*
+	//ReloadRules reads the fee rules from the rules file again and puts them
+	//into effect, which allows editing the file without restarting the daemon.
+	ReloadRules(ctx context.Context, in *ReloadRulesRequest, opts ...grpc.CallOption) (*ReloadRulesResponse, error)
+}
+
+type feePolicyClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewFeePolicyClient(cc *grpc.ClientConn) FeePolicyClient {
+	return &feePolicyClient{cc}
+}
+
+func (c *feePolicyClient) ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error) {
+	out := new(ListRulesResponse)
+	err := c.cc.Invoke(ctx, "/feepolicyrpc.FeePolicy/ListRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feePolicyClient) SetRules(ctx context.Context, in *SetRulesRequest, opts ...grpc.CallOption) (*SetRulesResponse, error) {
+	out := new(SetRulesResponse)
+	err := c.cc.Invoke(ctx, "/feepolicyrpc.FeePolicy/SetRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feePolicyClient) ReloadRules(ctx context.Context, in *ReloadRulesRequest, opts ...grpc.CallOption) (*ReloadRulesResponse, error) {
+	out := new(ReloadRulesResponse)
+	err := c.cc.Invoke(ctx, "/feepolicyrpc.FeePolicy/ReloadRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeePolicyServer is the server API for FeePolicy service.
+type FeePolicyServer interface {
+	//*
+	//ListRules returns the fee rules that are in effect, along with the current
+	//and target fees of all channels whose fees are managed by a rule.
+	ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error)
+	//*
+	//SetRules replaces the fee rules of all channels. The rules are saved to the
+	//rules file, such that they're kept across restarts, and the fees of our
+	//channels are evaluated against them right away.
+	SetRules(context.Context, *SetRulesRequest) (*SetRulesResponse, error)
+	//*
+	//ReloadRules reads the fee rules from the rules file again and puts them
+	//into effect, which allows editing the file without restarting the daemon.
+	ReloadRules(context.Context, *ReloadRulesRequest) (*ReloadRulesResponse, error)
+}
+
+func RegisterFeePolicyServer(s *grpc.Server, srv FeePolicyServer) {
+	s.RegisterService(&_FeePolicy_serviceDesc, srv)
+}
+
+func _FeePolicy_ListRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeePolicyServer).ListRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feepolicyrpc.FeePolicy/ListRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeePolicyServer).ListRules(ctx, req.(*ListRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeePolicy_SetRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeePolicyServer).SetRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feepolicyrpc.FeePolicy/SetRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeePolicyServer).SetRules(ctx, req.(*SetRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeePolicy_ReloadRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeePolicyServer).ReloadRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feepolicyrpc.FeePolicy/ReloadRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeePolicyServer).ReloadRules(ctx, req.(*ReloadRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _FeePolicy_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "feepolicyrpc.FeePolicy",
+	HandlerType: (*FeePolicyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRules",
+			Handler:    _FeePolicy_ListRules_Handler,
+		},
+		{
+			MethodName: "SetRules",
+			Handler:    _FeePolicy_SetRules_Handler,
+		},
+		{
+			MethodName: "ReloadRules",
+			Handler:    _FeePolicy_ReloadRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feepolicyrpc/feepolicy.proto",
+}
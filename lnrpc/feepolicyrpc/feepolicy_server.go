@@ -0,0 +1,357 @@
+// +build feepolicyrpc
+
+package feepolicyrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/BTCGPU/lnd/feepolicy"
+	"github.com/BTCGPU/lnd/lnrpc"
+	"github.com/BTCGPU/lnd/lnwire"
+	btcutil "github.com/btgsuite/btgutil"
+	"google.golang.org/grpc"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+const (
+	// subServerName is the name of the sub rpc server. We'll use this name
+	// to register ourselves, and we also require that the main
+	// SubServerConfigDispatcher instance recognize it as the name of our
+	// RPC service.
+	subServerName = "FeePolicyRPC"
+)
+
+var (
+	// macPermissions maps RPC calls to the permissions they require.
+	macPermissions = map[string][]bakery.Op{
+		"/feepolicyrpc.FeePolicy/ListRules": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/feepolicyrpc.FeePolicy/SetRules": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/feepolicyrpc.FeePolicy/ReloadRules": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+	}
+
+	// ErrFeePolicyNotActive signals that RPC calls cannot be processed
+	// because the fee policy manager is not active.
+	ErrFeePolicyNotActive = errors.New("fee policy manager not active")
+)
+
+// Server is a sub-server of the main RPC server: the fee policy RPC. This sub
+// RPC server allows external callers to inspect and replace the fee rules
+// that are applied to our channels.
+type Server struct {
+	cfg *Config
+}
+
+// A compile time check to ensure that Server fully implements the
+// FeePolicyServer gRPC service.
+var _ FeePolicyServer = (*Server)(nil)
+
+// New returns a new instance of the feepolicyrpc FeePolicy sub-server. We
+// also return the set of permissions for the macaroons that we may create
+// within this method. If the macaroons we need aren't found in the filepath,
+// then we'll create them on start up. If we're unable to locate, or create the
+// macaroons we need, then we'll return with an error.
+func New(cfg *Config) (*Server, lnrpc.MacaroonPerms, error) {
+	// We don't create any new macaroons for this subserver, instead reuse
+	// existing offchain permissions.
+	server := &Server{
+		cfg: cfg,
+	}
+
+	return server, macPermissions, nil
+}
+
+// Start launches any helper goroutines required for the Server to function.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Start() error {
+	return nil
+}
+
+// Stop signals any active goroutines for a graceful closure.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Stop() error {
+	return nil
+}
+
+// Name returns a unique string representation of the sub-server. This can be
+// used to identify the sub-server and also de-duplicate them.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Name() string {
+	return subServerName
+}
+
+// RegisterWithRootServer will be called by the root gRPC server to direct a
+// sub RPC server to register itself with the main gRPC root server. Until this
+// is called, each sub-server won't be able to have requests routed towards it.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) RegisterWithRootServer(grpcServer *grpc.Server) error {
+	// We make sure that we register it with the main gRPC server to ensure
+	// all our methods are routed properly.
+	RegisterFeePolicyServer(grpcServer, s)
+
+	log.Debugf("FeePolicy RPC server successfully register with root " +
+		"gRPC server")
+
+	return nil
+}
+
+// ListRules returns the fee rules that are in effect, along with the current
+// and target fees of all channels whose fees are managed.
+//
+// NOTE: Part of the FeePolicyServer interface.
+func (s *Server) ListRules(ctx context.Context,
+	in *ListRulesRequest) (*ListRulesResponse, error) {
+
+	if err := s.isActive(); err != nil {
+		return nil, err
+	}
+
+	defaultRule, channelRules := marshallRules(
+		s.cfg.FeePolicyManager.Rules(),
+	)
+	resp := &ListRulesResponse{
+		DefaultRule:  defaultRule,
+		ChannelRules: channelRules,
+	}
+
+	fees, err := s.cfg.FeePolicyManager.ChannelFees()
+	if err != nil {
+		return nil, err
+	}
+	for _, fee := range fees {
+		resp.ChannelFees = append(resp.ChannelFees, &ChannelFee{
+			ChanId:            fee.ChanID.ToUint64(),
+			ChannelPoint:      fee.ChanPoint.String(),
+			BaseFeeMsat:       uint64(fee.BaseFee),
+			FeeRatePpm:        fee.FeeRate,
+			TargetBaseFeeMsat: uint64(fee.TargetBaseFee),
+			TargetFeeRatePpm:  fee.TargetFeeRate,
+			LastUpdate:        uint64(fee.LastUpdate.Unix()),
+		})
+	}
+	sort.Slice(resp.ChannelFees, func(i, j int) bool {
+		return resp.ChannelFees[i].ChanId < resp.ChannelFees[j].ChanId
+	})
+
+	return resp, nil
+}
+
+// SetRules replaces the fee rules of all channels.
+//
+// NOTE: Part of the FeePolicyServer interface.
+func (s *Server) SetRules(ctx context.Context,
+	in *SetRulesRequest) (*SetRulesResponse, error) {
+
+	if err := s.isActive(); err != nil {
+		return nil, err
+	}
+
+	rules, err := unmarshallRules(in.DefaultRule, in.ChannelRules)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.cfg.FeePolicyManager.SetRules(rules); err != nil {
+		return nil, err
+	}
+
+	return &SetRulesResponse{}, nil
+}
+
+// ReloadRules reads the fee rules from the rules file again and puts them into
+// effect.
+//
+// NOTE: Part of the FeePolicyServer interface.
+func (s *Server) ReloadRules(ctx context.Context,
+	in *ReloadRulesRequest) (*ReloadRulesResponse, error) {
+
+	if err := s.isActive(); err != nil {
+		return nil, err
+	}
+
+	rules, err := s.cfg.FeePolicyManager.ReloadRules()
+	if err != nil {
+		return nil, err
+	}
+
+	defaultRule, channelRules := marshallRules(rules)
+	return &ReloadRulesResponse{
+		DefaultRule:  defaultRule,
+		ChannelRules: channelRules,
+	}, nil
+}
+
+// marshallRules converts the default rule and the channel rules into their RPC
+// representation. The channel rules are sorted by short channel id.
+func marshallRules(rules *feepolicy.Rules) (*Rule, []*Rule) {
+	var defaultRule *Rule
+	if rules.Default != nil {
+		defaultRule = marshallRule(
+			lnwire.ShortChannelID{}, rules.Default,
+		)
+	}
+
+	channelRules := make([]*Rule, 0, len(rules.Channels))
+	for chanID, rule := range rules.Channels {
+		channelRules = append(channelRules, marshallRule(chanID, rule))
+	}
+	sort.Slice(channelRules, func(i, j int) bool {
+		return channelRules[i].ChanId < channelRules[j].ChanId
+	})
+
+	return defaultRule, channelRules
+}
+
+// unmarshallRules converts the RPC representation of the default rule and the
+// channel rules into a set of fee rules.
+func unmarshallRules(defaultRule *Rule,
+	channelRules []*Rule) (*feepolicy.Rules, error) {
+
+	rules := &feepolicy.Rules{
+		Channels: make(map[lnwire.ShortChannelID]*feepolicy.Rule),
+	}
+
+	if defaultRule != nil {
+		if defaultRule.ChanId != 0 {
+			return nil, errors.New("default rule must not " +
+				"specify a chan_id")
+		}
+
+		rule, err := unmarshallRule(defaultRule)
+		if err != nil {
+			return nil, fmt.Errorf("default rule: %v", err)
+		}
+		rules.Default = rule
+	}
+
+	for _, rpcRule := range channelRules {
+		chanID := lnwire.NewShortChanIDFromInt(rpcRule.ChanId)
+		if rpcRule.ChanId == 0 {
+			return nil, errors.New("channel rules must specify a " +
+				"chan_id")
+		}
+		if _, ok := rules.Channels[chanID]; ok {
+			return nil, fmt.Errorf("duplicate rule for channel %v",
+				chanID)
+		}
+
+		rule, err := unmarshallRule(rpcRule)
+		if err != nil {
+			return nil, fmt.Errorf("rule of channel %v: %v",
+				chanID, err)
+		}
+		rules.Channels[chanID] = rule
+	}
+
+	return rules, nil
+}
+
+// unmarshallRule converts the RPC representation of a fee rule into a rule.
+func unmarshallRule(rpcRule *Rule) (*feepolicy.Rule, error) {
+	rule := &feepolicy.Rule{
+		BaseFee:    lnwire.MilliSatoshi(rpcRule.BaseFeeMsat),
+		FeeRate:    rpcRule.FeeRatePpm,
+		MinFeeRate: rpcRule.MinFeeRatePpm,
+		MaxFeeRate: rpcRule.MaxFeeRatePpm,
+	}
+
+	if rpcRule.Balance != nil {
+		rule.Balance = &feepolicy.BalanceRule{
+			EmptyMultiplier: rpcRule.Balance.EmptyMultiplier,
+			FullMultiplier:  rpcRule.Balance.FullMultiplier,
+		}
+	}
+
+	if rpcRule.Forwarding != nil {
+		rule.Forwarding = &feepolicy.ForwardingRule{
+			Window: time.Duration(
+				rpcRule.Forwarding.WindowSeconds,
+			) * time.Second,
+			MinVolume: btcutil.Amount(
+				rpcRule.Forwarding.MinVolumeSat,
+			),
+			Multiplier: rpcRule.Forwarding.Multiplier,
+		}
+	}
+
+	for _, schedule := range rpcRule.Schedules {
+		start, err := feepolicy.ParseTimeOfDay(schedule.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := feepolicy.ParseTimeOfDay(schedule.End)
+		if err != nil {
+			return nil, err
+		}
+
+		rule.Schedules = append(rule.Schedules, feepolicy.Schedule{
+			Start:      start,
+			End:        end,
+			Multiplier: schedule.Multiplier,
+		})
+	}
+
+	return rule, nil
+}
+
+// marshallRule converts a fee rule into its RPC representation.
+func marshallRule(chanID lnwire.ShortChannelID, rule *feepolicy.Rule) *Rule {
+	rpcRule := &Rule{
+		ChanId:        chanID.ToUint64(),
+		BaseFeeMsat:   uint64(rule.BaseFee),
+		FeeRatePpm:    rule.FeeRate,
+		MinFeeRatePpm: rule.MinFeeRate,
+		MaxFeeRatePpm: rule.MaxFeeRate,
+	}
+
+	if rule.Balance != nil {
+		rpcRule.Balance = &BalanceRule{
+			EmptyMultiplier: rule.Balance.EmptyMultiplier,
+			FullMultiplier:  rule.Balance.FullMultiplier,
+		}
+	}
+
+	if rule.Forwarding != nil {
+		rpcRule.Forwarding = &ForwardingRule{
+			WindowSeconds: uint64(rule.Forwarding.Window.Seconds()),
+			MinVolumeSat:  int64(rule.Forwarding.MinVolume),
+			Multiplier:    rule.Forwarding.Multiplier,
+		}
+	}
+
+	for _, schedule := range rule.Schedules {
+		rpcRule.Schedules = append(rpcRule.Schedules, &Schedule{
+			Start:      feepolicy.FormatTimeOfDay(schedule.Start),
+			End:        feepolicy.FormatTimeOfDay(schedule.End),
+			Multiplier: schedule.Multiplier,
+		})
+	}
+
+	return rpcRule
+}
+
+// isActive returns nil if the fee policy manager is initialized, and the
+// Server can process RPC requests.
+func (s *Server) isActive() error {
+	if s.cfg.Active {
+		return nil
+	}
+
+	return ErrFeePolicyNotActive
+}
@@ -47,6 +47,10 @@ type AddInvoiceConfig struct {
 	// ChanDB is a global boltdb instance which is needed to access the
 	// channel graph.
 	ChanDB *channeldb.DB
+
+	// Features is the feature vector advertised in the invoices we
+	// create.
+	Features *lnwire.FeatureVector
 }
 
 // AddInvoiceData contains the required data to create a new invoice.
@@ -371,6 +375,25 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 
 	}
 
+	// Advertise the features we support, so that the payer knows how to
+	// pay the invoice.
+	if cfg.Features != nil {
+		options = append(options, zpay32.Features(cfg.Features))
+	}
+
+	// If we signal support for payment addresses, generate a random one
+	// which the payer includes in the onion payload of the final hop. It's
+	// only enforced if our features require payment addresses.
+	var paymentAddr [32]byte
+	if cfg.Features != nil &&
+		cfg.Features.HasFeature(lnwire.PaymentAddrOptional) {
+
+		if _, err := rand.Read(paymentAddr[:]); err != nil {
+			return nil, nil, err
+		}
+		options = append(options, zpay32.PaymentAddr(paymentAddr))
+	}
+
 	// Create and encode the payment request as a bech32 (zpay32) string.
 	creationDate := time.Now()
 	payReq, err := zpay32.NewInvoice(
@@ -399,6 +422,8 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 		Terms: channeldb.ContractTerm{
 			Value:           amtMSat,
 			PaymentPreimage: paymentPreimage,
+			PaymentAddr:     paymentAddr,
+			Features:        cfg.Features,
 		},
 	}
 
@@ -50,4 +50,8 @@ type Config struct {
 	// ChanDB is a global boltdb instance which is needed to access the
 	// channel graph.
 	ChanDB *channeldb.DB
+
+	// Features is the feature vector advertised in the invoices we
+	// create.
+	Features *lnwire.FeatureVector
 }
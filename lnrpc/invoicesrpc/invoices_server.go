@@ -254,6 +254,7 @@ func (s *Server) AddHoldInvoice(ctx context.Context,
 		MaxPaymentMSat:    s.cfg.MaxPaymentMSat,
 		DefaultCLTVExpiry: s.cfg.DefaultCLTVExpiry,
 		ChanDB:            s.cfg.ChanDB,
+		Features:          s.cfg.Features,
 	}
 
 	hash, err := lntypes.MakeHash(invoice.Hash)
@@ -14,21 +14,39 @@ import (
 func CreateRPCInvoice(invoice *channeldb.Invoice,
 	activeNetParams *chaincfg.Params) (*lnrpc.Invoice, error) {
 
+	var (
+		rHash        []byte
+		descHash     []byte
+		fallbackAddr string
+		routeHints   []*lnrpc.RouteHint
+	)
+
+	// Invoices that were created on the fly for a keysend payment don't
+	// have a payment request. Their payment hash is derived from the
+	// preimage that the sender provided.
 	paymentRequest := string(invoice.PaymentRequest)
-	decoded, err := zpay32.Decode(paymentRequest, activeNetParams)
-	if err != nil {
-		return nil, fmt.Errorf("unable to decode payment request: %v",
-			err)
-	}
+	if paymentRequest == "" {
+		hash := invoice.Terms.PaymentPreimage.Hash()
+		rHash = hash[:]
+	} else {
+		decoded, err := zpay32.Decode(paymentRequest, activeNetParams)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode payment "+
+				"request: %v", err)
+		}
 
-	var descHash []byte
-	if decoded.DescriptionHash != nil {
-		descHash = decoded.DescriptionHash[:]
-	}
+		rHash = decoded.PaymentHash[:]
+
+		if decoded.DescriptionHash != nil {
+			descHash = decoded.DescriptionHash[:]
+		}
 
-	fallbackAddr := ""
-	if decoded.FallbackAddr != nil {
-		fallbackAddr = decoded.FallbackAddr.String()
+		if decoded.FallbackAddr != nil {
+			fallbackAddr = decoded.FallbackAddr.String()
+		}
+
+		// Convert between the `lnrpc` and `routing` types.
+		routeHints = CreateRPCRouteHints(decoded.RouteHints)
 	}
 
 	settleDate := int64(0)
@@ -36,9 +54,6 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 		settleDate = invoice.SettleDate.Unix()
 	}
 
-	// Convert between the `lnrpc` and `routing` types.
-	routeHints := CreateRPCRouteHints(decoded.RouteHints)
-
 	preimage := invoice.Terms.PaymentPreimage
 	satAmt := invoice.Terms.Value.ToSatoshis()
 	satAmtPaid := invoice.AmtPaid.ToSatoshis()
@@ -75,13 +90,14 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 		}
 
 		rpcHtlc := lnrpc.InvoiceHTLC{
-			ChanId:       key.ChanID.ToUint64(),
-			HtlcIndex:    key.HtlcID,
-			AcceptHeight: int32(htlc.AcceptHeight),
-			AcceptTime:   htlc.AcceptTime.Unix(),
-			ExpiryHeight: int32(htlc.Expiry),
-			AmtMsat:      uint64(htlc.Amt),
-			State:        state,
+			ChanId:        key.ChanID.ToUint64(),
+			HtlcIndex:     key.HtlcID,
+			AcceptHeight:  int32(htlc.AcceptHeight),
+			AcceptTime:    htlc.AcceptTime.Unix(),
+			ExpiryHeight:  int32(htlc.Expiry),
+			AmtMsat:       uint64(htlc.Amt),
+			State:         state,
+			CustomRecords: htlc.CustomRecords,
 		}
 
 		// Only report resolved times if htlc is resolved.
@@ -95,7 +111,7 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 	rpcInvoice := &lnrpc.Invoice{
 		Memo:            string(invoice.Memo[:]),
 		Receipt:         invoice.Receipt[:],
-		RHash:           decoded.PaymentHash[:],
+		RHash:           rHash,
 		Value:           int64(satAmt),
 		CreationDate:    invoice.CreationDate.Unix(),
 		SettleDate:      settleDate,
@@ -120,6 +136,11 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 		rpcInvoice.RPreimage = preimage[:]
 	}
 
+	paymentAddr := invoice.Terms.PaymentAddr
+	if paymentAddr != channeldb.BlankPayAddr {
+		rpcInvoice.PaymentAddr = paymentAddr[:]
+	}
+
 	return rpcInvoice, nil
 }
 
@@ -0,0 +1,26 @@
+// +build rebalancerrpc
+
+package rebalancerrpc
+
+import (
+	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/rebalancer"
+)
+
+// Config is the primary configuration struct for the rebalancer RPC server.
+// It contains all the items required for the rpc server to carry out its
+// duties. The fields with struct tags are meant to be parsed as normal
+// configuration options, while if able to be populated, the latter fields MUST
+// also be specified.
+type Config struct {
+	// Active indicates if the rebalancer is enabled.
+	Active bool
+
+	// Rebalancer is the active rebalancer whose targets are managed via
+	// RPC.
+	Rebalancer *rebalancer.Rebalancer
+
+	// RebalanceLog holds the outcome of all past rebalances. It is
+	// available even if the rebalancer is currently disabled.
+	RebalanceLog *channeldb.RebalanceLog
+}
@@ -0,0 +1,6 @@
+// +build !rebalancerrpc
+
+package rebalancerrpc
+
+// Config is empty for non-rebalancerrpc builds.
+type Config struct{}
@@ -0,0 +1,626 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: rebalancerrpc/rebalancer.proto
+
+package rebalancerrpc
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Target struct {
+	//*
+	//The short channel id of the channel that the target applies to, or zero
+	//for the default target.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	//*
+	//The lowest acceptable share of the channel capacity that is on our side.
+	//Channels below it are rebalanced.
+	MinLocalRatio float64 `protobuf:"fixed64,2,opt,name=min_local_ratio,json=minLocalRatio,proto3" json:"min_local_ratio,omitempty"`
+	//*
+	//The highest acceptable share of the channel capacity that is on our side.
+	//Channels above it are rebalanced.
+	MaxLocalRatio        float64  `protobuf:"fixed64,3,opt,name=max_local_ratio,json=maxLocalRatio,proto3" json:"max_local_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Target) Reset()         { *m = Target{} }
+func (m *Target) String() string { return proto.CompactTextString(m) }
+func (*Target) ProtoMessage()    {}
+func (*Target) Descriptor() ([]byte, []int) {
+	return fileDescriptor_276da7fd0dc55a3c, []int{0}
+}
+
+func (m *Target) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Target.Unmarshal(m, b)
+}
+func (m *Target) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Target.Marshal(b, m, deterministic)
+}
+func (m *Target) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Target.Merge(m, src)
+}
+func (m *Target) XXX_Size() int {
+	return xxx_messageInfo_Target.Size(m)
+}
+func (m *Target) XXX_DiscardUnknown() {
+	xxx_messageInfo_Target.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Target proto.InternalMessageInfo
+
+func (m *Target) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *Target) GetMinLocalRatio() float64 {
+	if m != nil {
+		return m.MinLocalRatio
+	}
+	return 0
+}
+
+func (m *Target) GetMaxLocalRatio() float64 {
+	if m != nil {
+		return m.MaxLocalRatio
+	}
+	return 0
+}
+
+type SetTargetRequest struct {
+	/// The target to set.
+	Target               *Target  `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetTargetRequest) Reset()         { *m = SetTargetRequest{} }
+func (m *SetTargetRequest) String() string { return proto.CompactTextString(m) }
+func (*SetTargetRequest) ProtoMessage()    {}
+func (*SetTargetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_276da7fd0dc55a3c, []int{1}
+}
+
+func (m *SetTargetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTargetRequest.Unmarshal(m, b)
+}
+func (m *SetTargetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTargetRequest.Marshal(b, m, deterministic)
+}
+func (m *SetTargetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTargetRequest.Merge(m, src)
+}
+func (m *SetTargetRequest) XXX_Size() int {
+	return xxx_messageInfo_SetTargetRequest.Size(m)
+}
+func (m *SetTargetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTargetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTargetRequest proto.InternalMessageInfo
+
+func (m *SetTargetRequest) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+type SetTargetResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetTargetResponse) Reset()         { *m = SetTargetResponse{} }
+func (m *SetTargetResponse) String() string { return proto.CompactTextString(m) }
+func (*SetTargetResponse) ProtoMessage()    {}
+func (*SetTargetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_276da7fd0dc55a3c, []int{2}
+}
+
+func (m *SetTargetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTargetResponse.Unmarshal(m, b)
+}
+func (m *SetTargetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTargetResponse.Marshal(b, m, deterministic)
+}
+func (m *SetTargetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTargetResponse.Merge(m, src)
+}
+func (m *SetTargetResponse) XXX_Size() int {
+	return xxx_messageInfo_SetTargetResponse.Size(m)
+}
+func (m *SetTargetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTargetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTargetResponse proto.InternalMessageInfo
+
+type ListTargetsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTargetsRequest) Reset()         { *m = ListTargetsRequest{} }
+func (m *ListTargetsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTargetsRequest) ProtoMessage()    {}
+func (*ListTargetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_276da7fd0dc55a3c, []int{3}
+}
+
+func (m *ListTargetsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTargetsRequest.Unmarshal(m, b)
+}
+func (m *ListTargetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTargetsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListTargetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTargetsRequest.Merge(m, src)
+}
+func (m *ListTargetsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTargetsRequest.Size(m)
+}
+func (m *ListTargetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTargetsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTargetsRequest proto.InternalMessageInfo
+
+type ListTargetsResponse struct {
+	/// The target of all channels without a target of their own.
+	DefaultTarget *Target `protobuf:"bytes,1,opt,name=default_target,json=defaultTarget,proto3" json:"default_target,omitempty"`
+	/// The targets of the channels that override the default target.
+	ChannelTargets       []*Target `protobuf:"bytes,2,rep,name=channel_targets,json=channelTargets,proto3" json:"channel_targets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ListTargetsResponse) Reset()         { *m = ListTargetsResponse{} }
+func (m *ListTargetsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTargetsResponse) ProtoMessage()    {}
+func (*ListTargetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_276da7fd0dc55a3c, []int{4}
+}
+
+func (m *ListTargetsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTargetsResponse.Unmarshal(m, b)
+}
+func (m *ListTargetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTargetsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTargetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTargetsResponse.Merge(m, src)
+}
+func (m *ListTargetsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTargetsResponse.Size(m)
+}
+func (m *ListTargetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTargetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTargetsResponse proto.InternalMessageInfo
+
+func (m *ListTargetsResponse) GetDefaultTarget() *Target {
+	if m != nil {
+		return m.DefaultTarget
+	}
+	return nil
+}
+
+func (m *ListTargetsResponse) GetChannelTargets() []*Target {
+	if m != nil {
+		return m.ChannelTargets
+	}
+	return nil
+}
+
+type RebalanceHistoryRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebalanceHistoryRequest) Reset()         { *m = RebalanceHistoryRequest{} }
+func (m *RebalanceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*RebalanceHistoryRequest) ProtoMessage()    {}
+func (*RebalanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_276da7fd0dc55a3c, []int{5}
+}
+
+func (m *RebalanceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceHistoryRequest.Unmarshal(m, b)
+}
+func (m *RebalanceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *RebalanceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceHistoryRequest.Merge(m, src)
+}
+func (m *RebalanceHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_RebalanceHistoryRequest.Size(m)
+}
+func (m *RebalanceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceHistoryRequest proto.InternalMessageInfo
+
+type Rebalance struct {
+	/// The payment hash of the circular payment.
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	/// The time at which the rebalance completed, in seconds since the epoch.
+	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	/// The channel that the payment left through.
+	OutgoingChanId uint64 `protobuf:"varint,3,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	//*
+	//The channel that the payment came back through. It is zero if the
+	//rebalance failed.
+	IncomingChanId uint64 `protobuf:"varint,4,opt,name=incoming_chan_id,json=incomingChanId,proto3" json:"incoming_chan_id,omitempty"`
+	/// The amount that was moved between the channels, excluding fees.
+	AmtMsat uint64 `protobuf:"varint,5,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	/// The fees paid for the rebalance.
+	FeeMsat uint64 `protobuf:"varint,6,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
+	/// Whether the rebalance succeeded.
+	Succeeded            bool     `protobuf:"varint,7,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Rebalance) Reset()         { *m = Rebalance{} }
+func (m *Rebalance) String() string { return proto.CompactTextString(m) }
+func (*Rebalance) ProtoMessage()    {}
+func (*Rebalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_276da7fd0dc55a3c, []int{6}
+}
+
+func (m *Rebalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rebalance.Unmarshal(m, b)
+}
+func (m *Rebalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rebalance.Marshal(b, m, deterministic)
+}
+func (m *Rebalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rebalance.Merge(m, src)
+}
+func (m *Rebalance) XXX_Size() int {
+	return xxx_messageInfo_Rebalance.Size(m)
+}
+func (m *Rebalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rebalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rebalance proto.InternalMessageInfo
+
+func (m *Rebalance) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *Rebalance) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Rebalance) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+func (m *Rebalance) GetIncomingChanId() uint64 {
+	if m != nil {
+		return m.IncomingChanId
+	}
+	return 0
+}
+
+func (m *Rebalance) GetAmtMsat() uint64 {
+	if m != nil {
+		return m.AmtMsat
+	}
+	return 0
+}
+
+func (m *Rebalance) GetFeeMsat() uint64 {
+	if m != nil {
+		return m.FeeMsat
+	}
+	return 0
+}
+
+func (m *Rebalance) GetSucceeded() bool {
+	if m != nil {
+		return m.Succeeded
+	}
+	return false
+}
+
+type RebalanceHistoryResponse struct {
+	/// All rebalances that were attempted.
+	Rebalances []*Rebalance `protobuf:"bytes,1,rep,name=rebalances,proto3" json:"rebalances,omitempty"`
+	/// The total fees paid for successful rebalances.
+	TotalFeeMsat         uint64   `protobuf:"varint,2,opt,name=total_fee_msat,json=totalFeeMsat,proto3" json:"total_fee_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebalanceHistoryResponse) Reset()         { *m = RebalanceHistoryResponse{} }
+func (m *RebalanceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*RebalanceHistoryResponse) ProtoMessage()    {}
+func (*RebalanceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_276da7fd0dc55a3c, []int{7}
+}
+
+func (m *RebalanceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceHistoryResponse.Unmarshal(m, b)
+}
+func (m *RebalanceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *RebalanceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceHistoryResponse.Merge(m, src)
+}
+func (m *RebalanceHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_RebalanceHistoryResponse.Size(m)
+}
+func (m *RebalanceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceHistoryResponse proto.InternalMessageInfo
+
+func (m *RebalanceHistoryResponse) GetRebalances() []*Rebalance {
+	if m != nil {
+		return m.Rebalances
+	}
+	return nil
+}
+
+func (m *RebalanceHistoryResponse) GetTotalFeeMsat() uint64 {
+	if m != nil {
+		return m.TotalFeeMsat
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Target)(nil), "rebalancerrpc.Target")
+	proto.RegisterType((*SetTargetRequest)(nil), "rebalancerrpc.SetTargetRequest")
+	proto.RegisterType((*SetTargetResponse)(nil), "rebalancerrpc.SetTargetResponse")
+	proto.RegisterType((*ListTargetsRequest)(nil), "rebalancerrpc.ListTargetsRequest")
+	proto.RegisterType((*ListTargetsResponse)(nil), "rebalancerrpc.ListTargetsResponse")
+	proto.RegisterType((*RebalanceHistoryRequest)(nil), "rebalancerrpc.RebalanceHistoryRequest")
+	proto.RegisterType((*Rebalance)(nil), "rebalancerrpc.Rebalance")
+	proto.RegisterType((*RebalanceHistoryResponse)(nil), "rebalancerrpc.RebalanceHistoryResponse")
+}
+
+func init() { proto.RegisterFile("rebalancerrpc/rebalancer.proto", fileDescriptor_276da7fd0dc55a3c) }
+
+var fileDescriptor_276da7fd0dc55a3c = []byte{
+	// 521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0x95, 0xb6, 0xb4, 0xeb, 0xe9, 0x9f, 0x15, 0x0f, 0xb4, 0xac, 0x9a, 0xa0, 0x8b, 0xd0,
+	0x28, 0x42, 0xb4, 0xd2, 0xb8, 0xe1, 0x02, 0x21, 0xb1, 0x49, 0x30, 0xa4, 0x81, 0x50, 0x28, 0x37,
+	0xdc, 0x44, 0xae, 0xe3, 0xb6, 0x96, 0x62, 0x3b, 0x8b, 0x5d, 0x69, 0xe3, 0x35, 0xb8, 0xe0, 0x45,
+	0x79, 0x00, 0x14, 0xc7, 0x49, 0x9b, 0x4c, 0x85, 0x5d, 0xe6, 0x3b, 0xbf, 0xe3, 0xf3, 0xe9, 0x3b,
+	0x47, 0x81, 0x27, 0x09, 0x9d, 0xe3, 0x08, 0x0b, 0x42, 0x93, 0x24, 0x26, 0xd3, 0xcd, 0xd7, 0x24,
+	0x4e, 0xa4, 0x96, 0xa8, 0x57, 0xaa, 0x7b, 0xd7, 0xd0, 0x9c, 0xe1, 0x64, 0x49, 0x35, 0x3a, 0x84,
+	0x16, 0x59, 0x61, 0x11, 0xb0, 0xd0, 0x75, 0x46, 0xce, 0xb8, 0xe1, 0x37, 0xd3, 0xcf, 0x4f, 0x21,
+	0x3a, 0x85, 0x7d, 0xce, 0x44, 0x10, 0x49, 0x82, 0xa3, 0x20, 0xc1, 0x9a, 0x49, 0xb7, 0x36, 0x72,
+	0xc6, 0x8e, 0xdf, 0xe3, 0x4c, 0x5c, 0xa5, 0xaa, 0x9f, 0x8a, 0x86, 0xc3, 0x37, 0x25, 0xae, 0x6e,
+	0x39, 0x7c, 0xb3, 0xe1, 0xbc, 0xf7, 0x30, 0xf8, 0x46, 0x75, 0x36, 0xd5, 0xa7, 0xd7, 0x6b, 0xaa,
+	0x34, 0x7a, 0x05, 0x4d, 0x6d, 0x04, 0x33, 0xbb, 0x73, 0xf6, 0x78, 0x52, 0xb2, 0x39, 0xb1, 0xb4,
+	0x85, 0xbc, 0x03, 0x78, 0xb8, 0xf5, 0x84, 0x8a, 0xa5, 0x50, 0xd4, 0x7b, 0x04, 0xe8, 0x8a, 0x29,
+	0xab, 0x2a, 0xfb, 0xb2, 0xf7, 0xcb, 0x81, 0x83, 0x92, 0x9c, 0xd1, 0xe8, 0x2d, 0xf4, 0x43, 0xba,
+	0xc0, 0xeb, 0x48, 0x07, 0xf7, 0x99, 0xdc, 0xb3, 0xb0, 0x0d, 0xeb, 0x1d, 0xec, 0xa7, 0xe9, 0x08,
+	0x1a, 0xd9, 0x6e, 0xe5, 0xd6, 0x46, 0xf5, 0xdd, 0xed, 0x7d, 0x4b, 0x5b, 0x17, 0xde, 0x11, 0x1c,
+	0xfa, 0x39, 0x77, 0xc9, 0x94, 0x96, 0xc9, 0x6d, 0x6e, 0xf8, 0x8f, 0x03, 0xed, 0xa2, 0x86, 0x4e,
+	0xa0, 0x1b, 0xe3, 0x5b, 0x4e, 0x85, 0x0e, 0x56, 0x58, 0xad, 0x8c, 0xc9, 0xae, 0xdf, 0xb1, 0xda,
+	0x25, 0x56, 0x2b, 0x74, 0x0c, 0x6d, 0xcd, 0x38, 0x55, 0x1a, 0xf3, 0xd8, 0x6c, 0xa6, 0xe1, 0x6f,
+	0x04, 0x34, 0x86, 0x81, 0x5c, 0xeb, 0xa5, 0x64, 0x62, 0x19, 0xe4, 0xfb, 0xad, 0x1b, 0xa8, 0x9f,
+	0xeb, 0x17, 0xd9, 0x9e, 0xc7, 0x30, 0x60, 0x82, 0x48, 0xbe, 0x4d, 0x36, 0x32, 0x32, 0xd7, 0x2d,
+	0x79, 0x04, 0x7b, 0x98, 0xeb, 0x80, 0x2b, 0xac, 0xdd, 0x07, 0x86, 0x68, 0x61, 0xae, 0x3f, 0x2b,
+	0xac, 0xd3, 0xd2, 0x82, 0xd2, 0xac, 0xd4, 0xcc, 0x4a, 0x0b, 0x4a, 0x4d, 0xe9, 0x18, 0xda, 0x6a,
+	0x4d, 0x08, 0xa5, 0x21, 0x0d, 0xdd, 0xd6, 0xc8, 0x19, 0xef, 0xf9, 0x1b, 0xc1, 0xfb, 0x09, 0xee,
+	0xdd, 0x44, 0xec, 0xae, 0xde, 0x00, 0x14, 0xa9, 0x2a, 0xd7, 0x31, 0x41, 0xbb, 0x95, 0xa0, 0x8b,
+	0x66, 0x7f, 0x8b, 0x45, 0xcf, 0xa0, 0xaf, 0xa5, 0xc6, 0x51, 0x50, 0x98, 0xca, 0x02, 0xea, 0x1a,
+	0xf5, 0x43, 0xe6, 0xec, 0xec, 0x77, 0x0d, 0xa0, 0xe8, 0x4f, 0xd0, 0x17, 0x68, 0x17, 0xd7, 0x85,
+	0x9e, 0x56, 0xe6, 0x54, 0x4f, 0x77, 0x38, 0xda, 0x0d, 0x58, 0xfb, 0x33, 0xe8, 0x6c, 0x5d, 0x20,
+	0x3a, 0xa9, 0x34, 0xdc, 0x3d, 0xda, 0xa1, 0xf7, 0x2f, 0xc4, 0xbe, 0x4a, 0x60, 0x50, 0x0d, 0x0c,
+	0x9d, 0xee, 0x0a, 0xa5, 0x7c, 0x63, 0xc3, 0xe7, 0xff, 0xe5, 0xb2, 0x21, 0xe7, 0x2f, 0x7f, 0xbc,
+	0x58, 0x32, 0xbd, 0x5a, 0xcf, 0x27, 0x44, 0xf2, 0xe9, 0xf9, 0xec, 0xe2, 0xe3, 0xd7, 0xef, 0xd3,
+	0x48, 0x84, 0xd3, 0x48, 0x94, 0xff, 0x2e, 0x49, 0x4c, 0xe6, 0x4d, 0xf3, 0x87, 0x79, 0xfd, 0x77,
+	0x00, 0x0d, 0xa0, 0x06, 0xce, 0x83, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RebalancerClient is the client API for Rebalancer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RebalancerClient interface {
+	//*
+	//SetTarget sets the range of local balance ratios that is acceptable for a
+	//channel. If no channel is specified, the default target that applies to
+	//all channels without a target of their own is set instead. Targets are
+	//persisted across restarts, and a default target set this way takes
+	//precedence over the one in the config.
+	SetTarget(ctx context.Context, in *SetTargetRequest, opts ...grpc.CallOption) (*SetTargetResponse, error)
+	//*
+	//ListTargets returns the default target along with the targets of all
+	//channels that override it.
+	ListTargets(ctx context.Context, in *ListTargetsRequest, opts ...grpc.CallOption) (*ListTargetsResponse, error)
+	//*
+	//RebalanceHistory returns all rebalances that were attempted, ordered by
+	//time, along with the total fees paid for rebalancing.
+	RebalanceHistory(ctx context.Context, in *RebalanceHistoryRequest, opts ...grpc.CallOption) (*RebalanceHistoryResponse, error)
+}
+
+type rebalancerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRebalancerClient(cc *grpc.ClientConn) RebalancerClient {
+	return &rebalancerClient{cc}
+}
+
+func (c *rebalancerClient) SetTarget(ctx context.Context, in *SetTargetRequest, opts ...grpc.CallOption) (*SetTargetResponse, error) {
+	out := new(SetTargetResponse)
+	err := c.cc.Invoke(ctx, "/rebalancerrpc.Rebalancer/SetTarget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rebalancerClient) ListTargets(ctx context.Context, in *ListTargetsRequest, opts ...grpc.CallOption) (*ListTargetsResponse, error) {
+	out := new(ListTargetsResponse)
+	err := c.cc.Invoke(ctx, "/rebalancerrpc.Rebalancer/ListTargets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rebalancerClient) RebalanceHistory(ctx context.Context, in *RebalanceHistoryRequest, opts ...grpc.CallOption) (*RebalanceHistoryResponse, error) {
+	out := new(RebalanceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/rebalancerrpc.Rebalancer/RebalanceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RebalancerServer is the server API for Rebalancer service.
+type RebalancerServer interface {
+	//*
+	//SetTarget sets the range of local balance ratios that is acceptable for a
+	//channel. If no channel is specified, the default target that applies to
+	//all channels without a target of their own is set instead. Targets are
+	//persisted across restarts, and a default target set this way takes
+	//precedence over the one in the config.
+	SetTarget(context.Context, *SetTargetRequest) (*SetTargetResponse, error)
+	//*
+	//ListTargets returns the default target along with the targets of all
+	//channels that override it.
+	ListTargets(context.Context, *ListTargetsRequest) (*ListTargetsResponse, error)
+	//*
+	//RebalanceHistory returns all rebalances that were attempted, ordered by
+	//time, along with the total fees paid for rebalancing.
+	RebalanceHistory(context.Context, *RebalanceHistoryRequest) (*RebalanceHistoryResponse, error)
+}
+
+func RegisterRebalancerServer(s *grpc.Server, srv RebalancerServer) {
+	s.RegisterService(&_Rebalancer_serviceDesc, srv)
+}
+
+func _Rebalancer_SetTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RebalancerServer).SetTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rebalancerrpc.Rebalancer/SetTarget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RebalancerServer).SetTarget(ctx, req.(*SetTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rebalancer_ListTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RebalancerServer).ListTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rebalancerrpc.Rebalancer/ListTargets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RebalancerServer).ListTargets(ctx, req.(*ListTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rebalancer_RebalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RebalancerServer).RebalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rebalancerrpc.Rebalancer/RebalanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RebalancerServer).RebalanceHistory(ctx, req.(*RebalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Rebalancer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rebalancerrpc.Rebalancer",
+	HandlerType: (*RebalancerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetTarget",
+			Handler:    _Rebalancer_SetTarget_Handler,
+		},
+		{
+			MethodName: "ListTargets",
+			Handler:    _Rebalancer_ListTargets_Handler,
+		},
+		{
+			MethodName: "RebalanceHistory",
+			Handler:    _Rebalancer_RebalanceHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rebalancerrpc/rebalancer.proto",
+}
@@ -0,0 +1,223 @@
+// +build rebalancerrpc
+
+package rebalancerrpc
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/BTCGPU/lnd/lnrpc"
+	"github.com/BTCGPU/lnd/lnwire"
+	"github.com/BTCGPU/lnd/rebalancer"
+	"google.golang.org/grpc"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+const (
+	// subServerName is the name of the sub rpc server. We'll use this name
+	// to register ourselves, and we also require that the main
+	// SubServerConfigDispatcher instance recognize it as the name of our
+	// RPC service.
+	subServerName = "RebalancerRPC"
+)
+
+var (
+	// macPermissions maps RPC calls to the permissions they require.
+	macPermissions = map[string][]bakery.Op{
+		"/rebalancerrpc.Rebalancer/SetTarget": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/rebalancerrpc.Rebalancer/ListTargets": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/rebalancerrpc.Rebalancer/RebalanceHistory": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+	}
+
+	// ErrRebalancerNotActive signals that RPC calls cannot be processed
+	// because the rebalancer is not active.
+	ErrRebalancerNotActive = errors.New("rebalancer not active")
+)
+
+// Server is a sub-server of the main RPC server: the rebalancer RPC. This sub
+// RPC server allows external callers to manage the targets of the rebalancer
+// at runtime, and to inspect the rebalances it performed.
+type Server struct {
+	cfg *Config
+}
+
+// A compile time check to ensure that Server fully implements the
+// RebalancerServer gRPC service.
+var _ RebalancerServer = (*Server)(nil)
+
+// New returns a new instance of the rebalancerrpc Rebalancer sub-server. We
+// also return the set of permissions for the macaroons that we may create
+// within this method. If the macaroons we need aren't found in the filepath,
+// then we'll create them on start up. If we're unable to locate, or create the
+// macaroons we need, then we'll return with an error.
+func New(cfg *Config) (*Server, lnrpc.MacaroonPerms, error) {
+	// We don't create any new macaroons for this subserver, instead reuse
+	// existing offchain permissions.
+	server := &Server{
+		cfg: cfg,
+	}
+
+	return server, macPermissions, nil
+}
+
+// Start launches any helper goroutines required for the Server to function.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Start() error {
+	return nil
+}
+
+// Stop signals any active goroutines for a graceful closure.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Stop() error {
+	return nil
+}
+
+// Name returns a unique string representation of the sub-server. This can be
+// used to identify the sub-server and also de-duplicate them.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Name() string {
+	return subServerName
+}
+
+// RegisterWithRootServer will be called by the root gRPC server to direct a
+// sub RPC server to register itself with the main gRPC root server. Until this
+// is called, each sub-server won't be able to have requests routed towards it.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) RegisterWithRootServer(grpcServer *grpc.Server) error {
+	// We make sure that we register it with the main gRPC server to ensure
+	// all our methods are routed properly.
+	RegisterRebalancerServer(grpcServer, s)
+
+	log.Debugf("Rebalancer RPC server successfully register with root " +
+		"gRPC server")
+
+	return nil
+}
+
+// SetTarget sets the range of local balance ratios that is acceptable for a
+// channel, or the default range if no channel is specified.
+//
+// NOTE: Part of the RebalancerServer interface.
+func (s *Server) SetTarget(ctx context.Context,
+	in *SetTargetRequest) (*SetTargetResponse, error) {
+
+	if err := s.isActive(); err != nil {
+		return nil, err
+	}
+
+	if in.Target == nil {
+		return nil, errors.New("target must be specified")
+	}
+
+	err := s.cfg.Rebalancer.SetTarget(
+		lnwire.NewShortChanIDFromInt(in.Target.ChanId),
+		rebalancer.Target{
+			MinLocalRatio: in.Target.MinLocalRatio,
+			MaxLocalRatio: in.Target.MaxLocalRatio,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SetTargetResponse{}, nil
+}
+
+// ListTargets returns the default target along with the targets of all
+// channels that override it.
+//
+// NOTE: Part of the RebalancerServer interface.
+func (s *Server) ListTargets(ctx context.Context,
+	in *ListTargetsRequest) (*ListTargetsResponse, error) {
+
+	if err := s.isActive(); err != nil {
+		return nil, err
+	}
+
+	marshallTarget := func(chanID lnwire.ShortChannelID,
+		target rebalancer.Target) *Target {
+
+		return &Target{
+			ChanId:        chanID.ToUint64(),
+			MinLocalRatio: target.MinLocalRatio,
+			MaxLocalRatio: target.MaxLocalRatio,
+		}
+	}
+
+	defaultTarget, targets := s.cfg.Rebalancer.Targets()
+
+	resp := &ListTargetsResponse{
+		DefaultTarget: marshallTarget(
+			lnwire.ShortChannelID{}, defaultTarget,
+		),
+	}
+	for chanID, target := range targets {
+		resp.ChannelTargets = append(
+			resp.ChannelTargets, marshallTarget(chanID, target),
+		)
+	}
+
+	sort.Slice(resp.ChannelTargets, func(i, j int) bool {
+		return resp.ChannelTargets[i].ChanId <
+			resp.ChannelTargets[j].ChanId
+	})
+
+	return resp, nil
+}
+
+// RebalanceHistory returns all rebalances that were attempted, along with the
+// total fees paid for rebalancing.
+//
+// NOTE: Part of the RebalancerServer interface.
+func (s *Server) RebalanceHistory(ctx context.Context,
+	in *RebalanceHistoryRequest) (*RebalanceHistoryResponse, error) {
+
+	events, err := s.cfg.RebalanceLog.FetchRebalances()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &RebalanceHistoryResponse{}
+	for _, event := range events {
+		hash := event.PaymentHash
+		resp.Rebalances = append(resp.Rebalances, &Rebalance{
+			PaymentHash:    hash[:],
+			Timestamp:      uint64(event.Timestamp.Unix()),
+			OutgoingChanId: event.OutgoingChanID.ToUint64(),
+			IncomingChanId: event.IncomingChanID.ToUint64(),
+			AmtMsat:        uint64(event.Amount),
+			FeeMsat:        uint64(event.Fee),
+			Succeeded:      event.Succeeded,
+		})
+
+		if event.Succeeded {
+			resp.TotalFeeMsat += uint64(event.Fee)
+		}
+	}
+
+	return resp, nil
+}
+
+// isActive returns nil if the rebalancer is initialized, and the Server can
+// process RPC requests that modify its targets.
+func (s *Server) isActive() error {
+	if s.cfg.Active {
+		return nil
+	}
+
+	return ErrRebalancerNotActive
+}
@@ -3,6 +3,7 @@
 package routerrpc
 
 import (
+	"github.com/BTCGPU/lnd/htlcswitch"
 	"github.com/BTCGPU/lnd/macaroons"
 	"github.com/BTCGPU/lnd/routing"
 )
@@ -39,6 +40,14 @@ type Config struct {
 	// RouterBackend contains shared logic between this sub server and the
 	// main rpc server.
 	RouterBackend *RouterBackend
+
+	// InterceptableForwarder is the forwarder that allows forwarded htlcs
+	// to be held and resolved by an HtlcInterceptor client.
+	InterceptableForwarder htlcswitch.InterceptableHtlcForwarder
+
+	// HtlcNotifier is the notifier that delivers the htlc events that are
+	// exposed through SubscribeHtlcEvents.
+	HtlcNotifier *htlcswitch.HtlcNotifier
 }
 
 // DefaultConfig defines the config defaults.
@@ -0,0 +1,253 @@
+// +build routerrpc
+
+package routerrpc
+
+import (
+	"errors"
+	"io"
+
+	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/htlcswitch"
+	"github.com/BTCGPU/lnd/lntypes"
+	"github.com/BTCGPU/lnd/lnwire"
+	"github.com/BTCGPU/lnd/queue"
+)
+
+var (
+	// ErrFwdNotExists is returned when the client tries to resolve a
+	// forward that isn't held by the interceptor.
+	ErrFwdNotExists = errors.New("forward does not exist")
+
+	// ErrMissingPreimage is returned when the client tries to settle a
+	// forward without providing a preimage.
+	ErrMissingPreimage = errors.New("missing preimage")
+
+	// ErrInterceptorAlreadyExists is returned when a client tries to
+	// register an interceptor while another one is still active.
+	ErrInterceptorAlreadyExists = errors.New("interceptor already exists")
+)
+
+// forwardInterceptor handles the lifecycle of a single HtlcInterceptor
+// stream. It is created when the stream opens, and unregisters itself from the
+// switch when the stream closes, which fails back all the forwards that are
+// still held.
+type forwardInterceptor struct {
+	// server is the Server reference.
+	server *Server
+
+	// stream is the bidirectional RPC stream.
+	stream Router_HtlcInterceptorServer
+
+	// holdForwards is the set of forwards that are currently held, and
+	// waiting for a resolution from the client.
+	holdForwards map[channeldb.CircuitKey]htlcswitch.InterceptedForward
+
+	// interceptedForwards queues the forwards that are intercepted by the
+	// switch, so that the switch doesn't block on a slow client.
+	interceptedForwards *queue.ConcurrentQueue
+
+	// resolutions is the channel over which the client responses are
+	// delivered.
+	resolutions chan *ForwardHtlcInterceptResponse
+
+	// errChan receives the error that ended the client response stream.
+	errChan chan error
+
+	// quit is closed when the interceptor is shutting down.
+	quit chan struct{}
+}
+
+// newForwardInterceptor creates a new forwardInterceptor for the given
+// stream.
+func newForwardInterceptor(server *Server,
+	stream Router_HtlcInterceptorServer) *forwardInterceptor {
+
+	return &forwardInterceptor{
+		server: server,
+		stream: stream,
+		holdForwards: make(
+			map[channeldb.CircuitKey]htlcswitch.InterceptedForward,
+		),
+		interceptedForwards: queue.NewConcurrentQueue(10),
+		resolutions:         make(chan *ForwardHtlcInterceptResponse),
+		errChan:             make(chan error, 1),
+		quit:                make(chan struct{}),
+	}
+}
+
+// run registers the interceptor with the switch, and processes intercepted
+// forwards and client responses until the stream ends.
+func (r *forwardInterceptor) run() error {
+	r.interceptedForwards.Start()
+	r.server.cfg.InterceptableForwarder.SetInterceptor(r.onIntercept)
+	defer r.stop()
+
+	// Receiving from the stream blocks, so we'll read the client
+	// responses in a separate goroutine.
+	go r.readClientResponses()
+
+	for {
+		select {
+		case item := <-r.interceptedForwards.ChanOut():
+			fwd := item.(htlcswitch.InterceptedForward)
+			if err := r.holdAndForwardToClient(fwd); err != nil {
+				return err
+			}
+
+		case resp := <-r.resolutions:
+			if err := r.resolveFromClient(resp); err != nil {
+				return err
+			}
+
+		case err := <-r.errChan:
+			return err
+
+		case <-r.stream.Context().Done():
+			return r.stream.Context().Err()
+		}
+	}
+}
+
+// onIntercept is the ForwardInterceptor that is registered with the switch.
+// It queues the forward for the main loop, and holds it unless the
+// interceptor is shutting down.
+func (r *forwardInterceptor) onIntercept(
+	fwd htlcswitch.InterceptedForward) bool {
+
+	select {
+	case r.interceptedForwards.ChanIn() <- fwd:
+		return true
+
+	case <-r.quit:
+		return false
+	}
+}
+
+// readClientResponses reads the client responses from the stream and
+// delivers them to the main loop, until the stream ends.
+func (r *forwardInterceptor) readClientResponses() {
+	for {
+		resp, err := r.stream.Recv()
+		if err != nil {
+			// The client closing its side of the stream is a
+			// regular way of ending the interceptor.
+			if err == io.EOF {
+				err = nil
+			}
+
+			r.errChan <- err
+			return
+		}
+
+		select {
+		case r.resolutions <- resp:
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// holdAndForwardToClient holds the given forward and sends it to the client.
+func (r *forwardInterceptor) holdAndForwardToClient(
+	fwd htlcswitch.InterceptedForward) error {
+
+	htlc := fwd.Packet()
+	inKey := htlc.IncomingCircuit
+
+	// Hold the forward before sending it to the client, so that it is
+	// failed back if the stream breaks.
+	r.holdForwards[inKey] = fwd
+
+	log.Debugf("Holding forward %v for interceptor", inKey)
+
+	return r.stream.Send(&ForwardHtlcInterceptRequest{
+		IncomingCircuitKey: &CircuitKey{
+			ChanId: inKey.ChanID.ToUint64(),
+			HtlcId: inKey.HtlcID,
+		},
+		PaymentHash:             htlc.Hash[:],
+		IncomingAmountMsat:      uint64(htlc.IncomingAmount),
+		IncomingExpiry:          htlc.IncomingExpiry,
+		OutgoingRequestedChanId: htlc.OutgoingChanID.ToUint64(),
+		OutgoingAmountMsat:      uint64(htlc.OutgoingAmount),
+		OutgoingExpiry:          htlc.OutgoingExpiry,
+	})
+}
+
+// resolveFromClient resolves a held forward according to the client
+// response.
+func (r *forwardInterceptor) resolveFromClient(
+	resp *ForwardHtlcInterceptResponse) error {
+
+	if resp.IncomingCircuitKey == nil {
+		return errors.New("missing incoming circuit key")
+	}
+
+	inKey := channeldb.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(
+			resp.IncomingCircuitKey.ChanId,
+		),
+		HtlcID: resp.IncomingCircuitKey.HtlcId,
+	}
+
+	fwd, ok := r.holdForwards[inKey]
+	if !ok {
+		return ErrFwdNotExists
+	}
+
+	log.Debugf("Resolving forward %v with action %v", inKey, resp.Action)
+
+	var err error
+	switch resp.Action {
+	case ResolveHoldForwardAction_RESUME:
+		err = fwd.Resume()
+
+	case ResolveHoldForwardAction_FAIL:
+		err = fwd.Fail()
+
+	case ResolveHoldForwardAction_SETTLE:
+		if resp.Preimage == nil {
+			return ErrMissingPreimage
+		}
+		preimage, perr := lntypes.MakePreimage(resp.Preimage)
+		if perr != nil {
+			return perr
+		}
+
+		// A wrong preimage leaves the forward held, so that it is
+		// failed back when the stream ends.
+		err = fwd.Settle(preimage)
+
+	default:
+		return errors.New("unrecognized resolve action")
+	}
+
+	switch err {
+	// The switch failed the forward back because its hold timeout
+	// expired. This doesn't end the stream, as the client can't know
+	// when exactly that happens.
+	case htlcswitch.ErrFwdNotHeld:
+		log.Debugf("Forward %v is no longer held", inKey)
+		delete(r.holdForwards, inKey)
+		return nil
+
+	case nil:
+		delete(r.holdForwards, inKey)
+		return nil
+
+	default:
+		return err
+	}
+}
+
+// stop unregisters the interceptor from the switch, which fails back all the
+// forwards that are still held.
+func (r *forwardInterceptor) stop() {
+	// Closing the quit channel first ensures that forwards that are being
+	// offered to the interceptor right now are forwarded as usual.
+	close(r.quit)
+	r.server.cfg.InterceptableForwarder.SetInterceptor(nil)
+	r.interceptedForwards.Stop()
+
+	r.holdForwards = nil
+}
@@ -74,6 +74,34 @@ func (PaymentState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7a0613f69d37b0a5, []int{0}
 }
 
+type ResolveHoldForwardAction int32
+
+const (
+	ResolveHoldForwardAction_SETTLE ResolveHoldForwardAction = 0
+	ResolveHoldForwardAction_FAIL   ResolveHoldForwardAction = 1
+	ResolveHoldForwardAction_RESUME ResolveHoldForwardAction = 2
+)
+
+var ResolveHoldForwardAction_name = map[int32]string{
+	0: "SETTLE",
+	1: "FAIL",
+	2: "RESUME",
+}
+
+var ResolveHoldForwardAction_value = map[string]int32{
+	"SETTLE": 0,
+	"FAIL":   1,
+	"RESUME": 2,
+}
+
+func (x ResolveHoldForwardAction) String() string {
+	return proto.EnumName(ResolveHoldForwardAction_name, int32(x))
+}
+
+func (ResolveHoldForwardAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{1}
+}
+
 type Failure_FailureCode int32
 
 const (
@@ -177,6 +205,37 @@ func (Failure_FailureCode) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7a0613f69d37b0a5, []int{7, 0}
 }
 
+type HtlcEvent_EventType int32
+
+const (
+	HtlcEvent_UNKNOWN HtlcEvent_EventType = 0
+	HtlcEvent_SEND    HtlcEvent_EventType = 1
+	HtlcEvent_RECEIVE HtlcEvent_EventType = 2
+	HtlcEvent_FORWARD HtlcEvent_EventType = 3
+)
+
+var HtlcEvent_EventType_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "SEND",
+	2: "RECEIVE",
+	3: "FORWARD",
+}
+
+var HtlcEvent_EventType_value = map[string]int32{
+	"UNKNOWN": 0,
+	"SEND":    1,
+	"RECEIVE": 2,
+	"FORWARD": 3,
+}
+
+func (x HtlcEvent_EventType) String() string {
+	return proto.EnumName(HtlcEvent_EventType_name, int32(x))
+}
+
+func (HtlcEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{18, 0}
+}
+
 type SendPaymentRequest struct {
 	/// The identity pubkey of the payment recipient
 	Dest []byte `protobuf:"bytes,1,opt,name=dest,proto3" json:"dest,omitempty"`
@@ -222,8 +281,31 @@ type SendPaymentRequest struct {
 	//*
 	//An optional field that can be used to pass an arbitrary set of TLV records
 	//to a peer which understands the new records. This can be used to pass
-	//application specific data during the payment attempt.
-	DestTlv              map[uint64][]byte `protobuf:"bytes,11,rep,name=dest_tlv,json=destTlv,proto3" json:"dest_tlv,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//application specific data during the payment attempt. For a spontaneous
+	//keysend payment, the payment preimage is included under record type
+	//5482373484, so that the receiver can settle without an invoice.
+	DestCustomRecords map[uint64][]byte `protobuf:"bytes,11,rep,name=dest_custom_records,json=destCustomRecords,proto3" json:"dest_custom_records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//*
+	//The maximum number of partial payments that may be used to complete the
+	//full amount. If zero or one, the payment is sent as a single HTLC. Values
+	//above one require payment_addr to be set.
+	MaxParts uint32 `protobuf:"varint,12,opt,name=max_parts,json=maxParts,proto3" json:"max_parts,omitempty"`
+	//*
+	//An optional payment address to be included in the final hop's payload
+	//using an MPP record. It is provided by the receiver and required to split
+	//a payment across multiple paths.
+	PaymentAddr []byte `protobuf:"bytes,13,opt,name=payment_addr,json=paymentAddr,proto3" json:"payment_addr,omitempty"`
+	//*
+	//The pubkey of the last hop of the route. If empty, any last hop may be
+	//used. Together with outgoing_chan_id and dest set to our own pubkey, this
+	//allows to rebalance channels by paying ourselves.
+	LastHopPubkey []byte `protobuf:"bytes,14,opt,name=last_hop_pubkey,json=lastHopPubkey,proto3" json:"last_hop_pubkey,omitempty"`
+	//*
+	//A list of nodes to ignore during path finding.
+	IgnoredNodes [][]byte `protobuf:"bytes,15,rep,name=ignored_nodes,json=ignoredNodes,proto3" json:"ignored_nodes,omitempty"`
+	//*
+	//A list of directed node pairs that will be ignored during path finding.
+	IgnoredPairs         []*lnrpc.NodePair `protobuf:"bytes,16,rep,name=ignored_pairs,json=ignoredPairs,proto3" json:"ignored_pairs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -324,9 +406,44 @@ func (m *SendPaymentRequest) GetRouteHints() []*lnrpc.RouteHint {
 	return nil
 }
 
-func (m *SendPaymentRequest) GetDestTlv() map[uint64][]byte {
+func (m *SendPaymentRequest) GetDestCustomRecords() map[uint64][]byte {
 	if m != nil {
-		return m.DestTlv
+		return m.DestCustomRecords
+	}
+	return nil
+}
+
+func (m *SendPaymentRequest) GetMaxParts() uint32 {
+	if m != nil {
+		return m.MaxParts
+	}
+	return 0
+}
+
+func (m *SendPaymentRequest) GetPaymentAddr() []byte {
+	if m != nil {
+		return m.PaymentAddr
+	}
+	return nil
+}
+
+func (m *SendPaymentRequest) GetLastHopPubkey() []byte {
+	if m != nil {
+		return m.LastHopPubkey
+	}
+	return nil
+}
+
+func (m *SendPaymentRequest) GetIgnoredNodes() [][]byte {
+	if m != nil {
+		return m.IgnoredNodes
+	}
+	return nil
+}
+
+func (m *SendPaymentRequest) GetIgnoredPairs() []*lnrpc.NodePair {
+	if m != nil {
+		return m.IgnoredPairs
 	}
 	return nil
 }
@@ -1317,11 +1434,680 @@ func (m *BuildRouteResponse) GetRoute() *lnrpc.Route {
 	return nil
 }
 
+type SubscribeHtlcEventsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeHtlcEventsRequest) Reset()         { *m = SubscribeHtlcEventsRequest{} }
+func (m *SubscribeHtlcEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeHtlcEventsRequest) ProtoMessage()    {}
+func (*SubscribeHtlcEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{17}
+}
+
+func (m *SubscribeHtlcEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeHtlcEventsRequest.Unmarshal(m, b)
+}
+func (m *SubscribeHtlcEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeHtlcEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeHtlcEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeHtlcEventsRequest.Merge(m, src)
+}
+func (m *SubscribeHtlcEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeHtlcEventsRequest.Size(m)
+}
+func (m *SubscribeHtlcEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeHtlcEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeHtlcEventsRequest proto.InternalMessageInfo
+
+//*
+//HtlcEvent contains the htlc event that was processed. These are served on a
+//best-effort basis; events are not persisted, delivery is not guaranteed
+//(in the event of a crash in the switch, forward events may be lost) and
+//some events may be replayed upon restart. Events consumed from this stream
+//should be de-duplicated by the htlc's unique combination of incoming and
+//outgoing channel id and htlc id.
+type HtlcEvent struct {
+	//*
+	//The short channel id that the incoming htlc arrived at our node on. This
+	//value is zero for sends.
+	IncomingChannelId uint64 `protobuf:"varint,1,opt,name=incoming_channel_id,json=incomingChannelId,proto3" json:"incoming_channel_id,omitempty"`
+	//*
+	//The short channel id that the outgoing htlc left our node on. This value
+	//is zero for receives.
+	OutgoingChannelId uint64 `protobuf:"varint,2,opt,name=outgoing_channel_id,json=outgoingChannelId,proto3" json:"outgoing_channel_id,omitempty"`
+	//*
+	//Incoming id is the index of the incoming htlc in the incoming channel.
+	//This value is zero for sends.
+	IncomingHtlcId uint64 `protobuf:"varint,3,opt,name=incoming_htlc_id,json=incomingHtlcId,proto3" json:"incoming_htlc_id,omitempty"`
+	//*
+	//Outgoing id is the index of the outgoing htlc in the outgoing channel.
+	//This value is zero for receives.
+	OutgoingHtlcId uint64 `protobuf:"varint,4,opt,name=outgoing_htlc_id,json=outgoingHtlcId,proto3" json:"outgoing_htlc_id,omitempty"`
+	//*
+	//The time in unix nanoseconds that the event occurred.
+	TimestampNs uint64 `protobuf:"varint,5,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
+	//*
+	//The event type indicates whether the htlc was part of a send, receive or
+	//forward.
+	EventType HtlcEvent_EventType `protobuf:"varint,6,opt,name=event_type,json=eventType,proto3,enum=routerrpc.HtlcEvent_EventType" json:"event_type,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//	*HtlcEvent_ForwardEvent
+	//	*HtlcEvent_ForwardFailEvent
+	//	*HtlcEvent_SettleEvent
+	//	*HtlcEvent_LinkFailEvent
+	Event                isHtlcEvent_Event `protobuf_oneof:"event"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *HtlcEvent) Reset()         { *m = HtlcEvent{} }
+func (m *HtlcEvent) String() string { return proto.CompactTextString(m) }
+func (*HtlcEvent) ProtoMessage()    {}
+func (*HtlcEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{18}
+}
+
+func (m *HtlcEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcEvent.Unmarshal(m, b)
+}
+func (m *HtlcEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcEvent.Marshal(b, m, deterministic)
+}
+func (m *HtlcEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcEvent.Merge(m, src)
+}
+func (m *HtlcEvent) XXX_Size() int {
+	return xxx_messageInfo_HtlcEvent.Size(m)
+}
+func (m *HtlcEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcEvent proto.InternalMessageInfo
+
+func (m *HtlcEvent) GetIncomingChannelId() uint64 {
+	if m != nil {
+		return m.IncomingChannelId
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetOutgoingChannelId() uint64 {
+	if m != nil {
+		return m.OutgoingChannelId
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetIncomingHtlcId() uint64 {
+	if m != nil {
+		return m.IncomingHtlcId
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetOutgoingHtlcId() uint64 {
+	if m != nil {
+		return m.OutgoingHtlcId
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetTimestampNs() uint64 {
+	if m != nil {
+		return m.TimestampNs
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetEventType() HtlcEvent_EventType {
+	if m != nil {
+		return m.EventType
+	}
+	return HtlcEvent_UNKNOWN
+}
+
+type isHtlcEvent_Event interface {
+	isHtlcEvent_Event()
+}
+
+type HtlcEvent_ForwardEvent struct {
+	ForwardEvent *ForwardEvent `protobuf:"bytes,7,opt,name=forward_event,json=forwardEvent,proto3,oneof"`
+}
+
+type HtlcEvent_ForwardFailEvent struct {
+	ForwardFailEvent *ForwardFailEvent `protobuf:"bytes,8,opt,name=forward_fail_event,json=forwardFailEvent,proto3,oneof"`
+}
+
+type HtlcEvent_SettleEvent struct {
+	SettleEvent *SettleEvent `protobuf:"bytes,9,opt,name=settle_event,json=settleEvent,proto3,oneof"`
+}
+
+type HtlcEvent_LinkFailEvent struct {
+	LinkFailEvent *LinkFailEvent `protobuf:"bytes,10,opt,name=link_fail_event,json=linkFailEvent,proto3,oneof"`
+}
+
+func (*HtlcEvent_ForwardEvent) isHtlcEvent_Event() {}
+
+func (*HtlcEvent_ForwardFailEvent) isHtlcEvent_Event() {}
+
+func (*HtlcEvent_SettleEvent) isHtlcEvent_Event() {}
+
+func (*HtlcEvent_LinkFailEvent) isHtlcEvent_Event() {}
+
+func (m *HtlcEvent) GetEvent() isHtlcEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *HtlcEvent) GetForwardEvent() *ForwardEvent {
+	if x, ok := m.GetEvent().(*HtlcEvent_ForwardEvent); ok {
+		return x.ForwardEvent
+	}
+	return nil
+}
+
+func (m *HtlcEvent) GetForwardFailEvent() *ForwardFailEvent {
+	if x, ok := m.GetEvent().(*HtlcEvent_ForwardFailEvent); ok {
+		return x.ForwardFailEvent
+	}
+	return nil
+}
+
+func (m *HtlcEvent) GetSettleEvent() *SettleEvent {
+	if x, ok := m.GetEvent().(*HtlcEvent_SettleEvent); ok {
+		return x.SettleEvent
+	}
+	return nil
+}
+
+func (m *HtlcEvent) GetLinkFailEvent() *LinkFailEvent {
+	if x, ok := m.GetEvent().(*HtlcEvent_LinkFailEvent); ok {
+		return x.LinkFailEvent
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*HtlcEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*HtlcEvent_ForwardEvent)(nil),
+		(*HtlcEvent_ForwardFailEvent)(nil),
+		(*HtlcEvent_SettleEvent)(nil),
+		(*HtlcEvent_LinkFailEvent)(nil),
+	}
+}
+
+type HtlcInfo struct {
+	/// The timelock on the incoming htlc.
+	IncomingTimelock uint32 `protobuf:"varint,1,opt,name=incoming_timelock,json=incomingTimelock,proto3" json:"incoming_timelock,omitempty"`
+	/// The timelock on the outgoing htlc.
+	OutgoingTimelock uint32 `protobuf:"varint,2,opt,name=outgoing_timelock,json=outgoingTimelock,proto3" json:"outgoing_timelock,omitempty"`
+	/// The amount of the incoming htlc.
+	IncomingAmtMsat uint64 `protobuf:"varint,3,opt,name=incoming_amt_msat,json=incomingAmtMsat,proto3" json:"incoming_amt_msat,omitempty"`
+	/// The amount of the outgoing htlc.
+	OutgoingAmtMsat      uint64   `protobuf:"varint,4,opt,name=outgoing_amt_msat,json=outgoingAmtMsat,proto3" json:"outgoing_amt_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HtlcInfo) Reset()         { *m = HtlcInfo{} }
+func (m *HtlcInfo) String() string { return proto.CompactTextString(m) }
+func (*HtlcInfo) ProtoMessage()    {}
+func (*HtlcInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{19}
+}
+
+func (m *HtlcInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcInfo.Unmarshal(m, b)
+}
+func (m *HtlcInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcInfo.Marshal(b, m, deterministic)
+}
+func (m *HtlcInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcInfo.Merge(m, src)
+}
+func (m *HtlcInfo) XXX_Size() int {
+	return xxx_messageInfo_HtlcInfo.Size(m)
+}
+func (m *HtlcInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcInfo proto.InternalMessageInfo
+
+func (m *HtlcInfo) GetIncomingTimelock() uint32 {
+	if m != nil {
+		return m.IncomingTimelock
+	}
+	return 0
+}
+
+func (m *HtlcInfo) GetOutgoingTimelock() uint32 {
+	if m != nil {
+		return m.OutgoingTimelock
+	}
+	return 0
+}
+
+func (m *HtlcInfo) GetIncomingAmtMsat() uint64 {
+	if m != nil {
+		return m.IncomingAmtMsat
+	}
+	return 0
+}
+
+func (m *HtlcInfo) GetOutgoingAmtMsat() uint64 {
+	if m != nil {
+		return m.OutgoingAmtMsat
+	}
+	return 0
+}
+
+type ForwardEvent struct {
+	/// Info contains details about the htlc that was forwarded.
+	Info                 *HtlcInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ForwardEvent) Reset()         { *m = ForwardEvent{} }
+func (m *ForwardEvent) String() string { return proto.CompactTextString(m) }
+func (*ForwardEvent) ProtoMessage()    {}
+func (*ForwardEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{20}
+}
+
+func (m *ForwardEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardEvent.Unmarshal(m, b)
+}
+func (m *ForwardEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardEvent.Marshal(b, m, deterministic)
+}
+func (m *ForwardEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardEvent.Merge(m, src)
+}
+func (m *ForwardEvent) XXX_Size() int {
+	return xxx_messageInfo_ForwardEvent.Size(m)
+}
+func (m *ForwardEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardEvent proto.InternalMessageInfo
+
+func (m *ForwardEvent) GetInfo() *HtlcInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type ForwardFailEvent struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForwardFailEvent) Reset()         { *m = ForwardFailEvent{} }
+func (m *ForwardFailEvent) String() string { return proto.CompactTextString(m) }
+func (*ForwardFailEvent) ProtoMessage()    {}
+func (*ForwardFailEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{21}
+}
+
+func (m *ForwardFailEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardFailEvent.Unmarshal(m, b)
+}
+func (m *ForwardFailEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardFailEvent.Marshal(b, m, deterministic)
+}
+func (m *ForwardFailEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardFailEvent.Merge(m, src)
+}
+func (m *ForwardFailEvent) XXX_Size() int {
+	return xxx_messageInfo_ForwardFailEvent.Size(m)
+}
+func (m *ForwardFailEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardFailEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardFailEvent proto.InternalMessageInfo
+
+type SettleEvent struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SettleEvent) Reset()         { *m = SettleEvent{} }
+func (m *SettleEvent) String() string { return proto.CompactTextString(m) }
+func (*SettleEvent) ProtoMessage()    {}
+func (*SettleEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{22}
+}
+
+func (m *SettleEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettleEvent.Unmarshal(m, b)
+}
+func (m *SettleEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettleEvent.Marshal(b, m, deterministic)
+}
+func (m *SettleEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleEvent.Merge(m, src)
+}
+func (m *SettleEvent) XXX_Size() int {
+	return xxx_messageInfo_SettleEvent.Size(m)
+}
+func (m *SettleEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettleEvent proto.InternalMessageInfo
+
+type LinkFailEvent struct {
+	/// Info contains details about the htlc that we failed.
+	Info *HtlcInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	/// FailureCode is the BOLT error code for the failure.
+	WireFailure Failure_FailureCode `protobuf:"varint,2,opt,name=wire_failure,json=wireFailure,proto3,enum=routerrpc.Failure_FailureCode" json:"wire_failure,omitempty"`
+	//*
+	//A human readable description of the reason the htlc was failed.
+	FailureString string `protobuf:"bytes,3,opt,name=failure_string,json=failureString,proto3" json:"failure_string,omitempty"`
+	//*
+	//Incoming is true if the htlc was failed on our incoming channel, and false
+	//if we were unable to add it to our outgoing channel.
+	Incoming             bool     `protobuf:"varint,4,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LinkFailEvent) Reset()         { *m = LinkFailEvent{} }
+func (m *LinkFailEvent) String() string { return proto.CompactTextString(m) }
+func (*LinkFailEvent) ProtoMessage()    {}
+func (*LinkFailEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{23}
+}
+
+func (m *LinkFailEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LinkFailEvent.Unmarshal(m, b)
+}
+func (m *LinkFailEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LinkFailEvent.Marshal(b, m, deterministic)
+}
+func (m *LinkFailEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkFailEvent.Merge(m, src)
+}
+func (m *LinkFailEvent) XXX_Size() int {
+	return xxx_messageInfo_LinkFailEvent.Size(m)
+}
+func (m *LinkFailEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkFailEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkFailEvent proto.InternalMessageInfo
+
+func (m *LinkFailEvent) GetInfo() *HtlcInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *LinkFailEvent) GetWireFailure() Failure_FailureCode {
+	if m != nil {
+		return m.WireFailure
+	}
+	return Failure_RESERVED
+}
+
+func (m *LinkFailEvent) GetFailureString() string {
+	if m != nil {
+		return m.FailureString
+	}
+	return ""
+}
+
+func (m *LinkFailEvent) GetIncoming() bool {
+	if m != nil {
+		return m.Incoming
+	}
+	return false
+}
+
+type CircuitKey struct {
+	/// The id of the channel that the htlc arrived on.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	/// The index of the htlc in the incoming channel.
+	HtlcId               uint64   `protobuf:"varint,2,opt,name=htlc_id,json=htlcId,proto3" json:"htlc_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CircuitKey) Reset()         { *m = CircuitKey{} }
+func (m *CircuitKey) String() string { return proto.CompactTextString(m) }
+func (*CircuitKey) ProtoMessage()    {}
+func (*CircuitKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{24}
+}
+
+func (m *CircuitKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CircuitKey.Unmarshal(m, b)
+}
+func (m *CircuitKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CircuitKey.Marshal(b, m, deterministic)
+}
+func (m *CircuitKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitKey.Merge(m, src)
+}
+func (m *CircuitKey) XXX_Size() int {
+	return xxx_messageInfo_CircuitKey.Size(m)
+}
+func (m *CircuitKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitKey proto.InternalMessageInfo
+
+func (m *CircuitKey) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *CircuitKey) GetHtlcId() uint64 {
+	if m != nil {
+		return m.HtlcId
+	}
+	return 0
+}
+
+type ForwardHtlcInterceptRequest struct {
+	//*
+	//The key of this forwarded htlc. It defines the incoming channel id and
+	//the index in this channel.
+	IncomingCircuitKey *CircuitKey `protobuf:"bytes,1,opt,name=incoming_circuit_key,json=incomingCircuitKey,proto3" json:"incoming_circuit_key,omitempty"`
+	/// The htlc payment hash.
+	PaymentHash []byte `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	/// The incoming htlc amount in msat.
+	IncomingAmountMsat uint64 `protobuf:"varint,3,opt,name=incoming_amount_msat,json=incomingAmountMsat,proto3" json:"incoming_amount_msat,omitempty"`
+	/// The incoming htlc expiry height.
+	IncomingExpiry uint32 `protobuf:"varint,4,opt,name=incoming_expiry,json=incomingExpiry,proto3" json:"incoming_expiry,omitempty"`
+	//*
+	//The requested outgoing channel id for this forwarded htlc. Because of
+	//non-strict forwarding, this isn't necessarily the channel over which the
+	//htlc will eventually be forwarded. A different channel to the same peer
+	//may be selected as well.
+	OutgoingRequestedChanId uint64 `protobuf:"varint,5,opt,name=outgoing_requested_chan_id,json=outgoingRequestedChanId,proto3" json:"outgoing_requested_chan_id,omitempty"`
+	/// The outgoing htlc amount in msat.
+	OutgoingAmountMsat uint64 `protobuf:"varint,6,opt,name=outgoing_amount_msat,json=outgoingAmountMsat,proto3" json:"outgoing_amount_msat,omitempty"`
+	/// The outgoing htlc expiry height.
+	OutgoingExpiry       uint32   `protobuf:"varint,7,opt,name=outgoing_expiry,json=outgoingExpiry,proto3" json:"outgoing_expiry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForwardHtlcInterceptRequest) Reset()         { *m = ForwardHtlcInterceptRequest{} }
+func (m *ForwardHtlcInterceptRequest) String() string { return proto.CompactTextString(m) }
+func (*ForwardHtlcInterceptRequest) ProtoMessage()    {}
+func (*ForwardHtlcInterceptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{25}
+}
+
+func (m *ForwardHtlcInterceptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardHtlcInterceptRequest.Unmarshal(m, b)
+}
+func (m *ForwardHtlcInterceptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardHtlcInterceptRequest.Marshal(b, m, deterministic)
+}
+func (m *ForwardHtlcInterceptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardHtlcInterceptRequest.Merge(m, src)
+}
+func (m *ForwardHtlcInterceptRequest) XXX_Size() int {
+	return xxx_messageInfo_ForwardHtlcInterceptRequest.Size(m)
+}
+func (m *ForwardHtlcInterceptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardHtlcInterceptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardHtlcInterceptRequest proto.InternalMessageInfo
+
+func (m *ForwardHtlcInterceptRequest) GetIncomingCircuitKey() *CircuitKey {
+	if m != nil {
+		return m.IncomingCircuitKey
+	}
+	return nil
+}
+
+func (m *ForwardHtlcInterceptRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *ForwardHtlcInterceptRequest) GetIncomingAmountMsat() uint64 {
+	if m != nil {
+		return m.IncomingAmountMsat
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetIncomingExpiry() uint32 {
+	if m != nil {
+		return m.IncomingExpiry
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetOutgoingRequestedChanId() uint64 {
+	if m != nil {
+		return m.OutgoingRequestedChanId
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetOutgoingAmountMsat() uint64 {
+	if m != nil {
+		return m.OutgoingAmountMsat
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetOutgoingExpiry() uint32 {
+	if m != nil {
+		return m.OutgoingExpiry
+	}
+	return 0
+}
+
+//*
+//ForwardHtlcInterceptResponse resolves a previously held forward. The caller
+//can choose to either:
+//- `RESUME`: Forward the htlc as if it was never intercepted.
+//- `FAIL`: Fail the htlc back to the sender.
+//- `SETTLE`: Settle the htlc with the given preimage.
+type ForwardHtlcInterceptResponse struct {
+	//*
+	//The key of the held htlc. It defines the incoming channel id and the
+	//index in this channel.
+	IncomingCircuitKey *CircuitKey `protobuf:"bytes,1,opt,name=incoming_circuit_key,json=incomingCircuitKey,proto3" json:"incoming_circuit_key,omitempty"`
+	/// The action that should be taken for the held htlc.
+	Action ResolveHoldForwardAction `protobuf:"varint,2,opt,name=action,proto3,enum=routerrpc.ResolveHoldForwardAction" json:"action,omitempty"`
+	/// The preimage to settle the htlc with, if the action is SETTLE.
+	Preimage             []byte   `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForwardHtlcInterceptResponse) Reset()         { *m = ForwardHtlcInterceptResponse{} }
+func (m *ForwardHtlcInterceptResponse) String() string { return proto.CompactTextString(m) }
+func (*ForwardHtlcInterceptResponse) ProtoMessage()    {}
+func (*ForwardHtlcInterceptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{26}
+}
+
+func (m *ForwardHtlcInterceptResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardHtlcInterceptResponse.Unmarshal(m, b)
+}
+func (m *ForwardHtlcInterceptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardHtlcInterceptResponse.Marshal(b, m, deterministic)
+}
+func (m *ForwardHtlcInterceptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardHtlcInterceptResponse.Merge(m, src)
+}
+func (m *ForwardHtlcInterceptResponse) XXX_Size() int {
+	return xxx_messageInfo_ForwardHtlcInterceptResponse.Size(m)
+}
+func (m *ForwardHtlcInterceptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardHtlcInterceptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardHtlcInterceptResponse proto.InternalMessageInfo
+
+func (m *ForwardHtlcInterceptResponse) GetIncomingCircuitKey() *CircuitKey {
+	if m != nil {
+		return m.IncomingCircuitKey
+	}
+	return nil
+}
+
+func (m *ForwardHtlcInterceptResponse) GetAction() ResolveHoldForwardAction {
+	if m != nil {
+		return m.Action
+	}
+	return ResolveHoldForwardAction_SETTLE
+}
+
+func (m *ForwardHtlcInterceptResponse) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("routerrpc.PaymentState", PaymentState_name, PaymentState_value)
+	proto.RegisterEnum("routerrpc.ResolveHoldForwardAction", ResolveHoldForwardAction_name, ResolveHoldForwardAction_value)
 	proto.RegisterEnum("routerrpc.Failure_FailureCode", Failure_FailureCode_name, Failure_FailureCode_value)
+	proto.RegisterEnum("routerrpc.HtlcEvent_EventType", HtlcEvent_EventType_name, HtlcEvent_EventType_value)
 	proto.RegisterType((*SendPaymentRequest)(nil), "routerrpc.SendPaymentRequest")
-	proto.RegisterMapType((map[uint64][]byte)(nil), "routerrpc.SendPaymentRequest.DestTlvEntry")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "routerrpc.SendPaymentRequest.DestCustomRecordsEntry")
 	proto.RegisterType((*TrackPaymentRequest)(nil), "routerrpc.TrackPaymentRequest")
 	proto.RegisterType((*PaymentStatus)(nil), "routerrpc.PaymentStatus")
 	proto.RegisterType((*RouteFeeRequest)(nil), "routerrpc.RouteFeeRequest")
@@ -1338,129 +2124,189 @@ func init() {
 	proto.RegisterType((*PairHistory)(nil), "routerrpc.PairHistory")
 	proto.RegisterType((*BuildRouteRequest)(nil), "routerrpc.BuildRouteRequest")
 	proto.RegisterType((*BuildRouteResponse)(nil), "routerrpc.BuildRouteResponse")
+	proto.RegisterType((*SubscribeHtlcEventsRequest)(nil), "routerrpc.SubscribeHtlcEventsRequest")
+	proto.RegisterType((*HtlcEvent)(nil), "routerrpc.HtlcEvent")
+	proto.RegisterType((*HtlcInfo)(nil), "routerrpc.HtlcInfo")
+	proto.RegisterType((*ForwardEvent)(nil), "routerrpc.ForwardEvent")
+	proto.RegisterType((*ForwardFailEvent)(nil), "routerrpc.ForwardFailEvent")
+	proto.RegisterType((*SettleEvent)(nil), "routerrpc.SettleEvent")
+	proto.RegisterType((*LinkFailEvent)(nil), "routerrpc.LinkFailEvent")
+	proto.RegisterType((*CircuitKey)(nil), "routerrpc.CircuitKey")
+	proto.RegisterType((*ForwardHtlcInterceptRequest)(nil), "routerrpc.ForwardHtlcInterceptRequest")
+	proto.RegisterType((*ForwardHtlcInterceptResponse)(nil), "routerrpc.ForwardHtlcInterceptResponse")
 }
 
 func init() { proto.RegisterFile("routerrpc/router.proto", fileDescriptor_7a0613f69d37b0a5) }
 
 var fileDescriptor_7a0613f69d37b0a5 = []byte{
-	// 1867 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0x4f, 0x73, 0x22, 0xc7,
-	0x15, 0x37, 0x02, 0x04, 0x3c, 0x40, 0x1a, 0xb5, 0xb4, 0xd2, 0x2c, 0x92, 0xbc, 0x32, 0x76, 0xd6,
-	0xaa, 0x2d, 0x47, 0x72, 0x94, 0xb2, 0x6b, 0xcb, 0x87, 0xa4, 0x58, 0x68, 0xac, 0xd9, 0x85, 0x19,
-	0xb9, 0x81, 0xb5, 0x37, 0x39, 0x74, 0xb5, 0xa0, 0x25, 0xa6, 0x34, 0xcc, 0xe0, 0x99, 0x46, 0x59,
-	0xe5, 0x90, 0x4b, 0xce, 0xf9, 0x14, 0xb9, 0xa6, 0x2a, 0x5f, 0x25, 0x9f, 0x22, 0xf9, 0x04, 0x7b,
-	0x4c, 0x55, 0xaa, 0xbb, 0x67, 0x60, 0x90, 0xd0, 0xc6, 0x27, 0x4d, 0xff, 0xde, 0xeb, 0xd7, 0xaf,
-	0xdf, 0x9f, 0x5f, 0x3f, 0x01, 0xbb, 0x61, 0x30, 0x13, 0x3c, 0x0c, 0xa7, 0xc3, 0x53, 0xfd, 0x75,
-	0x32, 0x0d, 0x03, 0x11, 0xa0, 0xd2, 0x1c, 0xaf, 0x95, 0xc2, 0xe9, 0x50, 0xa3, 0xf5, 0xff, 0x66,
-	0x01, 0xf5, 0xb8, 0x3f, 0xba, 0x60, 0x77, 0x13, 0xee, 0x0b, 0xc2, 0x7f, 0x9e, 0xf1, 0x48, 0x20,
-	0x04, 0xb9, 0x11, 0x8f, 0x84, 0x99, 0x39, 0xca, 0x1c, 0x57, 0x88, 0xfa, 0x46, 0x06, 0x64, 0xd9,
-	0x44, 0x98, 0x6b, 0x47, 0x99, 0xe3, 0x2c, 0x91, 0x9f, 0xe8, 0x33, 0xa8, 0x4c, 0xf5, 0x3e, 0x3a,
-	0x66, 0xd1, 0xd8, 0xcc, 0x2a, 0xed, 0x72, 0x8c, 0x9d, 0xb3, 0x68, 0x8c, 0x8e, 0xc1, 0xb8, 0x72,
-	0x7d, 0xe6, 0xd1, 0xa1, 0x27, 0x6e, 0xe9, 0x88, 0x7b, 0x82, 0x99, 0xb9, 0xa3, 0xcc, 0x71, 0x9e,
-	0x6c, 0x28, 0xbc, 0xe9, 0x89, 0xdb, 0x96, 0x44, 0xd1, 0x97, 0xb0, 0x99, 0x18, 0x0b, 0xb5, 0x17,
-	0x66, 0xfe, 0x28, 0x73, 0x5c, 0x22, 0x1b, 0xd3, 0x65, 0xdf, 0xbe, 0x84, 0x4d, 0xe1, 0x4e, 0x78,
-	0x30, 0x13, 0x34, 0xe2, 0xc3, 0xc0, 0x1f, 0x45, 0xe6, 0xba, 0xb6, 0x18, 0xc3, 0x3d, 0x8d, 0xa2,
-	0x3a, 0x54, 0xaf, 0x38, 0xa7, 0x9e, 0x3b, 0x71, 0x05, 0x8d, 0x98, 0x30, 0x0b, 0xca, 0xf5, 0xf2,
-	0x15, 0xe7, 0x1d, 0x89, 0xf5, 0x98, 0x90, 0xfe, 0x05, 0x33, 0x71, 0x1d, 0xb8, 0xfe, 0x35, 0x1d,
-	0x8e, 0x99, 0x4f, 0xdd, 0x91, 0x59, 0x3c, 0xca, 0x1c, 0xe7, 0xc8, 0x46, 0x82, 0x37, 0xc7, 0xcc,
-	0xb7, 0x46, 0xe8, 0x10, 0x40, 0xdd, 0x41, 0x99, 0x33, 0x4b, 0xea, 0xc4, 0x92, 0x44, 0x94, 0x2d,
-	0x74, 0x06, 0x65, 0x15, 0x60, 0x3a, 0x76, 0x7d, 0x11, 0x99, 0x70, 0x94, 0x3d, 0x2e, 0x9f, 0x19,
-	0x27, 0x9e, 0x2f, 0x63, 0x4d, 0xa4, 0xe4, 0xdc, 0xf5, 0x05, 0x49, 0x2b, 0x21, 0x0c, 0x45, 0x19,
-	0x59, 0x2a, 0xbc, 0x5b, 0xb3, 0xac, 0x36, 0xbc, 0x38, 0x99, 0x67, 0xe9, 0xe4, 0x61, 0x5a, 0x4e,
-	0x5a, 0x3c, 0x12, 0x7d, 0xef, 0x16, 0xfb, 0x22, 0xbc, 0x23, 0x85, 0x91, 0x5e, 0xd5, 0xbe, 0x83,
-	0x4a, 0x5a, 0x20, 0x13, 0x75, 0xc3, 0xef, 0x54, 0xee, 0x72, 0x44, 0x7e, 0xa2, 0x1d, 0xc8, 0xdf,
-	0x32, 0x6f, 0xc6, 0x55, 0xf2, 0x2a, 0x44, 0x2f, 0xbe, 0x5b, 0x7b, 0x99, 0xa9, 0xbf, 0x84, 0xed,
-	0x7e, 0xc8, 0x86, 0x37, 0xf7, 0xf2, 0x7f, 0x3f, 0xb3, 0x99, 0x07, 0x99, 0xad, 0xff, 0x05, 0xaa,
-	0xf1, 0xa6, 0x9e, 0x60, 0x62, 0x16, 0xa1, 0x5f, 0x43, 0x3e, 0x12, 0x4c, 0x70, 0xa5, 0xbc, 0x71,
-	0xb6, 0x97, 0xba, 0x4a, 0x4a, 0x91, 0x13, 0xad, 0x85, 0x6a, 0x50, 0x9c, 0x86, 0xdc, 0x9d, 0xb0,
-	0xeb, 0xc4, 0xad, 0xf9, 0x1a, 0xd5, 0x21, 0xaf, 0x36, 0xab, 0x8a, 0x2a, 0x9f, 0x55, 0xd2, 0x61,
-	0x24, 0x5a, 0x54, 0xff, 0x1d, 0x6c, 0xaa, 0x75, 0x9b, 0xf3, 0x8f, 0x55, 0xed, 0x1e, 0x14, 0xd8,
-	0x44, 0xa7, 0x5f, 0x57, 0xee, 0x3a, 0x9b, 0xc8, 0xcc, 0xd7, 0x47, 0x60, 0x2c, 0xf6, 0x47, 0xd3,
-	0xc0, 0x8f, 0xb8, 0xac, 0x06, 0x69, 0x5c, 0x16, 0x83, 0xac, 0x9c, 0x89, 0xdc, 0x95, 0x51, 0xbb,
-	0x36, 0x62, 0xbc, 0xcd, 0x79, 0x37, 0x62, 0x02, 0x3d, 0xd7, 0x45, 0x48, 0xbd, 0x60, 0x78, 0x23,
-	0xcb, 0x9a, 0xdd, 0xc5, 0xe6, 0xab, 0x12, 0xee, 0x04, 0xc3, 0x9b, 0x96, 0x04, 0xeb, 0x7f, 0xd4,
-	0xed, 0xd5, 0x0f, 0xb4, 0xef, 0xbf, 0x38, 0xbc, 0x8b, 0x10, 0xac, 0x3d, 0x1e, 0x02, 0x0a, 0xdb,
-	0x4b, 0xc6, 0xe3, 0x5b, 0xa4, 0x23, 0x9b, 0xb9, 0x17, 0xd9, 0xaf, 0xa0, 0x70, 0xc5, 0x5c, 0x6f,
-	0x16, 0x26, 0x86, 0x51, 0x2a, 0x4d, 0x6d, 0x2d, 0x21, 0x89, 0x4a, 0xfd, 0x43, 0x01, 0x0a, 0x31,
-	0x88, 0xce, 0x20, 0x37, 0x0c, 0x46, 0x49, 0x76, 0x3f, 0x7d, 0xb8, 0x2d, 0xf9, 0xdb, 0x0c, 0x46,
-	0x9c, 0x28, 0x5d, 0xf4, 0x7b, 0xd8, 0x90, 0x4d, 0xe5, 0x73, 0x8f, 0xce, 0xa6, 0x23, 0x36, 0x4f,
-	0xa8, 0x99, 0xda, 0xdd, 0xd4, 0x0a, 0x03, 0x25, 0x27, 0xd5, 0x61, 0x7a, 0x89, 0xf6, 0xa1, 0x34,
-	0x16, 0xde, 0x50, 0x67, 0x22, 0xa7, 0x0a, 0xba, 0x28, 0x01, 0x95, 0x83, 0x3a, 0x54, 0x03, 0xdf,
-	0x0d, 0x7c, 0x1a, 0x8d, 0x19, 0x3d, 0xfb, 0xe6, 0x5b, 0xc5, 0x17, 0x15, 0x52, 0x56, 0x60, 0x6f,
-	0xcc, 0xce, 0xbe, 0xf9, 0x16, 0x3d, 0x83, 0xb2, 0xea, 0x5a, 0xfe, 0x7e, 0xea, 0x86, 0x77, 0x8a,
-	0x28, 0xaa, 0x44, 0x35, 0x32, 0x56, 0x88, 0x6c, 0x8d, 0x2b, 0x8f, 0x5d, 0x47, 0x8a, 0x1c, 0xaa,
-	0x44, 0x2f, 0xd0, 0xd7, 0xb0, 0x13, 0xc7, 0x80, 0x46, 0xc1, 0x2c, 0x1c, 0x72, 0xea, 0xfa, 0x23,
-	0xfe, 0x5e, 0x51, 0x43, 0x95, 0xa0, 0x58, 0xd6, 0x53, 0x22, 0x4b, 0x4a, 0xd0, 0x2e, 0xac, 0x8f,
-	0xb9, 0x7b, 0x3d, 0xd6, 0xd4, 0x50, 0x25, 0xf1, 0xaa, 0xfe, 0x8f, 0x3c, 0x94, 0x53, 0x81, 0x41,
-	0x15, 0x28, 0x12, 0xdc, 0xc3, 0xe4, 0x2d, 0x6e, 0x19, 0x9f, 0xa0, 0x63, 0xf8, 0xc2, 0xb2, 0x9b,
-	0x0e, 0x21, 0xb8, 0xd9, 0xa7, 0x0e, 0xa1, 0x03, 0xfb, 0x8d, 0xed, 0xfc, 0x68, 0xd3, 0x8b, 0xc6,
-	0xbb, 0x2e, 0xb6, 0xfb, 0xb4, 0x85, 0xfb, 0x0d, 0xab, 0xd3, 0x33, 0x32, 0xe8, 0x00, 0xcc, 0x85,
-	0x66, 0x22, 0x6e, 0x74, 0x9d, 0x81, 0xdd, 0x37, 0xd6, 0xd0, 0x33, 0xd8, 0x6f, 0x5b, 0x76, 0xa3,
-	0x43, 0x17, 0x3a, 0xcd, 0x4e, 0xff, 0x2d, 0xc5, 0x3f, 0x5d, 0x58, 0xe4, 0x9d, 0x91, 0x5d, 0xa5,
-	0x70, 0xde, 0xef, 0x34, 0x13, 0x0b, 0x39, 0xf4, 0x14, 0x9e, 0x68, 0x05, 0xbd, 0x85, 0xf6, 0x1d,
-	0x87, 0xf6, 0x1c, 0xc7, 0x36, 0xf2, 0x68, 0x0b, 0xaa, 0x96, 0xfd, 0xb6, 0xd1, 0xb1, 0x5a, 0x94,
-	0xe0, 0x46, 0xa7, 0x6b, 0xac, 0xa3, 0x6d, 0xd8, 0xbc, 0xaf, 0x57, 0x90, 0x26, 0x12, 0x3d, 0xc7,
-	0xb6, 0x1c, 0x9b, 0xbe, 0xc5, 0xa4, 0x67, 0x39, 0xb6, 0x51, 0x44, 0xbb, 0x80, 0x96, 0x45, 0xe7,
-	0xdd, 0x46, 0xd3, 0x28, 0xa1, 0x27, 0xb0, 0xb5, 0x8c, 0xbf, 0xc1, 0xef, 0x0c, 0x40, 0x26, 0xec,
-	0x68, 0xc7, 0xe8, 0x2b, 0xdc, 0x71, 0x7e, 0xa4, 0x5d, 0xcb, 0xb6, 0xba, 0x83, 0xae, 0x51, 0x46,
-	0x3b, 0x60, 0xb4, 0x31, 0xa6, 0x96, 0xdd, 0x1b, 0xb4, 0xdb, 0x56, 0xd3, 0xc2, 0x76, 0xdf, 0xa8,
-	0xe8, 0x93, 0x57, 0x5d, 0xbc, 0x2a, 0x37, 0x34, 0xcf, 0x1b, 0xb6, 0x8d, 0x3b, 0xb4, 0x65, 0xf5,
-	0x1a, 0xaf, 0x3a, 0xb8, 0x65, 0x6c, 0xa0, 0x43, 0x78, 0xda, 0xc7, 0xdd, 0x0b, 0x87, 0x34, 0xc8,
-	0x3b, 0x9a, 0xc8, 0xdb, 0x0d, 0xab, 0x33, 0x20, 0xd8, 0xd8, 0x44, 0x9f, 0xc1, 0x21, 0xc1, 0x3f,
-	0x0c, 0x2c, 0x82, 0x5b, 0xd4, 0x76, 0x5a, 0x98, 0xb6, 0x71, 0xa3, 0x3f, 0x20, 0x98, 0x76, 0xad,
-	0x5e, 0xcf, 0xb2, 0xbf, 0x37, 0x0c, 0xf4, 0x05, 0x1c, 0xcd, 0x55, 0xe6, 0x06, 0xee, 0x69, 0x6d,
-	0xc9, 0xfb, 0x25, 0x29, 0xb5, 0xf1, 0x4f, 0x7d, 0x7a, 0x81, 0x31, 0x31, 0x10, 0xaa, 0xc1, 0xee,
-	0xe2, 0x78, 0x7d, 0x40, 0x7c, 0xf6, 0xb6, 0x94, 0x5d, 0x60, 0xd2, 0x6d, 0xd8, 0x32, 0xc1, 0x4b,
-	0xb2, 0x1d, 0xe9, 0xf6, 0x42, 0x76, 0xdf, 0xed, 0x27, 0x08, 0xc1, 0x46, 0x2a, 0x2b, 0xed, 0x06,
-	0x31, 0x76, 0xd1, 0x0e, 0x6c, 0x26, 0x1e, 0x24, 0x8a, 0xff, 0x2e, 0xa0, 0x3d, 0x40, 0x03, 0x9b,
-	0xe0, 0x46, 0x4b, 0x06, 0x64, 0x2e, 0xf8, 0x4f, 0xe1, 0x75, 0xae, 0xb8, 0x66, 0x64, 0xeb, 0xff,
-	0xcc, 0x42, 0x75, 0xa9, 0x2f, 0xd1, 0x01, 0x94, 0x22, 0xf7, 0xda, 0x67, 0x42, 0x32, 0x87, 0x26,
-	0x95, 0x05, 0xa0, 0xde, 0xc6, 0x31, 0x73, 0x7d, 0xcd, 0x66, 0x9a, 0xcd, 0x4b, 0x0a, 0x51, 0x5c,
-	0xb6, 0x07, 0x85, 0xe4, 0x6d, 0xcd, 0xaa, 0x1e, 0x5e, 0x1f, 0xea, 0x37, 0xf5, 0x00, 0x4a, 0x92,
-	0x2e, 0x23, 0xc1, 0x26, 0x53, 0xd5, 0xde, 0x55, 0xb2, 0x00, 0xd0, 0xe7, 0x50, 0x9d, 0xf0, 0x28,
-	0x62, 0xd7, 0x9c, 0xea, 0x16, 0x05, 0xa5, 0x51, 0x89, 0xc1, 0xb6, 0xea, 0xd4, 0xcf, 0x21, 0xa1,
-	0x8c, 0x58, 0x29, 0xaf, 0x95, 0x62, 0x50, 0x2b, 0xdd, 0x67, 0x6b, 0xc1, 0x62, 0x26, 0x48, 0xb3,
-	0xb5, 0x60, 0xe8, 0x05, 0x6c, 0x69, 0xba, 0x71, 0x7d, 0x77, 0x32, 0x9b, 0x68, 0xda, 0x29, 0x28,
-	0x97, 0x37, 0x15, 0xed, 0x68, 0x5c, 0xb1, 0xcf, 0x53, 0x28, 0x5e, 0xb2, 0x88, 0xcb, 0x87, 0x22,
-	0xa6, 0x85, 0x82, 0x5c, 0xb7, 0x39, 0x97, 0x22, 0xf9, 0x7c, 0x84, 0x92, 0xf0, 0x34, 0x1b, 0x14,
-	0xae, 0x38, 0x27, 0x32, 0x8e, 0xf3, 0x13, 0xd8, 0xfb, 0xc5, 0x09, 0xe5, 0xd4, 0x09, 0x1a, 0x57,
-	0x27, 0xbc, 0x80, 0x2d, 0xfe, 0x5e, 0x84, 0x8c, 0x06, 0x53, 0xf6, 0xf3, 0x8c, 0xd3, 0x11, 0x13,
-	0xcc, 0xac, 0xa8, 0xe0, 0x6e, 0x2a, 0x81, 0xa3, 0xf0, 0x16, 0x13, 0xac, 0x7e, 0x00, 0x35, 0xc2,
-	0x23, 0x2e, 0xba, 0x6e, 0x14, 0xb9, 0x81, 0xdf, 0x0c, 0x7c, 0x11, 0x06, 0x5e, 0xfc, 0xde, 0xd4,
-	0x0f, 0x61, 0x7f, 0xa5, 0x54, 0x3f, 0x18, 0x72, 0xf3, 0x0f, 0x33, 0x1e, 0xde, 0xad, 0xde, 0x7c,
-	0x07, 0xfb, 0x2b, 0xa5, 0xf1, 0x6b, 0xf3, 0x15, 0xe4, 0xfd, 0x60, 0xc4, 0x23, 0x33, 0xa3, 0x26,
-	0x98, 0xdd, 0x14, 0xb5, 0xdb, 0xc1, 0x88, 0x9f, 0xbb, 0x91, 0x08, 0xc2, 0x3b, 0xa2, 0x95, 0xa4,
-	0xf6, 0x94, 0xb9, 0x61, 0x64, 0xae, 0x3d, 0xd0, 0xbe, 0x60, 0x6e, 0x38, 0xd7, 0x56, 0x4a, 0xf5,
-	0xbf, 0x66, 0xa0, 0x9c, 0x32, 0x22, 0x49, 0x76, 0x3a, 0xbb, 0x4c, 0x86, 0x9b, 0x0a, 0x89, 0x57,
-	0xe8, 0x39, 0x6c, 0x78, 0x2c, 0x12, 0x54, 0xf2, 0x32, 0x95, 0x29, 0x8d, 0x1f, 0xe3, 0x7b, 0x28,
-	0x3a, 0x01, 0x14, 0x88, 0x31, 0x0f, 0x69, 0x34, 0x1b, 0x0e, 0x79, 0x14, 0xd1, 0x69, 0x18, 0x5c,
-	0xaa, 0x9a, 0x5c, 0x23, 0x2b, 0x24, 0xaf, 0x73, 0xc5, 0x9c, 0x91, 0xaf, 0x7f, 0xc8, 0x40, 0x39,
-	0xe5, 0x9c, 0xac, 0x5a, 0x79, 0x19, 0x7a, 0x15, 0x06, 0x93, 0xa4, 0x17, 0xe6, 0x00, 0x32, 0xa1,
-	0xa0, 0x16, 0x22, 0x88, 0x1b, 0x21, 0x59, 0x2e, 0x57, 0x7b, 0x56, 0x39, 0x98, 0xaa, 0xf6, 0x33,
-	0xd8, 0x99, 0xb8, 0x3e, 0x9d, 0x72, 0x9f, 0x79, 0xee, 0x9f, 0x39, 0x4d, 0xa6, 0x96, 0x9c, 0x52,
-	0x5c, 0x29, 0x43, 0x75, 0xa8, 0x2c, 0xdd, 0x24, 0xaf, 0x6e, 0xb2, 0x84, 0xa1, 0x97, 0xb0, 0xa7,
-	0xa2, 0xc0, 0x84, 0xe0, 0x93, 0xa9, 0x48, 0x2e, 0x78, 0x35, 0xf3, 0x54, 0x0f, 0x14, 0xc9, 0x63,
-	0xe2, 0xfa, 0xdf, 0x33, 0xb0, 0xf5, 0x6a, 0xe6, 0x7a, 0xa3, 0xa5, 0xd9, 0xe5, 0x29, 0x14, 0xe5,
-	0xf1, 0xa9, 0xd9, 0x48, 0x0e, 0x58, 0xaa, 0x60, 0x57, 0x0d, 0xfb, 0x6b, 0x2b, 0x87, 0xfd, 0x55,
-	0x63, 0x77, 0x76, 0xe5, 0xd8, 0xfd, 0x0c, 0xca, 0xe3, 0x60, 0x4a, 0x75, 0xa2, 0x23, 0x33, 0x77,
-	0x94, 0x3d, 0xae, 0x10, 0x18, 0x07, 0xd3, 0x0b, 0x8d, 0xd4, 0x5f, 0x02, 0x4a, 0x3b, 0x19, 0x57,
-	0xe5, 0x7c, 0x7c, 0xca, 0x3c, 0x3a, 0x3e, 0xbd, 0xf8, 0x5b, 0x06, 0x2a, 0xe9, 0xc9, 0x14, 0x55,
-	0xa1, 0x64, 0xd9, 0xb4, 0xdd, 0xb1, 0xbe, 0x3f, 0xef, 0x1b, 0x9f, 0xc8, 0x65, 0x6f, 0xd0, 0x6c,
-	0x62, 0xdc, 0xc2, 0x2d, 0x23, 0x23, 0xd9, 0x55, 0x12, 0x25, 0x6e, 0xd1, 0xbe, 0xd5, 0xc5, 0xce,
-	0x40, 0xbe, 0xbb, 0xdb, 0xb0, 0x19, 0x63, 0xb6, 0x43, 0x89, 0x33, 0xe8, 0x63, 0x23, 0x8b, 0x0c,
-	0xa8, 0xc4, 0x20, 0x26, 0xc4, 0x21, 0x46, 0x4e, 0x3e, 0x16, 0x31, 0xf2, 0xf0, 0x0d, 0x4f, 0x9e,
-	0xf8, 0xfc, 0xd9, 0xbf, 0x72, 0xb0, 0xae, 0x1c, 0x0c, 0xd1, 0x39, 0x94, 0x53, 0xe3, 0x3f, 0x3a,
-	0xfc, 0xe8, 0xbf, 0x05, 0x35, 0x73, 0xf5, 0xa8, 0x3d, 0x8b, 0xbe, 0xce, 0xa0, 0xd7, 0x50, 0x49,
-	0x0f, 0xf8, 0x28, 0x3d, 0xb8, 0xad, 0x98, 0xfc, 0x3f, 0x6a, 0xeb, 0x0d, 0x18, 0x38, 0x12, 0xee,
-	0x44, 0x0e, 0x6a, 0xf1, 0xe8, 0x8c, 0x6a, 0x29, 0xfd, 0x7b, 0xf3, 0x78, 0x6d, 0x7f, 0xa5, 0x2c,
-	0xce, 0x50, 0x47, 0x5f, 0x31, 0x1e, 0x5e, 0x1f, 0x5c, 0x71, 0x79, 0x62, 0xae, 0x7d, 0xfa, 0x98,
-	0x38, 0xb6, 0x36, 0x82, 0xed, 0x15, 0x0c, 0x87, 0x7e, 0x95, 0xf6, 0xe0, 0x51, 0x7e, 0xac, 0x3d,
-	0xff, 0x7f, 0x6a, 0x8b, 0x53, 0x56, 0x50, 0xe1, 0xd2, 0x29, 0x8f, 0x13, 0xe9, 0xd2, 0x29, 0x1f,
-	0x63, 0x54, 0x0b, 0x60, 0x51, 0xd1, 0xe8, 0x20, 0xb5, 0xeb, 0x41, 0x37, 0xd6, 0x0e, 0x1f, 0x91,
-	0x6a, 0x53, 0xaf, 0x7e, 0xf3, 0x87, 0xd3, 0x6b, 0x57, 0x8c, 0x67, 0x97, 0x27, 0xc3, 0x60, 0x72,
-	0xea, 0xc9, 0x89, 0xd4, 0x77, 0xfd, 0x6b, 0x9f, 0x8b, 0x3f, 0x05, 0xe1, 0xcd, 0xa9, 0xe7, 0x8f,
-	0x4e, 0x55, 0x63, 0x9c, 0xce, 0xad, 0x5c, 0xae, 0xab, 0x1f, 0x06, 0x7e, 0xfb, 0xbf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xbc, 0x7f, 0xf9, 0xc1, 0x48, 0x10, 0x00, 0x00,
+	// 2659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4f, 0x73, 0x1b, 0xc7,
+	0xb1, 0xd7, 0xe2, 0x0f, 0x01, 0x34, 0x00, 0x62, 0x39, 0xa4, 0x24, 0x08, 0xa4, 0x6c, 0x7a, 0x6d,
+	0x4b, 0x2c, 0x3d, 0x3f, 0x4a, 0xc5, 0x67, 0xfb, 0xa9, 0x9e, 0xfd, 0x9c, 0x02, 0x81, 0x85, 0x08,
+	0x0b, 0x58, 0xd0, 0x03, 0x40, 0xb6, 0x92, 0xc3, 0xd4, 0x10, 0x3b, 0x20, 0xb6, 0x04, 0xec, 0xc2,
+	0xbb, 0x03, 0x99, 0xcc, 0x21, 0x97, 0x9c, 0x93, 0x2f, 0x91, 0x6b, 0xaa, 0xf2, 0x05, 0x52, 0xa9,
+	0x7c, 0x9a, 0xa4, 0xf2, 0x01, 0x9c, 0x53, 0x0e, 0xa9, 0x99, 0xd9, 0x5d, 0x2c, 0x40, 0xd0, 0xf2,
+	0x21, 0x17, 0x09, 0xf3, 0xeb, 0xdf, 0xf4, 0xf4, 0xf4, 0xf4, 0xf4, 0x76, 0x0f, 0xe1, 0x9e, 0xef,
+	0x2d, 0x38, 0xf3, 0xfd, 0xf9, 0xe8, 0xa9, 0xfa, 0x75, 0x3c, 0xf7, 0x3d, 0xee, 0xa1, 0x42, 0x8c,
+	0xd7, 0x0a, 0xfe, 0x7c, 0xa4, 0x50, 0xe3, 0x1f, 0x59, 0x40, 0x7d, 0xe6, 0xda, 0xe7, 0xf4, 0x7a,
+	0xc6, 0x5c, 0x8e, 0xd9, 0xf7, 0x0b, 0x16, 0x70, 0x84, 0x20, 0x63, 0xb3, 0x80, 0x57, 0xb5, 0x43,
+	0xed, 0xa8, 0x84, 0xe5, 0x6f, 0xa4, 0x43, 0x9a, 0xce, 0x78, 0x35, 0x75, 0xa8, 0x1d, 0xa5, 0xb1,
+	0xf8, 0x89, 0x3e, 0x80, 0xd2, 0x5c, 0xcd, 0x23, 0x13, 0x1a, 0x4c, 0xaa, 0x69, 0xc9, 0x2e, 0x86,
+	0xd8, 0x19, 0x0d, 0x26, 0xe8, 0x08, 0xf4, 0xb1, 0xe3, 0xd2, 0x29, 0x19, 0x4d, 0xf9, 0x5b, 0x62,
+	0xb3, 0x29, 0xa7, 0xd5, 0xcc, 0xa1, 0x76, 0x94, 0xc5, 0xdb, 0x12, 0x6f, 0x4c, 0xf9, 0xdb, 0xa6,
+	0x40, 0xd1, 0x63, 0xa8, 0x44, 0xca, 0x7c, 0x65, 0x45, 0x35, 0x7b, 0xa8, 0x1d, 0x15, 0xf0, 0xf6,
+	0x7c, 0xd5, 0xb6, 0xc7, 0x50, 0xe1, 0xce, 0x8c, 0x79, 0x0b, 0x4e, 0x02, 0x36, 0xf2, 0x5c, 0x3b,
+	0xa8, 0x6e, 0x29, 0x8d, 0x21, 0xdc, 0x57, 0x28, 0x32, 0xa0, 0x3c, 0x66, 0x8c, 0x4c, 0x9d, 0x99,
+	0xc3, 0x49, 0x40, 0x79, 0x35, 0x27, 0x4d, 0x2f, 0x8e, 0x19, 0xeb, 0x08, 0xac, 0x4f, 0xb9, 0xb0,
+	0xcf, 0x5b, 0xf0, 0x4b, 0xcf, 0x71, 0x2f, 0xc9, 0x68, 0x42, 0x5d, 0xe2, 0xd8, 0xd5, 0xfc, 0xa1,
+	0x76, 0x94, 0xc1, 0xdb, 0x11, 0xde, 0x98, 0x50, 0xb7, 0x6d, 0xa3, 0x87, 0x00, 0x72, 0x0f, 0x52,
+	0x5d, 0xb5, 0x20, 0x57, 0x2c, 0x08, 0x44, 0xea, 0x42, 0x27, 0x50, 0x94, 0x0e, 0x26, 0x13, 0xc7,
+	0xe5, 0x41, 0x15, 0x0e, 0xd3, 0x47, 0xc5, 0x13, 0xfd, 0x78, 0xea, 0x0a, 0x5f, 0x63, 0x21, 0x39,
+	0x73, 0x5c, 0x8e, 0x93, 0x24, 0x64, 0xc3, 0xae, 0xf0, 0x2c, 0x19, 0x2d, 0x02, 0xee, 0xcd, 0x88,
+	0xcf, 0x46, 0x9e, 0x6f, 0x07, 0xd5, 0xa2, 0x9c, 0xfb, 0xe9, 0x71, 0x7c, 0x60, 0xc7, 0x37, 0x4f,
+	0xe8, 0xb8, 0xc9, 0x02, 0xde, 0x90, 0xf3, 0xb0, 0x9a, 0x66, 0xba, 0xdc, 0xbf, 0xc6, 0x3b, 0xf6,
+	0x3a, 0x8e, 0xf6, 0xa1, 0x30, 0xa3, 0x57, 0x64, 0x4e, 0x7d, 0x1e, 0x54, 0x4b, 0x87, 0xda, 0x51,
+	0x19, 0xe7, 0x67, 0xf4, 0xea, 0x5c, 0x8c, 0x93, 0x47, 0x48, 0x6d, 0xdb, 0xaf, 0x96, 0x57, 0x8e,
+	0xb0, 0x6e, 0xdb, 0x3e, 0x7a, 0x04, 0x95, 0x29, 0x0d, 0x38, 0x99, 0x78, 0x73, 0x32, 0x5f, 0x5c,
+	0xbc, 0x61, 0xd7, 0xd5, 0x6d, 0xc9, 0x2a, 0x0b, 0xf8, 0xcc, 0x9b, 0x9f, 0x4b, 0x10, 0x7d, 0x08,
+	0x65, 0xe7, 0xd2, 0xf5, 0x7c, 0x66, 0x13, 0xd7, 0xb3, 0x59, 0x50, 0xad, 0x1c, 0xa6, 0x8f, 0x4a,
+	0xb8, 0x14, 0x82, 0x96, 0xc0, 0xd0, 0xa7, 0x4b, 0xd2, 0x9c, 0x3a, 0x7e, 0x50, 0xd5, 0xe5, 0x66,
+	0x2b, 0xa1, 0xa3, 0x04, 0xe9, 0x9c, 0x3a, 0x7e, 0x3c, 0x4b, 0x0c, 0x82, 0x5a, 0x13, 0xee, 0x6d,
+	0xde, 0xaf, 0x08, 0x4a, 0x61, 0x90, 0x26, 0x8f, 0x4c, 0xfc, 0x44, 0x7b, 0x90, 0x7d, 0x4b, 0xa7,
+	0x0b, 0x26, 0x03, 0xb5, 0x84, 0xd5, 0xe0, 0xff, 0x52, 0xcf, 0x35, 0xe3, 0x39, 0xec, 0x0e, 0x7c,
+	0x3a, 0x7a, 0xb3, 0x16, 0xeb, 0xeb, 0x51, 0xac, 0xdd, 0x88, 0x62, 0xe3, 0x37, 0x50, 0x0e, 0x27,
+	0xf5, 0x39, 0xe5, 0x8b, 0x00, 0xfd, 0x37, 0x64, 0x03, 0x4e, 0x39, 0x93, 0xe4, 0xed, 0x93, 0xfb,
+	0x89, 0xb3, 0x4a, 0x10, 0x19, 0x56, 0x2c, 0x54, 0x83, 0xfc, 0xdc, 0x67, 0xce, 0x8c, 0x5e, 0x46,
+	0x66, 0xc5, 0x63, 0x64, 0x40, 0x56, 0x4e, 0x96, 0xb7, 0xa7, 0x78, 0x52, 0x4a, 0x86, 0x0c, 0x56,
+	0x22, 0xe3, 0x2b, 0xa8, 0xc8, 0x71, 0x8b, 0xb1, 0x9f, 0xba, 0xa1, 0xf7, 0x21, 0x47, 0x67, 0x2a,
+	0xd4, 0xd5, 0x2d, 0xdd, 0xa2, 0x33, 0x11, 0xe5, 0x86, 0x0d, 0xfa, 0x72, 0x7e, 0x30, 0xf7, 0xdc,
+	0x80, 0x89, 0xc8, 0x17, 0xca, 0x45, 0xe0, 0x8b, 0x5b, 0x32, 0x0b, 0xa8, 0x52, 0x96, 0xc6, 0xdb,
+	0x21, 0xde, 0x62, 0xac, 0x1b, 0x50, 0x2e, 0x02, 0x40, 0xdc, 0x2c, 0x32, 0xf5, 0x46, 0x6f, 0xc4,
+	0x15, 0xa6, 0xd7, 0xa1, 0xfa, 0xb2, 0x80, 0x3b, 0xde, 0xe8, 0x4d, 0x53, 0x80, 0xc6, 0xaf, 0x54,
+	0x2a, 0x19, 0x78, 0xca, 0xf6, 0x9f, 0xed, 0xde, 0xa5, 0x0b, 0x52, 0xb7, 0xbb, 0x80, 0xc0, 0xee,
+	0x8a, 0xf2, 0x70, 0x17, 0x49, 0xcf, 0x6a, 0x6b, 0x9e, 0xfd, 0x04, 0x72, 0x63, 0xea, 0x4c, 0x17,
+	0x7e, 0xa4, 0x18, 0x25, 0x8e, 0xa9, 0xa5, 0x24, 0x38, 0xa2, 0x18, 0x3f, 0xe6, 0x20, 0x17, 0x82,
+	0xe8, 0x04, 0x32, 0x23, 0xcf, 0x8e, 0x4e, 0xf7, 0xbd, 0x9b, 0xd3, 0xa2, 0xff, 0x1b, 0x9e, 0xcd,
+	0xb0, 0xe4, 0xa2, 0x5f, 0xc0, 0xb6, 0x48, 0x20, 0x2e, 0x9b, 0x92, 0xc5, 0xdc, 0xa6, 0xf1, 0x81,
+	0x56, 0x13, 0xb3, 0x1b, 0x8a, 0x30, 0x94, 0x72, 0x5c, 0x1e, 0x25, 0x87, 0xe2, 0x9e, 0x4e, 0xf8,
+	0x74, 0xa4, 0x4e, 0x22, 0x23, 0x03, 0x3a, 0x2f, 0x00, 0x79, 0x06, 0x06, 0x94, 0x3d, 0xd7, 0xf1,
+	0x5c, 0x12, 0x4c, 0x28, 0x39, 0xf9, 0xec, 0x73, 0x99, 0x1b, 0x4b, 0xb8, 0x28, 0xc1, 0xfe, 0x84,
+	0x9e, 0x7c, 0xf6, 0x39, 0x7a, 0x1f, 0x8a, 0x32, 0x43, 0xb1, 0xab, 0xb9, 0xe3, 0x5f, 0xcb, 0xa4,
+	0x58, 0xc6, 0x32, 0x69, 0x99, 0x12, 0x11, 0x57, 0x63, 0x3c, 0xa5, 0x97, 0x81, 0x4c, 0x84, 0x65,
+	0xac, 0x06, 0xe8, 0x19, 0xec, 0x85, 0x3e, 0x20, 0x81, 0xb7, 0xf0, 0x47, 0x8c, 0x38, 0xae, 0xcd,
+	0xae, 0x64, 0x1a, 0x2c, 0x63, 0x14, 0xca, 0xfa, 0x52, 0xd4, 0x16, 0x12, 0x74, 0x0f, 0xb6, 0x26,
+	0xcc, 0xb9, 0x9c, 0xa8, 0x34, 0x58, 0xc6, 0xe1, 0xc8, 0xf8, 0x63, 0x16, 0x8a, 0x09, 0xc7, 0xa0,
+	0x12, 0xe4, 0xb1, 0xd9, 0x37, 0xf1, 0x2b, 0xb3, 0xa9, 0xdf, 0x41, 0x47, 0xf0, 0x51, 0xdb, 0x6a,
+	0xf4, 0x30, 0x36, 0x1b, 0x03, 0xd2, 0xc3, 0x64, 0x68, 0xbd, 0xb4, 0x7a, 0xdf, 0x5a, 0xe4, 0xbc,
+	0xfe, 0xba, 0x6b, 0x5a, 0x03, 0xd2, 0x34, 0x07, 0xf5, 0x76, 0xa7, 0xaf, 0x6b, 0xe8, 0x00, 0xaa,
+	0x4b, 0x66, 0x24, 0xae, 0x77, 0x7b, 0x43, 0x6b, 0xa0, 0xa7, 0xd0, 0xfb, 0xb0, 0xdf, 0x6a, 0x5b,
+	0xf5, 0x0e, 0x59, 0x72, 0x1a, 0x9d, 0xc1, 0x2b, 0x62, 0x7e, 0x77, 0xde, 0xc6, 0xaf, 0xf5, 0xf4,
+	0x26, 0xc2, 0xd9, 0xa0, 0xd3, 0x88, 0x34, 0x64, 0xd0, 0x03, 0xb8, 0xab, 0x08, 0x6a, 0x0a, 0x19,
+	0xf4, 0x7a, 0xa4, 0xdf, 0xeb, 0x59, 0x7a, 0x16, 0xed, 0x40, 0xb9, 0x6d, 0xbd, 0xaa, 0x77, 0xda,
+	0x4d, 0x82, 0xcd, 0x7a, 0xa7, 0xab, 0x6f, 0xa1, 0x5d, 0xa8, 0xac, 0xf3, 0x72, 0x42, 0x45, 0xc4,
+	0xeb, 0x59, 0xed, 0x9e, 0x45, 0x5e, 0x99, 0xb8, 0xdf, 0xee, 0x59, 0x7a, 0x1e, 0xdd, 0x03, 0xb4,
+	0x2a, 0x3a, 0xeb, 0xd6, 0x1b, 0x7a, 0x01, 0xdd, 0x85, 0x9d, 0x55, 0xfc, 0xa5, 0xf9, 0x5a, 0x07,
+	0x54, 0x85, 0x3d, 0x65, 0x18, 0x39, 0x35, 0x3b, 0xbd, 0x6f, 0x49, 0xb7, 0x6d, 0xb5, 0xbb, 0xc3,
+	0xae, 0x5e, 0x44, 0x7b, 0xa0, 0xb7, 0x4c, 0x93, 0xb4, 0xad, 0xfe, 0xb0, 0xd5, 0x6a, 0x37, 0xda,
+	0xa6, 0x35, 0xd0, 0x4b, 0x6a, 0xe5, 0x4d, 0x1b, 0x2f, 0x8b, 0x09, 0x8d, 0xb3, 0xba, 0x65, 0x99,
+	0x1d, 0xd2, 0x6c, 0xf7, 0xeb, 0xa7, 0x1d, 0xb3, 0xa9, 0x6f, 0xa3, 0x87, 0xf0, 0x60, 0x60, 0x76,
+	0xcf, 0x7b, 0xb8, 0x8e, 0x5f, 0x93, 0x48, 0xde, 0xaa, 0xb7, 0x3b, 0x43, 0x6c, 0xea, 0x15, 0xf4,
+	0x01, 0x3c, 0xc4, 0xe6, 0x37, 0xc3, 0x36, 0x36, 0x9b, 0xc4, 0xea, 0x35, 0x4d, 0xd2, 0x32, 0xeb,
+	0x83, 0x21, 0x36, 0x49, 0xb7, 0xdd, 0xef, 0xb7, 0xad, 0x17, 0xba, 0x8e, 0x3e, 0x82, 0xc3, 0x98,
+	0x12, 0x2b, 0x58, 0x63, 0xed, 0x88, 0xfd, 0x45, 0x47, 0x6a, 0x99, 0xdf, 0x0d, 0xc8, 0xb9, 0x69,
+	0x62, 0x1d, 0xa1, 0x1a, 0xdc, 0x5b, 0x2e, 0xaf, 0x16, 0x08, 0xd7, 0xde, 0x15, 0xb2, 0x73, 0x13,
+	0x77, 0xeb, 0x96, 0x38, 0xe0, 0x15, 0xd9, 0x9e, 0x30, 0x7b, 0x29, 0x5b, 0x37, 0xfb, 0x2e, 0x42,
+	0xb0, 0x9d, 0x38, 0x95, 0x56, 0x1d, 0xeb, 0xf7, 0xd0, 0x1e, 0x54, 0x22, 0x0b, 0x22, 0xe2, 0xdf,
+	0x72, 0xe8, 0x3e, 0xa0, 0xa1, 0x85, 0xcd, 0x7a, 0x53, 0x38, 0x24, 0x16, 0xfc, 0x3d, 0xf7, 0x75,
+	0x26, 0x9f, 0xd2, 0xd3, 0xc6, 0x9f, 0xd2, 0x50, 0x5e, 0xb9, 0x97, 0xe8, 0x00, 0x0a, 0x81, 0x73,
+	0xe9, 0x52, 0xbe, 0xf0, 0x55, 0x0a, 0x28, 0xe1, 0x25, 0x20, 0xeb, 0x80, 0x09, 0x75, 0x5c, 0x95,
+	0xcd, 0x54, 0x36, 0x2f, 0x48, 0x44, 0xe6, 0xb2, 0xfb, 0x90, 0x8b, 0xea, 0x88, 0xb4, 0xbc, 0xc3,
+	0x5b, 0x23, 0x55, 0x3f, 0x1c, 0x40, 0x41, 0xa4, 0xcb, 0x80, 0xd3, 0xd9, 0x5c, 0x5e, 0xef, 0x32,
+	0x5e, 0x02, 0xe2, 0xe3, 0x39, 0x63, 0x41, 0x40, 0x2f, 0x19, 0x51, 0x57, 0x14, 0x24, 0xa3, 0x14,
+	0x82, 0x2d, 0x81, 0x09, 0x52, 0x94, 0x62, 0x14, 0x29, 0xab, 0x48, 0x21, 0xa8, 0x48, 0xeb, 0xd9,
+	0x9a, 0xd3, 0x30, 0x13, 0x24, 0xb3, 0x35, 0xa7, 0xe8, 0x09, 0xec, 0xa8, 0x74, 0xe3, 0xb8, 0xce,
+	0x6c, 0x31, 0x53, 0x69, 0x27, 0x27, 0x4d, 0xae, 0xc8, 0xb4, 0xa3, 0x70, 0x99, 0x7d, 0x1e, 0x40,
+	0xfe, 0x82, 0x06, 0x4c, 0x7c, 0x28, 0xc2, 0xb4, 0x90, 0x13, 0xe3, 0x16, 0x63, 0x42, 0x24, 0x3e,
+	0x1f, 0xbe, 0x48, 0x78, 0x2a, 0x1b, 0xe4, 0xc6, 0x8c, 0x61, 0xe1, 0xc7, 0x78, 0x05, 0x7a, 0xb5,
+	0x5c, 0xa1, 0x98, 0x58, 0x81, 0x5e, 0xc5, 0x2b, 0x3c, 0x81, 0x1d, 0x76, 0xc5, 0x7d, 0x4a, 0xbc,
+	0x39, 0xfd, 0x7e, 0xc1, 0x88, 0x4d, 0x39, 0x95, 0xc5, 0x4a, 0x09, 0x57, 0xa4, 0xa0, 0x27, 0xf1,
+	0x26, 0xe5, 0xd4, 0x38, 0x80, 0x1a, 0x66, 0x01, 0xe3, 0x5d, 0x27, 0x08, 0x1c, 0xcf, 0x6d, 0x78,
+	0x2e, 0xf7, 0xbd, 0x69, 0xf8, 0xbd, 0x31, 0x1e, 0xc2, 0xfe, 0x46, 0xa9, 0xfa, 0x60, 0x88, 0xc9,
+	0xdf, 0x2c, 0x98, 0x7f, 0xbd, 0x79, 0xf2, 0x35, 0xec, 0x6f, 0x94, 0xaa, 0xc9, 0xe8, 0x13, 0xc8,
+	0xaa, 0xd2, 0x46, 0x93, 0x55, 0xcb, 0xbd, 0x44, 0x6a, 0x17, 0x95, 0xcb, 0x99, 0x13, 0x70, 0xcf,
+	0xbf, 0xc6, 0x8a, 0x24, 0xd8, 0xaa, 0xc6, 0x49, 0xdd, 0x60, 0x8b, 0xb2, 0x26, 0x66, 0x4b, 0x92,
+	0xf1, 0x5b, 0x0d, 0x8a, 0x09, 0x25, 0x22, 0xc9, 0x86, 0xd5, 0x96, 0x0a, 0xc1, 0x70, 0x84, 0x1e,
+	0xc1, 0xb6, 0x2c, 0xc7, 0x44, 0x5e, 0x26, 0xe2, 0x48, 0xc3, 0x8f, 0xf1, 0x1a, 0x8a, 0x8e, 0x01,
+	0x79, 0x7c, 0xc2, 0x7c, 0x12, 0x2c, 0x46, 0x23, 0x16, 0x04, 0x64, 0xee, 0x7b, 0x17, 0x32, 0x26,
+	0x53, 0x78, 0x83, 0xe4, 0xeb, 0x4c, 0x3e, 0xa3, 0x67, 0x8d, 0x1f, 0x35, 0x28, 0x26, 0x8c, 0x13,
+	0x51, 0x2b, 0x36, 0x43, 0xc6, 0xbe, 0x37, 0x8b, 0xee, 0x42, 0x0c, 0xa0, 0x2a, 0xe4, 0xe4, 0x80,
+	0x7b, 0xe1, 0x45, 0x88, 0x86, 0xab, 0xd1, 0x9e, 0x96, 0x06, 0x2e, 0x01, 0x74, 0x02, 0x7b, 0x33,
+	0xc7, 0x25, 0x73, 0xe6, 0xd2, 0xa9, 0xf3, 0x6b, 0x46, 0xa2, 0xaa, 0x25, 0x23, 0x89, 0x1b, 0x65,
+	0xc8, 0x80, 0xd2, 0xca, 0x4e, 0xb2, 0x72, 0x27, 0x2b, 0x18, 0x7a, 0x0e, 0xf7, 0xa5, 0x17, 0x28,
+	0xe7, 0x6c, 0x36, 0xe7, 0xd1, 0x06, 0xc7, 0x8b, 0xa9, 0xbc, 0x03, 0x79, 0x7c, 0x9b, 0xd8, 0xf8,
+	0x83, 0x06, 0x3b, 0xa7, 0x0b, 0x67, 0x6a, 0xaf, 0xd4, 0x2e, 0x0f, 0x20, 0x2f, 0x96, 0x4f, 0xd4,
+	0x46, 0xa2, 0xc0, 0x92, 0x01, 0xbb, 0xa9, 0xb1, 0x49, 0x6d, 0x6c, 0x6c, 0x36, 0xb5, 0x18, 0xe9,
+	0x8d, 0x2d, 0xc6, 0xfb, 0x50, 0x5c, 0x16, 0xd9, 0x41, 0x35, 0x23, 0xeb, 0x67, 0x98, 0x44, 0x15,
+	0x76, 0x60, 0x3c, 0x07, 0x94, 0x34, 0x32, 0x8c, 0xca, 0xb8, 0x7c, 0xd2, 0x6e, 0x2f, 0x9f, 0x0e,
+	0xa0, 0xd6, 0x5f, 0x5c, 0x04, 0x23, 0xdf, 0xb9, 0x60, 0x67, 0x7c, 0x3a, 0x32, 0xdf, 0x32, 0x97,
+	0x07, 0x51, 0xd8, 0xff, 0x33, 0x03, 0x85, 0x18, 0x45, 0xc7, 0xb0, 0xeb, 0xb8, 0x23, 0x6f, 0x16,
+	0x19, 0x2c, 0xf2, 0x8d, 0x63, 0x87, 0x35, 0xf6, 0x4e, 0x24, 0x0a, 0x73, 0x66, 0xdb, 0x16, 0xfc,
+	0x95, 0x0d, 0x86, 0xfc, 0x94, 0xe2, 0x27, 0xf7, 0xa8, 0xf8, 0x47, 0xa0, 0xc7, 0xfa, 0x65, 0x82,
+	0x58, 0x3a, 0x24, 0xc2, 0x85, 0x31, 0x8a, 0x19, 0x6b, 0x8e, 0x98, 0x99, 0x55, 0xd7, 0x85, 0xcc,
+	0x0f, 0xa0, 0x14, 0x87, 0x17, 0x71, 0x55, 0x66, 0xcc, 0xe0, 0x62, 0x8c, 0x59, 0x01, 0xfa, 0x7f,
+	0x00, 0x26, 0xf6, 0x47, 0xf8, 0xf5, 0x9c, 0x55, 0xb7, 0x6e, 0x94, 0x76, 0xb1, 0x03, 0x8e, 0xe5,
+	0xbf, 0x83, 0xeb, 0x39, 0xc3, 0x05, 0x16, 0xfd, 0x44, 0x5f, 0x41, 0x79, 0xec, 0xf9, 0x3f, 0x50,
+	0xdf, 0x26, 0x12, 0x94, 0xb9, 0xb2, 0xb8, 0x52, 0xfa, 0xb7, 0x94, 0x5c, 0x4e, 0x3f, 0xbb, 0x83,
+	0x4b, 0xe3, 0xc4, 0x18, 0xbd, 0x04, 0x14, 0xcd, 0x97, 0x97, 0x54, 0x29, 0xc9, 0x4b, 0x25, 0xfb,
+	0x37, 0x95, 0x88, 0x3a, 0x2a, 0x52, 0xa4, 0x8f, 0xd7, 0x30, 0xf4, 0x05, 0x94, 0x02, 0xc6, 0xf9,
+	0x94, 0x85, 0x6a, 0x0a, 0x87, 0xda, 0x5a, 0x86, 0xe9, 0x4b, 0x71, 0xa4, 0xa1, 0x18, 0x2c, 0x87,
+	0xe8, 0x14, 0x2a, 0x53, 0xc7, 0x7d, 0x93, 0x34, 0x03, 0x6e, 0x94, 0xaa, 0x1d, 0xc7, 0x7d, 0x93,
+	0xb4, 0xa1, 0x3c, 0x4d, 0x02, 0xc6, 0x97, 0x50, 0x88, 0xbd, 0x84, 0x8a, 0x90, 0x0b, 0xbf, 0xbb,
+	0xfa, 0x1d, 0x94, 0x87, 0x4c, 0xdf, 0xb4, 0x9a, 0xba, 0x26, 0x60, 0x6c, 0x36, 0xcc, 0xf6, 0x2b,
+	0x53, 0x4f, 0x89, 0x41, 0xab, 0x87, 0xbf, 0xad, 0xe3, 0xa6, 0x9e, 0x3e, 0xcd, 0x41, 0x56, 0xae,
+	0x6b, 0xfc, 0x59, 0x83, 0xbc, 0x3c, 0x41, 0x77, 0xec, 0xa1, 0xff, 0x82, 0x38, 0xb8, 0x64, 0x0a,
+	0x13, 0x5f, 0x30, 0x19, 0x75, 0x65, 0x1c, 0x07, 0xcc, 0x20, 0xc4, 0x05, 0x39, 0x0e, 0x8d, 0x98,
+	0x9c, 0x52, 0xe4, 0x48, 0x10, 0x93, 0x9f, 0x24, 0x34, 0xc7, 0x17, 0x5a, 0x85, 0x5c, 0x25, 0x12,
+	0xd4, 0xc3, 0x8b, 0xfd, 0x24, 0xa1, 0x38, 0xe6, 0xaa, 0xa0, 0xab, 0x44, 0x82, 0x90, 0x6b, 0xfc,
+	0x2f, 0x94, 0x92, 0x67, 0x8e, 0x1e, 0x43, 0xc6, 0x71, 0xc7, 0x5e, 0x78, 0x11, 0x77, 0xd7, 0x82,
+	0x4b, 0x6c, 0x12, 0x4b, 0x82, 0x81, 0x40, 0x5f, 0x3f, 0x67, 0xa3, 0x0c, 0xc5, 0xc4, 0xa1, 0x19,
+	0x7f, 0xd1, 0xa0, 0xbc, 0x72, 0x08, 0x3f, 0x5b, 0x3b, 0xaa, 0x43, 0xe9, 0x07, 0xc7, 0x67, 0x24,
+	0xd9, 0xfd, 0xbc, 0xbb, 0x8d, 0x29, 0x8a, 0x39, 0x21, 0x80, 0x3e, 0x86, 0xed, 0xb8, 0x29, 0xe0,
+	0xbe, 0xe3, 0x5e, 0x4a, 0x77, 0x15, 0x70, 0x39, 0x44, 0xfb, 0x12, 0x14, 0xed, 0x57, 0xe4, 0x3f,
+	0xe9, 0xa3, 0x3c, 0x8e, 0xc7, 0xc6, 0x57, 0x00, 0x0d, 0xc7, 0x1f, 0x2d, 0x1c, 0xfe, 0x92, 0x5d,
+	0x27, 0xeb, 0x22, 0x6d, 0xa5, 0x2e, 0xba, 0x0f, 0xb9, 0xe8, 0x6a, 0xab, 0x8c, 0xb1, 0x35, 0x91,
+	0x57, 0xda, 0xf8, 0x57, 0x0a, 0xf6, 0x43, 0x27, 0xa9, 0xfd, 0x71, 0xe6, 0x8f, 0xd8, 0x3c, 0xee,
+	0xdb, 0x5f, 0xc0, 0xde, 0x32, 0x4d, 0xa9, 0x85, 0x48, 0xf4, 0xb9, 0x2c, 0x9e, 0xdc, 0x4d, 0xb6,
+	0x5d, 0xb1, 0x19, 0x18, 0xc5, 0xe9, 0x6b, 0x69, 0xda, 0x7a, 0x87, 0x9a, 0xba, 0xd9, 0xa1, 0x3e,
+	0x4b, 0xac, 0x45, 0x67, 0xde, 0xc2, 0x5d, 0x89, 0x21, 0xb4, 0x8c, 0x21, 0x21, 0x92, 0x61, 0xf4,
+	0x18, 0xe2, 0xc8, 0x8a, 0x1a, 0x32, 0x55, 0xf4, 0xc5, 0x39, 0x2e, 0x6c, 0xca, 0xbe, 0x80, 0x5a,
+	0x1c, 0x6f, 0xe1, 0xc3, 0x17, 0xb3, 0xe3, 0x0f, 0x85, 0xca, 0x63, 0xf7, 0x23, 0x06, 0x8e, 0x08,
+	0xe1, 0x17, 0xe3, 0x19, 0xec, 0x25, 0x82, 0x75, 0x69, 0xd7, 0x96, 0xb2, 0x6b, 0x19, 0xaf, 0x49,
+	0xbb, 0xe2, 0x19, 0xa1, 0x5d, 0xaa, 0x1b, 0x8c, 0x33, 0xaa, 0xb2, 0xcb, 0xf8, 0xab, 0x06, 0x07,
+	0x9b, 0xdd, 0x1f, 0x7e, 0x76, 0xfe, 0x63, 0xfe, 0xff, 0x02, 0xb6, 0xe8, 0x88, 0x3b, 0x9e, 0x1b,
+	0x06, 0xea, 0x87, 0x89, 0xa9, 0x98, 0x05, 0xde, 0xf4, 0x2d, 0x3b, 0xf3, 0xa6, 0x76, 0x68, 0x4c,
+	0x5d, 0x52, 0x71, 0x38, 0x65, 0xe5, 0x01, 0x20, 0xbd, 0xfa, 0x00, 0xf0, 0xe4, 0x77, 0x1a, 0x94,
+	0x92, 0xcf, 0x31, 0xa8, 0x0c, 0x85, 0xb6, 0x45, 0x5a, 0x9d, 0xf6, 0x8b, 0xb3, 0x81, 0x7e, 0x47,
+	0x0c, 0xfb, 0xc3, 0x46, 0xc3, 0x34, 0x9b, 0xa6, 0xc8, 0x57, 0x08, 0xb6, 0x45, 0x77, 0x60, 0x36,
+	0xc9, 0xa0, 0xdd, 0x35, 0x7b, 0x43, 0xd1, 0x6c, 0xee, 0x42, 0x25, 0xc4, 0xac, 0x1e, 0xc1, 0xbd,
+	0xe1, 0xc0, 0xd4, 0xd3, 0x48, 0x87, 0x52, 0x08, 0x9a, 0x18, 0xf7, 0xb0, 0x9e, 0x11, 0x1d, 0x52,
+	0x88, 0xdc, 0x6c, 0x5c, 0xa3, 0xbe, 0x36, 0xfb, 0xe4, 0x4b, 0xa8, 0xde, 0xb6, 0x1f, 0x04, 0xb0,
+	0xd5, 0x37, 0x07, 0x83, 0x8e, 0xa9, 0x52, 0xa8, 0xd0, 0xa6, 0x6b, 0x02, 0xc5, 0x66, 0x7f, 0xd8,
+	0x35, 0xf5, 0xd4, 0xc9, 0xef, 0xb7, 0x60, 0x4b, 0x7e, 0xd3, 0x7d, 0x74, 0x06, 0xc5, 0xc4, 0x93,
+	0x20, 0x7a, 0xf8, 0x93, 0x4f, 0x85, 0xb5, 0xea, 0xe6, 0xd7, 0xa9, 0x45, 0xf0, 0x4c, 0x43, 0x5f,
+	0x43, 0x29, 0xf9, 0x26, 0x86, 0x92, 0x49, 0x62, 0xc3, 0x63, 0xd9, 0x4f, 0xea, 0x7a, 0x09, 0xba,
+	0x19, 0x70, 0x67, 0x46, 0x39, 0x8b, 0x5e, 0x9b, 0x50, 0x2d, 0x79, 0x96, 0xab, 0x4f, 0x58, 0xb5,
+	0xfd, 0x8d, 0xb2, 0x30, 0xba, 0x3a, 0x50, 0x4c, 0xbc, 0xf7, 0xdc, 0xd8, 0xe2, 0xea, 0x23, 0x53,
+	0xed, 0xbd, 0xdb, 0xc4, 0xa1, 0x36, 0x1b, 0x76, 0x37, 0x34, 0x05, 0xe8, 0xe3, 0xd5, 0x48, 0xbb,
+	0xa5, 0xa5, 0xa8, 0x3d, 0x7a, 0x17, 0x6d, 0xb9, 0xca, 0x86, 0xee, 0x61, 0x65, 0x95, 0xdb, 0x7b,
+	0x8f, 0xda, 0xa3, 0x77, 0xd1, 0xc2, 0x55, 0xda, 0x00, 0xcb, 0x22, 0x10, 0x1d, 0x24, 0x66, 0xdd,
+	0x28, 0x60, 0x6b, 0x0f, 0x6f, 0x91, 0x86, 0xaa, 0x06, 0xb0, 0xbb, 0xa1, 0x2a, 0x5c, 0x31, 0xf8,
+	0xf6, 0xaa, 0xb1, 0xb6, 0xb7, 0xa9, 0x78, 0x7a, 0xa6, 0xa1, 0x31, 0x54, 0x56, 0x32, 0x86, 0xe7,
+	0xa3, 0xc7, 0x37, 0x0b, 0x9c, 0x8d, 0x49, 0xa5, 0xf6, 0xe8, 0x9d, 0x44, 0xb9, 0xf6, 0x91, 0xf6,
+	0x4c, 0x3b, 0x7d, 0xfc, 0xcb, 0x8f, 0x2f, 0x1d, 0x3e, 0x59, 0x5c, 0x1c, 0x8f, 0xbc, 0xd9, 0xd3,
+	0xd3, 0x41, 0xe3, 0xc5, 0xf9, 0xf0, 0xe9, 0xd4, 0xb5, 0x9f, 0x4e, 0xdd, 0xe5, 0x5f, 0x3f, 0xfc,
+	0xf9, 0xe8, 0x62, 0x4b, 0xfe, 0xad, 0xe3, 0x7f, 0xfe, 0x3d, 0x00, 0x84, 0x87, 0xf7, 0x6c, 0x1b,
+	0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1506,6 +2352,19 @@ type RouterClient interface {
 	//keys. It retrieves the relevant channel policies from the graph in order to
 	//calculate the correct fees and time locks.
 	BuildRoute(ctx context.Context, in *BuildRouteRequest, opts ...grpc.CallOption) (*BuildRouteResponse, error)
+	//*
+	//SubscribeHtlcEvents creates a uni-directional stream from the server to
+	//the client which delivers a stream of htlc events.
+	SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Router_SubscribeHtlcEventsClient, error)
+	//*
+	//HtlcInterceptor dispatches a bi-directional streaming RPC in which
+	//forwarded htlcs are sent to the client, and held until the client responds
+	//with the action to take for them. Htlcs that aren't resolved within a
+	//minute are failed back. Only a single interceptor can be active at a time.
+	//When the client disconnects, all htlcs that are still held are failed
+	//back. Htlcs that were held when lnd restarted are sent to the client again
+	//if it is connected by the time they are reforwarded.
+	HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Router_HtlcInterceptorClient, error)
 }
 
 type routerClient struct {
@@ -1625,6 +2484,69 @@ func (c *routerClient) BuildRoute(ctx context.Context, in *BuildRouteRequest, op
 	return out, nil
 }
 
+func (c *routerClient) SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Router_SubscribeHtlcEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Router_serviceDesc.Streams[2], "/routerrpc.Router/SubscribeHtlcEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerSubscribeHtlcEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Router_SubscribeHtlcEventsClient interface {
+	Recv() (*HtlcEvent, error)
+	grpc.ClientStream
+}
+
+type routerSubscribeHtlcEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerSubscribeHtlcEventsClient) Recv() (*HtlcEvent, error) {
+	m := new(HtlcEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *routerClient) HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Router_HtlcInterceptorClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Router_serviceDesc.Streams[3], "/routerrpc.Router/HtlcInterceptor", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerHtlcInterceptorClient{stream}
+	return x, nil
+}
+
+type Router_HtlcInterceptorClient interface {
+	Send(*ForwardHtlcInterceptResponse) error
+	Recv() (*ForwardHtlcInterceptRequest, error)
+	grpc.ClientStream
+}
+
+type routerHtlcInterceptorClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerHtlcInterceptorClient) Send(m *ForwardHtlcInterceptResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *routerHtlcInterceptorClient) Recv() (*ForwardHtlcInterceptRequest, error) {
+	m := new(ForwardHtlcInterceptRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RouterServer is the server API for Router service.
 type RouterServer interface {
 	//*
@@ -1658,6 +2580,19 @@ type RouterServer interface {
 	//keys. It retrieves the relevant channel policies from the graph in order to
 	//calculate the correct fees and time locks.
 	BuildRoute(context.Context, *BuildRouteRequest) (*BuildRouteResponse, error)
+	//*
+	//SubscribeHtlcEvents creates a uni-directional stream from the server to
+	//the client which delivers a stream of htlc events.
+	SubscribeHtlcEvents(*SubscribeHtlcEventsRequest, Router_SubscribeHtlcEventsServer) error
+	//*
+	//HtlcInterceptor dispatches a bi-directional streaming RPC in which
+	//forwarded htlcs are sent to the client, and held until the client responds
+	//with the action to take for them. Htlcs that aren't resolved within a
+	//minute are failed back. Only a single interceptor can be active at a time.
+	//When the client disconnects, all htlcs that are still held are failed
+	//back. Htlcs that were held when lnd restarted are sent to the client again
+	//if it is connected by the time they are reforwarded.
+	HtlcInterceptor(Router_HtlcInterceptorServer) error
 }
 
 func RegisterRouterServer(s *grpc.Server, srv RouterServer) {
@@ -1796,6 +2731,53 @@ func _Router_BuildRoute_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Router_SubscribeHtlcEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeHtlcEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouterServer).SubscribeHtlcEvents(m, &routerSubscribeHtlcEventsServer{stream})
+}
+
+type Router_SubscribeHtlcEventsServer interface {
+	Send(*HtlcEvent) error
+	grpc.ServerStream
+}
+
+type routerSubscribeHtlcEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerSubscribeHtlcEventsServer) Send(m *HtlcEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Router_HtlcInterceptor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RouterServer).HtlcInterceptor(&routerHtlcInterceptorServer{stream})
+}
+
+type Router_HtlcInterceptorServer interface {
+	Send(*ForwardHtlcInterceptRequest) error
+	Recv() (*ForwardHtlcInterceptResponse, error)
+	grpc.ServerStream
+}
+
+type routerHtlcInterceptorServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerHtlcInterceptorServer) Send(m *ForwardHtlcInterceptRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *routerHtlcInterceptorServer) Recv() (*ForwardHtlcInterceptResponse, error) {
+	m := new(ForwardHtlcInterceptResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Router_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "routerrpc.Router",
 	HandlerType: (*RouterServer)(nil),
@@ -1832,6 +2814,17 @@ var _Router_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Router_TrackPayment_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeHtlcEvents",
+			Handler:       _Router_SubscribeHtlcEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "HtlcInterceptor",
+			Handler:       _Router_HtlcInterceptor_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "routerrpc/router.proto",
 }
@@ -11,7 +11,9 @@ import (
 	"github.com/btgsuite/btgd/btcec"
 
 	"github.com/BTCGPU/lnd/lnrpc"
+	"github.com/BTCGPU/lnd/lntypes"
 	"github.com/BTCGPU/lnd/lnwire"
+	"github.com/BTCGPU/lnd/record"
 	"github.com/BTCGPU/lnd/routing"
 	"github.com/BTCGPU/lnd/routing/route"
 	"github.com/BTCGPU/lnd/tlv"
@@ -131,16 +133,15 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 	// Unmarshall restrictions from request.
 	feeLimit := calculateFeeLimit(in.FeeLimit, amtMSat)
 
-	ignoredNodes := make(map[route.Vertex]struct{})
-	for _, ignorePubKey := range in.IgnoredNodes {
-		ignoreVertex, err := route.NewVertexFromBytes(ignorePubKey)
-		if err != nil {
-			return nil, err
-		}
-		ignoredNodes[ignoreVertex] = struct{}{}
+	ignoredNodes, err := UnmarshallIgnoredNodes(in.IgnoredNodes)
+	if err != nil {
+		return nil, err
 	}
 
-	ignoredPairs := make(map[routing.DirectedNodePair]struct{})
+	ignoredPairs, err := UnmarshallIgnoredPairs(in.IgnoredPairs)
+	if err != nil {
+		return nil, err
+	}
 
 	// Convert deprecated ignoredEdges to pairs.
 	for _, ignoredEdge := range in.IgnoredEdges {
@@ -154,20 +155,9 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 		ignoredPairs[pair] = struct{}{}
 	}
 
-	// Add ignored pairs to set.
-	for _, ignorePair := range in.IgnoredPairs {
-		from, err := route.NewVertexFromBytes(ignorePair.From)
-		if err != nil {
-			return nil, err
-		}
-
-		to, err := route.NewVertexFromBytes(ignorePair.To)
-		if err != nil {
-			return nil, err
-		}
-
-		pair := routing.NewDirectedNodePair(from, to)
-		ignoredPairs[pair] = struct{}{}
+	lastHop, err := unmarshallLastHop(in.LastHopPubkey)
+	if err != nil {
+		return nil, err
 	}
 
 	// Since QueryRoutes allows having a different source other than
@@ -190,24 +180,24 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 	}
 	cltvLimit -= uint32(finalCLTVDelta)
 
-	var destTLV map[uint64][]byte
+	// If we have any TLV records destined for the final hop, then we'll
+	// attempt to decode them now into a form that the router can more
+	// easily manipulate. Only records in the custom type range may be
+	// specified.
+	destTLV := record.CustomSet(in.DestCustomRecords)
+	if err := destTLV.Validate(); err != nil {
+		return nil, err
+	}
+	destTlvRecords, err := tlv.MapToRecords(destTLV)
+	if err != nil {
+		return nil, err
+	}
+
 	restrictions := &routing.RestrictParams{
 		FeeLimit: feeLimit,
 		ProbabilitySource: func(fromNode, toNode route.Vertex,
 			amt lnwire.MilliSatoshi) float64 {
 
-			if _, ok := ignoredNodes[fromNode]; ok {
-				return 0
-			}
-
-			pair := routing.DirectedNodePair{
-				From: fromNode,
-				To:   toNode,
-			}
-			if _, ok := ignoredPairs[pair]; ok {
-				return 0
-			}
-
 			if !in.UseMissionControl {
 				return 1
 			}
@@ -216,16 +206,15 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 				fromNode, toNode, amt,
 			)
 		},
-		DestPayloadTLV: len(destTLV) != 0,
-		CltvLimit:      cltvLimit,
+		FinalDestRecords: destTlvRecords,
+		CltvLimit:        cltvLimit,
+		LastHop:          lastHop,
+		IgnoredNodes:     ignoredNodes,
+		IgnoredPairs:     ignoredPairs,
 	}
 
-	// If we have any TLV records destined for the final hop, then we'll
-	// attempt to decode them now into a form that the router can more
-	// easily manipulate.
-	destTlvRecords, err := tlv.MapToRecords(destTLV)
-	if err != nil {
-		return nil, err
+	if in.OutgoingChanId != 0 {
+		restrictions.OutgoingChannelID = &in.OutgoingChanId
 	}
 
 	// Query the channel router for a possible path to the destination that
@@ -301,6 +290,62 @@ func (r *RouterBackend) rpcEdgeToPair(e *lnrpc.EdgeLocator) (
 	return pair, nil
 }
 
+// UnmarshallIgnoredNodes parses the raw node public keys of a list of nodes
+// that path finding should avoid into a set of vertices.
+func UnmarshallIgnoredNodes(rpcNodes [][]byte) (
+	map[route.Vertex]struct{}, error) {
+
+	ignoredNodes := make(map[route.Vertex]struct{})
+	for _, ignorePubKey := range rpcNodes {
+		ignoreVertex, err := route.NewVertexFromBytes(ignorePubKey)
+		if err != nil {
+			return nil, err
+		}
+		ignoredNodes[ignoreVertex] = struct{}{}
+	}
+
+	return ignoredNodes, nil
+}
+
+// UnmarshallIgnoredPairs parses a list of rpc node pairs that path finding
+// should avoid into a set of directed node pairs.
+func UnmarshallIgnoredPairs(rpcPairs []*lnrpc.NodePair) (
+	map[routing.DirectedNodePair]struct{}, error) {
+
+	ignoredPairs := make(map[routing.DirectedNodePair]struct{})
+	for _, ignorePair := range rpcPairs {
+		from, err := route.NewVertexFromBytes(ignorePair.From)
+		if err != nil {
+			return nil, err
+		}
+
+		to, err := route.NewVertexFromBytes(ignorePair.To)
+		if err != nil {
+			return nil, err
+		}
+
+		pair := routing.NewDirectedNodePair(from, to)
+		ignoredPairs[pair] = struct{}{}
+	}
+
+	return ignoredPairs, nil
+}
+
+// unmarshallLastHop parses the optional raw public key of the last hop of a
+// route. Nil is returned if no last hop was specified.
+func unmarshallLastHop(rpcLastHop []byte) (*route.Vertex, error) {
+	if len(rpcLastHop) == 0 {
+		return nil, nil
+	}
+
+	lastHop, err := route.NewVertexFromBytes(rpcLastHop)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lastHop, nil
+}
+
 // calculateFeeLimit returns the fee limit in millisatoshis. If a percentage
 // based fee limit has been requested, we'll factor in the ratio provided with
 // the amount of the payment.
@@ -359,6 +404,7 @@ func (r *RouterBackend) MarshallRoute(route *route.Route) (*lnrpc.Route, error)
 				hop.PubKeyBytes[:],
 			),
 			TlvPayload: !hop.LegacyPayload,
+			MppRecord:  marshallMPP(hop.MPP),
 		}
 		incomingAmt = hop.AmtToForward
 	}
@@ -391,6 +437,11 @@ func (r *RouterBackend) UnmarshallHopByChannelLookup(hop *lnrpc.Hop,
 
 	var tlvRecords []tlv.Record
 
+	mpp, err := unmarshallMPP(hop.MppRecord)
+	if err != nil {
+		return nil, err
+	}
+
 	return &route.Hop{
 		OutgoingTimeLock: hop.Expiry,
 		AmtToForward:     lnwire.MilliSatoshi(hop.AmtToForwardMsat),
@@ -398,6 +449,7 @@ func (r *RouterBackend) UnmarshallHopByChannelLookup(hop *lnrpc.Hop,
 		ChannelID:        hop.ChanId,
 		TLVRecords:       tlvRecords,
 		LegacyPayload:    !hop.TlvPayload,
+		MPP:              mpp,
 	}, nil
 }
 
@@ -415,6 +467,11 @@ func UnmarshallKnownPubkeyHop(hop *lnrpc.Hop) (*route.Hop, error) {
 
 	var tlvRecords []tlv.Record
 
+	mpp, err := unmarshallMPP(hop.MppRecord)
+	if err != nil {
+		return nil, err
+	}
+
 	return &route.Hop{
 		OutgoingTimeLock: hop.Expiry,
 		AmtToForward:     lnwire.MilliSatoshi(hop.AmtToForwardMsat),
@@ -422,6 +479,7 @@ func UnmarshallKnownPubkeyHop(hop *lnrpc.Hop) (*route.Hop, error) {
 		ChannelID:        hop.ChanId,
 		TLVRecords:       tlvRecords,
 		LegacyPayload:    !hop.TlvPayload,
+		MPP:              mpp,
 	}, nil
 }
 
@@ -480,11 +538,34 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 
 	payIntent := &routing.LightningPayment{}
 
+	var err error
+
 	// Pass along an outgoing channel restriction if specified.
 	if rpcPayReq.OutgoingChanId != 0 {
 		payIntent.OutgoingChannelID = &rpcPayReq.OutgoingChanId
 	}
 
+	// Pass along a last hop restriction if specified.
+	payIntent.LastHop, err = unmarshallLastHop(rpcPayReq.LastHopPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the nodes and node pairs that path finding should ignore.
+	payIntent.IgnoredNodes, err = UnmarshallIgnoredNodes(
+		rpcPayReq.IgnoredNodes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	payIntent.IgnoredPairs, err = UnmarshallIgnoredPairs(
+		rpcPayReq.IgnoredPairs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Take the CLTV limit from the request if set, otherwise use the max.
 	cltvLimit, err := ValidateCLTVLimit(
 		uint32(rpcPayReq.CltvLimit), r.MaxTotalTimelock,
@@ -504,10 +585,18 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		return nil, errors.New("timeout_seconds must be specified")
 	}
 
-	var destTLV map[uint64][]byte
-	if len(destTLV) != 0 {
-		var err error
-		payIntent.FinalDestRecords, err = tlv.MapToRecords(destTLV)
+	// Take the custom records for the final hop from the request, such
+	// as the preimage of a keysend payment. Only records in the custom
+	// type range may be specified.
+	if len(rpcPayReq.DestCustomRecords) != 0 {
+		customRecords := record.CustomSet(rpcPayReq.DestCustomRecords)
+		if err := customRecords.Validate(); err != nil {
+			return nil, err
+		}
+
+		payIntent.FinalDestRecords, err = tlv.MapToRecords(
+			customRecords,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -516,6 +605,23 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	payIntent.PayAttemptTimeout = time.Second *
 		time.Duration(rpcPayReq.TimeoutSeconds)
 
+	// Take the maximum number of shards and the payment address from the
+	// request. The router makes sure that a payment address is present
+	// if the payment may be split.
+	payIntent.MaxParts = rpcPayReq.MaxParts
+
+	if len(rpcPayReq.PaymentAddr) > 0 {
+		if len(rpcPayReq.PaymentAddr) != 32 {
+			return nil, fmt.Errorf("payment_addr must be exactly "+
+				"32 bytes, is instead %v",
+				len(rpcPayReq.PaymentAddr))
+		}
+
+		var paymentAddr [32]byte
+		copy(paymentAddr[:], rpcPayReq.PaymentAddr)
+		payIntent.PaymentAddr = &paymentAddr
+	}
+
 	// Route hints.
 	routeHints, err := unmarshallRouteHints(
 		rpcPayReq.RouteHints,
@@ -589,6 +695,16 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		payIntent.RouteHints = append(
 			payIntent.RouteHints, payReq.RouteHints...,
 		)
+
+		// Use the payment address of the invoice, unless one was
+		// explicitly given in the request.
+		if payIntent.PaymentAddr == nil {
+			payIntent.PaymentAddr = payReq.PaymentAddr
+		}
+
+		// Path finding will use the features the destination
+		// advertises in its invoice.
+		payIntent.DestFeatures = payReq.Features
 	} else {
 		// Otherwise, If the payment request field was not specified
 		// (and a custom route wasn't specified), construct the payment
@@ -637,6 +753,61 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	return payIntent, nil
 }
 
+// unmarshallMPP converts an rpc MPP record into a record.MPP. If no record is
+// given, nil is returned to signal a regular single-shot payment. An error is
+// returned if only one of the fields is set or the payment address isn't 32
+// bytes.
+func unmarshallMPP(reqMPP *lnrpc.MPPRecord) (*record.MPP, error) {
+	// If no MPP record was submitted, assume the user wants to send a
+	// regular payment.
+	if reqMPP == nil {
+		return nil, nil
+	}
+
+	reqTotal := reqMPP.TotalAmtMsat
+	reqAddr := reqMPP.PaymentAddr
+
+	switch {
+
+	// No MPP fields were provided.
+	case reqTotal == 0 && len(reqAddr) == 0:
+		return nil, fmt.Errorf("missing total_msat and payment_addr")
+
+	// Total is present, but payment address is missing.
+	case reqTotal > 0 && len(reqAddr) == 0:
+		return nil, fmt.Errorf("missing payment_addr")
+
+	// Payment address is present, but total is missing.
+	case reqTotal == 0 && len(reqAddr) > 0:
+		return nil, fmt.Errorf("missing total_msat")
+	}
+
+	addr, err := lntypes.MakeHash(reqAddr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse "+
+			"payment_addr: %v", err)
+	}
+
+	total := lnwire.MilliSatoshi(reqTotal)
+
+	return record.NewMPP(total, addr), nil
+}
+
+// marshallMPP converts an MPP record into its rpc representation. A nil
+// record results in a nil rpc record.
+func marshallMPP(mpp *record.MPP) *lnrpc.MPPRecord {
+	if mpp == nil {
+		return nil
+	}
+
+	addr := mpp.PaymentAddr()
+
+	return &lnrpc.MPPRecord{
+		PaymentAddr:  addr[:],
+		TotalAmtMsat: int64(mpp.TotalMsat()),
+	}
+}
+
 // unmarshallRouteHints unmarshalls a list of route hints.
 func unmarshallRouteHints(rpcRouteHints []*lnrpc.RouteHint) (
 	[][]zpay32.HopHint, error) {
@@ -74,11 +74,14 @@ func testQueryRoutes(t *testing.T, useMissionControl bool) {
 			To:   node2[:],
 		}},
 		UseMissionControl: useMissionControl,
+		OutgoingChanId:    777,
+		LastHopPubkey:     node2[:],
+		DestCustomRecords: map[uint64][]byte{65536: {1, 2, 3}},
 	}
 
 	findRoute := func(source, target route.Vertex,
 		amt lnwire.MilliSatoshi, restrictions *routing.RestrictParams,
-		_ []tlv.Record,
+		destRecords []tlv.Record,
 		finalExpiry ...uint16) (*route.Route, error) {
 
 		if int64(amt) != request.Amt*1000 {
@@ -97,20 +100,39 @@ func testQueryRoutes(t *testing.T, useMissionControl bool) {
 			t.Fatal("unexpected fee limit")
 		}
 
-		if restrictions.ProbabilitySource(route.Vertex{2},
-			route.Vertex{1}, 0,
-		) != 0 {
-			t.Fatal("expecting 0% probability for ignored edge")
+		ignoredPair := routing.DirectedNodePair{
+			From: route.Vertex{2},
+			To:   route.Vertex{1},
+		}
+		if _, ok := restrictions.IgnoredPairs[ignoredPair]; !ok {
+			t.Fatal("expected ignored edge to be ignored")
+		}
+
+		if _, ok := restrictions.IgnoredNodes[ignoreNodeVertex]; !ok {
+			t.Fatal("expected node to be ignored")
+		}
+
+		ignoredPair = routing.NewDirectedNodePair(node1, node2)
+		if _, ok := restrictions.IgnoredPairs[ignoredPair]; !ok {
+			t.Fatal("expected pair to be ignored")
 		}
 
-		if restrictions.ProbabilitySource(ignoreNodeVertex,
-			route.Vertex{6}, 0,
-		) != 0 {
-			t.Fatal("expecting 0% probability for ignored node")
+		if restrictions.OutgoingChannelID == nil ||
+			*restrictions.OutgoingChannelID != 777 {
+
+			t.Fatal("unexpected outgoing channel id")
 		}
 
-		if restrictions.ProbabilitySource(node1, node2, 0) != 0 {
-			t.Fatal("expecting 0% probability for ignored pair")
+		if restrictions.LastHop == nil ||
+			*restrictions.LastHop != node2 {
+
+			t.Fatal("unexpected last hop")
+		}
+
+		if len(destRecords) != 1 || destRecords[0].Type() != 65536 ||
+			len(restrictions.FinalDestRecords) != 1 {
+
+			t.Fatal("unexpected dest custom records")
 		}
 
 		expectedProb := 1.0
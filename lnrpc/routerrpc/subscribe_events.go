@@ -0,0 +1,136 @@
+// +build routerrpc
+
+package routerrpc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/BTCGPU/lnd/htlcswitch"
+	"github.com/BTCGPU/lnd/lnwire"
+)
+
+// failureCodes maps the wire failure codes to their rpc counterparts.
+var failureCodes = map[lnwire.FailCode]Failure_FailureCode{
+	lnwire.CodeIncorrectOrUnknownPaymentDetails: Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS,
+	lnwire.CodeIncorrectPaymentAmount:           Failure_INCORRECT_PAYMENT_AMOUNT,
+	lnwire.CodeFinalIncorrectCltvExpiry:         Failure_FINAL_INCORRECT_CLTV_EXPIRY,
+	lnwire.CodeFinalIncorrectHtlcAmount:         Failure_FINAL_INCORRECT_HTLC_AMOUNT,
+	lnwire.CodeFinalExpiryTooSoon:               Failure_FINAL_EXPIRY_TOO_SOON,
+	lnwire.CodeInvalidRealm:                     Failure_INVALID_REALM,
+	lnwire.CodeExpiryTooSoon:                    Failure_EXPIRY_TOO_SOON,
+	lnwire.CodeInvalidOnionVersion:              Failure_INVALID_ONION_VERSION,
+	lnwire.CodeInvalidOnionHmac:                 Failure_INVALID_ONION_HMAC,
+	lnwire.CodeInvalidOnionKey:                  Failure_INVALID_ONION_KEY,
+	lnwire.CodeAmountBelowMinimum:               Failure_AMOUNT_BELOW_MINIMUM,
+	lnwire.CodeFeeInsufficient:                  Failure_FEE_INSUFFICIENT,
+	lnwire.CodeIncorrectCltvExpiry:              Failure_INCORRECT_CLTV_EXPIRY,
+	lnwire.CodeChannelDisabled:                  Failure_CHANNEL_DISABLED,
+	lnwire.CodeTemporaryChannelFailure:          Failure_TEMPORARY_CHANNEL_FAILURE,
+	lnwire.CodeRequiredNodeFeatureMissing:       Failure_REQUIRED_NODE_FEATURE_MISSING,
+	lnwire.CodeRequiredChannelFeatureMissing:    Failure_REQUIRED_CHANNEL_FEATURE_MISSING,
+	lnwire.CodeUnknownNextPeer:                  Failure_UNKNOWN_NEXT_PEER,
+	lnwire.CodeTemporaryNodeFailure:             Failure_TEMPORARY_NODE_FAILURE,
+	lnwire.CodePermanentNodeFailure:             Failure_PERMANENT_NODE_FAILURE,
+	lnwire.CodePermanentChannelFailure:          Failure_PERMANENT_CHANNEL_FAILURE,
+	lnwire.CodeExpiryTooFar:                     Failure_EXPIRY_TOO_FAR,
+}
+
+// rpcHtlcEvent returns a rpc htlc event from a htlcswitch event.
+func rpcHtlcEvent(htlcEvent interface{}) (*HtlcEvent, error) {
+	var (
+		key       htlcswitch.HtlcKey
+		timestamp time.Time
+		eventType htlcswitch.HtlcEventType
+		event     isHtlcEvent_Event
+	)
+
+	switch e := htlcEvent.(type) {
+	case *htlcswitch.ForwardingEvent:
+		event = &HtlcEvent_ForwardEvent{
+			ForwardEvent: &ForwardEvent{
+				Info: rpcInfo(e.HtlcInfo),
+			},
+		}
+
+		key = e.HtlcKey
+		eventType = e.HtlcEventType
+		timestamp = e.Timestamp
+
+	case *htlcswitch.ForwardingFailEvent:
+		event = &HtlcEvent_ForwardFailEvent{
+			ForwardFailEvent: &ForwardFailEvent{},
+		}
+
+		key = e.HtlcKey
+		eventType = e.HtlcEventType
+		timestamp = e.Timestamp
+
+	case *htlcswitch.LinkFailEvent:
+		wireFailure, ok := failureCodes[e.FailureCode]
+		if !ok {
+			wireFailure = Failure_UNKNOWN_FAILURE
+		}
+
+		event = &HtlcEvent_LinkFailEvent{
+			LinkFailEvent: &LinkFailEvent{
+				Info:          rpcInfo(e.HtlcInfo),
+				WireFailure:   wireFailure,
+				FailureString: e.FailureDetail,
+				Incoming:      e.Incoming,
+			},
+		}
+
+		key = e.HtlcKey
+		eventType = e.HtlcEventType
+		timestamp = e.Timestamp
+
+	case *htlcswitch.SettleEvent:
+		event = &HtlcEvent_SettleEvent{
+			SettleEvent: &SettleEvent{},
+		}
+
+		key = e.HtlcKey
+		eventType = e.HtlcEventType
+		timestamp = e.Timestamp
+
+	default:
+		return nil, fmt.Errorf("unknown event type: %T", e)
+	}
+
+	rpcEvent := &HtlcEvent{
+		IncomingChannelId: key.IncomingCircuit.ChanID.ToUint64(),
+		OutgoingChannelId: key.OutgoingCircuit.ChanID.ToUint64(),
+		IncomingHtlcId:    key.IncomingCircuit.HtlcID,
+		OutgoingHtlcId:    key.OutgoingCircuit.HtlcID,
+		TimestampNs:       uint64(timestamp.UnixNano()),
+		Event:             event,
+	}
+
+	switch eventType {
+	case htlcswitch.HtlcEventTypeSend:
+		rpcEvent.EventType = HtlcEvent_SEND
+
+	case htlcswitch.HtlcEventTypeReceive:
+		rpcEvent.EventType = HtlcEvent_RECEIVE
+
+	case htlcswitch.HtlcEventTypeForward:
+		rpcEvent.EventType = HtlcEvent_FORWARD
+
+	default:
+		return nil, fmt.Errorf("unknown event type: %v", eventType)
+	}
+
+	return rpcEvent, nil
+}
+
+// rpcInfo returns a rpc struct containing the htlc information from the
+// switch's htlc info struct.
+func rpcInfo(info htlcswitch.HtlcInfo) *HtlcInfo {
+	return &HtlcInfo{
+		IncomingTimelock: info.IncomingTimeLock,
+		OutgoingTimelock: info.OutgoingTimeLock,
+		IncomingAmtMsat:  uint64(info.IncomingAmt),
+		OutgoingAmtMsat:  uint64(info.OutgoingAmt),
+	}
+}
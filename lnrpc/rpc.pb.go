@@ -158,6 +158,31 @@ func (Peer_SyncType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{46, 0}
 }
 
+type PeerEvent_EventType int32
+
+const (
+	PeerEvent_PEER_ONLINE  PeerEvent_EventType = 0
+	PeerEvent_PEER_OFFLINE PeerEvent_EventType = 1
+)
+
+var PeerEvent_EventType_name = map[int32]string{
+	0: "PEER_ONLINE",
+	1: "PEER_OFFLINE",
+}
+
+var PeerEvent_EventType_value = map[string]int32{
+	"PEER_ONLINE":  0,
+	"PEER_OFFLINE": 1,
+}
+
+func (x PeerEvent_EventType) String() string {
+	return proto.EnumName(PeerEvent_EventType_name, int32(x))
+}
+
+func (PeerEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{48, 0}
+}
+
 type ChannelEventUpdate_UpdateType int32
 
 const (
@@ -186,7 +211,7 @@ func (x ChannelEventUpdate_UpdateType) String() string {
 }
 
 func (ChannelEventUpdate_UpdateType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{64, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{73, 0}
 }
 
 type Invoice_InvoiceState int32
@@ -217,7 +242,7 @@ func (x Invoice_InvoiceState) String() string {
 }
 
 func (Invoice_InvoiceState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{95, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{105, 0}
 }
 
 type Payment_PaymentStatus int32
@@ -248,7 +273,7 @@ func (x Payment_PaymentStatus) String() string {
 }
 
 func (Payment_PaymentStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{102, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{112, 0}
 }
 
 type GenSeedRequest struct {
@@ -1059,11 +1084,24 @@ type SendRequest struct {
 	//*
 	//An optional field that can be used to pass an arbitrary set of TLV records
 	//to a peer which understands the new records. This can be used to pass
-	//application specific data during the payment attempt.
-	DestTlv              map[uint64][]byte `protobuf:"bytes,11,rep,name=dest_tlv,json=destTlv,proto3" json:"dest_tlv,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	//application specific data during the payment attempt. For a spontaneous
+	//keysend payment, the payment preimage is included under record type
+	//5482373484, so that the receiver can settle without an invoice.
+	DestCustomRecords map[uint64][]byte `protobuf:"bytes,11,rep,name=dest_custom_records,json=destCustomRecords,proto3" json:"dest_custom_records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//*
+	//The pubkey of the last hop of the route. If empty, any last hop may be
+	//used. Together with outgoing_chan_id and a payment to ourselves, this
+	//allows to rebalance channels.
+	LastHopPubkey []byte `protobuf:"bytes,12,opt,name=last_hop_pubkey,json=lastHopPubkey,proto3" json:"last_hop_pubkey,omitempty"`
+	//*
+	//A list of nodes to ignore during path finding.
+	IgnoredNodes [][]byte `protobuf:"bytes,13,rep,name=ignored_nodes,json=ignoredNodes,proto3" json:"ignored_nodes,omitempty"`
+	//*
+	//A list of directed node pairs that will be ignored during path finding.
+	IgnoredPairs         []*NodePair `protobuf:"bytes,14,rep,name=ignored_pairs,json=ignoredPairs,proto3" json:"ignored_pairs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *SendRequest) Reset()         { *m = SendRequest{} }
@@ -1161,9 +1199,30 @@ func (m *SendRequest) GetCltvLimit() uint32 {
 	return 0
 }
 
-func (m *SendRequest) GetDestTlv() map[uint64][]byte {
+func (m *SendRequest) GetDestCustomRecords() map[uint64][]byte {
+	if m != nil {
+		return m.DestCustomRecords
+	}
+	return nil
+}
+
+func (m *SendRequest) GetLastHopPubkey() []byte {
+	if m != nil {
+		return m.LastHopPubkey
+	}
+	return nil
+}
+
+func (m *SendRequest) GetIgnoredNodes() [][]byte {
+	if m != nil {
+		return m.IgnoredNodes
+	}
+	return nil
+}
+
+func (m *SendRequest) GetIgnoredPairs() []*NodePair {
 	if m != nil {
-		return m.DestTlv
+		return m.IgnoredPairs
 	}
 	return nil
 }
@@ -1443,7 +1502,40 @@ type ChannelAcceptResponse struct {
 	/// Whether or not the client accepts the channel.
 	Accept bool `protobuf:"varint,1,opt,name=accept,proto3" json:"accept,omitempty"`
 	/// The pending channel id to which this response applies.
-	PendingChanId        []byte   `protobuf:"bytes,2,opt,name=pending_chan_id,json=pendingChanId,proto3" json:"pending_chan_id,omitempty"`
+	PendingChanId []byte `protobuf:"bytes,2,opt,name=pending_chan_id,json=pendingChanId,proto3" json:"pending_chan_id,omitempty"`
+	//*
+	//Whether the channel may be used before its funding transaction confirms.
+	//This should only be set for initiators that are trusted not to double
+	//spend the funding transaction. It is ignored if the channel is rejected.
+	ZeroConf bool `protobuf:"varint,3,opt,name=zero_conf,json=zeroConf,proto3" json:"zero_conf,omitempty"`
+	//*
+	//The reason for rejecting the channel, which is sent to the initiator. It
+	//can only be set if the channel is rejected. If empty, a generic error is
+	//sent instead.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	//*
+	//The number of blocks the initiator has to wait before it can sweep its
+	//funds after a unilateral close. If zero, the default delay is required.
+	//The delay can't exceed the largest delay that is required by default.
+	CsvDelay uint32 `protobuf:"varint,5,opt,name=csv_delay,json=csvDelay,proto3" json:"csv_delay,omitempty"`
+	//*
+	//The amount that the initiator has to keep on its side of the channel. If
+	//zero, the default reserve is required.
+	ReserveSat uint64 `protobuf:"varint,6,opt,name=reserve_sat,json=reserveSat,proto3" json:"reserve_sat,omitempty"`
+	//*
+	//The maximum number of HTLCs that the initiator may offer us at once. If
+	//zero, the default limit applies.
+	MaxHtlcCount uint32 `protobuf:"varint,7,opt,name=max_htlc_count,json=maxHtlcCount,proto3" json:"max_htlc_count,omitempty"`
+	//*
+	//The smallest HTLC in millisatoshi that the initiator may offer us. If
+	//zero, the default minimum applies. It must be below both the capacity of
+	//the channel and the value the initiator may have in flight.
+	MinHtlcIn uint64 `protobuf:"varint,8,opt,name=min_htlc_in,json=minHtlcIn,proto3" json:"min_htlc_in,omitempty"`
+	//*
+	//The number of confirmations of the funding transaction that are required
+	//before the channel is used. It is ignored for zero-conf channels. If zero,
+	//the default number of confirmations is required.
+	MinAcceptDepth       uint32   `protobuf:"varint,9,opt,name=min_accept_depth,json=minAcceptDepth,proto3" json:"min_accept_depth,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1488,6 +1580,55 @@ func (m *ChannelAcceptResponse) GetPendingChanId() []byte {
 	return nil
 }
 
+func (m *ChannelAcceptResponse) GetZeroConf() bool {
+	if m != nil {
+		return m.ZeroConf
+	}
+	return false
+}
+
+func (m *ChannelAcceptResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ChannelAcceptResponse) GetCsvDelay() uint32 {
+	if m != nil {
+		return m.CsvDelay
+	}
+	return 0
+}
+
+func (m *ChannelAcceptResponse) GetReserveSat() uint64 {
+	if m != nil {
+		return m.ReserveSat
+	}
+	return 0
+}
+
+func (m *ChannelAcceptResponse) GetMaxHtlcCount() uint32 {
+	if m != nil {
+		return m.MaxHtlcCount
+	}
+	return 0
+}
+
+func (m *ChannelAcceptResponse) GetMinHtlcIn() uint64 {
+	if m != nil {
+		return m.MinHtlcIn
+	}
+	return 0
+}
+
+func (m *ChannelAcceptResponse) GetMinAcceptDepth() uint32 {
+	if m != nil {
+		return m.MinAcceptDepth
+	}
+	return 0
+}
+
 type ChannelPoint struct {
 	// Types that are valid to be assigned to FundingTxid:
 	//	*ChannelPoint_FundingTxidBytes
@@ -2629,7 +2770,11 @@ type Channel struct {
 	//in the output of the remote party does not change each state. This makes
 	//back up and recovery easier as when the channel is closed, the funds go
 	//directly to that key.
-	StaticRemoteKey      bool     `protobuf:"varint,22,opt,name=static_remote_key,proto3" json:"static_remote_key,omitempty"`
+	StaticRemoteKey bool `protobuf:"varint,22,opt,name=static_remote_key,proto3" json:"static_remote_key,omitempty"`
+	//*
+	//The address that the funds of our side of the channel are paid to on
+	//cooperative close, if we committed to one when the channel was opened.
+	CloseAddress         string   `protobuf:"bytes,23,opt,name=close_address,proto3" json:"close_address,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2814,6 +2959,13 @@ func (m *Channel) GetStaticRemoteKey() bool {
 	return false
 }
 
+func (m *Channel) GetCloseAddress() string {
+	if m != nil {
+		return m.CloseAddress
+	}
+	return ""
+}
+
 type ListChannelsRequest struct {
 	ActiveOnly           bool     `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
 	InactiveOnly         bool     `protobuf:"varint,2,opt,name=inactive_only,json=inactiveOnly,proto3" json:"inactive_only,omitempty"`
@@ -3174,10 +3326,22 @@ type Peer struct {
 	/// Ping time to this peer
 	PingTime int64 `protobuf:"varint,9,opt,name=ping_time,proto3" json:"ping_time,omitempty"`
 	// The type of sync we are currently performing with this peer.
-	SyncType             Peer_SyncType `protobuf:"varint,10,opt,name=sync_type,proto3,enum=lnrpc.Peer_SyncType" json:"sync_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	SyncType Peer_SyncType `protobuf:"varint,10,opt,name=sync_type,proto3,enum=lnrpc.Peer_SyncType" json:"sync_type,omitempty"`
+	//*
+	//The number of times this peer has come online or gone offline since our
+	//node started.
+	FlapCount int32 `protobuf:"varint,11,opt,name=flap_count,proto3" json:"flap_count,omitempty"`
+	//*
+	//The time in unix nanoseconds at which this peer last came online or went
+	//offline, which for a connected peer is the time it came online.
+	LastFlapNs int64 `protobuf:"varint,12,opt,name=last_flap_ns,proto3" json:"last_flap_ns,omitempty"`
+	//*
+	//The time in unix nanoseconds at which we last received a message from
+	//this peer.
+	LastSeenNs           int64    `protobuf:"varint,13,opt,name=last_seen_ns,proto3" json:"last_seen_ns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Peer) Reset()         { *m = Peer{} }
@@ -3268,6 +3432,106 @@ func (m *Peer) GetSyncType() Peer_SyncType {
 	return Peer_UNKNOWN_SYNC
 }
 
+func (m *Peer) GetFlapCount() int32 {
+	if m != nil {
+		return m.FlapCount
+	}
+	return 0
+}
+
+func (m *Peer) GetLastFlapNs() int64 {
+	if m != nil {
+		return m.LastFlapNs
+	}
+	return 0
+}
+
+func (m *Peer) GetLastSeenNs() int64 {
+	if m != nil {
+		return m.LastSeenNs
+	}
+	return 0
+}
+
+type PeerEventSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerEventSubscription) Reset()         { *m = PeerEventSubscription{} }
+func (m *PeerEventSubscription) String() string { return proto.CompactTextString(m) }
+func (*PeerEventSubscription) ProtoMessage()    {}
+func (*PeerEventSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{47}
+}
+
+func (m *PeerEventSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerEventSubscription.Unmarshal(m, b)
+}
+func (m *PeerEventSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerEventSubscription.Marshal(b, m, deterministic)
+}
+func (m *PeerEventSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerEventSubscription.Merge(m, src)
+}
+func (m *PeerEventSubscription) XXX_Size() int {
+	return xxx_messageInfo_PeerEventSubscription.Size(m)
+}
+func (m *PeerEventSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerEventSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerEventSubscription proto.InternalMessageInfo
+
+type PeerEvent struct {
+	/// The identity pubkey of the peer.
+	PubKey               string              `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	Type                 PeerEvent_EventType `protobuf:"varint,2,opt,name=type,proto3,enum=lnrpc.PeerEvent_EventType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *PeerEvent) Reset()         { *m = PeerEvent{} }
+func (m *PeerEvent) String() string { return proto.CompactTextString(m) }
+func (*PeerEvent) ProtoMessage()    {}
+func (*PeerEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{48}
+}
+
+func (m *PeerEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerEvent.Unmarshal(m, b)
+}
+func (m *PeerEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerEvent.Marshal(b, m, deterministic)
+}
+func (m *PeerEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerEvent.Merge(m, src)
+}
+func (m *PeerEvent) XXX_Size() int {
+	return xxx_messageInfo_PeerEvent.Size(m)
+}
+func (m *PeerEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerEvent proto.InternalMessageInfo
+
+func (m *PeerEvent) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *PeerEvent) GetType() PeerEvent_EventType {
+	if m != nil {
+		return m.Type
+	}
+	return PeerEvent_PEER_ONLINE
+}
+
 type ListPeersRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -3278,7 +3542,7 @@ func (m *ListPeersRequest) Reset()         { *m = ListPeersRequest{} }
 func (m *ListPeersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPeersRequest) ProtoMessage()    {}
 func (*ListPeersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{47}
+	return fileDescriptor_77a6da22d6a3feb1, []int{49}
 }
 
 func (m *ListPeersRequest) XXX_Unmarshal(b []byte) error {
@@ -3311,7 +3575,7 @@ func (m *ListPeersResponse) Reset()         { *m = ListPeersResponse{} }
 func (m *ListPeersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPeersResponse) ProtoMessage()    {}
 func (*ListPeersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{48}
+	return fileDescriptor_77a6da22d6a3feb1, []int{50}
 }
 
 func (m *ListPeersResponse) XXX_Unmarshal(b []byte) error {
@@ -3349,7 +3613,7 @@ func (m *GetInfoRequest) Reset()         { *m = GetInfoRequest{} }
 func (m *GetInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetInfoRequest) ProtoMessage()    {}
 func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{49}
+	return fileDescriptor_77a6da22d6a3feb1, []int{51}
 }
 
 func (m *GetInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -3414,7 +3678,7 @@ func (m *GetInfoResponse) Reset()         { *m = GetInfoResponse{} }
 func (m *GetInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetInfoResponse) ProtoMessage()    {}
 func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{50}
+	return fileDescriptor_77a6da22d6a3feb1, []int{52}
 }
 
 func (m *GetInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -3562,7 +3826,7 @@ func (m *Chain) Reset()         { *m = Chain{} }
 func (m *Chain) String() string { return proto.CompactTextString(m) }
 func (*Chain) ProtoMessage()    {}
 func (*Chain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{51}
+	return fileDescriptor_77a6da22d6a3feb1, []int{53}
 }
 
 func (m *Chain) XXX_Unmarshal(b []byte) error {
@@ -3610,7 +3874,7 @@ func (m *ConfirmationUpdate) Reset()         { *m = ConfirmationUpdate{} }
 func (m *ConfirmationUpdate) String() string { return proto.CompactTextString(m) }
 func (*ConfirmationUpdate) ProtoMessage()    {}
 func (*ConfirmationUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{52}
+	return fileDescriptor_77a6da22d6a3feb1, []int{54}
 }
 
 func (m *ConfirmationUpdate) XXX_Unmarshal(b []byte) error {
@@ -3663,7 +3927,7 @@ func (m *ChannelOpenUpdate) Reset()         { *m = ChannelOpenUpdate{} }
 func (m *ChannelOpenUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelOpenUpdate) ProtoMessage()    {}
 func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{53}
+	return fileDescriptor_77a6da22d6a3feb1, []int{55}
 }
 
 func (m *ChannelOpenUpdate) XXX_Unmarshal(b []byte) error {
@@ -3703,7 +3967,7 @@ func (m *ChannelCloseUpdate) Reset()         { *m = ChannelCloseUpdate{} }
 func (m *ChannelCloseUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelCloseUpdate) ProtoMessage()    {}
 func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{54}
+	return fileDescriptor_77a6da22d6a3feb1, []int{56}
 }
 
 func (m *ChannelCloseUpdate) XXX_Unmarshal(b []byte) error {
@@ -3759,7 +4023,7 @@ func (m *CloseChannelRequest) Reset()         { *m = CloseChannelRequest{} }
 func (m *CloseChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseChannelRequest) ProtoMessage()    {}
 func (*CloseChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{55}
+	return fileDescriptor_77a6da22d6a3feb1, []int{57}
 }
 
 func (m *CloseChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -3822,7 +4086,7 @@ func (m *CloseStatusUpdate) Reset()         { *m = CloseStatusUpdate{} }
 func (m *CloseStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*CloseStatusUpdate) ProtoMessage()    {}
 func (*CloseStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{56}
+	return fileDescriptor_77a6da22d6a3feb1, []int{58}
 }
 
 func (m *CloseStatusUpdate) XXX_Unmarshal(b []byte) error {
@@ -3900,7 +4164,7 @@ func (m *PendingUpdate) Reset()         { *m = PendingUpdate{} }
 func (m *PendingUpdate) String() string { return proto.CompactTextString(m) }
 func (*PendingUpdate) ProtoMessage()    {}
 func (*PendingUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{57}
+	return fileDescriptor_77a6da22d6a3feb1, []int{59}
 }
 
 func (m *PendingUpdate) XXX_Unmarshal(b []byte) error {
@@ -3957,7 +4221,29 @@ type OpenChannelRequest struct {
 	/// The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
 	MinConfs int32 `protobuf:"varint,11,opt,name=min_confs,proto3" json:"min_confs,omitempty"`
 	/// Whether unconfirmed outputs should be used as inputs for the funding transaction.
-	SpendUnconfirmed     bool     `protobuf:"varint,12,opt,name=spend_unconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	SpendUnconfirmed bool `protobuf:"varint,12,opt,name=spend_unconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	//*
+	//If set, the wallet doesn't fund the channel. Instead, the funding output
+	//is returned in a psbt_fund update once the remote peer accepted the
+	//channel, and the funding workflow continues once a finalized PSBT that
+	//creates the output is passed to FundingStateStep. The PSBT must be passed
+	//within 10 minutes, otherwise the pending channel is canceled.
+	PsbtFunding bool `protobuf:"varint,13,opt,name=psbt_funding,proto3" json:"psbt_funding,omitempty"`
+	//*
+	//An optional address that the funds of our side of the channel are paid to
+	//on cooperative close. The address is committed to when opening the
+	//channel, so that a later close must pay out to it, even if the node is
+	//compromised. It can only be set if the remote peer supports upfront
+	//shutdown scripts. If unset, the closeaddress configured for the node, if
+	//any, is used.
+	CloseAddress string `protobuf:"bytes,14,opt,name=close_address,proto3" json:"close_address,omitempty"`
+	//*
+	//Whether the channel should be used before its funding transaction
+	//confirms. This is only possible if the remote peer trusts us not to double
+	//spend the funding transaction, and signals so by accepting the channel
+	//with a minimum depth of zero. Until the channel confirms, it is identified
+	//by a random alias short channel id and is not announced.
+	ZeroConf             bool     `protobuf:"varint,15,opt,name=zero_conf,proto3" json:"zero_conf,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3967,7 +4253,7 @@ func (m *OpenChannelRequest) Reset()         { *m = OpenChannelRequest{} }
 func (m *OpenChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*OpenChannelRequest) ProtoMessage()    {}
 func (*OpenChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{58}
+	return fileDescriptor_77a6da22d6a3feb1, []int{60}
 }
 
 func (m *OpenChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -4065,4951 +4351,6121 @@ func (m *OpenChannelRequest) GetSpendUnconfirmed() bool {
 	return false
 }
 
-type OpenStatusUpdate struct {
-	// Types that are valid to be assigned to Update:
-	//	*OpenStatusUpdate_ChanPending
-	//	*OpenStatusUpdate_ChanOpen
-	Update               isOpenStatusUpdate_Update `protobuf_oneof:"update"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+func (m *OpenChannelRequest) GetPsbtFunding() bool {
+	if m != nil {
+		return m.PsbtFunding
+	}
+	return false
 }
 
-func (m *OpenStatusUpdate) Reset()         { *m = OpenStatusUpdate{} }
-func (m *OpenStatusUpdate) String() string { return proto.CompactTextString(m) }
-func (*OpenStatusUpdate) ProtoMessage()    {}
-func (*OpenStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{59}
+func (m *OpenChannelRequest) GetCloseAddress() string {
+	if m != nil {
+		return m.CloseAddress
+	}
+	return ""
 }
 
-func (m *OpenStatusUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OpenStatusUpdate.Unmarshal(m, b)
+func (m *OpenChannelRequest) GetZeroConf() bool {
+	if m != nil {
+		return m.ZeroConf
+	}
+	return false
 }
-func (m *OpenStatusUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OpenStatusUpdate.Marshal(b, m, deterministic)
+
+type BatchOpenChannel struct {
+	/// The pubkey of the node to open a channel with.
+	NodePubkey []byte `protobuf:"bytes,1,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
+	/// The number of satoshis the wallet should commit to the channel.
+	LocalFundingAmount int64 `protobuf:"varint,2,opt,name=local_funding_amount,proto3" json:"local_funding_amount,omitempty"`
+	/// The number of satoshis to push to the remote side as part of the initial commitment state.
+	PushSat int64 `protobuf:"varint,3,opt,name=push_sat,proto3" json:"push_sat,omitempty"`
+	/// Whether this channel should be private, not announced to the greater network.
+	Private bool `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+	/// The minimum value in millisatoshi we will require for incoming HTLCs on the channel.
+	MinHtlcMsat int64 `protobuf:"varint,5,opt,name=min_htlc_msat,proto3" json:"min_htlc_msat,omitempty"`
+	/// The delay we require on the remote's commitment transaction. If this is not set, it will be scaled automatically with the channel size.
+	RemoteCsvDelay uint32 `protobuf:"varint,6,opt,name=remote_csv_delay,proto3" json:"remote_csv_delay,omitempty"`
+	//*
+	//An optional address that the funds of our side of the channel are paid to
+	//on cooperative close. See the close_address of OpenChannelRequest.
+	CloseAddress         string   `protobuf:"bytes,7,opt,name=close_address,proto3" json:"close_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *OpenStatusUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OpenStatusUpdate.Merge(m, src)
+
+func (m *BatchOpenChannel) Reset()         { *m = BatchOpenChannel{} }
+func (m *BatchOpenChannel) String() string { return proto.CompactTextString(m) }
+func (*BatchOpenChannel) ProtoMessage()    {}
+func (*BatchOpenChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{61}
 }
-func (m *OpenStatusUpdate) XXX_Size() int {
-	return xxx_messageInfo_OpenStatusUpdate.Size(m)
+
+func (m *BatchOpenChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchOpenChannel.Unmarshal(m, b)
 }
-func (m *OpenStatusUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_OpenStatusUpdate.DiscardUnknown(m)
+func (m *BatchOpenChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchOpenChannel.Marshal(b, m, deterministic)
 }
-
-var xxx_messageInfo_OpenStatusUpdate proto.InternalMessageInfo
-
-type isOpenStatusUpdate_Update interface {
-	isOpenStatusUpdate_Update()
+func (m *BatchOpenChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOpenChannel.Merge(m, src)
 }
-
-type OpenStatusUpdate_ChanPending struct {
-	ChanPending *PendingUpdate `protobuf:"bytes,1,opt,name=chan_pending,proto3,oneof"`
+func (m *BatchOpenChannel) XXX_Size() int {
+	return xxx_messageInfo_BatchOpenChannel.Size(m)
 }
-
-type OpenStatusUpdate_ChanOpen struct {
-	ChanOpen *ChannelOpenUpdate `protobuf:"bytes,3,opt,name=chan_open,proto3,oneof"`
+func (m *BatchOpenChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOpenChannel.DiscardUnknown(m)
 }
 
-func (*OpenStatusUpdate_ChanPending) isOpenStatusUpdate_Update() {}
-
-func (*OpenStatusUpdate_ChanOpen) isOpenStatusUpdate_Update() {}
+var xxx_messageInfo_BatchOpenChannel proto.InternalMessageInfo
 
-func (m *OpenStatusUpdate) GetUpdate() isOpenStatusUpdate_Update {
+func (m *BatchOpenChannel) GetNodePubkey() []byte {
 	if m != nil {
-		return m.Update
+		return m.NodePubkey
 	}
 	return nil
 }
 
-func (m *OpenStatusUpdate) GetChanPending() *PendingUpdate {
-	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanPending); ok {
-		return x.ChanPending
+func (m *BatchOpenChannel) GetLocalFundingAmount() int64 {
+	if m != nil {
+		return m.LocalFundingAmount
 	}
-	return nil
+	return 0
 }
 
-func (m *OpenStatusUpdate) GetChanOpen() *ChannelOpenUpdate {
-	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanOpen); ok {
-		return x.ChanOpen
+func (m *BatchOpenChannel) GetPushSat() int64 {
+	if m != nil {
+		return m.PushSat
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*OpenStatusUpdate) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*OpenStatusUpdate_ChanPending)(nil),
-		(*OpenStatusUpdate_ChanOpen)(nil),
+func (m *BatchOpenChannel) GetPrivate() bool {
+	if m != nil {
+		return m.Private
 	}
+	return false
 }
 
-type PendingHTLC struct {
-	/// The direction within the channel that the htlc was sent
-	Incoming bool `protobuf:"varint,1,opt,name=incoming,proto3" json:"incoming,omitempty"`
-	/// The total value of the htlc
-	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	/// The final output to be swept back to the user's wallet
-	Outpoint string `protobuf:"bytes,3,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	/// The next block height at which we can spend the current stage
-	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height,proto3" json:"maturity_height,omitempty"`
-	//*
-	//The number of blocks remaining until the current stage can be swept.
-	//Negative values indicate how many blocks have passed since becoming
-	//mature.
-	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity,proto3" json:"blocks_til_maturity,omitempty"`
-	/// Indicates whether the htlc is in its first or second stage of recovery
-	Stage                uint32   `protobuf:"varint,6,opt,name=stage,proto3" json:"stage,omitempty"`
+func (m *BatchOpenChannel) GetMinHtlcMsat() int64 {
+	if m != nil {
+		return m.MinHtlcMsat
+	}
+	return 0
+}
+
+func (m *BatchOpenChannel) GetRemoteCsvDelay() uint32 {
+	if m != nil {
+		return m.RemoteCsvDelay
+	}
+	return 0
+}
+
+func (m *BatchOpenChannel) GetCloseAddress() string {
+	if m != nil {
+		return m.CloseAddress
+	}
+	return ""
+}
+
+type BatchOpenChannelRequest struct {
+	/// The list of channels to open.
+	Channels []*BatchOpenChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	/// The target number of blocks that the funding transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf,proto3" json:"target_conf,omitempty"`
+	/// A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
+	SatPerByte           int64    `protobuf:"varint,3,opt,name=sat_per_byte,proto3" json:"sat_per_byte,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingHTLC) Reset()         { *m = PendingHTLC{} }
-func (m *PendingHTLC) String() string { return proto.CompactTextString(m) }
-func (*PendingHTLC) ProtoMessage()    {}
-func (*PendingHTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{60}
+func (m *BatchOpenChannelRequest) Reset()         { *m = BatchOpenChannelRequest{} }
+func (m *BatchOpenChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchOpenChannelRequest) ProtoMessage()    {}
+func (*BatchOpenChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{62}
 }
 
-func (m *PendingHTLC) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingHTLC.Unmarshal(m, b)
+func (m *BatchOpenChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchOpenChannelRequest.Unmarshal(m, b)
 }
-func (m *PendingHTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingHTLC.Marshal(b, m, deterministic)
+func (m *BatchOpenChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchOpenChannelRequest.Marshal(b, m, deterministic)
 }
-func (m *PendingHTLC) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingHTLC.Merge(m, src)
+func (m *BatchOpenChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOpenChannelRequest.Merge(m, src)
 }
-func (m *PendingHTLC) XXX_Size() int {
-	return xxx_messageInfo_PendingHTLC.Size(m)
+func (m *BatchOpenChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchOpenChannelRequest.Size(m)
 }
-func (m *PendingHTLC) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingHTLC.DiscardUnknown(m)
+func (m *BatchOpenChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOpenChannelRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingHTLC proto.InternalMessageInfo
+var xxx_messageInfo_BatchOpenChannelRequest proto.InternalMessageInfo
 
-func (m *PendingHTLC) GetIncoming() bool {
+func (m *BatchOpenChannelRequest) GetChannels() []*BatchOpenChannel {
 	if m != nil {
-		return m.Incoming
+		return m.Channels
 	}
-	return false
+	return nil
 }
 
-func (m *PendingHTLC) GetAmount() int64 {
+func (m *BatchOpenChannelRequest) GetTargetConf() int32 {
 	if m != nil {
-		return m.Amount
+		return m.TargetConf
 	}
 	return 0
 }
 
-func (m *PendingHTLC) GetOutpoint() string {
+func (m *BatchOpenChannelRequest) GetSatPerByte() int64 {
 	if m != nil {
-		return m.Outpoint
+		return m.SatPerByte
 	}
-	return ""
+	return 0
 }
 
-func (m *PendingHTLC) GetMaturityHeight() uint32 {
-	if m != nil {
-		return m.MaturityHeight
-	}
-	return 0
+type BatchOpenChannelResponse struct {
+	/// The funding outpoints of the pending channels, in the order of the request.
+	PendingChannels      []*PendingUpdate `protobuf:"bytes,1,rep,name=pending_channels,proto3" json:"pending_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *PendingHTLC) GetBlocksTilMaturity() int32 {
-	if m != nil {
-		return m.BlocksTilMaturity
-	}
-	return 0
+func (m *BatchOpenChannelResponse) Reset()         { *m = BatchOpenChannelResponse{} }
+func (m *BatchOpenChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchOpenChannelResponse) ProtoMessage()    {}
+func (*BatchOpenChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{63}
 }
 
-func (m *PendingHTLC) GetStage() uint32 {
+func (m *BatchOpenChannelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchOpenChannelResponse.Unmarshal(m, b)
+}
+func (m *BatchOpenChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchOpenChannelResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchOpenChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOpenChannelResponse.Merge(m, src)
+}
+func (m *BatchOpenChannelResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchOpenChannelResponse.Size(m)
+}
+func (m *BatchOpenChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOpenChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOpenChannelResponse proto.InternalMessageInfo
+
+func (m *BatchOpenChannelResponse) GetPendingChannels() []*PendingUpdate {
 	if m != nil {
-		return m.Stage
+		return m.PendingChannels
 	}
-	return 0
+	return nil
 }
 
-type PendingChannelsRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type OpenStatusUpdate struct {
+	// Types that are valid to be assigned to Update:
+	//	*OpenStatusUpdate_ChanPending
+	//	*OpenStatusUpdate_ChanOpen
+	//	*OpenStatusUpdate_PsbtFund
+	Update               isOpenStatusUpdate_Update `protobuf_oneof:"update"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *PendingChannelsRequest) Reset()         { *m = PendingChannelsRequest{} }
-func (m *PendingChannelsRequest) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsRequest) ProtoMessage()    {}
-func (*PendingChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{61}
+func (m *OpenStatusUpdate) Reset()         { *m = OpenStatusUpdate{} }
+func (m *OpenStatusUpdate) String() string { return proto.CompactTextString(m) }
+func (*OpenStatusUpdate) ProtoMessage()    {}
+func (*OpenStatusUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{64}
 }
 
-func (m *PendingChannelsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsRequest.Unmarshal(m, b)
+func (m *OpenStatusUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenStatusUpdate.Unmarshal(m, b)
 }
-func (m *PendingChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsRequest.Marshal(b, m, deterministic)
+func (m *OpenStatusUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenStatusUpdate.Marshal(b, m, deterministic)
 }
-func (m *PendingChannelsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsRequest.Merge(m, src)
+func (m *OpenStatusUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenStatusUpdate.Merge(m, src)
 }
-func (m *PendingChannelsRequest) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsRequest.Size(m)
+func (m *OpenStatusUpdate) XXX_Size() int {
+	return xxx_messageInfo_OpenStatusUpdate.Size(m)
 }
-func (m *PendingChannelsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsRequest.DiscardUnknown(m)
+func (m *OpenStatusUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenStatusUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsRequest proto.InternalMessageInfo
+var xxx_messageInfo_OpenStatusUpdate proto.InternalMessageInfo
 
-type PendingChannelsResponse struct {
-	/// The balance in satoshis encumbered in pending channels
-	TotalLimboBalance int64 `protobuf:"varint,1,opt,name=total_limbo_balance,proto3" json:"total_limbo_balance,omitempty"`
-	/// Channels pending opening
-	PendingOpenChannels []*PendingChannelsResponse_PendingOpenChannel `protobuf:"bytes,2,rep,name=pending_open_channels,proto3" json:"pending_open_channels,omitempty"`
-	/// Channels pending closing
-	PendingClosingChannels []*PendingChannelsResponse_ClosedChannel `protobuf:"bytes,3,rep,name=pending_closing_channels,proto3" json:"pending_closing_channels,omitempty"`
-	/// Channels pending force closing
-	PendingForceClosingChannels []*PendingChannelsResponse_ForceClosedChannel `protobuf:"bytes,4,rep,name=pending_force_closing_channels,proto3" json:"pending_force_closing_channels,omitempty"`
-	/// Channels waiting for closing tx to confirm
-	WaitingCloseChannels []*PendingChannelsResponse_WaitingCloseChannel `protobuf:"bytes,5,rep,name=waiting_close_channels,proto3" json:"waiting_close_channels,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
-	XXX_unrecognized     []byte                                         `json:"-"`
-	XXX_sizecache        int32                                          `json:"-"`
+type isOpenStatusUpdate_Update interface {
+	isOpenStatusUpdate_Update()
 }
 
-func (m *PendingChannelsResponse) Reset()         { *m = PendingChannelsResponse{} }
-func (m *PendingChannelsResponse) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse) ProtoMessage()    {}
-func (*PendingChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{62}
+type OpenStatusUpdate_ChanPending struct {
+	ChanPending *PendingUpdate `protobuf:"bytes,1,opt,name=chan_pending,proto3,oneof"`
 }
 
-func (m *PendingChannelsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse.Unmarshal(m, b)
-}
-func (m *PendingChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse.Marshal(b, m, deterministic)
-}
-func (m *PendingChannelsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse.Merge(m, src)
-}
-func (m *PendingChannelsResponse) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse.Size(m)
+type OpenStatusUpdate_ChanOpen struct {
+	ChanOpen *ChannelOpenUpdate `protobuf:"bytes,3,opt,name=chan_open,proto3,oneof"`
 }
-func (m *PendingChannelsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse.DiscardUnknown(m)
+
+type OpenStatusUpdate_PsbtFund struct {
+	PsbtFund *ReadyForPsbtFunding `protobuf:"bytes,5,opt,name=psbt_fund,proto3,oneof"`
 }
 
-var xxx_messageInfo_PendingChannelsResponse proto.InternalMessageInfo
+func (*OpenStatusUpdate_ChanPending) isOpenStatusUpdate_Update() {}
 
-func (m *PendingChannelsResponse) GetTotalLimboBalance() int64 {
+func (*OpenStatusUpdate_ChanOpen) isOpenStatusUpdate_Update() {}
+
+func (*OpenStatusUpdate_PsbtFund) isOpenStatusUpdate_Update() {}
+
+func (m *OpenStatusUpdate) GetUpdate() isOpenStatusUpdate_Update {
 	if m != nil {
-		return m.TotalLimboBalance
+		return m.Update
 	}
-	return 0
+	return nil
 }
 
-func (m *PendingChannelsResponse) GetPendingOpenChannels() []*PendingChannelsResponse_PendingOpenChannel {
-	if m != nil {
-		return m.PendingOpenChannels
+func (m *OpenStatusUpdate) GetChanPending() *PendingUpdate {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanPending); ok {
+		return x.ChanPending
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse) GetPendingClosingChannels() []*PendingChannelsResponse_ClosedChannel {
-	if m != nil {
-		return m.PendingClosingChannels
+func (m *OpenStatusUpdate) GetChanOpen() *ChannelOpenUpdate {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanOpen); ok {
+		return x.ChanOpen
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse) GetPendingForceClosingChannels() []*PendingChannelsResponse_ForceClosedChannel {
-	if m != nil {
-		return m.PendingForceClosingChannels
+func (m *OpenStatusUpdate) GetPsbtFund() *ReadyForPsbtFunding {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_PsbtFund); ok {
+		return x.PsbtFund
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse) GetWaitingCloseChannels() []*PendingChannelsResponse_WaitingCloseChannel {
-	if m != nil {
-		return m.WaitingCloseChannels
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*OpenStatusUpdate) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*OpenStatusUpdate_ChanPending)(nil),
+		(*OpenStatusUpdate_ChanOpen)(nil),
+		(*OpenStatusUpdate_PsbtFund)(nil),
 	}
-	return nil
 }
 
-type PendingChannelsResponse_PendingChannel struct {
-	RemoteNodePub string `protobuf:"bytes,1,opt,name=remote_node_pub,proto3" json:"remote_node_pub,omitempty"`
-	ChannelPoint  string `protobuf:"bytes,2,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
-	Capacity      int64  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	LocalBalance  int64  `protobuf:"varint,4,opt,name=local_balance,proto3" json:"local_balance,omitempty"`
-	RemoteBalance int64  `protobuf:"varint,5,opt,name=remote_balance,proto3" json:"remote_balance,omitempty"`
-	/// The minimum satoshis this node is required to reserve in its balance.
-	LocalChanReserveSat int64 `protobuf:"varint,6,opt,name=local_chan_reserve_sat,proto3" json:"local_chan_reserve_sat,omitempty"`
-	//*
-	//The minimum satoshis the other node is required to reserve in its
-	//balance.
-	RemoteChanReserveSat int64    `protobuf:"varint,7,opt,name=remote_chan_reserve_sat,proto3" json:"remote_chan_reserve_sat,omitempty"`
+type ReadyForPsbtFunding struct {
+	/// The pending channel ID to pass to FundingStateStep.
+	PendingChanId []byte `protobuf:"bytes,1,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	/// The P2WSH address of the funding output the funding transaction must create.
+	FundingAddress string `protobuf:"bytes,2,opt,name=funding_address,proto3" json:"funding_address,omitempty"`
+	/// The exact value in satoshis of the funding output.
+	FundingAmount        int64    `protobuf:"varint,3,opt,name=funding_amount,proto3" json:"funding_amount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsResponse_PendingChannel) Reset() {
-	*m = PendingChannelsResponse_PendingChannel{}
-}
-func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
-func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{62, 0}
+func (m *ReadyForPsbtFunding) Reset()         { *m = ReadyForPsbtFunding{} }
+func (m *ReadyForPsbtFunding) String() string { return proto.CompactTextString(m) }
+func (*ReadyForPsbtFunding) ProtoMessage()    {}
+func (*ReadyForPsbtFunding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{65}
 }
 
-func (m *PendingChannelsResponse_PendingChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Unmarshal(m, b)
+func (m *ReadyForPsbtFunding) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadyForPsbtFunding.Unmarshal(m, b)
 }
-func (m *PendingChannelsResponse_PendingChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Marshal(b, m, deterministic)
+func (m *ReadyForPsbtFunding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadyForPsbtFunding.Marshal(b, m, deterministic)
 }
-func (m *PendingChannelsResponse_PendingChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_PendingChannel.Merge(m, src)
+func (m *ReadyForPsbtFunding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadyForPsbtFunding.Merge(m, src)
 }
-func (m *PendingChannelsResponse_PendingChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Size(m)
+func (m *ReadyForPsbtFunding) XXX_Size() int {
+	return xxx_messageInfo_ReadyForPsbtFunding.Size(m)
 }
-func (m *PendingChannelsResponse_PendingChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_PendingChannel.DiscardUnknown(m)
+func (m *ReadyForPsbtFunding) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadyForPsbtFunding.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsResponse_PendingChannel proto.InternalMessageInfo
+var xxx_messageInfo_ReadyForPsbtFunding proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse_PendingChannel) GetRemoteNodePub() string {
+func (m *ReadyForPsbtFunding) GetPendingChanId() []byte {
 	if m != nil {
-		return m.RemoteNodePub
+		return m.PendingChanId
 	}
-	return ""
+	return nil
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetChannelPoint() string {
+func (m *ReadyForPsbtFunding) GetFundingAddress() string {
 	if m != nil {
-		return m.ChannelPoint
+		return m.FundingAddress
 	}
 	return ""
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetCapacity() int64 {
+func (m *ReadyForPsbtFunding) GetFundingAmount() int64 {
 	if m != nil {
-		return m.Capacity
+		return m.FundingAmount
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetLocalBalance() int64 {
-	if m != nil {
-		return m.LocalBalance
-	}
-	return 0
-}
-
-func (m *PendingChannelsResponse_PendingChannel) GetRemoteBalance() int64 {
-	if m != nil {
-		return m.RemoteBalance
-	}
-	return 0
-}
-
-func (m *PendingChannelsResponse_PendingChannel) GetLocalChanReserveSat() int64 {
-	if m != nil {
-		return m.LocalChanReserveSat
-	}
-	return 0
-}
-
-func (m *PendingChannelsResponse_PendingChannel) GetRemoteChanReserveSat() int64 {
-	if m != nil {
-		return m.RemoteChanReserveSat
-	}
-	return 0
-}
-
-type PendingChannelsResponse_PendingOpenChannel struct {
-	/// The pending channel
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	/// The height at which this channel will be confirmed
-	ConfirmationHeight uint32 `protobuf:"varint,2,opt,name=confirmation_height,proto3" json:"confirmation_height,omitempty"`
-	//*
-	//The amount calculated to be paid in fees for the current set of
-	//commitment transactions. The fee amount is persisted with the channel
-	//in order to allow the fee amount to be removed and recalculated with
-	//each channel state update, including updates that happen after a system
-	//restart.
-	CommitFee int64 `protobuf:"varint,4,opt,name=commit_fee,proto3" json:"commit_fee,omitempty"`
-	/// The weight of the commitment transaction
-	CommitWeight int64 `protobuf:"varint,5,opt,name=commit_weight,proto3" json:"commit_weight,omitempty"`
+type FundingPsbtVerify struct {
+	/// The pending channel ID of the channel that is funded.
+	PendingChanId []byte `protobuf:"bytes,1,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
 	//*
-	//The required number of satoshis per kilo-weight that the requester will
-	//pay at all times, for both the funding transaction and commitment
-	//transaction. This value can later be updated once the channel is open.
-	FeePerKw             int64    `protobuf:"varint,6,opt,name=fee_per_kw,proto3" json:"fee_per_kw,omitempty"`
+	//The serialized, finalized PSBT of the funding transaction. Every input of
+	//the PSBT must carry its final script signature or witness.
+	SignedPsbt           []byte   `protobuf:"bytes,2,opt,name=signed_psbt,proto3" json:"signed_psbt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) Reset() {
-	*m = PendingChannelsResponse_PendingOpenChannel{}
-}
-func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
-	return proto.CompactTextString(m)
-}
-func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{62, 1}
+func (m *FundingPsbtVerify) Reset()         { *m = FundingPsbtVerify{} }
+func (m *FundingPsbtVerify) String() string { return proto.CompactTextString(m) }
+func (*FundingPsbtVerify) ProtoMessage()    {}
+func (*FundingPsbtVerify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{66}
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Unmarshal(m, b)
+func (m *FundingPsbtVerify) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundingPsbtVerify.Unmarshal(m, b)
 }
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Marshal(b, m, deterministic)
+func (m *FundingPsbtVerify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundingPsbtVerify.Marshal(b, m, deterministic)
 }
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Merge(m, src)
+func (m *FundingPsbtVerify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingPsbtVerify.Merge(m, src)
 }
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Size(m)
+func (m *FundingPsbtVerify) XXX_Size() int {
+	return xxx_messageInfo_FundingPsbtVerify.Size(m)
 }
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.DiscardUnknown(m)
+func (m *FundingPsbtVerify) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingPsbtVerify.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel proto.InternalMessageInfo
+var xxx_messageInfo_FundingPsbtVerify proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+func (m *FundingPsbtVerify) GetPendingChanId() []byte {
 	if m != nil {
-		return m.Channel
+		return m.PendingChanId
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetConfirmationHeight() uint32 {
+func (m *FundingPsbtVerify) GetSignedPsbt() []byte {
 	if m != nil {
-		return m.ConfirmationHeight
+		return m.SignedPsbt
 	}
-	return 0
+	return nil
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitFee() int64 {
-	if m != nil {
-		return m.CommitFee
-	}
-	return 0
+type FundingStateStepRequest struct {
+	// Types that are valid to be assigned to Trigger:
+	//	*FundingStateStepRequest_PsbtVerify
+	Trigger              isFundingStateStepRequest_Trigger `protobuf_oneof:"trigger"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitWeight() int64 {
-	if m != nil {
-		return m.CommitWeight
-	}
-	return 0
+func (m *FundingStateStepRequest) Reset()         { *m = FundingStateStepRequest{} }
+func (m *FundingStateStepRequest) String() string { return proto.CompactTextString(m) }
+func (*FundingStateStepRequest) ProtoMessage()    {}
+func (*FundingStateStepRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{67}
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetFeePerKw() int64 {
-	if m != nil {
-		return m.FeePerKw
-	}
-	return 0
+func (m *FundingStateStepRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundingStateStepRequest.Unmarshal(m, b)
 }
-
-type PendingChannelsResponse_WaitingCloseChannel struct {
-	/// The pending channel waiting for closing tx to confirm
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	/// The balance in satoshis encumbered in this channel
-	LimboBalance         int64    `protobuf:"varint,2,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *FundingStateStepRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundingStateStepRequest.Marshal(b, m, deterministic)
 }
-
-func (m *PendingChannelsResponse_WaitingCloseChannel) Reset() {
-	*m = PendingChannelsResponse_WaitingCloseChannel{}
+func (m *FundingStateStepRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingStateStepRequest.Merge(m, src)
 }
-func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
-	return proto.CompactTextString(m)
+func (m *FundingStateStepRequest) XXX_Size() int {
+	return xxx_messageInfo_FundingStateStepRequest.Size(m)
 }
-func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{62, 2}
+func (m *FundingStateStepRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingStateStepRequest.DiscardUnknown(m)
 }
 
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Unmarshal(m, b)
-}
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Marshal(b, m, deterministic)
-}
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Merge(m, src)
-}
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Size(m)
+var xxx_messageInfo_FundingStateStepRequest proto.InternalMessageInfo
+
+type isFundingStateStepRequest_Trigger interface {
+	isFundingStateStepRequest_Trigger()
 }
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.DiscardUnknown(m)
+
+type FundingStateStepRequest_PsbtVerify struct {
+	PsbtVerify *FundingPsbtVerify `protobuf:"bytes,1,opt,name=psbt_verify,proto3,oneof"`
 }
 
-var xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel proto.InternalMessageInfo
+func (*FundingStateStepRequest_PsbtVerify) isFundingStateStepRequest_Trigger() {}
 
-func (m *PendingChannelsResponse_WaitingCloseChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+func (m *FundingStateStepRequest) GetTrigger() isFundingStateStepRequest_Trigger {
 	if m != nil {
-		return m.Channel
+		return m.Trigger
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse_WaitingCloseChannel) GetLimboBalance() int64 {
-	if m != nil {
-		return m.LimboBalance
+func (m *FundingStateStepRequest) GetPsbtVerify() *FundingPsbtVerify {
+	if x, ok := m.GetTrigger().(*FundingStateStepRequest_PsbtVerify); ok {
+		return x.PsbtVerify
 	}
-	return 0
+	return nil
 }
 
-type PendingChannelsResponse_ClosedChannel struct {
-	/// The pending channel to be closed
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	/// The transaction id of the closing transaction
-	ClosingTxid          string   `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*FundingStateStepRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*FundingStateStepRequest_PsbtVerify)(nil),
+	}
+}
+
+type FundingStateStepResp struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingChannelsResponse_ClosedChannel{} }
-func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
-func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{62, 3}
+func (m *FundingStateStepResp) Reset()         { *m = FundingStateStepResp{} }
+func (m *FundingStateStepResp) String() string { return proto.CompactTextString(m) }
+func (*FundingStateStepResp) ProtoMessage()    {}
+func (*FundingStateStepResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{68}
 }
 
-func (m *PendingChannelsResponse_ClosedChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Unmarshal(m, b)
-}
-func (m *PendingChannelsResponse_ClosedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Marshal(b, m, deterministic)
+func (m *FundingStateStepResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundingStateStepResp.Unmarshal(m, b)
 }
-func (m *PendingChannelsResponse_ClosedChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Merge(m, src)
+func (m *FundingStateStepResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundingStateStepResp.Marshal(b, m, deterministic)
 }
-func (m *PendingChannelsResponse_ClosedChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Size(m)
+func (m *FundingStateStepResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingStateStepResp.Merge(m, src)
 }
-func (m *PendingChannelsResponse_ClosedChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_ClosedChannel.DiscardUnknown(m)
+func (m *FundingStateStepResp) XXX_Size() int {
+	return xxx_messageInfo_FundingStateStepResp.Size(m)
 }
-
-var xxx_messageInfo_PendingChannelsResponse_ClosedChannel proto.InternalMessageInfo
-
-func (m *PendingChannelsResponse_ClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
-	if m != nil {
-		return m.Channel
-	}
-	return nil
+func (m *FundingStateStepResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingStateStepResp.DiscardUnknown(m)
 }
 
-func (m *PendingChannelsResponse_ClosedChannel) GetClosingTxid() string {
-	if m != nil {
-		return m.ClosingTxid
-	}
-	return ""
-}
+var xxx_messageInfo_FundingStateStepResp proto.InternalMessageInfo
 
-type PendingChannelsResponse_ForceClosedChannel struct {
-	/// The pending channel to be force closed
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	/// The transaction id of the closing transaction
-	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
-	/// The balance in satoshis encumbered in this pending channel
-	LimboBalance int64 `protobuf:"varint,3,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
-	/// The height at which funds can be swept into the wallet
+type PendingHTLC struct {
+	/// The direction within the channel that the htlc was sent
+	Incoming bool `protobuf:"varint,1,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	/// The total value of the htlc
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	/// The final output to be swept back to the user's wallet
+	Outpoint string `protobuf:"bytes,3,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	/// The next block height at which we can spend the current stage
 	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height,proto3" json:"maturity_height,omitempty"`
-	//
-	//Remaining # of blocks until the commitment output can be swept.
+	//*
+	//The number of blocks remaining until the current stage can be swept.
 	//Negative values indicate how many blocks have passed since becoming
 	//mature.
 	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity,proto3" json:"blocks_til_maturity,omitempty"`
-	/// The total value of funds successfully recovered from this channel
-	RecoveredBalance     int64          `protobuf:"varint,6,opt,name=recovered_balance,proto3" json:"recovered_balance,omitempty"`
-	PendingHtlcs         []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs,proto3" json:"pending_htlcs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	/// Indicates whether the htlc is in its first or second stage of recovery
+	Stage                uint32   `protobuf:"varint,6,opt,name=stage,proto3" json:"stage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) Reset() {
-	*m = PendingChannelsResponse_ForceClosedChannel{}
-}
-func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
-	return proto.CompactTextString(m)
-}
-func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{62, 4}
+func (m *PendingHTLC) Reset()         { *m = PendingHTLC{} }
+func (m *PendingHTLC) String() string { return proto.CompactTextString(m) }
+func (*PendingHTLC) ProtoMessage()    {}
+func (*PendingHTLC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{69}
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Unmarshal(m, b)
+func (m *PendingHTLC) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingHTLC.Unmarshal(m, b)
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Marshal(b, m, deterministic)
+func (m *PendingHTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingHTLC.Marshal(b, m, deterministic)
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Merge(m, src)
+func (m *PendingHTLC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingHTLC.Merge(m, src)
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Size(m)
+func (m *PendingHTLC) XXX_Size() int {
+	return xxx_messageInfo_PendingHTLC.Size(m)
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.DiscardUnknown(m)
+func (m *PendingHTLC) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingHTLC.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel proto.InternalMessageInfo
+var xxx_messageInfo_PendingHTLC proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+func (m *PendingHTLC) GetIncoming() bool {
 	if m != nil {
-		return m.Channel
+		return m.Incoming
 	}
-	return nil
+	return false
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetClosingTxid() string {
+func (m *PendingHTLC) GetAmount() int64 {
 	if m != nil {
-		return m.ClosingTxid
+		return m.Amount
 	}
-	return ""
+	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetLimboBalance() int64 {
+func (m *PendingHTLC) GetOutpoint() string {
 	if m != nil {
-		return m.LimboBalance
+		return m.Outpoint
 	}
-	return 0
+	return ""
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetMaturityHeight() uint32 {
+func (m *PendingHTLC) GetMaturityHeight() uint32 {
 	if m != nil {
 		return m.MaturityHeight
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetBlocksTilMaturity() int32 {
+func (m *PendingHTLC) GetBlocksTilMaturity() int32 {
 	if m != nil {
 		return m.BlocksTilMaturity
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetRecoveredBalance() int64 {
+func (m *PendingHTLC) GetStage() uint32 {
 	if m != nil {
-		return m.RecoveredBalance
+		return m.Stage
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*PendingHTLC {
-	if m != nil {
-		return m.PendingHtlcs
-	}
-	return nil
-}
-
-type ChannelEventSubscription struct {
+type PendingChannelsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelEventSubscription) Reset()         { *m = ChannelEventSubscription{} }
-func (m *ChannelEventSubscription) String() string { return proto.CompactTextString(m) }
-func (*ChannelEventSubscription) ProtoMessage()    {}
-func (*ChannelEventSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{63}
+func (m *PendingChannelsRequest) Reset()         { *m = PendingChannelsRequest{} }
+func (m *PendingChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsRequest) ProtoMessage()    {}
+func (*PendingChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{70}
 }
 
-func (m *ChannelEventSubscription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelEventSubscription.Unmarshal(m, b)
+func (m *PendingChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsRequest.Unmarshal(m, b)
 }
-func (m *ChannelEventSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelEventSubscription.Marshal(b, m, deterministic)
+func (m *PendingChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsRequest.Marshal(b, m, deterministic)
 }
-func (m *ChannelEventSubscription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelEventSubscription.Merge(m, src)
+func (m *PendingChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsRequest.Merge(m, src)
 }
-func (m *ChannelEventSubscription) XXX_Size() int {
-	return xxx_messageInfo_ChannelEventSubscription.Size(m)
+func (m *PendingChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsRequest.Size(m)
 }
-func (m *ChannelEventSubscription) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelEventSubscription.DiscardUnknown(m)
+func (m *PendingChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelEventSubscription proto.InternalMessageInfo
+var xxx_messageInfo_PendingChannelsRequest proto.InternalMessageInfo
 
-type ChannelEventUpdate struct {
-	// Types that are valid to be assigned to Channel:
-	//	*ChannelEventUpdate_OpenChannel
-	//	*ChannelEventUpdate_ClosedChannel
-	//	*ChannelEventUpdate_ActiveChannel
-	//	*ChannelEventUpdate_InactiveChannel
-	Channel              isChannelEventUpdate_Channel  `protobuf_oneof:"channel"`
-	Type                 ChannelEventUpdate_UpdateType `protobuf:"varint,5,opt,name=type,proto3,enum=lnrpc.ChannelEventUpdate_UpdateType" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+type PendingChannelsResponse struct {
+	/// The balance in satoshis encumbered in pending channels
+	TotalLimboBalance int64 `protobuf:"varint,1,opt,name=total_limbo_balance,proto3" json:"total_limbo_balance,omitempty"`
+	/// Channels pending opening
+	PendingOpenChannels []*PendingChannelsResponse_PendingOpenChannel `protobuf:"bytes,2,rep,name=pending_open_channels,proto3" json:"pending_open_channels,omitempty"`
+	/// Channels pending closing
+	PendingClosingChannels []*PendingChannelsResponse_ClosedChannel `protobuf:"bytes,3,rep,name=pending_closing_channels,proto3" json:"pending_closing_channels,omitempty"`
+	/// Channels pending force closing
+	PendingForceClosingChannels []*PendingChannelsResponse_ForceClosedChannel `protobuf:"bytes,4,rep,name=pending_force_closing_channels,proto3" json:"pending_force_closing_channels,omitempty"`
+	/// Channels waiting for closing tx to confirm
+	WaitingCloseChannels []*PendingChannelsResponse_WaitingCloseChannel `protobuf:"bytes,5,rep,name=waiting_close_channels,proto3" json:"waiting_close_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
 }
 
-func (m *ChannelEventUpdate) Reset()         { *m = ChannelEventUpdate{} }
-func (m *ChannelEventUpdate) String() string { return proto.CompactTextString(m) }
-func (*ChannelEventUpdate) ProtoMessage()    {}
-func (*ChannelEventUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{64}
+func (m *PendingChannelsResponse) Reset()         { *m = PendingChannelsResponse{} }
+func (m *PendingChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse) ProtoMessage()    {}
+func (*PendingChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{71}
 }
 
-func (m *ChannelEventUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelEventUpdate.Unmarshal(m, b)
+func (m *PendingChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse.Unmarshal(m, b)
 }
-func (m *ChannelEventUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelEventUpdate.Marshal(b, m, deterministic)
+func (m *PendingChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse.Marshal(b, m, deterministic)
 }
-func (m *ChannelEventUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelEventUpdate.Merge(m, src)
+func (m *PendingChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse.Merge(m, src)
 }
-func (m *ChannelEventUpdate) XXX_Size() int {
-	return xxx_messageInfo_ChannelEventUpdate.Size(m)
+func (m *PendingChannelsResponse) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse.Size(m)
 }
-func (m *ChannelEventUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelEventUpdate.DiscardUnknown(m)
+func (m *PendingChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelEventUpdate proto.InternalMessageInfo
+var xxx_messageInfo_PendingChannelsResponse proto.InternalMessageInfo
 
-type isChannelEventUpdate_Channel interface {
-	isChannelEventUpdate_Channel()
+func (m *PendingChannelsResponse) GetTotalLimboBalance() int64 {
+	if m != nil {
+		return m.TotalLimboBalance
+	}
+	return 0
 }
 
-type ChannelEventUpdate_OpenChannel struct {
-	OpenChannel *Channel `protobuf:"bytes,1,opt,name=open_channel,proto3,oneof"`
+func (m *PendingChannelsResponse) GetPendingOpenChannels() []*PendingChannelsResponse_PendingOpenChannel {
+	if m != nil {
+		return m.PendingOpenChannels
+	}
+	return nil
 }
 
-type ChannelEventUpdate_ClosedChannel struct {
-	ClosedChannel *ChannelCloseSummary `protobuf:"bytes,2,opt,name=closed_channel,proto3,oneof"`
+func (m *PendingChannelsResponse) GetPendingClosingChannels() []*PendingChannelsResponse_ClosedChannel {
+	if m != nil {
+		return m.PendingClosingChannels
+	}
+	return nil
 }
 
-type ChannelEventUpdate_ActiveChannel struct {
-	ActiveChannel *ChannelPoint `protobuf:"bytes,3,opt,name=active_channel,proto3,oneof"`
+func (m *PendingChannelsResponse) GetPendingForceClosingChannels() []*PendingChannelsResponse_ForceClosedChannel {
+	if m != nil {
+		return m.PendingForceClosingChannels
+	}
+	return nil
 }
 
-type ChannelEventUpdate_InactiveChannel struct {
-	InactiveChannel *ChannelPoint `protobuf:"bytes,4,opt,name=inactive_channel,proto3,oneof"`
+func (m *PendingChannelsResponse) GetWaitingCloseChannels() []*PendingChannelsResponse_WaitingCloseChannel {
+	if m != nil {
+		return m.WaitingCloseChannels
+	}
+	return nil
 }
 
-func (*ChannelEventUpdate_OpenChannel) isChannelEventUpdate_Channel() {}
+type PendingChannelsResponse_PendingChannel struct {
+	RemoteNodePub string `protobuf:"bytes,1,opt,name=remote_node_pub,proto3" json:"remote_node_pub,omitempty"`
+	ChannelPoint  string `protobuf:"bytes,2,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	Capacity      int64  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	LocalBalance  int64  `protobuf:"varint,4,opt,name=local_balance,proto3" json:"local_balance,omitempty"`
+	RemoteBalance int64  `protobuf:"varint,5,opt,name=remote_balance,proto3" json:"remote_balance,omitempty"`
+	/// The minimum satoshis this node is required to reserve in its balance.
+	LocalChanReserveSat int64 `protobuf:"varint,6,opt,name=local_chan_reserve_sat,proto3" json:"local_chan_reserve_sat,omitempty"`
+	//*
+	//The minimum satoshis the other node is required to reserve in its
+	//balance.
+	RemoteChanReserveSat int64    `protobuf:"varint,7,opt,name=remote_chan_reserve_sat,proto3" json:"remote_chan_reserve_sat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-func (*ChannelEventUpdate_ClosedChannel) isChannelEventUpdate_Channel() {}
+func (m *PendingChannelsResponse_PendingChannel) Reset() {
+	*m = PendingChannelsResponse_PendingChannel{}
+}
+func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
+func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{71, 0}
+}
 
-func (*ChannelEventUpdate_ActiveChannel) isChannelEventUpdate_Channel() {}
+func (m *PendingChannelsResponse_PendingChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Unmarshal(m, b)
+}
+func (m *PendingChannelsResponse_PendingChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Marshal(b, m, deterministic)
+}
+func (m *PendingChannelsResponse_PendingChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_PendingChannel.Merge(m, src)
+}
+func (m *PendingChannelsResponse_PendingChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Size(m)
+}
+func (m *PendingChannelsResponse_PendingChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_PendingChannel.DiscardUnknown(m)
+}
 
-func (*ChannelEventUpdate_InactiveChannel) isChannelEventUpdate_Channel() {}
+var xxx_messageInfo_PendingChannelsResponse_PendingChannel proto.InternalMessageInfo
 
-func (m *ChannelEventUpdate) GetChannel() isChannelEventUpdate_Channel {
+func (m *PendingChannelsResponse_PendingChannel) GetRemoteNodePub() string {
 	if m != nil {
-		return m.Channel
+		return m.RemoteNodePub
 	}
-	return nil
+	return ""
 }
 
-func (m *ChannelEventUpdate) GetOpenChannel() *Channel {
-	if x, ok := m.GetChannel().(*ChannelEventUpdate_OpenChannel); ok {
-		return x.OpenChannel
+func (m *PendingChannelsResponse_PendingChannel) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
 	}
-	return nil
+	return ""
 }
 
-func (m *ChannelEventUpdate) GetClosedChannel() *ChannelCloseSummary {
-	if x, ok := m.GetChannel().(*ChannelEventUpdate_ClosedChannel); ok {
-		return x.ClosedChannel
+func (m *PendingChannelsResponse_PendingChannel) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
 	}
-	return nil
+	return 0
 }
 
-func (m *ChannelEventUpdate) GetActiveChannel() *ChannelPoint {
-	if x, ok := m.GetChannel().(*ChannelEventUpdate_ActiveChannel); ok {
-		return x.ActiveChannel
+func (m *PendingChannelsResponse_PendingChannel) GetLocalBalance() int64 {
+	if m != nil {
+		return m.LocalBalance
 	}
-	return nil
+	return 0
 }
 
-func (m *ChannelEventUpdate) GetInactiveChannel() *ChannelPoint {
-	if x, ok := m.GetChannel().(*ChannelEventUpdate_InactiveChannel); ok {
-		return x.InactiveChannel
+func (m *PendingChannelsResponse_PendingChannel) GetRemoteBalance() int64 {
+	if m != nil {
+		return m.RemoteBalance
 	}
-	return nil
+	return 0
 }
 
-func (m *ChannelEventUpdate) GetType() ChannelEventUpdate_UpdateType {
+func (m *PendingChannelsResponse_PendingChannel) GetLocalChanReserveSat() int64 {
 	if m != nil {
-		return m.Type
+		return m.LocalChanReserveSat
 	}
-	return ChannelEventUpdate_OPEN_CHANNEL
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*ChannelEventUpdate) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*ChannelEventUpdate_OpenChannel)(nil),
-		(*ChannelEventUpdate_ClosedChannel)(nil),
-		(*ChannelEventUpdate_ActiveChannel)(nil),
-		(*ChannelEventUpdate_InactiveChannel)(nil),
+func (m *PendingChannelsResponse_PendingChannel) GetRemoteChanReserveSat() int64 {
+	if m != nil {
+		return m.RemoteChanReserveSat
 	}
+	return 0
 }
 
-type WalletBalanceRequest struct {
+type PendingChannelsResponse_PendingOpenChannel struct {
+	/// The pending channel
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	/// The height at which this channel will be confirmed
+	ConfirmationHeight uint32 `protobuf:"varint,2,opt,name=confirmation_height,proto3" json:"confirmation_height,omitempty"`
+	//*
+	//The amount calculated to be paid in fees for the current set of
+	//commitment transactions. The fee amount is persisted with the channel
+	//in order to allow the fee amount to be removed and recalculated with
+	//each channel state update, including updates that happen after a system
+	//restart.
+	CommitFee int64 `protobuf:"varint,4,opt,name=commit_fee,proto3" json:"commit_fee,omitempty"`
+	/// The weight of the commitment transaction
+	CommitWeight int64 `protobuf:"varint,5,opt,name=commit_weight,proto3" json:"commit_weight,omitempty"`
+	//*
+	//The required number of satoshis per kilo-weight that the requester will
+	//pay at all times, for both the funding transaction and commitment
+	//transaction. This value can later be updated once the channel is open.
+	FeePerKw             int64    `protobuf:"varint,6,opt,name=fee_per_kw,proto3" json:"fee_per_kw,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WalletBalanceRequest) Reset()         { *m = WalletBalanceRequest{} }
-func (m *WalletBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*WalletBalanceRequest) ProtoMessage()    {}
-func (*WalletBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{65}
+func (m *PendingChannelsResponse_PendingOpenChannel) Reset() {
+	*m = PendingChannelsResponse_PendingOpenChannel{}
+}
+func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{71, 1}
 }
 
-func (m *WalletBalanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WalletBalanceRequest.Unmarshal(m, b)
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Unmarshal(m, b)
 }
-func (m *WalletBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WalletBalanceRequest.Marshal(b, m, deterministic)
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Marshal(b, m, deterministic)
 }
-func (m *WalletBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WalletBalanceRequest.Merge(m, src)
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Merge(m, src)
 }
-func (m *WalletBalanceRequest) XXX_Size() int {
-	return xxx_messageInfo_WalletBalanceRequest.Size(m)
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Size(m)
 }
-func (m *WalletBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WalletBalanceRequest.DiscardUnknown(m)
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WalletBalanceRequest proto.InternalMessageInfo
-
-type WalletBalanceResponse struct {
-	/// The balance of the wallet
-	TotalBalance int64 `protobuf:"varint,1,opt,name=total_balance,proto3" json:"total_balance,omitempty"`
-	/// The confirmed balance of a wallet(with >= 1 confirmations)
-	ConfirmedBalance int64 `protobuf:"varint,2,opt,name=confirmed_balance,proto3" json:"confirmed_balance,omitempty"`
-	/// The unconfirmed balance of a wallet(with 0 confirmations)
-	UnconfirmedBalance   int64    `protobuf:"varint,3,opt,name=unconfirmed_balance,proto3" json:"unconfirmed_balance,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
+var xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel proto.InternalMessageInfo
 
-func (m *WalletBalanceResponse) Reset()         { *m = WalletBalanceResponse{} }
-func (m *WalletBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*WalletBalanceResponse) ProtoMessage()    {}
-func (*WalletBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{66}
+func (m *PendingChannelsResponse_PendingOpenChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
 }
 
-func (m *WalletBalanceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WalletBalanceResponse.Unmarshal(m, b)
-}
-func (m *WalletBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WalletBalanceResponse.Marshal(b, m, deterministic)
-}
-func (m *WalletBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WalletBalanceResponse.Merge(m, src)
-}
-func (m *WalletBalanceResponse) XXX_Size() int {
-	return xxx_messageInfo_WalletBalanceResponse.Size(m)
-}
-func (m *WalletBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_WalletBalanceResponse.DiscardUnknown(m)
+func (m *PendingChannelsResponse_PendingOpenChannel) GetConfirmationHeight() uint32 {
+	if m != nil {
+		return m.ConfirmationHeight
+	}
+	return 0
 }
 
-var xxx_messageInfo_WalletBalanceResponse proto.InternalMessageInfo
-
-func (m *WalletBalanceResponse) GetTotalBalance() int64 {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitFee() int64 {
 	if m != nil {
-		return m.TotalBalance
+		return m.CommitFee
 	}
 	return 0
 }
 
-func (m *WalletBalanceResponse) GetConfirmedBalance() int64 {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitWeight() int64 {
 	if m != nil {
-		return m.ConfirmedBalance
+		return m.CommitWeight
 	}
 	return 0
 }
 
-func (m *WalletBalanceResponse) GetUnconfirmedBalance() int64 {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetFeePerKw() int64 {
 	if m != nil {
-		return m.UnconfirmedBalance
+		return m.FeePerKw
 	}
 	return 0
 }
 
-type ChannelBalanceRequest struct {
+type PendingChannelsResponse_WaitingCloseChannel struct {
+	/// The pending channel waiting for closing tx to confirm
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	/// The balance in satoshis encumbered in this channel
+	LimboBalance         int64    `protobuf:"varint,2,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelBalanceRequest) Reset()         { *m = ChannelBalanceRequest{} }
-func (m *ChannelBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*ChannelBalanceRequest) ProtoMessage()    {}
-func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{67}
-}
-
-func (m *ChannelBalanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelBalanceRequest.Unmarshal(m, b)
-}
-func (m *ChannelBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelBalanceRequest.Marshal(b, m, deterministic)
-}
-func (m *ChannelBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelBalanceRequest.Merge(m, src)
-}
-func (m *ChannelBalanceRequest) XXX_Size() int {
-	return xxx_messageInfo_ChannelBalanceRequest.Size(m)
-}
-func (m *ChannelBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelBalanceRequest.DiscardUnknown(m)
+func (m *PendingChannelsResponse_WaitingCloseChannel) Reset() {
+	*m = PendingChannelsResponse_WaitingCloseChannel{}
 }
-
-var xxx_messageInfo_ChannelBalanceRequest proto.InternalMessageInfo
-
-type ChannelBalanceResponse struct {
-	/// Sum of channels balances denominated in satoshis
-	Balance int64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
-	/// Sum of channels pending balances denominated in satoshis
-	PendingOpenBalance   int64    `protobuf:"varint,2,opt,name=pending_open_balance,proto3" json:"pending_open_balance,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *ChannelBalanceResponse) Reset()         { *m = ChannelBalanceResponse{} }
-func (m *ChannelBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*ChannelBalanceResponse) ProtoMessage()    {}
-func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{68}
+func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{71, 2}
 }
 
-func (m *ChannelBalanceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelBalanceResponse.Unmarshal(m, b)
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Unmarshal(m, b)
 }
-func (m *ChannelBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelBalanceResponse.Marshal(b, m, deterministic)
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Marshal(b, m, deterministic)
 }
-func (m *ChannelBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelBalanceResponse.Merge(m, src)
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Merge(m, src)
 }
-func (m *ChannelBalanceResponse) XXX_Size() int {
-	return xxx_messageInfo_ChannelBalanceResponse.Size(m)
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Size(m)
 }
-func (m *ChannelBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelBalanceResponse.DiscardUnknown(m)
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel proto.InternalMessageInfo
 
-func (m *ChannelBalanceResponse) GetBalance() int64 {
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
 	if m != nil {
-		return m.Balance
+		return m.Channel
 	}
-	return 0
+	return nil
 }
 
-func (m *ChannelBalanceResponse) GetPendingOpenBalance() int64 {
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetLimboBalance() int64 {
 	if m != nil {
-		return m.PendingOpenBalance
+		return m.LimboBalance
 	}
 	return 0
 }
 
-type QueryRoutesRequest struct {
-	/// The 33-byte hex-encoded public key for the payment destination
-	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	/// The amount to send expressed in satoshis
-	Amt int64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
-	/// An optional CLTV delta from the current height that should be used for the timelock of the final hop
-	FinalCltvDelta int32 `protobuf:"varint,4,opt,name=final_cltv_delta,json=finalCltvDelta,proto3" json:"final_cltv_delta,omitempty"`
-	//*
-	//The maximum number of satoshis that will be paid as a fee of the payment.
-	//This value can be represented either as a percentage of the amount being
-	//sent, or as a fixed amount of the maximum fee the user is willing the pay to
-	//send the payment.
-	FeeLimit *FeeLimit `protobuf:"bytes,5,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
-	//*
-	//A list of nodes to ignore during path finding.
-	IgnoredNodes [][]byte `protobuf:"bytes,6,rep,name=ignored_nodes,json=ignoredNodes,proto3" json:"ignored_nodes,omitempty"`
-	//*
-	//Deprecated. A list of edges to ignore during path finding.
-	IgnoredEdges []*EdgeLocator `protobuf:"bytes,7,rep,name=ignored_edges,json=ignoredEdges,proto3" json:"ignored_edges,omitempty"` // Deprecated: Do not use.
-	//*
-	//The source node where the request route should originated from. If empty,
-	//self is assumed.
-	SourcePubKey string `protobuf:"bytes,8,opt,name=source_pub_key,json=sourcePubKey,proto3" json:"source_pub_key,omitempty"`
-	//*
-	//If set to true, edge probabilities from mission control will be used to get
-	//the optimal route.
-	UseMissionControl bool `protobuf:"varint,9,opt,name=use_mission_control,json=useMissionControl,proto3" json:"use_mission_control,omitempty"`
-	//*
-	//A list of directed node pairs that will be ignored during path finding.
-	IgnoredPairs []*NodePair `protobuf:"bytes,10,rep,name=ignored_pairs,json=ignoredPairs,proto3" json:"ignored_pairs,omitempty"`
-	//*
-	//An optional maximum total time lock for the route. If the source is empty or
-	//ourselves, this should not exceed lnd's `--max-cltv-expiry` setting. If
-	//zero, then the value of `--max-cltv-expiry` is used as the limit.
-	CltvLimit            uint32   `protobuf:"varint,11,opt,name=cltv_limit,json=cltvLimit,proto3" json:"cltv_limit,omitempty"`
+type PendingChannelsResponse_ClosedChannel struct {
+	/// The pending channel to be closed
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	/// The transaction id of the closing transaction
+	ClosingTxid          string   `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *QueryRoutesRequest) Reset()         { *m = QueryRoutesRequest{} }
-func (m *QueryRoutesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRoutesRequest) ProtoMessage()    {}
-func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{69}
+func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingChannelsResponse_ClosedChannel{} }
+func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
+func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{71, 3}
 }
 
-func (m *QueryRoutesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryRoutesRequest.Unmarshal(m, b)
+func (m *PendingChannelsResponse_ClosedChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Unmarshal(m, b)
 }
-func (m *QueryRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryRoutesRequest.Marshal(b, m, deterministic)
+func (m *PendingChannelsResponse_ClosedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Marshal(b, m, deterministic)
 }
-func (m *QueryRoutesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRoutesRequest.Merge(m, src)
+func (m *PendingChannelsResponse_ClosedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Merge(m, src)
 }
-func (m *QueryRoutesRequest) XXX_Size() int {
-	return xxx_messageInfo_QueryRoutesRequest.Size(m)
+func (m *PendingChannelsResponse_ClosedChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Size(m)
 }
-func (m *QueryRoutesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRoutesRequest.DiscardUnknown(m)
+func (m *PendingChannelsResponse_ClosedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_ClosedChannel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRoutesRequest proto.InternalMessageInfo
+var xxx_messageInfo_PendingChannelsResponse_ClosedChannel proto.InternalMessageInfo
 
-func (m *QueryRoutesRequest) GetPubKey() string {
+func (m *PendingChannelsResponse_ClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
 	if m != nil {
-		return m.PubKey
+		return m.Channel
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryRoutesRequest) GetAmt() int64 {
+func (m *PendingChannelsResponse_ClosedChannel) GetClosingTxid() string {
 	if m != nil {
-		return m.Amt
+		return m.ClosingTxid
 	}
-	return 0
+	return ""
 }
 
-func (m *QueryRoutesRequest) GetFinalCltvDelta() int32 {
-	if m != nil {
-		return m.FinalCltvDelta
-	}
-	return 0
+type PendingChannelsResponse_ForceClosedChannel struct {
+	/// The pending channel to be force closed
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	/// The transaction id of the closing transaction
+	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+	/// The balance in satoshis encumbered in this pending channel
+	LimboBalance int64 `protobuf:"varint,3,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
+	/// The height at which funds can be swept into the wallet
+	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height,proto3" json:"maturity_height,omitempty"`
+	//
+	//Remaining # of blocks until the commitment output can be swept.
+	//Negative values indicate how many blocks have passed since becoming
+	//mature.
+	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity,proto3" json:"blocks_til_maturity,omitempty"`
+	/// The total value of funds successfully recovered from this channel
+	RecoveredBalance     int64          `protobuf:"varint,6,opt,name=recovered_balance,proto3" json:"recovered_balance,omitempty"`
+	PendingHtlcs         []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs,proto3" json:"pending_htlcs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *QueryRoutesRequest) GetFeeLimit() *FeeLimit {
+func (m *PendingChannelsResponse_ForceClosedChannel) Reset() {
+	*m = PendingChannelsResponse_ForceClosedChannel{}
+}
+func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{71, 4}
+}
+
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Unmarshal(m, b)
+}
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Marshal(b, m, deterministic)
+}
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Merge(m, src)
+}
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Size(m)
+}
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel proto.InternalMessageInfo
+
+func (m *PendingChannelsResponse_ForceClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
 	if m != nil {
-		return m.FeeLimit
+		return m.Channel
 	}
 	return nil
 }
 
-func (m *QueryRoutesRequest) GetIgnoredNodes() [][]byte {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetClosingTxid() string {
 	if m != nil {
-		return m.IgnoredNodes
+		return m.ClosingTxid
 	}
-	return nil
+	return ""
 }
 
-// Deprecated: Do not use.
-func (m *QueryRoutesRequest) GetIgnoredEdges() []*EdgeLocator {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetLimboBalance() int64 {
 	if m != nil {
-		return m.IgnoredEdges
+		return m.LimboBalance
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryRoutesRequest) GetSourcePubKey() string {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetMaturityHeight() uint32 {
 	if m != nil {
-		return m.SourcePubKey
+		return m.MaturityHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryRoutesRequest) GetUseMissionControl() bool {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetBlocksTilMaturity() int32 {
 	if m != nil {
-		return m.UseMissionControl
+		return m.BlocksTilMaturity
 	}
-	return false
+	return 0
 }
 
-func (m *QueryRoutesRequest) GetIgnoredPairs() []*NodePair {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetRecoveredBalance() int64 {
 	if m != nil {
-		return m.IgnoredPairs
+		return m.RecoveredBalance
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryRoutesRequest) GetCltvLimit() uint32 {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*PendingHTLC {
 	if m != nil {
-		return m.CltvLimit
+		return m.PendingHtlcs
 	}
-	return 0
+	return nil
 }
 
-type NodePair struct {
-	/// The sending node of the pair.
-	From []byte `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	/// The receiving node of the pair.
-	To                   []byte   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+type ChannelEventSubscription struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NodePair) Reset()         { *m = NodePair{} }
-func (m *NodePair) String() string { return proto.CompactTextString(m) }
-func (*NodePair) ProtoMessage()    {}
-func (*NodePair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{70}
+func (m *ChannelEventSubscription) Reset()         { *m = ChannelEventSubscription{} }
+func (m *ChannelEventSubscription) String() string { return proto.CompactTextString(m) }
+func (*ChannelEventSubscription) ProtoMessage()    {}
+func (*ChannelEventSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{72}
 }
 
-func (m *NodePair) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NodePair.Unmarshal(m, b)
+func (m *ChannelEventSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEventSubscription.Unmarshal(m, b)
 }
-func (m *NodePair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NodePair.Marshal(b, m, deterministic)
+func (m *ChannelEventSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEventSubscription.Marshal(b, m, deterministic)
 }
-func (m *NodePair) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodePair.Merge(m, src)
+func (m *ChannelEventSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEventSubscription.Merge(m, src)
 }
-func (m *NodePair) XXX_Size() int {
-	return xxx_messageInfo_NodePair.Size(m)
+func (m *ChannelEventSubscription) XXX_Size() int {
+	return xxx_messageInfo_ChannelEventSubscription.Size(m)
 }
-func (m *NodePair) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodePair.DiscardUnknown(m)
+func (m *ChannelEventSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEventSubscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodePair proto.InternalMessageInfo
+var xxx_messageInfo_ChannelEventSubscription proto.InternalMessageInfo
 
-func (m *NodePair) GetFrom() []byte {
-	if m != nil {
-		return m.From
-	}
-	return nil
+type ChannelEventUpdate struct {
+	// Types that are valid to be assigned to Channel:
+	//	*ChannelEventUpdate_OpenChannel
+	//	*ChannelEventUpdate_ClosedChannel
+	//	*ChannelEventUpdate_ActiveChannel
+	//	*ChannelEventUpdate_InactiveChannel
+	Channel              isChannelEventUpdate_Channel  `protobuf_oneof:"channel"`
+	Type                 ChannelEventUpdate_UpdateType `protobuf:"varint,5,opt,name=type,proto3,enum=lnrpc.ChannelEventUpdate_UpdateType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
-func (m *NodePair) GetTo() []byte {
-	if m != nil {
-		return m.To
-	}
-	return nil
+func (m *ChannelEventUpdate) Reset()         { *m = ChannelEventUpdate{} }
+func (m *ChannelEventUpdate) String() string { return proto.CompactTextString(m) }
+func (*ChannelEventUpdate) ProtoMessage()    {}
+func (*ChannelEventUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{73}
 }
 
-type EdgeLocator struct {
-	/// The short channel id of this edge.
-	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	//*
-	//The direction of this edge. If direction_reverse is false, the direction
-	//of this edge is from the channel endpoint with the lexicographically smaller
-	//pub key to the endpoint with the larger pub key. If direction_reverse is
-	//is true, the edge goes the other way.
-	DirectionReverse     bool     `protobuf:"varint,2,opt,name=direction_reverse,json=directionReverse,proto3" json:"direction_reverse,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ChannelEventUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEventUpdate.Unmarshal(m, b)
 }
-
-func (m *EdgeLocator) Reset()         { *m = EdgeLocator{} }
-func (m *EdgeLocator) String() string { return proto.CompactTextString(m) }
-func (*EdgeLocator) ProtoMessage()    {}
-func (*EdgeLocator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{71}
+func (m *ChannelEventUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEventUpdate.Marshal(b, m, deterministic)
+}
+func (m *ChannelEventUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEventUpdate.Merge(m, src)
+}
+func (m *ChannelEventUpdate) XXX_Size() int {
+	return xxx_messageInfo_ChannelEventUpdate.Size(m)
+}
+func (m *ChannelEventUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEventUpdate.DiscardUnknown(m)
 }
 
-func (m *EdgeLocator) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EdgeLocator.Unmarshal(m, b)
+var xxx_messageInfo_ChannelEventUpdate proto.InternalMessageInfo
+
+type isChannelEventUpdate_Channel interface {
+	isChannelEventUpdate_Channel()
 }
-func (m *EdgeLocator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EdgeLocator.Marshal(b, m, deterministic)
+
+type ChannelEventUpdate_OpenChannel struct {
+	OpenChannel *Channel `protobuf:"bytes,1,opt,name=open_channel,proto3,oneof"`
 }
-func (m *EdgeLocator) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EdgeLocator.Merge(m, src)
+
+type ChannelEventUpdate_ClosedChannel struct {
+	ClosedChannel *ChannelCloseSummary `protobuf:"bytes,2,opt,name=closed_channel,proto3,oneof"`
 }
-func (m *EdgeLocator) XXX_Size() int {
-	return xxx_messageInfo_EdgeLocator.Size(m)
+
+type ChannelEventUpdate_ActiveChannel struct {
+	ActiveChannel *ChannelPoint `protobuf:"bytes,3,opt,name=active_channel,proto3,oneof"`
 }
-func (m *EdgeLocator) XXX_DiscardUnknown() {
-	xxx_messageInfo_EdgeLocator.DiscardUnknown(m)
+
+type ChannelEventUpdate_InactiveChannel struct {
+	InactiveChannel *ChannelPoint `protobuf:"bytes,4,opt,name=inactive_channel,proto3,oneof"`
 }
 
-var xxx_messageInfo_EdgeLocator proto.InternalMessageInfo
+func (*ChannelEventUpdate_OpenChannel) isChannelEventUpdate_Channel() {}
 
-func (m *EdgeLocator) GetChannelId() uint64 {
+func (*ChannelEventUpdate_ClosedChannel) isChannelEventUpdate_Channel() {}
+
+func (*ChannelEventUpdate_ActiveChannel) isChannelEventUpdate_Channel() {}
+
+func (*ChannelEventUpdate_InactiveChannel) isChannelEventUpdate_Channel() {}
+
+func (m *ChannelEventUpdate) GetChannel() isChannelEventUpdate_Channel {
 	if m != nil {
-		return m.ChannelId
+		return m.Channel
 	}
-	return 0
+	return nil
 }
 
-func (m *EdgeLocator) GetDirectionReverse() bool {
-	if m != nil {
-		return m.DirectionReverse
+func (m *ChannelEventUpdate) GetOpenChannel() *Channel {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_OpenChannel); ok {
+		return x.OpenChannel
 	}
-	return false
+	return nil
 }
 
-type QueryRoutesResponse struct {
-	//*
-	//The route that results from the path finding operation. This is still a
-	//repeated field to retain backwards compatibility.
-	Routes []*Route `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
-	//*
-	//The success probability of the returned route based on the current mission
-	//control state. [EXPERIMENTAL]
-	SuccessProb          float64  `protobuf:"fixed64,2,opt,name=success_prob,proto3" json:"success_prob,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ChannelEventUpdate) GetClosedChannel() *ChannelCloseSummary {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_ClosedChannel); ok {
+		return x.ClosedChannel
+	}
+	return nil
 }
 
-func (m *QueryRoutesResponse) Reset()         { *m = QueryRoutesResponse{} }
-func (m *QueryRoutesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryRoutesResponse) ProtoMessage()    {}
-func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72}
+func (m *ChannelEventUpdate) GetActiveChannel() *ChannelPoint {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_ActiveChannel); ok {
+		return x.ActiveChannel
+	}
+	return nil
 }
 
-func (m *QueryRoutesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryRoutesResponse.Unmarshal(m, b)
-}
-func (m *QueryRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryRoutesResponse.Marshal(b, m, deterministic)
-}
-func (m *QueryRoutesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRoutesResponse.Merge(m, src)
-}
-func (m *QueryRoutesResponse) XXX_Size() int {
-	return xxx_messageInfo_QueryRoutesResponse.Size(m)
-}
-func (m *QueryRoutesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRoutesResponse.DiscardUnknown(m)
+func (m *ChannelEventUpdate) GetInactiveChannel() *ChannelPoint {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_InactiveChannel); ok {
+		return x.InactiveChannel
+	}
+	return nil
 }
 
-var xxx_messageInfo_QueryRoutesResponse proto.InternalMessageInfo
-
-func (m *QueryRoutesResponse) GetRoutes() []*Route {
+func (m *ChannelEventUpdate) GetType() ChannelEventUpdate_UpdateType {
 	if m != nil {
-		return m.Routes
+		return m.Type
 	}
-	return nil
+	return ChannelEventUpdate_OPEN_CHANNEL
 }
 
-func (m *QueryRoutesResponse) GetSuccessProb() float64 {
-	if m != nil {
-		return m.SuccessProb
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ChannelEventUpdate) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ChannelEventUpdate_OpenChannel)(nil),
+		(*ChannelEventUpdate_ClosedChannel)(nil),
+		(*ChannelEventUpdate_ActiveChannel)(nil),
+		(*ChannelEventUpdate_InactiveChannel)(nil),
 	}
-	return 0
 }
 
-type Hop struct {
-	//*
-	//The unique channel ID for the channel. The first 3 bytes are the block
-	//height, the next 3 the index within the block, and the last 2 bytes are the
-	//output index for the channel.
-	ChanId           uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
-	ChanCapacity     int64  `protobuf:"varint,2,opt,name=chan_capacity,proto3" json:"chan_capacity,omitempty"`
-	AmtToForward     int64  `protobuf:"varint,3,opt,name=amt_to_forward,proto3" json:"amt_to_forward,omitempty"` // Deprecated: Do not use.
-	Fee              int64  `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`                       // Deprecated: Do not use.
-	Expiry           uint32 `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	AmtToForwardMsat int64  `protobuf:"varint,6,opt,name=amt_to_forward_msat,proto3" json:"amt_to_forward_msat,omitempty"`
-	FeeMsat          int64  `protobuf:"varint,7,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
-	//*
-	//An optional public key of the hop. If the public key is given, the payment
-	//can be executed without relying on a copy of the channel graph.
-	PubKey string `protobuf:"bytes,8,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
-	//*
-	//If set to true, then this hop will be encoded using the new variable length
-	//TLV format.
-	TlvPayload           bool     `protobuf:"varint,9,opt,name=tlv_payload,proto3" json:"tlv_payload,omitempty"`
+type WalletBalanceRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Hop) Reset()         { *m = Hop{} }
-func (m *Hop) String() string { return proto.CompactTextString(m) }
-func (*Hop) ProtoMessage()    {}
-func (*Hop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{73}
+func (m *WalletBalanceRequest) Reset()         { *m = WalletBalanceRequest{} }
+func (m *WalletBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*WalletBalanceRequest) ProtoMessage()    {}
+func (*WalletBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{74}
 }
 
-func (m *Hop) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Hop.Unmarshal(m, b)
+func (m *WalletBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletBalanceRequest.Unmarshal(m, b)
 }
-func (m *Hop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Hop.Marshal(b, m, deterministic)
+func (m *WalletBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletBalanceRequest.Marshal(b, m, deterministic)
 }
-func (m *Hop) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Hop.Merge(m, src)
+func (m *WalletBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletBalanceRequest.Merge(m, src)
 }
-func (m *Hop) XXX_Size() int {
-	return xxx_messageInfo_Hop.Size(m)
+func (m *WalletBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_WalletBalanceRequest.Size(m)
 }
-func (m *Hop) XXX_DiscardUnknown() {
-	xxx_messageInfo_Hop.DiscardUnknown(m)
+func (m *WalletBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletBalanceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Hop proto.InternalMessageInfo
+var xxx_messageInfo_WalletBalanceRequest proto.InternalMessageInfo
 
-func (m *Hop) GetChanId() uint64 {
-	if m != nil {
-		return m.ChanId
-	}
-	return 0
+type WalletBalanceResponse struct {
+	/// The balance of the wallet
+	TotalBalance int64 `protobuf:"varint,1,opt,name=total_balance,proto3" json:"total_balance,omitempty"`
+	/// The confirmed balance of a wallet(with >= 1 confirmations)
+	ConfirmedBalance int64 `protobuf:"varint,2,opt,name=confirmed_balance,proto3" json:"confirmed_balance,omitempty"`
+	/// The unconfirmed balance of a wallet(with 0 confirmations)
+	UnconfirmedBalance   int64    `protobuf:"varint,3,opt,name=unconfirmed_balance,proto3" json:"unconfirmed_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Hop) GetChanCapacity() int64 {
-	if m != nil {
-		return m.ChanCapacity
-	}
-	return 0
+func (m *WalletBalanceResponse) Reset()         { *m = WalletBalanceResponse{} }
+func (m *WalletBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*WalletBalanceResponse) ProtoMessage()    {}
+func (*WalletBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{75}
 }
 
-// Deprecated: Do not use.
-func (m *Hop) GetAmtToForward() int64 {
-	if m != nil {
-		return m.AmtToForward
-	}
-	return 0
+func (m *WalletBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletBalanceResponse.Unmarshal(m, b)
 }
-
-// Deprecated: Do not use.
-func (m *Hop) GetFee() int64 {
-	if m != nil {
-		return m.Fee
-	}
-	return 0
+func (m *WalletBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletBalanceResponse.Marshal(b, m, deterministic)
 }
-
-func (m *Hop) GetExpiry() uint32 {
-	if m != nil {
-		return m.Expiry
-	}
-	return 0
+func (m *WalletBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletBalanceResponse.Merge(m, src)
 }
-
-func (m *Hop) GetAmtToForwardMsat() int64 {
-	if m != nil {
-		return m.AmtToForwardMsat
-	}
-	return 0
+func (m *WalletBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_WalletBalanceResponse.Size(m)
+}
+func (m *WalletBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletBalanceResponse.DiscardUnknown(m)
 }
 
-func (m *Hop) GetFeeMsat() int64 {
+var xxx_messageInfo_WalletBalanceResponse proto.InternalMessageInfo
+
+func (m *WalletBalanceResponse) GetTotalBalance() int64 {
 	if m != nil {
-		return m.FeeMsat
+		return m.TotalBalance
 	}
 	return 0
 }
 
-func (m *Hop) GetPubKey() string {
+func (m *WalletBalanceResponse) GetConfirmedBalance() int64 {
 	if m != nil {
-		return m.PubKey
+		return m.ConfirmedBalance
 	}
-	return ""
+	return 0
 }
 
-func (m *Hop) GetTlvPayload() bool {
+func (m *WalletBalanceResponse) GetUnconfirmedBalance() int64 {
 	if m != nil {
-		return m.TlvPayload
+		return m.UnconfirmedBalance
 	}
-	return false
+	return 0
 }
 
-//*
-//A path through the channel graph which runs over one or more channels in
-//succession. This struct carries all the information required to craft the
-//Sphinx onion packet, and send the payment along the first hop in the path. A
-//route is only selected as valid if all the channels have sufficient capacity to
-//carry the initial payment amount after fees are accounted for.
-type Route struct {
-	//*
-	//The cumulative (final) time lock across the entire route.  This is the CLTV
-	//value that should be extended to the first hop in the route. All other hops
-	//will decrement the time-lock as advertised, leaving enough time for all
-	//hops to wait for or present the payment preimage to complete the payment.
-	TotalTimeLock uint32 `protobuf:"varint,1,opt,name=total_time_lock,proto3" json:"total_time_lock,omitempty"`
-	//*
-	//The sum of the fees paid at each hop within the final route.  In the case
-	//of a one-hop payment, this value will be zero as we don't need to pay a fee
-	//to ourselves.
-	TotalFees int64 `protobuf:"varint,2,opt,name=total_fees,proto3" json:"total_fees,omitempty"` // Deprecated: Do not use.
-	//*
-	//The total amount of funds required to complete a payment over this route.
-	//This value includes the cumulative fees at each hop. As a result, the HTLC
-	//extended to the first-hop in the route will need to have at least this many
-	//satoshis, otherwise the route will fail at an intermediate node due to an
-	//insufficient amount of fees.
-	TotalAmt int64 `protobuf:"varint,3,opt,name=total_amt,proto3" json:"total_amt,omitempty"` // Deprecated: Do not use.
-	//*
-	//Contains details concerning the specific forwarding details at each hop.
-	Hops []*Hop `protobuf:"bytes,4,rep,name=hops,proto3" json:"hops,omitempty"`
-	//*
-	//The total fees in millisatoshis.
-	TotalFeesMsat int64 `protobuf:"varint,5,opt,name=total_fees_msat,proto3" json:"total_fees_msat,omitempty"`
-	//*
-	//The total amount in millisatoshis.
-	TotalAmtMsat         int64    `protobuf:"varint,6,opt,name=total_amt_msat,proto3" json:"total_amt_msat,omitempty"`
+type ChannelBalanceRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Route) Reset()         { *m = Route{} }
-func (m *Route) String() string { return proto.CompactTextString(m) }
-func (*Route) ProtoMessage()    {}
-func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{74}
+func (m *ChannelBalanceRequest) Reset()         { *m = ChannelBalanceRequest{} }
+func (m *ChannelBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*ChannelBalanceRequest) ProtoMessage()    {}
+func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{76}
 }
 
-func (m *Route) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Route.Unmarshal(m, b)
+func (m *ChannelBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBalanceRequest.Unmarshal(m, b)
 }
-func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+func (m *ChannelBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBalanceRequest.Marshal(b, m, deterministic)
 }
-func (m *Route) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Route.Merge(m, src)
+func (m *ChannelBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBalanceRequest.Merge(m, src)
 }
-func (m *Route) XXX_Size() int {
-	return xxx_messageInfo_Route.Size(m)
+func (m *ChannelBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_ChannelBalanceRequest.Size(m)
 }
-func (m *Route) XXX_DiscardUnknown() {
-	xxx_messageInfo_Route.DiscardUnknown(m)
+func (m *ChannelBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBalanceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Route proto.InternalMessageInfo
+var xxx_messageInfo_ChannelBalanceRequest proto.InternalMessageInfo
 
-func (m *Route) GetTotalTimeLock() uint32 {
-	if m != nil {
-		return m.TotalTimeLock
-	}
-	return 0
+type ChannelBalanceResponse struct {
+	/// Sum of channels balances denominated in satoshis
+	Balance int64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	/// Sum of channels pending balances denominated in satoshis
+	PendingOpenBalance   int64    `protobuf:"varint,2,opt,name=pending_open_balance,proto3" json:"pending_open_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Deprecated: Do not use.
-func (m *Route) GetTotalFees() int64 {
-	if m != nil {
-		return m.TotalFees
-	}
-	return 0
+func (m *ChannelBalanceResponse) Reset()         { *m = ChannelBalanceResponse{} }
+func (m *ChannelBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*ChannelBalanceResponse) ProtoMessage()    {}
+func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{77}
 }
 
-// Deprecated: Do not use.
-func (m *Route) GetTotalAmt() int64 {
-	if m != nil {
-		return m.TotalAmt
-	}
-	return 0
+func (m *ChannelBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBalanceResponse.Unmarshal(m, b)
 }
-
-func (m *Route) GetHops() []*Hop {
-	if m != nil {
-		return m.Hops
-	}
-	return nil
+func (m *ChannelBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBalanceResponse.Marshal(b, m, deterministic)
+}
+func (m *ChannelBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBalanceResponse.Merge(m, src)
+}
+func (m *ChannelBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_ChannelBalanceResponse.Size(m)
+}
+func (m *ChannelBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBalanceResponse.DiscardUnknown(m)
 }
 
-func (m *Route) GetTotalFeesMsat() int64 {
+var xxx_messageInfo_ChannelBalanceResponse proto.InternalMessageInfo
+
+func (m *ChannelBalanceResponse) GetBalance() int64 {
 	if m != nil {
-		return m.TotalFeesMsat
+		return m.Balance
 	}
 	return 0
 }
 
-func (m *Route) GetTotalAmtMsat() int64 {
+func (m *ChannelBalanceResponse) GetPendingOpenBalance() int64 {
 	if m != nil {
-		return m.TotalAmtMsat
+		return m.PendingOpenBalance
 	}
 	return 0
 }
 
-type NodeInfoRequest struct {
-	/// The 33-byte hex-encoded compressed public of the target node
+type QueryRoutesRequest struct {
+	/// The 33-byte hex-encoded public key for the payment destination
 	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	/// If true, will include all known channels associated with the node.
-	IncludeChannels      bool     `protobuf:"varint,2,opt,name=include_channels,json=includeChannels,proto3" json:"include_channels,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	/// The amount to send expressed in satoshis
+	Amt int64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
+	/// An optional CLTV delta from the current height that should be used for the timelock of the final hop
+	FinalCltvDelta int32 `protobuf:"varint,4,opt,name=final_cltv_delta,json=finalCltvDelta,proto3" json:"final_cltv_delta,omitempty"`
+	//*
+	//The maximum number of satoshis that will be paid as a fee of the payment.
+	//This value can be represented either as a percentage of the amount being
+	//sent, or as a fixed amount of the maximum fee the user is willing the pay to
+	//send the payment.
+	FeeLimit *FeeLimit `protobuf:"bytes,5,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	//*
+	//A list of nodes to ignore during path finding.
+	IgnoredNodes [][]byte `protobuf:"bytes,6,rep,name=ignored_nodes,json=ignoredNodes,proto3" json:"ignored_nodes,omitempty"`
+	//*
+	//Deprecated. A list of edges to ignore during path finding.
+	IgnoredEdges []*EdgeLocator `protobuf:"bytes,7,rep,name=ignored_edges,json=ignoredEdges,proto3" json:"ignored_edges,omitempty"` // Deprecated: Do not use.
+	//*
+	//The source node where the request route should originated from. If empty,
+	//self is assumed.
+	SourcePubKey string `protobuf:"bytes,8,opt,name=source_pub_key,json=sourcePubKey,proto3" json:"source_pub_key,omitempty"`
+	//*
+	//If set to true, edge probabilities from mission control will be used to get
+	//the optimal route.
+	UseMissionControl bool `protobuf:"varint,9,opt,name=use_mission_control,json=useMissionControl,proto3" json:"use_mission_control,omitempty"`
+	//*
+	//A list of directed node pairs that will be ignored during path finding.
+	IgnoredPairs []*NodePair `protobuf:"bytes,10,rep,name=ignored_pairs,json=ignoredPairs,proto3" json:"ignored_pairs,omitempty"`
+	//*
+	//An optional maximum total time lock for the route. If the source is empty or
+	//ourselves, this should not exceed lnd's `--max-cltv-expiry` setting. If
+	//zero, then the value of `--max-cltv-expiry` is used as the limit.
+	CltvLimit uint32 `protobuf:"varint,11,opt,name=cltv_limit,json=cltvLimit,proto3" json:"cltv_limit,omitempty"`
+	//*
+	//The channel id of the channel that must be taken to the first hop. If zero,
+	//any channel may be used.
+	OutgoingChanId uint64 `protobuf:"varint,12,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	//*
+	//The pubkey of the last hop of the route. If empty, any last hop may be
+	//used.
+	LastHopPubkey []byte `protobuf:"bytes,13,opt,name=last_hop_pubkey,json=lastHopPubkey,proto3" json:"last_hop_pubkey,omitempty"`
+	//*
+	//An optional set of TLV records for the destination. If set, only routes
+	//to destinations that understand the TLV payload are returned, and the
+	//records are included in the payload of the final hop.
+	DestCustomRecords    map[uint64][]byte `protobuf:"bytes,14,rep,name=dest_custom_records,json=destCustomRecords,proto3" json:"dest_custom_records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
-func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*NodeInfoRequest) ProtoMessage()    {}
-func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{75}
+func (m *QueryRoutesRequest) Reset()         { *m = QueryRoutesRequest{} }
+func (m *QueryRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoutesRequest) ProtoMessage()    {}
+func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{78}
 }
 
-func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NodeInfoRequest.Unmarshal(m, b)
+func (m *QueryRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRoutesRequest.Unmarshal(m, b)
 }
-func (m *NodeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NodeInfoRequest.Marshal(b, m, deterministic)
+func (m *QueryRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRoutesRequest.Marshal(b, m, deterministic)
 }
-func (m *NodeInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeInfoRequest.Merge(m, src)
+func (m *QueryRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoutesRequest.Merge(m, src)
 }
-func (m *NodeInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_NodeInfoRequest.Size(m)
+func (m *QueryRoutesRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRoutesRequest.Size(m)
 }
-func (m *NodeInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeInfoRequest.DiscardUnknown(m)
+func (m *QueryRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoutesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRoutesRequest proto.InternalMessageInfo
 
-func (m *NodeInfoRequest) GetPubKey() string {
+func (m *QueryRoutesRequest) GetPubKey() string {
 	if m != nil {
 		return m.PubKey
 	}
 	return ""
 }
 
-func (m *NodeInfoRequest) GetIncludeChannels() bool {
+func (m *QueryRoutesRequest) GetAmt() int64 {
 	if m != nil {
-		return m.IncludeChannels
+		return m.Amt
 	}
-	return false
+	return 0
 }
 
-type NodeInfo struct {
-	//*
-	//An individual vertex/node within the channel graph. A node is
-	//connected to other nodes by one or more channel edges emanating from it. As
-	//the graph is directed, a node will also have an incoming edge attached to
-	//it for each outgoing edge.
-	Node *LightningNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	/// The total number of channels for the node.
-	NumChannels uint32 `protobuf:"varint,2,opt,name=num_channels,proto3" json:"num_channels,omitempty"`
-	/// The sum of all channels capacity for the node, denominated in satoshis.
-	TotalCapacity int64 `protobuf:"varint,3,opt,name=total_capacity,proto3" json:"total_capacity,omitempty"`
-	/// A list of all public channels for the node.
-	Channels             []*ChannelEdge `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *QueryRoutesRequest) GetFinalCltvDelta() int32 {
+	if m != nil {
+		return m.FinalCltvDelta
+	}
+	return 0
 }
 
-func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
-func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
-func (*NodeInfo) ProtoMessage()    {}
-func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{76}
+func (m *QueryRoutesRequest) GetFeeLimit() *FeeLimit {
+	if m != nil {
+		return m.FeeLimit
+	}
+	return nil
 }
 
-func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NodeInfo.Unmarshal(m, b)
-}
-func (m *NodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NodeInfo.Marshal(b, m, deterministic)
-}
-func (m *NodeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeInfo.Merge(m, src)
+func (m *QueryRoutesRequest) GetIgnoredNodes() [][]byte {
+	if m != nil {
+		return m.IgnoredNodes
+	}
+	return nil
 }
-func (m *NodeInfo) XXX_Size() int {
-	return xxx_messageInfo_NodeInfo.Size(m)
+
+// Deprecated: Do not use.
+func (m *QueryRoutesRequest) GetIgnoredEdges() []*EdgeLocator {
+	if m != nil {
+		return m.IgnoredEdges
+	}
+	return nil
 }
-func (m *NodeInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeInfo.DiscardUnknown(m)
+
+func (m *QueryRoutesRequest) GetSourcePubKey() string {
+	if m != nil {
+		return m.SourcePubKey
+	}
+	return ""
 }
 
-var xxx_messageInfo_NodeInfo proto.InternalMessageInfo
+func (m *QueryRoutesRequest) GetUseMissionControl() bool {
+	if m != nil {
+		return m.UseMissionControl
+	}
+	return false
+}
 
-func (m *NodeInfo) GetNode() *LightningNode {
+func (m *QueryRoutesRequest) GetIgnoredPairs() []*NodePair {
 	if m != nil {
-		return m.Node
+		return m.IgnoredPairs
 	}
 	return nil
 }
 
-func (m *NodeInfo) GetNumChannels() uint32 {
+func (m *QueryRoutesRequest) GetCltvLimit() uint32 {
 	if m != nil {
-		return m.NumChannels
+		return m.CltvLimit
 	}
 	return 0
 }
 
-func (m *NodeInfo) GetTotalCapacity() int64 {
+func (m *QueryRoutesRequest) GetOutgoingChanId() uint64 {
 	if m != nil {
-		return m.TotalCapacity
+		return m.OutgoingChanId
 	}
 	return 0
 }
 
-func (m *NodeInfo) GetChannels() []*ChannelEdge {
+func (m *QueryRoutesRequest) GetLastHopPubkey() []byte {
 	if m != nil {
-		return m.Channels
+		return m.LastHopPubkey
 	}
 	return nil
 }
 
-//*
-//An individual vertex/node within the channel graph. A node is
-//connected to other nodes by one or more channel edges emanating from it. As the
-//graph is directed, a node will also have an incoming edge attached to it for
-//each outgoing edge.
-type LightningNode struct {
-	LastUpdate           uint32         `protobuf:"varint,1,opt,name=last_update,proto3" json:"last_update,omitempty"`
-	PubKey               string         `protobuf:"bytes,2,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
-	Alias                string         `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
-	Addresses            []*NodeAddress `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Color                string         `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *QueryRoutesRequest) GetDestCustomRecords() map[uint64][]byte {
+	if m != nil {
+		return m.DestCustomRecords
+	}
+	return nil
 }
 
-func (m *LightningNode) Reset()         { *m = LightningNode{} }
-func (m *LightningNode) String() string { return proto.CompactTextString(m) }
-func (*LightningNode) ProtoMessage()    {}
-func (*LightningNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{77}
+type NodePair struct {
+	/// The sending node of the pair.
+	From []byte `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	/// The receiving node of the pair.
+	To                   []byte   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LightningNode) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LightningNode.Unmarshal(m, b)
+func (m *NodePair) Reset()         { *m = NodePair{} }
+func (m *NodePair) String() string { return proto.CompactTextString(m) }
+func (*NodePair) ProtoMessage()    {}
+func (*NodePair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{79}
 }
-func (m *LightningNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LightningNode.Marshal(b, m, deterministic)
+
+func (m *NodePair) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodePair.Unmarshal(m, b)
 }
-func (m *LightningNode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LightningNode.Merge(m, src)
+func (m *NodePair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodePair.Marshal(b, m, deterministic)
 }
-func (m *LightningNode) XXX_Size() int {
-	return xxx_messageInfo_LightningNode.Size(m)
+func (m *NodePair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodePair.Merge(m, src)
 }
-func (m *LightningNode) XXX_DiscardUnknown() {
-	xxx_messageInfo_LightningNode.DiscardUnknown(m)
+func (m *NodePair) XXX_Size() int {
+	return xxx_messageInfo_NodePair.Size(m)
+}
+func (m *NodePair) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodePair.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LightningNode proto.InternalMessageInfo
+var xxx_messageInfo_NodePair proto.InternalMessageInfo
 
-func (m *LightningNode) GetLastUpdate() uint32 {
+func (m *NodePair) GetFrom() []byte {
 	if m != nil {
-		return m.LastUpdate
+		return m.From
 	}
-	return 0
+	return nil
 }
 
-func (m *LightningNode) GetPubKey() string {
+func (m *NodePair) GetTo() []byte {
 	if m != nil {
-		return m.PubKey
+		return m.To
 	}
-	return ""
+	return nil
 }
 
-func (m *LightningNode) GetAlias() string {
-	if m != nil {
-		return m.Alias
-	}
-	return ""
+type EdgeLocator struct {
+	/// The short channel id of this edge.
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	//*
+	//The direction of this edge. If direction_reverse is false, the direction
+	//of this edge is from the channel endpoint with the lexicographically smaller
+	//pub key to the endpoint with the larger pub key. If direction_reverse is
+	//is true, the edge goes the other way.
+	DirectionReverse     bool     `protobuf:"varint,2,opt,name=direction_reverse,json=directionReverse,proto3" json:"direction_reverse,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LightningNode) GetAddresses() []*NodeAddress {
+func (m *EdgeLocator) Reset()         { *m = EdgeLocator{} }
+func (m *EdgeLocator) String() string { return proto.CompactTextString(m) }
+func (*EdgeLocator) ProtoMessage()    {}
+func (*EdgeLocator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{80}
+}
+
+func (m *EdgeLocator) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EdgeLocator.Unmarshal(m, b)
+}
+func (m *EdgeLocator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EdgeLocator.Marshal(b, m, deterministic)
+}
+func (m *EdgeLocator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EdgeLocator.Merge(m, src)
+}
+func (m *EdgeLocator) XXX_Size() int {
+	return xxx_messageInfo_EdgeLocator.Size(m)
+}
+func (m *EdgeLocator) XXX_DiscardUnknown() {
+	xxx_messageInfo_EdgeLocator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EdgeLocator proto.InternalMessageInfo
+
+func (m *EdgeLocator) GetChannelId() uint64 {
 	if m != nil {
-		return m.Addresses
+		return m.ChannelId
 	}
-	return nil
+	return 0
 }
 
-func (m *LightningNode) GetColor() string {
+func (m *EdgeLocator) GetDirectionReverse() bool {
 	if m != nil {
-		return m.Color
+		return m.DirectionReverse
 	}
-	return ""
+	return false
 }
 
-type NodeAddress struct {
-	Network              string   `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+type QueryRoutesResponse struct {
+	//*
+	//The route that results from the path finding operation. This is still a
+	//repeated field to retain backwards compatibility.
+	Routes []*Route `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	//*
+	//The success probability of the returned route based on the current mission
+	//control state. [EXPERIMENTAL]
+	SuccessProb          float64  `protobuf:"fixed64,2,opt,name=success_prob,proto3" json:"success_prob,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
-func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
-func (*NodeAddress) ProtoMessage()    {}
-func (*NodeAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{78}
+func (m *QueryRoutesResponse) Reset()         { *m = QueryRoutesResponse{} }
+func (m *QueryRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoutesResponse) ProtoMessage()    {}
+func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{81}
 }
 
-func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NodeAddress.Unmarshal(m, b)
+func (m *QueryRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRoutesResponse.Unmarshal(m, b)
 }
-func (m *NodeAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NodeAddress.Marshal(b, m, deterministic)
+func (m *QueryRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRoutesResponse.Marshal(b, m, deterministic)
 }
-func (m *NodeAddress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeAddress.Merge(m, src)
+func (m *QueryRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoutesResponse.Merge(m, src)
 }
-func (m *NodeAddress) XXX_Size() int {
-	return xxx_messageInfo_NodeAddress.Size(m)
+func (m *QueryRoutesResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryRoutesResponse.Size(m)
 }
-func (m *NodeAddress) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeAddress.DiscardUnknown(m)
+func (m *QueryRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoutesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeAddress proto.InternalMessageInfo
+var xxx_messageInfo_QueryRoutesResponse proto.InternalMessageInfo
 
-func (m *NodeAddress) GetNetwork() string {
+func (m *QueryRoutesResponse) GetRoutes() []*Route {
 	if m != nil {
-		return m.Network
+		return m.Routes
 	}
-	return ""
+	return nil
 }
 
-func (m *NodeAddress) GetAddr() string {
+func (m *QueryRoutesResponse) GetSuccessProb() float64 {
 	if m != nil {
-		return m.Addr
+		return m.SuccessProb
 	}
-	return ""
+	return 0
 }
 
-type RoutingPolicy struct {
-	TimeLockDelta        uint32   `protobuf:"varint,1,opt,name=time_lock_delta,proto3" json:"time_lock_delta,omitempty"`
-	MinHtlc              int64    `protobuf:"varint,2,opt,name=min_htlc,proto3" json:"min_htlc,omitempty"`
-	FeeBaseMsat          int64    `protobuf:"varint,3,opt,name=fee_base_msat,proto3" json:"fee_base_msat,omitempty"`
-	FeeRateMilliMsat     int64    `protobuf:"varint,4,opt,name=fee_rate_milli_msat,proto3" json:"fee_rate_milli_msat,omitempty"`
-	Disabled             bool     `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	MaxHtlcMsat          uint64   `protobuf:"varint,6,opt,name=max_htlc_msat,proto3" json:"max_htlc_msat,omitempty"`
-	LastUpdate           uint32   `protobuf:"varint,7,opt,name=last_update,proto3" json:"last_update,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type Hop struct {
+	//*
+	//The unique channel ID for the channel. The first 3 bytes are the block
+	//height, the next 3 the index within the block, and the last 2 bytes are the
+	//output index for the channel.
+	ChanId           uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	ChanCapacity     int64  `protobuf:"varint,2,opt,name=chan_capacity,proto3" json:"chan_capacity,omitempty"`
+	AmtToForward     int64  `protobuf:"varint,3,opt,name=amt_to_forward,proto3" json:"amt_to_forward,omitempty"` // Deprecated: Do not use.
+	Fee              int64  `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`                       // Deprecated: Do not use.
+	Expiry           uint32 `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	AmtToForwardMsat int64  `protobuf:"varint,6,opt,name=amt_to_forward_msat,proto3" json:"amt_to_forward_msat,omitempty"`
+	FeeMsat          int64  `protobuf:"varint,7,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
+	//*
+	//An optional public key of the hop. If the public key is given, the payment
+	//can be executed without relying on a copy of the channel graph.
+	PubKey string `protobuf:"bytes,8,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	//*
+	//If set to true, then this hop will be encoded using the new variable length
+	//TLV format.
+	TlvPayload bool `protobuf:"varint,9,opt,name=tlv_payload,proto3" json:"tlv_payload,omitempty"`
+	//*
+	//An optional TLV record that signals the use of an MPP payment. If present,
+	//the receiver will enforce that that the same mpp_record is included in the
+	//final hop payload of all non-zero payments in the HTLC set. If empty, a
+	//regular single-shot payment is or was attempted.
+	MppRecord            *MPPRecord `protobuf:"bytes,10,opt,name=mpp_record,proto3" json:"mpp_record,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *RoutingPolicy) Reset()         { *m = RoutingPolicy{} }
-func (m *RoutingPolicy) String() string { return proto.CompactTextString(m) }
-func (*RoutingPolicy) ProtoMessage()    {}
-func (*RoutingPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{79}
+func (m *Hop) Reset()         { *m = Hop{} }
+func (m *Hop) String() string { return proto.CompactTextString(m) }
+func (*Hop) ProtoMessage()    {}
+func (*Hop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{82}
 }
 
-func (m *RoutingPolicy) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RoutingPolicy.Unmarshal(m, b)
+func (m *Hop) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Hop.Unmarshal(m, b)
 }
-func (m *RoutingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RoutingPolicy.Marshal(b, m, deterministic)
+func (m *Hop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Hop.Marshal(b, m, deterministic)
 }
-func (m *RoutingPolicy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RoutingPolicy.Merge(m, src)
+func (m *Hop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hop.Merge(m, src)
 }
-func (m *RoutingPolicy) XXX_Size() int {
-	return xxx_messageInfo_RoutingPolicy.Size(m)
+func (m *Hop) XXX_Size() int {
+	return xxx_messageInfo_Hop.Size(m)
 }
-func (m *RoutingPolicy) XXX_DiscardUnknown() {
-	xxx_messageInfo_RoutingPolicy.DiscardUnknown(m)
+func (m *Hop) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hop.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RoutingPolicy proto.InternalMessageInfo
+var xxx_messageInfo_Hop proto.InternalMessageInfo
 
-func (m *RoutingPolicy) GetTimeLockDelta() uint32 {
+func (m *Hop) GetChanId() uint64 {
 	if m != nil {
-		return m.TimeLockDelta
+		return m.ChanId
 	}
 	return 0
 }
 
-func (m *RoutingPolicy) GetMinHtlc() int64 {
+func (m *Hop) GetChanCapacity() int64 {
 	if m != nil {
-		return m.MinHtlc
+		return m.ChanCapacity
 	}
 	return 0
 }
 
-func (m *RoutingPolicy) GetFeeBaseMsat() int64 {
+// Deprecated: Do not use.
+func (m *Hop) GetAmtToForward() int64 {
 	if m != nil {
-		return m.FeeBaseMsat
+		return m.AmtToForward
 	}
 	return 0
 }
 
-func (m *RoutingPolicy) GetFeeRateMilliMsat() int64 {
+// Deprecated: Do not use.
+func (m *Hop) GetFee() int64 {
 	if m != nil {
-		return m.FeeRateMilliMsat
+		return m.Fee
 	}
 	return 0
 }
 
-func (m *RoutingPolicy) GetDisabled() bool {
+func (m *Hop) GetExpiry() uint32 {
 	if m != nil {
-		return m.Disabled
+		return m.Expiry
 	}
-	return false
+	return 0
 }
 
-func (m *RoutingPolicy) GetMaxHtlcMsat() uint64 {
+func (m *Hop) GetAmtToForwardMsat() int64 {
 	if m != nil {
-		return m.MaxHtlcMsat
+		return m.AmtToForwardMsat
 	}
 	return 0
 }
 
-func (m *RoutingPolicy) GetLastUpdate() uint32 {
+func (m *Hop) GetFeeMsat() int64 {
 	if m != nil {
-		return m.LastUpdate
+		return m.FeeMsat
 	}
 	return 0
 }
 
-//*
-//A fully authenticated channel along with all its unique attributes.
-//Once an authenticated channel announcement has been processed on the network,
-//then an instance of ChannelEdgeInfo encapsulating the channels attributes is
-//stored. The other portions relevant to routing policy of a channel are stored
-//within a ChannelEdgePolicy for each direction of the channel.
-type ChannelEdge struct {
-	//*
-	//The unique channel ID for the channel. The first 3 bytes are the block
-	//height, the next 3 the index within the block, and the last 2 bytes are the
-	//output index for the channel.
-	ChannelId            uint64         `protobuf:"varint,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
-	ChanPoint            string         `protobuf:"bytes,2,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
-	LastUpdate           uint32         `protobuf:"varint,3,opt,name=last_update,proto3" json:"last_update,omitempty"` // Deprecated: Do not use.
-	Node1Pub             string         `protobuf:"bytes,4,opt,name=node1_pub,proto3" json:"node1_pub,omitempty"`
-	Node2Pub             string         `protobuf:"bytes,5,opt,name=node2_pub,proto3" json:"node2_pub,omitempty"`
-	Capacity             int64          `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	Node1Policy          *RoutingPolicy `protobuf:"bytes,7,opt,name=node1_policy,proto3" json:"node1_policy,omitempty"`
-	Node2Policy          *RoutingPolicy `protobuf:"bytes,8,opt,name=node2_policy,proto3" json:"node2_policy,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *Hop) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
 }
 
-func (m *ChannelEdge) Reset()         { *m = ChannelEdge{} }
-func (m *ChannelEdge) String() string { return proto.CompactTextString(m) }
-func (*ChannelEdge) ProtoMessage()    {}
-func (*ChannelEdge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{80}
+func (m *Hop) GetTlvPayload() bool {
+	if m != nil {
+		return m.TlvPayload
+	}
+	return false
 }
 
-func (m *ChannelEdge) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelEdge.Unmarshal(m, b)
+func (m *Hop) GetMppRecord() *MPPRecord {
+	if m != nil {
+		return m.MppRecord
+	}
+	return nil
 }
-func (m *ChannelEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelEdge.Marshal(b, m, deterministic)
+
+type MPPRecord struct {
+	//*
+	//A unique, random identifier used to authenticate the sender as the intended
+	//payer of a multi-path payment. The payment_addr must be the same for all
+	//subpayments, and match the payment_addr provided in the receiver's invoice.
+	//The same payment_addr must be used on all subpayments.
+	PaymentAddr []byte `protobuf:"bytes,11,opt,name=payment_addr,proto3" json:"payment_addr,omitempty"`
+	//*
+	//The total amount in milli-satoshis being sent as part of a larger multi-path
+	//payment. The caller is responsible for ensuring subpayments to the same node
+	//and payment_hash sum exactly to total_amt_msat. The same
+	//total_amt_msat must be used on all subpayments.
+	TotalAmtMsat         int64    `protobuf:"varint,10,opt,name=total_amt_msat,proto3" json:"total_amt_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ChannelEdge) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelEdge.Merge(m, src)
+
+func (m *MPPRecord) Reset()         { *m = MPPRecord{} }
+func (m *MPPRecord) String() string { return proto.CompactTextString(m) }
+func (*MPPRecord) ProtoMessage()    {}
+func (*MPPRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{83}
 }
-func (m *ChannelEdge) XXX_Size() int {
-	return xxx_messageInfo_ChannelEdge.Size(m)
+
+func (m *MPPRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MPPRecord.Unmarshal(m, b)
 }
-func (m *ChannelEdge) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelEdge.DiscardUnknown(m)
+func (m *MPPRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MPPRecord.Marshal(b, m, deterministic)
+}
+func (m *MPPRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MPPRecord.Merge(m, src)
+}
+func (m *MPPRecord) XXX_Size() int {
+	return xxx_messageInfo_MPPRecord.Size(m)
+}
+func (m *MPPRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MPPRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelEdge proto.InternalMessageInfo
+var xxx_messageInfo_MPPRecord proto.InternalMessageInfo
 
-func (m *ChannelEdge) GetChannelId() uint64 {
+func (m *MPPRecord) GetPaymentAddr() []byte {
 	if m != nil {
-		return m.ChannelId
+		return m.PaymentAddr
 	}
-	return 0
+	return nil
 }
 
-func (m *ChannelEdge) GetChanPoint() string {
+func (m *MPPRecord) GetTotalAmtMsat() int64 {
 	if m != nil {
-		return m.ChanPoint
+		return m.TotalAmtMsat
 	}
-	return ""
+	return 0
 }
 
-// Deprecated: Do not use.
-func (m *ChannelEdge) GetLastUpdate() uint32 {
+//*
+//A path through the channel graph which runs over one or more channels in
+//succession. This struct carries all the information required to craft the
+//Sphinx onion packet, and send the payment along the first hop in the path. A
+//route is only selected as valid if all the channels have sufficient capacity to
+//carry the initial payment amount after fees are accounted for.
+type Route struct {
+	//*
+	//The cumulative (final) time lock across the entire route.  This is the CLTV
+	//value that should be extended to the first hop in the route. All other hops
+	//will decrement the time-lock as advertised, leaving enough time for all
+	//hops to wait for or present the payment preimage to complete the payment.
+	TotalTimeLock uint32 `protobuf:"varint,1,opt,name=total_time_lock,proto3" json:"total_time_lock,omitempty"`
+	//*
+	//The sum of the fees paid at each hop within the final route.  In the case
+	//of a one-hop payment, this value will be zero as we don't need to pay a fee
+	//to ourselves.
+	TotalFees int64 `protobuf:"varint,2,opt,name=total_fees,proto3" json:"total_fees,omitempty"` // Deprecated: Do not use.
+	//*
+	//The total amount of funds required to complete a payment over this route.
+	//This value includes the cumulative fees at each hop. As a result, the HTLC
+	//extended to the first-hop in the route will need to have at least this many
+	//satoshis, otherwise the route will fail at an intermediate node due to an
+	//insufficient amount of fees.
+	TotalAmt int64 `protobuf:"varint,3,opt,name=total_amt,proto3" json:"total_amt,omitempty"` // Deprecated: Do not use.
+	//*
+	//Contains details concerning the specific forwarding details at each hop.
+	Hops []*Hop `protobuf:"bytes,4,rep,name=hops,proto3" json:"hops,omitempty"`
+	//*
+	//The total fees in millisatoshis.
+	TotalFeesMsat int64 `protobuf:"varint,5,opt,name=total_fees_msat,proto3" json:"total_fees_msat,omitempty"`
+	//*
+	//The total amount in millisatoshis.
+	TotalAmtMsat         int64    `protobuf:"varint,6,opt,name=total_amt_msat,proto3" json:"total_amt_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Route) Reset()         { *m = Route{} }
+func (m *Route) String() string { return proto.CompactTextString(m) }
+func (*Route) ProtoMessage()    {}
+func (*Route) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{84}
+}
+
+func (m *Route) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Route.Unmarshal(m, b)
+}
+func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+}
+func (m *Route) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Route.Merge(m, src)
+}
+func (m *Route) XXX_Size() int {
+	return xxx_messageInfo_Route.Size(m)
+}
+func (m *Route) XXX_DiscardUnknown() {
+	xxx_messageInfo_Route.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Route proto.InternalMessageInfo
+
+func (m *Route) GetTotalTimeLock() uint32 {
 	if m != nil {
-		return m.LastUpdate
+		return m.TotalTimeLock
 	}
 	return 0
 }
 
-func (m *ChannelEdge) GetNode1Pub() string {
+// Deprecated: Do not use.
+func (m *Route) GetTotalFees() int64 {
 	if m != nil {
-		return m.Node1Pub
+		return m.TotalFees
 	}
-	return ""
+	return 0
 }
 
-func (m *ChannelEdge) GetNode2Pub() string {
+// Deprecated: Do not use.
+func (m *Route) GetTotalAmt() int64 {
 	if m != nil {
-		return m.Node2Pub
+		return m.TotalAmt
 	}
-	return ""
+	return 0
 }
 
-func (m *ChannelEdge) GetCapacity() int64 {
+func (m *Route) GetHops() []*Hop {
 	if m != nil {
-		return m.Capacity
+		return m.Hops
 	}
-	return 0
+	return nil
 }
 
-func (m *ChannelEdge) GetNode1Policy() *RoutingPolicy {
+func (m *Route) GetTotalFeesMsat() int64 {
 	if m != nil {
-		return m.Node1Policy
+		return m.TotalFeesMsat
 	}
-	return nil
+	return 0
 }
 
-func (m *ChannelEdge) GetNode2Policy() *RoutingPolicy {
+func (m *Route) GetTotalAmtMsat() int64 {
 	if m != nil {
-		return m.Node2Policy
+		return m.TotalAmtMsat
 	}
-	return nil
+	return 0
 }
 
-type ChannelGraphRequest struct {
-	//*
-	//Whether unannounced channels are included in the response or not. If set,
-	//unannounced channels are included. Unannounced channels are both private
-	//channels, and public channels that are not yet announced to the network.
-	IncludeUnannounced   bool     `protobuf:"varint,1,opt,name=include_unannounced,proto3" json:"include_unannounced,omitempty"`
+type NodeInfoRequest struct {
+	/// The 33-byte hex-encoded compressed public of the target node
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	/// If true, will include all known channels associated with the node.
+	IncludeChannels      bool     `protobuf:"varint,2,opt,name=include_channels,json=includeChannels,proto3" json:"include_channels,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelGraphRequest) Reset()         { *m = ChannelGraphRequest{} }
-func (m *ChannelGraphRequest) String() string { return proto.CompactTextString(m) }
-func (*ChannelGraphRequest) ProtoMessage()    {}
-func (*ChannelGraphRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{81}
+func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
+func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeInfoRequest) ProtoMessage()    {}
+func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{85}
 }
 
-func (m *ChannelGraphRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelGraphRequest.Unmarshal(m, b)
+func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeInfoRequest.Unmarshal(m, b)
 }
-func (m *ChannelGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelGraphRequest.Marshal(b, m, deterministic)
+func (m *NodeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeInfoRequest.Marshal(b, m, deterministic)
 }
-func (m *ChannelGraphRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelGraphRequest.Merge(m, src)
+func (m *NodeInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeInfoRequest.Merge(m, src)
 }
-func (m *ChannelGraphRequest) XXX_Size() int {
-	return xxx_messageInfo_ChannelGraphRequest.Size(m)
+func (m *NodeInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_NodeInfoRequest.Size(m)
 }
-func (m *ChannelGraphRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelGraphRequest.DiscardUnknown(m)
+func (m *NodeInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelGraphRequest proto.InternalMessageInfo
+var xxx_messageInfo_NodeInfoRequest proto.InternalMessageInfo
 
-func (m *ChannelGraphRequest) GetIncludeUnannounced() bool {
+func (m *NodeInfoRequest) GetPubKey() string {
 	if m != nil {
-		return m.IncludeUnannounced
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *NodeInfoRequest) GetIncludeChannels() bool {
+	if m != nil {
+		return m.IncludeChannels
 	}
 	return false
 }
 
-/// Returns a new instance of the directed channel graph.
-type ChannelGraph struct {
-	/// The list of `LightningNode`s in this channel graph
-	Nodes []*LightningNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	/// The list of `ChannelEdge`s in this channel graph
-	Edges                []*ChannelEdge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+type NodeInfo struct {
+	//*
+	//An individual vertex/node within the channel graph. A node is
+	//connected to other nodes by one or more channel edges emanating from it. As
+	//the graph is directed, a node will also have an incoming edge attached to
+	//it for each outgoing edge.
+	Node *LightningNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	/// The total number of channels for the node.
+	NumChannels uint32 `protobuf:"varint,2,opt,name=num_channels,proto3" json:"num_channels,omitempty"`
+	/// The sum of all channels capacity for the node, denominated in satoshis.
+	TotalCapacity int64 `protobuf:"varint,3,opt,name=total_capacity,proto3" json:"total_capacity,omitempty"`
+	/// A list of all public channels for the node.
+	Channels             []*ChannelEdge `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *ChannelGraph) Reset()         { *m = ChannelGraph{} }
-func (m *ChannelGraph) String() string { return proto.CompactTextString(m) }
-func (*ChannelGraph) ProtoMessage()    {}
-func (*ChannelGraph) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{82}
+func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
+func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
+func (*NodeInfo) ProtoMessage()    {}
+func (*NodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{86}
 }
 
-func (m *ChannelGraph) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelGraph.Unmarshal(m, b)
-}
-func (m *ChannelGraph) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelGraph.Marshal(b, m, deterministic)
+func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeInfo.Unmarshal(m, b)
 }
-func (m *ChannelGraph) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelGraph.Merge(m, src)
+func (m *NodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeInfo.Marshal(b, m, deterministic)
 }
-func (m *ChannelGraph) XXX_Size() int {
-	return xxx_messageInfo_ChannelGraph.Size(m)
+func (m *NodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeInfo.Merge(m, src)
 }
-func (m *ChannelGraph) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelGraph.DiscardUnknown(m)
+func (m *NodeInfo) XXX_Size() int {
+	return xxx_messageInfo_NodeInfo.Size(m)
+}
+func (m *NodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelGraph proto.InternalMessageInfo
+var xxx_messageInfo_NodeInfo proto.InternalMessageInfo
 
-func (m *ChannelGraph) GetNodes() []*LightningNode {
+func (m *NodeInfo) GetNode() *LightningNode {
 	if m != nil {
-		return m.Nodes
+		return m.Node
 	}
 	return nil
 }
 
-func (m *ChannelGraph) GetEdges() []*ChannelEdge {
+func (m *NodeInfo) GetNumChannels() uint32 {
 	if m != nil {
-		return m.Edges
+		return m.NumChannels
+	}
+	return 0
+}
+
+func (m *NodeInfo) GetTotalCapacity() int64 {
+	if m != nil {
+		return m.TotalCapacity
+	}
+	return 0
+}
+
+func (m *NodeInfo) GetChannels() []*ChannelEdge {
+	if m != nil {
+		return m.Channels
 	}
 	return nil
 }
 
-type ChanInfoRequest struct {
-	//*
-	//The unique channel ID for the channel. The first 3 bytes are the block
-	//height, the next 3 the index within the block, and the last 2 bytes are the
-	//output index for the channel.
-	ChanId               uint64   `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+//*
+//An individual vertex/node within the channel graph. A node is
+//connected to other nodes by one or more channel edges emanating from it. As the
+//graph is directed, a node will also have an incoming edge attached to it for
+//each outgoing edge.
+type LightningNode struct {
+	LastUpdate           uint32         `protobuf:"varint,1,opt,name=last_update,proto3" json:"last_update,omitempty"`
+	PubKey               string         `protobuf:"bytes,2,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	Alias                string         `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	Addresses            []*NodeAddress `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Color                string         `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *ChanInfoRequest) Reset()         { *m = ChanInfoRequest{} }
-func (m *ChanInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*ChanInfoRequest) ProtoMessage()    {}
-func (*ChanInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{83}
+func (m *LightningNode) Reset()         { *m = LightningNode{} }
+func (m *LightningNode) String() string { return proto.CompactTextString(m) }
+func (*LightningNode) ProtoMessage()    {}
+func (*LightningNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{87}
 }
 
-func (m *ChanInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChanInfoRequest.Unmarshal(m, b)
+func (m *LightningNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LightningNode.Unmarshal(m, b)
 }
-func (m *ChanInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChanInfoRequest.Marshal(b, m, deterministic)
+func (m *LightningNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LightningNode.Marshal(b, m, deterministic)
 }
-func (m *ChanInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChanInfoRequest.Merge(m, src)
+func (m *LightningNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightningNode.Merge(m, src)
 }
-func (m *ChanInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_ChanInfoRequest.Size(m)
+func (m *LightningNode) XXX_Size() int {
+	return xxx_messageInfo_LightningNode.Size(m)
 }
-func (m *ChanInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChanInfoRequest.DiscardUnknown(m)
+func (m *LightningNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightningNode.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChanInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_LightningNode proto.InternalMessageInfo
 
-func (m *ChanInfoRequest) GetChanId() uint64 {
+func (m *LightningNode) GetLastUpdate() uint32 {
 	if m != nil {
-		return m.ChanId
+		return m.LastUpdate
 	}
 	return 0
 }
 
-type NetworkInfoRequest struct {
+func (m *LightningNode) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *LightningNode) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+func (m *LightningNode) GetAddresses() []*NodeAddress {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *LightningNode) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+type NodeAddress struct {
+	Network              string   `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NetworkInfoRequest) Reset()         { *m = NetworkInfoRequest{} }
-func (m *NetworkInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*NetworkInfoRequest) ProtoMessage()    {}
-func (*NetworkInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{84}
+func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
+func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
+func (*NodeAddress) ProtoMessage()    {}
+func (*NodeAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{88}
 }
 
-func (m *NetworkInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NetworkInfoRequest.Unmarshal(m, b)
+func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeAddress.Unmarshal(m, b)
 }
-func (m *NetworkInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NetworkInfoRequest.Marshal(b, m, deterministic)
+func (m *NodeAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeAddress.Marshal(b, m, deterministic)
 }
-func (m *NetworkInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NetworkInfoRequest.Merge(m, src)
+func (m *NodeAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeAddress.Merge(m, src)
 }
-func (m *NetworkInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_NetworkInfoRequest.Size(m)
+func (m *NodeAddress) XXX_Size() int {
+	return xxx_messageInfo_NodeAddress.Size(m)
 }
-func (m *NetworkInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_NetworkInfoRequest.DiscardUnknown(m)
+func (m *NodeAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeAddress.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NetworkInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_NodeAddress proto.InternalMessageInfo
 
-type NetworkInfo struct {
-	GraphDiameter        uint32  `protobuf:"varint,1,opt,name=graph_diameter,proto3" json:"graph_diameter,omitempty"`
-	AvgOutDegree         float64 `protobuf:"fixed64,2,opt,name=avg_out_degree,proto3" json:"avg_out_degree,omitempty"`
-	MaxOutDegree         uint32  `protobuf:"varint,3,opt,name=max_out_degree,proto3" json:"max_out_degree,omitempty"`
-	NumNodes             uint32  `protobuf:"varint,4,opt,name=num_nodes,proto3" json:"num_nodes,omitempty"`
-	NumChannels          uint32  `protobuf:"varint,5,opt,name=num_channels,proto3" json:"num_channels,omitempty"`
-	TotalNetworkCapacity int64   `protobuf:"varint,6,opt,name=total_network_capacity,proto3" json:"total_network_capacity,omitempty"`
-	AvgChannelSize       float64 `protobuf:"fixed64,7,opt,name=avg_channel_size,proto3" json:"avg_channel_size,omitempty"`
-	MinChannelSize       int64   `protobuf:"varint,8,opt,name=min_channel_size,proto3" json:"min_channel_size,omitempty"`
-	MaxChannelSize       int64   `protobuf:"varint,9,opt,name=max_channel_size,proto3" json:"max_channel_size,omitempty"`
-	MedianChannelSizeSat int64   `protobuf:"varint,10,opt,name=median_channel_size_sat,proto3" json:"median_channel_size_sat,omitempty"`
-	// The number of edges marked as zombies.
-	NumZombieChans       uint64   `protobuf:"varint,11,opt,name=num_zombie_chans,proto3" json:"num_zombie_chans,omitempty"`
+func (m *NodeAddress) GetNetwork() string {
+	if m != nil {
+		return m.Network
+	}
+	return ""
+}
+
+func (m *NodeAddress) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type RoutingPolicy struct {
+	TimeLockDelta        uint32   `protobuf:"varint,1,opt,name=time_lock_delta,proto3" json:"time_lock_delta,omitempty"`
+	MinHtlc              int64    `protobuf:"varint,2,opt,name=min_htlc,proto3" json:"min_htlc,omitempty"`
+	FeeBaseMsat          int64    `protobuf:"varint,3,opt,name=fee_base_msat,proto3" json:"fee_base_msat,omitempty"`
+	FeeRateMilliMsat     int64    `protobuf:"varint,4,opt,name=fee_rate_milli_msat,proto3" json:"fee_rate_milli_msat,omitempty"`
+	Disabled             bool     `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	MaxHtlcMsat          uint64   `protobuf:"varint,6,opt,name=max_htlc_msat,proto3" json:"max_htlc_msat,omitempty"`
+	LastUpdate           uint32   `protobuf:"varint,7,opt,name=last_update,proto3" json:"last_update,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NetworkInfo) Reset()         { *m = NetworkInfo{} }
-func (m *NetworkInfo) String() string { return proto.CompactTextString(m) }
-func (*NetworkInfo) ProtoMessage()    {}
-func (*NetworkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{85}
+func (m *RoutingPolicy) Reset()         { *m = RoutingPolicy{} }
+func (m *RoutingPolicy) String() string { return proto.CompactTextString(m) }
+func (*RoutingPolicy) ProtoMessage()    {}
+func (*RoutingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{89}
 }
 
-func (m *NetworkInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NetworkInfo.Unmarshal(m, b)
+func (m *RoutingPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoutingPolicy.Unmarshal(m, b)
 }
-func (m *NetworkInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NetworkInfo.Marshal(b, m, deterministic)
+func (m *RoutingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoutingPolicy.Marshal(b, m, deterministic)
 }
-func (m *NetworkInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NetworkInfo.Merge(m, src)
+func (m *RoutingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoutingPolicy.Merge(m, src)
 }
-func (m *NetworkInfo) XXX_Size() int {
-	return xxx_messageInfo_NetworkInfo.Size(m)
+func (m *RoutingPolicy) XXX_Size() int {
+	return xxx_messageInfo_RoutingPolicy.Size(m)
 }
-func (m *NetworkInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_NetworkInfo.DiscardUnknown(m)
+func (m *RoutingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoutingPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NetworkInfo proto.InternalMessageInfo
-
-func (m *NetworkInfo) GetGraphDiameter() uint32 {
-	if m != nil {
-		return m.GraphDiameter
-	}
-	return 0
-}
+var xxx_messageInfo_RoutingPolicy proto.InternalMessageInfo
 
-func (m *NetworkInfo) GetAvgOutDegree() float64 {
+func (m *RoutingPolicy) GetTimeLockDelta() uint32 {
 	if m != nil {
-		return m.AvgOutDegree
+		return m.TimeLockDelta
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetMaxOutDegree() uint32 {
+func (m *RoutingPolicy) GetMinHtlc() int64 {
 	if m != nil {
-		return m.MaxOutDegree
+		return m.MinHtlc
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetNumNodes() uint32 {
+func (m *RoutingPolicy) GetFeeBaseMsat() int64 {
 	if m != nil {
-		return m.NumNodes
+		return m.FeeBaseMsat
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetNumChannels() uint32 {
+func (m *RoutingPolicy) GetFeeRateMilliMsat() int64 {
 	if m != nil {
-		return m.NumChannels
+		return m.FeeRateMilliMsat
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetTotalNetworkCapacity() int64 {
+func (m *RoutingPolicy) GetDisabled() bool {
 	if m != nil {
-		return m.TotalNetworkCapacity
+		return m.Disabled
 	}
-	return 0
+	return false
 }
 
-func (m *NetworkInfo) GetAvgChannelSize() float64 {
+func (m *RoutingPolicy) GetMaxHtlcMsat() uint64 {
 	if m != nil {
-		return m.AvgChannelSize
+		return m.MaxHtlcMsat
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetMinChannelSize() int64 {
+func (m *RoutingPolicy) GetLastUpdate() uint32 {
 	if m != nil {
-		return m.MinChannelSize
+		return m.LastUpdate
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetMaxChannelSize() int64 {
-	if m != nil {
-		return m.MaxChannelSize
-	}
-	return 0
+//*
+//A fully authenticated channel along with all its unique attributes.
+//Once an authenticated channel announcement has been processed on the network,
+//then an instance of ChannelEdgeInfo encapsulating the channels attributes is
+//stored. The other portions relevant to routing policy of a channel are stored
+//within a ChannelEdgePolicy for each direction of the channel.
+type ChannelEdge struct {
+	//*
+	//The unique channel ID for the channel. The first 3 bytes are the block
+	//height, the next 3 the index within the block, and the last 2 bytes are the
+	//output index for the channel.
+	ChannelId            uint64         `protobuf:"varint,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	ChanPoint            string         `protobuf:"bytes,2,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
+	LastUpdate           uint32         `protobuf:"varint,3,opt,name=last_update,proto3" json:"last_update,omitempty"` // Deprecated: Do not use.
+	Node1Pub             string         `protobuf:"bytes,4,opt,name=node1_pub,proto3" json:"node1_pub,omitempty"`
+	Node2Pub             string         `protobuf:"bytes,5,opt,name=node2_pub,proto3" json:"node2_pub,omitempty"`
+	Capacity             int64          `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Node1Policy          *RoutingPolicy `protobuf:"bytes,7,opt,name=node1_policy,proto3" json:"node1_policy,omitempty"`
+	Node2Policy          *RoutingPolicy `protobuf:"bytes,8,opt,name=node2_policy,proto3" json:"node2_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *NetworkInfo) GetMedianChannelSizeSat() int64 {
+func (m *ChannelEdge) Reset()         { *m = ChannelEdge{} }
+func (m *ChannelEdge) String() string { return proto.CompactTextString(m) }
+func (*ChannelEdge) ProtoMessage()    {}
+func (*ChannelEdge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{90}
+}
+
+func (m *ChannelEdge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEdge.Unmarshal(m, b)
+}
+func (m *ChannelEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEdge.Marshal(b, m, deterministic)
+}
+func (m *ChannelEdge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEdge.Merge(m, src)
+}
+func (m *ChannelEdge) XXX_Size() int {
+	return xxx_messageInfo_ChannelEdge.Size(m)
+}
+func (m *ChannelEdge) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEdge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEdge proto.InternalMessageInfo
+
+func (m *ChannelEdge) GetChannelId() uint64 {
 	if m != nil {
-		return m.MedianChannelSizeSat
+		return m.ChannelId
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetNumZombieChans() uint64 {
+func (m *ChannelEdge) GetChanPoint() string {
 	if m != nil {
-		return m.NumZombieChans
+		return m.ChanPoint
 	}
-	return 0
+	return ""
 }
 
-type StopRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Deprecated: Do not use.
+func (m *ChannelEdge) GetLastUpdate() uint32 {
+	if m != nil {
+		return m.LastUpdate
+	}
+	return 0
 }
 
-func (m *StopRequest) Reset()         { *m = StopRequest{} }
-func (m *StopRequest) String() string { return proto.CompactTextString(m) }
-func (*StopRequest) ProtoMessage()    {}
-func (*StopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{86}
+func (m *ChannelEdge) GetNode1Pub() string {
+	if m != nil {
+		return m.Node1Pub
+	}
+	return ""
 }
 
-func (m *StopRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StopRequest.Unmarshal(m, b)
-}
-func (m *StopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StopRequest.Marshal(b, m, deterministic)
-}
-func (m *StopRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StopRequest.Merge(m, src)
-}
-func (m *StopRequest) XXX_Size() int {
-	return xxx_messageInfo_StopRequest.Size(m)
-}
-func (m *StopRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StopRequest.DiscardUnknown(m)
+func (m *ChannelEdge) GetNode2Pub() string {
+	if m != nil {
+		return m.Node2Pub
+	}
+	return ""
 }
 
-var xxx_messageInfo_StopRequest proto.InternalMessageInfo
-
-type StopResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ChannelEdge) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
 }
 
-func (m *StopResponse) Reset()         { *m = StopResponse{} }
-func (m *StopResponse) String() string { return proto.CompactTextString(m) }
-func (*StopResponse) ProtoMessage()    {}
-func (*StopResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{87}
+func (m *ChannelEdge) GetNode1Policy() *RoutingPolicy {
+	if m != nil {
+		return m.Node1Policy
+	}
+	return nil
 }
 
-func (m *StopResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StopResponse.Unmarshal(m, b)
-}
-func (m *StopResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StopResponse.Marshal(b, m, deterministic)
-}
-func (m *StopResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StopResponse.Merge(m, src)
-}
-func (m *StopResponse) XXX_Size() int {
-	return xxx_messageInfo_StopResponse.Size(m)
-}
-func (m *StopResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StopResponse.DiscardUnknown(m)
+func (m *ChannelEdge) GetNode2Policy() *RoutingPolicy {
+	if m != nil {
+		return m.Node2Policy
+	}
+	return nil
 }
 
-var xxx_messageInfo_StopResponse proto.InternalMessageInfo
-
-type GraphTopologySubscription struct {
+type ChannelGraphRequest struct {
+	//*
+	//Whether unannounced channels are included in the response or not. If set,
+	//unannounced channels are included. Unannounced channels are both private
+	//channels, and public channels that are not yet announced to the network.
+	IncludeUnannounced   bool     `protobuf:"varint,1,opt,name=include_unannounced,proto3" json:"include_unannounced,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GraphTopologySubscription) Reset()         { *m = GraphTopologySubscription{} }
-func (m *GraphTopologySubscription) String() string { return proto.CompactTextString(m) }
-func (*GraphTopologySubscription) ProtoMessage()    {}
-func (*GraphTopologySubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{88}
+func (m *ChannelGraphRequest) Reset()         { *m = ChannelGraphRequest{} }
+func (m *ChannelGraphRequest) String() string { return proto.CompactTextString(m) }
+func (*ChannelGraphRequest) ProtoMessage()    {}
+func (*ChannelGraphRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{91}
 }
 
-func (m *GraphTopologySubscription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GraphTopologySubscription.Unmarshal(m, b)
+func (m *ChannelGraphRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelGraphRequest.Unmarshal(m, b)
 }
-func (m *GraphTopologySubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GraphTopologySubscription.Marshal(b, m, deterministic)
+func (m *ChannelGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelGraphRequest.Marshal(b, m, deterministic)
 }
-func (m *GraphTopologySubscription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GraphTopologySubscription.Merge(m, src)
+func (m *ChannelGraphRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelGraphRequest.Merge(m, src)
 }
-func (m *GraphTopologySubscription) XXX_Size() int {
-	return xxx_messageInfo_GraphTopologySubscription.Size(m)
+func (m *ChannelGraphRequest) XXX_Size() int {
+	return xxx_messageInfo_ChannelGraphRequest.Size(m)
 }
-func (m *GraphTopologySubscription) XXX_DiscardUnknown() {
-	xxx_messageInfo_GraphTopologySubscription.DiscardUnknown(m)
+func (m *ChannelGraphRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelGraphRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GraphTopologySubscription proto.InternalMessageInfo
+var xxx_messageInfo_ChannelGraphRequest proto.InternalMessageInfo
 
-type GraphTopologyUpdate struct {
-	NodeUpdates          []*NodeUpdate          `protobuf:"bytes,1,rep,name=node_updates,json=nodeUpdates,proto3" json:"node_updates,omitempty"`
-	ChannelUpdates       []*ChannelEdgeUpdate   `protobuf:"bytes,2,rep,name=channel_updates,json=channelUpdates,proto3" json:"channel_updates,omitempty"`
-	ClosedChans          []*ClosedChannelUpdate `protobuf:"bytes,3,rep,name=closed_chans,json=closedChans,proto3" json:"closed_chans,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+func (m *ChannelGraphRequest) GetIncludeUnannounced() bool {
+	if m != nil {
+		return m.IncludeUnannounced
+	}
+	return false
 }
 
-func (m *GraphTopologyUpdate) Reset()         { *m = GraphTopologyUpdate{} }
-func (m *GraphTopologyUpdate) String() string { return proto.CompactTextString(m) }
-func (*GraphTopologyUpdate) ProtoMessage()    {}
-func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{89}
+/// Returns a new instance of the directed channel graph.
+type ChannelGraph struct {
+	/// The list of `LightningNode`s in this channel graph
+	Nodes []*LightningNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	/// The list of `ChannelEdge`s in this channel graph
+	Edges                []*ChannelEdge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *GraphTopologyUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GraphTopologyUpdate.Unmarshal(m, b)
+func (m *ChannelGraph) Reset()         { *m = ChannelGraph{} }
+func (m *ChannelGraph) String() string { return proto.CompactTextString(m) }
+func (*ChannelGraph) ProtoMessage()    {}
+func (*ChannelGraph) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{92}
 }
-func (m *GraphTopologyUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GraphTopologyUpdate.Marshal(b, m, deterministic)
+
+func (m *ChannelGraph) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelGraph.Unmarshal(m, b)
 }
-func (m *GraphTopologyUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GraphTopologyUpdate.Merge(m, src)
+func (m *ChannelGraph) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelGraph.Marshal(b, m, deterministic)
 }
-func (m *GraphTopologyUpdate) XXX_Size() int {
-	return xxx_messageInfo_GraphTopologyUpdate.Size(m)
+func (m *ChannelGraph) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelGraph.Merge(m, src)
 }
-func (m *GraphTopologyUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_GraphTopologyUpdate.DiscardUnknown(m)
+func (m *ChannelGraph) XXX_Size() int {
+	return xxx_messageInfo_ChannelGraph.Size(m)
 }
-
-var xxx_messageInfo_GraphTopologyUpdate proto.InternalMessageInfo
-
-func (m *GraphTopologyUpdate) GetNodeUpdates() []*NodeUpdate {
-	if m != nil {
-		return m.NodeUpdates
-	}
-	return nil
+func (m *ChannelGraph) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelGraph.DiscardUnknown(m)
 }
 
-func (m *GraphTopologyUpdate) GetChannelUpdates() []*ChannelEdgeUpdate {
+var xxx_messageInfo_ChannelGraph proto.InternalMessageInfo
+
+func (m *ChannelGraph) GetNodes() []*LightningNode {
 	if m != nil {
-		return m.ChannelUpdates
+		return m.Nodes
 	}
 	return nil
 }
 
-func (m *GraphTopologyUpdate) GetClosedChans() []*ClosedChannelUpdate {
+func (m *ChannelGraph) GetEdges() []*ChannelEdge {
 	if m != nil {
-		return m.ClosedChans
+		return m.Edges
 	}
 	return nil
 }
 
-type NodeUpdate struct {
-	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	IdentityKey          string   `protobuf:"bytes,2,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
-	GlobalFeatures       []byte   `protobuf:"bytes,3,opt,name=global_features,json=globalFeatures,proto3" json:"global_features,omitempty"`
-	Alias                string   `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
-	Color                string   `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+type ChanInfoRequest struct {
+	//*
+	//The unique channel ID for the channel. The first 3 bytes are the block
+	//height, the next 3 the index within the block, and the last 2 bytes are the
+	//output index for the channel.
+	ChanId               uint64   `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NodeUpdate) Reset()         { *m = NodeUpdate{} }
-func (m *NodeUpdate) String() string { return proto.CompactTextString(m) }
-func (*NodeUpdate) ProtoMessage()    {}
-func (*NodeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{90}
+func (m *ChanInfoRequest) Reset()         { *m = ChanInfoRequest{} }
+func (m *ChanInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*ChanInfoRequest) ProtoMessage()    {}
+func (*ChanInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{93}
 }
 
-func (m *NodeUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NodeUpdate.Unmarshal(m, b)
+func (m *ChanInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChanInfoRequest.Unmarshal(m, b)
 }
-func (m *NodeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NodeUpdate.Marshal(b, m, deterministic)
+func (m *ChanInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChanInfoRequest.Marshal(b, m, deterministic)
 }
-func (m *NodeUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeUpdate.Merge(m, src)
+func (m *ChanInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChanInfoRequest.Merge(m, src)
 }
-func (m *NodeUpdate) XXX_Size() int {
-	return xxx_messageInfo_NodeUpdate.Size(m)
+func (m *ChanInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_ChanInfoRequest.Size(m)
 }
-func (m *NodeUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeUpdate.DiscardUnknown(m)
+func (m *ChanInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChanInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeUpdate proto.InternalMessageInfo
+var xxx_messageInfo_ChanInfoRequest proto.InternalMessageInfo
 
-func (m *NodeUpdate) GetAddresses() []string {
+func (m *ChanInfoRequest) GetChanId() uint64 {
 	if m != nil {
-		return m.Addresses
+		return m.ChanId
 	}
-	return nil
+	return 0
 }
 
-func (m *NodeUpdate) GetIdentityKey() string {
-	if m != nil {
-		return m.IdentityKey
-	}
-	return ""
+type NetworkInfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NodeUpdate) GetGlobalFeatures() []byte {
-	if m != nil {
-		return m.GlobalFeatures
-	}
-	return nil
+func (m *NetworkInfoRequest) Reset()         { *m = NetworkInfoRequest{} }
+func (m *NetworkInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*NetworkInfoRequest) ProtoMessage()    {}
+func (*NetworkInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{94}
 }
 
-func (m *NodeUpdate) GetAlias() string {
-	if m != nil {
-		return m.Alias
-	}
-	return ""
+func (m *NetworkInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkInfoRequest.Unmarshal(m, b)
 }
-
-func (m *NodeUpdate) GetColor() string {
-	if m != nil {
-		return m.Color
-	}
-	return ""
+func (m *NetworkInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *NetworkInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkInfoRequest.Merge(m, src)
+}
+func (m *NetworkInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_NetworkInfoRequest.Size(m)
+}
+func (m *NetworkInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkInfoRequest.DiscardUnknown(m)
 }
 
-type ChannelEdgeUpdate struct {
-	//*
-	//The unique channel ID for the channel. The first 3 bytes are the block
-	//height, the next 3 the index within the block, and the last 2 bytes are the
-	//output index for the channel.
-	ChanId               uint64         `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
-	ChanPoint            *ChannelPoint  `protobuf:"bytes,2,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
-	Capacity             int64          `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	RoutingPolicy        *RoutingPolicy `protobuf:"bytes,4,opt,name=routing_policy,json=routingPolicy,proto3" json:"routing_policy,omitempty"`
-	AdvertisingNode      string         `protobuf:"bytes,5,opt,name=advertising_node,json=advertisingNode,proto3" json:"advertising_node,omitempty"`
-	ConnectingNode       string         `protobuf:"bytes,6,opt,name=connecting_node,json=connectingNode,proto3" json:"connecting_node,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+var xxx_messageInfo_NetworkInfoRequest proto.InternalMessageInfo
+
+type NetworkInfo struct {
+	GraphDiameter        uint32  `protobuf:"varint,1,opt,name=graph_diameter,proto3" json:"graph_diameter,omitempty"`
+	AvgOutDegree         float64 `protobuf:"fixed64,2,opt,name=avg_out_degree,proto3" json:"avg_out_degree,omitempty"`
+	MaxOutDegree         uint32  `protobuf:"varint,3,opt,name=max_out_degree,proto3" json:"max_out_degree,omitempty"`
+	NumNodes             uint32  `protobuf:"varint,4,opt,name=num_nodes,proto3" json:"num_nodes,omitempty"`
+	NumChannels          uint32  `protobuf:"varint,5,opt,name=num_channels,proto3" json:"num_channels,omitempty"`
+	TotalNetworkCapacity int64   `protobuf:"varint,6,opt,name=total_network_capacity,proto3" json:"total_network_capacity,omitempty"`
+	AvgChannelSize       float64 `protobuf:"fixed64,7,opt,name=avg_channel_size,proto3" json:"avg_channel_size,omitempty"`
+	MinChannelSize       int64   `protobuf:"varint,8,opt,name=min_channel_size,proto3" json:"min_channel_size,omitempty"`
+	MaxChannelSize       int64   `protobuf:"varint,9,opt,name=max_channel_size,proto3" json:"max_channel_size,omitempty"`
+	MedianChannelSizeSat int64   `protobuf:"varint,10,opt,name=median_channel_size_sat,proto3" json:"median_channel_size_sat,omitempty"`
+	// The number of edges marked as zombies.
+	NumZombieChans       uint64   `protobuf:"varint,11,opt,name=num_zombie_chans,proto3" json:"num_zombie_chans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelEdgeUpdate) Reset()         { *m = ChannelEdgeUpdate{} }
-func (m *ChannelEdgeUpdate) String() string { return proto.CompactTextString(m) }
-func (*ChannelEdgeUpdate) ProtoMessage()    {}
-func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{91}
+func (m *NetworkInfo) Reset()         { *m = NetworkInfo{} }
+func (m *NetworkInfo) String() string { return proto.CompactTextString(m) }
+func (*NetworkInfo) ProtoMessage()    {}
+func (*NetworkInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{95}
 }
 
-func (m *ChannelEdgeUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelEdgeUpdate.Unmarshal(m, b)
+func (m *NetworkInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkInfo.Unmarshal(m, b)
 }
-func (m *ChannelEdgeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelEdgeUpdate.Marshal(b, m, deterministic)
+func (m *NetworkInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkInfo.Marshal(b, m, deterministic)
 }
-func (m *ChannelEdgeUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelEdgeUpdate.Merge(m, src)
+func (m *NetworkInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkInfo.Merge(m, src)
 }
-func (m *ChannelEdgeUpdate) XXX_Size() int {
-	return xxx_messageInfo_ChannelEdgeUpdate.Size(m)
+func (m *NetworkInfo) XXX_Size() int {
+	return xxx_messageInfo_NetworkInfo.Size(m)
 }
-func (m *ChannelEdgeUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelEdgeUpdate.DiscardUnknown(m)
+func (m *NetworkInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelEdgeUpdate proto.InternalMessageInfo
+var xxx_messageInfo_NetworkInfo proto.InternalMessageInfo
 
-func (m *ChannelEdgeUpdate) GetChanId() uint64 {
+func (m *NetworkInfo) GetGraphDiameter() uint32 {
 	if m != nil {
-		return m.ChanId
+		return m.GraphDiameter
 	}
 	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetChanPoint() *ChannelPoint {
+func (m *NetworkInfo) GetAvgOutDegree() float64 {
 	if m != nil {
-		return m.ChanPoint
+		return m.AvgOutDegree
 	}
-	return nil
+	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetCapacity() int64 {
+func (m *NetworkInfo) GetMaxOutDegree() uint32 {
 	if m != nil {
-		return m.Capacity
+		return m.MaxOutDegree
 	}
 	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetRoutingPolicy() *RoutingPolicy {
+func (m *NetworkInfo) GetNumNodes() uint32 {
 	if m != nil {
-		return m.RoutingPolicy
+		return m.NumNodes
 	}
-	return nil
+	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetAdvertisingNode() string {
+func (m *NetworkInfo) GetNumChannels() uint32 {
 	if m != nil {
-		return m.AdvertisingNode
+		return m.NumChannels
 	}
-	return ""
+	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetConnectingNode() string {
+func (m *NetworkInfo) GetTotalNetworkCapacity() int64 {
 	if m != nil {
-		return m.ConnectingNode
+		return m.TotalNetworkCapacity
 	}
-	return ""
-}
-
-type ClosedChannelUpdate struct {
-	//*
-	//The unique channel ID for the channel. The first 3 bytes are the block
-	//height, the next 3 the index within the block, and the last 2 bytes are the
-	//output index for the channel.
-	ChanId               uint64        `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
-	Capacity             int64         `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	ClosedHeight         uint32        `protobuf:"varint,3,opt,name=closed_height,json=closedHeight,proto3" json:"closed_height,omitempty"`
-	ChanPoint            *ChannelPoint `protobuf:"bytes,4,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *ClosedChannelUpdate) Reset()         { *m = ClosedChannelUpdate{} }
-func (m *ClosedChannelUpdate) String() string { return proto.CompactTextString(m) }
-func (*ClosedChannelUpdate) ProtoMessage()    {}
-func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{92}
+	return 0
 }
 
-func (m *ClosedChannelUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ClosedChannelUpdate.Unmarshal(m, b)
-}
-func (m *ClosedChannelUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ClosedChannelUpdate.Marshal(b, m, deterministic)
-}
-func (m *ClosedChannelUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClosedChannelUpdate.Merge(m, src)
-}
-func (m *ClosedChannelUpdate) XXX_Size() int {
-	return xxx_messageInfo_ClosedChannelUpdate.Size(m)
-}
-func (m *ClosedChannelUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClosedChannelUpdate.DiscardUnknown(m)
+func (m *NetworkInfo) GetAvgChannelSize() float64 {
+	if m != nil {
+		return m.AvgChannelSize
+	}
+	return 0
 }
 
-var xxx_messageInfo_ClosedChannelUpdate proto.InternalMessageInfo
-
-func (m *ClosedChannelUpdate) GetChanId() uint64 {
+func (m *NetworkInfo) GetMinChannelSize() int64 {
 	if m != nil {
-		return m.ChanId
+		return m.MinChannelSize
 	}
 	return 0
 }
 
-func (m *ClosedChannelUpdate) GetCapacity() int64 {
+func (m *NetworkInfo) GetMaxChannelSize() int64 {
 	if m != nil {
-		return m.Capacity
+		return m.MaxChannelSize
 	}
 	return 0
 }
 
-func (m *ClosedChannelUpdate) GetClosedHeight() uint32 {
+func (m *NetworkInfo) GetMedianChannelSizeSat() int64 {
 	if m != nil {
-		return m.ClosedHeight
+		return m.MedianChannelSizeSat
 	}
 	return 0
 }
 
-func (m *ClosedChannelUpdate) GetChanPoint() *ChannelPoint {
+func (m *NetworkInfo) GetNumZombieChans() uint64 {
 	if m != nil {
-		return m.ChanPoint
+		return m.NumZombieChans
 	}
-	return nil
+	return 0
 }
 
-type HopHint struct {
-	/// The public key of the node at the start of the channel.
-	NodeId string `protobuf:"bytes,1,opt,name=node_id,proto3" json:"node_id,omitempty"`
-	/// The unique identifier of the channel.
-	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
-	/// The base fee of the channel denominated in millisatoshis.
-	FeeBaseMsat uint32 `protobuf:"varint,3,opt,name=fee_base_msat,proto3" json:"fee_base_msat,omitempty"`
-	//*
-	//The fee rate of the channel for sending one satoshi across it denominated in
-	//millionths of a satoshi.
-	FeeProportionalMillionths uint32 `protobuf:"varint,4,opt,name=fee_proportional_millionths,proto3" json:"fee_proportional_millionths,omitempty"`
-	/// The time-lock delta of the channel.
-	CltvExpiryDelta      uint32   `protobuf:"varint,5,opt,name=cltv_expiry_delta,proto3" json:"cltv_expiry_delta,omitempty"`
+type StopRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopRequest) Reset()         { *m = StopRequest{} }
+func (m *StopRequest) String() string { return proto.CompactTextString(m) }
+func (*StopRequest) ProtoMessage()    {}
+func (*StopRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{96}
+}
+
+func (m *StopRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopRequest.Unmarshal(m, b)
+}
+func (m *StopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopRequest.Marshal(b, m, deterministic)
+}
+func (m *StopRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopRequest.Merge(m, src)
+}
+func (m *StopRequest) XXX_Size() int {
+	return xxx_messageInfo_StopRequest.Size(m)
+}
+func (m *StopRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopRequest proto.InternalMessageInfo
+
+type StopResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopResponse) Reset()         { *m = StopResponse{} }
+func (m *StopResponse) String() string { return proto.CompactTextString(m) }
+func (*StopResponse) ProtoMessage()    {}
+func (*StopResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{97}
+}
+
+func (m *StopResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopResponse.Unmarshal(m, b)
+}
+func (m *StopResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopResponse.Marshal(b, m, deterministic)
+}
+func (m *StopResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopResponse.Merge(m, src)
+}
+func (m *StopResponse) XXX_Size() int {
+	return xxx_messageInfo_StopResponse.Size(m)
+}
+func (m *StopResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopResponse proto.InternalMessageInfo
+
+type GraphTopologySubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphTopologySubscription) Reset()         { *m = GraphTopologySubscription{} }
+func (m *GraphTopologySubscription) String() string { return proto.CompactTextString(m) }
+func (*GraphTopologySubscription) ProtoMessage()    {}
+func (*GraphTopologySubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{98}
+}
+
+func (m *GraphTopologySubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphTopologySubscription.Unmarshal(m, b)
+}
+func (m *GraphTopologySubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphTopologySubscription.Marshal(b, m, deterministic)
+}
+func (m *GraphTopologySubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphTopologySubscription.Merge(m, src)
+}
+func (m *GraphTopologySubscription) XXX_Size() int {
+	return xxx_messageInfo_GraphTopologySubscription.Size(m)
+}
+func (m *GraphTopologySubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphTopologySubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphTopologySubscription proto.InternalMessageInfo
+
+type GraphTopologyUpdate struct {
+	NodeUpdates          []*NodeUpdate          `protobuf:"bytes,1,rep,name=node_updates,json=nodeUpdates,proto3" json:"node_updates,omitempty"`
+	ChannelUpdates       []*ChannelEdgeUpdate   `protobuf:"bytes,2,rep,name=channel_updates,json=channelUpdates,proto3" json:"channel_updates,omitempty"`
+	ClosedChans          []*ClosedChannelUpdate `protobuf:"bytes,3,rep,name=closed_chans,json=closedChans,proto3" json:"closed_chans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GraphTopologyUpdate) Reset()         { *m = GraphTopologyUpdate{} }
+func (m *GraphTopologyUpdate) String() string { return proto.CompactTextString(m) }
+func (*GraphTopologyUpdate) ProtoMessage()    {}
+func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{99}
+}
+
+func (m *GraphTopologyUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphTopologyUpdate.Unmarshal(m, b)
+}
+func (m *GraphTopologyUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphTopologyUpdate.Marshal(b, m, deterministic)
+}
+func (m *GraphTopologyUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphTopologyUpdate.Merge(m, src)
+}
+func (m *GraphTopologyUpdate) XXX_Size() int {
+	return xxx_messageInfo_GraphTopologyUpdate.Size(m)
+}
+func (m *GraphTopologyUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphTopologyUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphTopologyUpdate proto.InternalMessageInfo
+
+func (m *GraphTopologyUpdate) GetNodeUpdates() []*NodeUpdate {
+	if m != nil {
+		return m.NodeUpdates
+	}
+	return nil
+}
+
+func (m *GraphTopologyUpdate) GetChannelUpdates() []*ChannelEdgeUpdate {
+	if m != nil {
+		return m.ChannelUpdates
+	}
+	return nil
+}
+
+func (m *GraphTopologyUpdate) GetClosedChans() []*ClosedChannelUpdate {
+	if m != nil {
+		return m.ClosedChans
+	}
+	return nil
+}
+
+type NodeUpdate struct {
+	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	IdentityKey          string   `protobuf:"bytes,2,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
+	GlobalFeatures       []byte   `protobuf:"bytes,3,opt,name=global_features,json=globalFeatures,proto3" json:"global_features,omitempty"`
+	Alias                string   `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
+	Color                string   `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeUpdate) Reset()         { *m = NodeUpdate{} }
+func (m *NodeUpdate) String() string { return proto.CompactTextString(m) }
+func (*NodeUpdate) ProtoMessage()    {}
+func (*NodeUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{100}
+}
+
+func (m *NodeUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeUpdate.Unmarshal(m, b)
+}
+func (m *NodeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeUpdate.Marshal(b, m, deterministic)
+}
+func (m *NodeUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeUpdate.Merge(m, src)
+}
+func (m *NodeUpdate) XXX_Size() int {
+	return xxx_messageInfo_NodeUpdate.Size(m)
+}
+func (m *NodeUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeUpdate proto.InternalMessageInfo
+
+func (m *NodeUpdate) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *NodeUpdate) GetIdentityKey() string {
+	if m != nil {
+		return m.IdentityKey
+	}
+	return ""
+}
+
+func (m *NodeUpdate) GetGlobalFeatures() []byte {
+	if m != nil {
+		return m.GlobalFeatures
+	}
+	return nil
+}
+
+func (m *NodeUpdate) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+func (m *NodeUpdate) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+type ChannelEdgeUpdate struct {
+	//*
+	//The unique channel ID for the channel. The first 3 bytes are the block
+	//height, the next 3 the index within the block, and the last 2 bytes are the
+	//output index for the channel.
+	ChanId               uint64         `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	ChanPoint            *ChannelPoint  `protobuf:"bytes,2,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	Capacity             int64          `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	RoutingPolicy        *RoutingPolicy `protobuf:"bytes,4,opt,name=routing_policy,json=routingPolicy,proto3" json:"routing_policy,omitempty"`
+	AdvertisingNode      string         `protobuf:"bytes,5,opt,name=advertising_node,json=advertisingNode,proto3" json:"advertising_node,omitempty"`
+	ConnectingNode       string         `protobuf:"bytes,6,opt,name=connecting_node,json=connectingNode,proto3" json:"connecting_node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ChannelEdgeUpdate) Reset()         { *m = ChannelEdgeUpdate{} }
+func (m *ChannelEdgeUpdate) String() string { return proto.CompactTextString(m) }
+func (*ChannelEdgeUpdate) ProtoMessage()    {}
+func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{101}
+}
+
+func (m *ChannelEdgeUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEdgeUpdate.Unmarshal(m, b)
+}
+func (m *ChannelEdgeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEdgeUpdate.Marshal(b, m, deterministic)
+}
+func (m *ChannelEdgeUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEdgeUpdate.Merge(m, src)
+}
+func (m *ChannelEdgeUpdate) XXX_Size() int {
+	return xxx_messageInfo_ChannelEdgeUpdate.Size(m)
+}
+func (m *ChannelEdgeUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEdgeUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEdgeUpdate proto.InternalMessageInfo
+
+func (m *ChannelEdgeUpdate) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *ChannelEdgeUpdate) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+func (m *ChannelEdgeUpdate) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *ChannelEdgeUpdate) GetRoutingPolicy() *RoutingPolicy {
+	if m != nil {
+		return m.RoutingPolicy
+	}
+	return nil
+}
+
+func (m *ChannelEdgeUpdate) GetAdvertisingNode() string {
+	if m != nil {
+		return m.AdvertisingNode
+	}
+	return ""
+}
+
+func (m *ChannelEdgeUpdate) GetConnectingNode() string {
+	if m != nil {
+		return m.ConnectingNode
+	}
+	return ""
+}
+
+type ClosedChannelUpdate struct {
+	//*
+	//The unique channel ID for the channel. The first 3 bytes are the block
+	//height, the next 3 the index within the block, and the last 2 bytes are the
+	//output index for the channel.
+	ChanId               uint64        `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	Capacity             int64         `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	ClosedHeight         uint32        `protobuf:"varint,3,opt,name=closed_height,json=closedHeight,proto3" json:"closed_height,omitempty"`
+	ChanPoint            *ChannelPoint `protobuf:"bytes,4,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ClosedChannelUpdate) Reset()         { *m = ClosedChannelUpdate{} }
+func (m *ClosedChannelUpdate) String() string { return proto.CompactTextString(m) }
+func (*ClosedChannelUpdate) ProtoMessage()    {}
+func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{102}
+}
+
+func (m *ClosedChannelUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClosedChannelUpdate.Unmarshal(m, b)
+}
+func (m *ClosedChannelUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClosedChannelUpdate.Marshal(b, m, deterministic)
+}
+func (m *ClosedChannelUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClosedChannelUpdate.Merge(m, src)
+}
+func (m *ClosedChannelUpdate) XXX_Size() int {
+	return xxx_messageInfo_ClosedChannelUpdate.Size(m)
+}
+func (m *ClosedChannelUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClosedChannelUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClosedChannelUpdate proto.InternalMessageInfo
+
+func (m *ClosedChannelUpdate) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *ClosedChannelUpdate) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *ClosedChannelUpdate) GetClosedHeight() uint32 {
+	if m != nil {
+		return m.ClosedHeight
+	}
+	return 0
+}
+
+func (m *ClosedChannelUpdate) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+type HopHint struct {
+	/// The public key of the node at the start of the channel.
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,proto3" json:"node_id,omitempty"`
+	/// The unique identifier of the channel.
+	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	/// The base fee of the channel denominated in millisatoshis.
+	FeeBaseMsat uint32 `protobuf:"varint,3,opt,name=fee_base_msat,proto3" json:"fee_base_msat,omitempty"`
+	//*
+	//The fee rate of the channel for sending one satoshi across it denominated in
+	//millionths of a satoshi.
+	FeeProportionalMillionths uint32 `protobuf:"varint,4,opt,name=fee_proportional_millionths,proto3" json:"fee_proportional_millionths,omitempty"`
+	/// The time-lock delta of the channel.
+	CltvExpiryDelta      uint32   `protobuf:"varint,5,opt,name=cltv_expiry_delta,proto3" json:"cltv_expiry_delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HopHint) Reset()         { *m = HopHint{} }
+func (m *HopHint) String() string { return proto.CompactTextString(m) }
+func (*HopHint) ProtoMessage()    {}
+func (*HopHint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{103}
+}
+
+func (m *HopHint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HopHint.Unmarshal(m, b)
+}
+func (m *HopHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HopHint.Marshal(b, m, deterministic)
+}
+func (m *HopHint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HopHint.Merge(m, src)
+}
+func (m *HopHint) XXX_Size() int {
+	return xxx_messageInfo_HopHint.Size(m)
+}
+func (m *HopHint) XXX_DiscardUnknown() {
+	xxx_messageInfo_HopHint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HopHint proto.InternalMessageInfo
+
+func (m *HopHint) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *HopHint) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *HopHint) GetFeeBaseMsat() uint32 {
+	if m != nil {
+		return m.FeeBaseMsat
+	}
+	return 0
+}
+
+func (m *HopHint) GetFeeProportionalMillionths() uint32 {
+	if m != nil {
+		return m.FeeProportionalMillionths
+	}
+	return 0
+}
+
+func (m *HopHint) GetCltvExpiryDelta() uint32 {
+	if m != nil {
+		return m.CltvExpiryDelta
+	}
+	return 0
+}
+
+type RouteHint struct {
+	//*
+	//A list of hop hints that when chained together can assist in reaching a
+	//specific destination.
+	HopHints             []*HopHint `protobuf:"bytes,1,rep,name=hop_hints,proto3" json:"hop_hints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *RouteHint) Reset()         { *m = RouteHint{} }
+func (m *RouteHint) String() string { return proto.CompactTextString(m) }
+func (*RouteHint) ProtoMessage()    {}
+func (*RouteHint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{104}
+}
+
+func (m *RouteHint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteHint.Unmarshal(m, b)
+}
+func (m *RouteHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteHint.Marshal(b, m, deterministic)
+}
+func (m *RouteHint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteHint.Merge(m, src)
+}
+func (m *RouteHint) XXX_Size() int {
+	return xxx_messageInfo_RouteHint.Size(m)
+}
+func (m *RouteHint) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteHint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteHint proto.InternalMessageInfo
+
+func (m *RouteHint) GetHopHints() []*HopHint {
+	if m != nil {
+		return m.HopHints
+	}
+	return nil
+}
+
+type Invoice struct {
+	//*
+	//An optional memo to attach along with the invoice. Used for record keeping
+	//purposes for the invoice's creator, and will also be set in the description
+	//field of the encoded payment request if the description_hash field is not
+	//being used.
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	//* Deprecated. An optional cryptographic receipt of payment which is not
+	//implemented.
+	Receipt []byte `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"` // Deprecated: Do not use.
+	//*
+	//The hex-encoded preimage (32 byte) which will allow settling an incoming
+	//HTLC payable to this preimage
+	RPreimage []byte `protobuf:"bytes,3,opt,name=r_preimage,proto3" json:"r_preimage,omitempty"`
+	/// The hash of the preimage
+	RHash []byte `protobuf:"bytes,4,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	/// The value of this invoice in satoshis
+	Value int64 `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	/// Whether this invoice has been fulfilled
+	Settled bool `protobuf:"varint,6,opt,name=settled,proto3" json:"settled,omitempty"` // Deprecated: Do not use.
+	/// When this invoice was created
+	CreationDate int64 `protobuf:"varint,7,opt,name=creation_date,proto3" json:"creation_date,omitempty"`
+	/// When this invoice was settled
+	SettleDate int64 `protobuf:"varint,8,opt,name=settle_date,proto3" json:"settle_date,omitempty"`
+	//*
+	//A bare-bones invoice for a payment within the Lightning Network.  With the
+	//details of the invoice, the sender has all the data necessary to send a
+	//payment to the recipient.
+	PaymentRequest string `protobuf:"bytes,9,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
+	//*
+	//Hash (SHA-256) of a description of the payment. Used if the description of
+	//payment (memo) is too long to naturally fit within the description field
+	//of an encoded payment request.
+	DescriptionHash []byte `protobuf:"bytes,10,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
+	/// Payment request expiry time in seconds. Default is 3600 (1 hour).
+	Expiry int64 `protobuf:"varint,11,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	/// Fallback on-chain address.
+	FallbackAddr string `protobuf:"bytes,12,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
+	/// Delta to use for the time-lock of the CLTV extended to the final hop.
+	CltvExpiry uint64 `protobuf:"varint,13,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
+	//*
+	//Route hints that can each be individually used to assist in reaching the
+	//invoice's destination.
+	RouteHints []*RouteHint `protobuf:"bytes,14,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
+	/// Whether this invoice should include routing hints for private channels.
+	Private bool `protobuf:"varint,15,opt,name=private,proto3" json:"private,omitempty"`
+	//*
+	//The "add" index of this invoice. Each newly created invoice will increment
+	//this index making it monotonically increasing. Callers to the
+	//SubscribeInvoices call can use this to instantly get notified of all added
+	//invoices with an add_index greater than this one.
+	AddIndex uint64 `protobuf:"varint,16,opt,name=add_index,proto3" json:"add_index,omitempty"`
+	//*
+	//The "settle" index of this invoice. Each newly settled invoice will
+	//increment this index making it monotonically increasing. Callers to the
+	//SubscribeInvoices call can use this to instantly get notified of all
+	//settled invoices with an settle_index greater than this one.
+	SettleIndex uint64 `protobuf:"varint,17,opt,name=settle_index,proto3" json:"settle_index,omitempty"`
+	/// Deprecated, use amt_paid_sat or amt_paid_msat.
+	AmtPaid int64 `protobuf:"varint,18,opt,name=amt_paid,proto3" json:"amt_paid,omitempty"` // Deprecated: Do not use.
+	//*
+	//The amount that was accepted for this invoice, in satoshis. This will ONLY
+	//be set if this invoice has been settled. We provide this field as if the
+	//invoice was created with a zero value, then we need to record what amount
+	//was ultimately accepted. Additionally, it's possible that the sender paid
+	//MORE that was specified in the original invoice. So we'll record that here
+	//as well.
+	AmtPaidSat int64 `protobuf:"varint,19,opt,name=amt_paid_sat,proto3" json:"amt_paid_sat,omitempty"`
+	//*
+	//The amount that was accepted for this invoice, in millisatoshis. This will
+	//ONLY be set if this invoice has been settled. We provide this field as if
+	//the invoice was created with a zero value, then we need to record what
+	//amount was ultimately accepted. Additionally, it's possible that the sender
+	//paid MORE that was specified in the original invoice. So we'll record that
+	//here as well.
+	AmtPaidMsat int64 `protobuf:"varint,20,opt,name=amt_paid_msat,proto3" json:"amt_paid_msat,omitempty"`
+	//*
+	//The state the invoice is in.
+	State Invoice_InvoiceState `protobuf:"varint,21,opt,name=state,proto3,enum=lnrpc.Invoice_InvoiceState" json:"state,omitempty"`
+	/// List of HTLCs paying to this invoice [EXPERIMENTAL].
+	Htlcs []*InvoiceHTLC `protobuf:"bytes,22,rep,name=htlcs,proto3" json:"htlcs,omitempty"`
+	//*
+	//The payment address of this invoice. Payers include it in the final hop's
+	//onion payload of the HTLCs paying to this invoice, which is only enforced
+	//if the features of the invoice require payment addresses. Empty if the
+	//invoice doesn't have a payment address.
+	PaymentAddr          []byte   `protobuf:"bytes,23,opt,name=payment_addr,proto3" json:"payment_addr,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HopHint) Reset()         { *m = HopHint{} }
-func (m *HopHint) String() string { return proto.CompactTextString(m) }
-func (*HopHint) ProtoMessage()    {}
-func (*HopHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{93}
+func (m *Invoice) Reset()         { *m = Invoice{} }
+func (m *Invoice) String() string { return proto.CompactTextString(m) }
+func (*Invoice) ProtoMessage()    {}
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{105}
+}
+
+func (m *Invoice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Invoice.Unmarshal(m, b)
+}
+func (m *Invoice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Invoice.Marshal(b, m, deterministic)
+}
+func (m *Invoice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Invoice.Merge(m, src)
+}
+func (m *Invoice) XXX_Size() int {
+	return xxx_messageInfo_Invoice.Size(m)
+}
+func (m *Invoice) XXX_DiscardUnknown() {
+	xxx_messageInfo_Invoice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Invoice proto.InternalMessageInfo
+
+func (m *Invoice) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (m *Invoice) GetReceipt() []byte {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *Invoice) GetRPreimage() []byte {
+	if m != nil {
+		return m.RPreimage
+	}
+	return nil
+}
+
+func (m *Invoice) GetRHash() []byte {
+	if m != nil {
+		return m.RHash
+	}
+	return nil
+}
+
+func (m *Invoice) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (m *Invoice) GetSettled() bool {
+	if m != nil {
+		return m.Settled
+	}
+	return false
+}
+
+func (m *Invoice) GetCreationDate() int64 {
+	if m != nil {
+		return m.CreationDate
+	}
+	return 0
+}
+
+func (m *Invoice) GetSettleDate() int64 {
+	if m != nil {
+		return m.SettleDate
+	}
+	return 0
+}
+
+func (m *Invoice) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+func (m *Invoice) GetDescriptionHash() []byte {
+	if m != nil {
+		return m.DescriptionHash
+	}
+	return nil
+}
+
+func (m *Invoice) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *Invoice) GetFallbackAddr() string {
+	if m != nil {
+		return m.FallbackAddr
+	}
+	return ""
+}
+
+func (m *Invoice) GetCltvExpiry() uint64 {
+	if m != nil {
+		return m.CltvExpiry
+	}
+	return 0
+}
+
+func (m *Invoice) GetRouteHints() []*RouteHint {
+	if m != nil {
+		return m.RouteHints
+	}
+	return nil
+}
+
+func (m *Invoice) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
+
+func (m *Invoice) GetAddIndex() uint64 {
+	if m != nil {
+		return m.AddIndex
+	}
+	return 0
+}
+
+func (m *Invoice) GetSettleIndex() uint64 {
+	if m != nil {
+		return m.SettleIndex
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (m *Invoice) GetAmtPaid() int64 {
+	if m != nil {
+		return m.AmtPaid
+	}
+	return 0
+}
+
+func (m *Invoice) GetAmtPaidSat() int64 {
+	if m != nil {
+		return m.AmtPaidSat
+	}
+	return 0
+}
+
+func (m *Invoice) GetAmtPaidMsat() int64 {
+	if m != nil {
+		return m.AmtPaidMsat
+	}
+	return 0
+}
+
+func (m *Invoice) GetState() Invoice_InvoiceState {
+	if m != nil {
+		return m.State
+	}
+	return Invoice_OPEN
+}
+
+func (m *Invoice) GetHtlcs() []*InvoiceHTLC {
+	if m != nil {
+		return m.Htlcs
+	}
+	return nil
+}
+
+func (m *Invoice) GetPaymentAddr() []byte {
+	if m != nil {
+		return m.PaymentAddr
+	}
+	return nil
+}
+
+/// Details of an HTLC that paid to an invoice
+type InvoiceHTLC struct {
+	/// Short channel id over which the htlc was received.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	/// Index identifying the htlc on the channel.
+	HtlcIndex uint64 `protobuf:"varint,2,opt,name=htlc_index,proto3" json:"htlc_index,omitempty"`
+	/// The amount of the htlc in msat.
+	AmtMsat uint64 `protobuf:"varint,3,opt,name=amt_msat,proto3" json:"amt_msat,omitempty"`
+	/// Block height at which this htlc was accepted.
+	AcceptHeight int32 `protobuf:"varint,4,opt,name=accept_height,proto3" json:"accept_height,omitempty"`
+	/// Time at which this htlc was accepted.
+	AcceptTime int64 `protobuf:"varint,5,opt,name=accept_time,proto3" json:"accept_time,omitempty"`
+	/// Time at which this htlc was settled or canceled.
+	ResolveTime int64 `protobuf:"varint,6,opt,name=resolve_time,proto3" json:"resolve_time,omitempty"`
+	/// Block height at which this htlc expires.
+	ExpiryHeight int32 `protobuf:"varint,7,opt,name=expiry_height,proto3" json:"expiry_height,omitempty"`
+	/// Current state the htlc is in.
+	State InvoiceHTLCState `protobuf:"varint,8,opt,name=state,proto3,enum=lnrpc.InvoiceHTLCState" json:"state,omitempty"`
+	/// Custom tlv records that were included in the htlc's onion payload.
+	CustomRecords        map[uint64][]byte `protobuf:"bytes,9,rep,name=custom_records,proto3" json:"custom_records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InvoiceHTLC) Reset()         { *m = InvoiceHTLC{} }
+func (m *InvoiceHTLC) String() string { return proto.CompactTextString(m) }
+func (*InvoiceHTLC) ProtoMessage()    {}
+func (*InvoiceHTLC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{106}
 }
 
-func (m *HopHint) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HopHint.Unmarshal(m, b)
+func (m *InvoiceHTLC) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvoiceHTLC.Unmarshal(m, b)
 }
-func (m *HopHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HopHint.Marshal(b, m, deterministic)
+func (m *InvoiceHTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvoiceHTLC.Marshal(b, m, deterministic)
 }
-func (m *HopHint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HopHint.Merge(m, src)
+func (m *InvoiceHTLC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvoiceHTLC.Merge(m, src)
 }
-func (m *HopHint) XXX_Size() int {
-	return xxx_messageInfo_HopHint.Size(m)
+func (m *InvoiceHTLC) XXX_Size() int {
+	return xxx_messageInfo_InvoiceHTLC.Size(m)
 }
-func (m *HopHint) XXX_DiscardUnknown() {
-	xxx_messageInfo_HopHint.DiscardUnknown(m)
+func (m *InvoiceHTLC) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvoiceHTLC.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HopHint proto.InternalMessageInfo
+var xxx_messageInfo_InvoiceHTLC proto.InternalMessageInfo
 
-func (m *HopHint) GetNodeId() string {
+func (m *InvoiceHTLC) GetChanId() uint64 {
 	if m != nil {
-		return m.NodeId
+		return m.ChanId
 	}
-	return ""
+	return 0
 }
 
-func (m *HopHint) GetChanId() uint64 {
+func (m *InvoiceHTLC) GetHtlcIndex() uint64 {
 	if m != nil {
-		return m.ChanId
+		return m.HtlcIndex
 	}
 	return 0
 }
 
-func (m *HopHint) GetFeeBaseMsat() uint32 {
+func (m *InvoiceHTLC) GetAmtMsat() uint64 {
 	if m != nil {
-		return m.FeeBaseMsat
+		return m.AmtMsat
 	}
 	return 0
 }
 
-func (m *HopHint) GetFeeProportionalMillionths() uint32 {
+func (m *InvoiceHTLC) GetAcceptHeight() int32 {
 	if m != nil {
-		return m.FeeProportionalMillionths
+		return m.AcceptHeight
 	}
 	return 0
 }
 
-func (m *HopHint) GetCltvExpiryDelta() uint32 {
+func (m *InvoiceHTLC) GetAcceptTime() int64 {
 	if m != nil {
-		return m.CltvExpiryDelta
+		return m.AcceptTime
 	}
 	return 0
 }
 
-type RouteHint struct {
-	//*
-	//A list of hop hints that when chained together can assist in reaching a
-	//specific destination.
-	HopHints             []*HopHint `protobuf:"bytes,1,rep,name=hop_hints,proto3" json:"hop_hints,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *InvoiceHTLC) GetResolveTime() int64 {
+	if m != nil {
+		return m.ResolveTime
+	}
+	return 0
 }
 
-func (m *RouteHint) Reset()         { *m = RouteHint{} }
-func (m *RouteHint) String() string { return proto.CompactTextString(m) }
-func (*RouteHint) ProtoMessage()    {}
-func (*RouteHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{94}
+func (m *InvoiceHTLC) GetExpiryHeight() int32 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
 }
 
-func (m *RouteHint) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RouteHint.Unmarshal(m, b)
-}
-func (m *RouteHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RouteHint.Marshal(b, m, deterministic)
-}
-func (m *RouteHint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RouteHint.Merge(m, src)
-}
-func (m *RouteHint) XXX_Size() int {
-	return xxx_messageInfo_RouteHint.Size(m)
-}
-func (m *RouteHint) XXX_DiscardUnknown() {
-	xxx_messageInfo_RouteHint.DiscardUnknown(m)
+func (m *InvoiceHTLC) GetState() InvoiceHTLCState {
+	if m != nil {
+		return m.State
+	}
+	return InvoiceHTLCState_ACCEPTED
 }
 
-var xxx_messageInfo_RouteHint proto.InternalMessageInfo
-
-func (m *RouteHint) GetHopHints() []*HopHint {
+func (m *InvoiceHTLC) GetCustomRecords() map[uint64][]byte {
 	if m != nil {
-		return m.HopHints
+		return m.CustomRecords
 	}
 	return nil
 }
 
-type Invoice struct {
-	//*
-	//An optional memo to attach along with the invoice. Used for record keeping
-	//purposes for the invoice's creator, and will also be set in the description
-	//field of the encoded payment request if the description_hash field is not
-	//being used.
-	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	//* Deprecated. An optional cryptographic receipt of payment which is not
-	//implemented.
-	Receipt []byte `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"` // Deprecated: Do not use.
-	//*
-	//The hex-encoded preimage (32 byte) which will allow settling an incoming
-	//HTLC payable to this preimage
-	RPreimage []byte `protobuf:"bytes,3,opt,name=r_preimage,proto3" json:"r_preimage,omitempty"`
-	/// The hash of the preimage
-	RHash []byte `protobuf:"bytes,4,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
-	/// The value of this invoice in satoshis
-	Value int64 `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
-	/// Whether this invoice has been fulfilled
-	Settled bool `protobuf:"varint,6,opt,name=settled,proto3" json:"settled,omitempty"` // Deprecated: Do not use.
-	/// When this invoice was created
-	CreationDate int64 `protobuf:"varint,7,opt,name=creation_date,proto3" json:"creation_date,omitempty"`
-	/// When this invoice was settled
-	SettleDate int64 `protobuf:"varint,8,opt,name=settle_date,proto3" json:"settle_date,omitempty"`
+type AddInvoiceResponse struct {
+	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
 	//*
 	//A bare-bones invoice for a payment within the Lightning Network.  With the
 	//details of the invoice, the sender has all the data necessary to send a
 	//payment to the recipient.
-	PaymentRequest string `protobuf:"bytes,9,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
-	//*
-	//Hash (SHA-256) of a description of the payment. Used if the description of
-	//payment (memo) is too long to naturally fit within the description field
-	//of an encoded payment request.
-	DescriptionHash []byte `protobuf:"bytes,10,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
-	/// Payment request expiry time in seconds. Default is 3600 (1 hour).
-	Expiry int64 `protobuf:"varint,11,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	/// Fallback on-chain address.
-	FallbackAddr string `protobuf:"bytes,12,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
-	/// Delta to use for the time-lock of the CLTV extended to the final hop.
-	CltvExpiry uint64 `protobuf:"varint,13,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
-	//*
-	//Route hints that can each be individually used to assist in reaching the
-	//invoice's destination.
-	RouteHints []*RouteHint `protobuf:"bytes,14,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
-	/// Whether this invoice should include routing hints for private channels.
-	Private bool `protobuf:"varint,15,opt,name=private,proto3" json:"private,omitempty"`
+	PaymentRequest string `protobuf:"bytes,2,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
 	//*
 	//The "add" index of this invoice. Each newly created invoice will increment
 	//this index making it monotonically increasing. Callers to the
 	//SubscribeInvoices call can use this to instantly get notified of all added
 	//invoices with an add_index greater than this one.
-	AddIndex uint64 `protobuf:"varint,16,opt,name=add_index,proto3" json:"add_index,omitempty"`
-	//*
-	//The "settle" index of this invoice. Each newly settled invoice will
-	//increment this index making it monotonically increasing. Callers to the
-	//SubscribeInvoices call can use this to instantly get notified of all
-	//settled invoices with an settle_index greater than this one.
-	SettleIndex uint64 `protobuf:"varint,17,opt,name=settle_index,proto3" json:"settle_index,omitempty"`
-	/// Deprecated, use amt_paid_sat or amt_paid_msat.
-	AmtPaid int64 `protobuf:"varint,18,opt,name=amt_paid,proto3" json:"amt_paid,omitempty"` // Deprecated: Do not use.
+	AddIndex             uint64   `protobuf:"varint,16,opt,name=add_index,proto3" json:"add_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddInvoiceResponse) Reset()         { *m = AddInvoiceResponse{} }
+func (m *AddInvoiceResponse) String() string { return proto.CompactTextString(m) }
+func (*AddInvoiceResponse) ProtoMessage()    {}
+func (*AddInvoiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{107}
+}
+
+func (m *AddInvoiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddInvoiceResponse.Unmarshal(m, b)
+}
+func (m *AddInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddInvoiceResponse.Marshal(b, m, deterministic)
+}
+func (m *AddInvoiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddInvoiceResponse.Merge(m, src)
+}
+func (m *AddInvoiceResponse) XXX_Size() int {
+	return xxx_messageInfo_AddInvoiceResponse.Size(m)
+}
+func (m *AddInvoiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddInvoiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddInvoiceResponse proto.InternalMessageInfo
+
+func (m *AddInvoiceResponse) GetRHash() []byte {
+	if m != nil {
+		return m.RHash
+	}
+	return nil
+}
+
+func (m *AddInvoiceResponse) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+func (m *AddInvoiceResponse) GetAddIndex() uint64 {
+	if m != nil {
+		return m.AddIndex
+	}
+	return 0
+}
+
+type PaymentHash struct {
 	//*
-	//The amount that was accepted for this invoice, in satoshis. This will ONLY
-	//be set if this invoice has been settled. We provide this field as if the
-	//invoice was created with a zero value, then we need to record what amount
-	//was ultimately accepted. Additionally, it's possible that the sender paid
-	//MORE that was specified in the original invoice. So we'll record that here
-	//as well.
-	AmtPaidSat int64 `protobuf:"varint,19,opt,name=amt_paid_sat,proto3" json:"amt_paid_sat,omitempty"`
+	//The hex-encoded payment hash of the invoice to be looked up. The passed
+	//payment hash must be exactly 32 bytes, otherwise an error is returned.
+	RHashStr string `protobuf:"bytes,1,opt,name=r_hash_str,proto3" json:"r_hash_str,omitempty"`
+	/// The payment hash of the invoice to be looked up.
+	RHash                []byte   `protobuf:"bytes,2,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaymentHash) Reset()         { *m = PaymentHash{} }
+func (m *PaymentHash) String() string { return proto.CompactTextString(m) }
+func (*PaymentHash) ProtoMessage()    {}
+func (*PaymentHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{108}
+}
+
+func (m *PaymentHash) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentHash.Unmarshal(m, b)
+}
+func (m *PaymentHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentHash.Marshal(b, m, deterministic)
+}
+func (m *PaymentHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentHash.Merge(m, src)
+}
+func (m *PaymentHash) XXX_Size() int {
+	return xxx_messageInfo_PaymentHash.Size(m)
+}
+func (m *PaymentHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentHash proto.InternalMessageInfo
+
+func (m *PaymentHash) GetRHashStr() string {
+	if m != nil {
+		return m.RHashStr
+	}
+	return ""
+}
+
+func (m *PaymentHash) GetRHash() []byte {
+	if m != nil {
+		return m.RHash
+	}
+	return nil
+}
+
+type ListInvoiceRequest struct {
+	/// If set, only unsettled invoices will be returned in the response.
+	PendingOnly bool `protobuf:"varint,1,opt,name=pending_only,proto3" json:"pending_only,omitempty"`
 	//*
-	//The amount that was accepted for this invoice, in millisatoshis. This will
-	//ONLY be set if this invoice has been settled. We provide this field as if
-	//the invoice was created with a zero value, then we need to record what
-	//amount was ultimately accepted. Additionally, it's possible that the sender
-	//paid MORE that was specified in the original invoice. So we'll record that
-	//here as well.
-	AmtPaidMsat int64 `protobuf:"varint,20,opt,name=amt_paid_msat,proto3" json:"amt_paid_msat,omitempty"`
+	//The index of an invoice that will be used as either the start or end of a
+	//query to determine which invoices should be returned in the response.
+	IndexOffset uint64 `protobuf:"varint,4,opt,name=index_offset,proto3" json:"index_offset,omitempty"`
+	/// The max number of invoices to return in the response to this query.
+	NumMaxInvoices uint64 `protobuf:"varint,5,opt,name=num_max_invoices,proto3" json:"num_max_invoices,omitempty"`
 	//*
-	//The state the invoice is in.
-	State Invoice_InvoiceState `protobuf:"varint,21,opt,name=state,proto3,enum=lnrpc.Invoice_InvoiceState" json:"state,omitempty"`
-	/// List of HTLCs paying to this invoice [EXPERIMENTAL].
-	Htlcs                []*InvoiceHTLC `protobuf:"bytes,22,rep,name=htlcs,proto3" json:"htlcs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	//If set, the invoices returned will result from seeking backwards from the
+	//specified index offset. This can be used to paginate backwards.
+	Reversed             bool     `protobuf:"varint,6,opt,name=reversed,proto3" json:"reversed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Invoice) Reset()         { *m = Invoice{} }
-func (m *Invoice) String() string { return proto.CompactTextString(m) }
-func (*Invoice) ProtoMessage()    {}
-func (*Invoice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{95}
+func (m *ListInvoiceRequest) Reset()         { *m = ListInvoiceRequest{} }
+func (m *ListInvoiceRequest) String() string { return proto.CompactTextString(m) }
+func (*ListInvoiceRequest) ProtoMessage()    {}
+func (*ListInvoiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{109}
 }
 
-func (m *Invoice) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Invoice.Unmarshal(m, b)
+func (m *ListInvoiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInvoiceRequest.Unmarshal(m, b)
 }
-func (m *Invoice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Invoice.Marshal(b, m, deterministic)
+func (m *ListInvoiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInvoiceRequest.Marshal(b, m, deterministic)
 }
-func (m *Invoice) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Invoice.Merge(m, src)
+func (m *ListInvoiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInvoiceRequest.Merge(m, src)
 }
-func (m *Invoice) XXX_Size() int {
-	return xxx_messageInfo_Invoice.Size(m)
+func (m *ListInvoiceRequest) XXX_Size() int {
+	return xxx_messageInfo_ListInvoiceRequest.Size(m)
 }
-func (m *Invoice) XXX_DiscardUnknown() {
-	xxx_messageInfo_Invoice.DiscardUnknown(m)
+func (m *ListInvoiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInvoiceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Invoice proto.InternalMessageInfo
+var xxx_messageInfo_ListInvoiceRequest proto.InternalMessageInfo
 
-func (m *Invoice) GetMemo() string {
+func (m *ListInvoiceRequest) GetPendingOnly() bool {
 	if m != nil {
-		return m.Memo
+		return m.PendingOnly
 	}
-	return ""
+	return false
 }
 
-// Deprecated: Do not use.
-func (m *Invoice) GetReceipt() []byte {
+func (m *ListInvoiceRequest) GetIndexOffset() uint64 {
 	if m != nil {
-		return m.Receipt
+		return m.IndexOffset
 	}
-	return nil
+	return 0
 }
 
-func (m *Invoice) GetRPreimage() []byte {
+func (m *ListInvoiceRequest) GetNumMaxInvoices() uint64 {
 	if m != nil {
-		return m.RPreimage
+		return m.NumMaxInvoices
 	}
-	return nil
+	return 0
 }
 
-func (m *Invoice) GetRHash() []byte {
+func (m *ListInvoiceRequest) GetReversed() bool {
 	if m != nil {
-		return m.RHash
+		return m.Reversed
+	}
+	return false
+}
+
+type ListInvoiceResponse struct {
+	//*
+	//A list of invoices from the time slice of the time series specified in the
+	//request.
+	Invoices []*Invoice `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	//*
+	//The index of the last item in the set of returned invoices. This can be used
+	//to seek further, pagination style.
+	LastIndexOffset uint64 `protobuf:"varint,2,opt,name=last_index_offset,proto3" json:"last_index_offset,omitempty"`
+	//*
+	//The index of the last item in the set of returned invoices. This can be used
+	//to seek backwards, pagination style.
+	FirstIndexOffset     uint64   `protobuf:"varint,3,opt,name=first_index_offset,proto3" json:"first_index_offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListInvoiceResponse) Reset()         { *m = ListInvoiceResponse{} }
+func (m *ListInvoiceResponse) String() string { return proto.CompactTextString(m) }
+func (*ListInvoiceResponse) ProtoMessage()    {}
+func (*ListInvoiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{110}
+}
+
+func (m *ListInvoiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInvoiceResponse.Unmarshal(m, b)
+}
+func (m *ListInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInvoiceResponse.Marshal(b, m, deterministic)
+}
+func (m *ListInvoiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInvoiceResponse.Merge(m, src)
+}
+func (m *ListInvoiceResponse) XXX_Size() int {
+	return xxx_messageInfo_ListInvoiceResponse.Size(m)
+}
+func (m *ListInvoiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInvoiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInvoiceResponse proto.InternalMessageInfo
+
+func (m *ListInvoiceResponse) GetInvoices() []*Invoice {
+	if m != nil {
+		return m.Invoices
 	}
 	return nil
 }
 
-func (m *Invoice) GetValue() int64 {
+func (m *ListInvoiceResponse) GetLastIndexOffset() uint64 {
 	if m != nil {
-		return m.Value
+		return m.LastIndexOffset
 	}
 	return 0
 }
 
-// Deprecated: Do not use.
-func (m *Invoice) GetSettled() bool {
+func (m *ListInvoiceResponse) GetFirstIndexOffset() uint64 {
 	if m != nil {
-		return m.Settled
+		return m.FirstIndexOffset
 	}
-	return false
+	return 0
 }
 
-func (m *Invoice) GetCreationDate() int64 {
+type InvoiceSubscription struct {
+	//*
+	//If specified (non-zero), then we'll first start by sending out
+	//notifications for all added indexes with an add_index greater than this
+	//value. This allows callers to catch up on any events they missed while they
+	//weren't connected to the streaming RPC.
+	AddIndex uint64 `protobuf:"varint,1,opt,name=add_index,proto3" json:"add_index,omitempty"`
+	//*
+	//If specified (non-zero), then we'll first start by sending out
+	//notifications for all settled indexes with an settle_index greater than
+	//this value. This allows callers to catch up on any events they missed while
+	//they weren't connected to the streaming RPC.
+	SettleIndex          uint64   `protobuf:"varint,2,opt,name=settle_index,proto3" json:"settle_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvoiceSubscription) Reset()         { *m = InvoiceSubscription{} }
+func (m *InvoiceSubscription) String() string { return proto.CompactTextString(m) }
+func (*InvoiceSubscription) ProtoMessage()    {}
+func (*InvoiceSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{111}
+}
+
+func (m *InvoiceSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvoiceSubscription.Unmarshal(m, b)
+}
+func (m *InvoiceSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvoiceSubscription.Marshal(b, m, deterministic)
+}
+func (m *InvoiceSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvoiceSubscription.Merge(m, src)
+}
+func (m *InvoiceSubscription) XXX_Size() int {
+	return xxx_messageInfo_InvoiceSubscription.Size(m)
+}
+func (m *InvoiceSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvoiceSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvoiceSubscription proto.InternalMessageInfo
+
+func (m *InvoiceSubscription) GetAddIndex() uint64 {
 	if m != nil {
-		return m.CreationDate
+		return m.AddIndex
 	}
 	return 0
 }
 
-func (m *Invoice) GetSettleDate() int64 {
+func (m *InvoiceSubscription) GetSettleIndex() uint64 {
 	if m != nil {
-		return m.SettleDate
+		return m.SettleIndex
 	}
 	return 0
 }
 
-func (m *Invoice) GetPaymentRequest() string {
+type Payment struct {
+	/// The payment hash
+	PaymentHash string `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	/// Deprecated, use value_sat or value_msat.
+	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"` // Deprecated: Do not use.
+	/// The date of this payment
+	CreationDate int64 `protobuf:"varint,3,opt,name=creation_date,proto3" json:"creation_date,omitempty"`
+	/// The path this payment took
+	Path []string `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"`
+	/// Deprecated, use fee_sat or fee_msat.
+	Fee int64 `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"` // Deprecated: Do not use.
+	/// The payment preimage
+	PaymentPreimage string `protobuf:"bytes,6,opt,name=payment_preimage,proto3" json:"payment_preimage,omitempty"`
+	/// The value of the payment in satoshis
+	ValueSat int64 `protobuf:"varint,7,opt,name=value_sat,proto3" json:"value_sat,omitempty"`
+	/// The value of the payment in milli-satoshis
+	ValueMsat int64 `protobuf:"varint,8,opt,name=value_msat,proto3" json:"value_msat,omitempty"`
+	/// The optional payment request being fulfilled.
+	PaymentRequest string `protobuf:"bytes,9,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
+	// The status of the payment.
+	Status Payment_PaymentStatus `protobuf:"varint,10,opt,name=status,proto3,enum=lnrpc.Payment_PaymentStatus" json:"status,omitempty"`
+	///  The fee paid for this payment in satoshis
+	FeeSat int64 `protobuf:"varint,11,opt,name=fee_sat,proto3" json:"fee_sat,omitempty"`
+	///  The fee paid for this payment in milli-satoshis
+	FeeMsat int64 `protobuf:"varint,12,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
+	//*
+	//Whether this payment is a circular payment to ourselves that was sent by
+	//the rebalancer to shift liquidity between our channels.
+	Rebalance            bool     `protobuf:"varint,13,opt,name=rebalance,proto3" json:"rebalance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Payment) Reset()         { *m = Payment{} }
+func (m *Payment) String() string { return proto.CompactTextString(m) }
+func (*Payment) ProtoMessage()    {}
+func (*Payment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{112}
+}
+
+func (m *Payment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Payment.Unmarshal(m, b)
+}
+func (m *Payment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Payment.Marshal(b, m, deterministic)
+}
+func (m *Payment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Payment.Merge(m, src)
+}
+func (m *Payment) XXX_Size() int {
+	return xxx_messageInfo_Payment.Size(m)
+}
+func (m *Payment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Payment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Payment proto.InternalMessageInfo
+
+func (m *Payment) GetPaymentHash() string {
 	if m != nil {
-		return m.PaymentRequest
+		return m.PaymentHash
 	}
 	return ""
 }
 
-func (m *Invoice) GetDescriptionHash() []byte {
+// Deprecated: Do not use.
+func (m *Payment) GetValue() int64 {
 	if m != nil {
-		return m.DescriptionHash
+		return m.Value
 	}
-	return nil
+	return 0
 }
 
-func (m *Invoice) GetExpiry() int64 {
+func (m *Payment) GetCreationDate() int64 {
 	if m != nil {
-		return m.Expiry
+		return m.CreationDate
 	}
 	return 0
 }
 
-func (m *Invoice) GetFallbackAddr() string {
+func (m *Payment) GetPath() []string {
 	if m != nil {
-		return m.FallbackAddr
+		return m.Path
 	}
-	return ""
+	return nil
 }
 
-func (m *Invoice) GetCltvExpiry() uint64 {
+// Deprecated: Do not use.
+func (m *Payment) GetFee() int64 {
 	if m != nil {
-		return m.CltvExpiry
+		return m.Fee
 	}
 	return 0
 }
 
-func (m *Invoice) GetRouteHints() []*RouteHint {
-	if m != nil {
-		return m.RouteHints
-	}
-	return nil
-}
-
-func (m *Invoice) GetPrivate() bool {
+func (m *Payment) GetPaymentPreimage() string {
 	if m != nil {
-		return m.Private
+		return m.PaymentPreimage
 	}
-	return false
+	return ""
 }
 
-func (m *Invoice) GetAddIndex() uint64 {
+func (m *Payment) GetValueSat() int64 {
 	if m != nil {
-		return m.AddIndex
+		return m.ValueSat
 	}
 	return 0
 }
 
-func (m *Invoice) GetSettleIndex() uint64 {
+func (m *Payment) GetValueMsat() int64 {
 	if m != nil {
-		return m.SettleIndex
+		return m.ValueMsat
 	}
 	return 0
 }
 
-// Deprecated: Do not use.
-func (m *Invoice) GetAmtPaid() int64 {
+func (m *Payment) GetPaymentRequest() string {
 	if m != nil {
-		return m.AmtPaid
+		return m.PaymentRequest
 	}
-	return 0
+	return ""
 }
 
-func (m *Invoice) GetAmtPaidSat() int64 {
+func (m *Payment) GetStatus() Payment_PaymentStatus {
 	if m != nil {
-		return m.AmtPaidSat
+		return m.Status
 	}
-	return 0
+	return Payment_UNKNOWN
 }
 
-func (m *Invoice) GetAmtPaidMsat() int64 {
+func (m *Payment) GetFeeSat() int64 {
 	if m != nil {
-		return m.AmtPaidMsat
+		return m.FeeSat
 	}
 	return 0
 }
 
-func (m *Invoice) GetState() Invoice_InvoiceState {
+func (m *Payment) GetFeeMsat() int64 {
 	if m != nil {
-		return m.State
+		return m.FeeMsat
 	}
-	return Invoice_OPEN
+	return 0
 }
 
-func (m *Invoice) GetHtlcs() []*InvoiceHTLC {
+func (m *Payment) GetRebalance() bool {
 	if m != nil {
-		return m.Htlcs
+		return m.Rebalance
 	}
-	return nil
+	return false
 }
 
-/// Details of an HTLC that paid to an invoice
-type InvoiceHTLC struct {
-	/// Short channel id over which the htlc was received.
-	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
-	/// Index identifying the htlc on the channel.
-	HtlcIndex uint64 `protobuf:"varint,2,opt,name=htlc_index,proto3" json:"htlc_index,omitempty"`
-	/// The amount of the htlc in msat.
-	AmtMsat uint64 `protobuf:"varint,3,opt,name=amt_msat,proto3" json:"amt_msat,omitempty"`
-	/// Block height at which this htlc was accepted.
-	AcceptHeight int32 `protobuf:"varint,4,opt,name=accept_height,proto3" json:"accept_height,omitempty"`
-	/// Time at which this htlc was accepted.
-	AcceptTime int64 `protobuf:"varint,5,opt,name=accept_time,proto3" json:"accept_time,omitempty"`
-	/// Time at which this htlc was settled or canceled.
-	ResolveTime int64 `protobuf:"varint,6,opt,name=resolve_time,proto3" json:"resolve_time,omitempty"`
-	/// Block height at which this htlc expires.
-	ExpiryHeight int32 `protobuf:"varint,7,opt,name=expiry_height,proto3" json:"expiry_height,omitempty"`
-	/// Current state the htlc is in.
-	State                InvoiceHTLCState `protobuf:"varint,8,opt,name=state,proto3,enum=lnrpc.InvoiceHTLCState" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type ListPaymentsRequest struct {
+	//*
+	//If true, then return payments that have not yet fully completed. This means
+	//that pending payments, as well as failed payments will show up if this
+	//field is set to True.
+	IncludeIncomplete    bool     `protobuf:"varint,1,opt,name=include_incomplete,json=includeIncomplete,proto3" json:"include_incomplete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InvoiceHTLC) Reset()         { *m = InvoiceHTLC{} }
-func (m *InvoiceHTLC) String() string { return proto.CompactTextString(m) }
-func (*InvoiceHTLC) ProtoMessage()    {}
-func (*InvoiceHTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{96}
+func (m *ListPaymentsRequest) Reset()         { *m = ListPaymentsRequest{} }
+func (m *ListPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPaymentsRequest) ProtoMessage()    {}
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{113}
 }
 
-func (m *InvoiceHTLC) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InvoiceHTLC.Unmarshal(m, b)
+func (m *ListPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPaymentsRequest.Unmarshal(m, b)
 }
-func (m *InvoiceHTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InvoiceHTLC.Marshal(b, m, deterministic)
+func (m *ListPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPaymentsRequest.Marshal(b, m, deterministic)
 }
-func (m *InvoiceHTLC) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InvoiceHTLC.Merge(m, src)
+func (m *ListPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPaymentsRequest.Merge(m, src)
 }
-func (m *InvoiceHTLC) XXX_Size() int {
-	return xxx_messageInfo_InvoiceHTLC.Size(m)
+func (m *ListPaymentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPaymentsRequest.Size(m)
 }
-func (m *InvoiceHTLC) XXX_DiscardUnknown() {
-	xxx_messageInfo_InvoiceHTLC.DiscardUnknown(m)
+func (m *ListPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPaymentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InvoiceHTLC proto.InternalMessageInfo
+var xxx_messageInfo_ListPaymentsRequest proto.InternalMessageInfo
 
-func (m *InvoiceHTLC) GetChanId() uint64 {
+func (m *ListPaymentsRequest) GetIncludeIncomplete() bool {
 	if m != nil {
-		return m.ChanId
+		return m.IncludeIncomplete
 	}
-	return 0
+	return false
 }
 
-func (m *InvoiceHTLC) GetHtlcIndex() uint64 {
-	if m != nil {
-		return m.HtlcIndex
-	}
-	return 0
+type ListPaymentsResponse struct {
+	/// The list of payments
+	Payments             []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *InvoiceHTLC) GetAmtMsat() uint64 {
-	if m != nil {
-		return m.AmtMsat
-	}
-	return 0
+func (m *ListPaymentsResponse) Reset()         { *m = ListPaymentsResponse{} }
+func (m *ListPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPaymentsResponse) ProtoMessage()    {}
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{114}
 }
 
-func (m *InvoiceHTLC) GetAcceptHeight() int32 {
-	if m != nil {
-		return m.AcceptHeight
-	}
-	return 0
+func (m *ListPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPaymentsResponse.Unmarshal(m, b)
+}
+func (m *ListPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPaymentsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPaymentsResponse.Merge(m, src)
+}
+func (m *ListPaymentsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPaymentsResponse.Size(m)
+}
+func (m *ListPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPaymentsResponse.DiscardUnknown(m)
 }
 
-func (m *InvoiceHTLC) GetAcceptTime() int64 {
+var xxx_messageInfo_ListPaymentsResponse proto.InternalMessageInfo
+
+func (m *ListPaymentsResponse) GetPayments() []*Payment {
 	if m != nil {
-		return m.AcceptTime
+		return m.Payments
 	}
-	return 0
+	return nil
 }
 
-func (m *InvoiceHTLC) GetResolveTime() int64 {
-	if m != nil {
-		return m.ResolveTime
-	}
-	return 0
+type DeleteAllPaymentsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InvoiceHTLC) GetExpiryHeight() int32 {
-	if m != nil {
-		return m.ExpiryHeight
-	}
-	return 0
+func (m *DeleteAllPaymentsRequest) Reset()         { *m = DeleteAllPaymentsRequest{} }
+func (m *DeleteAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAllPaymentsRequest) ProtoMessage()    {}
+func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{115}
 }
 
-func (m *InvoiceHTLC) GetState() InvoiceHTLCState {
-	if m != nil {
-		return m.State
-	}
-	return InvoiceHTLCState_ACCEPTED
+func (m *DeleteAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAllPaymentsRequest.Unmarshal(m, b)
+}
+func (m *DeleteAllPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAllPaymentsRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteAllPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAllPaymentsRequest.Merge(m, src)
+}
+func (m *DeleteAllPaymentsRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAllPaymentsRequest.Size(m)
+}
+func (m *DeleteAllPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAllPaymentsRequest.DiscardUnknown(m)
 }
 
-type AddInvoiceResponse struct {
-	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
-	//*
-	//A bare-bones invoice for a payment within the Lightning Network.  With the
-	//details of the invoice, the sender has all the data necessary to send a
-	//payment to the recipient.
-	PaymentRequest string `protobuf:"bytes,2,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
-	//*
-	//The "add" index of this invoice. Each newly created invoice will increment
-	//this index making it monotonically increasing. Callers to the
-	//SubscribeInvoices call can use this to instantly get notified of all added
-	//invoices with an add_index greater than this one.
-	AddIndex             uint64   `protobuf:"varint,16,opt,name=add_index,proto3" json:"add_index,omitempty"`
+var xxx_messageInfo_DeleteAllPaymentsRequest proto.InternalMessageInfo
+
+type DeleteAllPaymentsResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddInvoiceResponse) Reset()         { *m = AddInvoiceResponse{} }
-func (m *AddInvoiceResponse) String() string { return proto.CompactTextString(m) }
-func (*AddInvoiceResponse) ProtoMessage()    {}
-func (*AddInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{97}
+func (m *DeleteAllPaymentsResponse) Reset()         { *m = DeleteAllPaymentsResponse{} }
+func (m *DeleteAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAllPaymentsResponse) ProtoMessage()    {}
+func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{116}
 }
 
-func (m *AddInvoiceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddInvoiceResponse.Unmarshal(m, b)
+func (m *DeleteAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAllPaymentsResponse.Unmarshal(m, b)
 }
-func (m *AddInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddInvoiceResponse.Marshal(b, m, deterministic)
+func (m *DeleteAllPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAllPaymentsResponse.Marshal(b, m, deterministic)
 }
-func (m *AddInvoiceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddInvoiceResponse.Merge(m, src)
+func (m *DeleteAllPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAllPaymentsResponse.Merge(m, src)
 }
-func (m *AddInvoiceResponse) XXX_Size() int {
-	return xxx_messageInfo_AddInvoiceResponse.Size(m)
+func (m *DeleteAllPaymentsResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteAllPaymentsResponse.Size(m)
 }
-func (m *AddInvoiceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddInvoiceResponse.DiscardUnknown(m)
+func (m *DeleteAllPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAllPaymentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddInvoiceResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeleteAllPaymentsResponse proto.InternalMessageInfo
 
-func (m *AddInvoiceResponse) GetRHash() []byte {
-	if m != nil {
-		return m.RHash
-	}
-	return nil
+type AbandonChannelRequest struct {
+	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *AddInvoiceResponse) GetPaymentRequest() string {
-	if m != nil {
-		return m.PaymentRequest
-	}
-	return ""
+func (m *AbandonChannelRequest) Reset()         { *m = AbandonChannelRequest{} }
+func (m *AbandonChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*AbandonChannelRequest) ProtoMessage()    {}
+func (*AbandonChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{117}
 }
 
-func (m *AddInvoiceResponse) GetAddIndex() uint64 {
+func (m *AbandonChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbandonChannelRequest.Unmarshal(m, b)
+}
+func (m *AbandonChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbandonChannelRequest.Marshal(b, m, deterministic)
+}
+func (m *AbandonChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbandonChannelRequest.Merge(m, src)
+}
+func (m *AbandonChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_AbandonChannelRequest.Size(m)
+}
+func (m *AbandonChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbandonChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbandonChannelRequest proto.InternalMessageInfo
+
+func (m *AbandonChannelRequest) GetChannelPoint() *ChannelPoint {
 	if m != nil {
-		return m.AddIndex
+		return m.ChannelPoint
 	}
-	return 0
+	return nil
 }
 
-type PaymentHash struct {
-	//*
-	//The hex-encoded payment hash of the invoice to be looked up. The passed
-	//payment hash must be exactly 32 bytes, otherwise an error is returned.
-	RHashStr string `protobuf:"bytes,1,opt,name=r_hash_str,proto3" json:"r_hash_str,omitempty"`
-	/// The payment hash of the invoice to be looked up.
-	RHash                []byte   `protobuf:"bytes,2,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+type AbandonChannelResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PaymentHash) Reset()         { *m = PaymentHash{} }
-func (m *PaymentHash) String() string { return proto.CompactTextString(m) }
-func (*PaymentHash) ProtoMessage()    {}
-func (*PaymentHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{98}
+func (m *AbandonChannelResponse) Reset()         { *m = AbandonChannelResponse{} }
+func (m *AbandonChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*AbandonChannelResponse) ProtoMessage()    {}
+func (*AbandonChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{118}
 }
 
-func (m *PaymentHash) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PaymentHash.Unmarshal(m, b)
-}
-func (m *PaymentHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PaymentHash.Marshal(b, m, deterministic)
+func (m *AbandonChannelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbandonChannelResponse.Unmarshal(m, b)
 }
-func (m *PaymentHash) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentHash.Merge(m, src)
+func (m *AbandonChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbandonChannelResponse.Marshal(b, m, deterministic)
 }
-func (m *PaymentHash) XXX_Size() int {
-	return xxx_messageInfo_PaymentHash.Size(m)
+func (m *AbandonChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbandonChannelResponse.Merge(m, src)
 }
-func (m *PaymentHash) XXX_DiscardUnknown() {
-	xxx_messageInfo_PaymentHash.DiscardUnknown(m)
+func (m *AbandonChannelResponse) XXX_Size() int {
+	return xxx_messageInfo_AbandonChannelResponse.Size(m)
 }
-
-var xxx_messageInfo_PaymentHash proto.InternalMessageInfo
-
-func (m *PaymentHash) GetRHashStr() string {
-	if m != nil {
-		return m.RHashStr
-	}
-	return ""
+func (m *AbandonChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbandonChannelResponse.DiscardUnknown(m)
 }
 
-func (m *PaymentHash) GetRHash() []byte {
-	if m != nil {
-		return m.RHash
-	}
-	return nil
-}
+var xxx_messageInfo_AbandonChannelResponse proto.InternalMessageInfo
 
-type ListInvoiceRequest struct {
-	/// If set, only unsettled invoices will be returned in the response.
-	PendingOnly bool `protobuf:"varint,1,opt,name=pending_only,proto3" json:"pending_only,omitempty"`
-	//*
-	//The index of an invoice that will be used as either the start or end of a
-	//query to determine which invoices should be returned in the response.
-	IndexOffset uint64 `protobuf:"varint,4,opt,name=index_offset,proto3" json:"index_offset,omitempty"`
-	/// The max number of invoices to return in the response to this query.
-	NumMaxInvoices uint64 `protobuf:"varint,5,opt,name=num_max_invoices,proto3" json:"num_max_invoices,omitempty"`
-	//*
-	//If set, the invoices returned will result from seeking backwards from the
-	//specified index offset. This can be used to paginate backwards.
-	Reversed             bool     `protobuf:"varint,6,opt,name=reversed,proto3" json:"reversed,omitempty"`
+type DebugLevelRequest struct {
+	Show                 bool     `protobuf:"varint,1,opt,name=show,proto3" json:"show,omitempty"`
+	LevelSpec            string   `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec,proto3" json:"level_spec,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListInvoiceRequest) Reset()         { *m = ListInvoiceRequest{} }
-func (m *ListInvoiceRequest) String() string { return proto.CompactTextString(m) }
-func (*ListInvoiceRequest) ProtoMessage()    {}
-func (*ListInvoiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{99}
+func (m *DebugLevelRequest) Reset()         { *m = DebugLevelRequest{} }
+func (m *DebugLevelRequest) String() string { return proto.CompactTextString(m) }
+func (*DebugLevelRequest) ProtoMessage()    {}
+func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{119}
 }
 
-func (m *ListInvoiceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListInvoiceRequest.Unmarshal(m, b)
+func (m *DebugLevelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DebugLevelRequest.Unmarshal(m, b)
 }
-func (m *ListInvoiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListInvoiceRequest.Marshal(b, m, deterministic)
+func (m *DebugLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DebugLevelRequest.Marshal(b, m, deterministic)
 }
-func (m *ListInvoiceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListInvoiceRequest.Merge(m, src)
+func (m *DebugLevelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DebugLevelRequest.Merge(m, src)
 }
-func (m *ListInvoiceRequest) XXX_Size() int {
-	return xxx_messageInfo_ListInvoiceRequest.Size(m)
+func (m *DebugLevelRequest) XXX_Size() int {
+	return xxx_messageInfo_DebugLevelRequest.Size(m)
 }
-func (m *ListInvoiceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListInvoiceRequest.DiscardUnknown(m)
+func (m *DebugLevelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DebugLevelRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListInvoiceRequest proto.InternalMessageInfo
+var xxx_messageInfo_DebugLevelRequest proto.InternalMessageInfo
 
-func (m *ListInvoiceRequest) GetPendingOnly() bool {
+func (m *DebugLevelRequest) GetShow() bool {
 	if m != nil {
-		return m.PendingOnly
+		return m.Show
 	}
 	return false
 }
 
-func (m *ListInvoiceRequest) GetIndexOffset() uint64 {
-	if m != nil {
-		return m.IndexOffset
-	}
-	return 0
-}
-
-func (m *ListInvoiceRequest) GetNumMaxInvoices() uint64 {
-	if m != nil {
-		return m.NumMaxInvoices
-	}
-	return 0
-}
-
-func (m *ListInvoiceRequest) GetReversed() bool {
+func (m *DebugLevelRequest) GetLevelSpec() string {
 	if m != nil {
-		return m.Reversed
+		return m.LevelSpec
 	}
-	return false
+	return ""
 }
 
-type ListInvoiceResponse struct {
-	//*
-	//A list of invoices from the time slice of the time series specified in the
-	//request.
-	Invoices []*Invoice `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
-	//*
-	//The index of the last item in the set of returned invoices. This can be used
-	//to seek further, pagination style.
-	LastIndexOffset uint64 `protobuf:"varint,2,opt,name=last_index_offset,proto3" json:"last_index_offset,omitempty"`
-	//*
-	//The index of the last item in the set of returned invoices. This can be used
-	//to seek backwards, pagination style.
-	FirstIndexOffset     uint64   `protobuf:"varint,3,opt,name=first_index_offset,proto3" json:"first_index_offset,omitempty"`
+type DebugLevelResponse struct {
+	SubSystems           string   `protobuf:"bytes,1,opt,name=sub_systems,proto3" json:"sub_systems,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListInvoiceResponse) Reset()         { *m = ListInvoiceResponse{} }
-func (m *ListInvoiceResponse) String() string { return proto.CompactTextString(m) }
-func (*ListInvoiceResponse) ProtoMessage()    {}
-func (*ListInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{100}
+func (m *DebugLevelResponse) Reset()         { *m = DebugLevelResponse{} }
+func (m *DebugLevelResponse) String() string { return proto.CompactTextString(m) }
+func (*DebugLevelResponse) ProtoMessage()    {}
+func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{120}
 }
 
-func (m *ListInvoiceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListInvoiceResponse.Unmarshal(m, b)
-}
-func (m *ListInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListInvoiceResponse.Marshal(b, m, deterministic)
+func (m *DebugLevelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DebugLevelResponse.Unmarshal(m, b)
 }
-func (m *ListInvoiceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListInvoiceResponse.Merge(m, src)
+func (m *DebugLevelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DebugLevelResponse.Marshal(b, m, deterministic)
 }
-func (m *ListInvoiceResponse) XXX_Size() int {
-	return xxx_messageInfo_ListInvoiceResponse.Size(m)
+func (m *DebugLevelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DebugLevelResponse.Merge(m, src)
 }
-func (m *ListInvoiceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListInvoiceResponse.DiscardUnknown(m)
+func (m *DebugLevelResponse) XXX_Size() int {
+	return xxx_messageInfo_DebugLevelResponse.Size(m)
 }
-
-var xxx_messageInfo_ListInvoiceResponse proto.InternalMessageInfo
-
-func (m *ListInvoiceResponse) GetInvoices() []*Invoice {
-	if m != nil {
-		return m.Invoices
-	}
-	return nil
+func (m *DebugLevelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DebugLevelResponse.DiscardUnknown(m)
 }
 
-func (m *ListInvoiceResponse) GetLastIndexOffset() uint64 {
-	if m != nil {
-		return m.LastIndexOffset
-	}
-	return 0
-}
+var xxx_messageInfo_DebugLevelResponse proto.InternalMessageInfo
 
-func (m *ListInvoiceResponse) GetFirstIndexOffset() uint64 {
+func (m *DebugLevelResponse) GetSubSystems() string {
 	if m != nil {
-		return m.FirstIndexOffset
+		return m.SubSystems
 	}
-	return 0
+	return ""
 }
 
-type InvoiceSubscription struct {
-	//*
-	//If specified (non-zero), then we'll first start by sending out
-	//notifications for all added indexes with an add_index greater than this
-	//value. This allows callers to catch up on any events they missed while they
-	//weren't connected to the streaming RPC.
-	AddIndex uint64 `protobuf:"varint,1,opt,name=add_index,proto3" json:"add_index,omitempty"`
-	//*
-	//If specified (non-zero), then we'll first start by sending out
-	//notifications for all settled indexes with an settle_index greater than
-	//this value. This allows callers to catch up on any events they missed while
-	//they weren't connected to the streaming RPC.
-	SettleIndex          uint64   `protobuf:"varint,2,opt,name=settle_index,proto3" json:"settle_index,omitempty"`
+type PayReqString struct {
+	/// The payment request string to be decoded
+	PayReq               string   `protobuf:"bytes,1,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InvoiceSubscription) Reset()         { *m = InvoiceSubscription{} }
-func (m *InvoiceSubscription) String() string { return proto.CompactTextString(m) }
-func (*InvoiceSubscription) ProtoMessage()    {}
-func (*InvoiceSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{101}
+func (m *PayReqString) Reset()         { *m = PayReqString{} }
+func (m *PayReqString) String() string { return proto.CompactTextString(m) }
+func (*PayReqString) ProtoMessage()    {}
+func (*PayReqString) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{121}
 }
 
-func (m *InvoiceSubscription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InvoiceSubscription.Unmarshal(m, b)
+func (m *PayReqString) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayReqString.Unmarshal(m, b)
 }
-func (m *InvoiceSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InvoiceSubscription.Marshal(b, m, deterministic)
+func (m *PayReqString) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayReqString.Marshal(b, m, deterministic)
 }
-func (m *InvoiceSubscription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InvoiceSubscription.Merge(m, src)
+func (m *PayReqString) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayReqString.Merge(m, src)
 }
-func (m *InvoiceSubscription) XXX_Size() int {
-	return xxx_messageInfo_InvoiceSubscription.Size(m)
+func (m *PayReqString) XXX_Size() int {
+	return xxx_messageInfo_PayReqString.Size(m)
 }
-func (m *InvoiceSubscription) XXX_DiscardUnknown() {
-	xxx_messageInfo_InvoiceSubscription.DiscardUnknown(m)
+func (m *PayReqString) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayReqString.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InvoiceSubscription proto.InternalMessageInfo
-
-func (m *InvoiceSubscription) GetAddIndex() uint64 {
-	if m != nil {
-		return m.AddIndex
-	}
-	return 0
-}
+var xxx_messageInfo_PayReqString proto.InternalMessageInfo
 
-func (m *InvoiceSubscription) GetSettleIndex() uint64 {
+func (m *PayReqString) GetPayReq() string {
 	if m != nil {
-		return m.SettleIndex
+		return m.PayReq
 	}
-	return 0
+	return ""
 }
 
-type Payment struct {
-	/// The payment hash
-	PaymentHash string `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
-	/// Deprecated, use value_sat or value_msat.
-	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"` // Deprecated: Do not use.
-	/// The date of this payment
-	CreationDate int64 `protobuf:"varint,3,opt,name=creation_date,proto3" json:"creation_date,omitempty"`
-	/// The path this payment took
-	Path []string `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"`
-	/// Deprecated, use fee_sat or fee_msat.
-	Fee int64 `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"` // Deprecated: Do not use.
-	/// The payment preimage
-	PaymentPreimage string `protobuf:"bytes,6,opt,name=payment_preimage,proto3" json:"payment_preimage,omitempty"`
-	/// The value of the payment in satoshis
-	ValueSat int64 `protobuf:"varint,7,opt,name=value_sat,proto3" json:"value_sat,omitempty"`
-	/// The value of the payment in milli-satoshis
-	ValueMsat int64 `protobuf:"varint,8,opt,name=value_msat,proto3" json:"value_msat,omitempty"`
-	/// The optional payment request being fulfilled.
-	PaymentRequest string `protobuf:"bytes,9,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
-	// The status of the payment.
-	Status Payment_PaymentStatus `protobuf:"varint,10,opt,name=status,proto3,enum=lnrpc.Payment_PaymentStatus" json:"status,omitempty"`
-	///  The fee paid for this payment in satoshis
-	FeeSat int64 `protobuf:"varint,11,opt,name=fee_sat,proto3" json:"fee_sat,omitempty"`
-	///  The fee paid for this payment in milli-satoshis
-	FeeMsat              int64    `protobuf:"varint,12,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type PayReq struct {
+	Destination          string       `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	PaymentHash          string       `protobuf:"bytes,2,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	NumSatoshis          int64        `protobuf:"varint,3,opt,name=num_satoshis,proto3" json:"num_satoshis,omitempty"`
+	Timestamp            int64        `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Expiry               int64        `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Description          string       `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	DescriptionHash      string       `protobuf:"bytes,7,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
+	FallbackAddr         string       `protobuf:"bytes,8,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
+	CltvExpiry           int64        `protobuf:"varint,9,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
+	RouteHints           []*RouteHint `protobuf:"bytes,10,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
+	PaymentAddr          []byte       `protobuf:"bytes,11,opt,name=payment_addr,proto3" json:"payment_addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *Payment) Reset()         { *m = Payment{} }
-func (m *Payment) String() string { return proto.CompactTextString(m) }
-func (*Payment) ProtoMessage()    {}
-func (*Payment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{102}
+func (m *PayReq) Reset()         { *m = PayReq{} }
+func (m *PayReq) String() string { return proto.CompactTextString(m) }
+func (*PayReq) ProtoMessage()    {}
+func (*PayReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{122}
 }
 
-func (m *Payment) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Payment.Unmarshal(m, b)
+func (m *PayReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayReq.Unmarshal(m, b)
 }
-func (m *Payment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Payment.Marshal(b, m, deterministic)
+func (m *PayReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayReq.Marshal(b, m, deterministic)
 }
-func (m *Payment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Payment.Merge(m, src)
+func (m *PayReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayReq.Merge(m, src)
 }
-func (m *Payment) XXX_Size() int {
-	return xxx_messageInfo_Payment.Size(m)
+func (m *PayReq) XXX_Size() int {
+	return xxx_messageInfo_PayReq.Size(m)
 }
-func (m *Payment) XXX_DiscardUnknown() {
-	xxx_messageInfo_Payment.DiscardUnknown(m)
+func (m *PayReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Payment proto.InternalMessageInfo
+var xxx_messageInfo_PayReq proto.InternalMessageInfo
 
-func (m *Payment) GetPaymentHash() string {
+func (m *PayReq) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *PayReq) GetPaymentHash() string {
 	if m != nil {
 		return m.PaymentHash
 	}
 	return ""
 }
 
-// Deprecated: Do not use.
-func (m *Payment) GetValue() int64 {
+func (m *PayReq) GetNumSatoshis() int64 {
 	if m != nil {
-		return m.Value
+		return m.NumSatoshis
 	}
 	return 0
 }
 
-func (m *Payment) GetCreationDate() int64 {
+func (m *PayReq) GetTimestamp() int64 {
 	if m != nil {
-		return m.CreationDate
+		return m.Timestamp
 	}
 	return 0
 }
 
-func (m *Payment) GetPath() []string {
+func (m *PayReq) GetExpiry() int64 {
 	if m != nil {
-		return m.Path
+		return m.Expiry
 	}
-	return nil
+	return 0
 }
 
-// Deprecated: Do not use.
-func (m *Payment) GetFee() int64 {
+func (m *PayReq) GetDescription() string {
 	if m != nil {
-		return m.Fee
+		return m.Description
 	}
-	return 0
+	return ""
 }
 
-func (m *Payment) GetPaymentPreimage() string {
+func (m *PayReq) GetDescriptionHash() string {
 	if m != nil {
-		return m.PaymentPreimage
+		return m.DescriptionHash
 	}
 	return ""
 }
 
-func (m *Payment) GetValueSat() int64 {
+func (m *PayReq) GetFallbackAddr() string {
 	if m != nil {
-		return m.ValueSat
+		return m.FallbackAddr
+	}
+	return ""
+}
+
+func (m *PayReq) GetCltvExpiry() int64 {
+	if m != nil {
+		return m.CltvExpiry
 	}
 	return 0
 }
-
-func (m *Payment) GetValueMsat() int64 {
-	if m != nil {
-		return m.ValueMsat
-	}
-	return 0
+
+func (m *PayReq) GetRouteHints() []*RouteHint {
+	if m != nil {
+		return m.RouteHints
+	}
+	return nil
+}
+
+func (m *PayReq) GetPaymentAddr() []byte {
+	if m != nil {
+		return m.PaymentAddr
+	}
+	return nil
+}
+
+type FeeReportRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeeReportRequest) Reset()         { *m = FeeReportRequest{} }
+func (m *FeeReportRequest) String() string { return proto.CompactTextString(m) }
+func (*FeeReportRequest) ProtoMessage()    {}
+func (*FeeReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{123}
+}
+
+func (m *FeeReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeReportRequest.Unmarshal(m, b)
+}
+func (m *FeeReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeReportRequest.Marshal(b, m, deterministic)
+}
+func (m *FeeReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeReportRequest.Merge(m, src)
+}
+func (m *FeeReportRequest) XXX_Size() int {
+	return xxx_messageInfo_FeeReportRequest.Size(m)
+}
+func (m *FeeReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeReportRequest proto.InternalMessageInfo
+
+type ChannelFeeReport struct {
+	/// The channel that this fee report belongs to.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=channel_point,proto3" json:"chan_point,omitempty"`
+	/// The base fee charged regardless of the number of milli-satoshis sent.
+	BaseFeeMsat int64 `protobuf:"varint,2,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
+	/// The amount charged per milli-satoshis transferred expressed in millionths of a satoshi.
+	FeePerMil int64 `protobuf:"varint,3,opt,name=fee_per_mil,proto3" json:"fee_per_mil,omitempty"`
+	/// The effective fee rate in milli-satoshis. Computed by dividing the fee_per_mil value by 1 million.
+	FeeRate              float64  `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelFeeReport) Reset()         { *m = ChannelFeeReport{} }
+func (m *ChannelFeeReport) String() string { return proto.CompactTextString(m) }
+func (*ChannelFeeReport) ProtoMessage()    {}
+func (*ChannelFeeReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{124}
+}
+
+func (m *ChannelFeeReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelFeeReport.Unmarshal(m, b)
+}
+func (m *ChannelFeeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelFeeReport.Marshal(b, m, deterministic)
+}
+func (m *ChannelFeeReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFeeReport.Merge(m, src)
+}
+func (m *ChannelFeeReport) XXX_Size() int {
+	return xxx_messageInfo_ChannelFeeReport.Size(m)
+}
+func (m *ChannelFeeReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFeeReport.DiscardUnknown(m)
 }
 
-func (m *Payment) GetPaymentRequest() string {
+var xxx_messageInfo_ChannelFeeReport proto.InternalMessageInfo
+
+func (m *ChannelFeeReport) GetChanPoint() string {
 	if m != nil {
-		return m.PaymentRequest
+		return m.ChanPoint
 	}
 	return ""
 }
 
-func (m *Payment) GetStatus() Payment_PaymentStatus {
+func (m *ChannelFeeReport) GetBaseFeeMsat() int64 {
 	if m != nil {
-		return m.Status
+		return m.BaseFeeMsat
 	}
-	return Payment_UNKNOWN
+	return 0
 }
 
-func (m *Payment) GetFeeSat() int64 {
+func (m *ChannelFeeReport) GetFeePerMil() int64 {
 	if m != nil {
-		return m.FeeSat
+		return m.FeePerMil
 	}
 	return 0
 }
 
-func (m *Payment) GetFeeMsat() int64 {
+func (m *ChannelFeeReport) GetFeeRate() float64 {
 	if m != nil {
-		return m.FeeMsat
+		return m.FeeRate
 	}
 	return 0
 }
 
-type ListPaymentsRequest struct {
-	//*
-	//If true, then return payments that have not yet fully completed. This means
-	//that pending payments, as well as failed payments will show up if this
-	//field is set to True.
-	IncludeIncomplete    bool     `protobuf:"varint,1,opt,name=include_incomplete,json=includeIncomplete,proto3" json:"include_incomplete,omitempty"`
+type FeeReportResponse struct {
+	/// An array of channel fee reports which describes the current fee schedule for each channel.
+	ChannelFees []*ChannelFeeReport `protobuf:"bytes,1,rep,name=channel_fees,proto3" json:"channel_fees,omitempty"`
+	/// The total amount of fee revenue (in satoshis) the switch has collected over the past 24 hrs.
+	DayFeeSum uint64 `protobuf:"varint,2,opt,name=day_fee_sum,proto3" json:"day_fee_sum,omitempty"`
+	/// The total amount of fee revenue (in satoshis) the switch has collected over the past 1 week.
+	WeekFeeSum uint64 `protobuf:"varint,3,opt,name=week_fee_sum,proto3" json:"week_fee_sum,omitempty"`
+	/// The total amount of fee revenue (in satoshis) the switch has collected over the past 1 month.
+	MonthFeeSum          uint64   `protobuf:"varint,4,opt,name=month_fee_sum,proto3" json:"month_fee_sum,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListPaymentsRequest) Reset()         { *m = ListPaymentsRequest{} }
-func (m *ListPaymentsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListPaymentsRequest) ProtoMessage()    {}
-func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{103}
+func (m *FeeReportResponse) Reset()         { *m = FeeReportResponse{} }
+func (m *FeeReportResponse) String() string { return proto.CompactTextString(m) }
+func (*FeeReportResponse) ProtoMessage()    {}
+func (*FeeReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{125}
 }
 
-func (m *ListPaymentsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListPaymentsRequest.Unmarshal(m, b)
+func (m *FeeReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeReportResponse.Unmarshal(m, b)
 }
-func (m *ListPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListPaymentsRequest.Marshal(b, m, deterministic)
+func (m *FeeReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeReportResponse.Marshal(b, m, deterministic)
 }
-func (m *ListPaymentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListPaymentsRequest.Merge(m, src)
+func (m *FeeReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeReportResponse.Merge(m, src)
 }
-func (m *ListPaymentsRequest) XXX_Size() int {
-	return xxx_messageInfo_ListPaymentsRequest.Size(m)
+func (m *FeeReportResponse) XXX_Size() int {
+	return xxx_messageInfo_FeeReportResponse.Size(m)
 }
-func (m *ListPaymentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListPaymentsRequest.DiscardUnknown(m)
+func (m *FeeReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeReportResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListPaymentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_FeeReportResponse proto.InternalMessageInfo
 
-func (m *ListPaymentsRequest) GetIncludeIncomplete() bool {
+func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
 	if m != nil {
-		return m.IncludeIncomplete
+		return m.ChannelFees
 	}
-	return false
-}
-
-type ListPaymentsResponse struct {
-	/// The list of payments
-	Payments             []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	return nil
 }
 
-func (m *ListPaymentsResponse) Reset()         { *m = ListPaymentsResponse{} }
-func (m *ListPaymentsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListPaymentsResponse) ProtoMessage()    {}
-func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{104}
+func (m *FeeReportResponse) GetDayFeeSum() uint64 {
+	if m != nil {
+		return m.DayFeeSum
+	}
+	return 0
 }
 
-func (m *ListPaymentsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListPaymentsResponse.Unmarshal(m, b)
-}
-func (m *ListPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListPaymentsResponse.Marshal(b, m, deterministic)
-}
-func (m *ListPaymentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListPaymentsResponse.Merge(m, src)
-}
-func (m *ListPaymentsResponse) XXX_Size() int {
-	return xxx_messageInfo_ListPaymentsResponse.Size(m)
-}
-func (m *ListPaymentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListPaymentsResponse.DiscardUnknown(m)
+func (m *FeeReportResponse) GetWeekFeeSum() uint64 {
+	if m != nil {
+		return m.WeekFeeSum
+	}
+	return 0
 }
 
-var xxx_messageInfo_ListPaymentsResponse proto.InternalMessageInfo
-
-func (m *ListPaymentsResponse) GetPayments() []*Payment {
+func (m *FeeReportResponse) GetMonthFeeSum() uint64 {
 	if m != nil {
-		return m.Payments
+		return m.MonthFeeSum
 	}
-	return nil
+	return 0
 }
 
-type DeleteAllPaymentsRequest struct {
+type PolicyUpdateRequest struct {
+	// Types that are valid to be assigned to Scope:
+	//	*PolicyUpdateRequest_Global
+	//	*PolicyUpdateRequest_ChanPoint
+	Scope isPolicyUpdateRequest_Scope `protobuf_oneof:"scope"`
+	/// The base fee charged regardless of the number of milli-satoshis sent.
+	BaseFeeMsat int64 `protobuf:"varint,3,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
+	/// The effective fee rate in milli-satoshis. The precision of this value goes up to 6 decimal places, so 1e-6.
+	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
+	/// The required timelock delta for HTLCs forwarded over the channel.
+	TimeLockDelta uint32 `protobuf:"varint,5,opt,name=time_lock_delta,proto3" json:"time_lock_delta,omitempty"`
+	/// If set, the maximum HTLC size in milli-satoshis. If unset, the maximum HTLC will be unchanged.
+	MaxHtlcMsat          uint64   `protobuf:"varint,6,opt,name=max_htlc_msat,proto3" json:"max_htlc_msat,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteAllPaymentsRequest) Reset()         { *m = DeleteAllPaymentsRequest{} }
-func (m *DeleteAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteAllPaymentsRequest) ProtoMessage()    {}
-func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{105}
+func (m *PolicyUpdateRequest) Reset()         { *m = PolicyUpdateRequest{} }
+func (m *PolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*PolicyUpdateRequest) ProtoMessage()    {}
+func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{126}
 }
 
-func (m *DeleteAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteAllPaymentsRequest.Unmarshal(m, b)
+func (m *PolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyUpdateRequest.Unmarshal(m, b)
 }
-func (m *DeleteAllPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteAllPaymentsRequest.Marshal(b, m, deterministic)
+func (m *PolicyUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyUpdateRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteAllPaymentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteAllPaymentsRequest.Merge(m, src)
+func (m *PolicyUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyUpdateRequest.Merge(m, src)
 }
-func (m *DeleteAllPaymentsRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteAllPaymentsRequest.Size(m)
+func (m *PolicyUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_PolicyUpdateRequest.Size(m)
 }
-func (m *DeleteAllPaymentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteAllPaymentsRequest.DiscardUnknown(m)
+func (m *PolicyUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyUpdateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteAllPaymentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_PolicyUpdateRequest proto.InternalMessageInfo
 
-type DeleteAllPaymentsResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type isPolicyUpdateRequest_Scope interface {
+	isPolicyUpdateRequest_Scope()
 }
 
-func (m *DeleteAllPaymentsResponse) Reset()         { *m = DeleteAllPaymentsResponse{} }
-func (m *DeleteAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteAllPaymentsResponse) ProtoMessage()    {}
-func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{106}
+type PolicyUpdateRequest_Global struct {
+	Global bool `protobuf:"varint,1,opt,name=global,proto3,oneof"`
 }
 
-func (m *DeleteAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteAllPaymentsResponse.Unmarshal(m, b)
-}
-func (m *DeleteAllPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteAllPaymentsResponse.Marshal(b, m, deterministic)
-}
-func (m *DeleteAllPaymentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteAllPaymentsResponse.Merge(m, src)
-}
-func (m *DeleteAllPaymentsResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteAllPaymentsResponse.Size(m)
-}
-func (m *DeleteAllPaymentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteAllPaymentsResponse.DiscardUnknown(m)
+type PolicyUpdateRequest_ChanPoint struct {
+	ChanPoint *ChannelPoint `protobuf:"bytes,2,opt,name=chan_point,proto3,oneof"`
 }
 
-var xxx_messageInfo_DeleteAllPaymentsResponse proto.InternalMessageInfo
+func (*PolicyUpdateRequest_Global) isPolicyUpdateRequest_Scope() {}
 
-type AbandonChannelRequest struct {
-	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
+func (*PolicyUpdateRequest_ChanPoint) isPolicyUpdateRequest_Scope() {}
 
-func (m *AbandonChannelRequest) Reset()         { *m = AbandonChannelRequest{} }
-func (m *AbandonChannelRequest) String() string { return proto.CompactTextString(m) }
-func (*AbandonChannelRequest) ProtoMessage()    {}
-func (*AbandonChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{107}
+func (m *PolicyUpdateRequest) GetScope() isPolicyUpdateRequest_Scope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
 }
 
-func (m *AbandonChannelRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AbandonChannelRequest.Unmarshal(m, b)
-}
-func (m *AbandonChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AbandonChannelRequest.Marshal(b, m, deterministic)
+func (m *PolicyUpdateRequest) GetGlobal() bool {
+	if x, ok := m.GetScope().(*PolicyUpdateRequest_Global); ok {
+		return x.Global
+	}
+	return false
 }
-func (m *AbandonChannelRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AbandonChannelRequest.Merge(m, src)
+
+func (m *PolicyUpdateRequest) GetChanPoint() *ChannelPoint {
+	if x, ok := m.GetScope().(*PolicyUpdateRequest_ChanPoint); ok {
+		return x.ChanPoint
+	}
+	return nil
 }
-func (m *AbandonChannelRequest) XXX_Size() int {
-	return xxx_messageInfo_AbandonChannelRequest.Size(m)
+
+func (m *PolicyUpdateRequest) GetBaseFeeMsat() int64 {
+	if m != nil {
+		return m.BaseFeeMsat
+	}
+	return 0
 }
-func (m *AbandonChannelRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AbandonChannelRequest.DiscardUnknown(m)
+
+func (m *PolicyUpdateRequest) GetFeeRate() float64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
 }
 
-var xxx_messageInfo_AbandonChannelRequest proto.InternalMessageInfo
+func (m *PolicyUpdateRequest) GetTimeLockDelta() uint32 {
+	if m != nil {
+		return m.TimeLockDelta
+	}
+	return 0
+}
 
-func (m *AbandonChannelRequest) GetChannelPoint() *ChannelPoint {
+func (m *PolicyUpdateRequest) GetMaxHtlcMsat() uint64 {
 	if m != nil {
-		return m.ChannelPoint
+		return m.MaxHtlcMsat
 	}
-	return nil
+	return 0
 }
 
-type AbandonChannelResponse struct {
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*PolicyUpdateRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*PolicyUpdateRequest_Global)(nil),
+		(*PolicyUpdateRequest_ChanPoint)(nil),
+	}
+}
+
+type PolicyUpdateResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AbandonChannelResponse) Reset()         { *m = AbandonChannelResponse{} }
-func (m *AbandonChannelResponse) String() string { return proto.CompactTextString(m) }
-func (*AbandonChannelResponse) ProtoMessage()    {}
-func (*AbandonChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{108}
+func (m *PolicyUpdateResponse) Reset()         { *m = PolicyUpdateResponse{} }
+func (m *PolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*PolicyUpdateResponse) ProtoMessage()    {}
+func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{127}
 }
 
-func (m *AbandonChannelResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AbandonChannelResponse.Unmarshal(m, b)
+func (m *PolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyUpdateResponse.Unmarshal(m, b)
 }
-func (m *AbandonChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AbandonChannelResponse.Marshal(b, m, deterministic)
+func (m *PolicyUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyUpdateResponse.Marshal(b, m, deterministic)
 }
-func (m *AbandonChannelResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AbandonChannelResponse.Merge(m, src)
+func (m *PolicyUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyUpdateResponse.Merge(m, src)
 }
-func (m *AbandonChannelResponse) XXX_Size() int {
-	return xxx_messageInfo_AbandonChannelResponse.Size(m)
+func (m *PolicyUpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_PolicyUpdateResponse.Size(m)
 }
-func (m *AbandonChannelResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AbandonChannelResponse.DiscardUnknown(m)
+func (m *PolicyUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyUpdateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AbandonChannelResponse proto.InternalMessageInfo
+var xxx_messageInfo_PolicyUpdateResponse proto.InternalMessageInfo
 
-type DebugLevelRequest struct {
-	Show                 bool     `protobuf:"varint,1,opt,name=show,proto3" json:"show,omitempty"`
-	LevelSpec            string   `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec,proto3" json:"level_spec,omitempty"`
+type ForwardingHistoryRequest struct {
+	/// Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	/// End time is the end point of the forwarding history request. The response will carry at most 50k records between the start time and the end time. The index offset can be used to implement pagination.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,proto3" json:"end_time,omitempty"`
+	/// Index offset is the offset in the time series to start at. As each response can only contain 50k records, callers can use this to skip around within a packed time series.
+	IndexOffset uint32 `protobuf:"varint,3,opt,name=index_offset,proto3" json:"index_offset,omitempty"`
+	/// The max number of events to return in the response to this query.
+	NumMaxEvents         uint32   `protobuf:"varint,4,opt,name=num_max_events,proto3" json:"num_max_events,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DebugLevelRequest) Reset()         { *m = DebugLevelRequest{} }
-func (m *DebugLevelRequest) String() string { return proto.CompactTextString(m) }
-func (*DebugLevelRequest) ProtoMessage()    {}
-func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{109}
+func (m *ForwardingHistoryRequest) Reset()         { *m = ForwardingHistoryRequest{} }
+func (m *ForwardingHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ForwardingHistoryRequest) ProtoMessage()    {}
+func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{128}
 }
 
-func (m *DebugLevelRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DebugLevelRequest.Unmarshal(m, b)
+func (m *ForwardingHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingHistoryRequest.Unmarshal(m, b)
 }
-func (m *DebugLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DebugLevelRequest.Marshal(b, m, deterministic)
+func (m *ForwardingHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingHistoryRequest.Marshal(b, m, deterministic)
 }
-func (m *DebugLevelRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DebugLevelRequest.Merge(m, src)
+func (m *ForwardingHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingHistoryRequest.Merge(m, src)
 }
-func (m *DebugLevelRequest) XXX_Size() int {
-	return xxx_messageInfo_DebugLevelRequest.Size(m)
+func (m *ForwardingHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ForwardingHistoryRequest.Size(m)
 }
-func (m *DebugLevelRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DebugLevelRequest.DiscardUnknown(m)
+func (m *ForwardingHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DebugLevelRequest proto.InternalMessageInfo
+var xxx_messageInfo_ForwardingHistoryRequest proto.InternalMessageInfo
 
-func (m *DebugLevelRequest) GetShow() bool {
+func (m *ForwardingHistoryRequest) GetStartTime() uint64 {
 	if m != nil {
-		return m.Show
+		return m.StartTime
 	}
-	return false
+	return 0
 }
 
-func (m *DebugLevelRequest) GetLevelSpec() string {
+func (m *ForwardingHistoryRequest) GetEndTime() uint64 {
 	if m != nil {
-		return m.LevelSpec
+		return m.EndTime
 	}
-	return ""
-}
-
-type DebugLevelResponse struct {
-	SubSystems           string   `protobuf:"bytes,1,opt,name=sub_systems,proto3" json:"sub_systems,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *DebugLevelResponse) Reset()         { *m = DebugLevelResponse{} }
-func (m *DebugLevelResponse) String() string { return proto.CompactTextString(m) }
-func (*DebugLevelResponse) ProtoMessage()    {}
-func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{110}
+	return 0
 }
 
-func (m *DebugLevelResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DebugLevelResponse.Unmarshal(m, b)
-}
-func (m *DebugLevelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DebugLevelResponse.Marshal(b, m, deterministic)
-}
-func (m *DebugLevelResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DebugLevelResponse.Merge(m, src)
-}
-func (m *DebugLevelResponse) XXX_Size() int {
-	return xxx_messageInfo_DebugLevelResponse.Size(m)
-}
-func (m *DebugLevelResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DebugLevelResponse.DiscardUnknown(m)
+func (m *ForwardingHistoryRequest) GetIndexOffset() uint32 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
 }
 
-var xxx_messageInfo_DebugLevelResponse proto.InternalMessageInfo
-
-func (m *DebugLevelResponse) GetSubSystems() string {
+func (m *ForwardingHistoryRequest) GetNumMaxEvents() uint32 {
 	if m != nil {
-		return m.SubSystems
+		return m.NumMaxEvents
 	}
-	return ""
+	return 0
 }
 
-type PayReqString struct {
-	/// The payment request string to be decoded
-	PayReq               string   `protobuf:"bytes,1,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
+type ForwardingEvent struct {
+	/// Timestamp is the time (unix epoch offset) that this circuit was completed.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	/// The incoming channel ID that carried the HTLC that created the circuit.
+	ChanIdIn uint64 `protobuf:"varint,2,opt,name=chan_id_in,proto3" json:"chan_id_in,omitempty"`
+	/// The outgoing channel ID that carried the preimage that completed the circuit.
+	ChanIdOut uint64 `protobuf:"varint,4,opt,name=chan_id_out,proto3" json:"chan_id_out,omitempty"`
+	/// The total amount (in satoshis) of the incoming HTLC that created half the circuit.
+	AmtIn uint64 `protobuf:"varint,5,opt,name=amt_in,proto3" json:"amt_in,omitempty"`
+	/// The total amount (in satoshis) of the outgoing HTLC that created the second half of the circuit.
+	AmtOut uint64 `protobuf:"varint,6,opt,name=amt_out,proto3" json:"amt_out,omitempty"`
+	/// The total fee (in satoshis) that this payment circuit carried.
+	Fee uint64 `protobuf:"varint,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	/// The total fee (in milli-satoshis) that this payment circuit carried.
+	FeeMsat              uint64   `protobuf:"varint,8,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PayReqString) Reset()         { *m = PayReqString{} }
-func (m *PayReqString) String() string { return proto.CompactTextString(m) }
-func (*PayReqString) ProtoMessage()    {}
-func (*PayReqString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{111}
-}
-
-func (m *PayReqString) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PayReqString.Unmarshal(m, b)
-}
-func (m *PayReqString) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PayReqString.Marshal(b, m, deterministic)
-}
-func (m *PayReqString) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PayReqString.Merge(m, src)
-}
-func (m *PayReqString) XXX_Size() int {
-	return xxx_messageInfo_PayReqString.Size(m)
-}
-func (m *PayReqString) XXX_DiscardUnknown() {
-	xxx_messageInfo_PayReqString.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_PayReqString proto.InternalMessageInfo
-
-func (m *PayReqString) GetPayReq() string {
-	if m != nil {
-		return m.PayReq
-	}
-	return ""
-}
-
-type PayReq struct {
-	Destination          string       `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
-	PaymentHash          string       `protobuf:"bytes,2,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
-	NumSatoshis          int64        `protobuf:"varint,3,opt,name=num_satoshis,proto3" json:"num_satoshis,omitempty"`
-	Timestamp            int64        `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Expiry               int64        `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	Description          string       `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	DescriptionHash      string       `protobuf:"bytes,7,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
-	FallbackAddr         string       `protobuf:"bytes,8,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
-	CltvExpiry           int64        `protobuf:"varint,9,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
-	RouteHints           []*RouteHint `protobuf:"bytes,10,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
-}
-
-func (m *PayReq) Reset()         { *m = PayReq{} }
-func (m *PayReq) String() string { return proto.CompactTextString(m) }
-func (*PayReq) ProtoMessage()    {}
-func (*PayReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{112}
+func (m *ForwardingEvent) Reset()         { *m = ForwardingEvent{} }
+func (m *ForwardingEvent) String() string { return proto.CompactTextString(m) }
+func (*ForwardingEvent) ProtoMessage()    {}
+func (*ForwardingEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{129}
 }
 
-func (m *PayReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PayReq.Unmarshal(m, b)
+func (m *ForwardingEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingEvent.Unmarshal(m, b)
 }
-func (m *PayReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PayReq.Marshal(b, m, deterministic)
+func (m *ForwardingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingEvent.Marshal(b, m, deterministic)
 }
-func (m *PayReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PayReq.Merge(m, src)
+func (m *ForwardingEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingEvent.Merge(m, src)
 }
-func (m *PayReq) XXX_Size() int {
-	return xxx_messageInfo_PayReq.Size(m)
+func (m *ForwardingEvent) XXX_Size() int {
+	return xxx_messageInfo_ForwardingEvent.Size(m)
 }
-func (m *PayReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_PayReq.DiscardUnknown(m)
+func (m *ForwardingEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PayReq proto.InternalMessageInfo
+var xxx_messageInfo_ForwardingEvent proto.InternalMessageInfo
 
-func (m *PayReq) GetDestination() string {
+func (m *ForwardingEvent) GetTimestamp() uint64 {
 	if m != nil {
-		return m.Destination
+		return m.Timestamp
 	}
-	return ""
+	return 0
 }
 
-func (m *PayReq) GetPaymentHash() string {
+func (m *ForwardingEvent) GetChanIdIn() uint64 {
 	if m != nil {
-		return m.PaymentHash
+		return m.ChanIdIn
 	}
-	return ""
+	return 0
 }
 
-func (m *PayReq) GetNumSatoshis() int64 {
+func (m *ForwardingEvent) GetChanIdOut() uint64 {
 	if m != nil {
-		return m.NumSatoshis
+		return m.ChanIdOut
 	}
 	return 0
 }
 
-func (m *PayReq) GetTimestamp() int64 {
+func (m *ForwardingEvent) GetAmtIn() uint64 {
 	if m != nil {
-		return m.Timestamp
+		return m.AmtIn
 	}
 	return 0
 }
 
-func (m *PayReq) GetExpiry() int64 {
+func (m *ForwardingEvent) GetAmtOut() uint64 {
 	if m != nil {
-		return m.Expiry
+		return m.AmtOut
 	}
 	return 0
 }
 
-func (m *PayReq) GetDescription() string {
+func (m *ForwardingEvent) GetFee() uint64 {
 	if m != nil {
-		return m.Description
+		return m.Fee
 	}
-	return ""
+	return 0
 }
 
-func (m *PayReq) GetDescriptionHash() string {
+func (m *ForwardingEvent) GetFeeMsat() uint64 {
 	if m != nil {
-		return m.DescriptionHash
+		return m.FeeMsat
 	}
-	return ""
+	return 0
 }
 
-func (m *PayReq) GetFallbackAddr() string {
+type ForwardingHistoryResponse struct {
+	/// A list of forwarding events from the time slice of the time series specified in the request.
+	ForwardingEvents []*ForwardingEvent `protobuf:"bytes,1,rep,name=forwarding_events,proto3" json:"forwarding_events,omitempty"`
+	/// The index of the last time in the set of returned forwarding events. Can be used to seek further, pagination style.
+	LastOffsetIndex uint32 `protobuf:"varint,2,opt,name=last_offset_index,proto3" json:"last_offset_index,omitempty"`
+	//*
+	//The total fees in milli-satoshis paid by the rebalancer for successful
+	//rebalances within the time slice specified in the request. This is the
+	//cost of keeping our channels balanced, as opposed to the routing income
+	//reported by the forwarding events.
+	RebalanceFeeMsat     uint64   `protobuf:"varint,3,opt,name=rebalance_fee_msat,proto3" json:"rebalance_fee_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForwardingHistoryResponse) Reset()         { *m = ForwardingHistoryResponse{} }
+func (m *ForwardingHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ForwardingHistoryResponse) ProtoMessage()    {}
+func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{130}
+}
+
+func (m *ForwardingHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingHistoryResponse.Unmarshal(m, b)
+}
+func (m *ForwardingHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *ForwardingHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingHistoryResponse.Merge(m, src)
+}
+func (m *ForwardingHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ForwardingHistoryResponse.Size(m)
+}
+func (m *ForwardingHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardingHistoryResponse proto.InternalMessageInfo
+
+func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
 	if m != nil {
-		return m.FallbackAddr
+		return m.ForwardingEvents
 	}
-	return ""
+	return nil
 }
 
-func (m *PayReq) GetCltvExpiry() int64 {
+func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
 	if m != nil {
-		return m.CltvExpiry
+		return m.LastOffsetIndex
 	}
 	return 0
 }
 
-func (m *PayReq) GetRouteHints() []*RouteHint {
+func (m *ForwardingHistoryResponse) GetRebalanceFeeMsat() uint64 {
 	if m != nil {
-		return m.RouteHints
+		return m.RebalanceFeeMsat
 	}
-	return nil
+	return 0
 }
 
-type FeeReportRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ExportChannelBackupRequest struct {
+	/// The target channel point to obtain a back up for.
+	ChanPoint            *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *FeeReportRequest) Reset()         { *m = FeeReportRequest{} }
-func (m *FeeReportRequest) String() string { return proto.CompactTextString(m) }
-func (*FeeReportRequest) ProtoMessage()    {}
-func (*FeeReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{113}
+func (m *ExportChannelBackupRequest) Reset()         { *m = ExportChannelBackupRequest{} }
+func (m *ExportChannelBackupRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportChannelBackupRequest) ProtoMessage()    {}
+func (*ExportChannelBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{131}
 }
 
-func (m *FeeReportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FeeReportRequest.Unmarshal(m, b)
+func (m *ExportChannelBackupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportChannelBackupRequest.Unmarshal(m, b)
 }
-func (m *FeeReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FeeReportRequest.Marshal(b, m, deterministic)
+func (m *ExportChannelBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportChannelBackupRequest.Marshal(b, m, deterministic)
 }
-func (m *FeeReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FeeReportRequest.Merge(m, src)
+func (m *ExportChannelBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportChannelBackupRequest.Merge(m, src)
 }
-func (m *FeeReportRequest) XXX_Size() int {
-	return xxx_messageInfo_FeeReportRequest.Size(m)
+func (m *ExportChannelBackupRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportChannelBackupRequest.Size(m)
 }
-func (m *FeeReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FeeReportRequest.DiscardUnknown(m)
+func (m *ExportChannelBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportChannelBackupRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FeeReportRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExportChannelBackupRequest proto.InternalMessageInfo
 
-type ChannelFeeReport struct {
-	/// The channel that this fee report belongs to.
-	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=channel_point,proto3" json:"chan_point,omitempty"`
-	/// The base fee charged regardless of the number of milli-satoshis sent.
-	BaseFeeMsat int64 `protobuf:"varint,2,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
-	/// The amount charged per milli-satoshis transferred expressed in millionths of a satoshi.
-	FeePerMil int64 `protobuf:"varint,3,opt,name=fee_per_mil,proto3" json:"fee_per_mil,omitempty"`
-	/// The effective fee rate in milli-satoshis. Computed by dividing the fee_per_mil value by 1 million.
-	FeeRate              float64  `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
+func (m *ExportChannelBackupRequest) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+type ChannelBackup struct {
+	//*
+	//Identifies the channel that this backup belongs to.
+	ChanPoint *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
+	//*
+	//Is an encrypted single-chan backup. this can be passed to
+	//RestoreChannelBackups, or the WalletUnlocker Init and Unlock methods in
+	//order to trigger the recovery protocol.
+	ChanBackup           []byte   `protobuf:"bytes,2,opt,name=chan_backup,proto3" json:"chan_backup,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelFeeReport) Reset()         { *m = ChannelFeeReport{} }
-func (m *ChannelFeeReport) String() string { return proto.CompactTextString(m) }
-func (*ChannelFeeReport) ProtoMessage()    {}
-func (*ChannelFeeReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{114}
+func (m *ChannelBackup) Reset()         { *m = ChannelBackup{} }
+func (m *ChannelBackup) String() string { return proto.CompactTextString(m) }
+func (*ChannelBackup) ProtoMessage()    {}
+func (*ChannelBackup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{132}
 }
 
-func (m *ChannelFeeReport) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelFeeReport.Unmarshal(m, b)
+func (m *ChannelBackup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBackup.Unmarshal(m, b)
 }
-func (m *ChannelFeeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelFeeReport.Marshal(b, m, deterministic)
+func (m *ChannelBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBackup.Marshal(b, m, deterministic)
 }
-func (m *ChannelFeeReport) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelFeeReport.Merge(m, src)
+func (m *ChannelBackup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBackup.Merge(m, src)
 }
-func (m *ChannelFeeReport) XXX_Size() int {
-	return xxx_messageInfo_ChannelFeeReport.Size(m)
+func (m *ChannelBackup) XXX_Size() int {
+	return xxx_messageInfo_ChannelBackup.Size(m)
 }
-func (m *ChannelFeeReport) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelFeeReport.DiscardUnknown(m)
+func (m *ChannelBackup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBackup.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelFeeReport proto.InternalMessageInfo
+var xxx_messageInfo_ChannelBackup proto.InternalMessageInfo
 
-func (m *ChannelFeeReport) GetChanPoint() string {
+func (m *ChannelBackup) GetChanPoint() *ChannelPoint {
 	if m != nil {
 		return m.ChanPoint
 	}
-	return ""
-}
-
-func (m *ChannelFeeReport) GetBaseFeeMsat() int64 {
-	if m != nil {
-		return m.BaseFeeMsat
-	}
-	return 0
-}
-
-func (m *ChannelFeeReport) GetFeePerMil() int64 {
-	if m != nil {
-		return m.FeePerMil
-	}
-	return 0
+	return nil
 }
 
-func (m *ChannelFeeReport) GetFeeRate() float64 {
+func (m *ChannelBackup) GetChanBackup() []byte {
 	if m != nil {
-		return m.FeeRate
+		return m.ChanBackup
 	}
-	return 0
+	return nil
 }
 
-type FeeReportResponse struct {
-	/// An array of channel fee reports which describes the current fee schedule for each channel.
-	ChannelFees []*ChannelFeeReport `protobuf:"bytes,1,rep,name=channel_fees,proto3" json:"channel_fees,omitempty"`
-	/// The total amount of fee revenue (in satoshis) the switch has collected over the past 24 hrs.
-	DayFeeSum uint64 `protobuf:"varint,2,opt,name=day_fee_sum,proto3" json:"day_fee_sum,omitempty"`
-	/// The total amount of fee revenue (in satoshis) the switch has collected over the past 1 week.
-	WeekFeeSum uint64 `protobuf:"varint,3,opt,name=week_fee_sum,proto3" json:"week_fee_sum,omitempty"`
-	/// The total amount of fee revenue (in satoshis) the switch has collected over the past 1 month.
-	MonthFeeSum          uint64   `protobuf:"varint,4,opt,name=month_fee_sum,proto3" json:"month_fee_sum,omitempty"`
+type MultiChanBackup struct {
+	//*
+	//Is the set of all channels that are included in this multi-channel backup.
+	ChanPoints []*ChannelPoint `protobuf:"bytes,1,rep,name=chan_points,proto3" json:"chan_points,omitempty"`
+	//*
+	//A single encrypted blob containing all the static channel backups of the
+	//channel listed above. This can be stored as a single file or blob, and
+	//safely be replaced with any prior/future versions.
+	MultiChanBackup      []byte   `protobuf:"bytes,2,opt,name=multi_chan_backup,proto3" json:"multi_chan_backup,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FeeReportResponse) Reset()         { *m = FeeReportResponse{} }
-func (m *FeeReportResponse) String() string { return proto.CompactTextString(m) }
-func (*FeeReportResponse) ProtoMessage()    {}
-func (*FeeReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{115}
+func (m *MultiChanBackup) Reset()         { *m = MultiChanBackup{} }
+func (m *MultiChanBackup) String() string { return proto.CompactTextString(m) }
+func (*MultiChanBackup) ProtoMessage()    {}
+func (*MultiChanBackup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{133}
 }
 
-func (m *FeeReportResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FeeReportResponse.Unmarshal(m, b)
-}
-func (m *FeeReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FeeReportResponse.Marshal(b, m, deterministic)
-}
-func (m *FeeReportResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FeeReportResponse.Merge(m, src)
-}
-func (m *FeeReportResponse) XXX_Size() int {
-	return xxx_messageInfo_FeeReportResponse.Size(m)
+func (m *MultiChanBackup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiChanBackup.Unmarshal(m, b)
 }
-func (m *FeeReportResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FeeReportResponse.DiscardUnknown(m)
+func (m *MultiChanBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiChanBackup.Marshal(b, m, deterministic)
 }
-
-var xxx_messageInfo_FeeReportResponse proto.InternalMessageInfo
-
-func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
-	if m != nil {
-		return m.ChannelFees
-	}
-	return nil
+func (m *MultiChanBackup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiChanBackup.Merge(m, src)
 }
-
-func (m *FeeReportResponse) GetDayFeeSum() uint64 {
-	if m != nil {
-		return m.DayFeeSum
-	}
-	return 0
+func (m *MultiChanBackup) XXX_Size() int {
+	return xxx_messageInfo_MultiChanBackup.Size(m)
+}
+func (m *MultiChanBackup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiChanBackup.DiscardUnknown(m)
 }
 
-func (m *FeeReportResponse) GetWeekFeeSum() uint64 {
+var xxx_messageInfo_MultiChanBackup proto.InternalMessageInfo
+
+func (m *MultiChanBackup) GetChanPoints() []*ChannelPoint {
 	if m != nil {
-		return m.WeekFeeSum
+		return m.ChanPoints
 	}
-	return 0
+	return nil
 }
 
-func (m *FeeReportResponse) GetMonthFeeSum() uint64 {
+func (m *MultiChanBackup) GetMultiChanBackup() []byte {
 	if m != nil {
-		return m.MonthFeeSum
+		return m.MultiChanBackup
 	}
-	return 0
+	return nil
 }
 
-type PolicyUpdateRequest struct {
-	// Types that are valid to be assigned to Scope:
-	//	*PolicyUpdateRequest_Global
-	//	*PolicyUpdateRequest_ChanPoint
-	Scope isPolicyUpdateRequest_Scope `protobuf_oneof:"scope"`
-	/// The base fee charged regardless of the number of milli-satoshis sent.
-	BaseFeeMsat int64 `protobuf:"varint,3,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
-	/// The effective fee rate in milli-satoshis. The precision of this value goes up to 6 decimal places, so 1e-6.
-	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
-	/// The required timelock delta for HTLCs forwarded over the channel.
-	TimeLockDelta uint32 `protobuf:"varint,5,opt,name=time_lock_delta,proto3" json:"time_lock_delta,omitempty"`
-	/// If set, the maximum HTLC size in milli-satoshis. If unset, the maximum HTLC will be unchanged.
-	MaxHtlcMsat          uint64   `protobuf:"varint,6,opt,name=max_htlc_msat,proto3" json:"max_htlc_msat,omitempty"`
+type ChanBackupExportRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PolicyUpdateRequest) Reset()         { *m = PolicyUpdateRequest{} }
-func (m *PolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
-func (*PolicyUpdateRequest) ProtoMessage()    {}
-func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{116}
+func (m *ChanBackupExportRequest) Reset()         { *m = ChanBackupExportRequest{} }
+func (m *ChanBackupExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ChanBackupExportRequest) ProtoMessage()    {}
+func (*ChanBackupExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{134}
 }
 
-func (m *PolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PolicyUpdateRequest.Unmarshal(m, b)
+func (m *ChanBackupExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChanBackupExportRequest.Unmarshal(m, b)
 }
-func (m *PolicyUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PolicyUpdateRequest.Marshal(b, m, deterministic)
+func (m *ChanBackupExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChanBackupExportRequest.Marshal(b, m, deterministic)
 }
-func (m *PolicyUpdateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PolicyUpdateRequest.Merge(m, src)
+func (m *ChanBackupExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChanBackupExportRequest.Merge(m, src)
 }
-func (m *PolicyUpdateRequest) XXX_Size() int {
-	return xxx_messageInfo_PolicyUpdateRequest.Size(m)
+func (m *ChanBackupExportRequest) XXX_Size() int {
+	return xxx_messageInfo_ChanBackupExportRequest.Size(m)
 }
-func (m *PolicyUpdateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PolicyUpdateRequest.DiscardUnknown(m)
+func (m *ChanBackupExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChanBackupExportRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PolicyUpdateRequest proto.InternalMessageInfo
+var xxx_messageInfo_ChanBackupExportRequest proto.InternalMessageInfo
 
-type isPolicyUpdateRequest_Scope interface {
-	isPolicyUpdateRequest_Scope()
+type ChanBackupSnapshot struct {
+	//*
+	//The set of new channels that have been added since the last channel backup
+	//snapshot was requested.
+	SingleChanBackups *ChannelBackups `protobuf:"bytes,1,opt,name=single_chan_backups,proto3" json:"single_chan_backups,omitempty"`
+	//*
+	//A multi-channel backup that covers all open channels currently known to
+	//lnd.
+	MultiChanBackup      *MultiChanBackup `protobuf:"bytes,2,opt,name=multi_chan_backup,proto3" json:"multi_chan_backup,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-type PolicyUpdateRequest_Global struct {
-	Global bool `protobuf:"varint,1,opt,name=global,proto3,oneof"`
+func (m *ChanBackupSnapshot) Reset()         { *m = ChanBackupSnapshot{} }
+func (m *ChanBackupSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ChanBackupSnapshot) ProtoMessage()    {}
+func (*ChanBackupSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{135}
 }
 
-type PolicyUpdateRequest_ChanPoint struct {
-	ChanPoint *ChannelPoint `protobuf:"bytes,2,opt,name=chan_point,proto3,oneof"`
+func (m *ChanBackupSnapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChanBackupSnapshot.Unmarshal(m, b)
+}
+func (m *ChanBackupSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChanBackupSnapshot.Marshal(b, m, deterministic)
+}
+func (m *ChanBackupSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChanBackupSnapshot.Merge(m, src)
+}
+func (m *ChanBackupSnapshot) XXX_Size() int {
+	return xxx_messageInfo_ChanBackupSnapshot.Size(m)
+}
+func (m *ChanBackupSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChanBackupSnapshot.DiscardUnknown(m)
 }
 
-func (*PolicyUpdateRequest_Global) isPolicyUpdateRequest_Scope() {}
-
-func (*PolicyUpdateRequest_ChanPoint) isPolicyUpdateRequest_Scope() {}
+var xxx_messageInfo_ChanBackupSnapshot proto.InternalMessageInfo
 
-func (m *PolicyUpdateRequest) GetScope() isPolicyUpdateRequest_Scope {
+func (m *ChanBackupSnapshot) GetSingleChanBackups() *ChannelBackups {
 	if m != nil {
-		return m.Scope
+		return m.SingleChanBackups
 	}
 	return nil
 }
 
-func (m *PolicyUpdateRequest) GetGlobal() bool {
-	if x, ok := m.GetScope().(*PolicyUpdateRequest_Global); ok {
-		return x.Global
-	}
-	return false
-}
-
-func (m *PolicyUpdateRequest) GetChanPoint() *ChannelPoint {
-	if x, ok := m.GetScope().(*PolicyUpdateRequest_ChanPoint); ok {
-		return x.ChanPoint
+func (m *ChanBackupSnapshot) GetMultiChanBackup() *MultiChanBackup {
+	if m != nil {
+		return m.MultiChanBackup
 	}
 	return nil
 }
 
-func (m *PolicyUpdateRequest) GetBaseFeeMsat() int64 {
-	if m != nil {
-		return m.BaseFeeMsat
-	}
-	return 0
+type ChannelBackups struct {
+	//*
+	//A set of single-chan static channel backups.
+	ChanBackups          []*ChannelBackup `protobuf:"bytes,1,rep,name=chan_backups,proto3" json:"chan_backups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *PolicyUpdateRequest) GetFeeRate() float64 {
-	if m != nil {
-		return m.FeeRate
-	}
-	return 0
+func (m *ChannelBackups) Reset()         { *m = ChannelBackups{} }
+func (m *ChannelBackups) String() string { return proto.CompactTextString(m) }
+func (*ChannelBackups) ProtoMessage()    {}
+func (*ChannelBackups) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{136}
 }
 
-func (m *PolicyUpdateRequest) GetTimeLockDelta() uint32 {
-	if m != nil {
-		return m.TimeLockDelta
-	}
-	return 0
+func (m *ChannelBackups) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBackups.Unmarshal(m, b)
 }
-
-func (m *PolicyUpdateRequest) GetMaxHtlcMsat() uint64 {
-	if m != nil {
-		return m.MaxHtlcMsat
-	}
-	return 0
+func (m *ChannelBackups) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBackups.Marshal(b, m, deterministic)
+}
+func (m *ChannelBackups) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBackups.Merge(m, src)
+}
+func (m *ChannelBackups) XXX_Size() int {
+	return xxx_messageInfo_ChannelBackups.Size(m)
+}
+func (m *ChannelBackups) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBackups.DiscardUnknown(m)
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*PolicyUpdateRequest) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*PolicyUpdateRequest_Global)(nil),
-		(*PolicyUpdateRequest_ChanPoint)(nil),
+var xxx_messageInfo_ChannelBackups proto.InternalMessageInfo
+
+func (m *ChannelBackups) GetChanBackups() []*ChannelBackup {
+	if m != nil {
+		return m.ChanBackups
 	}
+	return nil
 }
 
-type PolicyUpdateResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type RestoreChanBackupRequest struct {
+	// Types that are valid to be assigned to Backup:
+	//	*RestoreChanBackupRequest_ChanBackups
+	//	*RestoreChanBackupRequest_MultiChanBackup
+	Backup               isRestoreChanBackupRequest_Backup `protobuf_oneof:"backup"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
-func (m *PolicyUpdateResponse) Reset()         { *m = PolicyUpdateResponse{} }
-func (m *PolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
-func (*PolicyUpdateResponse) ProtoMessage()    {}
-func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{117}
+func (m *RestoreChanBackupRequest) Reset()         { *m = RestoreChanBackupRequest{} }
+func (m *RestoreChanBackupRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreChanBackupRequest) ProtoMessage()    {}
+func (*RestoreChanBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{137}
 }
 
-func (m *PolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PolicyUpdateResponse.Unmarshal(m, b)
-}
-func (m *PolicyUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PolicyUpdateResponse.Marshal(b, m, deterministic)
+func (m *RestoreChanBackupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreChanBackupRequest.Unmarshal(m, b)
 }
-func (m *PolicyUpdateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PolicyUpdateResponse.Merge(m, src)
+func (m *RestoreChanBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreChanBackupRequest.Marshal(b, m, deterministic)
 }
-func (m *PolicyUpdateResponse) XXX_Size() int {
-	return xxx_messageInfo_PolicyUpdateResponse.Size(m)
+func (m *RestoreChanBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreChanBackupRequest.Merge(m, src)
 }
-func (m *PolicyUpdateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PolicyUpdateResponse.DiscardUnknown(m)
+func (m *RestoreChanBackupRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreChanBackupRequest.Size(m)
 }
-
-var xxx_messageInfo_PolicyUpdateResponse proto.InternalMessageInfo
-
-type ForwardingHistoryRequest struct {
-	/// Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
-	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,proto3" json:"start_time,omitempty"`
-	/// End time is the end point of the forwarding history request. The response will carry at most 50k records between the start time and the end time. The index offset can be used to implement pagination.
-	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,proto3" json:"end_time,omitempty"`
-	/// Index offset is the offset in the time series to start at. As each response can only contain 50k records, callers can use this to skip around within a packed time series.
-	IndexOffset uint32 `protobuf:"varint,3,opt,name=index_offset,proto3" json:"index_offset,omitempty"`
-	/// The max number of events to return in the response to this query.
-	NumMaxEvents         uint32   `protobuf:"varint,4,opt,name=num_max_events,proto3" json:"num_max_events,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *RestoreChanBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreChanBackupRequest.DiscardUnknown(m)
 }
 
-func (m *ForwardingHistoryRequest) Reset()         { *m = ForwardingHistoryRequest{} }
-func (m *ForwardingHistoryRequest) String() string { return proto.CompactTextString(m) }
-func (*ForwardingHistoryRequest) ProtoMessage()    {}
-func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{118}
-}
+var xxx_messageInfo_RestoreChanBackupRequest proto.InternalMessageInfo
 
-func (m *ForwardingHistoryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ForwardingHistoryRequest.Unmarshal(m, b)
-}
-func (m *ForwardingHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ForwardingHistoryRequest.Marshal(b, m, deterministic)
-}
-func (m *ForwardingHistoryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForwardingHistoryRequest.Merge(m, src)
+type isRestoreChanBackupRequest_Backup interface {
+	isRestoreChanBackupRequest_Backup()
 }
-func (m *ForwardingHistoryRequest) XXX_Size() int {
-	return xxx_messageInfo_ForwardingHistoryRequest.Size(m)
+
+type RestoreChanBackupRequest_ChanBackups struct {
+	ChanBackups *ChannelBackups `protobuf:"bytes,1,opt,name=chan_backups,proto3,oneof"`
 }
-func (m *ForwardingHistoryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForwardingHistoryRequest.DiscardUnknown(m)
+
+type RestoreChanBackupRequest_MultiChanBackup struct {
+	MultiChanBackup []byte `protobuf:"bytes,2,opt,name=multi_chan_backup,proto3,oneof"`
 }
 
-var xxx_messageInfo_ForwardingHistoryRequest proto.InternalMessageInfo
+func (*RestoreChanBackupRequest_ChanBackups) isRestoreChanBackupRequest_Backup() {}
 
-func (m *ForwardingHistoryRequest) GetStartTime() uint64 {
+func (*RestoreChanBackupRequest_MultiChanBackup) isRestoreChanBackupRequest_Backup() {}
+
+func (m *RestoreChanBackupRequest) GetBackup() isRestoreChanBackupRequest_Backup {
 	if m != nil {
-		return m.StartTime
+		return m.Backup
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingHistoryRequest) GetEndTime() uint64 {
-	if m != nil {
-		return m.EndTime
+func (m *RestoreChanBackupRequest) GetChanBackups() *ChannelBackups {
+	if x, ok := m.GetBackup().(*RestoreChanBackupRequest_ChanBackups); ok {
+		return x.ChanBackups
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingHistoryRequest) GetIndexOffset() uint32 {
-	if m != nil {
-		return m.IndexOffset
+func (m *RestoreChanBackupRequest) GetMultiChanBackup() []byte {
+	if x, ok := m.GetBackup().(*RestoreChanBackupRequest_MultiChanBackup); ok {
+		return x.MultiChanBackup
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingHistoryRequest) GetNumMaxEvents() uint32 {
-	if m != nil {
-		return m.NumMaxEvents
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*RestoreChanBackupRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*RestoreChanBackupRequest_ChanBackups)(nil),
+		(*RestoreChanBackupRequest_MultiChanBackup)(nil),
 	}
-	return 0
 }
 
-type ForwardingEvent struct {
-	/// Timestamp is the time (unix epoch offset) that this circuit was completed.
-	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	/// The incoming channel ID that carried the HTLC that created the circuit.
-	ChanIdIn uint64 `protobuf:"varint,2,opt,name=chan_id_in,proto3" json:"chan_id_in,omitempty"`
-	/// The outgoing channel ID that carried the preimage that completed the circuit.
-	ChanIdOut uint64 `protobuf:"varint,4,opt,name=chan_id_out,proto3" json:"chan_id_out,omitempty"`
-	/// The total amount (in satoshis) of the incoming HTLC that created half the circuit.
-	AmtIn uint64 `protobuf:"varint,5,opt,name=amt_in,proto3" json:"amt_in,omitempty"`
-	/// The total amount (in satoshis) of the outgoing HTLC that created the second half of the circuit.
-	AmtOut uint64 `protobuf:"varint,6,opt,name=amt_out,proto3" json:"amt_out,omitempty"`
-	/// The total fee (in satoshis) that this payment circuit carried.
-	Fee uint64 `protobuf:"varint,7,opt,name=fee,proto3" json:"fee,omitempty"`
-	/// The total fee (in milli-satoshis) that this payment circuit carried.
-	FeeMsat              uint64   `protobuf:"varint,8,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
+type RestoreBackupResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ForwardingEvent) Reset()         { *m = ForwardingEvent{} }
-func (m *ForwardingEvent) String() string { return proto.CompactTextString(m) }
-func (*ForwardingEvent) ProtoMessage()    {}
-func (*ForwardingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{119}
+func (m *RestoreBackupResponse) Reset()         { *m = RestoreBackupResponse{} }
+func (m *RestoreBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreBackupResponse) ProtoMessage()    {}
+func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{138}
 }
 
-func (m *ForwardingEvent) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ForwardingEvent.Unmarshal(m, b)
+func (m *RestoreBackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreBackupResponse.Unmarshal(m, b)
 }
-func (m *ForwardingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ForwardingEvent.Marshal(b, m, deterministic)
+func (m *RestoreBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreBackupResponse.Marshal(b, m, deterministic)
 }
-func (m *ForwardingEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForwardingEvent.Merge(m, src)
+func (m *RestoreBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreBackupResponse.Merge(m, src)
 }
-func (m *ForwardingEvent) XXX_Size() int {
-	return xxx_messageInfo_ForwardingEvent.Size(m)
+func (m *RestoreBackupResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreBackupResponse.Size(m)
 }
-func (m *ForwardingEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForwardingEvent.DiscardUnknown(m)
+func (m *RestoreBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreBackupResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ForwardingEvent proto.InternalMessageInfo
+var xxx_messageInfo_RestoreBackupResponse proto.InternalMessageInfo
 
-func (m *ForwardingEvent) GetTimestamp() uint64 {
-	if m != nil {
-		return m.Timestamp
-	}
-	return 0
+type ChannelBackupSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ForwardingEvent) GetChanIdIn() uint64 {
-	if m != nil {
-		return m.ChanIdIn
-	}
-	return 0
+func (m *ChannelBackupSubscription) Reset()         { *m = ChannelBackupSubscription{} }
+func (m *ChannelBackupSubscription) String() string { return proto.CompactTextString(m) }
+func (*ChannelBackupSubscription) ProtoMessage()    {}
+func (*ChannelBackupSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{139}
 }
 
-func (m *ForwardingEvent) GetChanIdOut() uint64 {
-	if m != nil {
-		return m.ChanIdOut
-	}
-	return 0
+func (m *ChannelBackupSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBackupSubscription.Unmarshal(m, b)
 }
-
-func (m *ForwardingEvent) GetAmtIn() uint64 {
-	if m != nil {
-		return m.AmtIn
-	}
-	return 0
+func (m *ChannelBackupSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBackupSubscription.Marshal(b, m, deterministic)
 }
-
-func (m *ForwardingEvent) GetAmtOut() uint64 {
-	if m != nil {
-		return m.AmtOut
-	}
-	return 0
+func (m *ChannelBackupSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBackupSubscription.Merge(m, src)
 }
-
-func (m *ForwardingEvent) GetFee() uint64 {
-	if m != nil {
-		return m.Fee
-	}
-	return 0
+func (m *ChannelBackupSubscription) XXX_Size() int {
+	return xxx_messageInfo_ChannelBackupSubscription.Size(m)
 }
-
-func (m *ForwardingEvent) GetFeeMsat() uint64 {
-	if m != nil {
-		return m.FeeMsat
-	}
-	return 0
+func (m *ChannelBackupSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBackupSubscription.DiscardUnknown(m)
 }
 
-type ForwardingHistoryResponse struct {
-	/// A list of forwarding events from the time slice of the time series specified in the request.
-	ForwardingEvents []*ForwardingEvent `protobuf:"bytes,1,rep,name=forwarding_events,proto3" json:"forwarding_events,omitempty"`
-	/// The index of the last time in the set of returned forwarding events. Can be used to seek further, pagination style.
-	LastOffsetIndex      uint32   `protobuf:"varint,2,opt,name=last_offset_index,proto3" json:"last_offset_index,omitempty"`
+var xxx_messageInfo_ChannelBackupSubscription proto.InternalMessageInfo
+
+type VerifyChanBackupResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ForwardingHistoryResponse) Reset()         { *m = ForwardingHistoryResponse{} }
-func (m *ForwardingHistoryResponse) String() string { return proto.CompactTextString(m) }
-func (*ForwardingHistoryResponse) ProtoMessage()    {}
-func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{120}
+func (m *VerifyChanBackupResponse) Reset()         { *m = VerifyChanBackupResponse{} }
+func (m *VerifyChanBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyChanBackupResponse) ProtoMessage()    {}
+func (*VerifyChanBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{140}
 }
 
-func (m *ForwardingHistoryResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ForwardingHistoryResponse.Unmarshal(m, b)
-}
-func (m *ForwardingHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ForwardingHistoryResponse.Marshal(b, m, deterministic)
+func (m *VerifyChanBackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyChanBackupResponse.Unmarshal(m, b)
 }
-func (m *ForwardingHistoryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForwardingHistoryResponse.Merge(m, src)
+func (m *VerifyChanBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyChanBackupResponse.Marshal(b, m, deterministic)
 }
-func (m *ForwardingHistoryResponse) XXX_Size() int {
-	return xxx_messageInfo_ForwardingHistoryResponse.Size(m)
+func (m *VerifyChanBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyChanBackupResponse.Merge(m, src)
 }
-func (m *ForwardingHistoryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForwardingHistoryResponse.DiscardUnknown(m)
+func (m *VerifyChanBackupResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyChanBackupResponse.Size(m)
 }
-
-var xxx_messageInfo_ForwardingHistoryResponse proto.InternalMessageInfo
-
-func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
-	if m != nil {
-		return m.ForwardingEvents
-	}
-	return nil
+func (m *VerifyChanBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyChanBackupResponse.DiscardUnknown(m)
 }
 
-func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
-	if m != nil {
-		return m.LastOffsetIndex
-	}
-	return 0
-}
+var xxx_messageInfo_VerifyChanBackupResponse proto.InternalMessageInfo
 
-type ExportChannelBackupRequest struct {
-	/// The target channel point to obtain a back up for.
-	ChanPoint            *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type MacaroonPermission struct {
+	/// The entity a permission grants access to.
+	Entity string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	/// The action that is granted.
+	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ExportChannelBackupRequest) Reset()         { *m = ExportChannelBackupRequest{} }
-func (m *ExportChannelBackupRequest) String() string { return proto.CompactTextString(m) }
-func (*ExportChannelBackupRequest) ProtoMessage()    {}
-func (*ExportChannelBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{121}
+func (m *MacaroonPermission) Reset()         { *m = MacaroonPermission{} }
+func (m *MacaroonPermission) String() string { return proto.CompactTextString(m) }
+func (*MacaroonPermission) ProtoMessage()    {}
+func (*MacaroonPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{141}
 }
 
-func (m *ExportChannelBackupRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ExportChannelBackupRequest.Unmarshal(m, b)
+func (m *MacaroonPermission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MacaroonPermission.Unmarshal(m, b)
 }
-func (m *ExportChannelBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ExportChannelBackupRequest.Marshal(b, m, deterministic)
+func (m *MacaroonPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MacaroonPermission.Marshal(b, m, deterministic)
 }
-func (m *ExportChannelBackupRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExportChannelBackupRequest.Merge(m, src)
+func (m *MacaroonPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MacaroonPermission.Merge(m, src)
 }
-func (m *ExportChannelBackupRequest) XXX_Size() int {
-	return xxx_messageInfo_ExportChannelBackupRequest.Size(m)
+func (m *MacaroonPermission) XXX_Size() int {
+	return xxx_messageInfo_MacaroonPermission.Size(m)
 }
-func (m *ExportChannelBackupRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExportChannelBackupRequest.DiscardUnknown(m)
+func (m *MacaroonPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MacaroonPermission.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExportChannelBackupRequest proto.InternalMessageInfo
+var xxx_messageInfo_MacaroonPermission proto.InternalMessageInfo
 
-func (m *ExportChannelBackupRequest) GetChanPoint() *ChannelPoint {
+func (m *MacaroonPermission) GetEntity() string {
 	if m != nil {
-		return m.ChanPoint
+		return m.Entity
 	}
-	return nil
+	return ""
 }
 
-type ChannelBackup struct {
-	//*
-	//Identifies the channel that this backup belongs to.
-	ChanPoint *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
+func (m *MacaroonPermission) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+type BakeMacaroonRequest struct {
+	/// The list of permissions the new macaroon should grant.
+	Permissions []*MacaroonPermission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	//*
-	//Is an encrypted single-chan backup. this can be passed to
-	//RestoreChannelBackups, or the WalletUnlocker Init and Unlock methods in
-	//order to trigger the recovery protocol.
-	ChanBackup           []byte   `protobuf:"bytes,2,opt,name=chan_backup,proto3" json:"chan_backup,omitempty"`
+	//The root key ID used to create the macaroon. Macaroons baked under the same
+	//root key ID can be revoked all at once by deleting the ID. If not set, the
+	//default root key ID 0 is used.
+	RootKeyId            uint64   `protobuf:"varint,2,opt,name=root_key_id,proto3" json:"root_key_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelBackup) Reset()         { *m = ChannelBackup{} }
-func (m *ChannelBackup) String() string { return proto.CompactTextString(m) }
-func (*ChannelBackup) ProtoMessage()    {}
-func (*ChannelBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{122}
+func (m *BakeMacaroonRequest) Reset()         { *m = BakeMacaroonRequest{} }
+func (m *BakeMacaroonRequest) String() string { return proto.CompactTextString(m) }
+func (*BakeMacaroonRequest) ProtoMessage()    {}
+func (*BakeMacaroonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{142}
 }
 
-func (m *ChannelBackup) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelBackup.Unmarshal(m, b)
-}
-func (m *ChannelBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelBackup.Marshal(b, m, deterministic)
+func (m *BakeMacaroonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BakeMacaroonRequest.Unmarshal(m, b)
 }
-func (m *ChannelBackup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelBackup.Merge(m, src)
+func (m *BakeMacaroonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BakeMacaroonRequest.Marshal(b, m, deterministic)
 }
-func (m *ChannelBackup) XXX_Size() int {
-	return xxx_messageInfo_ChannelBackup.Size(m)
+func (m *BakeMacaroonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BakeMacaroonRequest.Merge(m, src)
 }
-func (m *ChannelBackup) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelBackup.DiscardUnknown(m)
+func (m *BakeMacaroonRequest) XXX_Size() int {
+	return xxx_messageInfo_BakeMacaroonRequest.Size(m)
+}
+func (m *BakeMacaroonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BakeMacaroonRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelBackup proto.InternalMessageInfo
+var xxx_messageInfo_BakeMacaroonRequest proto.InternalMessageInfo
 
-func (m *ChannelBackup) GetChanPoint() *ChannelPoint {
+func (m *BakeMacaroonRequest) GetPermissions() []*MacaroonPermission {
 	if m != nil {
-		return m.ChanPoint
+		return m.Permissions
 	}
 	return nil
 }
 
-func (m *ChannelBackup) GetChanBackup() []byte {
+func (m *BakeMacaroonRequest) GetRootKeyId() uint64 {
 	if m != nil {
-		return m.ChanBackup
+		return m.RootKeyId
 	}
-	return nil
+	return 0
 }
 
-type MultiChanBackup struct {
-	//*
-	//Is the set of all channels that are included in this multi-channel backup.
-	ChanPoints []*ChannelPoint `protobuf:"bytes,1,rep,name=chan_points,proto3" json:"chan_points,omitempty"`
-	//*
-	//A single encrypted blob containing all the static channel backups of the
-	//channel listed above. This can be stored as a single file or blob, and
-	//safely be replaced with any prior/future versions.
-	MultiChanBackup      []byte   `protobuf:"bytes,2,opt,name=multi_chan_backup,proto3" json:"multi_chan_backup,omitempty"`
+type BakeMacaroonResponse struct {
+	/// The hex encoded macaroon, serialized in binary format.
+	Macaroon             string   `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiChanBackup) Reset()         { *m = MultiChanBackup{} }
-func (m *MultiChanBackup) String() string { return proto.CompactTextString(m) }
-func (*MultiChanBackup) ProtoMessage()    {}
-func (*MultiChanBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{123}
+func (m *BakeMacaroonResponse) Reset()         { *m = BakeMacaroonResponse{} }
+func (m *BakeMacaroonResponse) String() string { return proto.CompactTextString(m) }
+func (*BakeMacaroonResponse) ProtoMessage()    {}
+func (*BakeMacaroonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{143}
 }
 
-func (m *MultiChanBackup) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MultiChanBackup.Unmarshal(m, b)
+func (m *BakeMacaroonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BakeMacaroonResponse.Unmarshal(m, b)
 }
-func (m *MultiChanBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MultiChanBackup.Marshal(b, m, deterministic)
+func (m *BakeMacaroonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BakeMacaroonResponse.Marshal(b, m, deterministic)
 }
-func (m *MultiChanBackup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiChanBackup.Merge(m, src)
+func (m *BakeMacaroonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BakeMacaroonResponse.Merge(m, src)
 }
-func (m *MultiChanBackup) XXX_Size() int {
-	return xxx_messageInfo_MultiChanBackup.Size(m)
+func (m *BakeMacaroonResponse) XXX_Size() int {
+	return xxx_messageInfo_BakeMacaroonResponse.Size(m)
 }
-func (m *MultiChanBackup) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiChanBackup.DiscardUnknown(m)
+func (m *BakeMacaroonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BakeMacaroonResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiChanBackup proto.InternalMessageInfo
-
-func (m *MultiChanBackup) GetChanPoints() []*ChannelPoint {
-	if m != nil {
-		return m.ChanPoints
-	}
-	return nil
-}
+var xxx_messageInfo_BakeMacaroonResponse proto.InternalMessageInfo
 
-func (m *MultiChanBackup) GetMultiChanBackup() []byte {
+func (m *BakeMacaroonResponse) GetMacaroon() string {
 	if m != nil {
-		return m.MultiChanBackup
+		return m.Macaroon
 	}
-	return nil
+	return ""
 }
 
-type ChanBackupExportRequest struct {
+type ListPermissionsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChanBackupExportRequest) Reset()         { *m = ChanBackupExportRequest{} }
-func (m *ChanBackupExportRequest) String() string { return proto.CompactTextString(m) }
-func (*ChanBackupExportRequest) ProtoMessage()    {}
-func (*ChanBackupExportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{124}
+func (m *ListPermissionsRequest) Reset()         { *m = ListPermissionsRequest{} }
+func (m *ListPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPermissionsRequest) ProtoMessage()    {}
+func (*ListPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{144}
 }
 
-func (m *ChanBackupExportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChanBackupExportRequest.Unmarshal(m, b)
+func (m *ListPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPermissionsRequest.Unmarshal(m, b)
 }
-func (m *ChanBackupExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChanBackupExportRequest.Marshal(b, m, deterministic)
+func (m *ListPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPermissionsRequest.Marshal(b, m, deterministic)
 }
-func (m *ChanBackupExportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChanBackupExportRequest.Merge(m, src)
+func (m *ListPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPermissionsRequest.Merge(m, src)
 }
-func (m *ChanBackupExportRequest) XXX_Size() int {
-	return xxx_messageInfo_ChanBackupExportRequest.Size(m)
+func (m *ListPermissionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPermissionsRequest.Size(m)
 }
-func (m *ChanBackupExportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChanBackupExportRequest.DiscardUnknown(m)
+func (m *ListPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPermissionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChanBackupExportRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListPermissionsRequest proto.InternalMessageInfo
 
-type ChanBackupSnapshot struct {
-	//*
-	//The set of new channels that have been added since the last channel backup
-	//snapshot was requested.
-	SingleChanBackups *ChannelBackups `protobuf:"bytes,1,opt,name=single_chan_backups,proto3" json:"single_chan_backups,omitempty"`
+type ListPermissionsResponse struct {
 	//*
-	//A multi-channel backup that covers all open channels currently known to
-	//lnd.
-	MultiChanBackup      *MultiChanBackup `protobuf:"bytes,2,opt,name=multi_chan_backup,proto3" json:"multi_chan_backup,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	//A map between all RPC method URIs and their required macaroon permissions to
+	//access them.
+	MethodPermissions    map[string]*MacaroonPermissionList `protobuf:"bytes,1,rep,name=method_permissions,proto3" json:"method_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
 }
 
-func (m *ChanBackupSnapshot) Reset()         { *m = ChanBackupSnapshot{} }
-func (m *ChanBackupSnapshot) String() string { return proto.CompactTextString(m) }
-func (*ChanBackupSnapshot) ProtoMessage()    {}
-func (*ChanBackupSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{125}
+func (m *ListPermissionsResponse) Reset()         { *m = ListPermissionsResponse{} }
+func (m *ListPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPermissionsResponse) ProtoMessage()    {}
+func (*ListPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{145}
 }
 
-func (m *ChanBackupSnapshot) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChanBackupSnapshot.Unmarshal(m, b)
+func (m *ListPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPermissionsResponse.Unmarshal(m, b)
 }
-func (m *ChanBackupSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChanBackupSnapshot.Marshal(b, m, deterministic)
+func (m *ListPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPermissionsResponse.Marshal(b, m, deterministic)
 }
-func (m *ChanBackupSnapshot) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChanBackupSnapshot.Merge(m, src)
+func (m *ListPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPermissionsResponse.Merge(m, src)
 }
-func (m *ChanBackupSnapshot) XXX_Size() int {
-	return xxx_messageInfo_ChanBackupSnapshot.Size(m)
+func (m *ListPermissionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPermissionsResponse.Size(m)
 }
-func (m *ChanBackupSnapshot) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChanBackupSnapshot.DiscardUnknown(m)
+func (m *ListPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPermissionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChanBackupSnapshot proto.InternalMessageInfo
+var xxx_messageInfo_ListPermissionsResponse proto.InternalMessageInfo
 
-func (m *ChanBackupSnapshot) GetSingleChanBackups() *ChannelBackups {
+func (m *ListPermissionsResponse) GetMethodPermissions() map[string]*MacaroonPermissionList {
 	if m != nil {
-		return m.SingleChanBackups
+		return m.MethodPermissions
 	}
 	return nil
 }
 
-func (m *ChanBackupSnapshot) GetMultiChanBackup() *MultiChanBackup {
+type MacaroonPermissionList struct {
+	/// A list of macaroon permissions.
+	Permissions          []*MacaroonPermission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MacaroonPermissionList) Reset()         { *m = MacaroonPermissionList{} }
+func (m *MacaroonPermissionList) String() string { return proto.CompactTextString(m) }
+func (*MacaroonPermissionList) ProtoMessage()    {}
+func (*MacaroonPermissionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{146}
+}
+
+func (m *MacaroonPermissionList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MacaroonPermissionList.Unmarshal(m, b)
+}
+func (m *MacaroonPermissionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MacaroonPermissionList.Marshal(b, m, deterministic)
+}
+func (m *MacaroonPermissionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MacaroonPermissionList.Merge(m, src)
+}
+func (m *MacaroonPermissionList) XXX_Size() int {
+	return xxx_messageInfo_MacaroonPermissionList.Size(m)
+}
+func (m *MacaroonPermissionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MacaroonPermissionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MacaroonPermissionList proto.InternalMessageInfo
+
+func (m *MacaroonPermissionList) GetPermissions() []*MacaroonPermission {
 	if m != nil {
-		return m.MultiChanBackup
+		return m.Permissions
 	}
 	return nil
 }
 
-type ChannelBackups struct {
-	//*
-	//A set of single-chan static channel backups.
-	ChanBackups          []*ChannelBackup `protobuf:"bytes,1,rep,name=chan_backups,proto3" json:"chan_backups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type ListMacaroonIDsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelBackups) Reset()         { *m = ChannelBackups{} }
-func (m *ChannelBackups) String() string { return proto.CompactTextString(m) }
-func (*ChannelBackups) ProtoMessage()    {}
-func (*ChannelBackups) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{126}
+func (m *ListMacaroonIDsRequest) Reset()         { *m = ListMacaroonIDsRequest{} }
+func (m *ListMacaroonIDsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMacaroonIDsRequest) ProtoMessage()    {}
+func (*ListMacaroonIDsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{147}
 }
 
-func (m *ChannelBackups) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelBackups.Unmarshal(m, b)
+func (m *ListMacaroonIDsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMacaroonIDsRequest.Unmarshal(m, b)
 }
-func (m *ChannelBackups) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelBackups.Marshal(b, m, deterministic)
+func (m *ListMacaroonIDsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMacaroonIDsRequest.Marshal(b, m, deterministic)
 }
-func (m *ChannelBackups) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelBackups.Merge(m, src)
+func (m *ListMacaroonIDsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMacaroonIDsRequest.Merge(m, src)
 }
-func (m *ChannelBackups) XXX_Size() int {
-	return xxx_messageInfo_ChannelBackups.Size(m)
+func (m *ListMacaroonIDsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMacaroonIDsRequest.Size(m)
 }
-func (m *ChannelBackups) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelBackups.DiscardUnknown(m)
+func (m *ListMacaroonIDsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMacaroonIDsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelBackups proto.InternalMessageInfo
+var xxx_messageInfo_ListMacaroonIDsRequest proto.InternalMessageInfo
 
-func (m *ChannelBackups) GetChanBackups() []*ChannelBackup {
+type ListMacaroonIDsResponse struct {
+	/// The list of root key IDs that are in use.
+	RootKeyIds           []uint64 `protobuf:"varint,1,rep,packed,name=root_key_ids,proto3" json:"root_key_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListMacaroonIDsResponse) Reset()         { *m = ListMacaroonIDsResponse{} }
+func (m *ListMacaroonIDsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMacaroonIDsResponse) ProtoMessage()    {}
+func (*ListMacaroonIDsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{148}
+}
+
+func (m *ListMacaroonIDsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMacaroonIDsResponse.Unmarshal(m, b)
+}
+func (m *ListMacaroonIDsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMacaroonIDsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListMacaroonIDsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMacaroonIDsResponse.Merge(m, src)
+}
+func (m *ListMacaroonIDsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListMacaroonIDsResponse.Size(m)
+}
+func (m *ListMacaroonIDsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMacaroonIDsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMacaroonIDsResponse proto.InternalMessageInfo
+
+func (m *ListMacaroonIDsResponse) GetRootKeyIds() []uint64 {
 	if m != nil {
-		return m.ChanBackups
+		return m.RootKeyIds
 	}
 	return nil
 }
 
-type RestoreChanBackupRequest struct {
-	// Types that are valid to be assigned to Backup:
-	//	*RestoreChanBackupRequest_ChanBackups
-	//	*RestoreChanBackupRequest_MultiChanBackup
-	Backup               isRestoreChanBackupRequest_Backup `protobuf_oneof:"backup"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_unrecognized     []byte                            `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+type DeleteMacaroonIDRequest struct {
+	/// The root key ID to be removed.
+	RootKeyId            uint64   `protobuf:"varint,1,opt,name=root_key_id,proto3" json:"root_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RestoreChanBackupRequest) Reset()         { *m = RestoreChanBackupRequest{} }
-func (m *RestoreChanBackupRequest) String() string { return proto.CompactTextString(m) }
-func (*RestoreChanBackupRequest) ProtoMessage()    {}
-func (*RestoreChanBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{127}
+func (m *DeleteMacaroonIDRequest) Reset()         { *m = DeleteMacaroonIDRequest{} }
+func (m *DeleteMacaroonIDRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteMacaroonIDRequest) ProtoMessage()    {}
+func (*DeleteMacaroonIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{149}
 }
 
-func (m *RestoreChanBackupRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RestoreChanBackupRequest.Unmarshal(m, b)
+func (m *DeleteMacaroonIDRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteMacaroonIDRequest.Unmarshal(m, b)
 }
-func (m *RestoreChanBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RestoreChanBackupRequest.Marshal(b, m, deterministic)
+func (m *DeleteMacaroonIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteMacaroonIDRequest.Marshal(b, m, deterministic)
 }
-func (m *RestoreChanBackupRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RestoreChanBackupRequest.Merge(m, src)
+func (m *DeleteMacaroonIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMacaroonIDRequest.Merge(m, src)
 }
-func (m *RestoreChanBackupRequest) XXX_Size() int {
-	return xxx_messageInfo_RestoreChanBackupRequest.Size(m)
+func (m *DeleteMacaroonIDRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteMacaroonIDRequest.Size(m)
 }
-func (m *RestoreChanBackupRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RestoreChanBackupRequest.DiscardUnknown(m)
+func (m *DeleteMacaroonIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMacaroonIDRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RestoreChanBackupRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteMacaroonIDRequest proto.InternalMessageInfo
 
-type isRestoreChanBackupRequest_Backup interface {
-	isRestoreChanBackupRequest_Backup()
+func (m *DeleteMacaroonIDRequest) GetRootKeyId() uint64 {
+	if m != nil {
+		return m.RootKeyId
+	}
+	return 0
 }
 
-type RestoreChanBackupRequest_ChanBackups struct {
-	ChanBackups *ChannelBackups `protobuf:"bytes,1,opt,name=chan_backups,proto3,oneof"`
+type DeleteMacaroonIDResponse struct {
+	/// A boolean indicates that the deletion is successful.
+	Deleted              bool     `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type RestoreChanBackupRequest_MultiChanBackup struct {
-	MultiChanBackup []byte `protobuf:"bytes,2,opt,name=multi_chan_backup,proto3,oneof"`
+func (m *DeleteMacaroonIDResponse) Reset()         { *m = DeleteMacaroonIDResponse{} }
+func (m *DeleteMacaroonIDResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteMacaroonIDResponse) ProtoMessage()    {}
+func (*DeleteMacaroonIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{150}
 }
 
-func (*RestoreChanBackupRequest_ChanBackups) isRestoreChanBackupRequest_Backup() {}
+func (m *DeleteMacaroonIDResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteMacaroonIDResponse.Unmarshal(m, b)
+}
+func (m *DeleteMacaroonIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteMacaroonIDResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteMacaroonIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMacaroonIDResponse.Merge(m, src)
+}
+func (m *DeleteMacaroonIDResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteMacaroonIDResponse.Size(m)
+}
+func (m *DeleteMacaroonIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMacaroonIDResponse.DiscardUnknown(m)
+}
 
-func (*RestoreChanBackupRequest_MultiChanBackup) isRestoreChanBackupRequest_Backup() {}
+var xxx_messageInfo_DeleteMacaroonIDResponse proto.InternalMessageInfo
 
-func (m *RestoreChanBackupRequest) GetBackup() isRestoreChanBackupRequest_Backup {
+func (m *DeleteMacaroonIDResponse) GetDeleted() bool {
 	if m != nil {
-		return m.Backup
+		return m.Deleted
 	}
-	return nil
+	return false
 }
 
-func (m *RestoreChanBackupRequest) GetChanBackups() *ChannelBackups {
-	if x, ok := m.GetBackup().(*RestoreChanBackupRequest_ChanBackups); ok {
-		return x.ChanBackups
-	}
-	return nil
+type DatabaseStatsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RestoreChanBackupRequest) GetMultiChanBackup() []byte {
-	if x, ok := m.GetBackup().(*RestoreChanBackupRequest_MultiChanBackup); ok {
-		return x.MultiChanBackup
-	}
-	return nil
+func (m *DatabaseStatsRequest) Reset()         { *m = DatabaseStatsRequest{} }
+func (m *DatabaseStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*DatabaseStatsRequest) ProtoMessage()    {}
+func (*DatabaseStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{151}
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*RestoreChanBackupRequest) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*RestoreChanBackupRequest_ChanBackups)(nil),
-		(*RestoreChanBackupRequest_MultiChanBackup)(nil),
-	}
+func (m *DatabaseStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseStatsRequest.Unmarshal(m, b)
+}
+func (m *DatabaseStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *DatabaseStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseStatsRequest.Merge(m, src)
+}
+func (m *DatabaseStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_DatabaseStatsRequest.Size(m)
+}
+func (m *DatabaseStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseStatsRequest.DiscardUnknown(m)
 }
 
-type RestoreBackupResponse struct {
+var xxx_messageInfo_DatabaseStatsRequest proto.InternalMessageInfo
+
+type BucketStats struct {
+	//*
+	//The name of the top-level bucket. Names that aren't printable are hex
+	//encoded.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	/// The number of key/value pairs within the bucket and its nested buckets.
+	NumKeys uint64 `protobuf:"varint,2,opt,name=num_keys,proto3" json:"num_keys,omitempty"`
+	/// The number of buckets nested within the bucket.
+	NumBuckets uint64 `protobuf:"varint,3,opt,name=num_buckets,proto3" json:"num_buckets,omitempty"`
+	/// The total size of all keys within the bucket in bytes.
+	KeyBytes uint64 `protobuf:"varint,4,opt,name=key_bytes,proto3" json:"key_bytes,omitempty"`
+	/// The total size of all values within the bucket in bytes.
+	ValueBytes           uint64   `protobuf:"varint,5,opt,name=value_bytes,proto3" json:"value_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RestoreBackupResponse) Reset()         { *m = RestoreBackupResponse{} }
-func (m *RestoreBackupResponse) String() string { return proto.CompactTextString(m) }
-func (*RestoreBackupResponse) ProtoMessage()    {}
-func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{128}
+func (m *BucketStats) Reset()         { *m = BucketStats{} }
+func (m *BucketStats) String() string { return proto.CompactTextString(m) }
+func (*BucketStats) ProtoMessage()    {}
+func (*BucketStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{152}
 }
 
-func (m *RestoreBackupResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RestoreBackupResponse.Unmarshal(m, b)
+func (m *BucketStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketStats.Unmarshal(m, b)
 }
-func (m *RestoreBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RestoreBackupResponse.Marshal(b, m, deterministic)
+func (m *BucketStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketStats.Marshal(b, m, deterministic)
 }
-func (m *RestoreBackupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RestoreBackupResponse.Merge(m, src)
+func (m *BucketStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketStats.Merge(m, src)
 }
-func (m *RestoreBackupResponse) XXX_Size() int {
-	return xxx_messageInfo_RestoreBackupResponse.Size(m)
+func (m *BucketStats) XXX_Size() int {
+	return xxx_messageInfo_BucketStats.Size(m)
 }
-func (m *RestoreBackupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RestoreBackupResponse.DiscardUnknown(m)
+func (m *BucketStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketStats proto.InternalMessageInfo
+
+func (m *BucketStats) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BucketStats) GetNumKeys() uint64 {
+	if m != nil {
+		return m.NumKeys
+	}
+	return 0
+}
+
+func (m *BucketStats) GetNumBuckets() uint64 {
+	if m != nil {
+		return m.NumBuckets
+	}
+	return 0
 }
 
-var xxx_messageInfo_RestoreBackupResponse proto.InternalMessageInfo
+func (m *BucketStats) GetKeyBytes() uint64 {
+	if m != nil {
+		return m.KeyBytes
+	}
+	return 0
+}
 
-type ChannelBackupSubscription struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *BucketStats) GetValueBytes() uint64 {
+	if m != nil {
+		return m.ValueBytes
+	}
+	return 0
 }
 
-func (m *ChannelBackupSubscription) Reset()         { *m = ChannelBackupSubscription{} }
-func (m *ChannelBackupSubscription) String() string { return proto.CompactTextString(m) }
-func (*ChannelBackupSubscription) ProtoMessage()    {}
-func (*ChannelBackupSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{129}
+type DatabaseStatsResponse struct {
+	/// The size of the channel database file on disk in bytes.
+	FileSize uint64 `protobuf:"varint,1,opt,name=file_size,proto3" json:"file_size,omitempty"`
+	//*
+	//The total size of all keys and values within the database in bytes. The
+	//difference to the file size is taken up by the overhead of the storage
+	//engine and by free pages, the latter of which can be reclaimed by
+	//compacting the database.
+	DataSize uint64 `protobuf:"varint,2,opt,name=data_size,proto3" json:"data_size,omitempty"`
+	/// The statistics of each top-level bucket of the database.
+	Buckets              []*BucketStats `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *ChannelBackupSubscription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelBackupSubscription.Unmarshal(m, b)
+func (m *DatabaseStatsResponse) Reset()         { *m = DatabaseStatsResponse{} }
+func (m *DatabaseStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*DatabaseStatsResponse) ProtoMessage()    {}
+func (*DatabaseStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{153}
 }
-func (m *ChannelBackupSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelBackupSubscription.Marshal(b, m, deterministic)
+
+func (m *DatabaseStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseStatsResponse.Unmarshal(m, b)
 }
-func (m *ChannelBackupSubscription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelBackupSubscription.Merge(m, src)
+func (m *DatabaseStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseStatsResponse.Marshal(b, m, deterministic)
 }
-func (m *ChannelBackupSubscription) XXX_Size() int {
-	return xxx_messageInfo_ChannelBackupSubscription.Size(m)
+func (m *DatabaseStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseStatsResponse.Merge(m, src)
 }
-func (m *ChannelBackupSubscription) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelBackupSubscription.DiscardUnknown(m)
+func (m *DatabaseStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_DatabaseStatsResponse.Size(m)
+}
+func (m *DatabaseStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseStatsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelBackupSubscription proto.InternalMessageInfo
+var xxx_messageInfo_DatabaseStatsResponse proto.InternalMessageInfo
 
-type VerifyChanBackupResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *DatabaseStatsResponse) GetFileSize() uint64 {
+	if m != nil {
+		return m.FileSize
+	}
+	return 0
 }
 
-func (m *VerifyChanBackupResponse) Reset()         { *m = VerifyChanBackupResponse{} }
-func (m *VerifyChanBackupResponse) String() string { return proto.CompactTextString(m) }
-func (*VerifyChanBackupResponse) ProtoMessage()    {}
-func (*VerifyChanBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{130}
+func (m *DatabaseStatsResponse) GetDataSize() uint64 {
+	if m != nil {
+		return m.DataSize
+	}
+	return 0
 }
 
-func (m *VerifyChanBackupResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VerifyChanBackupResponse.Unmarshal(m, b)
-}
-func (m *VerifyChanBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VerifyChanBackupResponse.Marshal(b, m, deterministic)
-}
-func (m *VerifyChanBackupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VerifyChanBackupResponse.Merge(m, src)
-}
-func (m *VerifyChanBackupResponse) XXX_Size() int {
-	return xxx_messageInfo_VerifyChanBackupResponse.Size(m)
-}
-func (m *VerifyChanBackupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_VerifyChanBackupResponse.DiscardUnknown(m)
+func (m *DatabaseStatsResponse) GetBuckets() []*BucketStats {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
 }
 
-var xxx_messageInfo_VerifyChanBackupResponse proto.InternalMessageInfo
-
 func init() {
 	proto.RegisterEnum("lnrpc.AddressType", AddressType_name, AddressType_value)
 	proto.RegisterEnum("lnrpc.InvoiceHTLCState", InvoiceHTLCState_name, InvoiceHTLCState_value)
 	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
 	proto.RegisterEnum("lnrpc.Peer_SyncType", Peer_SyncType_name, Peer_SyncType_value)
+	proto.RegisterEnum("lnrpc.PeerEvent_EventType", PeerEvent_EventType_name, PeerEvent_EventType_value)
 	proto.RegisterEnum("lnrpc.ChannelEventUpdate_UpdateType", ChannelEventUpdate_UpdateType_name, ChannelEventUpdate_UpdateType_value)
 	proto.RegisterEnum("lnrpc.Invoice_InvoiceState", Invoice_InvoiceState_name, Invoice_InvoiceState_value)
 	proto.RegisterEnum("lnrpc.Payment_PaymentStatus", Payment_PaymentStatus_name, Payment_PaymentStatus_value)
@@ -9027,7 +10483,7 @@ func init() {
 	proto.RegisterType((*TransactionDetails)(nil), "lnrpc.TransactionDetails")
 	proto.RegisterType((*FeeLimit)(nil), "lnrpc.FeeLimit")
 	proto.RegisterType((*SendRequest)(nil), "lnrpc.SendRequest")
-	proto.RegisterMapType((map[uint64][]byte)(nil), "lnrpc.SendRequest.DestTlvEntry")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "lnrpc.SendRequest.DestCustomRecordsEntry")
 	proto.RegisterType((*SendResponse)(nil), "lnrpc.SendResponse")
 	proto.RegisterType((*SendToRouteRequest)(nil), "lnrpc.SendToRouteRequest")
 	proto.RegisterType((*ChannelAcceptRequest)(nil), "lnrpc.ChannelAcceptRequest")
@@ -9063,6 +10519,8 @@ func init() {
 	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
 	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
 	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
+	proto.RegisterType((*PeerEventSubscription)(nil), "lnrpc.PeerEventSubscription")
+	proto.RegisterType((*PeerEvent)(nil), "lnrpc.PeerEvent")
 	proto.RegisterType((*ListPeersRequest)(nil), "lnrpc.ListPeersRequest")
 	proto.RegisterType((*ListPeersResponse)(nil), "lnrpc.ListPeersResponse")
 	proto.RegisterType((*GetInfoRequest)(nil), "lnrpc.GetInfoRequest")
@@ -9075,7 +10533,14 @@ func init() {
 	proto.RegisterType((*CloseStatusUpdate)(nil), "lnrpc.CloseStatusUpdate")
 	proto.RegisterType((*PendingUpdate)(nil), "lnrpc.PendingUpdate")
 	proto.RegisterType((*OpenChannelRequest)(nil), "lnrpc.OpenChannelRequest")
+	proto.RegisterType((*BatchOpenChannel)(nil), "lnrpc.BatchOpenChannel")
+	proto.RegisterType((*BatchOpenChannelRequest)(nil), "lnrpc.BatchOpenChannelRequest")
+	proto.RegisterType((*BatchOpenChannelResponse)(nil), "lnrpc.BatchOpenChannelResponse")
 	proto.RegisterType((*OpenStatusUpdate)(nil), "lnrpc.OpenStatusUpdate")
+	proto.RegisterType((*ReadyForPsbtFunding)(nil), "lnrpc.ReadyForPsbtFunding")
+	proto.RegisterType((*FundingPsbtVerify)(nil), "lnrpc.FundingPsbtVerify")
+	proto.RegisterType((*FundingStateStepRequest)(nil), "lnrpc.FundingStateStepRequest")
+	proto.RegisterType((*FundingStateStepResp)(nil), "lnrpc.FundingStateStepResp")
 	proto.RegisterType((*PendingHTLC)(nil), "lnrpc.PendingHTLC")
 	proto.RegisterType((*PendingChannelsRequest)(nil), "lnrpc.PendingChannelsRequest")
 	proto.RegisterType((*PendingChannelsResponse)(nil), "lnrpc.PendingChannelsResponse")
@@ -9091,10 +10556,12 @@ func init() {
 	proto.RegisterType((*ChannelBalanceRequest)(nil), "lnrpc.ChannelBalanceRequest")
 	proto.RegisterType((*ChannelBalanceResponse)(nil), "lnrpc.ChannelBalanceResponse")
 	proto.RegisterType((*QueryRoutesRequest)(nil), "lnrpc.QueryRoutesRequest")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "lnrpc.QueryRoutesRequest.DestCustomRecordsEntry")
 	proto.RegisterType((*NodePair)(nil), "lnrpc.NodePair")
 	proto.RegisterType((*EdgeLocator)(nil), "lnrpc.EdgeLocator")
 	proto.RegisterType((*QueryRoutesResponse)(nil), "lnrpc.QueryRoutesResponse")
 	proto.RegisterType((*Hop)(nil), "lnrpc.Hop")
+	proto.RegisterType((*MPPRecord)(nil), "lnrpc.MPPRecord")
 	proto.RegisterType((*Route)(nil), "lnrpc.Route")
 	proto.RegisterType((*NodeInfoRequest)(nil), "lnrpc.NodeInfoRequest")
 	proto.RegisterType((*NodeInfo)(nil), "lnrpc.NodeInfo")
@@ -9118,6 +10585,7 @@ func init() {
 	proto.RegisterType((*RouteHint)(nil), "lnrpc.RouteHint")
 	proto.RegisterType((*Invoice)(nil), "lnrpc.Invoice")
 	proto.RegisterType((*InvoiceHTLC)(nil), "lnrpc.InvoiceHTLC")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "lnrpc.InvoiceHTLC.CustomRecordsEntry")
 	proto.RegisterType((*AddInvoiceResponse)(nil), "lnrpc.AddInvoiceResponse")
 	proto.RegisterType((*PaymentHash)(nil), "lnrpc.PaymentHash")
 	proto.RegisterType((*ListInvoiceRequest)(nil), "lnrpc.ListInvoiceRequest")
@@ -9152,542 +10620,623 @@ func init() {
 	proto.RegisterType((*RestoreBackupResponse)(nil), "lnrpc.RestoreBackupResponse")
 	proto.RegisterType((*ChannelBackupSubscription)(nil), "lnrpc.ChannelBackupSubscription")
 	proto.RegisterType((*VerifyChanBackupResponse)(nil), "lnrpc.VerifyChanBackupResponse")
+	proto.RegisterType((*MacaroonPermission)(nil), "lnrpc.MacaroonPermission")
+	proto.RegisterType((*BakeMacaroonRequest)(nil), "lnrpc.BakeMacaroonRequest")
+	proto.RegisterType((*BakeMacaroonResponse)(nil), "lnrpc.BakeMacaroonResponse")
+	proto.RegisterType((*ListPermissionsRequest)(nil), "lnrpc.ListPermissionsRequest")
+	proto.RegisterType((*ListPermissionsResponse)(nil), "lnrpc.ListPermissionsResponse")
+	proto.RegisterMapType((map[string]*MacaroonPermissionList)(nil), "lnrpc.ListPermissionsResponse.MethodPermissionsEntry")
+	proto.RegisterType((*MacaroonPermissionList)(nil), "lnrpc.MacaroonPermissionList")
+	proto.RegisterType((*ListMacaroonIDsRequest)(nil), "lnrpc.ListMacaroonIDsRequest")
+	proto.RegisterType((*ListMacaroonIDsResponse)(nil), "lnrpc.ListMacaroonIDsResponse")
+	proto.RegisterType((*DeleteMacaroonIDRequest)(nil), "lnrpc.DeleteMacaroonIDRequest")
+	proto.RegisterType((*DeleteMacaroonIDResponse)(nil), "lnrpc.DeleteMacaroonIDResponse")
+	proto.RegisterType((*DatabaseStatsRequest)(nil), "lnrpc.DatabaseStatsRequest")
+	proto.RegisterType((*BucketStats)(nil), "lnrpc.BucketStats")
+	proto.RegisterType((*DatabaseStatsResponse)(nil), "lnrpc.DatabaseStatsResponse")
 }
 
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 8476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7d, 0x5d, 0x6c, 0x1c, 0x5b,
-	0xd2, 0x50, 0x7a, 0x7e, 0xec, 0x99, 0x9a, 0xb1, 0x3d, 0x3e, 0x76, 0xec, 0xc9, 0xdc, 0xdc, 0xdc,
-	0xdc, 0xde, 0x7c, 0x49, 0x36, 0x7b, 0xd7, 0xc9, 0xcd, 0xee, 0x5e, 0xf2, 0xdd, 0xf0, 0xf1, 0xe1,
-	0xd8, 0x4e, 0x9c, 0x5d, 0x5f, 0xc7, 0xdb, 0x4e, 0x36, 0xec, 0xee, 0x87, 0x66, 0xdb, 0x33, 0xc7,
-	0x76, 0x6f, 0x66, 0xba, 0x67, 0xbb, 0x7b, 0xec, 0x78, 0x2f, 0x17, 0x09, 0x84, 0x10, 0x42, 0x42,
-	0x68, 0xe1, 0x05, 0x10, 0x08, 0x69, 0xf7, 0x7b, 0x60, 0xe1, 0x01, 0x78, 0x00, 0x81, 0xf4, 0x49,
-	0xdf, 0x23, 0x4f, 0x08, 0xa1, 0xef, 0x8d, 0x07, 0x3e, 0x21, 0x90, 0x60, 0xe1, 0x0d, 0x89, 0x77,
-	0x54, 0x75, 0x7e, 0xfa, 0x9c, 0xee, 0x9e, 0x24, 0x77, 0x77, 0xe1, 0xc9, 0x73, 0xaa, 0xaa, 0xcf,
-	0x6f, 0x55, 0x9d, 0xaa, 0x3a, 0x75, 0x8e, 0xa1, 0x19, 0x4f, 0x06, 0x1b, 0x93, 0x38, 0x4a, 0x23,
-	0x56, 0x1f, 0x85, 0xf1, 0x64, 0xd0, 0xbb, 0x7a, 0x12, 0x45, 0x27, 0x23, 0x7e, 0xd7, 0x9f, 0x04,
-	0x77, 0xfd, 0x30, 0x8c, 0x52, 0x3f, 0x0d, 0xa2, 0x30, 0x11, 0x44, 0xee, 0x8f, 0x60, 0xf1, 0x09,
-	0x0f, 0x0f, 0x39, 0x1f, 0x7a, 0xfc, 0x27, 0x53, 0x9e, 0xa4, 0xec, 0x6b, 0xb0, 0xec, 0xf3, 0x9f,
-	0x72, 0x3e, 0xec, 0x4f, 0xfc, 0x24, 0x99, 0x9c, 0xc6, 0x7e, 0xc2, 0xbb, 0xce, 0x75, 0xe7, 0x76,
-	0xdb, 0xeb, 0x08, 0xc4, 0x81, 0x86, 0xb3, 0x0f, 0xa1, 0x9d, 0x20, 0x29, 0x0f, 0xd3, 0x38, 0x9a,
-	0x5c, 0x74, 0x2b, 0x44, 0xd7, 0x42, 0xd8, 0x8e, 0x00, 0xb9, 0x23, 0x58, 0xd2, 0x2d, 0x24, 0x93,
-	0x28, 0x4c, 0x38, 0xbb, 0x07, 0xab, 0x83, 0x60, 0x72, 0xca, 0xe3, 0x3e, 0x7d, 0x3c, 0x0e, 0xf9,
-	0x38, 0x0a, 0x83, 0x41, 0xd7, 0xb9, 0x5e, 0xbd, 0xdd, 0xf4, 0x98, 0xc0, 0xe1, 0x17, 0x9f, 0x49,
-	0x0c, 0xbb, 0x05, 0x4b, 0x3c, 0x14, 0x70, 0x3e, 0xa4, 0xaf, 0x64, 0x53, 0x8b, 0x19, 0x18, 0x3f,
-	0x70, 0xff, 0x46, 0x05, 0x96, 0x9f, 0x86, 0x41, 0xfa, 0xd2, 0x1f, 0x8d, 0x78, 0xaa, 0xc6, 0x74,
-	0x0b, 0x96, 0xce, 0x09, 0x40, 0x63, 0x3a, 0x8f, 0xe2, 0xa1, 0x1c, 0xd1, 0xa2, 0x00, 0x1f, 0x48,
-	0xe8, 0xcc, 0x9e, 0x55, 0x66, 0xf6, 0xac, 0x74, 0xba, 0xaa, 0x33, 0xa6, 0xeb, 0x16, 0x2c, 0xc5,
-	0x7c, 0x10, 0x9d, 0xf1, 0xf8, 0xa2, 0x7f, 0x1e, 0x84, 0xc3, 0xe8, 0xbc, 0x5b, 0xbb, 0xee, 0xdc,
-	0xae, 0x7b, 0x8b, 0x0a, 0xfc, 0x92, 0xa0, 0xec, 0x11, 0x2c, 0x0d, 0x4e, 0xfd, 0x30, 0xe4, 0xa3,
-	0xfe, 0x91, 0x3f, 0x78, 0x35, 0x9d, 0x24, 0xdd, 0xfa, 0x75, 0xe7, 0x76, 0xeb, 0xfe, 0x95, 0x0d,
-	0x5a, 0xd5, 0x8d, 0xad, 0x53, 0x3f, 0x7c, 0x44, 0x98, 0xc3, 0xd0, 0x9f, 0x24, 0xa7, 0x51, 0xea,
-	0x2d, 0xca, 0x2f, 0x04, 0x38, 0x71, 0x57, 0x81, 0x99, 0x33, 0x21, 0xe6, 0xde, 0xfd, 0x67, 0x0e,
-	0xac, 0xbc, 0x08, 0x47, 0xd1, 0xe0, 0xd5, 0xaf, 0x39, 0x45, 0x25, 0x63, 0xa8, 0xbc, 0xeb, 0x18,
-	0xaa, 0x5f, 0x76, 0x0c, 0x6b, 0xb0, 0x6a, 0x77, 0x56, 0x8e, 0x82, 0xc3, 0x65, 0xfc, 0xfa, 0x84,
-	0xab, 0x6e, 0xa9, 0x61, 0x7c, 0x15, 0x3a, 0x83, 0x69, 0x1c, 0xf3, 0xb0, 0x30, 0x8e, 0x25, 0x09,
-	0xd7, 0x03, 0xf9, 0x10, 0xda, 0x21, 0x3f, 0xcf, 0xc8, 0x24, 0xef, 0x86, 0xfc, 0x5c, 0x91, 0xb8,
-	0x5d, 0x58, 0xcb, 0x37, 0x23, 0x3b, 0xf0, 0x5f, 0x1c, 0xa8, 0xbd, 0x48, 0x5f, 0x47, 0x6c, 0x03,
-	0x6a, 0xe9, 0xc5, 0x44, 0x48, 0xc8, 0xe2, 0x7d, 0x26, 0x87, 0xb6, 0x39, 0x1c, 0xc6, 0x3c, 0x49,
-	0x9e, 0x5f, 0x4c, 0xb8, 0xd7, 0xf6, 0x45, 0xa1, 0x8f, 0x74, 0xac, 0x0b, 0xf3, 0xb2, 0x4c, 0x0d,
-	0x36, 0x3d, 0x55, 0x64, 0xd7, 0x00, 0xfc, 0x71, 0x34, 0x0d, 0xd3, 0x7e, 0xe2, 0xa7, 0x34, 0x55,
-	0x55, 0xcf, 0x80, 0xb0, 0xab, 0xd0, 0x9c, 0xbc, 0xea, 0x27, 0x83, 0x38, 0x98, 0xa4, 0xc4, 0x36,
-	0x4d, 0x2f, 0x03, 0xb0, 0xaf, 0x41, 0x23, 0x9a, 0xa6, 0x93, 0x28, 0x08, 0x53, 0xc9, 0x2a, 0x4b,
-	0xb2, 0x2f, 0xcf, 0xa6, 0xe9, 0x01, 0x82, 0x3d, 0x4d, 0xc0, 0x6e, 0xc0, 0xc2, 0x20, 0x0a, 0x8f,
-	0x83, 0x78, 0x2c, 0x94, 0x41, 0x77, 0x8e, 0x5a, 0xb3, 0x81, 0xee, 0xbf, 0xad, 0x40, 0xeb, 0x79,
-	0xec, 0x87, 0x89, 0x3f, 0x40, 0x00, 0x76, 0x3d, 0x7d, 0xdd, 0x3f, 0xf5, 0x93, 0x53, 0x1a, 0x6d,
-	0xd3, 0x53, 0x45, 0xb6, 0x06, 0x73, 0xa2, 0xa3, 0x34, 0xa6, 0xaa, 0x27, 0x4b, 0xec, 0x23, 0x58,
-	0x0e, 0xa7, 0xe3, 0xbe, 0xdd, 0x56, 0x95, 0xb8, 0xa5, 0x88, 0xc0, 0x09, 0x38, 0xc2, 0xb5, 0x16,
-	0x4d, 0x88, 0x11, 0x1a, 0x10, 0xe6, 0x42, 0x5b, 0x96, 0x78, 0x70, 0x72, 0x2a, 0x86, 0x59, 0xf7,
-	0x2c, 0x18, 0xd6, 0x91, 0x06, 0x63, 0xde, 0x4f, 0x52, 0x7f, 0x3c, 0x91, 0xc3, 0x32, 0x20, 0x84,
-	0x8f, 0x52, 0x7f, 0xd4, 0x3f, 0xe6, 0x3c, 0xe9, 0xce, 0x4b, 0xbc, 0x86, 0xb0, 0x9b, 0xb0, 0x38,
-	0xe4, 0x49, 0xda, 0x97, 0x8b, 0xc2, 0x93, 0x6e, 0x83, 0x44, 0x3f, 0x07, 0xc5, 0x7a, 0x62, 0xff,
-	0xbc, 0x8f, 0x13, 0xc0, 0x5f, 0x77, 0x9b, 0xa2, 0xaf, 0x19, 0x04, 0x39, 0xe7, 0x09, 0x4f, 0x8d,
-	0xd9, 0x4b, 0x24, 0x87, 0xba, 0x7b, 0xc0, 0x0c, 0xf0, 0x36, 0x4f, 0xfd, 0x60, 0x94, 0xb0, 0x4f,
-	0xa0, 0x9d, 0x1a, 0xc4, 0xa4, 0x0a, 0x5b, 0x9a, 0x9d, 0x8c, 0x0f, 0x3c, 0x8b, 0xce, 0x7d, 0x02,
-	0x8d, 0xc7, 0x9c, 0xef, 0x05, 0xe3, 0x20, 0x65, 0x6b, 0x50, 0x3f, 0x0e, 0x5e, 0x73, 0xc1, 0xf0,
-	0xd5, 0xdd, 0x4b, 0x9e, 0x28, 0xb2, 0x1e, 0xcc, 0x4f, 0x78, 0x3c, 0xe0, 0x6a, 0x79, 0x76, 0x2f,
-	0x79, 0x0a, 0xf0, 0x68, 0x1e, 0xea, 0x23, 0xfc, 0xd8, 0xfd, 0x55, 0x15, 0x5a, 0x87, 0x3c, 0xd4,
-	0x82, 0xc4, 0xa0, 0x86, 0x43, 0x96, 0xc2, 0x43, 0xbf, 0xd9, 0x07, 0xd0, 0xa2, 0x69, 0x48, 0xd2,
-	0x38, 0x08, 0x4f, 0x24, 0xff, 0x02, 0x82, 0x0e, 0x09, 0xc2, 0x3a, 0x50, 0xf5, 0xc7, 0x8a, 0x77,
-	0xf1, 0x27, 0x0a, 0xd9, 0xc4, 0xbf, 0x18, 0xa3, 0x3c, 0xea, 0x55, 0x6d, 0x7b, 0x2d, 0x09, 0xdb,
-	0xc5, 0x65, 0xdd, 0x80, 0x15, 0x93, 0x44, 0xd5, 0x5e, 0xa7, 0xda, 0x97, 0x0d, 0x4a, 0xd9, 0xc8,
-	0x2d, 0x58, 0x52, 0xf4, 0xb1, 0xe8, 0x2c, 0xad, 0x73, 0xd3, 0x5b, 0x94, 0x60, 0x35, 0x84, 0xdb,
-	0xd0, 0x39, 0x0e, 0x42, 0x7f, 0xd4, 0x1f, 0x8c, 0xd2, 0xb3, 0xfe, 0x90, 0x8f, 0x52, 0x9f, 0x56,
-	0xbc, 0xee, 0x2d, 0x12, 0x7c, 0x6b, 0x94, 0x9e, 0x6d, 0x23, 0x94, 0x7d, 0x04, 0xcd, 0x63, 0xce,
-	0xfb, 0x34, 0x13, 0xdd, 0x86, 0x25, 0x3d, 0x6a, 0x76, 0xbd, 0xc6, 0xb1, 0x9a, 0xe7, 0xdb, 0xd0,
-	0x89, 0xa6, 0xe9, 0x49, 0x14, 0x84, 0x27, 0x7d, 0xd4, 0x57, 0xfd, 0x60, 0x48, 0x1c, 0x50, 0xf3,
-	0x16, 0x15, 0x1c, 0xb5, 0xc6, 0xd3, 0x21, 0x7b, 0x1f, 0x80, 0xda, 0x16, 0x15, 0xc3, 0x75, 0xe7,
-	0xf6, 0x82, 0xd7, 0x44, 0x88, 0xa8, 0xe8, 0x53, 0x68, 0xd0, 0x7c, 0xa6, 0xa3, 0xb3, 0x6e, 0x8b,
-	0x16, 0xfc, 0x03, 0xd9, 0xaa, 0xb1, 0x12, 0x1b, 0xdb, 0x3c, 0x49, 0x9f, 0x8f, 0xce, 0x70, 0x3f,
-	0xbd, 0xf0, 0xe6, 0x87, 0xa2, 0xd4, 0xfb, 0x14, 0xda, 0x26, 0x02, 0xa7, 0xfe, 0x15, 0xbf, 0xa0,
-	0xe5, 0xaa, 0x79, 0xf8, 0x93, 0xad, 0x42, 0xfd, 0xcc, 0x1f, 0x4d, 0xb9, 0x54, 0x6c, 0xa2, 0xf0,
-	0x69, 0xe5, 0x81, 0xe3, 0xfe, 0x1b, 0x07, 0xda, 0xa2, 0x05, 0xb9, 0x21, 0xdf, 0x80, 0x05, 0x35,
-	0xa5, 0x3c, 0x8e, 0xa3, 0x58, 0xca, 0xb7, 0x0d, 0x64, 0x77, 0xa0, 0xa3, 0x00, 0x93, 0x98, 0x07,
-	0x63, 0xff, 0x44, 0xd5, 0x5d, 0x80, 0xb3, 0xfb, 0x59, 0x8d, 0x71, 0x34, 0x4d, 0xb9, 0x54, 0xfd,
-	0x6d, 0x39, 0x3e, 0x0f, 0x61, 0x9e, 0x4d, 0x82, 0xf2, 0x5d, 0xc2, 0x2b, 0x16, 0xcc, 0xfd, 0x99,
-	0x03, 0x0c, 0xbb, 0xfe, 0x3c, 0x12, 0x55, 0xc8, 0xa5, 0xce, 0xb3, 0x99, 0xf3, 0xce, 0x6c, 0x56,
-	0x99, 0xc5, 0x66, 0x2e, 0xd4, 0x45, 0xcf, 0x6b, 0x25, 0x3d, 0x17, 0xa8, 0x6f, 0xd7, 0x1a, 0xd5,
-	0x4e, 0xcd, 0xfd, 0x4f, 0x55, 0x58, 0xdd, 0x12, 0xfb, 0xd6, 0xe6, 0x60, 0xc0, 0x27, 0x9a, 0x01,
-	0x3f, 0x80, 0x56, 0x18, 0x0d, 0x79, 0x7f, 0x32, 0x3d, 0x52, 0x6b, 0xd3, 0xf6, 0x00, 0x41, 0x07,
-	0x04, 0x21, 0xfe, 0x38, 0xf5, 0x83, 0x50, 0x74, 0x5a, 0xcc, 0x65, 0x93, 0x20, 0xd4, 0xe5, 0x9b,
-	0xb0, 0x34, 0xe1, 0xe1, 0xd0, 0xe4, 0x33, 0x61, 0x59, 0x2c, 0x48, 0xb0, 0x64, 0xb3, 0x0f, 0xa0,
-	0x75, 0x3c, 0x15, 0x74, 0x28, 0x7e, 0x35, 0xe2, 0x01, 0x90, 0xa0, 0xcd, 0x71, 0xca, 0xae, 0x40,
-	0x63, 0x32, 0x4d, 0x4e, 0x09, 0x5b, 0x27, 0xec, 0x3c, 0x96, 0x11, 0xf5, 0x3e, 0xc0, 0x70, 0x9a,
-	0xa4, 0x92, 0x45, 0xe7, 0x08, 0xd9, 0x44, 0x88, 0x60, 0xd1, 0xaf, 0xc3, 0xca, 0xd8, 0x7f, 0xdd,
-	0x27, 0xde, 0xe9, 0x07, 0x61, 0xff, 0x78, 0x44, 0xaa, 0x77, 0x9e, 0xe8, 0x3a, 0x63, 0xff, 0xf5,
-	0xf7, 0x10, 0xf3, 0x34, 0x7c, 0x4c, 0x70, 0x94, 0x4d, 0xb5, 0xe7, 0xc7, 0x3c, 0xe1, 0xf1, 0x19,
-	0x27, 0x71, 0xaa, 0xe9, 0x8d, 0xdd, 0x13, 0x50, 0xec, 0xd1, 0x18, 0xc7, 0x9d, 0x8e, 0x06, 0x52,
-	0x76, 0xe6, 0xc7, 0x41, 0xb8, 0x9b, 0x8e, 0x06, 0xec, 0x2a, 0x00, 0x0a, 0xe3, 0x84, 0xc7, 0xfd,
-	0x57, 0xe7, 0x24, 0x34, 0x35, 0x12, 0xbe, 0x03, 0x1e, 0x7f, 0xe7, 0x9c, 0xbd, 0x07, 0xcd, 0x41,
-	0x42, 0xd2, 0xec, 0x5f, 0x74, 0x5b, 0x24, 0x51, 0x8d, 0x41, 0x82, 0x72, 0xec, 0x5f, 0xb0, 0x8f,
-	0x80, 0x61, 0x6f, 0x7d, 0x5a, 0x05, 0x3e, 0xa4, 0xea, 0x93, 0x6e, 0x9b, 0xa8, 0xb0, 0xb3, 0x9b,
-	0x12, 0x81, 0xed, 0x24, 0xec, 0x2b, 0xb0, 0xa0, 0x3a, 0x7b, 0x3c, 0xf2, 0x4f, 0x92, 0xee, 0x02,
-	0x11, 0xb6, 0x25, 0xf0, 0x31, 0xc2, 0xdc, 0x97, 0xc2, 0xd2, 0x30, 0xd6, 0x56, 0xca, 0x0c, 0xee,
-	0x79, 0x04, 0xa1, 0x75, 0x6d, 0x78, 0xb2, 0x54, 0xb6, 0x68, 0x95, 0x92, 0x45, 0x73, 0x7f, 0xee,
-	0x40, 0x5b, 0xd6, 0x4c, 0xdb, 0x33, 0xbb, 0x07, 0x4c, 0xad, 0x62, 0xfa, 0x3a, 0x18, 0xf6, 0x8f,
-	0x2e, 0x52, 0x9e, 0x08, 0xa6, 0xd9, 0xbd, 0xe4, 0x95, 0xe0, 0xd8, 0x47, 0xd0, 0xb1, 0xa0, 0x49,
-	0x1a, 0x0b, 0x7e, 0xde, 0xbd, 0xe4, 0x15, 0x30, 0x28, 0x5e, 0x68, 0x00, 0x4c, 0xd3, 0x7e, 0x10,
-	0x0e, 0xf9, 0x6b, 0x62, 0xa5, 0x05, 0xcf, 0x82, 0x3d, 0x5a, 0x84, 0xb6, 0xf9, 0x9d, 0xfb, 0x63,
-	0x68, 0x28, 0xf3, 0x81, 0xb6, 0xce, 0x5c, 0xbf, 0x3c, 0x03, 0xc2, 0x7a, 0xd0, 0xb0, 0x7b, 0xe1,
-	0x35, 0xbe, 0x4c, 0xdb, 0xee, 0x9f, 0x83, 0xce, 0x1e, 0x32, 0x51, 0x88, 0x4c, 0x2b, 0x6d, 0xa2,
-	0x35, 0x98, 0x33, 0x84, 0xa7, 0xe9, 0xc9, 0x12, 0xee, 0x4e, 0xa7, 0x51, 0x92, 0xca, 0x76, 0xe8,
-	0xb7, 0xfb, 0xef, 0x1c, 0x60, 0x3b, 0x49, 0x1a, 0x8c, 0xfd, 0x94, 0x3f, 0xe6, 0x5a, 0x35, 0x3c,
-	0x83, 0x36, 0xd6, 0xf6, 0x3c, 0xda, 0x14, 0x16, 0x8a, 0xd8, 0x59, 0xbf, 0x26, 0xc5, 0xb9, 0xf8,
-	0xc1, 0x86, 0x49, 0x2d, 0x94, 0xae, 0x55, 0x01, 0x4a, 0x5b, 0xea, 0xc7, 0x27, 0x3c, 0x25, 0xf3,
-	0x45, 0x1a, 0xbf, 0x20, 0x40, 0x5b, 0x51, 0x78, 0xdc, 0xfb, 0x7d, 0x58, 0x2e, 0xd4, 0x61, 0xea,
-	0xe7, 0x66, 0x89, 0x7e, 0xae, 0x9a, 0xfa, 0x79, 0x00, 0x2b, 0x56, 0xbf, 0x24, 0xc7, 0x75, 0x61,
-	0x1e, 0x05, 0x03, 0xad, 0x43, 0xda, 0xe1, 0x3d, 0x55, 0x64, 0xf7, 0x61, 0xf5, 0x98, 0xf3, 0xd8,
-	0x4f, 0xa9, 0x48, 0xa2, 0x83, 0x6b, 0x22, 0x6b, 0x2e, 0xc5, 0xb9, 0xff, 0xd5, 0x81, 0x25, 0xd4,
-	0xa4, 0x9f, 0xf9, 0xe1, 0x85, 0x9a, 0xab, 0xbd, 0xd2, 0xb9, 0xba, 0x6d, 0x6c, 0x4a, 0x06, 0xf5,
-	0x97, 0x9d, 0xa8, 0x6a, 0x7e, 0xa2, 0xd8, 0x75, 0x68, 0x5b, 0xdd, 0xad, 0x0b, 0x73, 0x2c, 0xf1,
-	0xd3, 0x03, 0x1e, 0x3f, 0xba, 0x48, 0xf9, 0x6f, 0x3e, 0x95, 0x37, 0xa1, 0x93, 0x75, 0x5b, 0xce,
-	0x23, 0x83, 0x1a, 0x32, 0xa6, 0xac, 0x80, 0x7e, 0xbb, 0xff, 0xd0, 0x11, 0x84, 0x5b, 0x51, 0xa0,
-	0x4d, 0x35, 0x24, 0x44, 0x8b, 0x4f, 0x11, 0xe2, 0xef, 0x99, 0xa6, 0xee, 0x6f, 0x3e, 0x58, 0xd4,
-	0x89, 0x09, 0x0f, 0x87, 0x7d, 0x7f, 0x34, 0x22, 0x45, 0xdc, 0xf0, 0xe6, 0xb1, 0xbc, 0x39, 0x1a,
-	0xb9, 0xb7, 0x60, 0xd9, 0xe8, 0xdd, 0x1b, 0xc6, 0xb1, 0x0f, 0x6c, 0x2f, 0x48, 0xd2, 0x17, 0x61,
-	0x32, 0x31, 0x2c, 0xa1, 0xf7, 0xa0, 0x89, 0xda, 0x16, 0x7b, 0x26, 0x24, 0xb7, 0xee, 0xa1, 0xfa,
-	0xc5, 0x7e, 0x25, 0x84, 0xf4, 0x5f, 0x4b, 0x64, 0x45, 0x22, 0xfd, 0xd7, 0x84, 0x74, 0x1f, 0xc0,
-	0x8a, 0x55, 0x9f, 0x6c, 0xfa, 0x43, 0xa8, 0x4f, 0xd3, 0xd7, 0x91, 0xb2, 0x53, 0x5b, 0x92, 0x43,
-	0xd0, 0x23, 0xf2, 0x04, 0xc6, 0x7d, 0x08, 0xcb, 0xfb, 0xfc, 0x5c, 0x0a, 0xb2, 0xea, 0xc8, 0xcd,
-	0xb7, 0x7a, 0x4b, 0x84, 0x77, 0x37, 0x80, 0x99, 0x1f, 0x67, 0x02, 0xa0, 0x7c, 0x27, 0xc7, 0xf2,
-	0x9d, 0xdc, 0x9b, 0xc0, 0x0e, 0x83, 0x93, 0xf0, 0x33, 0x9e, 0x24, 0xfe, 0x89, 0x16, 0xfd, 0x0e,
-	0x54, 0xc7, 0xc9, 0x89, 0x54, 0x55, 0xf8, 0xd3, 0xfd, 0x06, 0xac, 0x58, 0x74, 0xb2, 0xe2, 0xab,
-	0xd0, 0x4c, 0x82, 0x93, 0xd0, 0x4f, 0xa7, 0x31, 0x97, 0x55, 0x67, 0x00, 0xf7, 0x31, 0xac, 0x7e,
-	0x8f, 0xc7, 0xc1, 0xf1, 0xc5, 0xdb, 0xaa, 0xb7, 0xeb, 0xa9, 0xe4, 0xeb, 0xd9, 0x81, 0xcb, 0xb9,
-	0x7a, 0x64, 0xf3, 0x82, 0x7d, 0xe5, 0x4a, 0x36, 0x3c, 0x51, 0x30, 0x74, 0x5f, 0xc5, 0xd4, 0x7d,
-	0xee, 0x0b, 0x60, 0x5b, 0x51, 0x18, 0xf2, 0x41, 0x7a, 0xc0, 0x79, 0x9c, 0x85, 0x6d, 0x32, 0x5e,
-	0x6d, 0xdd, 0x5f, 0x97, 0x33, 0x9b, 0x57, 0xa8, 0x92, 0x89, 0x19, 0xd4, 0x26, 0x3c, 0x1e, 0x53,
-	0xc5, 0x0d, 0x8f, 0x7e, 0xbb, 0x97, 0x61, 0xc5, 0xaa, 0x56, 0x3a, 0xba, 0x1f, 0xc3, 0xe5, 0xed,
-	0x20, 0x19, 0x14, 0x1b, 0xec, 0xc2, 0xfc, 0x64, 0x7a, 0xd4, 0xcf, 0x24, 0x51, 0x15, 0xd1, 0xf7,
-	0xc9, 0x7f, 0x22, 0x2b, 0xfb, 0xeb, 0x0e, 0xd4, 0x76, 0x9f, 0xef, 0x6d, 0xe1, 0x5e, 0x11, 0x84,
-	0x83, 0x68, 0x8c, 0x16, 0x98, 0x18, 0xb4, 0x2e, 0xcf, 0x94, 0xb0, 0xab, 0xd0, 0x24, 0xc3, 0x0d,
-	0xdd, 0x3d, 0x69, 0x07, 0x65, 0x00, 0x74, 0x35, 0xf9, 0xeb, 0x49, 0x10, 0x93, 0x2f, 0xa9, 0x3c,
-	0xc4, 0x1a, 0x6d, 0x33, 0x45, 0x84, 0xfb, 0x3f, 0xe7, 0x60, 0x5e, 0x6e, 0xbe, 0x62, 0x23, 0x4f,
-	0x83, 0x33, 0x9e, 0x6d, 0xe4, 0x58, 0x42, 0xa3, 0x38, 0xe6, 0xe3, 0x28, 0xd5, 0xf6, 0x9b, 0x58,
-	0x06, 0x1b, 0x48, 0xae, 0xb4, 0x34, 0x22, 0x84, 0xf3, 0x5d, 0x15, 0x54, 0x16, 0x10, 0x27, 0x4b,
-	0x19, 0x03, 0xc2, 0x3a, 0x53, 0x45, 0x9c, 0x89, 0x81, 0x3f, 0xf1, 0x07, 0x41, 0x7a, 0x21, 0x55,
-	0x82, 0x2e, 0x63, 0xdd, 0xa3, 0x68, 0xe0, 0x8f, 0xfa, 0x47, 0xfe, 0xc8, 0x0f, 0x07, 0x5c, 0xb9,
-	0xe9, 0x16, 0x10, 0x5d, 0x56, 0xd9, 0x25, 0x45, 0x26, 0xdc, 0xda, 0x1c, 0x14, 0xf7, 0xef, 0x41,
-	0x34, 0x1e, 0x07, 0x29, 0x7a, 0xba, 0x64, 0x96, 0x55, 0x3d, 0x03, 0x22, 0x82, 0x02, 0x54, 0x3a,
-	0x17, 0xb3, 0xd7, 0x54, 0x41, 0x01, 0x03, 0x88, 0xb5, 0xe4, 0xac, 0xb3, 0xaa, 0x67, 0x40, 0x70,
-	0x1d, 0xa6, 0x61, 0xc2, 0xd3, 0x74, 0xc4, 0x87, 0xba, 0x43, 0x2d, 0x22, 0x2b, 0x22, 0xd8, 0x3d,
-	0x58, 0x11, 0xce, 0x77, 0xe2, 0xa7, 0x51, 0x72, 0x1a, 0x24, 0xfd, 0x04, 0xdd, 0xd4, 0x36, 0xd1,
-	0x97, 0xa1, 0xd8, 0x03, 0x58, 0xcf, 0x81, 0x63, 0x3e, 0xe0, 0xc1, 0x19, 0x1f, 0x92, 0xf9, 0x56,
-	0xf5, 0x66, 0xa1, 0xd9, 0x75, 0x68, 0x85, 0xd3, 0x71, 0x7f, 0x3a, 0x19, 0xfa, 0x68, 0xc0, 0x2c,
-	0xd2, 0x3a, 0x98, 0x20, 0xf6, 0x31, 0x28, 0x1b, 0x4d, 0x5a, 0x8e, 0x4b, 0x96, 0x76, 0x43, 0xce,
-	0xf5, 0x6c, 0x0a, 0x64, 0xca, 0xcc, 0x1c, 0xed, 0x48, 0x07, 0x4f, 0x01, 0x48, 0x46, 0xe2, 0xe0,
-	0xcc, 0x4f, 0x79, 0x77, 0x59, 0x28, 0x74, 0x59, 0xc4, 0xef, 0x82, 0x30, 0x48, 0x03, 0x3f, 0x8d,
-	0xe2, 0x2e, 0x23, 0x5c, 0x06, 0xc0, 0x49, 0x24, 0xfe, 0x48, 0x52, 0x3f, 0x9d, 0x26, 0xd2, 0x3a,
-	0x5d, 0x11, 0x9e, 0x4a, 0x01, 0xc1, 0x3e, 0x81, 0x35, 0xc1, 0x11, 0x84, 0x92, 0x76, 0x37, 0x99,
-	0x09, 0xab, 0x34, 0x23, 0x33, 0xb0, 0x38, 0x95, 0x92, 0x45, 0x0a, 0x1f, 0x5e, 0x16, 0x53, 0x39,
-	0x03, 0x8d, 0xfd, 0xc3, 0x1e, 0x04, 0x83, 0xbe, 0xa4, 0x40, 0xf1, 0x58, 0xa3, 0x51, 0x14, 0x11,
-	0xee, 0x3f, 0x76, 0xc4, 0x26, 0x22, 0x05, 0x2e, 0x31, 0xdc, 0x23, 0x21, 0x6a, 0xfd, 0x28, 0x1c,
-	0x5d, 0x48, 0xe9, 0x03, 0x01, 0x7a, 0x16, 0x8e, 0x2e, 0xd0, 0x40, 0x0f, 0x42, 0x93, 0x44, 0xe8,
-	0xab, 0xb6, 0x02, 0x12, 0xd1, 0x07, 0xd0, 0x9a, 0x4c, 0x8f, 0x46, 0xc1, 0x40, 0x90, 0x54, 0x45,
-	0x2d, 0x02, 0x44, 0x04, 0xe8, 0x1b, 0x8a, 0x59, 0x17, 0x14, 0x35, 0xa2, 0x68, 0x49, 0x18, 0x92,
-	0xb8, 0x8f, 0x60, 0xd5, 0xee, 0xa0, 0x54, 0xcc, 0x77, 0xa0, 0x21, 0xe5, 0x38, 0x91, 0x0e, 0xfa,
-	0xa2, 0x11, 0xbb, 0x44, 0x77, 0x46, 0xe3, 0xdd, 0x7f, 0x5d, 0x83, 0x15, 0x09, 0xdd, 0x1a, 0x45,
-	0x09, 0x3f, 0x9c, 0x8e, 0xc7, 0x7e, 0x5c, 0xa2, 0x20, 0x9c, 0xb7, 0x28, 0x88, 0x8a, 0xad, 0x20,
-	0xae, 0x59, 0x3e, 0xa2, 0xd0, 0x2e, 0x06, 0x84, 0xdd, 0x86, 0xa5, 0xc1, 0x28, 0x4a, 0x84, 0xc9,
-	0x6e, 0x86, 0xce, 0xf2, 0xe0, 0xa2, 0x42, 0xab, 0x97, 0x29, 0x34, 0x53, 0x21, 0xcd, 0xe5, 0x14,
-	0x92, 0x0b, 0x6d, 0xac, 0x94, 0x2b, 0xfd, 0x3a, 0x2f, 0x1d, 0x26, 0x03, 0x86, 0xfd, 0xc9, 0x8b,
-	0xbf, 0xd0, 0x35, 0x4b, 0x65, 0xc2, 0x1f, 0x8c, 0x39, 0xe9, 0x6f, 0x83, 0xba, 0x29, 0x85, 0xbf,
-	0x88, 0x62, 0x8f, 0x01, 0x44, 0x5b, 0x64, 0x44, 0x00, 0x19, 0x11, 0x37, 0xed, 0x15, 0x31, 0xe7,
-	0x7e, 0x03, 0x0b, 0xd3, 0x98, 0x93, 0x61, 0x61, 0x7c, 0xe9, 0xfe, 0x4d, 0x07, 0x5a, 0x06, 0x8e,
-	0x5d, 0x86, 0xe5, 0xad, 0x67, 0xcf, 0x0e, 0x76, 0xbc, 0xcd, 0xe7, 0x4f, 0xbf, 0xb7, 0xd3, 0xdf,
-	0xda, 0x7b, 0x76, 0xb8, 0xd3, 0xb9, 0x84, 0xe0, 0xbd, 0x67, 0x5b, 0x9b, 0x7b, 0xfd, 0xc7, 0xcf,
-	0xbc, 0x2d, 0x05, 0x76, 0xd8, 0x1a, 0x30, 0x6f, 0xe7, 0xb3, 0x67, 0xcf, 0x77, 0x2c, 0x78, 0x85,
-	0x75, 0xa0, 0xfd, 0xc8, 0xdb, 0xd9, 0xdc, 0xda, 0x95, 0x90, 0x2a, 0x5b, 0x85, 0xce, 0xe3, 0x17,
-	0xfb, 0xdb, 0x4f, 0xf7, 0x9f, 0xf4, 0xb7, 0x36, 0xf7, 0xb7, 0x76, 0xf6, 0x76, 0xb6, 0x3b, 0x35,
-	0xb6, 0x00, 0xcd, 0xcd, 0x47, 0x9b, 0xfb, 0xdb, 0xcf, 0xf6, 0x77, 0xb6, 0x3b, 0x75, 0xf7, 0x3f,
-	0x3b, 0x70, 0x99, 0x7a, 0x3d, 0xcc, 0x0b, 0xc8, 0x75, 0x68, 0x0d, 0xa2, 0x68, 0x82, 0xc6, 0x7b,
-	0xb6, 0x3d, 0x99, 0x20, 0x64, 0x7e, 0x21, 0xdc, 0xc7, 0x51, 0x3c, 0xe0, 0x52, 0x3e, 0x80, 0x40,
-	0x8f, 0x11, 0x82, 0xcc, 0x2f, 0x97, 0x57, 0x50, 0x08, 0xf1, 0x68, 0x09, 0x98, 0x20, 0x59, 0x83,
-	0xb9, 0xa3, 0x98, 0xfb, 0x83, 0x53, 0x29, 0x19, 0xb2, 0xc4, 0xbe, 0x9a, 0x79, 0x97, 0x03, 0x9c,
-	0xfd, 0x11, 0x1f, 0x12, 0xc7, 0x34, 0xbc, 0x25, 0x09, 0xdf, 0x92, 0x60, 0xd4, 0x66, 0xfe, 0x91,
-	0x1f, 0x0e, 0xa3, 0x90, 0x0f, 0xa5, 0xe9, 0x9a, 0x01, 0xdc, 0x03, 0x58, 0xcb, 0x8f, 0x4f, 0xca,
-	0xd7, 0x27, 0x86, 0x7c, 0x09, 0x4b, 0xb2, 0x37, 0x7b, 0x35, 0x0d, 0x59, 0xfb, 0xd3, 0x0a, 0xd4,
-	0xd0, 0xb0, 0x98, 0x6d, 0x84, 0x98, 0xb6, 0x62, 0xb5, 0x10, 0x67, 0x27, 0x87, 0x55, 0x6c, 0x35,
-	0x32, 0x58, 0x92, 0x41, 0x32, 0x7c, 0xcc, 0x07, 0x67, 0x32, 0x5c, 0x62, 0x40, 0x50, 0x40, 0xd0,
-	0x90, 0xa7, 0xaf, 0xa5, 0x80, 0xa8, 0xb2, 0xc2, 0xd1, 0x97, 0xf3, 0x19, 0x8e, 0xbe, 0xeb, 0xc2,
-	0x7c, 0x10, 0x1e, 0x45, 0xd3, 0x70, 0x48, 0x02, 0xd1, 0xf0, 0x54, 0x91, 0x22, 0xfb, 0x24, 0xa8,
-	0xc1, 0x58, 0xb1, 0x7f, 0x06, 0x60, 0xf7, 0xa1, 0x99, 0x5c, 0x84, 0x03, 0x93, 0xe7, 0x57, 0xe5,
-	0x2c, 0xe1, 0x1c, 0x6c, 0x1c, 0x5e, 0x84, 0x03, 0xe2, 0xf0, 0x8c, 0xcc, 0xfd, 0x7d, 0x68, 0x28,
-	0x30, 0xb2, 0xe5, 0x8b, 0xfd, 0xef, 0xec, 0x3f, 0x7b, 0xb9, 0xdf, 0x3f, 0xfc, 0xfe, 0xfe, 0x56,
-	0xe7, 0x12, 0x5b, 0x82, 0xd6, 0xe6, 0x16, 0x71, 0x3a, 0x01, 0x1c, 0x24, 0x39, 0xd8, 0x3c, 0x3c,
-	0xd4, 0x90, 0x8a, 0xcb, 0xd0, 0x19, 0x4f, 0xc8, 0x7a, 0xd3, 0x91, 0xeb, 0x4f, 0x60, 0xd9, 0x80,
-	0x65, 0x9e, 0xc0, 0x04, 0x01, 0x39, 0x4f, 0x80, 0xcc, 0x3e, 0x81, 0x71, 0x3b, 0xb0, 0xf8, 0x84,
-	0xa7, 0x4f, 0xc3, 0xe3, 0x48, 0xd5, 0xf4, 0xdf, 0x6b, 0xb0, 0xa4, 0x41, 0xb2, 0xa2, 0xdb, 0xb0,
-	0x14, 0x0c, 0x79, 0x98, 0x06, 0xe9, 0x45, 0xdf, 0xf2, 0xf9, 0xf3, 0x60, 0x34, 0x97, 0xfd, 0x51,
-	0xe0, 0xab, 0x03, 0x14, 0x51, 0x40, 0x1f, 0x18, 0xf7, 0x72, 0x33, 0xf6, 0x42, 0x7c, 0x25, 0x42,
-	0x0d, 0xa5, 0x38, 0xd4, 0x40, 0x08, 0x97, 0x5b, 0x8c, 0xfe, 0x44, 0x98, 0x8d, 0x65, 0x28, 0x5c,
-	0x2a, 0x51, 0x13, 0x0e, 0xb9, 0x2e, 0xf6, 0x7b, 0x0d, 0x28, 0x9c, 0x50, 0xcc, 0x09, 0xfd, 0x98,
-	0x3f, 0xa1, 0x30, 0x4e, 0x39, 0x1a, 0x85, 0x53, 0x0e, 0xd4, 0x9f, 0x17, 0xe1, 0x80, 0x0f, 0xfb,
-	0x69, 0xd4, 0x27, 0x3d, 0x4f, 0x2c, 0xd1, 0xf0, 0xf2, 0x60, 0x76, 0x15, 0xe6, 0x53, 0x9e, 0xa4,
-	0x21, 0x17, 0xa1, 0xe5, 0xc6, 0xa3, 0x4a, 0xd7, 0xf1, 0x14, 0x08, 0x6d, 0xfc, 0x69, 0x1c, 0x24,
-	0xdd, 0x36, 0x9d, 0x5f, 0xd0, 0x6f, 0xf6, 0x4d, 0xb8, 0x7c, 0xc4, 0x93, 0xb4, 0x7f, 0xca, 0xfd,
-	0x21, 0x8f, 0x89, 0xbd, 0xc4, 0x41, 0x89, 0x30, 0x9d, 0xca, 0x91, 0xc8, 0xb8, 0x67, 0x3c, 0x4e,
-	0x82, 0x28, 0x24, 0xa3, 0xa9, 0xe9, 0xa9, 0x22, 0xd6, 0x87, 0x83, 0xd7, 0x9b, 0xb4, 0x9e, 0xc1,
-	0x25, 0x1a, 0x78, 0x39, 0x92, 0xdd, 0x80, 0x39, 0x1a, 0x40, 0xd2, 0xed, 0x10, 0xcf, 0xb4, 0x33,
-	0x99, 0x0f, 0x42, 0x4f, 0xe2, 0x70, 0x95, 0x07, 0xd1, 0x28, 0x8a, 0xc9, 0x72, 0x6a, 0x7a, 0xa2,
-	0x60, 0xcf, 0xce, 0x49, 0xec, 0x4f, 0x4e, 0xa5, 0xf5, 0x94, 0x07, 0x7f, 0xbb, 0xd6, 0x68, 0x75,
-	0xda, 0xee, 0x9f, 0x81, 0x3a, 0x55, 0x4b, 0xd5, 0xd1, 0x64, 0x3a, 0xb2, 0x3a, 0x82, 0x76, 0x61,
-	0x3e, 0xe4, 0xe9, 0x79, 0x14, 0xbf, 0x52, 0xa7, 0x71, 0xb2, 0xe8, 0xfe, 0x94, 0xbc, 0x2c, 0x7d,
-	0x3a, 0xf5, 0x82, 0x4c, 0x44, 0xf4, 0x95, 0xc5, 0x52, 0x25, 0xa7, 0xbe, 0x74, 0xfc, 0x1a, 0x04,
-	0x38, 0x3c, 0xf5, 0x51, 0xd7, 0x5a, 0xab, 0x2f, 0x7c, 0xe9, 0x16, 0xc1, 0x76, 0xc5, 0xe2, 0xdf,
-	0x80, 0x45, 0x75, 0xee, 0x95, 0xf4, 0x47, 0xfc, 0x38, 0x55, 0x91, 0xb0, 0x70, 0x3a, 0x26, 0x87,
-	0x7b, 0x8f, 0x1f, 0xa7, 0xee, 0x3e, 0x2c, 0x4b, 0xfd, 0xf7, 0x6c, 0xc2, 0x55, 0xd3, 0xbf, 0x5b,
-	0x66, 0x47, 0xb4, 0xee, 0xaf, 0xd8, 0x0a, 0x53, 0x9c, 0xf4, 0xd9, 0x94, 0xae, 0x07, 0xcc, 0xd4,
-	0xa7, 0xb2, 0x42, 0xb9, 0x99, 0xab, 0x58, 0x9f, 0x1c, 0x8e, 0x05, 0xc3, 0xf9, 0x49, 0xa6, 0x83,
-	0x81, 0x3a, 0xad, 0x6c, 0x78, 0xaa, 0xe8, 0xfe, 0x13, 0x07, 0x56, 0xa8, 0x36, 0x65, 0x09, 0xc9,
-	0x3d, 0xeb, 0xc1, 0x97, 0xe8, 0xa6, 0x8a, 0xb4, 0x8a, 0xf8, 0xe2, 0x2a, 0xd4, 0xcd, 0x5d, 0x4c,
-	0x14, 0xbe, 0x7c, 0x5c, 0xa5, 0x96, 0x8f, 0xab, 0xb8, 0x7f, 0xcf, 0x81, 0x65, 0xb1, 0x91, 0x90,
-	0xd5, 0x2c, 0x87, 0xff, 0x67, 0x61, 0x41, 0x58, 0x04, 0x52, 0x2b, 0xc8, 0x8e, 0x66, 0xaa, 0x95,
-	0xa0, 0x82, 0x78, 0xf7, 0x92, 0x67, 0x13, 0xb3, 0x87, 0x64, 0x95, 0x85, 0x7d, 0x82, 0x96, 0x9c,
-	0x6b, 0xdb, 0x73, 0xbd, 0x7b, 0xc9, 0x33, 0xc8, 0x1f, 0x35, 0x60, 0x4e, 0xb8, 0x1c, 0xee, 0x13,
-	0x58, 0xb0, 0x1a, 0xb2, 0x62, 0x3a, 0x6d, 0x11, 0xd3, 0x29, 0x04, 0x4f, 0x2b, 0x25, 0xc1, 0xd3,
-	0x7f, 0x59, 0x05, 0x86, 0xcc, 0x92, 0x5b, 0x8d, 0xeb, 0xf6, 0x09, 0x84, 0x3a, 0xe2, 0xce, 0x40,
-	0x6c, 0x03, 0x98, 0x51, 0x54, 0xa7, 0x22, 0x62, 0xcb, 0x2c, 0xc1, 0xa0, 0x9a, 0x95, 0x16, 0x87,
-	0x3e, 0x71, 0x20, 0x5f, 0x5d, 0x4c, 0x7b, 0x29, 0x0e, 0x77, 0x45, 0x3a, 0x7e, 0x40, 0xcf, 0x42,
-	0xfa, 0xb8, 0xaa, 0x9c, 0x5f, 0xdf, 0xb9, 0xb7, 0xae, 0xef, 0x7c, 0x21, 0x6e, 0x66, 0x78, 0x59,
-	0x0d, 0xdb, 0xcb, 0xba, 0x01, 0x0b, 0xea, 0x94, 0xa1, 0x3f, 0xc6, 0xd6, 0xa5, 0x4b, 0x6b, 0x01,
-	0xd9, 0x1d, 0xe8, 0x28, 0x47, 0x47, 0xbb, 0x72, 0xe2, 0xac, 0xae, 0x00, 0x47, 0xfd, 0x9f, 0x45,
-	0xd2, 0x5a, 0xd4, 0xd9, 0x0c, 0x40, 0x7e, 0x11, 0x72, 0x48, 0x7f, 0x1a, 0xca, 0xa3, 0x6d, 0x3e,
-	0x24, 0x67, 0x16, 0xfd, 0xa2, 0x3c, 0xc2, 0xfd, 0x3b, 0x0e, 0x74, 0x70, 0xcd, 0x2c, 0xb6, 0xfc,
-	0x14, 0x48, 0x2a, 0xde, 0x91, 0x2b, 0x2d, 0x5a, 0xf6, 0x00, 0x9a, 0x54, 0x8e, 0x26, 0x3c, 0x94,
-	0x3c, 0xd9, 0xb5, 0x79, 0x32, 0xd3, 0x27, 0xbb, 0x97, 0xbc, 0x8c, 0xd8, 0xe0, 0xc8, 0xff, 0xe0,
-	0x40, 0x4b, 0xb6, 0xf2, 0x6b, 0x47, 0x6a, 0x7a, 0x46, 0x2e, 0x82, 0xe0, 0xa4, 0x2c, 0xf5, 0xe0,
-	0x36, 0x2c, 0x8d, 0xfd, 0x74, 0x1a, 0xe3, 0x7e, 0x6e, 0x45, 0x69, 0xf2, 0x60, 0xdc, 0x9c, 0x49,
-	0x75, 0x26, 0xfd, 0x34, 0x18, 0xf5, 0x15, 0x56, 0x9e, 0xfa, 0x97, 0xa1, 0x50, 0x83, 0x24, 0xa9,
-	0x7f, 0xc2, 0xe5, 0xbe, 0x2b, 0x0a, 0x6e, 0x17, 0xd6, 0x0e, 0xb2, 0x93, 0x17, 0xc3, 0xbe, 0x76,
-	0xff, 0xf9, 0x02, 0xac, 0x17, 0x50, 0x3a, 0x47, 0x49, 0x86, 0x1f, 0x46, 0xc1, 0xf8, 0x28, 0xd2,
-	0xce, 0x89, 0x63, 0x46, 0x26, 0x2c, 0x14, 0x3b, 0x81, 0xcb, 0xca, 0xc0, 0xc0, 0x39, 0xcd, 0x36,
-	0xc3, 0x0a, 0xed, 0x72, 0x1f, 0xdb, 0x4b, 0x98, 0x6f, 0x50, 0xc1, 0x4d, 0x21, 0x2e, 0xaf, 0x8f,
-	0x9d, 0x42, 0x57, 0x5b, 0x32, 0x52, 0x59, 0x1b, 0xd6, 0x0e, 0xb6, 0xf5, 0xd1, 0x5b, 0xda, 0xb2,
-	0xcc, 0x71, 0x6f, 0x66, 0x6d, 0xec, 0x02, 0xae, 0x29, 0x1c, 0x69, 0xe3, 0x62, 0x7b, 0xb5, 0x77,
-	0x1a, 0x1b, 0x39, 0x1a, 0x76, 0xa3, 0x6f, 0xa9, 0x98, 0xfd, 0x18, 0xd6, 0xce, 0xfd, 0x20, 0x55,
-	0xdd, 0x32, 0x6c, 0x8b, 0x3a, 0x35, 0x79, 0xff, 0x2d, 0x4d, 0xbe, 0x14, 0x1f, 0x5b, 0x5b, 0xd4,
-	0x8c, 0x1a, 0x7b, 0x7f, 0x54, 0x81, 0x45, 0xbb, 0x1e, 0x64, 0x53, 0x29, 0xfb, 0x4a, 0x07, 0x2a,
-	0x6b, 0x34, 0x07, 0x2e, 0xfa, 0xf7, 0x95, 0x32, 0xff, 0xde, 0xf4, 0xaa, 0xab, 0x6f, 0x0b, 0xf3,
-	0xd5, 0xde, 0x2d, 0xcc, 0x57, 0x2f, 0x0d, 0xf3, 0xcd, 0x8e, 0x06, 0xcd, 0xfd, 0xba, 0xd1, 0xa0,
-	0xf9, 0x37, 0x46, 0x83, 0x7a, 0xff, 0xc7, 0x01, 0x56, 0xe4, 0x5e, 0xf6, 0x44, 0x84, 0x34, 0x42,
-	0x3e, 0x92, 0x4a, 0xec, 0xeb, 0xef, 0x26, 0x01, 0x6a, 0xb5, 0xd4, 0xd7, 0x28, 0x8a, 0x66, 0xa2,
-	0x90, 0x69, 0x5e, 0x2d, 0x78, 0x65, 0xa8, 0x5c, 0xa8, 0xb3, 0xf6, 0xf6, 0x50, 0x67, 0xfd, 0xed,
-	0xa1, 0xce, 0xb9, 0x7c, 0xa8, 0xb3, 0xf7, 0xd7, 0x1c, 0x58, 0x29, 0x61, 0xb3, 0xdf, 0xde, 0xc0,
-	0x91, 0x31, 0x2c, 0xed, 0x53, 0x91, 0x8c, 0x61, 0x02, 0x7b, 0x7f, 0x09, 0x16, 0x2c, 0xd1, 0xfa,
-	0xed, 0xb5, 0x9f, 0xb7, 0x10, 0x05, 0x67, 0x5b, 0xb0, 0xde, 0xff, 0xaa, 0x00, 0x2b, 0x8a, 0xf7,
-	0xff, 0xd7, 0x3e, 0x14, 0xe7, 0xa9, 0x5a, 0x32, 0x4f, 0xff, 0x4f, 0x77, 0x9e, 0x8f, 0x60, 0x59,
-	0x66, 0x3f, 0x1a, 0x81, 0x2c, 0xc1, 0x31, 0x45, 0x04, 0xda, 0xc8, 0x76, 0x9c, 0xb9, 0x61, 0x65,
-	0x7b, 0x19, 0xdb, 0x6f, 0x2e, 0xdc, 0xec, 0xf6, 0xa0, 0x2b, 0x67, 0x68, 0xe7, 0x8c, 0x87, 0xe9,
-	0xe1, 0xf4, 0x48, 0xa4, 0xff, 0x05, 0x51, 0xe8, 0xfe, 0xab, 0xaa, 0x36, 0xf3, 0x09, 0x29, 0x0d,
-	0x8a, 0x6f, 0x42, 0xdb, 0xdc, 0x3e, 0xe4, 0x72, 0xe4, 0xe2, 0x98, 0x68, 0x4a, 0x98, 0x54, 0x6c,
-	0x1b, 0x16, 0x49, 0x49, 0x0e, 0xf5, 0x77, 0x15, 0xfa, 0xee, 0x0d, 0xf1, 0x99, 0xdd, 0x4b, 0x5e,
-	0xee, 0x1b, 0xf6, 0x7b, 0xb0, 0x68, 0x3b, 0x7f, 0xd2, 0x2a, 0x29, 0xf3, 0x06, 0xf0, 0x73, 0x9b,
-	0x98, 0x6d, 0x42, 0x27, 0xef, 0x3d, 0xca, 0x6c, 0x9c, 0x19, 0x15, 0x14, 0xc8, 0xd9, 0x03, 0x79,
-	0xe0, 0x58, 0xa7, 0xb8, 0xc9, 0x0d, 0xfb, 0x33, 0x63, 0x9a, 0x36, 0xc4, 0x1f, 0xe3, 0x08, 0xf2,
-	0x0f, 0x00, 0x32, 0x18, 0xeb, 0x40, 0xfb, 0xd9, 0xc1, 0xce, 0x7e, 0x7f, 0x6b, 0x77, 0x73, 0x7f,
-	0x7f, 0x67, 0xaf, 0x73, 0x89, 0x31, 0x58, 0xa4, 0x30, 0xdf, 0xb6, 0x86, 0x39, 0x08, 0x93, 0x81,
-	0x15, 0x05, 0xab, 0xb0, 0x55, 0xe8, 0x3c, 0xdd, 0xcf, 0x41, 0xab, 0x8f, 0x9a, 0x5a, 0x3e, 0xdc,
-	0x35, 0x58, 0x15, 0xd9, 0xad, 0x8f, 0x04, 0x7b, 0x28, 0xeb, 0xe4, 0x1f, 0x39, 0x70, 0x39, 0x87,
-	0xc8, 0xd2, 0xb5, 0x84, 0x01, 0x62, 0x5b, 0x25, 0x36, 0x90, 0x0e, 0x11, 0x94, 0xad, 0x99, 0xd3,
-	0x20, 0x45, 0x04, 0xf2, 0xbc, 0x61, 0x9b, 0xe6, 0x24, 0xa9, 0x0c, 0xe5, 0xae, 0xeb, 0xcc, 0x98,
-	0x5c, 0xc7, 0x8f, 0x45, 0xd6, 0xac, 0x89, 0xc8, 0x0e, 0x70, 0xed, 0x2e, 0xab, 0x22, 0xba, 0x15,
-	0x96, 0xb1, 0x63, 0xf7, 0xb7, 0x14, 0xe7, 0xfe, 0xd3, 0x2a, 0xb0, 0xef, 0x4e, 0x79, 0x7c, 0x41,
-	0x39, 0x59, 0x3a, 0x6a, 0xba, 0x9e, 0x8f, 0x09, 0xce, 0x4d, 0xa6, 0x47, 0xdf, 0xe1, 0x17, 0x2a,
-	0x3b, 0xb1, 0x92, 0x65, 0x27, 0x96, 0x65, 0x08, 0xd6, 0xde, 0x9e, 0x21, 0x58, 0x7f, 0x5b, 0x86,
-	0xe0, 0x57, 0x60, 0x21, 0x38, 0x09, 0x23, 0x94, 0x79, 0xb4, 0x13, 0x92, 0xee, 0xdc, 0xf5, 0x2a,
-	0xfa, 0xd6, 0x12, 0xb8, 0x8f, 0x30, 0xf6, 0x30, 0x23, 0xe2, 0xc3, 0x13, 0xca, 0x46, 0x35, 0xb5,
-	0xc0, 0xce, 0xf0, 0x84, 0xef, 0x45, 0x03, 0x3f, 0x8d, 0x62, 0x0a, 0xec, 0xa8, 0x8f, 0x11, 0x9e,
-	0xb0, 0x1b, 0xb0, 0x98, 0x44, 0x53, 0xb4, 0x9c, 0xd4, 0x58, 0x45, 0x24, 0xa9, 0x2d, 0xa0, 0x07,
-	0x62, 0xc4, 0x1b, 0xb0, 0x32, 0x4d, 0x78, 0x7f, 0x1c, 0x24, 0x09, 0xee, 0x8e, 0x83, 0x28, 0x4c,
-	0xe3, 0x68, 0x24, 0xe3, 0x49, 0xcb, 0xd3, 0x84, 0x7f, 0x26, 0x30, 0x5b, 0x02, 0xc1, 0xbe, 0x99,
-	0x75, 0x69, 0xe2, 0x07, 0x71, 0xd2, 0x05, 0xea, 0x92, 0x1a, 0x29, 0xf6, 0xfb, 0xc0, 0x0f, 0x62,
-	0xdd, 0x17, 0x2c, 0x24, 0xb9, 0x2c, 0xc7, 0x56, 0x2e, 0xcb, 0x51, 0x26, 0xc9, 0x6d, 0x40, 0x43,
-	0x7d, 0x8e, 0x4e, 0xee, 0x71, 0x1c, 0x8d, 0x95, 0x93, 0x8b, 0xbf, 0xd9, 0x22, 0x54, 0xd2, 0x48,
-	0x3a, 0xa8, 0x95, 0x34, 0x72, 0xbf, 0x0f, 0x2d, 0x63, 0x06, 0x64, 0xa6, 0x1c, 0x19, 0x54, 0xd2,
-	0x3b, 0xae, 0x09, 0xff, 0x25, 0xe4, 0xa3, 0xa7, 0x43, 0xf6, 0x35, 0x58, 0x1e, 0x06, 0x31, 0xa7,
-	0xa4, 0xd8, 0x7e, 0xcc, 0xcf, 0x78, 0x9c, 0xa8, 0x38, 0x42, 0x47, 0x23, 0x3c, 0x01, 0x77, 0xfb,
-	0xb0, 0x62, 0xb1, 0x8d, 0x96, 0xaa, 0x39, 0xca, 0xea, 0x53, 0xa1, 0x4c, 0x3b, 0xe3, 0x4f, 0xe2,
-	0x70, 0x3f, 0x92, 0x21, 0x90, 0xfe, 0x24, 0x8e, 0x8e, 0xa8, 0x11, 0xc7, 0xb3, 0x60, 0xee, 0x2f,
-	0x2b, 0x50, 0xdd, 0x8d, 0x26, 0xe6, 0xa1, 0x8e, 0x63, 0x1f, 0xea, 0x48, 0xa3, 0xb1, 0xaf, 0x6d,
-	0x42, 0xb9, 0xb3, 0x5b, 0x40, 0x76, 0x07, 0x16, 0xfd, 0x71, 0xda, 0x4f, 0x23, 0x34, 0x92, 0xcf,
-	0xfd, 0x58, 0xa4, 0xff, 0x55, 0x89, 0x1d, 0x72, 0x18, 0xb6, 0x0a, 0x55, 0x6d, 0xeb, 0x10, 0x01,
-	0x16, 0xd1, 0x43, 0xa3, 0xc3, 0xef, 0x0b, 0x19, 0xab, 0x94, 0x25, 0x94, 0x76, 0xfb, 0x7b, 0xe1,
-	0x1e, 0x8b, 0x1d, 0xab, 0x0c, 0x85, 0x06, 0x2c, 0x0a, 0xc0, 0x38, 0xb3, 0x07, 0x75, 0xd9, 0x8c,
-	0xc2, 0x37, 0xec, 0x28, 0xfc, 0x75, 0x68, 0xa5, 0xa3, 0xb3, 0xfe, 0xc4, 0xbf, 0x18, 0x45, 0xfe,
-	0x50, 0x32, 0x9e, 0x09, 0x72, 0x7f, 0xe5, 0x40, 0x9d, 0x66, 0x18, 0xf7, 0x67, 0xa1, 0xc0, 0xf4,
-	0xc9, 0x0f, 0xcd, 0xda, 0x82, 0x97, 0x07, 0x33, 0xd7, 0x4a, 0xe2, 0xae, 0xe8, 0x21, 0x9b, 0x89,
-	0xdc, 0xd7, 0xa1, 0x29, 0x4a, 0x3a, 0x21, 0x99, 0x48, 0x32, 0x20, 0xbb, 0x06, 0xb5, 0xd3, 0x68,
-	0xa2, 0x5c, 0x18, 0x50, 0x87, 0xbc, 0xd1, 0xc4, 0x23, 0x78, 0xd6, 0x1f, 0xac, 0x4f, 0x0c, 0x5c,
-	0x98, 0x89, 0x79, 0x30, 0x9a, 0xe6, 0xba, 0x5a, 0x73, 0x22, 0x73, 0x50, 0xf7, 0x05, 0x2c, 0xa1,
-	0x0c, 0x18, 0x91, 0xf0, 0xd9, 0xca, 0xea, 0xab, 0xb8, 0xf7, 0x0d, 0x46, 0xd3, 0x21, 0x37, 0x1d,
-	0x49, 0x8a, 0x74, 0x4a, 0xb8, 0x32, 0xa1, 0xdc, 0x7f, 0xe1, 0x08, 0xd9, 0xc2, 0x7a, 0xd9, 0x6d,
-	0xa8, 0xa1, 0xca, 0xc9, 0xc5, 0x0d, 0x74, 0x1e, 0x08, 0xd2, 0x79, 0x44, 0x81, 0x9c, 0x4c, 0xb1,
-	0x48, 0xb3, 0x76, 0x11, 0x89, 0xcc, 0xbc, 0x30, 0x3d, 0xb2, 0x9c, 0xf3, 0x92, 0x83, 0xb2, 0x0d,
-	0xe3, 0x20, 0xa7, 0x66, 0xa9, 0x31, 0xb5, 0xd5, 0x0e, 0x4f, 0xb8, 0x71, 0x80, 0xf3, 0x4b, 0x07,
-	0x16, 0xac, 0x3e, 0x21, 0xa7, 0x8c, 0xfc, 0x24, 0x95, 0x67, 0xf1, 0x72, 0xe5, 0x4d, 0x90, 0xc9,
-	0x65, 0x15, 0x9b, 0xcb, 0xf4, 0x81, 0x40, 0xd5, 0x3c, 0x10, 0xb8, 0x07, 0xcd, 0x2c, 0x8b, 0xdf,
-	0xee, 0x14, 0xb6, 0xa8, 0x32, 0x62, 0x32, 0xa2, 0x2c, 0xe4, 0x5c, 0x37, 0x42, 0xce, 0xee, 0x43,
-	0x68, 0x19, 0xf4, 0x66, 0xc8, 0xd8, 0xb1, 0x42, 0xc6, 0x3a, 0x5d, 0xac, 0x92, 0xa5, 0x8b, 0xb9,
-	0x3f, 0xab, 0xc0, 0x02, 0xb2, 0x77, 0x10, 0x9e, 0x1c, 0x44, 0xa3, 0x60, 0x70, 0x41, 0x6c, 0xa5,
-	0x38, 0x59, 0x6e, 0x39, 0x8a, 0xcd, 0x6d, 0x30, 0x8a, 0x9c, 0xce, 0x91, 0x15, 0xfa, 0x41, 0x97,
-	0x51, 0x81, 0xa0, 0xf8, 0x1d, 0xf9, 0x89, 0x94, 0x49, 0x69, 0xf2, 0x5a, 0x40, 0x14, 0x73, 0x04,
-	0x50, 0xf2, 0xdf, 0x38, 0x18, 0x8d, 0x02, 0x41, 0x2b, 0x1c, 0xa2, 0x32, 0x14, 0xb6, 0x39, 0x0c,
-	0x12, 0xff, 0x28, 0x3b, 0xec, 0xd3, 0x65, 0x8a, 0xa6, 0xf9, 0xaf, 0x8d, 0x68, 0x9a, 0xc8, 0x16,
-	0xb6, 0x81, 0xf9, 0x85, 0x9c, 0x2f, 0x2c, 0xa4, 0xfb, 0xc7, 0x15, 0x68, 0x19, 0x6c, 0x21, 0x4f,
-	0xb8, 0x6d, 0xdd, 0x6e, 0x40, 0x14, 0xde, 0x72, 0xaf, 0x0d, 0x08, 0xbb, 0x61, 0xb7, 0x48, 0x11,
-	0x75, 0x12, 0x76, 0x8b, 0x7d, 0xae, 0x42, 0x13, 0xd9, 0xfe, 0x63, 0xf2, 0xe5, 0xe5, 0xf5, 0x19,
-	0x0d, 0x50, 0xd8, 0xfb, 0x84, 0xad, 0x67, 0x58, 0x02, 0xbc, 0xf1, 0x4c, 0xfc, 0x01, 0xb4, 0x65,
-	0x35, 0xb4, 0xbe, 0x34, 0xe0, 0x4c, 0xf0, 0xac, 0xb5, 0xf7, 0x2c, 0x4a, 0xf5, 0xe5, 0x7d, 0xf5,
-	0x65, 0xe3, 0x6d, 0x5f, 0x2a, 0x4a, 0xf7, 0x89, 0x4e, 0x35, 0x78, 0x12, 0xfb, 0x93, 0x53, 0xa5,
-	0x4c, 0xee, 0xc1, 0x8a, 0xd2, 0x19, 0xd3, 0xd0, 0x0f, 0xc3, 0x68, 0x1a, 0x0e, 0xb8, 0xca, 0x2a,
-	0x2b, 0x43, 0xb9, 0x43, 0x9d, 0x83, 0x4c, 0x15, 0xb1, 0x3b, 0x50, 0x17, 0x06, 0x8b, 0xd8, 0x02,
-	0xcb, 0xd5, 0x87, 0x20, 0x61, 0xb7, 0xa1, 0x2e, 0xec, 0x96, 0xca, 0x4c, 0x81, 0x17, 0x04, 0xee,
-	0x1d, 0x58, 0xa2, 0xa4, 0x67, 0x5b, 0xef, 0xd9, 0x5b, 0xe3, 0xdc, 0x40, 0xa4, 0x45, 0xaf, 0x02,
-	0xdb, 0x17, 0xf2, 0x64, 0x1e, 0x18, 0xfe, 0xaa, 0x0a, 0x2d, 0x03, 0x8c, 0x7a, 0x89, 0x4e, 0x79,
-	0xfa, 0xc3, 0xc0, 0x1f, 0xf3, 0x94, 0xc7, 0x52, 0x86, 0x72, 0x50, 0xa4, 0xf3, 0xcf, 0x4e, 0xfa,
-	0xd1, 0x34, 0xed, 0x0f, 0xf9, 0x49, 0xcc, 0xb9, 0xdc, 0xaf, 0x73, 0x50, 0xa4, 0x43, 0x2e, 0x36,
-	0xe8, 0xc4, 0xb9, 0x4c, 0x0e, 0xaa, 0x8e, 0xff, 0xc4, 0x1c, 0xd5, 0xb2, 0xe3, 0x3f, 0x31, 0x23,
-	0x79, 0x8d, 0x5a, 0x2f, 0xd1, 0xa8, 0x9f, 0xc0, 0x9a, 0xd0, 0x9d, 0x52, 0x6b, 0xf4, 0x73, 0x8c,
-	0x35, 0x03, 0xcb, 0xee, 0x40, 0x07, 0xfb, 0xac, 0xc4, 0x22, 0x09, 0x7e, 0x2a, 0x64, 0xcb, 0xf1,
-	0x0a, 0x70, 0xa4, 0xa5, 0x98, 0xb4, 0x49, 0x2b, 0x72, 0x30, 0x0a, 0x70, 0xa2, 0xf5, 0x5f, 0xdb,
-	0xb4, 0x4d, 0x49, 0x9b, 0x83, 0xb3, 0x07, 0xb0, 0x3e, 0xe6, 0xc3, 0xc0, 0xb7, 0xab, 0xa0, 0x10,
-	0x91, 0x48, 0x04, 0x9b, 0x85, 0xc6, 0x56, 0x70, 0x16, 0x7e, 0x1a, 0x8d, 0x8f, 0x02, 0xb1, 0xa1,
-	0x89, 0xe8, 0x79, 0xcd, 0x2b, 0xc0, 0xdd, 0x05, 0x68, 0x1d, 0xa6, 0xd1, 0x44, 0x2d, 0xfd, 0x22,
-	0xb4, 0x45, 0x51, 0xe6, 0x10, 0xbe, 0x07, 0x57, 0x88, 0x57, 0x9f, 0x47, 0x93, 0x68, 0x14, 0x9d,
-	0x5c, 0x58, 0x3e, 0xf0, 0xbf, 0x77, 0x60, 0xc5, 0xc2, 0x66, 0x4e, 0x30, 0x05, 0xec, 0x54, 0xf2,
-	0x97, 0x60, 0xef, 0x65, 0x63, 0x3b, 0x10, 0x84, 0xe2, 0x6c, 0xe4, 0x85, 0xcc, 0x07, 0xdb, 0xcc,
-	0x6e, 0x33, 0xa8, 0x0f, 0x05, 0xaf, 0x77, 0x8b, 0xbc, 0x2e, 0xbf, 0x57, 0xf7, 0x1c, 0x54, 0x15,
-	0xbf, 0x27, 0x33, 0x66, 0x86, 0x72, 0xd0, 0x55, 0x3b, 0xcb, 0xc1, 0x8c, 0x99, 0xa8, 0x1e, 0x0c,
-	0x34, 0x30, 0x71, 0x7f, 0xee, 0x00, 0x64, 0xbd, 0xa3, 0x3c, 0x0b, 0xbd, 0xa5, 0x89, 0xdb, 0xb2,
-	0xc6, 0xf6, 0xf5, 0x21, 0xb4, 0xf5, 0x51, 0x79, 0xb6, 0x4b, 0xb6, 0x14, 0x0c, 0xad, 0x8a, 0x5b,
-	0xb0, 0x74, 0x32, 0x8a, 0x8e, 0xc8, 0x7a, 0xa1, 0xa4, 0xd4, 0x44, 0x66, 0x52, 0x2e, 0x0a, 0xf0,
-	0x63, 0x09, 0xcd, 0xb6, 0xd4, 0x9a, 0xb9, 0xa5, 0x96, 0x6f, 0x90, 0x7f, 0xab, 0xa2, 0xcf, 0x2b,
-	0xb3, 0x99, 0x98, 0x29, 0xe1, 0xec, 0x7e, 0x41, 0x9d, 0xcf, 0x38, 0x1e, 0x24, 0xfb, 0xfe, 0xe0,
-	0xad, 0xe1, 0xd3, 0x87, 0xb0, 0x18, 0x0b, 0x5d, 0xa9, 0x14, 0x69, 0xed, 0x0d, 0x8a, 0x74, 0x21,
-	0xb6, 0x76, 0xe3, 0xaf, 0x42, 0xc7, 0x1f, 0x9e, 0xf1, 0x38, 0x0d, 0x28, 0x9c, 0x44, 0xa6, 0x93,
-	0x18, 0xdc, 0x92, 0x01, 0x27, 0x0b, 0xe5, 0x16, 0x2c, 0xc9, 0x9c, 0x56, 0x4d, 0x29, 0xef, 0x9d,
-	0x65, 0x60, 0x24, 0x74, 0x7f, 0xa1, 0x8e, 0x46, 0xed, 0x95, 0x9d, 0x3d, 0x23, 0xe6, 0xe8, 0x2a,
-	0xb9, 0xd1, 0x7d, 0x45, 0x1e, 0x53, 0x0e, 0x55, 0xcc, 0xaa, 0x6a, 0xe4, 0x5c, 0x0d, 0xe5, 0xb1,
-	0xb2, 0x3d, 0xa5, 0xb5, 0x77, 0x99, 0x52, 0xf7, 0x4f, 0x1c, 0x98, 0xdf, 0x8d, 0x26, 0xbb, 0x32,
-	0xfb, 0x8c, 0xc4, 0x43, 0x27, 0x93, 0xab, 0xe2, 0x1b, 0xf2, 0xd2, 0x4a, 0x2d, 0x90, 0x85, 0xbc,
-	0x05, 0xf2, 0xe7, 0xe1, 0x3d, 0x8a, 0x98, 0xc6, 0xd1, 0x24, 0x8a, 0x51, 0x44, 0xfd, 0x91, 0x30,
-	0x37, 0xa2, 0x30, 0x3d, 0x55, 0x2a, 0xf4, 0x4d, 0x24, 0x14, 0xc6, 0x40, 0xef, 0x52, 0x78, 0x2e,
-	0xd2, 0x62, 0x12, 0x9a, 0xb5, 0x88, 0x70, 0x7f, 0x17, 0x9a, 0xe4, 0x4d, 0xd0, 0xb0, 0x3e, 0x82,
-	0xe6, 0x69, 0x34, 0xe9, 0x9f, 0x06, 0x61, 0xaa, 0x44, 0x7e, 0x31, 0x33, 0xf3, 0x77, 0x69, 0x42,
-	0x34, 0x81, 0xfb, 0xc7, 0x73, 0x30, 0xff, 0x34, 0x3c, 0x8b, 0x82, 0x01, 0x1d, 0xc3, 0x8e, 0xf9,
-	0x38, 0x52, 0xa9, 0xf5, 0xf8, 0x9b, 0x5d, 0x85, 0x79, 0xca, 0x25, 0x9d, 0x08, 0xa6, 0x6d, 0x8b,
-	0x74, 0x0b, 0x09, 0xa2, 0x0b, 0xa1, 0xd9, 0xb5, 0x38, 0x21, 0x54, 0x06, 0x04, 0x3d, 0xb1, 0xd8,
-	0xbc, 0xd6, 0x26, 0x4b, 0xd9, 0xd5, 0x85, 0xba, 0x71, 0x75, 0x01, 0xdb, 0x92, 0xd9, 0x72, 0x22,
-	0x9d, 0x4a, 0xb4, 0x25, 0x41, 0xe4, 0x3d, 0xc6, 0x5c, 0x44, 0xbc, 0xb5, 0x91, 0x85, 0xde, 0xa3,
-	0x09, 0x44, 0x43, 0x4c, 0x7c, 0x20, 0x68, 0xc4, 0x06, 0x60, 0x82, 0xd0, 0x14, 0xcd, 0xdf, 0xa4,
-	0x14, 0x37, 0x59, 0xf3, 0x60, 0xd4, 0xdf, 0x43, 0xae, 0xd5, 0xac, 0x18, 0x07, 0x88, 0xab, 0x7f,
-	0x79, 0xb8, 0xe1, 0x73, 0x8a, 0xb4, 0x5f, 0xe5, 0x73, 0x22, 0xc3, 0xf8, 0xa3, 0xd1, 0x91, 0x3f,
-	0x78, 0x45, 0x17, 0x69, 0xe9, 0x60, 0xb4, 0xe9, 0xd9, 0x40, 0xca, 0x79, 0xcb, 0x56, 0x95, 0x12,
-	0x53, 0x6a, 0x9e, 0x09, 0x62, 0xf7, 0xa1, 0x45, 0xbe, 0xb8, 0x5c, 0xd7, 0x45, 0x5a, 0xd7, 0x8e,
-	0xe9, 0xac, 0xd3, 0xca, 0x9a, 0x44, 0xe6, 0x11, 0xf1, 0x52, 0x21, 0x11, 0xd7, 0x1f, 0x0e, 0xe5,
-	0xc9, 0x7a, 0x47, 0xc4, 0x15, 0x34, 0x80, 0xbc, 0x7d, 0x31, 0x61, 0x82, 0x60, 0x99, 0x08, 0x2c,
-	0x18, 0xbb, 0x06, 0x0d, 0xf4, 0xf0, 0x26, 0x7e, 0x30, 0xa4, 0x5c, 0x14, 0xe1, 0x68, 0x6a, 0x18,
-	0xd6, 0xa1, 0x7e, 0xd3, 0x56, 0xb9, 0x42, 0xb3, 0x62, 0xc1, 0x70, 0x6e, 0x74, 0x79, 0x9c, 0x65,
-	0xee, 0xda, 0x40, 0xf6, 0x31, 0x9d, 0x6f, 0xa6, 0x9c, 0xd2, 0x73, 0x17, 0xef, 0xbf, 0x27, 0xc7,
-	0x2c, 0x99, 0x56, 0xfd, 0x3d, 0x44, 0x12, 0x4f, 0x50, 0xa2, 0x91, 0x26, 0x42, 0xcc, 0x6b, 0x96,
-	0x91, 0x26, 0x49, 0x29, 0xc4, 0x2c, 0x08, 0xdc, 0x4d, 0x68, 0x9b, 0x15, 0xb0, 0x06, 0xd4, 0x9e,
-	0x1d, 0xec, 0xec, 0x77, 0x2e, 0xb1, 0x16, 0xcc, 0x1f, 0xee, 0x3c, 0x7f, 0xbe, 0xb7, 0xb3, 0xdd,
-	0x71, 0x58, 0x1b, 0x1a, 0x3a, 0x95, 0xb1, 0x82, 0xa5, 0xcd, 0xad, 0xad, 0x9d, 0x83, 0xe7, 0x3b,
-	0xdb, 0x9d, 0xaa, 0xfb, 0x87, 0x15, 0x68, 0x19, 0x35, 0xbf, 0x21, 0xfe, 0x71, 0x0d, 0x80, 0x3c,
-	0x86, 0x2c, 0xa1, 0xa1, 0xe6, 0x19, 0x10, 0xd4, 0x88, 0xda, 0x97, 0xae, 0x8a, 0x1b, 0x80, 0xaa,
-	0x4c, 0x73, 0x45, 0x57, 0xed, 0xcc, 0x28, 0x7e, 0xdd, 0xb3, 0x81, 0xc8, 0x47, 0x12, 0x40, 0x59,
-	0x75, 0x42, 0xba, 0x4c, 0x10, 0xae, 0x4b, 0xcc, 0x93, 0x68, 0x74, 0xc6, 0x05, 0x89, 0xb0, 0xbf,
-	0x2c, 0x18, 0xb6, 0x25, 0xd5, 0x8b, 0x91, 0xf1, 0x5a, 0xf7, 0x6c, 0x20, 0xfb, 0xba, 0x5a, 0x97,
-	0x06, 0xad, 0xcb, 0x7a, 0x71, 0x92, 0xcd, 0x35, 0x71, 0x53, 0x60, 0x9b, 0xc3, 0xa1, 0xc4, 0x9a,
-	0xf7, 0x09, 0x63, 0xf3, 0xf2, 0xaa, 0x52, 0x10, 0x25, 0x42, 0x5a, 0x29, 0x17, 0xd2, 0x37, 0xb2,
-	0xb2, 0xbb, 0x03, 0xad, 0x03, 0xe3, 0x3a, 0x2c, 0xe9, 0x2b, 0x75, 0x11, 0x56, 0xea, 0x39, 0x03,
-	0x62, 0x74, 0xa7, 0x62, 0x76, 0xc7, 0xfd, 0x43, 0x47, 0xdc, 0x30, 0xd2, 0xdd, 0x17, 0x6d, 0xbb,
-	0xd0, 0xd6, 0x31, 0xda, 0x2c, 0x99, 0xdb, 0x82, 0x21, 0x0d, 0x75, 0xa5, 0x1f, 0x1d, 0x1f, 0x27,
-	0x5c, 0xa5, 0x5e, 0x5a, 0x30, 0x65, 0x28, 0xa2, 0xe9, 0x19, 0x88, 0x16, 0x12, 0x99, 0x82, 0x59,
-	0x80, 0x23, 0x93, 0xc8, 0x50, 0x9f, 0x4a, 0x3a, 0xd5, 0x65, 0x9d, 0x73, 0x9e, 0x9f, 0xe5, 0x3b,
-	0xd0, 0xd0, 0xf5, 0xda, 0x3b, 0x82, 0xa2, 0xd4, 0x78, 0xdc, 0x79, 0xc8, 0x81, 0xb4, 0x3a, 0x2d,
-	0x78, 0xb5, 0x88, 0x60, 0x1b, 0xc0, 0x8e, 0x83, 0x38, 0x4f, 0x2e, 0x98, 0xb7, 0x04, 0xe3, 0xbe,
-	0x84, 0x15, 0x25, 0x6f, 0x86, 0x05, 0x6b, 0x2f, 0xa2, 0xf3, 0x36, 0x7d, 0x54, 0x29, 0xea, 0x23,
-	0xf7, 0x4f, 0xab, 0x30, 0x2f, 0x57, 0xba, 0x70, 0xa5, 0x5a, 0xac, 0xb3, 0x05, 0x63, 0x5d, 0xeb,
-	0xf2, 0x1c, 0x29, 0x2f, 0xb9, 0x0b, 0x15, 0xf6, 0x99, 0x6a, 0xd9, 0x3e, 0xc3, 0xa0, 0x36, 0xf1,
-	0xd3, 0x53, 0x0a, 0xb1, 0x34, 0x3d, 0xfa, 0xad, 0xa2, 0x91, 0x75, 0x3b, 0x1a, 0x59, 0x76, 0x81,
-	0x5c, 0x98, 0x50, 0xc5, 0x0b, 0xe4, 0x57, 0xa1, 0x29, 0x2e, 0x1d, 0x67, 0x01, 0xc7, 0x0c, 0x80,
-	0xdc, 0x2b, 0x0a, 0xa4, 0x21, 0xe4, 0x5d, 0x96, 0x0c, 0xf2, 0x25, 0x76, 0xb6, 0x6f, 0xc2, 0x9c,
-	0xb8, 0x4c, 0x21, 0x53, 0x6b, 0xaf, 0xaa, 0x43, 0x38, 0x41, 0xa7, 0xfe, 0x8a, 0x1c, 0x1d, 0x4f,
-	0xd2, 0x9a, 0x57, 0x31, 0x5b, 0xf6, 0x55, 0x4c, 0x33, 0x4e, 0xda, 0xb6, 0xe3, 0xa4, 0xee, 0x63,
-	0x58, 0xb0, 0xaa, 0x43, 0xcd, 0x2a, 0x53, 0x73, 0x3b, 0x97, 0xd8, 0x02, 0x34, 0x9f, 0xee, 0xf7,
-	0x1f, 0xef, 0x3d, 0x7d, 0xb2, 0xfb, 0xbc, 0xe3, 0x60, 0xf1, 0xf0, 0xc5, 0xd6, 0xd6, 0xce, 0xce,
-	0x36, 0x69, 0x5a, 0x80, 0xb9, 0xc7, 0x9b, 0x4f, 0xf7, 0x48, 0xcf, 0x6e, 0x0b, 0xde, 0x96, 0x75,
-	0xe9, 0x83, 0x8f, 0xaf, 0x03, 0x53, 0x3e, 0x3e, 0xa5, 0xe8, 0x4c, 0x46, 0x3c, 0x55, 0x59, 0xe3,
-	0xcb, 0x12, 0xf3, 0x54, 0x23, 0xd4, 0xa5, 0x87, 0xac, 0x96, 0x4c, 0x44, 0xe4, 0x24, 0xe5, 0x45,
-	0x44, 0x92, 0x7a, 0x1a, 0xef, 0xf6, 0xa0, 0xbb, 0xcd, 0xb1, 0xb6, 0xcd, 0xd1, 0x28, 0xd7, 0x1d,
-	0x74, 0xd4, 0x4a, 0x70, 0xd2, 0x8b, 0xfb, 0x2e, 0x5c, 0xde, 0x14, 0x09, 0xe2, 0xbf, 0xad, 0xfc,
-	0x41, 0xb7, 0x0b, 0x6b, 0xf9, 0x2a, 0x65, 0x63, 0x8f, 0x61, 0x79, 0x9b, 0x1f, 0x4d, 0x4f, 0xf6,
-	0xf8, 0x59, 0xd6, 0x10, 0x83, 0x5a, 0x72, 0x1a, 0x9d, 0xcb, 0xf9, 0xa1, 0xdf, 0xec, 0x7d, 0x80,
-	0x11, 0xd2, 0xf4, 0x93, 0x09, 0x1f, 0xa8, 0x0b, 0x7c, 0x04, 0x39, 0x9c, 0xf0, 0x81, 0xfb, 0x09,
-	0x30, 0xb3, 0x1e, 0x39, 0x5f, 0x68, 0x67, 0x4d, 0x8f, 0xfa, 0xc9, 0x45, 0x92, 0xf2, 0xb1, 0xba,
-	0x99, 0x68, 0x82, 0xdc, 0x5b, 0xd0, 0x3e, 0xf0, 0x2f, 0x3c, 0xfe, 0x13, 0xf9, 0xb4, 0xc0, 0x3a,
-	0xcc, 0x4f, 0xfc, 0x0b, 0x64, 0x41, 0x1d, 0xf4, 0x25, 0xb4, 0xfb, 0xbf, 0x2b, 0x30, 0x27, 0x28,
-	0xb1, 0xd6, 0x21, 0x4f, 0xd2, 0x20, 0x24, 0x49, 0x53, 0xb5, 0x1a, 0xa0, 0x82, 0x6c, 0x57, 0x4a,
-	0x64, 0x5b, 0x46, 0x24, 0xd4, 0x65, 0x28, 0x29, 0xc0, 0x16, 0x0c, 0x25, 0x2d, 0x4b, 0x04, 0x16,
-	0xa1, 0xc1, 0x0c, 0x90, 0x3b, 0x41, 0xc8, 0xac, 0x39, 0xd1, 0x3f, 0xa5, 0xb6, 0xa4, 0x18, 0x9b,
-	0xa0, 0x52, 0x9b, 0x71, 0x5e, 0x48, 0x7b, 0xc1, 0x66, 0x2c, 0xd8, 0x86, 0x8d, 0x77, 0xb0, 0x0d,
-	0x45, 0x98, 0xe2, 0x4d, 0xb6, 0x21, 0xbc, 0x83, 0x6d, 0xe8, 0x32, 0xe8, 0xd0, 0x2d, 0x6b, 0xf4,
-	0x3e, 0x14, 0xef, 0xfe, 0x7d, 0x07, 0x3a, 0x92, 0x8b, 0x34, 0x8e, 0x7d, 0x68, 0x79, 0x59, 0xa5,
-	0xd7, 0x78, 0x6e, 0xc0, 0x02, 0xf9, 0x3e, 0x5a, 0x05, 0xc8, 0x73, 0x1d, 0x0b, 0x88, 0xe3, 0x50,
-	0x69, 0x24, 0xe3, 0x60, 0x24, 0x17, 0xc5, 0x04, 0x29, 0x2d, 0x12, 0xfb, 0x32, 0xa1, 0xd5, 0xf1,
-	0x74, 0xd9, 0xfd, 0x23, 0x07, 0x96, 0x8d, 0x0e, 0x4b, 0x2e, 0x7c, 0x08, 0x6d, 0xfd, 0x98, 0x01,
-	0xd7, 0x9b, 0xdb, 0xba, 0x2d, 0x36, 0xd9, 0x67, 0x16, 0x31, 0x2d, 0xa6, 0x7f, 0x41, 0x1d, 0x4c,
-	0xa6, 0x63, 0xb9, 0xab, 0x98, 0x20, 0x64, 0xa4, 0x73, 0xce, 0x5f, 0x69, 0x12, 0xb1, 0xaf, 0x59,
-	0x30, 0x8a, 0x0f, 0xa3, 0xcf, 0xa6, 0x89, 0x6a, 0x32, 0x3e, 0x6c, 0x02, 0xdd, 0xbf, 0x52, 0x81,
-	0x15, 0xe1, 0x7c, 0xcb, 0x80, 0x87, 0xbe, 0x4f, 0x3a, 0x27, 0x62, 0x10, 0x42, 0x22, 0x77, 0x2f,
-	0x79, 0xb2, 0xcc, 0xbe, 0xf5, 0x8e, 0x01, 0x03, 0x9d, 0x65, 0x3b, 0x63, 0x2d, 0xaa, 0x65, 0x6b,
-	0xf1, 0x86, 0x99, 0x2e, 0x0b, 0xd5, 0xd7, 0xcb, 0x43, 0xf5, 0xef, 0x14, 0x1a, 0x7f, 0x34, 0x0f,
-	0xf5, 0x64, 0x10, 0x4d, 0xb8, 0xbb, 0x06, 0xab, 0xf6, 0x14, 0x48, 0x45, 0xf5, 0x73, 0x07, 0xba,
-	0x8f, 0xc5, 0xa9, 0x5b, 0x10, 0x9e, 0xec, 0x06, 0x49, 0x1a, 0xc5, 0xfa, 0x72, 0xfe, 0x35, 0x80,
-	0x24, 0xf5, 0x63, 0x69, 0xd0, 0xca, 0x30, 0x79, 0x06, 0xc1, 0x91, 0xf0, 0x70, 0x28, 0xb0, 0x62,
-	0x05, 0x75, 0xb9, 0x60, 0x7a, 0xc9, 0x20, 0x82, 0x65, 0xc0, 0xdc, 0x14, 0xb9, 0xe9, 0xd8, 0x65,
-	0x7e, 0x46, 0xda, 0x5f, 0x78, 0xe7, 0x39, 0xa8, 0xfb, 0x1f, 0x1d, 0x58, 0xca, 0x3a, 0x49, 0x39,
-	0x14, 0xb6, 0x0e, 0x91, 0x56, 0x4b, 0xa6, 0x43, 0x54, 0x00, 0x3f, 0x40, 0x33, 0x46, 0x59, 0xfb,
-	0x19, 0x84, 0xe4, 0x5a, 0x96, 0xa2, 0xa9, 0xb2, 0x0b, 0x4d, 0x90, 0xc8, 0x34, 0x45, 0x03, 0x4a,
-	0x1a, 0x83, 0xb2, 0x44, 0xb7, 0x7c, 0xc6, 0x29, 0x7d, 0x25, 0x66, 0x5c, 0x15, 0x59, 0x47, 0x58,
-	0x20, 0xe2, 0xa1, 0x12, 0xb2, 0x3e, 0xcc, 0x9d, 0xb9, 0xa1, 0x5f, 0x15, 0x11, 0x3b, 0xf3, 0xdf,
-	0x76, 0xe0, 0x4a, 0xc9, 0xc4, 0x4b, 0xd9, 0xda, 0x86, 0xe5, 0x63, 0x8d, 0x54, 0x93, 0x23, 0x04,
-	0x6c, 0x4d, 0x25, 0x01, 0xd8, 0x13, 0xe2, 0x15, 0x3f, 0xd0, 0xe6, 0xa4, 0x98, 0x6e, 0x2b, 0x97,
-	0xbb, 0x88, 0x70, 0x0f, 0xa0, 0xb7, 0xf3, 0x1a, 0x45, 0x75, 0xcb, 0x7c, 0x11, 0x4d, 0xf1, 0xc2,
-	0xfd, 0x82, 0x2a, 0x7a, 0x7b, 0xc0, 0xe7, 0x18, 0x16, 0xac, 0xba, 0xd8, 0x37, 0xde, 0xb5, 0x12,
-	0x53, 0xaa, 0xd4, 0x5a, 0x89, 0x27, 0xdd, 0x54, 0x46, 0xb9, 0x01, 0x72, 0xcf, 0x60, 0xe9, 0xb3,
-	0xe9, 0x28, 0x0d, 0xb2, 0xe7, 0xdd, 0xd8, 0xb7, 0xe4, 0x47, 0x54, 0x85, 0x9a, 0xba, 0xd2, 0xa6,
-	0x4c, 0x3a, 0x9c, 0xb1, 0x31, 0xd6, 0xd4, 0x2f, 0xb6, 0x58, 0x44, 0xb8, 0x57, 0x60, 0x3d, 0x6b,
-	0x52, 0xcc, 0x9d, 0x52, 0xe7, 0xbf, 0x70, 0x44, 0x6a, 0x94, 0xfd, 0xda, 0x1c, 0x7b, 0x02, 0x2b,
-	0x49, 0x10, 0x9e, 0x8c, 0xb8, 0x59, 0x4f, 0x22, 0x67, 0xe2, 0xb2, 0xdd, 0x3d, 0xf9, 0x22, 0x9d,
-	0x57, 0xf6, 0x05, 0x32, 0x48, 0x79, 0x47, 0x33, 0x06, 0xc9, 0x4d, 0x49, 0xd9, 0x00, 0xbe, 0x0d,
-	0x8b, 0x76, 0x63, 0xec, 0x81, 0x4c, 0x06, 0xcf, 0x7a, 0x66, 0x9e, 0xca, 0xd8, 0x9c, 0x61, 0x51,
-	0xba, 0x3f, 0x73, 0xa0, 0xeb, 0x71, 0x64, 0x63, 0x6e, 0x34, 0x2a, 0xb9, 0xe7, 0x61, 0xa1, 0xda,
-	0xd9, 0x03, 0xd6, 0x49, 0xe6, 0x6a, 0xac, 0x1b, 0x33, 0x17, 0x65, 0xf7, 0x52, 0xc9, 0xa8, 0x1e,
-	0x35, 0x60, 0x4e, 0x8e, 0x6f, 0x1d, 0x2e, 0xcb, 0x2e, 0xa9, 0xee, 0x64, 0x21, 0x7d, 0xab, 0x51,
-	0x2b, 0xa4, 0xdf, 0x83, 0xae, 0x78, 0x35, 0xc1, 0x1c, 0x87, 0xf8, 0xf0, 0xce, 0x17, 0xd0, 0x32,
-	0xde, 0x8e, 0x60, 0xeb, 0xb0, 0xf2, 0xf2, 0xe9, 0xf3, 0xfd, 0x9d, 0xc3, 0xc3, 0xfe, 0xc1, 0x8b,
-	0x47, 0xdf, 0xd9, 0xf9, 0x7e, 0x7f, 0x77, 0xf3, 0x70, 0xb7, 0x73, 0x89, 0xad, 0x01, 0xdb, 0xdf,
-	0x39, 0x7c, 0xbe, 0xb3, 0x6d, 0xc1, 0x1d, 0x76, 0x0d, 0x7a, 0x2f, 0xf6, 0x5f, 0x1c, 0xee, 0x6c,
-	0xf7, 0xcb, 0xbe, 0xab, 0xb0, 0xf7, 0xe1, 0x8a, 0xc4, 0x97, 0x7c, 0x5e, 0xbd, 0xf3, 0x10, 0x3a,
-	0x79, 0x1f, 0xdf, 0x8a, 0x88, 0xbc, 0x29, 0x74, 0x72, 0xff, 0x67, 0x55, 0x58, 0x14, 0xe9, 0x5d,
-	0xe2, 0x85, 0x43, 0x1e, 0xb3, 0xcf, 0x60, 0x5e, 0x3e, 0x95, 0xc9, 0xd4, 0x62, 0xd8, 0x8f, 0x73,
-	0xf6, 0xd6, 0xf2, 0x60, 0x39, 0x83, 0x2b, 0x7f, 0xf5, 0x4f, 0xfe, 0xdb, 0xdf, 0xad, 0x2c, 0xb0,
-	0xd6, 0xdd, 0xb3, 0x8f, 0xef, 0x9e, 0xf0, 0x30, 0xc1, 0x3a, 0xfe, 0x00, 0x20, 0x7b, 0x00, 0x92,
-	0x75, 0xb5, 0x9f, 0x9b, 0x7b, 0x1d, 0xb3, 0x77, 0xa5, 0x04, 0x23, 0xeb, 0xbd, 0x42, 0xf5, 0xae,
-	0xb8, 0x8b, 0x58, 0x6f, 0x10, 0x06, 0xa9, 0x78, 0x0c, 0xf2, 0x53, 0xe7, 0x0e, 0x1b, 0x42, 0xdb,
-	0x7c, 0x9a, 0x91, 0xa9, 0x33, 0x8d, 0x92, 0xc7, 0x25, 0x7b, 0xef, 0x95, 0xe2, 0xd4, 0xea, 0x53,
-	0x1b, 0x97, 0xdd, 0x0e, 0xb6, 0x31, 0x25, 0x8a, 0xac, 0x95, 0x91, 0x90, 0x89, 0xec, 0x05, 0x46,
-	0x76, 0xd5, 0x60, 0xd3, 0xc2, 0xfb, 0x8f, 0xbd, 0xf7, 0x67, 0x60, 0x65, 0x5b, 0xef, 0x53, 0x5b,
-	0xeb, 0x2e, 0xc3, 0xb6, 0x06, 0x44, 0xa3, 0xde, 0x7f, 0xfc, 0xd4, 0xb9, 0x73, 0xff, 0x7f, 0xdc,
-	0x84, 0xa6, 0x3e, 0xeb, 0x64, 0x3f, 0x86, 0x05, 0x2b, 0xff, 0x8e, 0xa9, 0x61, 0x94, 0xa5, 0xeb,
-	0xf5, 0xae, 0x96, 0x23, 0x65, 0xc3, 0xd7, 0xa8, 0xe1, 0x2e, 0x5b, 0xc3, 0x86, 0x65, 0x02, 0xdb,
-	0x5d, 0xca, 0x24, 0x15, 0x17, 0xd1, 0x5e, 0x19, 0xb2, 0x2f, 0x1a, 0xbb, 0x9a, 0x17, 0x47, 0xab,
-	0xb5, 0xf7, 0x67, 0x60, 0x65, 0x73, 0x57, 0xa9, 0xb9, 0x35, 0xb6, 0x6a, 0x36, 0xa7, 0xcf, 0x20,
-	0x39, 0xdd, 0xbe, 0x34, 0x1f, 0x27, 0x64, 0xef, 0x6b, 0xc6, 0x2a, 0x7b, 0xb4, 0x50, 0xb3, 0x48,
-	0xf1, 0xe5, 0x42, 0xb7, 0x4b, 0x4d, 0x31, 0x46, 0xcb, 0x67, 0xbe, 0x4d, 0xc8, 0x8e, 0xa0, 0x65,
-	0x3c, 0x63, 0xc4, 0xae, 0xcc, 0x7c, 0x72, 0xa9, 0xd7, 0x2b, 0x43, 0x95, 0x0d, 0xc5, 0xac, 0xff,
-	0x2e, 0x6e, 0xea, 0x3f, 0x84, 0xa6, 0x7e, 0x18, 0x87, 0xad, 0x1b, 0x0f, 0x15, 0x99, 0x0f, 0xf9,
-	0xf4, 0xba, 0x45, 0x44, 0x19, 0xf3, 0x99, 0xb5, 0x23, 0xf3, 0xbd, 0x84, 0x96, 0xf1, 0xf8, 0x8d,
-	0x1e, 0x40, 0xf1, 0x81, 0x1d, 0x3d, 0x80, 0x92, 0xb7, 0x72, 0xdc, 0x65, 0x6a, 0xa2, 0xc5, 0x9a,
-	0xc4, 0xdf, 0xe9, 0xeb, 0x28, 0x61, 0x7b, 0x70, 0x59, 0xea, 0xb8, 0x23, 0xfe, 0x65, 0x96, 0xa1,
-	0xe4, 0x3d, 0xc8, 0x7b, 0x0e, 0x7b, 0x08, 0x0d, 0xf5, 0xc6, 0x11, 0x5b, 0x2b, 0x7f, 0xab, 0xa9,
-	0xb7, 0x5e, 0x80, 0x4b, 0xdb, 0xe6, 0xfb, 0x00, 0xd9, 0x4b, 0x3b, 0x5a, 0x49, 0x14, 0x5e, 0xee,
-	0xd1, 0x1c, 0x50, 0x7c, 0x96, 0xc7, 0x5d, 0xa3, 0x01, 0x76, 0x18, 0x29, 0x89, 0x90, 0x9f, 0xab,
-	0x8b, 0xd6, 0x3f, 0x82, 0x96, 0xf1, 0xd8, 0x8e, 0x9e, 0xbe, 0xe2, 0x43, 0x3d, 0x7a, 0xfa, 0x4a,
-	0xde, 0xe6, 0x71, 0x7b, 0x54, 0xfb, 0xaa, 0xbb, 0x84, 0xb5, 0x27, 0xc1, 0x49, 0x38, 0x16, 0x04,
-	0xb8, 0x40, 0xa7, 0xb0, 0x60, 0xbd, 0xa8, 0xa3, 0x25, 0xb4, 0xec, 0xbd, 0x1e, 0x2d, 0xa1, 0xa5,
-	0x8f, 0xf0, 0x28, 0x3e, 0x73, 0x97, 0xb1, 0x9d, 0x33, 0x22, 0x31, 0x5a, 0xfa, 0x01, 0xb4, 0x8c,
-	0xd7, 0x71, 0xf4, 0x58, 0x8a, 0x0f, 0xf1, 0xe8, 0xb1, 0x94, 0x3d, 0xa6, 0xb3, 0x4a, 0x6d, 0x2c,
-	0xba, 0xc4, 0x0a, 0x74, 0x65, 0x18, 0xeb, 0xfe, 0x31, 0x2c, 0xda, 0xef, 0xe5, 0x68, 0xd9, 0x2f,
-	0x7d, 0x79, 0x47, 0xcb, 0xfe, 0x8c, 0x47, 0x76, 0x24, 0x4b, 0xdf, 0x59, 0xd1, 0x8d, 0xdc, 0xfd,
-	0x5c, 0x66, 0x4a, 0x7d, 0xc1, 0xbe, 0x8b, 0x0a, 0x4e, 0xde, 0xe1, 0x66, 0xeb, 0x06, 0xd7, 0x9a,
-	0x37, 0xbd, 0xb5, 0xbc, 0x14, 0xae, 0x7b, 0xdb, 0xcc, 0x2c, 0x2e, 0x3d, 0xd3, 0xae, 0x45, 0x77,
-	0xb9, 0x8d, 0x5d, 0xcb, 0xbc, 0xee, 0x6d, 0xec, 0x5a, 0xd6, 0x95, 0xef, 0xfc, 0xae, 0x95, 0x06,
-	0x58, 0x47, 0x08, 0x4b, 0xb9, 0x3b, 0x02, 0x5a, 0x2a, 0xca, 0xaf, 0x71, 0xf5, 0xae, 0xbd, 0xf9,
-	0x6a, 0x81, 0xad, 0x41, 0x94, 0x12, 0xbc, 0xab, 0x2e, 0xcd, 0xfd, 0x45, 0x68, 0x9b, 0x6f, 0x7f,
-	0x30, 0x53, 0x94, 0xf3, 0x2d, 0xbd, 0x57, 0x8a, 0xb3, 0x17, 0x97, 0xb5, 0xcd, 0x66, 0xd8, 0xf7,
-	0x60, 0x4d, 0x8b, 0xba, 0x99, 0x76, 0x9e, 0xb0, 0x0f, 0x4a, 0x92, 0xd1, 0x4d, 0xcb, 0xa7, 0x77,
-	0x65, 0x66, 0xb6, 0xfa, 0x3d, 0x07, 0x99, 0xc6, 0x7e, 0x54, 0x21, 0xdb, 0x30, 0xca, 0xde, 0x92,
-	0xc8, 0x36, 0x8c, 0xd2, 0x97, 0x18, 0x14, 0xd3, 0xb0, 0x15, 0x6b, 0x8e, 0xc4, 0x21, 0x33, 0xfb,
-	0x01, 0x2c, 0x19, 0x17, 0x7b, 0x0e, 0x2f, 0xc2, 0x81, 0x16, 0x80, 0xe2, 0x9d, 0xd3, 0x5e, 0x99,
-	0x5d, 0xef, 0xae, 0x53, 0xfd, 0xcb, 0xae, 0x35, 0x39, 0xc8, 0xfc, 0x5b, 0xd0, 0x32, 0x2f, 0x0d,
-	0xbd, 0xa1, 0xde, 0x75, 0x03, 0x65, 0x5e, 0x99, 0xbc, 0xe7, 0xb0, 0x03, 0x91, 0x60, 0xa4, 0x1f,
-	0x69, 0x8c, 0xe2, 0xfc, 0xf6, 0x69, 0x3f, 0xde, 0xa8, 0x17, 0xb2, 0xec, 0xd9, 0xce, 0xdb, 0xce,
-	0x3d, 0x87, 0xfd, 0x03, 0x07, 0xda, 0xd6, 0xa5, 0x1e, 0x2b, 0x65, 0x23, 0xd7, 0xb3, 0xae, 0x89,
-	0x33, 0xbb, 0xe6, 0x7a, 0x34, 0xec, 0xbd, 0x3b, 0xdf, 0xb6, 0xa6, 0xf5, 0x73, 0x2b, 0x68, 0xb4,
-	0x91, 0x7f, 0xa9, 0xf1, 0x8b, 0x3c, 0x81, 0x79, 0xd3, 0xf7, 0x8b, 0x7b, 0x0e, 0xfb, 0xa5, 0x03,
-	0x8b, 0x76, 0xa8, 0x53, 0x0f, 0xb7, 0x34, 0xa8, 0xaa, 0x17, 0x7f, 0x46, 0x7c, 0xf4, 0x07, 0xd4,
-	0xcb, 0xe7, 0x77, 0x3c, 0xab, 0x97, 0xf2, 0x01, 0x8f, 0xdf, 0xac, 0xb7, 0xec, 0x53, 0xf1, 0xac,
-	0xb0, 0x3a, 0x90, 0x60, 0xc5, 0x07, 0x6e, 0x35, 0xc3, 0x98, 0x4f, 0xd2, 0xd2, 0x22, 0xfc, 0x48,
-	0xbc, 0x50, 0xa8, 0x62, 0xe6, 0xc8, 0x77, 0xef, 0xfa, 0xbd, 0x7b, 0x83, 0xc6, 0x74, 0xcd, 0xbd,
-	0x62, 0x8d, 0x29, 0xbf, 0xc3, 0x6f, 0x8a, 0xde, 0xc9, 0xd7, 0x64, 0xb3, 0x2d, 0xaa, 0xf0, 0xc2,
-	0xec, 0xec, 0x4e, 0x8e, 0x45, 0x27, 0x25, 0xb9, 0x25, 0x1c, 0xef, 0x58, 0x8d, 0x7b, 0x87, 0xfa,
-	0x7a, 0xc3, 0xfd, 0x60, 0x66, 0x5f, 0xef, 0x52, 0xc0, 0x12, 0x7b, 0x7c, 0x00, 0x90, 0x1d, 0x1e,
-	0xb2, 0xdc, 0xe1, 0x95, 0x56, 0x19, 0xc5, 0xf3, 0x45, 0x5b, 0x02, 0xd5, 0x19, 0x17, 0xd6, 0xf8,
-	0x43, 0xa1, 0x00, 0x9f, 0xaa, 0x63, 0x2f, 0xd3, 0xcc, 0xb1, 0x4f, 0xf9, 0x2c, 0x33, 0x27, 0x5f,
-	0xbf, 0xa5, 0xfe, 0xf4, 0x19, 0xda, 0x0b, 0x58, 0xd8, 0x8b, 0xa2, 0x57, 0xd3, 0x89, 0xce, 0xac,
-	0xb0, 0xcf, 0x12, 0x76, 0xfd, 0xe4, 0xb4, 0x97, 0x1b, 0x85, 0x7b, 0x9d, 0xaa, 0xea, 0xb1, 0xae,
-	0x51, 0xd5, 0xdd, 0xcf, 0xb3, 0xc3, 0xc9, 0x2f, 0x98, 0x0f, 0xcb, 0x5a, 0xab, 0xea, 0x8e, 0xf7,
-	0xec, 0x6a, 0x2c, 0x5d, 0x9a, 0x6f, 0xc2, 0xb2, 0xc7, 0x55, 0x6f, 0xef, 0x26, 0xaa, 0x4e, 0xd2,
-	0x29, 0xed, 0x6d, 0x3e, 0xa0, 0x2b, 0x0b, 0x14, 0x90, 0x5f, 0xc9, 0x3a, 0xae, 0x23, 0xf9, 0xbd,
-	0x05, 0x0b, 0x68, 0xef, 0x34, 0x13, 0xff, 0x22, 0xe6, 0x3f, 0xb9, 0xfb, 0xb9, 0x0c, 0xf5, 0x7f,
-	0xa1, 0x76, 0x1a, 0x75, 0x16, 0x62, 0xed, 0x34, 0xb9, 0xc3, 0x13, 0x6b, 0xa7, 0x29, 0x1c, 0x9e,
-	0x58, 0x53, 0xad, 0xce, 0x62, 0xd8, 0x08, 0x96, 0x0b, 0xe7, 0x2d, 0x7a, 0x93, 0x99, 0x75, 0x4a,
-	0xd3, 0xbb, 0x3e, 0x9b, 0xc0, 0x6e, 0xed, 0x8e, 0xdd, 0xda, 0x21, 0x2c, 0x6c, 0x73, 0x31, 0x59,
-	0x22, 0x75, 0x34, 0x77, 0x33, 0xcc, 0x4c, 0x4c, 0xcd, 0x6f, 0x09, 0x84, 0xb3, 0x4d, 0x09, 0xca,
-	0xdb, 0x64, 0x3f, 0x84, 0xd6, 0x13, 0x9e, 0xaa, 0x5c, 0x51, 0x6d, 0xcc, 0xe6, 0x92, 0x47, 0x7b,
-	0x25, 0xa9, 0xa6, 0x36, 0xcf, 0x50, 0x6d, 0x77, 0xf9, 0xf0, 0x84, 0x0b, 0xe5, 0xd4, 0x0f, 0x86,
-	0x5f, 0xb0, 0xbf, 0x40, 0x95, 0xeb, 0x44, 0xf9, 0x35, 0x23, 0xf9, 0xcf, 0xac, 0x7c, 0x29, 0x07,
-	0x2f, 0xab, 0x39, 0x8c, 0x86, 0xdc, 0x30, 0xaa, 0x42, 0x68, 0x19, 0x17, 0x4a, 0xb4, 0x00, 0x15,
-	0xef, 0x26, 0x69, 0x01, 0x2a, 0xb9, 0x7f, 0xe2, 0xde, 0xa6, 0x76, 0x5c, 0x76, 0x3d, 0x6b, 0x47,
-	0xdc, 0x39, 0xc9, 0x5a, 0xba, 0xfb, 0xb9, 0x3f, 0x4e, 0xbf, 0x60, 0x2f, 0xe9, 0x41, 0x1d, 0x33,
-	0x1f, 0x36, 0xb3, 0xce, 0xf3, 0xa9, 0xb3, 0x7a, 0xb2, 0x0c, 0x94, 0x6d, 0xb1, 0x8b, 0xa6, 0xc8,
-	0xf6, 0xfa, 0x16, 0xc0, 0x61, 0x1a, 0x4d, 0xb6, 0x7d, 0x3e, 0x8e, 0xc2, 0x4c, 0xd7, 0x66, 0xd9,
-	0x98, 0x99, 0xfe, 0x32, 0x52, 0x32, 0xd9, 0x4b, 0xc3, 0x9d, 0xb1, 0xd2, 0x89, 0x15, 0x73, 0xcd,
-	0x4c, 0xd8, 0xd4, 0x13, 0x52, 0x92, 0xb4, 0x79, 0xcf, 0x61, 0x9b, 0x00, 0xd9, 0x81, 0x9b, 0x76,
-	0x4e, 0x0a, 0x67, 0x79, 0x5a, 0xed, 0x95, 0x9c, 0xce, 0x1d, 0x40, 0x33, 0x3b, 0xc1, 0x59, 0xcf,
-	0xae, 0x6c, 0x59, 0xe7, 0x3d, 0x7a, 0x07, 0x2f, 0x9c, 0xab, 0xb8, 0x1d, 0x9a, 0x2a, 0x60, 0x0d,
-	0x9c, 0x2a, 0x3a, 0x2c, 0x09, 0x60, 0x45, 0x74, 0x50, 0x1b, 0x38, 0x94, 0x49, 0xa8, 0x46, 0x52,
-	0x72, 0xb6, 0xa1, 0xa5, 0xb9, 0x34, 0xe8, 0x6f, 0xc5, 0x58, 0x90, 0x5b, 0x45, 0x16, 0x23, 0xaa,
-	0xe6, 0x31, 0x2c, 0x17, 0xa2, 0xd2, 0x5a, 0xa4, 0x67, 0x1d, 0x14, 0x68, 0x91, 0x9e, 0x19, 0xd0,
-	0x76, 0x2f, 0x53, 0x93, 0x4b, 0x2e, 0x90, 0x4f, 0x75, 0x1e, 0xa4, 0x83, 0x53, 0x6c, 0xee, 0x17,
-	0x0e, 0xac, 0x94, 0x04, 0x9d, 0xd9, 0x87, 0xca, 0x3d, 0x9f, 0x19, 0x90, 0xee, 0x95, 0xc6, 0x24,
-	0xdd, 0x43, 0x6a, 0xe7, 0x33, 0xf6, 0x1d, 0x6b, 0x63, 0x13, 0xe1, 0x40, 0x29, 0x99, 0x6f, 0x34,
-	0x2a, 0x4a, 0x2d, 0x8a, 0x9f, 0xc0, 0xba, 0xe8, 0xc8, 0xe6, 0x68, 0x94, 0x8b, 0x97, 0x5e, 0x2b,
-	0xfc, 0x67, 0x11, 0x2b, 0x0e, 0xdc, 0x9b, 0xfd, 0x9f, 0x47, 0x66, 0x18, 0xc0, 0xa2, 0xab, 0x6c,
-	0x0a, 0x9d, 0x7c, 0x0c, 0x92, 0xcd, 0xae, 0xab, 0xf7, 0x81, 0xe5, 0x68, 0x16, 0xe3, 0x96, 0xee,
-	0xef, 0x50, 0x63, 0x1f, 0xb8, 0xbd, 0xb2, 0x79, 0x11, 0xbe, 0x27, 0xae, 0xc7, 0x5f, 0xd6, 0x01,
-	0xd3, 0xdc, 0x38, 0x55, 0x03, 0xb3, 0x22, 0xbc, 0xda, 0xd5, 0x2d, 0x8f, 0xb7, 0xde, 0xa4, 0xe6,
-	0xaf, 0xbb, 0xef, 0x95, 0x35, 0x1f, 0x8b, 0x4f, 0x84, 0xd3, 0xbb, 0x9e, 0x97, 0x6b, 0xd5, 0x83,
-	0xeb, 0x65, 0xeb, 0x3d, 0xd3, 0x7b, 0xc9, 0xcd, 0xf5, 0xa5, 0x7b, 0xce, 0xa3, 0x5b, 0x3f, 0xf8,
-	0x9d, 0x93, 0x20, 0x3d, 0x9d, 0x1e, 0x6d, 0x0c, 0xa2, 0xf1, 0xdd, 0x91, 0x0a, 0xba, 0xc9, 0xbc,
-	0xf7, 0xbb, 0xa3, 0x70, 0x78, 0x97, 0xbe, 0x3f, 0x9a, 0xa3, 0x7f, 0x54, 0xf4, 0x8d, 0xff, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0x8c, 0x9e, 0x6c, 0x70, 0xda, 0x68, 0x00, 0x00,
+	// 9549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7d, 0x6f, 0x6c, 0x1c, 0x49,
+	0x76, 0x9f, 0x7a, 0x66, 0x48, 0xce, 0xbc, 0x19, 0x0e, 0x87, 0x45, 0x89, 0x1c, 0xb5, 0xfe, 0xf1,
+	0xda, 0xf2, 0xae, 0xac, 0xdb, 0x25, 0xb5, 0xda, 0xbd, 0xcd, 0x7a, 0xe5, 0x8b, 0x8f, 0x22, 0x29,
+	0x51, 0xb7, 0x14, 0xc5, 0x6b, 0x4a, 0xa7, 0xec, 0xde, 0x05, 0xb3, 0xcd, 0x99, 0x22, 0xd9, 0xab,
+	0x99, 0xee, 0xb9, 0xee, 0x1e, 0x4a, 0xdc, 0xcd, 0x06, 0x70, 0x90, 0x04, 0x41, 0x80, 0x00, 0x39,
+	0x24, 0x31, 0xe2, 0x20, 0x81, 0x01, 0xdb, 0x1f, 0x62, 0xe4, 0x43, 0xfc, 0x25, 0x81, 0x03, 0x18,
+	0xf0, 0x47, 0x7f, 0x4a, 0x82, 0xc0, 0xdf, 0x12, 0x20, 0x40, 0xe0, 0x04, 0x81, 0x91, 0xcf, 0xf9,
+	0x18, 0x20, 0x78, 0xaf, 0xaa, 0xba, 0xab, 0xba, 0x7b, 0x24, 0xee, 0xed, 0x25, 0x5f, 0xa4, 0xa9,
+	0xdf, 0xab, 0xae, 0xbf, 0xaf, 0x5e, 0xbd, 0x7a, 0xef, 0x55, 0x11, 0x1a, 0xd1, 0xb8, 0xbf, 0x36,
+	0x8e, 0xc2, 0x24, 0x64, 0x33, 0xc3, 0x20, 0x1a, 0xf7, 0xed, 0xab, 0xc7, 0x61, 0x78, 0x3c, 0xe4,
+	0xeb, 0xde, 0xd8, 0x5f, 0xf7, 0x82, 0x20, 0x4c, 0xbc, 0xc4, 0x0f, 0x83, 0x58, 0x64, 0x72, 0x3e,
+	0x87, 0xf6, 0x43, 0x1e, 0x1c, 0x70, 0x3e, 0x70, 0xf9, 0xcf, 0x26, 0x3c, 0x4e, 0xd8, 0x77, 0x61,
+	0xd1, 0xe3, 0x5f, 0x72, 0x3e, 0xe8, 0x8d, 0xbd, 0x38, 0x1e, 0x9f, 0x44, 0x5e, 0xcc, 0xbb, 0xd6,
+	0xaa, 0x75, 0xab, 0xe5, 0x76, 0x04, 0x61, 0x3f, 0xc5, 0xd9, 0x77, 0xa0, 0x15, 0x63, 0x56, 0x1e,
+	0x24, 0x51, 0x38, 0x3e, 0xeb, 0x56, 0x28, 0x5f, 0x13, 0xb1, 0x6d, 0x01, 0x39, 0x43, 0x58, 0x48,
+	0x6b, 0x88, 0xc7, 0x61, 0x10, 0x73, 0x76, 0x07, 0x2e, 0xf6, 0xfd, 0xf1, 0x09, 0x8f, 0x7a, 0xf4,
+	0xf1, 0x28, 0xe0, 0xa3, 0x30, 0xf0, 0xfb, 0x5d, 0x6b, 0xb5, 0x7a, 0xab, 0xe1, 0x32, 0x41, 0xc3,
+	0x2f, 0x1e, 0x4b, 0x0a, 0x7b, 0x1b, 0x16, 0x78, 0x20, 0x70, 0x3e, 0xa0, 0xaf, 0x64, 0x55, 0xed,
+	0x0c, 0xc6, 0x0f, 0x9c, 0xbf, 0x57, 0x81, 0xc5, 0x47, 0x81, 0x9f, 0x3c, 0xf7, 0x86, 0x43, 0x9e,
+	0xa8, 0x3e, 0xbd, 0x0d, 0x0b, 0x2f, 0x09, 0xa0, 0x3e, 0xbd, 0x0c, 0xa3, 0x81, 0xec, 0x51, 0x5b,
+	0xc0, 0xfb, 0x12, 0x9d, 0xda, 0xb2, 0xca, 0xd4, 0x96, 0x95, 0x0e, 0x57, 0x75, 0xca, 0x70, 0xbd,
+	0x0d, 0x0b, 0x11, 0xef, 0x87, 0xa7, 0x3c, 0x3a, 0xeb, 0xbd, 0xf4, 0x83, 0x41, 0xf8, 0xb2, 0x5b,
+	0x5b, 0xb5, 0x6e, 0xcd, 0xb8, 0x6d, 0x05, 0x3f, 0x27, 0x94, 0xdd, 0x87, 0x85, 0xfe, 0x89, 0x17,
+	0x04, 0x7c, 0xd8, 0x3b, 0xf4, 0xfa, 0x2f, 0x26, 0xe3, 0xb8, 0x3b, 0xb3, 0x6a, 0xdd, 0x6a, 0xde,
+	0xbd, 0xbc, 0x46, 0xb3, 0xba, 0xb6, 0x79, 0xe2, 0x05, 0xf7, 0x89, 0x72, 0x10, 0x78, 0xe3, 0xf8,
+	0x24, 0x4c, 0xdc, 0xb6, 0xfc, 0x42, 0xc0, 0xb1, 0x73, 0x11, 0x98, 0x3e, 0x12, 0x62, 0xec, 0x9d,
+	0x7f, 0x65, 0xc1, 0xd2, 0xb3, 0x60, 0x18, 0xf6, 0x5f, 0xfc, 0x82, 0x43, 0x54, 0xd2, 0x87, 0xca,
+	0x79, 0xfb, 0x50, 0xfd, 0xa6, 0x7d, 0x58, 0x86, 0x8b, 0x66, 0x63, 0x65, 0x2f, 0x38, 0x5c, 0xc2,
+	0xaf, 0x8f, 0xb9, 0x6a, 0x96, 0xea, 0xc6, 0xaf, 0x41, 0xa7, 0x3f, 0x89, 0x22, 0x1e, 0x14, 0xfa,
+	0xb1, 0x20, 0xf1, 0xb4, 0x23, 0xdf, 0x81, 0x56, 0xc0, 0x5f, 0x66, 0xd9, 0x24, 0xef, 0x06, 0xfc,
+	0xa5, 0xca, 0xe2, 0x74, 0x61, 0x39, 0x5f, 0x8d, 0x6c, 0xc0, 0x7f, 0xb3, 0xa0, 0xf6, 0x2c, 0x79,
+	0x15, 0xb2, 0x35, 0xa8, 0x25, 0x67, 0x63, 0xb1, 0x42, 0xda, 0x77, 0x99, 0xec, 0xda, 0xc6, 0x60,
+	0x10, 0xf1, 0x38, 0x7e, 0x7a, 0x36, 0xe6, 0x6e, 0xcb, 0x13, 0x89, 0x1e, 0xe6, 0x63, 0x5d, 0x98,
+	0x93, 0x69, 0xaa, 0xb0, 0xe1, 0xaa, 0x24, 0xbb, 0x0e, 0xe0, 0x8d, 0xc2, 0x49, 0x90, 0xf4, 0x62,
+	0x2f, 0xa1, 0xa1, 0xaa, 0xba, 0x1a, 0xc2, 0xae, 0x42, 0x63, 0xfc, 0xa2, 0x17, 0xf7, 0x23, 0x7f,
+	0x9c, 0x10, 0xdb, 0x34, 0xdc, 0x0c, 0x60, 0xdf, 0x85, 0x7a, 0x38, 0x49, 0xc6, 0xa1, 0x1f, 0x24,
+	0x92, 0x55, 0x16, 0x64, 0x5b, 0x9e, 0x4c, 0x92, 0x7d, 0x84, 0xdd, 0x34, 0x03, 0xbb, 0x09, 0xf3,
+	0xfd, 0x30, 0x38, 0xf2, 0xa3, 0x91, 0x10, 0x06, 0xdd, 0x59, 0xaa, 0xcd, 0x04, 0x9d, 0x7f, 0x57,
+	0x81, 0xe6, 0xd3, 0xc8, 0x0b, 0x62, 0xaf, 0x8f, 0x00, 0x36, 0x3d, 0x79, 0xd5, 0x3b, 0xf1, 0xe2,
+	0x13, 0xea, 0x6d, 0xc3, 0x55, 0x49, 0xb6, 0x0c, 0xb3, 0xa2, 0xa1, 0xd4, 0xa7, 0xaa, 0x2b, 0x53,
+	0xec, 0x1d, 0x58, 0x0c, 0x26, 0xa3, 0x9e, 0x59, 0x57, 0x95, 0xb8, 0xa5, 0x48, 0xc0, 0x01, 0x38,
+	0xc4, 0xb9, 0x16, 0x55, 0x88, 0x1e, 0x6a, 0x08, 0x73, 0xa0, 0x25, 0x53, 0xdc, 0x3f, 0x3e, 0x11,
+	0xdd, 0x9c, 0x71, 0x0d, 0x0c, 0xcb, 0x48, 0xfc, 0x11, 0xef, 0xc5, 0x89, 0x37, 0x1a, 0xcb, 0x6e,
+	0x69, 0x08, 0xd1, 0xc3, 0xc4, 0x1b, 0xf6, 0x8e, 0x38, 0x8f, 0xbb, 0x73, 0x92, 0x9e, 0x22, 0xec,
+	0x2d, 0x68, 0x0f, 0x78, 0x9c, 0xf4, 0xe4, 0xa4, 0xf0, 0xb8, 0x5b, 0xa7, 0xa5, 0x9f, 0x43, 0xb1,
+	0x9c, 0xc8, 0x7b, 0xd9, 0xc3, 0x01, 0xe0, 0xaf, 0xba, 0x0d, 0xd1, 0xd6, 0x0c, 0x41, 0xce, 0x79,
+	0xc8, 0x13, 0x6d, 0xf4, 0x62, 0xc9, 0xa1, 0xce, 0x2e, 0x30, 0x0d, 0xde, 0xe2, 0x89, 0xe7, 0x0f,
+	0x63, 0xf6, 0x21, 0xb4, 0x12, 0x2d, 0x33, 0x89, 0xc2, 0x66, 0xca, 0x4e, 0xda, 0x07, 0xae, 0x91,
+	0xcf, 0x79, 0x08, 0xf5, 0x07, 0x9c, 0xef, 0xfa, 0x23, 0x3f, 0x61, 0xcb, 0x30, 0x73, 0xe4, 0xbf,
+	0xe2, 0x82, 0xe1, 0xab, 0x3b, 0x17, 0x5c, 0x91, 0x64, 0x36, 0xcc, 0x8d, 0x79, 0xd4, 0xe7, 0x6a,
+	0x7a, 0x76, 0x2e, 0xb8, 0x0a, 0xb8, 0x3f, 0x07, 0x33, 0x43, 0xfc, 0xd8, 0xf9, 0x27, 0x33, 0xd0,
+	0x3c, 0xe0, 0x41, 0xba, 0x90, 0x18, 0xd4, 0xb0, 0xcb, 0x72, 0xf1, 0xd0, 0x6f, 0x76, 0x03, 0x9a,
+	0xf8, 0x7f, 0x2f, 0x4e, 0x22, 0x3f, 0x38, 0x96, 0xfc, 0x0b, 0x08, 0x1d, 0x10, 0xc2, 0x3a, 0x50,
+	0xf5, 0x46, 0x8a, 0x77, 0xf1, 0x27, 0x2e, 0xb2, 0xb1, 0x77, 0x36, 0xc2, 0xf5, 0x98, 0xce, 0x6a,
+	0xcb, 0x6d, 0x4a, 0x6c, 0x07, 0xa7, 0x75, 0x0d, 0x96, 0xf4, 0x2c, 0xaa, 0xf4, 0x19, 0x2a, 0x7d,
+	0x51, 0xcb, 0x29, 0x2b, 0x79, 0x1b, 0x16, 0x54, 0xfe, 0x48, 0x34, 0x96, 0xe6, 0xb9, 0xe1, 0xb6,
+	0x25, 0xac, 0xba, 0x70, 0x0b, 0x3a, 0x47, 0x7e, 0xe0, 0x0d, 0x7b, 0xfd, 0x61, 0x72, 0xda, 0x1b,
+	0xf0, 0x61, 0xe2, 0xd1, 0x8c, 0xcf, 0xb8, 0x6d, 0xc2, 0x37, 0x87, 0xc9, 0xe9, 0x16, 0xa2, 0xec,
+	0x1d, 0x68, 0x1c, 0x71, 0xde, 0xa3, 0x91, 0xe8, 0xd6, 0x8d, 0xd5, 0xa3, 0x46, 0xd7, 0xad, 0x1f,
+	0xc9, 0x5f, 0x58, 0x6e, 0x38, 0x49, 0x8e, 0x43, 0x3f, 0x38, 0xee, 0xa1, 0xbc, 0xea, 0xf9, 0x03,
+	0xe2, 0x80, 0x9a, 0xdb, 0x56, 0x38, 0x4a, 0x8d, 0x47, 0x03, 0x76, 0x0d, 0x80, 0xea, 0x16, 0x05,
+	0xc3, 0xaa, 0x75, 0x6b, 0xde, 0x6d, 0x20, 0x22, 0x0a, 0xfa, 0x14, 0x96, 0x68, 0x3c, 0xfb, 0x93,
+	0x38, 0x09, 0x47, 0x3d, 0x94, 0x9f, 0xd1, 0x20, 0xee, 0x36, 0x69, 0xee, 0x7f, 0x4d, 0x36, 0x40,
+	0x9b, 0x94, 0xb5, 0x2d, 0x1e, 0x27, 0x9b, 0x94, 0xd9, 0x15, 0x79, 0x71, 0x93, 0x3d, 0x73, 0x17,
+	0x07, 0x79, 0x9c, 0xbd, 0x05, 0x0b, 0x43, 0x2f, 0x4e, 0x7a, 0x27, 0xe1, 0xb8, 0x37, 0x9e, 0x1c,
+	0xbe, 0xe0, 0x67, 0xdd, 0x16, 0x0d, 0xfd, 0x3c, 0xc2, 0x3b, 0xe1, 0x78, 0x9f, 0x40, 0xf6, 0x2b,
+	0x30, 0xef, 0x1f, 0x07, 0x21, 0xee, 0xaa, 0x41, 0x38, 0xe0, 0x71, 0x77, 0x7e, 0xb5, 0x7a, 0xab,
+	0xe5, 0xb6, 0x24, 0xb8, 0x87, 0x18, 0xfb, 0x20, 0xcb, 0x34, 0xf6, 0xfc, 0x28, 0xee, 0xb6, 0x57,
+	0xab, 0xda, 0x10, 0x61, 0xa6, 0x7d, 0xcf, 0x8f, 0xd2, 0xaf, 0x30, 0x11, 0xdb, 0x5b, 0xb0, 0x5c,
+	0xde, 0x5e, 0x64, 0x13, 0x6c, 0x90, 0x45, 0x63, 0x86, 0x3f, 0xd9, 0x45, 0x98, 0x39, 0xf5, 0x86,
+	0x13, 0x2e, 0x85, 0xb0, 0x48, 0x7c, 0x5c, 0xf9, 0xc8, 0x72, 0xfe, 0xd8, 0x82, 0x96, 0x18, 0x02,
+	0xa9, 0x3c, 0xdc, 0x84, 0x79, 0x35, 0xfd, 0x3c, 0x8a, 0xc2, 0x48, 0xca, 0x22, 0x13, 0x64, 0xb7,
+	0xa1, 0xa3, 0x80, 0x71, 0xc4, 0xfd, 0x91, 0x77, 0xac, 0xca, 0x2e, 0xe0, 0xec, 0x6e, 0x56, 0x62,
+	0x14, 0x4e, 0x12, 0x2e, 0xb7, 0xa9, 0x96, 0xec, 0x9e, 0x8b, 0x98, 0x6b, 0x66, 0x41, 0x59, 0x54,
+	0xc2, 0xd7, 0x06, 0xe6, 0xfc, 0xdc, 0x02, 0x86, 0x4d, 0x7f, 0x1a, 0x8a, 0x22, 0x24, 0x5b, 0xe6,
+	0x97, 0x84, 0x75, 0xee, 0x25, 0x51, 0x99, 0xb6, 0x24, 0x1c, 0x98, 0x11, 0x2d, 0xaf, 0x95, 0xb4,
+	0x5c, 0x90, 0x7e, 0x58, 0xab, 0x57, 0x3b, 0x35, 0xe7, 0x3f, 0x57, 0xe1, 0xe2, 0xa6, 0xd8, 0x63,
+	0x37, 0xfa, 0x7d, 0x3e, 0x4e, 0x17, 0xcb, 0x0d, 0x68, 0x22, 0x03, 0x28, 0x66, 0x11, 0x8d, 0x02,
+	0x84, 0x24, 0xa7, 0x20, 0x2f, 0x9f, 0x78, 0x7e, 0x20, 0x1a, 0x2d, 0xc6, 0xb2, 0x41, 0x08, 0x35,
+	0xf9, 0x2d, 0x58, 0x18, 0xf3, 0x60, 0xa0, 0xaf, 0x09, 0xa1, 0x05, 0xcd, 0x4b, 0x58, 0x2e, 0x89,
+	0x1b, 0xd0, 0x3c, 0x9a, 0x88, 0x7c, 0x28, 0x2a, 0x6a, 0xc4, 0x03, 0x20, 0xa1, 0x8d, 0x51, 0xc2,
+	0x2e, 0x43, 0x7d, 0x3c, 0x89, 0x4f, 0x88, 0x3a, 0x43, 0xd4, 0x39, 0x4c, 0x23, 0xe9, 0x1a, 0xc0,
+	0x60, 0x12, 0x27, 0x72, 0x39, 0xcd, 0x12, 0xb1, 0x81, 0x88, 0x58, 0x4e, 0xef, 0xc2, 0xd2, 0xc8,
+	0x7b, 0xd5, 0x23, 0xde, 0xe9, 0xf9, 0x41, 0xef, 0x68, 0x48, 0xdb, 0xc4, 0x1c, 0xe5, 0xeb, 0x8c,
+	0xbc, 0x57, 0x3f, 0x46, 0xca, 0xa3, 0xe0, 0x01, 0xe1, 0x28, 0x47, 0x94, 0x7e, 0x12, 0xf1, 0x98,
+	0x47, 0xa7, 0x9c, 0x96, 0x7e, 0x2d, 0x55, 0x42, 0x5c, 0x81, 0x62, 0x8b, 0x46, 0xd8, 0xef, 0x64,
+	0xd8, 0x97, 0xeb, 0x7c, 0x6e, 0xe4, 0x07, 0x3b, 0xc9, 0xb0, 0xcf, 0xae, 0x02, 0xa0, 0xe0, 0x18,
+	0xf3, 0xa8, 0xf7, 0xe2, 0x25, 0x2d, 0xf0, 0x1a, 0x09, 0x8a, 0x7d, 0x1e, 0x7d, 0xf2, 0x92, 0x5d,
+	0x81, 0x46, 0x3f, 0x26, 0xc9, 0xe3, 0x9d, 0x75, 0x9b, 0xb4, 0xfa, 0xeb, 0xfd, 0x18, 0x65, 0x8e,
+	0x77, 0xc6, 0xde, 0x01, 0x86, 0xad, 0xf5, 0x68, 0x16, 0xf8, 0x80, 0x8a, 0x8f, 0x69, 0x91, 0xce,
+	0x53, 0x63, 0x37, 0x24, 0x01, 0xeb, 0x89, 0x71, 0x9d, 0xaa, 0xc6, 0x1e, 0x0d, 0xbd, 0x63, 0x5c,
+	0xa7, 0x98, 0xb1, 0x25, 0xc1, 0x07, 0x88, 0x39, 0x7f, 0x5c, 0x81, 0x4b, 0xb9, 0xc9, 0x95, 0x8b,
+	0x06, 0x37, 0x68, 0x42, 0x68, 0x62, 0xeb, 0xae, 0x4c, 0x95, 0xcd, 0x5a, 0xa5, 0x6c, 0xd6, 0xae,
+	0x40, 0xe3, 0x4b, 0x1e, 0x85, 0xb4, 0x61, 0xd3, 0xbc, 0xd6, 0xdd, 0x3a, 0x02, 0x9b, 0x61, 0x70,
+	0x84, 0x8b, 0x57, 0xac, 0x44, 0xb1, 0x65, 0x8b, 0x84, 0xd9, 0xf9, 0x99, 0x5c, 0xe7, 0x6f, 0x40,
+	0x53, 0x8e, 0x39, 0x29, 0x3b, 0x62, 0x2a, 0x41, 0x42, 0x07, 0x1e, 0x6a, 0x28, 0x6d, 0x1c, 0x1d,
+	0x1c, 0x94, 0x5e, 0x9f, 0x34, 0x8b, 0x39, 0xd1, 0xe1, 0x91, 0xf7, 0x0a, 0x47, 0x64, 0x13, 0x31,
+	0x76, 0x1d, 0x9a, 0x6a, 0x66, 0x7a, 0x7e, 0x20, 0xa7, 0xaf, 0x21, 0x27, 0xe7, 0x51, 0x80, 0x92,
+	0x1a, 0xe9, 0xa2, 0xb3, 0xbd, 0x01, 0x1f, 0x27, 0x27, 0x34, 0x83, 0xf3, 0x6e, 0x7b, 0xe4, 0x07,
+	0x62, 0x8c, 0xb6, 0x10, 0x75, 0x7e, 0xcf, 0x82, 0x96, 0x1c, 0x3a, 0x52, 0x96, 0xd8, 0x1d, 0x60,
+	0x8a, 0x4f, 0x93, 0x57, 0xfe, 0xa0, 0x77, 0x78, 0x96, 0xf0, 0x58, 0x2c, 0x8b, 0x9d, 0x0b, 0x6e,
+	0x09, 0x8d, 0xbd, 0x03, 0x1d, 0x03, 0x8d, 0x93, 0x48, 0xac, 0xd8, 0x9d, 0x0b, 0x6e, 0x81, 0x82,
+	0x02, 0x04, 0xd5, 0xb1, 0x49, 0xd2, 0xf3, 0x83, 0x01, 0x7f, 0x45, 0x83, 0x3a, 0xef, 0x1a, 0xd8,
+	0xfd, 0x36, 0xb4, 0xf4, 0xef, 0x9c, 0x2f, 0xa0, 0xae, 0x94, 0x39, 0x52, 0x64, 0x72, 0xed, 0x72,
+	0x35, 0x84, 0xd9, 0x50, 0x37, 0x5b, 0xe1, 0xd6, 0xbf, 0x49, 0xdd, 0xce, 0x5f, 0x85, 0xce, 0x2e,
+	0x2e, 0x93, 0x00, 0x97, 0xa5, 0xd4, 0x50, 0x97, 0x61, 0x56, 0x13, 0x0f, 0x0d, 0x57, 0xa6, 0x50,
+	0x57, 0x38, 0x09, 0xe3, 0x44, 0xd6, 0x43, 0xbf, 0x9d, 0x3f, 0xb3, 0x80, 0x6d, 0xc7, 0x89, 0x3f,
+	0xf2, 0x12, 0xfe, 0x80, 0xa7, 0xc2, 0xef, 0x09, 0xb4, 0xb0, 0xb4, 0xa7, 0xe1, 0x86, 0xd0, 0x17,
+	0x85, 0x9e, 0xf3, 0x5d, 0x29, 0xb0, 0x8a, 0x1f, 0xac, 0xe9, 0xb9, 0xc5, 0x6e, 0x67, 0x14, 0x80,
+	0x9c, 0x94, 0x78, 0xd1, 0x31, 0x4f, 0x04, 0x6f, 0x8a, 0xa3, 0x08, 0x08, 0x08, 0xb9, 0xd3, 0xfe,
+	0x4d, 0x58, 0x2c, 0x94, 0xa1, 0xef, 0x40, 0x8d, 0x92, 0x1d, 0xa8, 0xaa, 0xef, 0x40, 0x7d, 0x58,
+	0x32, 0xda, 0x25, 0x97, 0x54, 0x17, 0xe6, 0x8e, 0xb8, 0x60, 0x5f, 0xd2, 0xb7, 0x5c, 0x95, 0x64,
+	0x77, 0xe1, 0xe2, 0x11, 0xe7, 0x91, 0x97, 0x50, 0x92, 0x84, 0x03, 0xce, 0x89, 0x2c, 0xb9, 0x94,
+	0xe6, 0xfc, 0x85, 0x05, 0x0b, 0xb8, 0x57, 0x3c, 0xf6, 0x82, 0x33, 0x35, 0x56, 0xbb, 0xa5, 0x63,
+	0x75, 0x4b, 0xd3, 0x0b, 0xb4, 0xdc, 0xdf, 0x74, 0xa0, 0xaa, 0xf9, 0x81, 0x62, 0xab, 0xd0, 0x32,
+	0x9a, 0x3b, 0x23, 0x94, 0xe3, 0xd8, 0x4b, 0xf6, 0x79, 0x74, 0xff, 0x2c, 0xe1, 0xdf, 0x7e, 0x28,
+	0xdf, 0x82, 0x4e, 0xd6, 0x6c, 0x39, 0x8e, 0x0c, 0x6a, 0xc8, 0x98, 0xb2, 0x00, 0xfa, 0xed, 0xfc,
+	0x73, 0x4b, 0x64, 0xdc, 0x0c, 0xfd, 0x54, 0x71, 0xc6, 0x8c, 0xa8, 0x7f, 0xab, 0x8c, 0xf8, 0x7b,
+	0xea, 0xc1, 0xe3, 0xdb, 0x77, 0x16, 0xa5, 0x7e, 0xcc, 0x83, 0x41, 0xcf, 0x1b, 0x0e, 0x49, 0x3e,
+	0xd5, 0xdd, 0x39, 0x4c, 0x6f, 0x0c, 0x87, 0xce, 0xdb, 0xb0, 0xa8, 0xb5, 0xee, 0x35, 0xfd, 0xd8,
+	0x03, 0xb6, 0xeb, 0xc7, 0xc9, 0xb3, 0x20, 0x1e, 0x6b, 0x7a, 0xe9, 0x15, 0x40, 0x11, 0x45, 0x2d,
+	0x13, 0x2b, 0x77, 0xc6, 0xc5, 0x0d, 0x06, 0xdb, 0x15, 0x13, 0xd1, 0x7b, 0x25, 0x89, 0x15, 0x49,
+	0xf4, 0x5e, 0x11, 0xd1, 0xf9, 0x08, 0x96, 0x8c, 0xf2, 0x64, 0xd5, 0xdf, 0x81, 0x99, 0x49, 0xf2,
+	0x2a, 0x54, 0xa7, 0x86, 0xa6, 0xe4, 0x10, 0x3c, 0x9f, 0xba, 0x82, 0xe2, 0xdc, 0x83, 0xc5, 0x3d,
+	0xfe, 0x52, 0x2e, 0x64, 0xd5, 0x90, 0xb7, 0xde, 0x78, 0x76, 0x25, 0xba, 0xb3, 0x06, 0x4c, 0xff,
+	0x38, 0x5b, 0x00, 0xea, 0x24, 0x6b, 0x19, 0x27, 0x59, 0xe7, 0x2d, 0x60, 0x07, 0xfe, 0x71, 0xf0,
+	0x98, 0xc7, 0xb1, 0x77, 0x9c, 0x2e, 0xfd, 0x0e, 0x54, 0x47, 0xf1, 0xb1, 0x14, 0x55, 0xf8, 0xd3,
+	0x79, 0x1f, 0x96, 0x8c, 0x7c, 0xb2, 0xe0, 0xab, 0xd0, 0x88, 0xfd, 0xe3, 0xc0, 0x4b, 0x26, 0x11,
+	0x97, 0x45, 0x67, 0x80, 0xf3, 0x00, 0x2e, 0xfe, 0x98, 0x47, 0xfe, 0xd1, 0xd9, 0x9b, 0x8a, 0x37,
+	0xcb, 0xa9, 0xe4, 0xcb, 0xd9, 0x86, 0x4b, 0xb9, 0x72, 0x64, 0xf5, 0x82, 0x7d, 0xe5, 0x4c, 0xd6,
+	0x5d, 0x91, 0xd0, 0x64, 0x5f, 0x45, 0x97, 0x7d, 0xce, 0x33, 0x60, 0x9b, 0x61, 0x10, 0xf0, 0x7e,
+	0xb2, 0xcf, 0x79, 0x94, 0x19, 0xd1, 0x32, 0x5e, 0x6d, 0xde, 0x5d, 0x91, 0x23, 0x9b, 0x17, 0xa8,
+	0x92, 0x89, 0x19, 0xd4, 0xc6, 0x3c, 0x1a, 0x51, 0xc1, 0x75, 0x97, 0x7e, 0x3b, 0x97, 0x60, 0xc9,
+	0x28, 0x56, 0x9a, 0x1d, 0xde, 0x83, 0x4b, 0x5b, 0x7e, 0xdc, 0x2f, 0x56, 0xd8, 0x85, 0xb9, 0xf1,
+	0xe4, 0xb0, 0x97, 0xad, 0x44, 0x95, 0xc4, 0x93, 0x68, 0xfe, 0x13, 0x59, 0xd8, 0xdf, 0xb5, 0xa0,
+	0xb6, 0xf3, 0x74, 0x77, 0x13, 0xf7, 0x0a, 0x3f, 0xe8, 0x87, 0x23, 0xd4, 0x31, 0x45, 0xa7, 0xd3,
+	0xf4, 0xd4, 0x15, 0x76, 0x15, 0x1a, 0xa4, 0x9a, 0xe2, 0xe1, 0x5b, 0x6a, 0x7a, 0x19, 0x80, 0x07,
+	0x7f, 0xfe, 0x6a, 0xec, 0x47, 0x74, 0xb2, 0x57, 0xe7, 0xf5, 0x1a, 0x6d, 0x33, 0x45, 0x82, 0xf3,
+	0x0f, 0xe7, 0x60, 0x4e, 0x6e, 0xbe, 0x54, 0x5f, 0x3f, 0xf1, 0x4f, 0x79, 0xa6, 0xa9, 0x60, 0x0a,
+	0xd5, 0xfe, 0x88, 0x8f, 0xc2, 0x24, 0xd5, 0x50, 0xc5, 0x34, 0x98, 0x20, 0xe6, 0x52, 0x6a, 0x92,
+	0x30, 0x85, 0x54, 0x45, 0x2e, 0x03, 0xc4, 0xc1, 0x52, 0xda, 0x8e, 0xd0, 0x3f, 0x55, 0x12, 0x47,
+	0xa2, 0xef, 0x8d, 0xbd, 0xbe, 0x9f, 0x9c, 0x49, 0x91, 0x90, 0xa6, 0xb1, 0xec, 0x61, 0xd8, 0xf7,
+	0xd0, 0x9a, 0x35, 0xf4, 0x82, 0x3e, 0x57, 0x46, 0x13, 0x03, 0x44, 0x03, 0x82, 0x6c, 0x92, 0xca,
+	0x26, 0x8c, 0x0c, 0x39, 0x14, 0xf7, 0xef, 0x7e, 0x38, 0x1a, 0xf9, 0x09, 0xda, 0x1d, 0x48, 0x73,
+	0xa9, 0xba, 0x1a, 0x42, 0x3d, 0x11, 0xa9, 0x97, 0x62, 0xf4, 0x1a, 0xca, 0x44, 0xa3, 0x81, 0x58,
+	0x4a, 0x4e, 0xff, 0xac, 0xba, 0x1a, 0x82, 0xf3, 0x30, 0x09, 0x62, 0x9e, 0x24, 0x43, 0x3e, 0x48,
+	0x1b, 0xd4, 0xa4, 0x6c, 0x45, 0x02, 0xbb, 0x03, 0x4b, 0xc2, 0x14, 0x12, 0x7b, 0x49, 0x18, 0x9f,
+	0xf8, 0x71, 0x2f, 0xe6, 0x41, 0x42, 0x3a, 0x69, 0xd5, 0x2d, 0x23, 0xb1, 0x8f, 0x60, 0x25, 0x07,
+	0x47, 0xbc, 0xcf, 0xfd, 0x53, 0x3e, 0x20, 0x05, 0xb5, 0xea, 0x4e, 0x23, 0xb3, 0x55, 0x68, 0xa2,
+	0x05, 0x68, 0x32, 0x1e, 0x78, 0xa8, 0xc0, 0xb4, 0x69, 0x1e, 0x74, 0x88, 0xbd, 0x07, 0x4a, 0x09,
+	0x95, 0xba, 0xf1, 0x82, 0x21, 0xdd, 0x90, 0x73, 0x5d, 0x33, 0x07, 0xbb, 0xaa, 0xeb, 0x9c, 0x1d,
+	0x79, 0xdc, 0x56, 0x00, 0xad, 0x91, 0xc8, 0x3f, 0xf5, 0x12, 0xde, 0x5d, 0x14, 0x02, 0x5d, 0x26,
+	0xf1, 0x3b, 0x3f, 0xf0, 0x13, 0xdf, 0x4b, 0xc2, 0xa8, 0xcb, 0x88, 0x96, 0x01, 0x38, 0x88, 0xc4,
+	0x1f, 0x71, 0xe2, 0x25, 0x93, 0x58, 0xea, 0xdf, 0x4b, 0xe2, 0x2c, 0x56, 0x20, 0xb0, 0x0f, 0x61,
+	0x59, 0x70, 0x04, 0x91, 0x74, 0x2d, 0xf7, 0x22, 0x8d, 0xc8, 0x14, 0x2a, 0x0e, 0xa5, 0x64, 0x91,
+	0xc2, 0x87, 0x97, 0xc4, 0x50, 0x4e, 0x21, 0x63, 0xfb, 0xb0, 0x05, 0x7e, 0xbf, 0x27, 0x73, 0xe0,
+	0xf2, 0x58, 0xa6, 0x5e, 0x14, 0x09, 0xc4, 0x58, 0xc3, 0x30, 0xe6, 0xca, 0x98, 0xd5, 0x5d, 0x91,
+	0x4b, 0x44, 0x07, 0x9d, 0xdf, 0xb5, 0xc4, 0x56, 0x23, 0x97, 0x65, 0xac, 0x1d, 0x13, 0xc5, 0x82,
+	0xec, 0x85, 0xc1, 0xf0, 0x4c, 0xae, 0x51, 0x10, 0xd0, 0x93, 0x60, 0x28, 0x0c, 0x0a, 0x81, 0x9e,
+	0x45, 0x48, 0xb5, 0x96, 0x1f, 0x68, 0x99, 0x6e, 0x40, 0x73, 0x3c, 0x39, 0x1c, 0xfa, 0x7d, 0x91,
+	0x45, 0x1c, 0x28, 0x40, 0x40, 0x94, 0x01, 0xcf, 0xc8, 0x62, 0x6e, 0x44, 0x8e, 0x1a, 0xe5, 0x68,
+	0x4a, 0x0c, 0xb3, 0x38, 0xf7, 0xe1, 0xa2, 0xd9, 0x40, 0x29, 0xbe, 0x6f, 0x43, 0x5d, 0xae, 0x76,
+	0x65, 0x49, 0x69, 0x6b, 0xf6, 0x66, 0x3c, 0xd6, 0xa5, 0x74, 0xe7, 0xdf, 0xd6, 0x60, 0x49, 0xa2,
+	0x9b, 0xd8, 0xfd, 0x83, 0xc9, 0x68, 0xe4, 0x45, 0x25, 0x62, 0xc4, 0x7a, 0x83, 0x18, 0xa9, 0x98,
+	0x62, 0xe4, 0xba, 0x71, 0x56, 0x16, 0x32, 0x48, 0x43, 0xd8, 0x2d, 0x58, 0xc0, 0xe1, 0x16, 0x8a,
+	0xbd, 0x6e, 0xee, 0xcc, 0xc3, 0x45, 0xb1, 0x37, 0x53, 0x26, 0xf6, 0x74, 0xb1, 0x35, 0x9b, 0x13,
+	0x5b, 0x0e, 0xb4, 0xc4, 0xd4, 0x4a, 0x29, 0x2c, 0xcf, 0x51, 0x3a, 0x86, 0xed, 0xc9, 0x0b, 0x09,
+	0x21, 0x91, 0x16, 0xca, 0x44, 0x04, 0x5a, 0x53, 0x51, 0xca, 0x6b, 0xb9, 0x1b, 0x52, 0x44, 0x14,
+	0x49, 0xec, 0x01, 0x80, 0xa8, 0x8b, 0x54, 0x0d, 0x20, 0x55, 0xe3, 0x2d, 0x73, 0x46, 0xf4, 0xb1,
+	0x5f, 0xc3, 0xc4, 0x24, 0xe2, 0xa4, 0x7e, 0x68, 0x5f, 0x3a, 0x7f, 0xdf, 0x82, 0xa6, 0x46, 0x63,
+	0x97, 0x60, 0x71, 0xf3, 0xc9, 0x93, 0xfd, 0x6d, 0x77, 0xe3, 0xe9, 0xa3, 0x1f, 0x6f, 0xf7, 0x36,
+	0x77, 0x9f, 0x1c, 0x6c, 0x77, 0x2e, 0x20, 0xbc, 0xfb, 0x64, 0x73, 0x63, 0xb7, 0xf7, 0xe0, 0x89,
+	0xbb, 0xa9, 0x60, 0x8b, 0x2d, 0x03, 0x73, 0xb7, 0x1f, 0x3f, 0x79, 0xba, 0x6d, 0xe0, 0x15, 0xd6,
+	0x81, 0xd6, 0x7d, 0x77, 0x7b, 0x63, 0x73, 0x47, 0x22, 0x55, 0x76, 0x11, 0x3a, 0x0f, 0x9e, 0xed,
+	0x6d, 0x3d, 0xda, 0x7b, 0xd8, 0xdb, 0xdc, 0xd8, 0xdb, 0xdc, 0xde, 0xdd, 0xde, 0xea, 0xd4, 0xd8,
+	0x3c, 0x34, 0x36, 0xee, 0x6f, 0xec, 0x6d, 0x3d, 0xd9, 0xdb, 0xde, 0xea, 0xcc, 0x38, 0xff, 0xd5,
+	0x82, 0x4b, 0xd4, 0xea, 0x41, 0x7e, 0x81, 0xac, 0x42, 0xb3, 0x1f, 0x86, 0x63, 0x1e, 0x79, 0xda,
+	0x26, 0xa6, 0x43, 0xc8, 0xfc, 0x42, 0x04, 0x1c, 0x85, 0x51, 0x9f, 0xcb, 0xf5, 0x01, 0x04, 0x3d,
+	0x40, 0x04, 0x99, 0x5f, 0x4e, 0xaf, 0xc8, 0x21, 0x96, 0x47, 0x53, 0x60, 0x22, 0xcb, 0x32, 0xcc,
+	0x1e, 0x46, 0xdc, 0xeb, 0x9f, 0xc8, 0x95, 0x21, 0x53, 0xe8, 0xfe, 0x50, 0x27, 0xc6, 0x3e, 0x8e,
+	0xfe, 0x90, 0x0f, 0x88, 0x63, 0xea, 0xee, 0x82, 0xc4, 0x37, 0x25, 0x8c, 0x32, 0xcf, 0x3b, 0xf4,
+	0x82, 0x41, 0x18, 0xf0, 0x81, 0x54, 0x70, 0x33, 0xc0, 0xd9, 0x87, 0xe5, 0x7c, 0xff, 0xe4, 0xfa,
+	0xfa, 0x50, 0x5b, 0x5f, 0x42, 0xdf, 0xb4, 0xa7, 0xcf, 0xa6, 0xb6, 0xd6, 0xfe, 0xac, 0x0a, 0x35,
+	0x54, 0x3f, 0xa6, 0xab, 0x2a, 0xba, 0x46, 0x59, 0x2d, 0xf8, 0x46, 0xe8, 0x58, 0x2b, 0x36, 0x24,
+	0x69, 0x34, 0xca, 0x90, 0x8c, 0x1e, 0xf1, 0xfe, 0xa9, 0x34, 0x1b, 0x69, 0x08, 0x2e, 0x10, 0x54,
+	0xf7, 0xe9, 0x6b, 0xb9, 0x40, 0x54, 0x5a, 0xd1, 0xe8, 0xcb, 0xb9, 0x8c, 0x46, 0xdf, 0x75, 0x61,
+	0xce, 0x0f, 0x0e, 0xc3, 0x49, 0x30, 0xa0, 0x05, 0x51, 0x77, 0x55, 0x92, 0xbc, 0x31, 0xb4, 0x50,
+	0xfd, 0x91, 0x62, 0xff, 0x0c, 0x60, 0x77, 0xa1, 0x11, 0x9f, 0x05, 0x7d, 0x9d, 0xe7, 0x2f, 0xca,
+	0x51, 0xc2, 0x31, 0x58, 0x3b, 0x38, 0x0b, 0xfa, 0xc4, 0xe1, 0x59, 0x36, 0xda, 0xcb, 0x87, 0xde,
+	0x58, 0x9a, 0x3b, 0x9a, 0xe2, 0xc8, 0x92, 0x21, 0xb8, 0x90, 0xc9, 0xa4, 0x4b, 0x50, 0x10, 0xcb,
+	0x6d, 0xd9, 0xc0, 0xd2, 0x3c, 0x31, 0xe7, 0x01, 0xe6, 0x99, 0xd7, 0xf2, 0x48, 0xcc, 0xf9, 0x4d,
+	0xa8, 0xab, 0xea, 0x91, 0xfd, 0x9f, 0xed, 0x7d, 0xb2, 0xf7, 0xe4, 0xf9, 0x5e, 0xef, 0xe0, 0xd3,
+	0xbd, 0xcd, 0xce, 0x05, 0xb6, 0x00, 0xcd, 0x8d, 0x4d, 0x5a, 0x51, 0x04, 0x58, 0x98, 0x65, 0x7f,
+	0xe3, 0xe0, 0x20, 0x45, 0x2a, 0xce, 0x0a, 0x5c, 0xc2, 0x4e, 0x6c, 0x9f, 0xf2, 0x20, 0x39, 0x98,
+	0x1c, 0x0a, 0x07, 0x94, 0x1f, 0x06, 0xce, 0xdf, 0xb1, 0xa0, 0x91, 0x52, 0x5e, 0x33, 0xcf, 0xca,
+	0x67, 0x56, 0xa1, 0x81, 0xb1, 0xb5, 0x81, 0xa1, 0x2f, 0xd7, 0xe8, 0x5f, 0xe3, 0xfc, 0xd1, 0x48,
+	0x21, 0x6c, 0xe0, 0xfe, 0xf6, 0xb6, 0xdb, 0x7b, 0xb2, 0xb7, 0xfb, 0x68, 0x0f, 0x57, 0x3c, 0x36,
+	0x90, 0x80, 0x07, 0x0f, 0x08, 0xb1, 0x1c, 0x86, 0xb6, 0x8b, 0x98, 0x94, 0xdd, 0xd4, 0xed, 0xf2,
+	0x21, 0x2c, 0x6a, 0x58, 0x76, 0x70, 0x1a, 0x23, 0x90, 0x3b, 0x38, 0x61, 0x26, 0x57, 0x50, 0x9c,
+	0x0e, 0x3a, 0xc8, 0x93, 0x47, 0xc1, 0x51, 0xa8, 0x4a, 0xfa, 0x9f, 0x35, 0x58, 0x48, 0x21, 0x59,
+	0xd0, 0x2d, 0x58, 0xf0, 0x07, 0x3c, 0x48, 0xfc, 0xe4, 0xac, 0x67, 0x98, 0x48, 0xf2, 0x30, 0x9e,
+	0x2e, 0xbc, 0xa1, 0xef, 0x29, 0xef, 0x9f, 0x48, 0xa0, 0xc9, 0x00, 0x55, 0x1f, 0xdd, 0x16, 0x47,
+	0x0b, 0x4c, 0x58, 0x66, 0x4a, 0x69, 0x28, 0x8a, 0x11, 0x97, 0x7b, 0x6d, 0xfa, 0x89, 0xd0, 0xb2,
+	0xcb, 0x48, 0xc8, 0xb3, 0xa2, 0x24, 0xec, 0xb2, 0x30, 0xc9, 0x65, 0x40, 0xc1, 0xbd, 0x36, 0x2b,
+	0x36, 0x8a, 0xbc, 0x7b, 0x4d, 0x73, 0xd1, 0xd5, 0x0b, 0x2e, 0x3a, 0xdc, 0x48, 0xce, 0x82, 0x3e,
+	0x1f, 0xf4, 0x92, 0xb0, 0x47, 0x1b, 0x1e, 0xad, 0x8d, 0xba, 0x9b, 0x87, 0xd9, 0x55, 0x98, 0x4b,
+	0x78, 0x9c, 0x04, 0x5c, 0xf8, 0x45, 0xea, 0xf7, 0x2b, 0x5d, 0xcb, 0x55, 0x10, 0x1e, 0x89, 0x26,
+	0x91, 0x8f, 0x3c, 0x8e, 0xce, 0x37, 0xfa, 0xcd, 0x3e, 0x80, 0x4b, 0x87, 0x1c, 0x5d, 0x1a, 0xdc,
+	0x1b, 0xf0, 0x88, 0xd6, 0x99, 0xf0, 0xf2, 0x09, 0x26, 0x2f, 0x27, 0x22, 0x17, 0x9e, 0xf2, 0x28,
+	0xf6, 0xc3, 0x80, 0x74, 0xcc, 0x86, 0xab, 0x92, 0x58, 0x1e, 0x76, 0xde, 0x0f, 0x72, 0xc3, 0xd4,
+	0x5d, 0xa0, 0x8e, 0x97, 0x13, 0xd9, 0x4d, 0x98, 0xa5, 0x0e, 0xc4, 0xdd, 0xce, 0x6a, 0x55, 0xb3,
+	0xb5, 0x6f, 0x22, 0xe8, 0x4a, 0x1a, 0xce, 0x72, 0x3f, 0x1c, 0x86, 0x11, 0x29, 0x9a, 0x0d, 0x57,
+	0x24, 0xcc, 0xd1, 0x39, 0x8e, 0xbc, 0xf1, 0x89, 0x54, 0x36, 0xf3, 0xf0, 0x0f, 0x6b, 0xf5, 0x66,
+	0xa7, 0xe5, 0xfc, 0x15, 0x98, 0xa1, 0x62, 0xa9, 0x38, 0x1a, 0x4c, 0x4b, 0x16, 0x47, 0x68, 0x17,
+	0xe6, 0x02, 0x9e, 0xbc, 0x0c, 0xa3, 0x17, 0xca, 0x95, 0x2c, 0x93, 0xce, 0x97, 0x74, 0x28, 0x4d,
+	0x5d, 0xab, 0xcf, 0x48, 0xa3, 0x46, 0xd3, 0x82, 0x98, 0xaa, 0xf8, 0xc4, 0x93, 0xe7, 0xe4, 0x3a,
+	0x01, 0x07, 0x27, 0x1e, 0x6e, 0x3a, 0xc6, 0xec, 0x0b, 0xd3, 0x43, 0x93, 0xb0, 0x1d, 0x31, 0xf9,
+	0x37, 0xa1, 0xad, 0x9c, 0xb6, 0x71, 0x6f, 0xc8, 0x8f, 0x12, 0x65, 0x38, 0x0c, 0x26, 0x23, 0xac,
+	0x2e, 0xde, 0xe5, 0x47, 0x89, 0xb3, 0x07, 0x8b, 0x72, 0x23, 0x78, 0x32, 0xe6, 0xaa, 0xea, 0x5f,
+	0x2f, 0x53, 0xa8, 0x9a, 0x77, 0x97, 0xcc, 0x9d, 0x43, 0xb8, 0xa9, 0xcd, 0x9c, 0x8e, 0x0b, 0x4c,
+	0xdf, 0x58, 0x64, 0x81, 0x52, 0xab, 0x51, 0xa6, 0x51, 0xd9, 0x1d, 0x03, 0xc3, 0xf1, 0x89, 0x27,
+	0xfd, 0xbe, 0x72, 0xb5, 0xd7, 0x5d, 0x95, 0x74, 0xfe, 0xa5, 0x05, 0x4b, 0x54, 0xda, 0xa6, 0xb2,
+	0xf4, 0x8b, 0xcd, 0xfb, 0xa3, 0x6f, 0xd0, 0xcc, 0x56, 0x5f, 0x4b, 0xe1, 0x0c, 0xe9, 0xdb, 0xb9,
+	0x48, 0x7c, 0x73, 0x33, 0x54, 0x2d, 0x6f, 0x86, 0x72, 0xfe, 0xa9, 0x05, 0x8b, 0x62, 0x47, 0xa5,
+	0x43, 0x86, 0xec, 0xfe, 0x6f, 0x28, 0x25, 0x5e, 0x4a, 0x05, 0xd9, 0xd0, 0x6c, 0x8f, 0x21, 0x54,
+	0x64, 0xde, 0xb9, 0xe0, 0x9a, 0x99, 0xd9, 0x3d, 0x52, 0x4f, 0x83, 0x1e, 0xa1, 0x25, 0x41, 0x19,
+	0xe6, 0x58, 0xef, 0x5c, 0x70, 0xb5, 0xec, 0xf7, 0xeb, 0x30, 0x2b, 0x4e, 0x68, 0xce, 0x43, 0x98,
+	0x37, 0x2a, 0x32, 0x4c, 0x60, 0x2d, 0x61, 0x02, 0x2b, 0xd8, 0x9a, 0x2b, 0x25, 0xb6, 0xe6, 0xdf,
+	0xa9, 0x01, 0x43, 0x66, 0xc9, 0xcd, 0xc6, 0xaa, 0xe9, 0x92, 0x52, 0xf1, 0x19, 0x19, 0xc4, 0xd6,
+	0x80, 0x69, 0x49, 0xe5, 0x26, 0x13, 0xba, 0x43, 0x09, 0x05, 0xc5, 0xac, 0x54, 0xbd, 0x52, 0x17,
+	0x14, 0x6d, 0xb6, 0x62, 0xd8, 0x4b, 0x69, 0xa8, 0x1e, 0x90, 0x3f, 0x0a, 0x0f, 0x62, 0xd2, 0x24,
+	0xa0, 0xd2, 0xf9, 0xf9, 0x9d, 0x7d, 0xe3, 0xfc, 0xce, 0x15, 0xcc, 0x8c, 0xda, 0xa1, 0xb4, 0x6e,
+	0x1e, 0x4a, 0x6f, 0xc2, 0x7c, 0xea, 0xdc, 0x18, 0x61, 0xed, 0xd2, 0x02, 0x60, 0x80, 0xe8, 0xe8,
+	0x54, 0xe7, 0xc2, 0xf4, 0xe4, 0x2b, 0x1c, 0xcd, 0x05, 0x1c, 0xe5, 0x7f, 0x66, 0x78, 0x14, 0x0a,
+	0x46, 0x06, 0xd0, 0x31, 0x12, 0x39, 0xa4, 0x37, 0x09, 0x64, 0x5c, 0x06, 0x1f, 0x74, 0x5b, 0xf2,
+	0x18, 0x99, 0x27, 0x90, 0x03, 0x34, 0x3e, 0x4c, 0xd4, 0x68, 0x91, 0x10, 0xae, 0xbb, 0x06, 0x56,
+	0x3c, 0x6a, 0xb6, 0x4b, 0x8e, 0x9a, 0xd8, 0xaa, 0xcc, 0xb7, 0xb4, 0x20, 0x14, 0xd1, 0x14, 0x70,
+	0x7e, 0xbb, 0x02, 0x9d, 0xfb, 0x5e, 0xd2, 0x3f, 0xd1, 0x18, 0x24, 0xcf, 0x19, 0x56, 0x91, 0x33,
+	0xa6, 0xcd, 0x74, 0xe5, 0x9c, 0x33, 0x5d, 0xcd, 0xcd, 0xb4, 0x36, 0x4d, 0xb5, 0x37, 0x4c, 0xd3,
+	0xcc, 0x79, 0xa7, 0x69, 0x76, 0xca, 0x34, 0x15, 0x86, 0x6d, 0xae, 0xec, 0x84, 0xfe, 0x8f, 0x2d,
+	0x58, 0xc9, 0x0f, 0x8c, 0x5a, 0x39, 0xef, 0x17, 0x74, 0x74, 0x65, 0x82, 0x2c, 0x7c, 0x91, 0x66,
+	0xc4, 0x41, 0x2d, 0x7a, 0x52, 0x74, 0x88, 0x39, 0x39, 0x6e, 0x16, 0x83, 0x64, 0x60, 0xce, 0x4f,
+	0xa1, 0x5b, 0x6c, 0x95, 0xd4, 0x92, 0x7e, 0x00, 0x9d, 0x82, 0x86, 0x23, 0x9a, 0x57, 0x2a, 0xb8,
+	0xdc, 0x42, 0x6e, 0xe7, 0x3f, 0x58, 0xd0, 0xc1, 0x92, 0x0d, 0x61, 0xf8, 0x31, 0x90, 0x2c, 0x3e,
+	0xa7, 0x2c, 0x34, 0xf2, 0xb2, 0x8f, 0xa0, 0x41, 0xe9, 0x70, 0xcc, 0x03, 0x29, 0x09, 0xbb, 0xa6,
+	0x24, 0xcc, 0x76, 0xb1, 0x9d, 0x0b, 0x6e, 0x96, 0x99, 0x7d, 0x0c, 0x8d, 0x94, 0xd9, 0x65, 0xc4,
+	0x95, 0xd2, 0x64, 0x5d, 0xee, 0x0d, 0xce, 0x1e, 0x84, 0xd1, 0x7e, 0x7c, 0x98, 0x3c, 0x10, 0x3c,
+	0x86, 0xdf, 0xa6, 0xd9, 0x35, 0x19, 0xfa, 0x73, 0x0b, 0x96, 0x4a, 0xb2, 0xa3, 0xaa, 0x90, 0x77,
+	0xcc, 0xca, 0x30, 0xb6, 0x1c, 0x8c, 0x39, 0x53, 0x3e, 0x36, 0x02, 0xcb, 0xf2, 0x30, 0x9a, 0x26,
+	0x73, 0xab, 0x41, 0x4c, 0x60, 0x0e, 0x75, 0x7a, 0xb0, 0x28, 0x9b, 0x81, 0x2d, 0x12, 0x46, 0xf2,
+	0x6f, 0xd0, 0xa0, 0x55, 0x68, 0xa2, 0x95, 0x9d, 0x0f, 0x7a, 0xd8, 0xe1, 0x34, 0x24, 0x34, 0x83,
+	0x9c, 0x43, 0x58, 0x91, 0x15, 0xe0, 0x3c, 0xf2, 0x83, 0x84, 0x8f, 0x15, 0xe7, 0xfe, 0x06, 0x34,
+	0x69, 0x98, 0x4e, 0xa9, 0xd6, 0xae, 0x65, 0xcc, 0x48, 0xa1, 0x55, 0x3b, 0x17, 0x5c, 0x3d, 0xfb,
+	0xfd, 0x06, 0xcc, 0x25, 0x91, 0x7f, 0x7c, 0xcc, 0x23, 0x8c, 0x1c, 0x2c, 0xd6, 0x11, 0x8f, 0x9d,
+	0xff, 0x68, 0x41, 0x53, 0xb2, 0xc4, 0x2f, 0x6c, 0xfb, 0xb6, 0xb5, 0x58, 0x3b, 0xb1, 0xd9, 0xa4,
+	0x69, 0x1c, 0xa7, 0x11, 0x3a, 0x18, 0x50, 0xe5, 0x37, 0xec, 0xde, 0x79, 0x18, 0xf5, 0x77, 0xd2,
+	0xae, 0xe2, 0x5e, 0xe2, 0x0f, 0x7b, 0x8a, 0x2a, 0xa3, 0xda, 0xca, 0x48, 0xa8, 0x64, 0xc4, 0x09,
+	0x46, 0xb2, 0x08, 0xc9, 0x21, 0x12, 0x68, 0xe0, 0xdf, 0xcf, 0x9c, 0xf5, 0x9a, 0x2d, 0xc2, 0xf9,
+	0xd7, 0xf3, 0xb0, 0x52, 0x20, 0xa5, 0x31, 0xb8, 0xd2, 0xa0, 0x3b, 0xf4, 0x47, 0x87, 0x61, 0x6a,
+	0xc8, 0xb1, 0x74, 0x5b, 0xaf, 0x41, 0x62, 0xc7, 0x70, 0x49, 0x4d, 0x35, 0x2e, 0x80, 0x6c, 0x09,
+	0x57, 0x68, 0x09, 0xbf, 0x67, 0xae, 0xb7, 0x7c, 0x85, 0x0a, 0xd7, 0xe5, 0x42, 0x79, 0x79, 0xec,
+	0x04, 0xba, 0x8a, 0xa0, 0xf4, 0x39, 0xed, 0x40, 0x84, 0x75, 0xbd, 0xf3, 0x86, 0xba, 0x0c, 0xd3,
+	0x85, 0x3b, 0xb5, 0x34, 0x76, 0x06, 0xd7, 0x15, 0x8d, 0x14, 0xb6, 0x62, 0x7d, 0xb5, 0x73, 0xf5,
+	0x8d, 0x8c, 0x32, 0x66, 0xa5, 0x6f, 0x28, 0x98, 0x7d, 0x01, 0xcb, 0x2f, 0x3d, 0x3f, 0x51, 0xcd,
+	0xd2, 0x8e, 0x1f, 0x33, 0x54, 0xe5, 0xdd, 0x37, 0x54, 0xf9, 0x5c, 0x7c, 0x6c, 0x68, 0xb1, 0x53,
+	0x4a, 0xb4, 0xff, 0xa4, 0x02, 0x6d, 0xb3, 0x1c, 0x64, 0x53, 0xb9, 0xef, 0xa8, 0x5d, 0x53, 0x1d,
+	0x58, 0x73, 0x70, 0xd1, 0x16, 0x5a, 0x29, 0xb3, 0x85, 0xea, 0x16, 0xc8, 0xea, 0x9b, 0x1c, 0x27,
+	0xb5, 0xf3, 0x39, 0x4e, 0x66, 0x4a, 0x1d, 0x27, 0xd3, 0xed, 0xeb, 0xb3, 0xbf, 0xa8, 0x7d, 0x7d,
+	0xee, 0xb5, 0xf6, 0x75, 0xfb, 0x7f, 0x5b, 0xc0, 0x8a, 0xdc, 0xcb, 0x1e, 0x0a, 0xf3, 0x6f, 0xc0,
+	0x87, 0x52, 0x4c, 0xbd, 0x7b, 0xbe, 0x15, 0xa0, 0x66, 0x4b, 0x7d, 0x8d, 0x4b, 0x51, 0x0f, 0x84,
+	0xd5, 0x4f, 0x60, 0xf3, 0x6e, 0x19, 0x29, 0xe7, 0x3c, 0xaa, 0xbd, 0xd9, 0x79, 0x34, 0xf3, 0x66,
+	0xe7, 0xd1, 0x6c, 0xde, 0x79, 0x64, 0xff, 0x6d, 0x0b, 0x96, 0x4a, 0xd8, 0xec, 0x97, 0xd7, 0x71,
+	0x64, 0x0c, 0x43, 0xfa, 0x54, 0x24, 0x63, 0xe8, 0xa0, 0xfd, 0x37, 0x60, 0xde, 0x58, 0x5a, 0xbf,
+	0xbc, 0xfa, 0xf3, 0x87, 0x48, 0xc1, 0xd9, 0x06, 0x66, 0xff, 0xaf, 0x0a, 0xb0, 0xe2, 0xf2, 0xfe,
+	0xff, 0xda, 0x86, 0xe2, 0x38, 0x55, 0x4b, 0xc6, 0xe9, 0xff, 0xe9, 0xce, 0xf3, 0x0e, 0x2c, 0xca,
+	0xe8, 0x7e, 0xcd, 0xe8, 0x2f, 0x38, 0xa6, 0x48, 0xc0, 0x63, 0xb4, 0xe9, 0xb9, 0xab, 0x1b, 0xd1,
+	0xcc, 0xda, 0xf6, 0x9b, 0x73, 0xe0, 0x39, 0x36, 0x74, 0xe5, 0x08, 0x15, 0xad, 0x8b, 0xff, 0xa6,
+	0x0a, 0x4c, 0x27, 0x4a, 0xed, 0xef, 0x03, 0x68, 0xe9, 0xdb, 0x87, 0x9c, 0x8e, 0x9c, 0xcf, 0x07,
+	0xf5, 0x3e, 0x3d, 0x17, 0xdb, 0x82, 0x36, 0x09, 0xc9, 0x41, 0xfa, 0x5d, 0xc5, 0x50, 0xe1, 0x4a,
+	0x6c, 0xd9, 0x3b, 0x17, 0xdc, 0xdc, 0x37, 0xec, 0xfb, 0xd0, 0x36, 0xed, 0x43, 0xdd, 0xea, 0x54,
+	0x83, 0x01, 0x7e, 0x6e, 0x66, 0x66, 0x1b, 0xd0, 0xc9, 0x1b, 0x98, 0xba, 0xb5, 0xd7, 0x15, 0x50,
+	0xc8, 0xce, 0x3e, 0x92, 0xa6, 0xd4, 0x19, 0x32, 0xa5, 0xde, 0x34, 0x3f, 0xd3, 0x86, 0x69, 0x4d,
+	0xfc, 0xa7, 0x19, 0x55, 0x7f, 0x0a, 0x90, 0x61, 0x68, 0x44, 0x7d, 0xb2, 0xbf, 0xbd, 0xd7, 0xdb,
+	0xdc, 0xd9, 0xd8, 0xdb, 0xdb, 0xde, 0xed, 0x5c, 0x60, 0x0c, 0xda, 0xe4, 0x12, 0xd9, 0x4a, 0x31,
+	0x0b, 0x31, 0x69, 0x1c, 0x56, 0x58, 0x05, 0xfd, 0x25, 0x8f, 0xf6, 0x72, 0x68, 0x15, 0x35, 0x31,
+	0xd9, 0x44, 0xd4, 0xc4, 0xc4, 0xed, 0x8d, 0xfb, 0x82, 0x3d, 0x94, 0x76, 0xf2, 0x2f, 0x2c, 0xb8,
+	0x94, 0x23, 0x64, 0x21, 0xbe, 0x42, 0x01, 0x31, 0xb5, 0x12, 0x13, 0x24, 0xb7, 0xac, 0x3a, 0x8e,
+	0xe6, 0x24, 0x48, 0x91, 0x80, 0x3c, 0x3f, 0x09, 0x0a, 0xb0, 0x5c, 0x49, 0x65, 0x24, 0x34, 0x73,
+	0x6f, 0xaa, 0xdb, 0x28, 0x46, 0xc3, 0x8f, 0x60, 0x39, 0x4f, 0xc8, 0x42, 0x62, 0xcc, 0x26, 0xab,
+	0x24, 0x9e, 0x47, 0x0d, 0x65, 0xc7, 0x6c, 0x6f, 0x29, 0xcd, 0xf9, 0x83, 0x19, 0x60, 0x3f, 0x9a,
+	0xf0, 0xe8, 0x8c, 0xe2, 0x78, 0x53, 0x0f, 0xd3, 0x4a, 0xde, 0xae, 0x8e, 0xa1, 0x28, 0x9f, 0xf0,
+	0x33, 0x15, 0x7d, 0x5f, 0xc9, 0xa2, 0xef, 0xcb, 0x22, 0xe0, 0x6b, 0x6f, 0x8e, 0x80, 0x9f, 0x79,
+	0x53, 0x04, 0x7c, 0x21, 0x6a, 0x7c, 0xb6, 0x24, 0x6a, 0xfc, 0x5e, 0x96, 0x89, 0x0f, 0x8e, 0xe9,
+	0xb6, 0x85, 0x2e, 0x05, 0xb6, 0x07, 0xc7, 0x7c, 0x37, 0xec, 0x7b, 0x49, 0x18, 0x91, 0xed, 0x57,
+	0x7d, 0x8c, 0x38, 0x9a, 0x59, 0xdb, 0x71, 0x38, 0x41, 0xcd, 0x49, 0xf5, 0x55, 0x18, 0x9b, 0x5b,
+	0x02, 0xdd, 0x17, 0x3d, 0x5e, 0x83, 0xa5, 0x49, 0xcc, 0x7b, 0x23, 0x3f, 0x46, 0x8b, 0x2e, 0x1e,
+	0x52, 0x93, 0x28, 0x1c, 0x4a, 0x93, 0xf3, 0xe2, 0x24, 0xe6, 0x8f, 0x05, 0x65, 0x53, 0x10, 0x8a,
+	0x81, 0xec, 0x70, 0x8e, 0x40, 0xf6, 0x5c, 0x14, 0x7f, 0x33, 0x1f, 0xc5, 0x5f, 0x76, 0x1d, 0xa0,
+	0x55, 0x7a, 0x1d, 0xa0, 0x24, 0x28, 0x7f, 0xbe, 0x2c, 0x28, 0xff, 0xf3, 0xf2, 0x7b, 0x01, 0x22,
+	0xea, 0xfe, 0x8e, 0x6c, 0x6c, 0x91, 0x33, 0xce, 0x7f, 0x3d, 0xe0, 0x97, 0x13, 0x9b, 0x2f, 0x43,
+	0xca, 0xd7, 0xa0, 0xae, 0x06, 0x0e, 0x2d, 0x80, 0x47, 0x51, 0x38, 0x52, 0x16, 0x40, 0xfc, 0xcd,
+	0xda, 0x50, 0x49, 0x42, 0xf9, 0x71, 0x25, 0x09, 0x9d, 0x4f, 0xa1, 0xa9, 0xcd, 0xbd, 0x8c, 0x2b,
+	0x27, 0x55, 0x52, 0x9e, 0x29, 0x6b, 0xe2, 0x98, 0x1d, 0xf0, 0xe1, 0xa3, 0x01, 0xde, 0xaf, 0x1b,
+	0xf8, 0x11, 0xa7, 0xeb, 0x2e, 0xbd, 0x88, 0xa3, 0xf1, 0x5e, 0x19, 0x59, 0x3b, 0x29, 0xc1, 0x15,
+	0xb8, 0xd3, 0x83, 0x25, 0x63, 0x58, 0x52, 0x79, 0x32, 0x4b, 0x31, 0xf0, 0xca, 0xda, 0x60, 0xc6,
+	0xc7, 0x4b, 0x1a, 0x59, 0x37, 0x84, 0x7d, 0xb8, 0x37, 0x8e, 0xc2, 0x43, 0xaa, 0xc4, 0x72, 0x0d,
+	0xcc, 0xf9, 0x2f, 0x15, 0xa8, 0xee, 0x84, 0x63, 0xdd, 0xf5, 0x6f, 0x99, 0xae, 0x7f, 0xa9, 0x2e,
+	0xf7, 0x52, 0x6d, 0x58, 0xea, 0x34, 0x06, 0xc8, 0x6e, 0x43, 0xdb, 0x1b, 0x25, 0x68, 0xef, 0x3f,
+	0x0a, 0xa3, 0x97, 0x5e, 0x24, 0x82, 0xe5, 0xab, 0xb4, 0x10, 0x72, 0x14, 0x76, 0x11, 0xaa, 0xa9,
+	0x96, 0x47, 0x19, 0x30, 0x89, 0x67, 0x53, 0x0a, 0xa4, 0x52, 0xb1, 0xd5, 0x32, 0x85, 0x72, 0xce,
+	0xfc, 0x5e, 0x18, 0xa5, 0xc4, 0x5e, 0x5d, 0x46, 0x42, 0xd5, 0x1d, 0x97, 0xfe, 0x28, 0xd3, 0x84,
+	0xd3, 0xb4, 0xee, 0xc3, 0xab, 0x9b, 0x3e, 0x3c, 0xb4, 0x16, 0x0d, 0x4f, 0x7b, 0x63, 0xef, 0x6c,
+	0x18, 0x7a, 0x03, 0xb9, 0xe4, 0x74, 0x88, 0xdd, 0x01, 0x18, 0x8d, 0xc7, 0x92, 0x7b, 0xc9, 0x26,
+	0xd9, 0xbc, 0xdb, 0x91, 0x23, 0xff, 0x78, 0x7f, 0x5f, 0x70, 0x9d, 0xab, 0xe5, 0x71, 0x9e, 0x43,
+	0x23, 0x25, 0xe8, 0x37, 0x2c, 0x28, 0x94, 0xae, 0x69, 0xde, 0xb0, 0x40, 0x0c, 0xcf, 0x0c, 0x62,
+	0x4f, 0xc0, 0x7e, 0x51, 0x07, 0x44, 0x08, 0x54, 0x0e, 0x75, 0xfe, 0xd2, 0x82, 0x19, 0x9a, 0x6c,
+	0x54, 0x92, 0x04, 0x2d, 0x0d, 0x55, 0xa0, 0x09, 0x9c, 0x77, 0xf3, 0x30, 0x73, 0x8c, 0x9b, 0x62,
+	0x95, 0x74, 0xf4, 0x35, 0x94, 0xad, 0x42, 0x23, 0xad, 0x49, 0x9b, 0xc1, 0x0c, 0x64, 0xd7, 0x31,
+	0x34, 0x7a, 0xac, 0xce, 0x91, 0xa0, 0x62, 0x97, 0xc2, 0xb1, 0x4b, 0x78, 0xd6, 0x1e, 0x2c, 0x4f,
+	0xb7, 0x1f, 0xe6, 0xe1, 0x92, 0xbe, 0xce, 0x96, 0xf6, 0xf5, 0x19, 0x2c, 0xe0, 0x72, 0xd4, 0x3c,
+	0x96, 0xd3, 0x77, 0x8c, 0x5f, 0x43, 0x05, 0xa4, 0x3f, 0x9c, 0x0c, 0xb8, 0x7e, 0x9a, 0x27, 0x8f,
+	0x94, 0xc4, 0x95, 0x1e, 0xeb, 0xfc, 0x91, 0x05, 0x75, 0x55, 0x2e, 0xbb, 0x05, 0x35, 0x94, 0xfb,
+	0x39, 0x4b, 0x5b, 0x1a, 0xde, 0x88, 0xf9, 0x5c, 0xca, 0x81, 0xb3, 0x48, 0x3e, 0x23, 0xbd, 0xf4,
+	0x79, 0xd7, 0xc0, 0xb2, 0x9e, 0xe5, 0x4e, 0x90, 0x39, 0x94, 0xad, 0x69, 0x56, 0xcd, 0x9a, 0xb1,
+	0x97, 0x28, 0x7d, 0x67, 0x70, 0xcc, 0xb5, 0x88, 0x83, 0x3f, 0xb4, 0x60, 0xde, 0x68, 0x13, 0x32,
+	0x2d, 0x09, 0x60, 0x61, 0x7c, 0x93, 0x33, 0xaf, 0x43, 0x3a, 0xc3, 0x57, 0x4c, 0x86, 0x4f, 0x1d,
+	0xb7, 0x55, 0xdd, 0x71, 0x7b, 0x07, 0x1a, 0xd9, 0x55, 0x41, 0xb3, 0x51, 0x58, 0xa3, 0x0a, 0xf4,
+	0xcc, 0x32, 0x65, 0xae, 0xc1, 0x19, 0xcd, 0x35, 0xe8, 0xdc, 0x83, 0xa6, 0x96, 0x5f, 0x77, 0xed,
+	0x59, 0x86, 0x6b, 0x2f, 0x8d, 0x82, 0xae, 0x64, 0x51, 0xd0, 0xce, 0xcf, 0x2b, 0x30, 0x8f, 0xec,
+	0x8d, 0xb6, 0xb1, 0x70, 0xe8, 0xf7, 0xc9, 0x5a, 0x97, 0x72, 0xb2, 0xdc, 0xf7, 0x15, 0x9b, 0x9b,
+	0x30, 0xae, 0xfe, 0xf4, 0x72, 0x8b, 0x10, 0x55, 0x69, 0x1a, 0x65, 0x19, 0x4a, 0x82, 0x43, 0x2f,
+	0x96, 0xe2, 0x41, 0x9e, 0x3b, 0x0c, 0x10, 0x25, 0x0e, 0x02, 0x14, 0xd3, 0x3e, 0xf2, 0x87, 0x43,
+	0x5f, 0xe4, 0x15, 0xa7, 0xd2, 0x32, 0x12, 0xd6, 0x39, 0xf0, 0x63, 0xef, 0x30, 0x8b, 0x4e, 0x49,
+	0xd3, 0x58, 0x67, 0x7a, 0xf1, 0x63, 0x94, 0xdd, 0x0d, 0x31, 0xc1, 0xfc, 0x44, 0xce, 0x15, 0x26,
+	0xd2, 0xf9, 0xd3, 0x0a, 0x34, 0x35, 0xb6, 0x90, 0x21, 0x59, 0xe6, 0x36, 0xa3, 0x21, 0x8a, 0x6e,
+	0xd8, 0x38, 0x34, 0x84, 0xdd, 0x34, 0x6b, 0x24, 0xcf, 0x27, 0x2d, 0x76, 0x1d, 0x26, 0x0f, 0x7b,
+	0x38, 0xe0, 0xef, 0x91, 0x41, 0x45, 0xde, 0xd1, 0x4d, 0x01, 0x45, 0xbd, 0x4b, 0xd4, 0x99, 0x8c,
+	0x4a, 0xc0, 0x6b, 0x83, 0xb8, 0x3e, 0x82, 0x96, 0x2c, 0x86, 0xe6, 0xb7, 0x3b, 0x67, 0x2c, 0x3c,
+	0x63, 0xee, 0x5d, 0x23, 0xa7, 0xfa, 0xf2, 0xae, 0xfa, 0xb2, 0xfe, 0xa6, 0x2f, 0x55, 0x4e, 0xe7,
+	0x61, 0x1a, 0x1b, 0xf7, 0x10, 0x7d, 0xd2, 0x4a, 0x98, 0xdc, 0x81, 0x25, 0x25, 0x33, 0x26, 0x81,
+	0x17, 0x04, 0xe1, 0x24, 0xe8, 0x73, 0x15, 0x2c, 0x5d, 0x46, 0x72, 0x06, 0xd0, 0xd2, 0x0b, 0x62,
+	0xb7, 0x61, 0x46, 0x68, 0x8d, 0xa6, 0xed, 0xdf, 0x14, 0x1f, 0x22, 0x0b, 0xbb, 0x05, 0x33, 0x42,
+	0x79, 0xac, 0x4c, 0x5d, 0xf0, 0x22, 0x83, 0x73, 0x1b, 0x16, 0x48, 0xcd, 0x32, 0xe5, 0x9e, 0xb9,
+	0x4b, 0xa3, 0x7b, 0x3e, 0x78, 0x34, 0xc0, 0xab, 0xf1, 0x7b, 0x62, 0x3d, 0x69, 0xd9, 0x9d, 0xbf,
+	0xac, 0x42, 0x53, 0x83, 0x51, 0x2e, 0x91, 0x37, 0xbe, 0x37, 0xf0, 0xbd, 0x11, 0x4f, 0x78, 0x24,
+	0xd7, 0x50, 0x0e, 0xc5, 0x7c, 0xde, 0xe9, 0x71, 0x2f, 0x9c, 0x24, 0xbd, 0x01, 0x3f, 0x8e, 0x38,
+	0x97, 0xaa, 0x43, 0x0e, 0xc5, 0x7c, 0xc8, 0xc5, 0x5a, 0x3e, 0xe1, 0x3f, 0xcf, 0xa1, 0x2a, 0x4c,
+	0x43, 0x8c, 0x51, 0x2d, 0x0b, 0xd3, 0x10, 0x23, 0x92, 0x97, 0xa8, 0x33, 0x25, 0x12, 0xf5, 0x43,
+	0x58, 0x16, 0xb2, 0x53, 0x4a, 0x8d, 0x5e, 0x8e, 0xb1, 0xa6, 0x50, 0xd1, 0x4b, 0x85, 0x6d, 0x56,
+	0xcb, 0x22, 0xf6, 0xbf, 0x14, 0x6b, 0xcb, 0x72, 0x0b, 0x38, 0xe6, 0x25, 0xdf, 0xa1, 0x9e, 0x57,
+	0x04, 0x0d, 0x16, 0x70, 0xca, 0xeb, 0xbd, 0x32, 0x30, 0xe9, 0xcd, 0x2c, 0xe0, 0x68, 0xa7, 0x1b,
+	0xf1, 0x81, 0xef, 0x99, 0x45, 0xf4, 0xb2, 0xcd, 0x7d, 0x1a, 0x19, 0x6b, 0xc1, 0x51, 0xf8, 0x32,
+	0x1c, 0x1d, 0xfa, 0x62, 0x43, 0x13, 0x5e, 0xce, 0x9a, 0x5b, 0xc0, 0x9d, 0x79, 0x68, 0x1e, 0x24,
+	0xa1, 0x72, 0x3b, 0x38, 0x6d, 0x68, 0x89, 0xa4, 0x0c, 0x8d, 0xbf, 0x02, 0x97, 0x89, 0x57, 0x9f,
+	0x86, 0xe3, 0x70, 0x18, 0x1e, 0x9f, 0x19, 0x86, 0x88, 0x7f, 0x6f, 0xc1, 0x92, 0x41, 0xcd, 0x2c,
+	0x11, 0x64, 0x35, 0x55, 0x31, 0xcd, 0x82, 0xbd, 0x17, 0xb5, 0xed, 0x40, 0x64, 0x14, 0x9e, 0x4a,
+	0xf1, 0x3b, 0x66, 0x1b, 0xd9, 0x35, 0x44, 0xf5, 0xa1, 0xe0, 0xf5, 0x6e, 0x91, 0xd7, 0xe5, 0xf7,
+	0xea, 0x82, 0xa2, 0x2a, 0xe2, 0xfb, 0xd0, 0xd2, 0x0c, 0x13, 0xca, 0x48, 0x9e, 0x9a, 0x32, 0x74,
+	0xc3, 0x95, 0x6a, 0x41, 0x3f, 0x05, 0x63, 0xbc, 0xfb, 0x06, 0x59, 0xeb, 0x90, 0xfd, 0xb2, 0x2d,
+	0x4d, 0x3c, 0xc9, 0x91, 0x01, 0x18, 0x27, 0x92, 0x86, 0x34, 0x65, 0xbb, 0x64, 0x53, 0x61, 0xa8,
+	0x55, 0xbc, 0x0d, 0x0b, 0xc7, 0xc3, 0xf0, 0x90, 0xb4, 0x17, 0xba, 0x6b, 0x11, 0xcb, 0x0b, 0x02,
+	0x6d, 0x01, 0x3f, 0x90, 0x68, 0xb6, 0xa5, 0xd6, 0xf4, 0x2d, 0xb5, 0x7c, 0x83, 0xfc, 0x07, 0x15,
+	0x58, 0x2c, 0x8c, 0xc4, 0xd4, 0x15, 0xce, 0xee, 0x16, 0xc4, 0xf9, 0x94, 0x30, 0x0e, 0x3a, 0x6a,
+	0xec, 0xbf, 0xd1, 0x86, 0x7d, 0x0f, 0xda, 0x91, 0x90, 0x95, 0x4a, 0x90, 0xd6, 0x5e, 0x23, 0x48,
+	0xe7, 0x23, 0x3d, 0x89, 0x6a, 0x96, 0x37, 0x38, 0xe5, 0x51, 0xe2, 0x93, 0x4d, 0x8f, 0x54, 0x27,
+	0xd1, 0xb9, 0x05, 0x0d, 0x27, 0x0d, 0x05, 0x2f, 0xa5, 0x8a, 0xab, 0x1a, 0x69, 0x4e, 0x79, 0xb9,
+	0x3d, 0x83, 0x31, 0xa3, 0xf3, 0xfb, 0x2a, 0x84, 0xc5, 0x9c, 0xd9, 0xe9, 0x23, 0xa2, 0xf7, 0xae,
+	0x92, 0xeb, 0xdd, 0xaf, 0x48, 0x8f, 0xf3, 0x40, 0x19, 0x0e, 0xab, 0x5a, 0x90, 0xf0, 0x40, 0x86,
+	0xff, 0x98, 0x43, 0x5a, 0x3b, 0xcf, 0x90, 0x3a, 0x7f, 0x6e, 0xc1, 0xdc, 0x4e, 0x38, 0xde, 0x91,
+	0xe1, 0xd2, 0xb4, 0x3c, 0xd2, 0x3b, 0x52, 0x2a, 0xf9, 0x9a, 0x40, 0xea, 0x52, 0x0d, 0x64, 0x3e,
+	0xaf, 0x81, 0xfc, 0x00, 0xae, 0x20, 0x30, 0x8e, 0xc2, 0x71, 0x18, 0xe1, 0x12, 0xf5, 0x86, 0x42,
+	0xdd, 0x08, 0x83, 0xe4, 0x44, 0x89, 0xd0, 0xd7, 0x65, 0x21, 0x5b, 0x12, 0x1e, 0xf1, 0xc5, 0x21,
+	0x4a, 0x6a, 0x4c, 0x42, 0xb2, 0x16, 0x09, 0xce, 0xaf, 0x43, 0x83, 0x4e, 0x13, 0xd4, 0xad, 0x77,
+	0xa0, 0x81, 0xe7, 0xf9, 0x13, 0x3f, 0x48, 0xd4, 0x92, 0x6f, 0x67, 0x6a, 0xfe, 0x0e, 0x0d, 0x48,
+	0x9a, 0xc1, 0xf9, 0x8b, 0x59, 0x98, 0x7b, 0x14, 0x9c, 0x86, 0x7e, 0x9f, 0xc2, 0x65, 0x46, 0x7c,
+	0x14, 0xaa, 0x1b, 0x63, 0xf8, 0x1b, 0xc3, 0xe2, 0xe8, 0x8a, 0xc4, 0x58, 0x3a, 0x4e, 0x45, 0x58,
+	0x9c, 0x84, 0xe8, 0xd5, 0x89, 0xec, 0x3e, 0xbb, 0x58, 0x54, 0x1a, 0x82, 0x87, 0xc2, 0x48, 0xbf,
+	0x8f, 0x2e, 0x53, 0xd9, 0x11, 0x7e, 0x46, 0xbb, 0x91, 0x87, 0x75, 0xc9, 0xf0, 0x6e, 0x11, 0xff,
+	0x2b, 0xea, 0x92, 0x10, 0x1d, 0x64, 0x23, 0x2e, 0xdc, 0x0e, 0xa9, 0x92, 0x55, 0x75, 0x4d, 0x90,
+	0x9c, 0xbd, 0xf4, 0x81, 0xc8, 0x23, 0x36, 0x00, 0x1d, 0x22, 0xc7, 0x71, 0xee, 0xb9, 0x06, 0xf1,
+	0x5c, 0x46, 0x1e, 0x46, 0xf9, 0x3d, 0xe0, 0xa9, 0x98, 0x15, 0xfd, 0x00, 0x71, 0x67, 0x3f, 0x8f,
+	0x6b, 0xc7, 0x5f, 0x71, 0x9b, 0x45, 0xa6, 0x88, 0x61, 0xbc, 0xe1, 0x10, 0x1f, 0x9c, 0x11, 0xc7,
+	0xc6, 0x96, 0xf0, 0x56, 0x19, 0x20, 0xb6, 0x5a, 0x9b, 0x55, 0x32, 0xc2, 0xd4, 0x5c, 0x1d, 0x62,
+	0x77, 0xa1, 0x49, 0x66, 0x01, 0x39, 0xaf, 0xc2, 0xf4, 0xd2, 0xd1, 0xed, 0x06, 0x34, 0xb3, 0x7a,
+	0x26, 0x3d, 0x46, 0x64, 0xa1, 0x70, 0xbf, 0xc4, 0x1b, 0x0c, 0x64, 0x04, 0x54, 0x47, 0x98, 0x38,
+	0x52, 0x80, 0x0c, 0x0f, 0x62, 0xc0, 0x44, 0x86, 0x45, 0xca, 0x60, 0x60, 0xec, 0x3a, 0xd4, 0xf1,
+	0x84, 0x37, 0xf6, 0xfc, 0x41, 0x97, 0xa5, 0x07, 0xcd, 0x14, 0xc3, 0x32, 0xd4, 0x6f, 0xda, 0x2a,
+	0x97, 0x44, 0x68, 0x86, 0x8e, 0xe1, 0xd8, 0xa4, 0xe9, 0x51, 0x76, 0x21, 0xc5, 0x04, 0xd9, 0x7b,
+	0xe4, 0x64, 0x4e, 0x38, 0xdd, 0x3a, 0x69, 0xdf, 0xbd, 0x22, 0xfb, 0x2c, 0x99, 0x56, 0xfd, 0x4f,
+	0x4e, 0x75, 0x57, 0xe4, 0x44, 0x25, 0x4d, 0xd8, 0xf9, 0x97, 0x0d, 0x25, 0x4d, 0x66, 0x25, 0x3b,
+	0xbf, 0xc8, 0x50, 0x38, 0xd4, 0xaf, 0x14, 0x0f, 0xf5, 0xce, 0x06, 0xb4, 0xf4, 0x4a, 0x58, 0x1d,
+	0x6a, 0x68, 0x9a, 0xee, 0x5c, 0x60, 0x4d, 0x98, 0x3b, 0xd8, 0x7e, 0xfa, 0x14, 0x23, 0xf2, 0x2d,
+	0xd6, 0x82, 0x7a, 0x1a, 0x9f, 0x5f, 0xc1, 0xd4, 0xc6, 0xe6, 0xe6, 0xf6, 0xfe, 0xd3, 0xed, 0xad,
+	0x4e, 0x15, 0x5d, 0x05, 0x4d, 0xad, 0xf6, 0xd7, 0x98, 0x6b, 0xae, 0x03, 0xc8, 0xdb, 0xe3, 0x2a,
+	0x38, 0xad, 0xe6, 0x6a, 0x08, 0x4a, 0xcd, 0xf4, 0xbc, 0x5d, 0x25, 0x6a, 0x9a, 0xa6, 0xf1, 0x14,
+	0x37, 0xcb, 0x35, 0x77, 0xcb, 0x8c, 0x6b, 0x82, 0xc8, 0x6b, 0x12, 0xa0, 0x50, 0x71, 0xb1, 0x02,
+	0x75, 0x08, 0x07, 0x25, 0xe2, 0x71, 0x38, 0x3c, 0xe5, 0x22, 0x8b, 0xd0, 0xd1, 0x0c, 0x0c, 0xeb,
+	0x92, 0x22, 0x48, 0xbb, 0xc6, 0x31, 0xe3, 0x9a, 0x20, 0x7b, 0x57, 0xcd, 0x5d, 0x9d, 0xe6, 0x6e,
+	0xa5, 0x38, 0x11, 0xc6, 0xbc, 0x3d, 0x86, 0x76, 0xce, 0xc4, 0xd8, 0xa0, 0x09, 0xfc, 0xd5, 0xe2,
+	0x77, 0x6b, 0x25, 0x76, 0xc5, 0xdc, 0xc7, 0xf6, 0x0f, 0x80, 0x7d, 0xcb, 0xc7, 0x3e, 0x12, 0x60,
+	0x1b, 0x83, 0x81, 0xac, 0x56, 0x7f, 0xbc, 0x20, 0xd2, 0x9f, 0xca, 0x90, 0xa9, 0x32, 0xc9, 0x52,
+	0x29, 0x97, 0x2c, 0xaf, 0x5d, 0x7f, 0xce, 0x36, 0x34, 0xf7, 0xb5, 0xc7, 0x37, 0x48, 0xc8, 0xaa,
+	0x67, 0x37, 0xa4, 0x70, 0xd6, 0x10, 0xad, 0x39, 0x15, 0xbd, 0x39, 0xce, 0x1f, 0x58, 0xe2, 0xb6,
+	0x6f, 0xda, 0x7c, 0x51, 0x37, 0xb2, 0xbc, 0xb2, 0xee, 0x67, 0x57, 0xa6, 0x0c, 0x0c, 0xf3, 0x50,
+	0x53, 0x7a, 0xe1, 0xd1, 0x51, 0xcc, 0xd5, 0x05, 0x07, 0x03, 0x53, 0xda, 0x2d, 0xea, 0xcb, 0xbe,
+	0xa8, 0x21, 0x96, 0x17, 0x1d, 0x0a, 0x38, 0x72, 0xad, 0x34, 0x95, 0xaa, 0xab, 0x1d, 0x69, 0x3a,
+	0xbd, 0xd9, 0x95, 0x1f, 0xe5, 0xdb, 0x18, 0x08, 0x23, 0xcb, 0x35, 0xb7, 0x31, 0x95, 0x33, 0xa5,
+	0xe3, 0x76, 0x49, 0xa7, 0x5e, 0xa3, 0xd1, 0x62, 0xf1, 0x14, 0x09, 0x18, 0xa5, 0x79, 0xe4, 0x47,
+	0xf9, 0xec, 0x62, 0x35, 0x95, 0x50, 0x9c, 0xe7, 0xb0, 0xa4, 0x04, 0x80, 0xa6, 0x76, 0x9b, 0x93,
+	0x68, 0xbd, 0x49, 0x88, 0x56, 0x8a, 0x42, 0xd4, 0xf9, 0x3f, 0x55, 0x98, 0x93, 0x33, 0x5d, 0x78,
+	0xc0, 0x45, 0xcc, 0xb3, 0x81, 0xb1, 0xae, 0x71, 0x91, 0x9d, 0x24, 0xae, 0x00, 0x8a, 0x9b, 0x63,
+	0xb5, 0x6c, 0x73, 0xc4, 0x8b, 0xbd, 0x5e, 0x72, 0x42, 0x76, 0xa1, 0x86, 0x4b, 0xbf, 0x95, 0x35,
+	0x77, 0xc6, 0xb4, 0xe6, 0x96, 0x3d, 0x57, 0x23, 0xf4, 0xbe, 0x02, 0x8e, 0xe3, 0x40, 0x8d, 0xd0,
+	0x42, 0x17, 0x32, 0x00, 0xb9, 0x57, 0x24, 0x48, 0x64, 0xc9, 0x7b, 0xa5, 0x19, 0xf2, 0x0d, 0xb6,
+	0xe3, 0x0f, 0x60, 0x56, 0x5c, 0x6c, 0x94, 0x17, 0x58, 0xae, 0x2a, 0xf7, 0xad, 0xc8, 0xa7, 0xfe,
+	0x17, 0xa1, 0x78, 0xae, 0xcc, 0xab, 0x3f, 0x8b, 0xd0, 0x34, 0x9f, 0x45, 0xd0, 0xed, 0xcc, 0xad,
+	0x9c, 0x9d, 0xf9, 0x2a, 0x34, 0x22, 0xae, 0x7c, 0x62, 0x22, 0x94, 0x34, 0x03, 0x9c, 0x07, 0x30,
+	0x6f, 0x54, 0x86, 0x1b, 0x81, 0xbc, 0xb6, 0xd2, 0xb9, 0x80, 0x57, 0xb3, 0x1e, 0xed, 0xf5, 0x1e,
+	0xec, 0x3e, 0x7a, 0xb8, 0xf3, 0xb4, 0x63, 0x61, 0xf2, 0xe0, 0xd9, 0xe6, 0xe6, 0xf6, 0xf6, 0x16,
+	0x6d, 0x0c, 0x00, 0xb3, 0x0f, 0x36, 0x1e, 0xed, 0xd2, 0xb6, 0xb0, 0x25, 0x38, 0x5f, 0x96, 0x95,
+	0x3a, 0xd4, 0xde, 0x05, 0xa6, 0xcc, 0x16, 0x14, 0xfa, 0x35, 0x1e, 0xf2, 0x44, 0xdd, 0xdc, 0x5a,
+	0x94, 0x94, 0x47, 0x29, 0x41, 0x5d, 0x3c, 0xcc, 0x4a, 0xc9, 0x16, 0x90, 0x1c, 0xc2, 0xfc, 0x02,
+	0x92, 0x59, 0xdd, 0x94, 0x8e, 0x7e, 0xee, 0x2d, 0x8e, 0xa5, 0x6d, 0x0c, 0x87, 0xb9, 0xe6, 0xe0,
+	0xd9, 0xb3, 0x84, 0x26, 0x0f, 0xa6, 0x3f, 0x82, 0x4b, 0x1b, 0xe2, 0x92, 0xd6, 0x2f, 0x2b, 0x74,
+	0x1d, 0xe3, 0xc7, 0xf2, 0x45, 0xca, 0xca, 0x1e, 0xc0, 0xe2, 0x16, 0x3f, 0x9c, 0x1c, 0xef, 0xf2,
+	0xd3, 0xac, 0x22, 0x06, 0xb5, 0xf8, 0x24, 0x7c, 0x29, 0xc7, 0x87, 0x7e, 0xa3, 0x0f, 0x67, 0x88,
+	0x79, 0x7a, 0xf1, 0x98, 0xf7, 0xd5, 0x55, 0x7b, 0x42, 0x0e, 0xc6, 0xbc, 0xef, 0x7c, 0x08, 0x4c,
+	0x2f, 0x47, 0x8e, 0x17, 0xaa, 0x8e, 0x93, 0xc3, 0x5e, 0x7c, 0x16, 0x27, 0x7c, 0xa4, 0xde, 0x10,
+	0xd0, 0x21, 0xe7, 0x6d, 0x68, 0xed, 0x7b, 0xf8, 0xc0, 0x85, 0x7c, 0xe6, 0x08, 0xed, 0xd8, 0xde,
+	0x19, 0x32, 0x68, 0x6a, 0xc7, 0x26, 0xb2, 0xf3, 0xdb, 0x55, 0x98, 0x15, 0x39, 0xb1, 0xd4, 0x01,
+	0x8f, 0x13, 0x3f, 0xa0, 0x75, 0xa8, 0x4a, 0xd5, 0xa0, 0xc2, 0xca, 0xaf, 0x94, 0xac, 0x7c, 0x69,
+	0x64, 0x51, 0xd7, 0x96, 0x55, 0xa4, 0xab, 0x8e, 0x21, 0xcf, 0x66, 0x77, 0x50, 0x84, 0xb5, 0x33,
+	0x03, 0x72, 0xfe, 0x99, 0x4c, 0x41, 0x15, 0xed, 0x53, 0x42, 0x4d, 0x2e, 0x72, 0x1d, 0x2a, 0x55,
+	0x83, 0x45, 0x04, 0x70, 0x01, 0x2f, 0xaa, 0xbb, 0xf5, 0x73, 0xa8, 0xbb, 0xc2, 0xf2, 0xf2, 0x3a,
+	0x75, 0x17, 0xce, 0xa3, 0xee, 0x9e, 0xc3, 0x41, 0x83, 0x37, 0xb1, 0xe8, 0xcd, 0x14, 0x3c, 0x74,
+	0x29, 0xfe, 0xfe, 0x1d, 0x0b, 0x3a, 0x92, 0xd3, 0x52, 0x1a, 0xfb, 0x8e, 0x71, 0xb8, 0x2c, 0xbd,
+	0x6e, 0x7b, 0x13, 0xe6, 0xe9, 0xc8, 0x97, 0x0a, 0x11, 0xe9, 0x59, 0x33, 0x40, 0xec, 0xab, 0x0a,
+	0x61, 0x1a, 0xf9, 0x43, 0x39, 0x71, 0x3a, 0xa4, 0xe4, 0x50, 0xa4, 0x62, 0xb9, 0x2d, 0x37, 0x4d,
+	0x3b, 0x7f, 0x62, 0xc1, 0xa2, 0xd6, 0x60, 0xc9, 0xa9, 0xf7, 0x40, 0xad, 0x18, 0xe1, 0x0c, 0x32,
+	0x43, 0xaa, 0xf3, 0x7d, 0x71, 0x8d, 0xcc, 0x34, 0xe1, 0xde, 0x19, 0x35, 0x30, 0x9e, 0x8c, 0xe4,
+	0xbe, 0xa4, 0x43, 0x38, 0x90, 0x2f, 0x39, 0x7f, 0x91, 0x66, 0x11, 0x3b, 0xa3, 0x81, 0x91, 0x59,
+	0x1c, 0x8f, 0xaa, 0x69, 0xa6, 0x9a, 0x34, 0x8b, 0xeb, 0xa0, 0xf3, 0x5b, 0x15, 0x58, 0x12, 0x36,
+	0x07, 0x69, 0xe7, 0x49, 0x5f, 0x87, 0x98, 0x15, 0xa6, 0x17, 0xb1, 0x6a, 0x77, 0x2e, 0xb8, 0x32,
+	0xcd, 0xbe, 0x77, 0x4e, 0x3b, 0x49, 0x7a, 0x09, 0x64, 0xca, 0x5c, 0x54, 0xcb, 0xe6, 0xe2, 0x35,
+	0x23, 0x5d, 0xe6, 0xa1, 0x98, 0x29, 0xf7, 0x50, 0x9c, 0xcb, 0x23, 0x80, 0x0f, 0x19, 0xc6, 0xfd,
+	0x70, 0xcc, 0x31, 0xdc, 0xc4, 0x1c, 0x02, 0x29, 0xcc, 0x7e, 0xcf, 0x82, 0xee, 0x03, 0xe1, 0xf7,
+	0xc4, 0xe0, 0x23, 0x3f, 0x4e, 0xc2, 0x28, 0x7d, 0x6a, 0xe7, 0x3a, 0x40, 0x9c, 0x78, 0x91, 0xd4,
+	0xd1, 0xa5, 0x77, 0x20, 0x43, 0xb0, 0x27, 0x3c, 0x18, 0x08, 0xaa, 0x98, 0xc1, 0x34, 0x5d, 0x50,
+	0xde, 0xa4, 0xed, 0x44, 0xc7, 0xd0, 0xf4, 0xab, 0x94, 0x34, 0x7e, 0x4a, 0x3b, 0x84, 0x30, 0x4a,
+	0xe4, 0x50, 0xe7, 0x3f, 0x59, 0xb0, 0x90, 0x35, 0x52, 0xdc, 0xa3, 0x34, 0xe4, 0x8c, 0xd4, 0x7b,
+	0x52, 0x20, 0xf5, 0x5b, 0xf8, 0xa8, 0x08, 0xa9, 0x03, 0x4c, 0x86, 0xd0, 0xda, 0x97, 0xa9, 0x70,
+	0xa2, 0x34, 0x4b, 0x1d, 0x12, 0x51, 0xce, 0xa8, 0x82, 0x49, 0x75, 0x52, 0xa6, 0xe8, 0x36, 0xee,
+	0x28, 0xa1, 0xaf, 0xc4, 0x88, 0xab, 0x24, 0xeb, 0x08, 0x1d, 0x46, 0x3c, 0xac, 0x86, 0x3f, 0x8d,
+	0xbd, 0xbd, 0x9e, 0xbe, 0x82, 0x46, 0x69, 0xe7, 0x4f, 0x2d, 0xb8, 0x5c, 0x32, 0xf0, 0x72, 0x6d,
+	0x6d, 0xc1, 0xe2, 0x51, 0x4a, 0x54, 0x83, 0x23, 0x16, 0xd8, 0xb2, 0x0a, 0x40, 0x31, 0x07, 0xc4,
+	0x2d, 0x7e, 0x90, 0x2a, 0xa4, 0x62, 0xb8, 0x8d, 0xab, 0x46, 0x45, 0x02, 0x2a, 0xa4, 0xa9, 0x72,
+	0x61, 0xb2, 0x70, 0xcd, 0x2d, 0xa1, 0x38, 0xfb, 0x60, 0x6f, 0xbf, 0xc2, 0xa5, 0xbd, 0xa9, 0xbf,
+	0x4e, 0xab, 0x78, 0xe7, 0x6e, 0x41, 0x74, 0xbd, 0xd9, 0x2e, 0x76, 0x04, 0xf3, 0x46, 0x59, 0xec,
+	0xfd, 0xf3, 0x16, 0xa2, 0xaf, 0x42, 0x35, 0xb7, 0xe2, 0x79, 0x5d, 0x15, 0x69, 0xaf, 0x41, 0xce,
+	0x29, 0x2c, 0x3c, 0x9e, 0x0c, 0x13, 0x3f, 0x7b, 0x6a, 0x97, 0x7d, 0x0f, 0x9a, 0x59, 0x11, 0x6a,
+	0xa8, 0x4b, 0xab, 0xd2, 0xf3, 0xe1, 0x08, 0x8f, 0xb0, 0xa4, 0x5e, 0xb1, 0xc6, 0x22, 0xc1, 0xb9,
+	0x0c, 0x2b, 0x59, 0x95, 0x62, 0xec, 0x94, 0xf8, 0xff, 0x7d, 0x0b, 0x58, 0x46, 0x53, 0x2f, 0xff,
+	0xb2, 0x87, 0xb0, 0x84, 0x46, 0xd0, 0x21, 0xd7, 0xcb, 0x89, 0xe5, 0x48, 0x5c, 0x32, 0x9b, 0x27,
+	0x3e, 0x8d, 0xdd, 0xb2, 0x2f, 0x90, 0xa1, 0xca, 0x1b, 0x9a, 0x31, 0x54, 0x6e, 0x48, 0xca, 0x3a,
+	0xf0, 0x43, 0x68, 0x9b, 0x95, 0xa1, 0x23, 0x2d, 0xd7, 0x32, 0xdd, 0x79, 0x65, 0x72, 0x86, 0x91,
+	0x13, 0xef, 0x78, 0x74, 0x5d, 0x8e, 0x6c, 0xcf, 0xb5, 0x4a, 0x25, 0xf7, 0xdc, 0x2b, 0x14, 0x3b,
+	0xbd, 0xc3, 0xe9, 0xed, 0x15, 0xd5, 0xd7, 0xb5, 0xa9, 0x93, 0xb2, 0x73, 0xa1, 0xa4, 0x57, 0x78,
+	0xef, 0x44, 0xf6, 0x6f, 0x05, 0x2e, 0xc9, 0x26, 0xa9, 0xe6, 0x64, 0x9e, 0x0f, 0xa3, 0x52, 0xc3,
+	0xf3, 0x61, 0x43, 0x57, 0x5c, 0xbc, 0xd0, 0xfb, 0x21, 0x3f, 0xdc, 0x02, 0xf6, 0xd8, 0xeb, 0x7b,
+	0x51, 0x18, 0x06, 0xfb, 0x3c, 0x92, 0x21, 0x59, 0xa4, 0x06, 0x91, 0x63, 0x40, 0x69, 0x6c, 0x22,
+	0xa5, 0x9e, 0xf9, 0x09, 0x03, 0xf5, 0x9c, 0x92, 0x48, 0x39, 0x09, 0x2c, 0xdd, 0xf7, 0x5e, 0x70,
+	0x55, 0x52, 0x36, 0x4a, 0xcd, 0x71, 0x5a, 0xa8, 0x1a, 0x7b, 0x75, 0x65, 0xb1, 0x58, 0xad, 0xab,
+	0xe7, 0xc6, 0x65, 0x12, 0x85, 0x61, 0x82, 0xee, 0x8a, 0xcc, 0xc4, 0xac, 0x43, 0xce, 0x5d, 0xb8,
+	0x68, 0xd6, 0x2a, 0x85, 0x13, 0x3a, 0xc7, 0x25, 0x26, 0xdb, 0x9f, 0xa6, 0x51, 0x6d, 0x16, 0x17,
+	0xca, 0xd3, 0x8a, 0x14, 0x87, 0xff, 0x0f, 0x0b, 0x56, 0x0a, 0x24, 0x59, 0x22, 0x07, 0x36, 0xe2,
+	0xc9, 0x49, 0x38, 0xe8, 0x15, 0xfb, 0xf3, 0xbd, 0xd4, 0x11, 0x5a, 0xfa, 0xed, 0xda, 0x63, 0xfa,
+	0x50, 0xa3, 0x08, 0x33, 0x4c, 0x49, 0x81, 0x76, 0x1f, 0x96, 0xcb, 0x73, 0x97, 0x3c, 0xd7, 0xf6,
+	0xbe, 0x7e, 0xca, 0x6d, 0xde, 0xbd, 0x36, 0x75, 0x54, 0xb1, 0x5d, 0xba, 0xb5, 0xe6, 0x19, 0x2c,
+	0x97, 0x67, 0xfa, 0x56, 0xd3, 0xa5, 0x06, 0x56, 0x65, 0x7b, 0xb4, 0x95, 0x0e, 0xec, 0xf7, 0x61,
+	0xa5, 0x40, 0x91, 0xe3, 0x8a, 0x36, 0xb4, 0x6c, 0x42, 0x45, 0x95, 0x35, 0xd7, 0xc0, 0x9c, 0x7b,
+	0xb0, 0x22, 0x0e, 0x56, 0x59, 0x01, 0xda, 0x55, 0x53, 0x9d, 0x45, 0xac, 0x22, 0x8b, 0x7c, 0x00,
+	0xdd, 0xe2, 0xc7, 0x59, 0xd8, 0xe7, 0x80, 0x68, 0xca, 0x0d, 0xae, 0x92, 0xa8, 0x8c, 0x6c, 0x79,
+	0x89, 0x87, 0x6a, 0x11, 0x1e, 0x5d, 0xd3, 0x9e, 0xfc, 0xae, 0x05, 0xcd, 0xfb, 0x93, 0xfe, 0x0b,
+	0x4e, 0x27, 0xda, 0x18, 0x0f, 0x55, 0x81, 0x37, 0x52, 0xaf, 0x9d, 0xd1, 0x6f, 0x64, 0x3e, 0xd4,
+	0x0e, 0x5e, 0xf0, 0xb3, 0x58, 0xe9, 0x1c, 0x2a, 0xad, 0x5e, 0x4f, 0x3a, 0xa4, 0x22, 0x62, 0xb9,
+	0x75, 0xe9, 0x10, 0x6a, 0x0d, 0xd8, 0x72, 0xf1, 0x3c, 0xa4, 0xd8, 0xf5, 0x33, 0x00, 0xbf, 0x17,
+	0x36, 0x01, 0x41, 0x17, 0x1b, 0xbf, 0x0e, 0x39, 0xbf, 0x65, 0xc1, 0xa5, 0x5c, 0xd3, 0xb3, 0xe7,
+	0xd9, 0x8e, 0xfc, 0x21, 0x17, 0x5e, 0x5c, 0xa9, 0x8f, 0xa4, 0x00, 0x52, 0x07, 0x5e, 0xe2, 0x09,
+	0xaa, 0x68, 0x76, 0x06, 0xb0, 0x77, 0x60, 0x2e, 0x6b, 0xb3, 0x6e, 0x2b, 0xd6, 0x06, 0xc3, 0x55,
+	0x59, 0x6e, 0x7f, 0x0d, 0x4d, 0xed, 0x31, 0x3a, 0xb6, 0x02, 0x4b, 0xcf, 0x1f, 0x3d, 0xdd, 0xdb,
+	0x3e, 0x38, 0xe8, 0xed, 0x3f, 0xbb, 0xff, 0xc9, 0xf6, 0xa7, 0xbd, 0x9d, 0x8d, 0x83, 0x9d, 0xce,
+	0x05, 0x7c, 0xdc, 0x65, 0x6f, 0xfb, 0xe0, 0xe9, 0xf6, 0x96, 0x81, 0x5b, 0xec, 0x3a, 0xd8, 0xcf,
+	0xf6, 0x9e, 0x61, 0x08, 0x73, 0xd9, 0x77, 0x15, 0x76, 0x0d, 0x2e, 0x4b, 0x7a, 0xc9, 0xe7, 0xd5,
+	0xdb, 0xf7, 0xa0, 0x93, 0xb7, 0x9c, 0x1a, 0x76, 0xe6, 0xd7, 0x19, 0xa4, 0xef, 0xfe, 0xbc, 0x0a,
+	0x6d, 0x11, 0xdd, 0x2c, 0x1e, 0xb0, 0xe7, 0x11, 0x7b, 0x0c, 0x73, 0xf2, 0x2f, 0x21, 0x30, 0x25,
+	0xdf, 0xcd, 0xbf, 0xbd, 0x60, 0x2f, 0xe7, 0x61, 0x29, 0x5b, 0x97, 0xfe, 0xd6, 0x9f, 0xff, 0xf7,
+	0x7f, 0x54, 0x99, 0x67, 0xcd, 0xf5, 0xd3, 0xf7, 0xd6, 0x8f, 0x79, 0x10, 0x63, 0x19, 0x3f, 0x05,
+	0xc8, 0xde, 0xf7, 0x67, 0xdd, 0xd4, 0x58, 0x97, 0xfb, 0xe3, 0x07, 0xf6, 0xe5, 0x12, 0x8a, 0x2c,
+	0xf7, 0x32, 0x95, 0xbb, 0xf4, 0xb1, 0x75, 0xdb, 0x69, 0x63, 0xd1, 0x7e, 0xe0, 0x27, 0xe2, 0xb9,
+	0x7f, 0x36, 0x80, 0x96, 0xfe, 0xf2, 0x3e, 0x53, 0xde, 0xe4, 0x92, 0xbf, 0x1d, 0x60, 0x5f, 0x29,
+	0xa5, 0xa9, 0x0d, 0x85, 0xea, 0xb8, 0x84, 0x75, 0x74, 0xb0, 0x8e, 0x09, 0x65, 0x92, 0xb5, 0x0c,
+	0xa1, 0x6d, 0x3e, 0xb0, 0xcf, 0xae, 0x6a, 0x3b, 0x5f, 0xe1, 0x79, 0x7f, 0xfb, 0xda, 0x14, 0xaa,
+	0xac, 0xeb, 0x1a, 0xd5, 0xb5, 0x82, 0x75, 0x31, 0xac, 0xab, 0x4f, 0xd9, 0xd4, 0x0b, 0xff, 0x77,
+	0xff, 0xe8, 0x5d, 0x68, 0xa4, 0x51, 0x26, 0xec, 0x0b, 0x98, 0x37, 0xc2, 0xcf, 0x99, 0xea, 0x46,
+	0x59, 0xb4, 0xba, 0x7d, 0xb5, 0x9c, 0x28, 0x2b, 0xbe, 0x4e, 0x15, 0x77, 0xd9, 0x32, 0xd6, 0x2a,
+	0xf5, 0xc7, 0x75, 0xba, 0x48, 0x21, 0x9e, 0x6a, 0x78, 0xa1, 0xa9, 0x13, 0xa2, 0xb2, 0xab, 0xf9,
+	0x1d, 0xde, 0xa8, 0xed, 0xda, 0x14, 0xaa, 0xac, 0xee, 0x2a, 0x55, 0xb7, 0xcc, 0x2e, 0xea, 0xd5,
+	0xa5, 0xd1, 0x1f, 0x9c, 0xde, 0x27, 0xd1, 0xdf, 0x9e, 0x67, 0xd7, 0x52, 0xc6, 0x2a, 0x7b, 0x93,
+	0x3e, 0x65, 0x91, 0xe2, 0xc3, 0xf4, 0x4e, 0x97, 0xaa, 0x62, 0x8c, 0xe6, 0x4e, 0x7f, 0x7a, 0x9e,
+	0x1d, 0x42, 0x53, 0x7b, 0x17, 0x95, 0x5d, 0x9e, 0xfa, 0x86, 0xab, 0x6d, 0x97, 0x91, 0xca, 0xba,
+	0xa2, 0x97, 0xbf, 0x8e, 0xe7, 0x8a, 0x9f, 0x40, 0x23, 0x7d, 0x69, 0x93, 0xad, 0x68, 0x2f, 0x9f,
+	0xea, 0x2f, 0x83, 0xda, 0xdd, 0x22, 0x61, 0x0a, 0xf3, 0x19, 0x1d, 0x78, 0x0e, 0x4d, 0xed, 0x35,
+	0xcd, 0xb4, 0x03, 0xc5, 0x17, 0x3b, 0x6d, 0xbb, 0x8c, 0x24, 0xab, 0x58, 0xa4, 0x2a, 0x9a, 0xac,
+	0x41, 0xcc, 0x8d, 0x8f, 0x6d, 0xb2, 0x5d, 0xb8, 0x24, 0xd5, 0xa6, 0x43, 0xfe, 0x4d, 0xa6, 0xa1,
+	0xe4, 0xb9, 0xff, 0x3b, 0x16, 0xbb, 0x07, 0x75, 0xf5, 0x68, 0x2a, 0x5b, 0x2e, 0x7f, 0xfc, 0xd5,
+	0x5e, 0x29, 0xe0, 0x52, 0x58, 0x7f, 0x0a, 0x90, 0x3d, 0xdd, 0x99, 0x0a, 0x89, 0xc2, 0x53, 0xa0,
+	0xf6, 0xe5, 0x12, 0x8a, 0xec, 0xe0, 0x32, 0x75, 0xb0, 0xc3, 0x48, 0x42, 0x04, 0xfc, 0xa5, 0xba,
+	0x4e, 0xfc, 0x39, 0x34, 0xb5, 0xd7, 0x3b, 0xd3, 0xe1, 0x2b, 0xbe, 0xfc, 0x69, 0xdb, 0x65, 0x24,
+	0x59, 0xba, 0x4d, 0xa5, 0x5f, 0xc4, 0x19, 0x5a, 0xc0, 0x0a, 0xf0, 0x9e, 0xf0, 0x48, 0x16, 0x79,
+	0x02, 0xf3, 0xc6, 0x13, 0x9d, 0xe9, 0x0a, 0x2d, 0x7b, 0x00, 0xd4, 0xbe, 0x5a, 0x4e, 0x34, 0xf9,
+	0x0c, 0xeb, 0x59, 0xc4, 0x7a, 0xc4, 0x8d, 0x61, 0x55, 0xd3, 0x67, 0xd0, 0xd4, 0x9e, 0xdb, 0x4c,
+	0xfb, 0x52, 0x7c, 0xd9, 0xd3, 0xb6, 0xcb, 0x48, 0xb2, 0x8e, 0x8b, 0x54, 0x47, 0x1b, 0xeb, 0x20,
+	0x6e, 0x10, 0xef, 0xea, 0x7c, 0x01, 0x6d, 0xf3, 0x01, 0xce, 0x74, 0xed, 0x97, 0x3e, 0xe5, 0x69,
+	0x5f, 0x9b, 0x42, 0x35, 0x59, 0xfa, 0xf6, 0x52, 0x5a, 0xc3, 0xfa, 0x57, 0x32, 0x46, 0xf5, 0x6b,
+	0xf6, 0x23, 0x68, 0x08, 0xed, 0x11, 0x2b, 0x5e, 0x31, 0xf4, 0x49, 0x1e, 0x15, 0xd6, 0x4b, 0xe1,
+	0x41, 0x24, 0x93, 0x99, 0x45, 0xf3, 0x1f, 0xc2, 0x52, 0xca, 0xcc, 0xe9, 0x13, 0x4d, 0x71, 0xda,
+	0x87, 0xd2, 0x97, 0xa0, 0xec, 0x4e, 0x9e, 0x7a, 0xc7, 0x12, 0xdb, 0x1f, 0x3d, 0x9b, 0xa4, 0x6d,
+	0x7f, 0xfa, 0xcb, 0x4a, 0xf6, 0x72, 0x1e, 0x2e, 0xdf, 0xfe, 0x12, 0x1f, 0xcb, 0x08, 0x60, 0x21,
+	0x77, 0xd7, 0x2e, 0x5d, 0x5e, 0xe5, 0xd7, 0xa1, 0xed, 0xeb, 0xaf, 0xbf, 0xa2, 0x67, 0x8a, 0x22,
+	0x25, 0x4d, 0xd7, 0xd5, 0x4b, 0x01, 0x7f, 0x1d, 0x5a, 0xfa, 0x7b, 0x83, 0x4c, 0x97, 0x09, 0xf9,
+	0x9a, 0xae, 0x94, 0xd2, 0x4c, 0x2e, 0x61, 0x2d, 0xbd, 0x1a, 0xf6, 0x63, 0x58, 0x4e, 0x87, 0x59,
+	0xbf, 0xbe, 0x15, 0xb3, 0x1b, 0x25, 0x97, 0xba, 0x8c, 0xc1, 0xbe, 0x3c, 0xf5, 0xd6, 0xd7, 0x1d,
+	0x0b, 0xb9, 0xcf, 0x7c, 0xc8, 0x2d, 0xdb, 0x79, 0xca, 0xde, 0xaf, 0xb3, 0xaf, 0x4d, 0xa1, 0x9a,
+	0xdc, 0xc7, 0x96, 0x8c, 0x31, 0x12, 0x71, 0x42, 0xec, 0x33, 0x58, 0xd0, 0x2e, 0xc8, 0xe2, 0x23,
+	0x63, 0xe9, 0x4a, 0x2a, 0x3e, 0x52, 0x61, 0x97, 0xd9, 0x1c, 0x9c, 0x15, 0x2a, 0x7f, 0x11, 0x97,
+	0x90, 0x39, 0x3e, 0x9b, 0xd0, 0xd4, 0xca, 0x78, 0x5d, 0xb9, 0x2b, 0x1a, 0x49, 0x7f, 0x27, 0xe2,
+	0x8e, 0xc5, 0xa2, 0x92, 0xb7, 0x44, 0xae, 0x4f, 0x7b, 0x19, 0x43, 0x16, 0x77, 0x63, 0x2a, 0xfd,
+	0x35, 0x4a, 0x07, 0x8d, 0xca, 0x21, 0x7e, 0xc1, 0x86, 0xd0, 0xc9, 0x3f, 0x44, 0x90, 0xd6, 0x39,
+	0xe5, 0x15, 0x04, 0xfb, 0xca, 0x54, 0x7a, 0x3c, 0x2e, 0xec, 0x69, 0xf2, 0xf5, 0x86, 0xf5, 0x18,
+	0x4b, 0xde, 0x87, 0x05, 0xe3, 0x2f, 0x00, 0x84, 0x51, 0x5e, 0xd3, 0x30, 0xff, 0x32, 0x80, 0x7d,
+	0xa5, 0x9c, 0x4a, 0xed, 0xb8, 0x65, 0xdd, 0xb1, 0xd8, 0x3f, 0xc3, 0x97, 0xf1, 0xf5, 0xeb, 0xbf,
+	0x46, 0x5c, 0x61, 0x6e, 0xb0, 0xba, 0x3a, 0x4d, 0x1f, 0x7c, 0xc7, 0xa5, 0x56, 0xef, 0xde, 0xfe,
+	0xa1, 0x31, 0x44, 0x5f, 0x19, 0x26, 0xfe, 0xb5, 0xfc, 0x2b, 0xf9, 0x5f, 0xe7, 0x33, 0xe8, 0xcf,
+	0x06, 0x7d, 0x7d, 0xc7, 0x62, 0x7f, 0x68, 0x41, 0xdb, 0x74, 0x5e, 0xa5, 0xdd, 0x2d, 0x75, 0x93,
+	0xd9, 0xd7, 0xa6, 0x50, 0xe5, 0x5c, 0x7e, 0x46, 0xad, 0x7c, 0x7a, 0xdb, 0x35, 0x5a, 0x29, 0x9f,
+	0x45, 0xfc, 0x76, 0xad, 0x65, 0x1f, 0x8b, 0x3f, 0xb0, 0xa3, 0x1c, 0xd0, 0xac, 0xf8, 0xf7, 0x5d,
+	0xec, 0x25, 0x03, 0x13, 0x6d, 0xa2, 0x49, 0xf8, 0x1c, 0x16, 0xb4, 0x6f, 0x69, 0x65, 0x9d, 0xf7,
+	0x7b, 0xe7, 0x26, 0xf5, 0xe9, 0x3a, 0xf2, 0xcb, 0x65, 0xa3, 0x5b, 0x86, 0x32, 0xb4, 0x01, 0x4d,
+	0xed, 0x6f, 0x95, 0x64, 0xbb, 0x79, 0xe1, 0xef, 0x97, 0x4c, 0x6f, 0xe4, 0x08, 0x16, 0xb4, 0xec,
+	0xc6, 0xf2, 0x3f, 0x67, 0x31, 0xce, 0x6d, 0x6a, 0xeb, 0x4d, 0x6c, 0xeb, 0x8d, 0xa9, 0x6d, 0x5d,
+	0x17, 0x7f, 0x82, 0x65, 0x1f, 0x20, 0x0b, 0x16, 0x61, 0xb9, 0x60, 0x85, 0x54, 0x28, 0x16, 0xe3,
+	0x49, 0x0a, 0x32, 0x26, 0x0d, 0x6b, 0xf8, 0x89, 0x10, 0xf1, 0x8f, 0x54, 0x5a, 0xd7, 0x08, 0xcd,
+	0xa8, 0x0e, 0xdb, 0x2e, 0x23, 0x95, 0x09, 0xf8, 0xb4, 0xf0, 0x67, 0x30, 0xbf, 0x1b, 0x86, 0x2f,
+	0x26, 0x63, 0xd5, 0x62, 0x66, 0x7a, 0x87, 0x31, 0xf6, 0xc4, 0xce, 0xf5, 0xc2, 0x59, 0xa5, 0xa2,
+	0x6c, 0xd6, 0xd5, 0x8a, 0x5a, 0xff, 0x2a, 0x0b, 0x46, 0xf9, 0x9a, 0x79, 0xb0, 0x98, 0xee, 0x1b,
+	0x69, 0xc3, 0x6d, 0xb3, 0x18, 0x63, 0xb7, 0xc8, 0x57, 0x61, 0x1c, 0x5d, 0x54, 0x6b, 0xd7, 0x63,
+	0x55, 0xe6, 0x1d, 0x8b, 0xed, 0x43, 0x6b, 0x8b, 0xf7, 0xe9, 0x8a, 0x1f, 0xb9, 0x58, 0x97, 0xb2,
+	0x86, 0xa7, 0xbe, 0x59, 0x7b, 0xde, 0x00, 0xcd, 0xbd, 0x74, 0xec, 0x9d, 0x45, 0xfc, 0x67, 0xeb,
+	0x5f, 0x49, 0xe7, 0xed, 0xd7, 0x6a, 0x2f, 0x95, 0x3d, 0x37, 0xf7, 0xd2, 0x9c, 0x3b, 0xdc, 0xbe,
+	0x52, 0x4a, 0x2b, 0x1b, 0x6a, 0xe5, 0x5d, 0x67, 0x43, 0xf4, 0x5b, 0xe7, 0x3c, 0xe8, 0xe9, 0x36,
+	0x3a, 0xcd, 0xef, 0x6e, 0xaf, 0x4e, 0xcf, 0x60, 0xd6, 0x76, 0xdb, 0xac, 0xed, 0x00, 0xe6, 0xb7,
+	0xb8, 0x18, 0x2c, 0x71, 0xbf, 0x21, 0x77, 0x87, 0x5c, 0xbf, 0x3d, 0x61, 0x2f, 0x95, 0xd0, 0x4c,
+	0xad, 0x8b, 0x2e, 0x17, 0xb0, 0x9f, 0x40, 0xf3, 0x21, 0x4f, 0xd4, 0x85, 0x86, 0x54, 0xef, 0xcf,
+	0xdd, 0x70, 0xb0, 0x4b, 0xee, 0x43, 0x98, 0x3c, 0x43, 0xa5, 0xad, 0xe3, 0x0d, 0x09, 0x21, 0x9c,
+	0x7a, 0xfe, 0xe0, 0x6b, 0xf6, 0xd7, 0xa8, 0xf0, 0xf4, 0x36, 0xd7, 0xb2, 0x16, 0xa1, 0xae, 0x17,
+	0xbe, 0x90, 0xc3, 0xcb, 0x4a, 0x0e, 0xc2, 0x01, 0xd7, 0xf4, 0xcf, 0x00, 0x9a, 0xda, 0x05, 0xcc,
+	0x74, 0x01, 0x15, 0xef, 0xaa, 0xda, 0x76, 0x19, 0x49, 0x8e, 0xf3, 0x2d, 0xaa, 0xc7, 0x61, 0xab,
+	0x59, 0x3d, 0xe2, 0x8e, 0x66, 0x56, 0xd3, 0xfa, 0x57, 0xde, 0x28, 0xf9, 0x9a, 0x3d, 0xa7, 0xd7,
+	0x39, 0xf5, 0x4b, 0x1b, 0xd9, 0x41, 0x26, 0x7f, 0xbf, 0xc3, 0x66, 0x45, 0x92, 0x79, 0xb8, 0x11,
+	0x55, 0x91, 0x76, 0xf9, 0x3d, 0x00, 0xbc, 0x10, 0xb0, 0xe5, 0xf1, 0x51, 0x18, 0x64, 0xb2, 0x36,
+	0xbb, 0x32, 0x60, 0x2f, 0x19, 0x98, 0x3c, 0x6e, 0x3d, 0xd7, 0x4e, 0x7e, 0xfa, 0x14, 0x33, 0xc5,
+	0x5c, 0x53, 0x6f, 0x15, 0xd8, 0x76, 0x59, 0x8e, 0x54, 0x73, 0xd9, 0x00, 0xc8, 0x42, 0x28, 0xd2,
+	0x73, 0x5c, 0x21, 0x3a, 0xc3, 0xbe, 0x5c, 0x42, 0x91, 0x6d, 0xdb, 0x87, 0x46, 0xe6, 0x6f, 0x5f,
+	0xc9, 0x2e, 0x77, 0x1b, 0xde, 0x79, 0xbb, 0x5b, 0x24, 0xc8, 0x59, 0xe9, 0xd0, 0x50, 0x01, 0xab,
+	0x93, 0xd2, 0xc1, 0x79, 0xcc, 0x7c, 0x58, 0x12, 0x0d, 0x4c, 0x55, 0x38, 0x0a, 0x77, 0x4f, 0x1f,
+	0x74, 0x2d, 0x7a, 0xa2, 0xed, 0x2b, 0xa5, 0xb4, 0x29, 0xe6, 0x28, 0x64, 0x58, 0x79, 0x8d, 0x69,
+	0x04, 0x8b, 0x05, 0x1f, 0x62, 0xba, 0xa4, 0xa7, 0xb9, 0x75, 0xed, 0xd5, 0xe9, 0x19, 0x64, 0x95,
+	0x97, 0xa8, 0xca, 0x05, 0xac, 0x12, 0xb0, 0xca, 0xf8, 0xa5, 0x8f, 0x4a, 0x1b, 0x46, 0xd7, 0x97,
+	0xb8, 0xfc, 0xd8, 0x77, 0x94, 0x25, 0x63, 0xaa, 0x3b, 0xd0, 0x2e, 0xf5, 0x08, 0x39, 0x07, 0x54,
+	0xcf, 0x63, 0xf6, 0x49, 0x4e, 0x43, 0x44, 0xa2, 0x5c, 0x99, 0xaf, 0x55, 0x2a, 0x4a, 0x35, 0x8a,
+	0x9f, 0xc1, 0x8a, 0x68, 0xc8, 0xc6, 0x70, 0x98, 0xf3, 0x56, 0x5d, 0x2f, 0xfc, 0x8d, 0x4d, 0xc3,
+	0x0b, 0x67, 0x4f, 0xff, 0x1b, 0x9c, 0x53, 0x54, 0x7c, 0xd1, 0x54, 0x36, 0x81, 0x4e, 0xde, 0x03,
+	0xc4, 0xa6, 0x97, 0x95, 0x2a, 0xcf, 0x53, 0xbd, 0x46, 0xbf, 0x4a, 0x95, 0xdd, 0xc0, 0xf1, 0xb7,
+	0xcb, 0x86, 0x46, 0x1c, 0xd3, 0xd9, 0xdf, 0x4c, 0xdd, 0x55, 0xb9, 0x7e, 0xde, 0x48, 0x9f, 0x5c,
+	0x2b, 0xf7, 0xaf, 0xd9, 0x57, 0xcd, 0x0c, 0xb9, 0xea, 0xdf, 0xa2, 0xea, 0x57, 0xb1, 0xfa, 0x2b,
+	0x65, 0xd5, 0x47, 0xe2, 0x2b, 0xf6, 0x19, 0xac, 0xe4, 0xd7, 0xb5, 0x6a, 0xc1, 0x6a, 0xd9, 0x7c,
+	0x4f, 0x3d, 0x9f, 0xe5, 0xc6, 0xfa, 0x02, 0xe9, 0x76, 0x2d, 0xdd, 0xf9, 0x94, 0x2e, 0x9f, 0x12,
+	0x3f, 0x98, 0x7d, 0xa5, 0x94, 0x36, 0x45, 0xaf, 0x51, 0xae, 0x2a, 0x16, 0xc1, 0x42, 0xce, 0xa7,
+	0x94, 0x1e, 0x95, 0xcb, 0x5d, 0x58, 0xf6, 0xf5, 0x69, 0x64, 0x59, 0x95, 0xb1, 0x13, 0xa8, 0x7a,
+	0xd6, 0x75, 0xa7, 0xdb, 0x17, 0xa2, 0x4e, 0xcd, 0x57, 0x63, 0xd4, 0x59, 0xf4, 0xee, 0xd8, 0xd7,
+	0xa7, 0x91, 0x65, 0x9d, 0x86, 0x25, 0x32, 0xad, 0xd3, 0x1f, 0xc4, 0xec, 0x25, 0x74, 0xf2, 0xbe,
+	0x99, 0x74, 0x01, 0x4c, 0xf1, 0xf8, 0xd8, 0x37, 0xa6, 0xd2, 0x65, 0x75, 0x0e, 0x55, 0x77, 0xf5,
+	0xb6, 0x6d, 0x54, 0xf7, 0x95, 0xe6, 0x13, 0xfa, 0x9a, 0x7d, 0x0e, 0xf3, 0x86, 0x8f, 0x24, 0x35,
+	0x50, 0x95, 0x39, 0x7d, 0xec, 0xab, 0xe5, 0xc4, 0x32, 0x55, 0x66, 0x70, 0xb8, 0x1e, 0x23, 0xf5,
+	0xfe, 0xb5, 0xcf, 0xae, 0x1c, 0xfb, 0xc9, 0xc9, 0xe4, 0x70, 0xad, 0x1f, 0x8e, 0xd6, 0xef, 0x3f,
+	0xdd, 0x7c, 0xb8, 0xff, 0x6c, 0x7d, 0x18, 0x0c, 0xd6, 0xa9, 0xa8, 0xc3, 0x59, 0xfa, 0x2b, 0xce,
+	0xef, 0xff, 0xdf, 0x01, 0x00, 0xc9, 0xc3, 0x6d, 0xec, 0xf7, 0x79, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -9987,6 +11536,11 @@ type LightningClient interface {
 	//* lncli: `listpeers`
 	//ListPeers returns a verbose listing of all currently active peers.
 	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error)
+	//* lncli: `subscribepeerevents`
+	//SubscribePeerEvents creates a uni-directional stream from the server to
+	//the client in which any events relevant to the state of peers are sent
+	//over. Events include peers going online and offline.
+	SubscribePeerEvents(ctx context.Context, in *PeerEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribePeerEventsClient, error)
 	//* lncli: `getinfo`
 	//GetInfo returns general information concerning the lightning node including
 	//it's identity pubkey, alias, the chains it is connected to, and information
@@ -10025,6 +11579,23 @@ type LightningClient interface {
 	//rate to us for the funding transaction. If neither are specified, then a
 	//lax block confirmation target is used.
 	OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error)
+	//* lncli: `batchopenchannel`
+	//BatchOpenChannel attempts to open multiple singly funded channels to remote
+	//peers, all funded by a single funding transaction that creates one output
+	//per channel. The funding transaction is only published once every remote
+	//peer has sent its signature for our commitment transaction. If any of the
+	//channels fails to be opened, all channels of the batch are canceled.
+	BatchOpenChannel(ctx context.Context, in *BatchOpenChannelRequest, opts ...grpc.CallOption) (*BatchOpenChannelResponse, error)
+	//* lncli: `fundingstatestep`
+	//FundingStateStep is an advanced funding related call that allows the caller
+	//to step through the funding workflow of a channel that was opened with
+	//psbt_funding set. Once the OpenChannel stream delivers the psbt_fund
+	//update, the caller assembles and signs a transaction that creates the
+	//returned funding output, and passes it back as a finalized PSBT. lnd then
+	//continues the funding workflow with the remote peer, and only publishes the
+	//funding transaction once the remote peer's signature for our commitment
+	//transaction has been stored.
+	FundingStateStep(ctx context.Context, in *FundingStateStepRequest, opts ...grpc.CallOption) (*FundingStateStepResp, error)
 	//*
 	//ChannelAcceptor dispatches a bi-directional streaming RPC in which
 	//OpenChannel requests are sent to the client and the client responds with
@@ -10212,6 +11783,27 @@ type LightningClient interface {
 	//ups, but the updated set of encrypted multi-chan backups with the closed
 	//channel(s) removed.
 	SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error)
+	//* lncli: `bakemacaroon`
+	//BakeMacaroon allows the creation of a new macaroon with custom read and
+	//write permissions. No first-party caveats are added since this can be done
+	//offline.
+	BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error)
+	//* lncli: `listpermissions`
+	//ListPermissions lists all RPC method URIs and their required macaroon
+	//permissions to access them.
+	ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
+	//* lncli: `listmacaroonids`
+	//ListMacaroonIDs returns all root key IDs that are in use.
+	ListMacaroonIDs(ctx context.Context, in *ListMacaroonIDsRequest, opts ...grpc.CallOption) (*ListMacaroonIDsResponse, error)
+	//* lncli: `deletemacaroonid`
+	//DeleteMacaroonID deletes the specified macaroon ID and invalidates all
+	//macaroons derived from the key with that ID.
+	DeleteMacaroonID(ctx context.Context, in *DeleteMacaroonIDRequest, opts ...grpc.CallOption) (*DeleteMacaroonIDResponse, error)
+	//* lncli: `dbstats`
+	//DatabaseStats returns the size of the channel database on disk along with
+	//statistics for each of its top-level buckets, which indicate what is
+	//taking up the space within the database.
+	DatabaseStats(ctx context.Context, in *DatabaseStatsRequest, opts ...grpc.CallOption) (*DatabaseStatsResponse, error)
 }
 
 type lightningClient struct {
@@ -10371,6 +11963,38 @@ func (c *lightningClient) ListPeers(ctx context.Context, in *ListPeersRequest, o
 	return out, nil
 }
 
+func (c *lightningClient) SubscribePeerEvents(ctx context.Context, in *PeerEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribePeerEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[1], "/lnrpc.Lightning/SubscribePeerEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribePeerEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribePeerEventsClient interface {
+	Recv() (*PeerEvent, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribePeerEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribePeerEventsClient) Recv() (*PeerEvent, error) {
+	m := new(PeerEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *lightningClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
 	out := new(GetInfoResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetInfo", in, out, opts...)
@@ -10399,7 +12023,7 @@ func (c *lightningClient) ListChannels(ctx context.Context, in *ListChannelsRequ
 }
 
 func (c *lightningClient) SubscribeChannelEvents(ctx context.Context, in *ChannelEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[1], "/lnrpc.Lightning/SubscribeChannelEvents", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[2], "/lnrpc.Lightning/SubscribeChannelEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -10449,7 +12073,7 @@ func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRe
 }
 
 func (c *lightningClient) OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[2], "/lnrpc.Lightning/OpenChannel", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[3], "/lnrpc.Lightning/OpenChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -10480,8 +12104,26 @@ func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
 	return m, nil
 }
 
+func (c *lightningClient) BatchOpenChannel(ctx context.Context, in *BatchOpenChannelRequest, opts ...grpc.CallOption) (*BatchOpenChannelResponse, error) {
+	out := new(BatchOpenChannelResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/BatchOpenChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) FundingStateStep(ctx context.Context, in *FundingStateStepRequest, opts ...grpc.CallOption) (*FundingStateStepResp, error) {
+	out := new(FundingStateStepResp)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/FundingStateStep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ChannelAcceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_ChannelAcceptorClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[3], "/lnrpc.Lightning/ChannelAcceptor", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[4], "/lnrpc.Lightning/ChannelAcceptor", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -10512,7 +12154,7 @@ func (x *lightningChannelAcceptorClient) Recv() (*ChannelAcceptRequest, error) {
 }
 
 func (c *lightningClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[4], "/lnrpc.Lightning/CloseChannel", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[5], "/lnrpc.Lightning/CloseChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -10553,7 +12195,7 @@ func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannel
 }
 
 func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[5], "/lnrpc.Lightning/SendPayment", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[6], "/lnrpc.Lightning/SendPayment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -10593,7 +12235,7 @@ func (c *lightningClient) SendPaymentSync(ctx context.Context, in *SendRequest,
 }
 
 func (c *lightningClient) SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[6], "/lnrpc.Lightning/SendToRoute", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[7], "/lnrpc.Lightning/SendToRoute", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -10660,7 +12302,7 @@ func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, op
 }
 
 func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[7], "/lnrpc.Lightning/SubscribeInvoices", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[8], "/lnrpc.Lightning/SubscribeInvoices", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -10773,7 +12415,7 @@ func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts
 }
 
 func (c *lightningClient) SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[8], "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[9], "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -10877,7 +12519,7 @@ func (c *lightningClient) RestoreChannelBackups(ctx context.Context, in *Restore
 }
 
 func (c *lightningClient) SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[9], "/lnrpc.Lightning/SubscribeChannelBackups", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[10], "/lnrpc.Lightning/SubscribeChannelBackups", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -10908,6 +12550,51 @@ func (x *lightningSubscribeChannelBackupsClient) Recv() (*ChanBackupSnapshot, er
 	return m, nil
 }
 
+func (c *lightningClient) BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error) {
+	out := new(BakeMacaroonResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/BakeMacaroon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error) {
+	out := new(ListPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListMacaroonIDs(ctx context.Context, in *ListMacaroonIDsRequest, opts ...grpc.CallOption) (*ListMacaroonIDsResponse, error) {
+	out := new(ListMacaroonIDsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListMacaroonIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DeleteMacaroonID(ctx context.Context, in *DeleteMacaroonIDRequest, opts ...grpc.CallOption) (*DeleteMacaroonIDResponse, error) {
+	out := new(DeleteMacaroonIDResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DeleteMacaroonID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DatabaseStats(ctx context.Context, in *DatabaseStatsRequest, opts ...grpc.CallOption) (*DatabaseStatsResponse, error) {
+	out := new(DatabaseStatsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DatabaseStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LightningServer is the server API for Lightning service.
 type LightningServer interface {
 	//* lncli: `walletbalance`
@@ -10976,6 +12663,11 @@ type LightningServer interface {
 	//* lncli: `listpeers`
 	//ListPeers returns a verbose listing of all currently active peers.
 	ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error)
+	//* lncli: `subscribepeerevents`
+	//SubscribePeerEvents creates a uni-directional stream from the server to
+	//the client in which any events relevant to the state of peers are sent
+	//over. Events include peers going online and offline.
+	SubscribePeerEvents(*PeerEventSubscription, Lightning_SubscribePeerEventsServer) error
 	//* lncli: `getinfo`
 	//GetInfo returns general information concerning the lightning node including
 	//it's identity pubkey, alias, the chains it is connected to, and information
@@ -11014,6 +12706,23 @@ type LightningServer interface {
 	//rate to us for the funding transaction. If neither are specified, then a
 	//lax block confirmation target is used.
 	OpenChannel(*OpenChannelRequest, Lightning_OpenChannelServer) error
+	//* lncli: `batchopenchannel`
+	//BatchOpenChannel attempts to open multiple singly funded channels to remote
+	//peers, all funded by a single funding transaction that creates one output
+	//per channel. The funding transaction is only published once every remote
+	//peer has sent its signature for our commitment transaction. If any of the
+	//channels fails to be opened, all channels of the batch are canceled.
+	BatchOpenChannel(context.Context, *BatchOpenChannelRequest) (*BatchOpenChannelResponse, error)
+	//* lncli: `fundingstatestep`
+	//FundingStateStep is an advanced funding related call that allows the caller
+	//to step through the funding workflow of a channel that was opened with
+	//psbt_funding set. Once the OpenChannel stream delivers the psbt_fund
+	//update, the caller assembles and signs a transaction that creates the
+	//returned funding output, and passes it back as a finalized PSBT. lnd then
+	//continues the funding workflow with the remote peer, and only publishes the
+	//funding transaction once the remote peer's signature for our commitment
+	//transaction has been stored.
+	FundingStateStep(context.Context, *FundingStateStepRequest) (*FundingStateStepResp, error)
 	//*
 	//ChannelAcceptor dispatches a bi-directional streaming RPC in which
 	//OpenChannel requests are sent to the client and the client responds with
@@ -11201,6 +12910,27 @@ type LightningServer interface {
 	//ups, but the updated set of encrypted multi-chan backups with the closed
 	//channel(s) removed.
 	SubscribeChannelBackups(*ChannelBackupSubscription, Lightning_SubscribeChannelBackupsServer) error
+	//* lncli: `bakemacaroon`
+	//BakeMacaroon allows the creation of a new macaroon with custom read and
+	//write permissions. No first-party caveats are added since this can be done
+	//offline.
+	BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error)
+	//* lncli: `listpermissions`
+	//ListPermissions lists all RPC method URIs and their required macaroon
+	//permissions to access them.
+	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
+	//* lncli: `listmacaroonids`
+	//ListMacaroonIDs returns all root key IDs that are in use.
+	ListMacaroonIDs(context.Context, *ListMacaroonIDsRequest) (*ListMacaroonIDsResponse, error)
+	//* lncli: `deletemacaroonid`
+	//DeleteMacaroonID deletes the specified macaroon ID and invalidates all
+	//macaroons derived from the key with that ID.
+	DeleteMacaroonID(context.Context, *DeleteMacaroonIDRequest) (*DeleteMacaroonIDResponse, error)
+	//* lncli: `dbstats`
+	//DatabaseStats returns the size of the channel database on disk along with
+	//statistics for each of its top-level buckets, which indicate what is
+	//taking up the space within the database.
+	DatabaseStats(context.Context, *DatabaseStatsRequest) (*DatabaseStatsResponse, error)
 }
 
 func RegisterLightningServer(s *grpc.Server, srv LightningServer) {
@@ -11462,6 +13192,27 @@ func _Lightning_ListPeers_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_SubscribePeerEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PeerEventSubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribePeerEvents(m, &lightningSubscribePeerEventsServer{stream})
+}
+
+type Lightning_SubscribePeerEventsServer interface {
+	Send(*PeerEvent) error
+	grpc.ServerStream
+}
+
+type lightningSubscribePeerEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribePeerEventsServer) Send(m *PeerEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Lightning_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInfoRequest)
 	if err := dec(in); err != nil {
@@ -11594,6 +13345,42 @@ func (x *lightningOpenChannelServer) Send(m *OpenStatusUpdate) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_BatchOpenChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchOpenChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BatchOpenChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/BatchOpenChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).BatchOpenChannel(ctx, req.(*BatchOpenChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_FundingStateStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundingStateStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).FundingStateStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/FundingStateStep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).FundingStateStep(ctx, req.(*FundingStateStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ChannelAcceptor_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(LightningServer).ChannelAcceptor(&lightningChannelAcceptorServer{stream})
 }
@@ -12170,6 +13957,96 @@ func (x *lightningSubscribeChannelBackupsServer) Send(m *ChanBackupSnapshot) err
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_BakeMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BakeMacaroonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BakeMacaroon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/BakeMacaroon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).BakeMacaroon(ctx, req.(*BakeMacaroonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListPermissions(ctx, req.(*ListPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListMacaroonIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMacaroonIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListMacaroonIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListMacaroonIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListMacaroonIDs(ctx, req.(*ListMacaroonIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_DeleteMacaroonID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMacaroonIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).DeleteMacaroonID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/DeleteMacaroonID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).DeleteMacaroonID(ctx, req.(*DeleteMacaroonIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_DatabaseStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DatabaseStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).DatabaseStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/DatabaseStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).DatabaseStats(ctx, req.(*DatabaseStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Lightning_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lnrpc.Lightning",
 	HandlerType: (*LightningServer)(nil),
@@ -12246,6 +14123,14 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OpenChannelSync",
 			Handler:    _Lightning_OpenChannelSync_Handler,
 		},
+		{
+			MethodName: "BatchOpenChannel",
+			Handler:    _Lightning_BatchOpenChannel_Handler,
+		},
+		{
+			MethodName: "FundingStateStep",
+			Handler:    _Lightning_FundingStateStep_Handler,
+		},
 		{
 			MethodName: "AbandonChannel",
 			Handler:    _Lightning_AbandonChannel_Handler,
@@ -12338,6 +14223,26 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RestoreChannelBackups",
 			Handler:    _Lightning_RestoreChannelBackups_Handler,
 		},
+		{
+			MethodName: "BakeMacaroon",
+			Handler:    _Lightning_BakeMacaroon_Handler,
+		},
+		{
+			MethodName: "ListPermissions",
+			Handler:    _Lightning_ListPermissions_Handler,
+		},
+		{
+			MethodName: "ListMacaroonIDs",
+			Handler:    _Lightning_ListMacaroonIDs_Handler,
+		},
+		{
+			MethodName: "DeleteMacaroonID",
+			Handler:    _Lightning_DeleteMacaroonID_Handler,
+		},
+		{
+			MethodName: "DatabaseStats",
+			Handler:    _Lightning_DatabaseStats_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -12345,6 +14250,11 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeTransactions_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribePeerEvents",
+			Handler:       _Lightning_SubscribePeerEvents_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeChannelEvents",
 			Handler:       _Lightning_SubscribeChannelEvents_Handler,
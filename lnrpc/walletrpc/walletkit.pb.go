@@ -85,6 +85,15 @@ const (
 	//A witness type that allows us to sweep an output that sends to a nested P2SH
 	//script that pays to a key solely under our control.
 	WitnessType_NESTED_WITNESS_KEY_HASH WitnessType = 12
+	//
+	//A witness type that allows us to spend our output on the remote party's
+	//commitment transaction after a relative lock-time of one block. This is
+	//used for the to_remote output of anchor commitments.
+	WitnessType_COMMITMENT_TO_REMOTE_CONFIRMED WitnessType = 13
+	//
+	//A witness type that allows us to spend our anchor output on the commitment
+	//transaction.
+	WitnessType_COMMITMENT_ANCHOR WitnessType = 14
 )
 
 var WitnessType_name = map[int32]string{
@@ -101,6 +110,8 @@ var WitnessType_name = map[int32]string{
 	10: "HTLC_SECOND_LEVEL_REVOKE",
 	11: "WITNESS_KEY_HASH",
 	12: "NESTED_WITNESS_KEY_HASH",
+	13: "COMMITMENT_TO_REMOTE_CONFIRMED",
+	14: "COMMITMENT_ANCHOR",
 }
 
 var WitnessType_value = map[string]int32{
@@ -117,6 +128,8 @@ var WitnessType_value = map[string]int32{
 	"HTLC_SECOND_LEVEL_REVOKE":           10,
 	"WITNESS_KEY_HASH":                   11,
 	"NESTED_WITNESS_KEY_HASH":            12,
+	"COMMITMENT_TO_REMOTE_CONFIRMED":     13,
+	"COMMITMENT_ANCHOR":                  14,
 }
 
 func (x WitnessType) String() string {
@@ -768,6 +781,189 @@ func (m *BumpFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BumpFeeResponse proto.InternalMessageInfo
 
+type LeaseOutputRequest struct {
+	//
+	//An ID of 32 random bytes that must be unique for each distinct application
+	//using this RPC which will be used to bound the output lease to.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The identifying outpoint of the output being leased.
+	Outpoint *lnrpc.OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	//
+	//The number of seconds the output should be leased for. If not set, the
+	//output is leased for 10 minutes.
+	ExpirationSeconds    uint64   `protobuf:"varint,3,opt,name=expiration_seconds,proto3" json:"expiration_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaseOutputRequest) Reset()         { *m = LeaseOutputRequest{} }
+func (m *LeaseOutputRequest) String() string { return proto.CompactTextString(m) }
+func (*LeaseOutputRequest) ProtoMessage()    {}
+func (*LeaseOutputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{14}
+}
+
+func (m *LeaseOutputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaseOutputRequest.Unmarshal(m, b)
+}
+func (m *LeaseOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaseOutputRequest.Marshal(b, m, deterministic)
+}
+func (m *LeaseOutputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseOutputRequest.Merge(m, src)
+}
+func (m *LeaseOutputRequest) XXX_Size() int {
+	return xxx_messageInfo_LeaseOutputRequest.Size(m)
+}
+func (m *LeaseOutputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseOutputRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseOutputRequest proto.InternalMessageInfo
+
+func (m *LeaseOutputRequest) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *LeaseOutputRequest) GetOutpoint() *lnrpc.OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *LeaseOutputRequest) GetExpirationSeconds() uint64 {
+	if m != nil {
+		return m.ExpirationSeconds
+	}
+	return 0
+}
+
+type LeaseOutputResponse struct {
+	//
+	//The absolute expiration of the output lease represented as a unix timestamp.
+	Expiration           uint64   `protobuf:"varint,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaseOutputResponse) Reset()         { *m = LeaseOutputResponse{} }
+func (m *LeaseOutputResponse) String() string { return proto.CompactTextString(m) }
+func (*LeaseOutputResponse) ProtoMessage()    {}
+func (*LeaseOutputResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{15}
+}
+
+func (m *LeaseOutputResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaseOutputResponse.Unmarshal(m, b)
+}
+func (m *LeaseOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaseOutputResponse.Marshal(b, m, deterministic)
+}
+func (m *LeaseOutputResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseOutputResponse.Merge(m, src)
+}
+func (m *LeaseOutputResponse) XXX_Size() int {
+	return xxx_messageInfo_LeaseOutputResponse.Size(m)
+}
+func (m *LeaseOutputResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseOutputResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseOutputResponse proto.InternalMessageInfo
+
+func (m *LeaseOutputResponse) GetExpiration() uint64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+type ReleaseOutputRequest struct {
+	// The unique ID that was used to lock the output.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The identifying outpoint of the output being released.
+	Outpoint             *lnrpc.OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReleaseOutputRequest) Reset()         { *m = ReleaseOutputRequest{} }
+func (m *ReleaseOutputRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseOutputRequest) ProtoMessage()    {}
+func (*ReleaseOutputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{16}
+}
+
+func (m *ReleaseOutputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseOutputRequest.Unmarshal(m, b)
+}
+func (m *ReleaseOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseOutputRequest.Marshal(b, m, deterministic)
+}
+func (m *ReleaseOutputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseOutputRequest.Merge(m, src)
+}
+func (m *ReleaseOutputRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseOutputRequest.Size(m)
+}
+func (m *ReleaseOutputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseOutputRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseOutputRequest proto.InternalMessageInfo
+
+func (m *ReleaseOutputRequest) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ReleaseOutputRequest) GetOutpoint() *lnrpc.OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+type ReleaseOutputResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseOutputResponse) Reset()         { *m = ReleaseOutputResponse{} }
+func (m *ReleaseOutputResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseOutputResponse) ProtoMessage()    {}
+func (*ReleaseOutputResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{17}
+}
+
+func (m *ReleaseOutputResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseOutputResponse.Unmarshal(m, b)
+}
+func (m *ReleaseOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseOutputResponse.Marshal(b, m, deterministic)
+}
+func (m *ReleaseOutputResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseOutputResponse.Merge(m, src)
+}
+func (m *ReleaseOutputResponse) XXX_Size() int {
+	return xxx_messageInfo_ReleaseOutputResponse.Size(m)
+}
+func (m *ReleaseOutputResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseOutputResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseOutputResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("walletrpc.WitnessType", WitnessType_name, WitnessType_value)
 	proto.RegisterType((*KeyReq)(nil), "walletrpc.KeyReq")
@@ -784,73 +980,86 @@ func init() {
 	proto.RegisterType((*PendingSweepsResponse)(nil), "walletrpc.PendingSweepsResponse")
 	proto.RegisterType((*BumpFeeRequest)(nil), "walletrpc.BumpFeeRequest")
 	proto.RegisterType((*BumpFeeResponse)(nil), "walletrpc.BumpFeeResponse")
+	proto.RegisterType((*LeaseOutputRequest)(nil), "walletrpc.LeaseOutputRequest")
+	proto.RegisterType((*LeaseOutputResponse)(nil), "walletrpc.LeaseOutputResponse")
+	proto.RegisterType((*ReleaseOutputRequest)(nil), "walletrpc.ReleaseOutputRequest")
+	proto.RegisterType((*ReleaseOutputResponse)(nil), "walletrpc.ReleaseOutputResponse")
 }
 
 func init() { proto.RegisterFile("walletrpc/walletkit.proto", fileDescriptor_6cc6942ac78249e5) }
 
 var fileDescriptor_6cc6942ac78249e5 = []byte{
-	// 976 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xed, 0x6e, 0xe2, 0x46,
-	0x14, 0x2d, 0x21, 0x61, 0xc3, 0x05, 0x12, 0x67, 0x08, 0x89, 0x97, 0xcd, 0x6e, 0xa8, 0xfb, 0x21,
-	0xd4, 0x56, 0xa0, 0x66, 0xdb, 0xaa, 0x6a, 0x7f, 0xb4, 0x59, 0x70, 0x44, 0xc4, 0x87, 0xa9, 0xed,
-	0x6c, 0xba, 0x55, 0xa5, 0x91, 0x81, 0x59, 0xb0, 0x00, 0xdb, 0x3b, 0x1e, 0x0a, 0xfc, 0x6d, 0x9f,
-	0xa4, 0xaf, 0xd1, 0xa7, 0xab, 0x3c, 0xb6, 0xc9, 0x18, 0x92, 0x4a, 0xfd, 0x15, 0xe7, 0x9c, 0x73,
-	0xcf, 0xdc, 0xb9, 0x33, 0x73, 0x04, 0x3c, 0x5f, 0x5a, 0xb3, 0x19, 0x61, 0xd4, 0x1b, 0xd6, 0xc3,
-	0xaf, 0xa9, 0xcd, 0x6a, 0x1e, 0x75, 0x99, 0x8b, 0xb2, 0x1b, 0xaa, 0x9c, 0xa5, 0xde, 0x30, 0x44,
-	0xcb, 0xa7, 0xbe, 0x3d, 0x76, 0x02, 0x79, 0xf0, 0x97, 0xd0, 0x10, 0x55, 0x7e, 0x81, 0x4c, 0x9b,
-	0xac, 0x75, 0xf2, 0x01, 0x55, 0x41, 0x9a, 0x92, 0x35, 0x7e, 0x6f, 0x3b, 0x63, 0x42, 0xb1, 0x47,
-	0x6d, 0x87, 0xc9, 0xa9, 0x4a, 0xaa, 0x7a, 0xa0, 0x1f, 0x4d, 0xc9, 0xfa, 0x86, 0xc3, 0xfd, 0x00,
-	0x45, 0x2f, 0x01, 0xb8, 0xd2, 0x9a, 0xdb, 0xb3, 0xb5, 0xbc, 0xc7, 0x35, 0xd9, 0x40, 0xc3, 0x01,
-	0xa5, 0x00, 0xb9, 0xeb, 0xd1, 0x88, 0xea, 0xe4, 0xc3, 0x82, 0xf8, 0x4c, 0x51, 0x20, 0x1f, 0xfe,
-	0xeb, 0x7b, 0xae, 0xe3, 0x13, 0x84, 0x60, 0xdf, 0x1a, 0x8d, 0x28, 0xf7, 0xce, 0xea, 0xfc, 0x5b,
-	0xf9, 0x14, 0x72, 0x26, 0xb5, 0x1c, 0xdf, 0x1a, 0x32, 0xdb, 0x75, 0x50, 0x09, 0x32, 0x6c, 0x85,
-	0x27, 0x64, 0xc5, 0x45, 0x79, 0xfd, 0x80, 0xad, 0x5a, 0x64, 0xa5, 0x7c, 0x07, 0xc7, 0xfd, 0xc5,
-	0x60, 0x66, 0xfb, 0x93, 0x8d, 0xd9, 0x27, 0x50, 0xf0, 0x42, 0x08, 0x13, 0x4a, 0xdd, 0xd8, 0x35,
-	0x1f, 0x81, 0x6a, 0x80, 0x29, 0xbf, 0x03, 0x32, 0x88, 0x33, 0xd2, 0x16, 0xcc, 0x5b, 0x30, 0x3f,
-	0xea, 0x0b, 0x5d, 0x00, 0xf8, 0x16, 0xc3, 0x1e, 0xa1, 0x78, 0xba, 0xe4, 0x75, 0x69, 0xfd, 0xd0,
-	0xb7, 0x58, 0x9f, 0xd0, 0xf6, 0x12, 0x55, 0xe1, 0x99, 0x1b, 0xea, 0xe5, 0xbd, 0x4a, 0xba, 0x9a,
-	0xbb, 0x3a, 0xaa, 0x45, 0xf3, 0xab, 0x99, 0x2b, 0x6d, 0xc1, 0xf4, 0x98, 0x56, 0xbe, 0x82, 0x62,
-	0xc2, 0x3d, 0xea, 0xac, 0x04, 0x19, 0x6a, 0x2d, 0x31, 0xdb, 0xec, 0x81, 0x5a, 0x4b, 0x73, 0xa5,
-	0x7c, 0x0b, 0x48, 0xf5, 0x99, 0x3d, 0xb7, 0x18, 0xb9, 0x21, 0x24, 0xee, 0xe5, 0x12, 0x72, 0x43,
-	0xd7, 0x79, 0x8f, 0x99, 0x45, 0xc7, 0x24, 0x1e, 0x3b, 0x04, 0x90, 0xc9, 0x11, 0xe5, 0x35, 0x14,
-	0x13, 0x65, 0xd1, 0x22, 0xff, 0xb9, 0x07, 0xe5, 0xef, 0x3d, 0xc8, 0xf7, 0x89, 0x33, 0xb2, 0x9d,
-	0xb1, 0xb1, 0x24, 0xc4, 0x43, 0x5f, 0xc2, 0x61, 0xd0, 0xb5, 0x1b, 0x1f, 0x6d, 0xee, 0xea, 0xb8,
-	0x36, 0xe3, 0x7b, 0xd2, 0x16, 0xac, 0x1f, 0xc0, 0xfa, 0x46, 0x80, 0x7e, 0x80, 0xfc, 0xd2, 0x66,
-	0x0e, 0xf1, 0x7d, 0xcc, 0xd6, 0x1e, 0xe1, 0xe7, 0x7c, 0x74, 0x75, 0x56, 0xdb, 0x5c, 0xae, 0xda,
-	0x7d, 0x48, 0x9b, 0x6b, 0x8f, 0xe8, 0x09, 0x2d, 0x7a, 0x05, 0x60, 0xcd, 0xdd, 0x85, 0xc3, 0xb0,
-	0x6f, 0x31, 0x39, 0x5d, 0x49, 0x55, 0x0b, 0xba, 0x80, 0x20, 0x05, 0xf2, 0x71, 0xdf, 0x83, 0x35,
-	0x23, 0xf2, 0x3e, 0x57, 0x24, 0x30, 0x54, 0x03, 0x34, 0xa0, 0xae, 0x35, 0x1a, 0x5a, 0x3e, 0xc3,
-	0x16, 0x63, 0x64, 0xee, 0x31, 0x5f, 0x3e, 0xe0, 0xca, 0x47, 0x18, 0xf4, 0x0d, 0x94, 0x1c, 0xb2,
-	0x62, 0xf8, 0x81, 0x9a, 0x10, 0x7b, 0x3c, 0x61, 0x72, 0x86, 0x97, 0x3c, 0x4e, 0x2a, 0x67, 0x70,
-	0x2a, 0x8e, 0x28, 0xbe, 0x1d, 0xca, 0xaf, 0x50, 0xda, 0xc2, 0xa3, 0x91, 0xff, 0x04, 0x47, 0x5e,
-	0x48, 0x60, 0x9f, 0x33, 0x72, 0x8a, 0xdf, 0x8f, 0x73, 0x61, 0x30, 0x62, 0xa5, 0xbe, 0x25, 0x57,
-	0xfe, 0x4a, 0xc1, 0xd1, 0x9b, 0xc5, 0xdc, 0x13, 0x8e, 0xff, 0x7f, 0x9d, 0x4b, 0x05, 0x72, 0xe1,
-	0x35, 0xc1, 0xc1, 0xfd, 0xe0, 0xc7, 0x52, 0xd0, 0x45, 0x68, 0x67, 0xba, 0xe9, 0xdd, 0xe9, 0x2a,
-	0x27, 0x70, 0xbc, 0x69, 0x22, 0xdc, 0xd9, 0x17, 0x7f, 0xa6, 0x21, 0x27, 0x1c, 0x29, 0x2a, 0xc2,
-	0xf1, 0x5d, 0xaf, 0xdd, 0xd3, 0xee, 0x7b, 0xf8, 0xfe, 0xd6, 0xec, 0xa9, 0x86, 0x21, 0x7d, 0x84,
-	0x64, 0x38, 0x6d, 0x68, 0xdd, 0xee, 0xad, 0xd9, 0x55, 0x7b, 0x26, 0x36, 0x6f, 0xbb, 0x2a, 0xee,
-	0x68, 0x8d, 0xb6, 0x94, 0x42, 0xe7, 0x50, 0x14, 0x98, 0x9e, 0x86, 0x9b, 0x6a, 0xe7, 0xfa, 0x9d,
-	0xb4, 0x87, 0x4a, 0x70, 0x22, 0x10, 0xba, 0xfa, 0x56, 0x6b, 0xab, 0x52, 0x3a, 0xd0, 0xb7, 0xcc,
-	0x4e, 0x03, 0x6b, 0x37, 0x37, 0xaa, 0xae, 0x36, 0x63, 0x62, 0x3f, 0x58, 0x82, 0x13, 0xd7, 0x8d,
-	0x86, 0xda, 0x37, 0x1f, 0x98, 0x03, 0xf4, 0x19, 0x7c, 0x9c, 0x28, 0x09, 0x96, 0xd7, 0xee, 0x4c,
-	0x6c, 0xa8, 0x0d, 0xad, 0xd7, 0xc4, 0x1d, 0xf5, 0xad, 0xda, 0x91, 0x32, 0xe8, 0x73, 0x50, 0x92,
-	0x06, 0xc6, 0x5d, 0xa3, 0xa1, 0x1a, 0x46, 0x52, 0xf7, 0x0c, 0x5d, 0xc2, 0x8b, 0xad, 0x0e, 0xba,
-	0x9a, 0xa9, 0xc6, 0xae, 0xd2, 0x21, 0xaa, 0xc0, 0xc5, 0x76, 0x27, 0x5c, 0x11, 0xf9, 0x49, 0x59,
-	0x74, 0x01, 0x32, 0x57, 0x88, 0xce, 0x71, 0xbf, 0x80, 0x4e, 0x41, 0x8a, 0x26, 0x87, 0xdb, 0xea,
-	0x3b, 0xdc, 0xba, 0x36, 0x5a, 0x52, 0x0e, 0xbd, 0x80, 0xf3, 0x9e, 0x6a, 0x04, 0x76, 0x3b, 0x64,
-	0xfe, 0xea, 0x9f, 0x7d, 0xc8, 0xde, 0xf3, 0x8b, 0xd4, 0xb6, 0x83, 0x37, 0x58, 0x68, 0x12, 0x6a,
-	0xff, 0x41, 0x7a, 0x64, 0xc5, 0xda, 0x64, 0x8d, 0x4e, 0x84, 0x5b, 0x16, 0xe6, 0x76, 0xf9, 0x6c,
-	0x13, 0x4c, 0x6d, 0xb2, 0x6e, 0x12, 0x7f, 0x48, 0x6d, 0x8f, 0xb9, 0x14, 0x7d, 0x0f, 0xd9, 0xb0,
-	0x36, 0xa8, 0x2b, 0x8a, 0xa2, 0x8e, 0x3b, 0xb4, 0x98, 0x4b, 0x9f, 0xac, 0xfc, 0x11, 0x0e, 0x83,
-	0xf5, 0x82, 0xd4, 0x46, 0xe2, 0x7b, 0x17, 0x52, 0xbd, 0x7c, 0xbe, 0x83, 0x47, 0xef, 0xa3, 0x05,
-	0x28, 0x0a, 0x69, 0x31, 0xd1, 0x45, 0x1b, 0x01, 0x2f, 0x97, 0xc5, 0x57, 0xb3, 0x95, 0xed, 0x1d,
-	0xc8, 0x09, 0xc1, 0x8a, 0x5e, 0x0a, 0xd2, 0xdd, 0x38, 0x2f, 0xbf, 0x7a, 0x8a, 0x7e, 0x70, 0x13,
-	0x12, 0x34, 0xe1, 0xb6, 0x1b, 0xc8, 0x09, 0xb7, 0xc7, 0x82, 0x57, 0x87, 0x42, 0x22, 0x1e, 0xd0,
-	0xe5, 0x13, 0xcf, 0x7f, 0xd3, 0x5f, 0xe5, 0x69, 0x41, 0xe4, 0xf9, 0x33, 0x3c, 0x8b, 0x9e, 0x24,
-	0x7a, 0x2e, 0x88, 0x93, 0x59, 0x91, 0x98, 0xd8, 0xd6, 0x0b, 0x7e, 0xf3, 0xf5, 0x6f, 0xf5, 0xb1,
-	0xcd, 0x26, 0x8b, 0x41, 0x6d, 0xe8, 0xce, 0xeb, 0xb3, 0x20, 0xe0, 0x1c, 0xdb, 0x19, 0x3b, 0x84,
-	0x2d, 0x5d, 0x3a, 0xad, 0xcf, 0x9c, 0x51, 0x9d, 0xc7, 0x4a, 0x7d, 0x63, 0x31, 0xc8, 0xf0, 0x9f,
-	0x01, 0xaf, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xc7, 0x77, 0x11, 0x4f, 0x08, 0x00, 0x00,
+	// 1109 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xed, 0x6e, 0xe2, 0x46,
+	0x14, 0x2d, 0x90, 0xb0, 0xe1, 0xf2, 0x11, 0xef, 0x10, 0x12, 0xd6, 0x9b, 0x25, 0xd4, 0xed, 0xb6,
+	0x51, 0x5b, 0x11, 0x29, 0xdb, 0xad, 0xaa, 0xf6, 0x47, 0x9b, 0x80, 0x53, 0x22, 0x3e, 0x4c, 0x8d,
+	0xb3, 0xe9, 0x56, 0x95, 0x46, 0x0e, 0xcc, 0x12, 0x2b, 0x60, 0x7b, 0xc7, 0x43, 0x81, 0x9f, 0x55,
+	0x9f, 0xa4, 0x0f, 0xd2, 0x37, 0xe9, 0xc3, 0x54, 0x1e, 0xdb, 0x30, 0x86, 0xd0, 0xaa, 0x52, 0x7f,
+	0x85, 0x9c, 0x73, 0xe6, 0xce, 0x99, 0x3b, 0x77, 0xee, 0x35, 0x3c, 0x9b, 0x99, 0xe3, 0x31, 0x61,
+	0xd4, 0x1d, 0x9c, 0x05, 0xbf, 0x1e, 0x2c, 0x56, 0x73, 0xa9, 0xc3, 0x1c, 0x94, 0x59, 0x52, 0x72,
+	0x86, 0xba, 0x83, 0x00, 0x95, 0x0f, 0x3c, 0x6b, 0x64, 0xfb, 0x72, 0xff, 0x2f, 0xa1, 0x01, 0xaa,
+	0xfc, 0x08, 0xe9, 0x16, 0x59, 0xe8, 0xe4, 0x3d, 0x3a, 0x05, 0xe9, 0x81, 0x2c, 0xf0, 0x3b, 0xcb,
+	0x1e, 0x11, 0x8a, 0x5d, 0x6a, 0xd9, 0xac, 0x9c, 0xa8, 0x26, 0x4e, 0x77, 0xf5, 0xc2, 0x03, 0x59,
+	0x5c, 0x71, 0xb8, 0xe7, 0xa3, 0xe8, 0x05, 0x00, 0x57, 0x9a, 0x13, 0x6b, 0xbc, 0x28, 0x27, 0xb9,
+	0x26, 0xe3, 0x6b, 0x38, 0xa0, 0xe4, 0x21, 0x7b, 0x31, 0x1c, 0x52, 0x9d, 0xbc, 0x9f, 0x12, 0x8f,
+	0x29, 0x0a, 0xe4, 0x82, 0x7f, 0x3d, 0xd7, 0xb1, 0x3d, 0x82, 0x10, 0xec, 0x98, 0xc3, 0x21, 0xe5,
+	0xb1, 0x33, 0x3a, 0xff, 0xad, 0x7c, 0x0c, 0x59, 0x83, 0x9a, 0xb6, 0x67, 0x0e, 0x98, 0xe5, 0xd8,
+	0xa8, 0x04, 0x69, 0x36, 0xc7, 0xf7, 0x64, 0xce, 0x45, 0x39, 0x7d, 0x97, 0xcd, 0x9b, 0x64, 0xae,
+	0x7c, 0x05, 0xfb, 0xbd, 0xe9, 0xdd, 0xd8, 0xf2, 0xee, 0x97, 0xc1, 0x3e, 0x82, 0xbc, 0x1b, 0x40,
+	0x98, 0x50, 0xea, 0x44, 0x51, 0x73, 0x21, 0xa8, 0xfa, 0x98, 0xf2, 0x0b, 0xa0, 0x3e, 0xb1, 0x87,
+	0xda, 0x94, 0xb9, 0x53, 0xe6, 0x85, 0xbe, 0xd0, 0x31, 0x80, 0x67, 0x32, 0xec, 0x12, 0x8a, 0x1f,
+	0x66, 0x7c, 0x5d, 0x4a, 0xdf, 0xf3, 0x4c, 0xd6, 0x23, 0xb4, 0x35, 0x43, 0xa7, 0xf0, 0xc4, 0x09,
+	0xf4, 0xe5, 0x64, 0x35, 0x75, 0x9a, 0x3d, 0x2f, 0xd4, 0xc2, 0xfc, 0xd5, 0x8c, 0xb9, 0x36, 0x65,
+	0x7a, 0x44, 0x2b, 0x5f, 0x40, 0x31, 0x16, 0x3d, 0x74, 0x56, 0x82, 0x34, 0x35, 0x67, 0x98, 0x2d,
+	0xcf, 0x40, 0xcd, 0x99, 0x31, 0x57, 0x5e, 0x03, 0x52, 0x3d, 0x66, 0x4d, 0x4c, 0x46, 0xae, 0x08,
+	0x89, 0xbc, 0x9c, 0x40, 0x76, 0xe0, 0xd8, 0xef, 0x30, 0x33, 0xe9, 0x88, 0x44, 0x69, 0x07, 0x1f,
+	0x32, 0x38, 0xa2, 0xbc, 0x82, 0x62, 0x6c, 0x59, 0xb8, 0xc9, 0x3f, 0x9e, 0x41, 0xf9, 0x23, 0x09,
+	0xb9, 0x1e, 0xb1, 0x87, 0x96, 0x3d, 0xea, 0xcf, 0x08, 0x71, 0xd1, 0xe7, 0xb0, 0xe7, 0xbb, 0x76,
+	0xa2, 0xab, 0xcd, 0x9e, 0xef, 0xd7, 0xc6, 0xfc, 0x4c, 0xda, 0x94, 0xf5, 0x7c, 0x58, 0x5f, 0x0a,
+	0xd0, 0x37, 0x90, 0x9b, 0x59, 0xcc, 0x26, 0x9e, 0x87, 0xd9, 0xc2, 0x25, 0xfc, 0x9e, 0x0b, 0xe7,
+	0x87, 0xb5, 0x65, 0x71, 0xd5, 0x6e, 0x03, 0xda, 0x58, 0xb8, 0x44, 0x8f, 0x69, 0x51, 0x05, 0xc0,
+	0x9c, 0x38, 0x53, 0x9b, 0x61, 0xcf, 0x64, 0xe5, 0x54, 0x35, 0x71, 0x9a, 0xd7, 0x05, 0x04, 0x29,
+	0x90, 0x8b, 0x7c, 0xdf, 0x2d, 0x18, 0x29, 0xef, 0x70, 0x45, 0x0c, 0x43, 0x35, 0x40, 0x77, 0xd4,
+	0x31, 0x87, 0x03, 0xd3, 0x63, 0xd8, 0x64, 0x8c, 0x4c, 0x5c, 0xe6, 0x95, 0x77, 0xb9, 0xf2, 0x11,
+	0x06, 0x7d, 0x09, 0x25, 0x9b, 0xcc, 0x19, 0x5e, 0x51, 0xf7, 0xc4, 0x1a, 0xdd, 0xb3, 0x72, 0x9a,
+	0x2f, 0x79, 0x9c, 0x54, 0x0e, 0xe1, 0x40, 0x4c, 0x51, 0x54, 0x1d, 0xca, 0x4f, 0x50, 0x5a, 0xc3,
+	0xc3, 0x94, 0x7f, 0x07, 0x05, 0x37, 0x20, 0xb0, 0xc7, 0x99, 0x72, 0x82, 0xd7, 0xc7, 0x91, 0x90,
+	0x18, 0x71, 0xa5, 0xbe, 0x26, 0x57, 0x7e, 0x4f, 0x40, 0xe1, 0x72, 0x3a, 0x71, 0x85, 0xeb, 0xff,
+	0x4f, 0xf7, 0x52, 0x85, 0x6c, 0x50, 0x26, 0xd8, 0xaf, 0x0f, 0x7e, 0x2d, 0x79, 0x5d, 0x84, 0x36,
+	0xb2, 0x9b, 0xda, 0xcc, 0xae, 0xf2, 0x14, 0xf6, 0x97, 0x26, 0x82, 0x93, 0x29, 0xbf, 0x25, 0x00,
+	0xb5, 0x89, 0xe9, 0x91, 0xa0, 0x94, 0x23, 0x73, 0x05, 0x48, 0x5a, 0xc3, 0xb0, 0x88, 0x93, 0xd6,
+	0x30, 0x66, 0x36, 0xf9, 0x6f, 0x66, 0x6b, 0x80, 0xc8, 0xdc, 0xb5, 0xa8, 0xe9, 0xbf, 0x6b, 0xec,
+	0x91, 0x81, 0x63, 0x0f, 0x3d, 0x6e, 0x68, 0x47, 0x7f, 0x84, 0x51, 0x5e, 0x43, 0x31, 0x66, 0x21,
+	0x4c, 0x7a, 0x05, 0x60, 0x25, 0xe6, 0x5e, 0x76, 0x74, 0x01, 0x51, 0xfa, 0x70, 0xa0, 0x93, 0xf1,
+	0xff, 0xeb, 0x5d, 0x39, 0x82, 0xd2, 0x5a, 0xd0, 0xc0, 0xcd, 0x67, 0x7f, 0xa6, 0x20, 0x2b, 0xd4,
+	0x3e, 0x2a, 0xc2, 0xfe, 0x4d, 0xb7, 0xd5, 0xd5, 0x6e, 0xbb, 0xf8, 0xf6, 0xda, 0xe8, 0xaa, 0xfd,
+	0xbe, 0xf4, 0x01, 0x2a, 0xc3, 0x41, 0x5d, 0xeb, 0x74, 0xae, 0x8d, 0x8e, 0xda, 0x35, 0xb0, 0x71,
+	0xdd, 0x51, 0x71, 0x5b, 0xab, 0xb7, 0xa4, 0x04, 0x3a, 0x82, 0xa2, 0xc0, 0x74, 0x35, 0xdc, 0x50,
+	0xdb, 0x17, 0x6f, 0xa5, 0x24, 0x2a, 0xc1, 0x53, 0x81, 0xd0, 0xd5, 0x37, 0x5a, 0x4b, 0x95, 0x52,
+	0xbe, 0xbe, 0x69, 0xb4, 0xeb, 0x58, 0xbb, 0xba, 0x52, 0x75, 0xb5, 0x11, 0x11, 0x3b, 0xfe, 0x16,
+	0x9c, 0xb8, 0xa8, 0xd7, 0xd5, 0x9e, 0xb1, 0x62, 0x76, 0xd1, 0x4b, 0xf8, 0x30, 0xb6, 0xc4, 0xdf,
+	0x5e, 0xbb, 0x31, 0x70, 0x5f, 0xad, 0x6b, 0xdd, 0x06, 0x6e, 0xab, 0x6f, 0xd4, 0xb6, 0x94, 0x46,
+	0x9f, 0x80, 0x12, 0x0f, 0xd0, 0xbf, 0xa9, 0xd7, 0xd5, 0x7e, 0x3f, 0xae, 0x7b, 0x82, 0x4e, 0xe0,
+	0xf9, 0x9a, 0x83, 0x8e, 0x66, 0xa8, 0x51, 0x54, 0x69, 0x0f, 0x55, 0xe1, 0x78, 0xdd, 0x09, 0x57,
+	0x84, 0xf1, 0xa4, 0x0c, 0x3a, 0x86, 0x32, 0x57, 0x88, 0x91, 0x23, 0xbf, 0x80, 0x0e, 0x40, 0x0a,
+	0x33, 0x87, 0x5b, 0xea, 0x5b, 0xdc, 0xbc, 0xe8, 0x37, 0xa5, 0x2c, 0x7a, 0x0e, 0x47, 0x5d, 0xb5,
+	0xef, 0x87, 0xdb, 0x20, 0x73, 0x48, 0x81, 0x8a, 0x98, 0x5f, 0x2d, 0xda, 0xb2, 0xae, 0x75, 0xaf,
+	0xae, 0xf5, 0x8e, 0xda, 0x90, 0xf2, 0x6b, 0x09, 0xbd, 0xe8, 0xd6, 0x9b, 0x9a, 0x2e, 0x15, 0xce,
+	0xff, 0xda, 0x85, 0xcc, 0x2d, 0x7f, 0xac, 0x2d, 0xcb, 0xef, 0x73, 0xf9, 0x06, 0xa1, 0xd6, 0xaf,
+	0xa4, 0x4b, 0xe6, 0xac, 0x45, 0x16, 0xe8, 0xa9, 0xf0, 0x92, 0x83, 0xd9, 0x28, 0x1f, 0x2e, 0x9b,
+	0x7f, 0x8b, 0x2c, 0x1a, 0xc4, 0x1b, 0x50, 0xcb, 0x65, 0x0e, 0x45, 0x5f, 0x43, 0x26, 0x58, 0xeb,
+	0xaf, 0x2b, 0x8a, 0xa2, 0xb6, 0x33, 0x30, 0x99, 0x43, 0xb7, 0xae, 0xfc, 0x16, 0xf6, 0xfc, 0xfd,
+	0xfc, 0xc9, 0x88, 0xc4, 0x9e, 0x2a, 0x4c, 0x4e, 0xf9, 0x68, 0x03, 0x0f, 0x9f, 0x43, 0x13, 0x50,
+	0x38, 0x08, 0xc5, 0xa9, 0x29, 0x86, 0x11, 0x70, 0x59, 0x16, 0x3b, 0xd3, 0xda, 0xfc, 0x6c, 0x43,
+	0x56, 0x18, 0x5e, 0xe8, 0x85, 0x20, 0xdd, 0x1c, 0x99, 0x72, 0x65, 0x1b, 0xbd, 0x8a, 0x26, 0x4c,
+	0xa9, 0x58, 0xb4, 0xcd, 0xa1, 0x27, 0x57, 0xb6, 0xd1, 0x61, 0x34, 0x1d, 0xf2, 0xb1, 0x16, 0x8c,
+	0x4e, 0xb6, 0xb4, 0xd8, 0xa5, 0xbf, 0xea, 0x76, 0x41, 0x18, 0xf3, 0x7b, 0x78, 0x12, 0xb6, 0x3d,
+	0xf4, 0x4c, 0x10, 0xc7, 0xfb, 0xb1, 0x2c, 0x3f, 0x46, 0xad, 0xce, 0x28, 0x74, 0xa8, 0xd8, 0x19,
+	0x37, 0x9b, 0xa7, 0x5c, 0xd9, 0x46, 0xaf, 0xce, 0x18, 0xeb, 0x31, 0xb1, 0x33, 0x3e, 0xd6, 0xd2,
+	0xe4, 0xea, 0x76, 0x41, 0x10, 0xf3, 0xf2, 0xd3, 0x9f, 0x5f, 0x8e, 0x2c, 0x76, 0x3f, 0xbd, 0xab,
+	0x0d, 0x9c, 0xc9, 0xd9, 0xa5, 0x51, 0xff, 0xa1, 0x77, 0x73, 0x36, 0xb6, 0x87, 0x67, 0x63, 0x7b,
+	0xf5, 0xb5, 0x48, 0xdd, 0xc1, 0x5d, 0x9a, 0x7f, 0x02, 0xbe, 0xfa, 0x7b, 0x00, 0xda, 0x1a, 0x59,
+	0xd6, 0x4b, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -930,6 +1139,19 @@ type WalletKitClient interface {
 	//fee preference being provided. For now, the responsibility of ensuring that
 	//the new fee preference is sufficient is delegated to the user.
 	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	//
+	//LeaseOutput locks an output to the given ID, preventing it from being
+	//available for any future coin selection attempts. The absolute time of the
+	//lock's expiration is returned. The expiration of the lock can be extended by
+	//successive invocations of this RPC with the same ID. Leases are persisted,
+	//so they survive restarts of lnd. Outputs can be unlocked before their
+	//expiration through `ReleaseOutput`.
+	LeaseOutput(ctx context.Context, in *LeaseOutputRequest, opts ...grpc.CallOption) (*LeaseOutputResponse, error)
+	//
+	//ReleaseOutput unlocks an output, allowing it to be available for coin
+	//selection if it remains unspent. The ID should match the one used to
+	//originally lock the output.
+	ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error)
 }
 
 type walletKitClient struct {
@@ -1012,6 +1234,24 @@ func (c *walletKitClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts
 	return out, nil
 }
 
+func (c *walletKitClient) LeaseOutput(ctx context.Context, in *LeaseOutputRequest, opts ...grpc.CallOption) (*LeaseOutputResponse, error) {
+	out := new(LeaseOutputResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/LeaseOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error) {
+	out := new(ReleaseOutputResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ReleaseOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WalletKitServer is the server API for WalletKit service.
 type WalletKitServer interface {
 	//*
@@ -1079,6 +1319,19 @@ type WalletKitServer interface {
 	//fee preference being provided. For now, the responsibility of ensuring that
 	//the new fee preference is sufficient is delegated to the user.
 	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	//
+	//LeaseOutput locks an output to the given ID, preventing it from being
+	//available for any future coin selection attempts. The absolute time of the
+	//lock's expiration is returned. The expiration of the lock can be extended by
+	//successive invocations of this RPC with the same ID. Leases are persisted,
+	//so they survive restarts of lnd. Outputs can be unlocked before their
+	//expiration through `ReleaseOutput`.
+	LeaseOutput(context.Context, *LeaseOutputRequest) (*LeaseOutputResponse, error)
+	//
+	//ReleaseOutput unlocks an output, allowing it to be available for coin
+	//selection if it remains unspent. The ID should match the one used to
+	//originally lock the output.
+	ReleaseOutput(context.Context, *ReleaseOutputRequest) (*ReleaseOutputResponse, error)
 }
 
 func RegisterWalletKitServer(s *grpc.Server, srv WalletKitServer) {
@@ -1229,6 +1482,42 @@ func _WalletKit_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletKit_LeaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).LeaseOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/LeaseOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).LeaseOutput(ctx, req.(*LeaseOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ReleaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ReleaseOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ReleaseOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ReleaseOutput(ctx, req.(*ReleaseOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WalletKit_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "walletrpc.WalletKit",
 	HandlerType: (*WalletKitServer)(nil),
@@ -1265,6 +1554,14 @@ var _WalletKit_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BumpFee",
 			Handler:    _WalletKit_BumpFee_Handler,
 		},
+		{
+			MethodName: "LeaseOutput",
+			Handler:    _WalletKit_LeaseOutput_Handler,
+		},
+		{
+			MethodName: "ReleaseOutput",
+			Handler:    _WalletKit_ReleaseOutput_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "walletrpc/walletkit.proto",
@@ -10,6 +10,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/BTCGPU/lnd/input"
 	"github.com/BTCGPU/lnd/keychain"
@@ -87,6 +88,14 @@ var (
 			Entity: "onchain",
 			Action: "write",
 		}},
+		"/walletrpc.WalletKit/LeaseOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ReleaseOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
 	}
 
 	// DefaultWalletKitMacFilename is the default name of the wallet kit
@@ -389,6 +398,10 @@ func (w *WalletKit) PendingSweeps(ctx context.Context,
 			witnessType = WitnessType_WITNESS_KEY_HASH
 		case input.NestedWitnessKeyHash:
 			witnessType = WitnessType_NESTED_WITNESS_KEY_HASH
+		case input.CommitmentToRemoteConfirmed:
+			witnessType = WitnessType_COMMITMENT_TO_REMOTE_CONFIRMED
+		case input.CommitmentAnchor:
+			witnessType = WitnessType_COMMITMENT_ANCHOR
 		default:
 			log.Warnf("Unhandled witness type %v for input %v",
 				pendingInput.WitnessType, pendingInput.OutPoint)
@@ -541,3 +554,74 @@ func (w *WalletKit) BumpFee(ctx context.Context,
 
 	return &BumpFeeResponse{}, nil
 }
+
+// unmarshallLockID parses the lock ID of a lease request. The ID must be
+// exactly 32 bytes.
+func unmarshallLockID(id []byte) (lnwallet.LockID, error) {
+	var lockID lnwallet.LockID
+	if len(id) != len(lockID) {
+		return lockID, fmt.Errorf("id must be %v random bytes",
+			len(lockID))
+	}
+
+	copy(lockID[:], id)
+
+	return lockID, nil
+}
+
+// LeaseOutput locks an output to the given ID, preventing it from being
+// available for any future coin selection attempts. The absolute time of the
+// lock's expiration is returned. The expiration of the lock can be extended by
+// successive invocations of this call with the same ID. Outputs can be
+// unlocked before their expiration through ReleaseOutput.
+func (w *WalletKit) LeaseOutput(ctx context.Context,
+	req *LeaseOutputRequest) (*LeaseOutputResponse, error) {
+
+	lockID, err := unmarshallLockID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := unmarshallOutPoint(req.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := lnwallet.DefaultLeaseDuration
+	if req.ExpirationSeconds != 0 {
+		duration = time.Duration(req.ExpirationSeconds) * time.Second
+	}
+
+	expiration, err := w.cfg.Wallet.LeaseOutput(lockID, *op, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeaseOutputResponse{
+		Expiration: uint64(expiration.Unix()),
+	}, nil
+}
+
+// ReleaseOutput unlocks an output, allowing it to be available for coin
+// selection if it remains unspent. The ID should match the one used to
+// originally lock the output.
+func (w *WalletKit) ReleaseOutput(ctx context.Context,
+	req *ReleaseOutputRequest) (*ReleaseOutputResponse, error) {
+
+	lockID, err := unmarshallLockID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := unmarshallOutPoint(req.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.cfg.Wallet.ReleaseOutput(lockID, *op)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReleaseOutputResponse{}, nil
+}
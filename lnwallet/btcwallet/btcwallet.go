@@ -58,6 +58,14 @@ type BtcWallet struct {
 	netParams *chaincfg.Params
 
 	chainKeyScope waddrmgr.KeyScope
+
+	// leases tracks all outputs that are currently leased, mirroring the
+	// leases persisted within the wallet database.
+	leases map[wire.OutPoint]outputLease
+
+	// leaseMtx guards the set of leases, and ensures leases are expired
+	// atomically with regards to coin selection.
+	leaseMtx sync.Mutex
 }
 
 // A compile time check to ensure that BtcWallet implements the
@@ -126,6 +134,7 @@ func New(cfg Config) (*BtcWallet, error) {
 		chain:         cfg.ChainSource,
 		netParams:     cfg.NetParams,
 		chainKeyScope: chainKeyScope,
+		leases:        make(map[wire.OutPoint]outputLease),
 	}, nil
 }
 
@@ -176,6 +185,12 @@ func (b *BtcWallet) Start() error {
 		}
 	}
 
+	// Lock all outputs that are still leased from a previous run, so
+	// they aren't picked up by coin selection.
+	if err := b.loadLeases(); err != nil {
+		return err
+	}
+
 	// Establish an RPC connection in addition to starting the goroutines
 	// in the underlying wallet.
 	if err := b.chain.Start(); err != nil {
@@ -299,6 +314,12 @@ func (b *BtcWallet) SendOutputs(outputs []*wire.TxOut,
 	if len(outputs) < 1 {
 		return nil, lnwallet.ErrNoOutputs
 	}
+
+	// Make sure outputs of expired leases are available again.
+	if err := b.refreshLeases(); err != nil {
+		return nil, err
+	}
+
 	return b.wallet.SendOutputs(outputs, defaultAccount, 1, feeSatPerKB)
 }
 
@@ -338,6 +359,11 @@ func (b *BtcWallet) CreateSimpleTx(outputs []*wire.TxOut,
 		}
 	}
 
+	// Make sure outputs of expired leases are available again.
+	if err := b.refreshLeases(); err != nil {
+		return nil, err
+	}
+
 	return b.wallet.CreateSimpleTx(defaultAccount, outputs, 1, feeSatPerKB, dryRun)
 }
 
@@ -352,10 +378,18 @@ func (b *BtcWallet) LockOutpoint(o wire.OutPoint) {
 }
 
 // UnlockOutpoint unlocks a previously locked output, marking it eligible for
-// coin selection.
+// coin selection. Leased outputs remain locked until their lease is released
+// or expires.
 //
 // This is a part of the WalletController interface.
 func (b *BtcWallet) UnlockOutpoint(o wire.OutPoint) {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	if _, ok := b.leases[o]; ok {
+		return
+	}
+
 	b.wallet.UnlockOutpoint(o)
 }
 
@@ -365,6 +399,13 @@ func (b *BtcWallet) UnlockOutpoint(o wire.OutPoint) {
 // This is a part of the WalletController interface.
 func (b *BtcWallet) ListUnspentWitness(minConfs, maxConfs int32) (
 	[]*lnwallet.Utxo, error) {
+
+	// Make sure outputs of expired leases are reported again. Outputs
+	// that are still leased are locked, and therefore not returned.
+	if err := b.refreshLeases(); err != nil {
+		return nil, err
+	}
+
 	// First, grab all the unfiltered currently unspent outputs.
 	unspentOutputs, err := b.wallet.ListUnspent(minConfs, maxConfs, nil)
 	if err != nil {
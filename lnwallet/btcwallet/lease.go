@@ -0,0 +1,295 @@
+package btcwallet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/BTCGPU/lnd/lnwallet"
+	"github.com/btgsuite/btgd/chaincfg/chainhash"
+	"github.com/btgsuite/btgd/wire"
+	"github.com/btgsuite/btgwallet/walletdb"
+)
+
+var (
+	// wtxmgrNamespaceKey is the namespace key that the wtxmgr state is
+	// stored within the top-level walletdb buckets of btcwallet.
+	wtxmgrNamespaceKey = []byte("wtxmgr")
+
+	// leaseBucketKey is the top-level bucket within the wallet database
+	// that stores all outputs that are currently leased. Each entry is
+	// keyed by the outpoint of the leased output.
+	leaseBucketKey = []byte("lnwallet-output-leases")
+)
+
+const (
+	// leaseKeySize is the size of a serialized lease key: the txid and
+	// output index of the leased output.
+	leaseKeySize = chainhash.HashSize + 4
+
+	// leaseValueSize is the size of a serialized lease: the lock ID and
+	// the expiration time as a unix timestamp.
+	leaseValueSize = 32 + 8
+)
+
+// outputLease describes an active lease of a wallet output.
+type outputLease struct {
+	// id is the ID the output was leased with. Only the owner of this ID
+	// can release the output again.
+	id lnwallet.LockID
+
+	// expiration is the time at which the lease expires, after which the
+	// output becomes eligible for coin selection again.
+	expiration time.Time
+}
+
+// LeaseOutput locks an output to the given ID for the given duration,
+// preventing it from being available for coin selection. Leases are persisted
+// within the wallet database, so they survive restarts.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) LeaseOutput(id lnwallet.LockID, op wire.OutPoint,
+	duration time.Duration) (time.Time, error) {
+
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	if err := b.expireLeases(); err != nil {
+		return time.Time{}, err
+	}
+
+	// An output that is leased under a different ID can't be leased. The
+	// same goes for outputs the wallet has locked itself, for instance
+	// while funding a channel.
+	lease, isLeased := b.leases[op]
+	switch {
+	case isLeased && lease.id != id:
+		return time.Time{}, lnwallet.ErrOutputAlreadyLeased
+
+	case !isLeased && b.wallet.LockedOutpoint(op):
+		return time.Time{}, lnwallet.ErrOutputAlreadyLeased
+	}
+
+	lease = outputLease{
+		id:         id,
+		expiration: time.Now().Add(duration),
+	}
+	err := walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		// If we're not extending an existing lease, we'll make sure
+		// the output is actually one of our unspent outputs.
+		if !isLeased {
+			known, err := b.isUnspentOutput(tx, op)
+			if err != nil {
+				return err
+			}
+			if !known {
+				return lnwallet.ErrUnknownOutput
+			}
+		}
+
+		leases := tx.ReadWriteBucket(leaseBucketKey)
+		return leases.Put(serializeLeaseKey(op), serializeLease(lease))
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	b.leases[op] = lease
+	b.wallet.LockOutpoint(op)
+
+	return lease.expiration, nil
+}
+
+// ReleaseOutput unlocks an output previously leased under the given ID,
+// allowing it to be used for coin selection again.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ReleaseOutput(id lnwallet.LockID, op wire.OutPoint) error {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	lease, ok := b.leases[op]
+	if !ok {
+		return nil
+	}
+	if lease.id != id {
+		return lnwallet.ErrOutputUnlockNotAllowed
+	}
+
+	err := walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		leases := tx.ReadWriteBucket(leaseBucketKey)
+		return leases.Delete(serializeLeaseKey(op))
+	})
+	if err != nil {
+		return err
+	}
+
+	delete(b.leases, op)
+	b.wallet.UnlockOutpoint(op)
+
+	return nil
+}
+
+// isUnspentOutput returns whether the given outpoint is a known unspent output
+// of the wallet.
+func (b *BtcWallet) isUnspentOutput(tx walletdb.ReadTx,
+	op wire.OutPoint) (bool, error) {
+
+	txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+	credits, err := b.wallet.TxStore.UnspentOutputs(txmgrNs)
+	if err != nil {
+		return false, err
+	}
+
+	for _, credit := range credits {
+		if credit.OutPoint == op {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// loadLeases reads all persisted leases from the wallet database and locks
+// their outputs within the wallet. Expired leases are removed.
+func (b *BtcWallet) loadLeases() error {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	b.leases = make(map[wire.OutPoint]outputLease)
+	err := walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		// Create the lease bucket if this is the first time we're
+		// starting up with leasing support.
+		leases := tx.ReadWriteBucket(leaseBucketKey)
+		if leases == nil {
+			var err error
+			leases, err = tx.CreateTopLevelBucket(leaseBucketKey)
+			if err != nil {
+				return err
+			}
+		}
+
+		return leases.ForEach(func(k, v []byte) error {
+			op, err := deserializeLeaseKey(k)
+			if err != nil {
+				return err
+			}
+			lease, err := deserializeLease(v)
+			if err != nil {
+				return err
+			}
+
+			b.leases[op] = lease
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	for op := range b.leases {
+		b.wallet.LockOutpoint(op)
+	}
+
+	return b.expireLeases()
+}
+
+// refreshLeases expires all leases that have run out, so their outputs are
+// available to the coin selection that follows.
+func (b *BtcWallet) refreshLeases() error {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	return b.expireLeases()
+}
+
+// expireLeases removes all expired leases and unlocks their outputs, making
+// them available for coin selection again.
+//
+// NOTE: The leaseMtx MUST be held when calling this method.
+func (b *BtcWallet) expireLeases() error {
+	now := time.Now()
+
+	var expired []wire.OutPoint
+	for op, lease := range b.leases {
+		if now.Before(lease.expiration) {
+			continue
+		}
+
+		expired = append(expired, op)
+	}
+
+	if len(expired) == 0 {
+		return nil
+	}
+
+	err := walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		leases := tx.ReadWriteBucket(leaseBucketKey)
+		for _, op := range expired {
+			err := leases.Delete(serializeLeaseKey(op))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, op := range expired {
+		delete(b.leases, op)
+		b.wallet.UnlockOutpoint(op)
+	}
+
+	return nil
+}
+
+// serializeLeaseKey serializes the outpoint of a leased output.
+func serializeLeaseKey(op wire.OutPoint) []byte {
+	var k [leaseKeySize]byte
+	copy(k[:], op.Hash[:])
+	binary.BigEndian.PutUint32(k[chainhash.HashSize:], op.Index)
+
+	return k[:]
+}
+
+// deserializeLeaseKey deserializes the outpoint of a leased output.
+func deserializeLeaseKey(k []byte) (wire.OutPoint, error) {
+	var op wire.OutPoint
+	if len(k) != leaseKeySize {
+		return op, fmt.Errorf("invalid lease key length: %v", len(k))
+	}
+
+	copy(op.Hash[:], k[:chainhash.HashSize])
+	op.Index = binary.BigEndian.Uint32(k[chainhash.HashSize:])
+
+	return op, nil
+}
+
+// serializeLease serializes the ID and expiration of a lease.
+func serializeLease(lease outputLease) []byte {
+	var v [leaseValueSize]byte
+	copy(v[:], lease.id[:])
+	binary.BigEndian.PutUint64(
+		v[len(lease.id):], uint64(lease.expiration.Unix()),
+	)
+
+	return v[:]
+}
+
+// deserializeLease deserializes the ID and expiration of a lease.
+func deserializeLease(v []byte) (outputLease, error) {
+	var lease outputLease
+	if len(v) != leaseValueSize {
+		return lease, fmt.Errorf("invalid lease length: %v", len(v))
+	}
+
+	copy(lease.id[:], v[:len(lease.id)])
+	lease.expiration = time.Unix(
+		int64(binary.BigEndian.Uint64(v[len(lease.id):])), 0,
+	)
+
+	return lease, nil
+}
@@ -781,8 +781,9 @@ func (lc *LightningChannel) diskHtlcToPayDesc(feeRate SatPerKWeight,
 		htlc.Amt.ToSatoshis(), lc.channelState.LocalChanCfg.DustLimit)
 	if !isDustLocal && localCommitKeys != nil {
 		ourP2WSH, ourWitnessScript, err = genHtlcScript(
-			htlc.Incoming, true, htlc.RefundTimeout, htlc.RHash,
-			localCommitKeys)
+			lc.channelState.ChanType, htlc.Incoming, true,
+			htlc.RefundTimeout, htlc.RHash, localCommitKeys,
+		)
 		if err != nil {
 			return pd, err
 		}
@@ -791,8 +792,9 @@ func (lc *LightningChannel) diskHtlcToPayDesc(feeRate SatPerKWeight,
 		htlc.Amt.ToSatoshis(), lc.channelState.RemoteChanCfg.DustLimit)
 	if !isDustRemote && remoteCommitKeys != nil {
 		theirP2WSH, theirWitnessScript, err = genHtlcScript(
-			htlc.Incoming, false, htlc.RefundTimeout, htlc.RHash,
-			remoteCommitKeys)
+			lc.channelState.ChanType, htlc.Incoming, false,
+			htlc.RefundTimeout, htlc.RHash, remoteCommitKeys,
+		)
 		if err != nil {
 			return pd, err
 		}
@@ -864,12 +866,6 @@ func (lc *LightningChannel) diskCommitToMemCommit(isLocal bool,
 	diskCommit *channeldb.ChannelCommitment, localCommitPoint,
 	remoteCommitPoint *btcec.PublicKey) (*commitment, error) {
 
-	// If this commit is tweakless, then it'll affect the way we derive our
-	// keys, which will affect the commitment transaction reconstruction.
-	// So we'll determine this first, before we do anything else.
-	tweaklessCommit := (lc.channelState.ChanType ==
-		channeldb.SingleFunderTweakless)
-
 	// First, we'll need to re-derive the commitment key ring for each
 	// party used within this particular state. If this is a pending commit
 	// (we extended but weren't able to complete the commitment dance
@@ -878,13 +874,13 @@ func (lc *LightningChannel) diskCommitToMemCommit(isLocal bool,
 	var localCommitKeys, remoteCommitKeys *CommitmentKeyRing
 	if localCommitPoint != nil {
 		localCommitKeys = DeriveCommitmentKeys(
-			localCommitPoint, true, tweaklessCommit,
+			localCommitPoint, true, lc.channelState.ChanType,
 			lc.localChanCfg, lc.remoteChanCfg,
 		)
 	}
 	if remoteCommitPoint != nil {
 		remoteCommitKeys = DeriveCommitmentKeys(
-			remoteCommitPoint, false, tweaklessCommit,
+			remoteCommitPoint, false, lc.channelState.ChanType,
 			lc.localChanCfg, lc.remoteChanCfg,
 		)
 	}
@@ -980,13 +976,23 @@ type CommitmentKeyRing struct {
 	// redeem outputs from a revoked commitment transaction if it were to
 	// be published.
 	RevocationKey *btcec.PublicKey
+
+	// ToLocalAnchorKey is the commitment transaction owner's key used in
+	// the anchor output paying to them. This is only set for channels
+	// using the anchor commitment format.
+	ToLocalAnchorKey *btcec.PublicKey
+
+	// ToRemoteAnchorKey is the other party's key used in the anchor
+	// output paying to them. This is only set for channels using the
+	// anchor commitment format.
+	ToRemoteAnchorKey *btcec.PublicKey
 }
 
 // DeriveCommitmentKey generates a new commitment key set using the base points
 // and commitment point. The keys are derived differently depending whether the
 // commitment transaction is ours or the remote peer's.
 func DeriveCommitmentKeys(commitPoint *btcec.PublicKey,
-	isOurCommit, tweaklessCommit bool,
+	isOurCommit bool, chanType channeldb.ChannelType,
 	localChanCfg, remoteChanCfg *channeldb.ChannelConfig) *CommitmentKeyRing {
 
 	// First, we'll derive all the keys that don't depend on the context of
@@ -1017,15 +1023,21 @@ func DeriveCommitmentKeys(commitPoint *btcec.PublicKey,
 		delayBasePoint      *btcec.PublicKey
 		noDelayBasePoint    *btcec.PublicKey
 		revocationBasePoint *btcec.PublicKey
+		toLocalAnchorKey    *btcec.PublicKey
+		toRemoteAnchorKey   *btcec.PublicKey
 	)
 	if isOurCommit {
 		delayBasePoint = localChanCfg.DelayBasePoint.PubKey
 		noDelayBasePoint = remoteChanCfg.PaymentBasePoint.PubKey
 		revocationBasePoint = remoteChanCfg.RevocationBasePoint.PubKey
+		toLocalAnchorKey = localChanCfg.MultiSigKey.PubKey
+		toRemoteAnchorKey = remoteChanCfg.MultiSigKey.PubKey
 	} else {
 		delayBasePoint = remoteChanCfg.DelayBasePoint.PubKey
 		noDelayBasePoint = localChanCfg.PaymentBasePoint.PubKey
 		revocationBasePoint = localChanCfg.RevocationBasePoint.PubKey
+		toLocalAnchorKey = remoteChanCfg.MultiSigKey.PubKey
+		toRemoteAnchorKey = localChanCfg.MultiSigKey.PubKey
 	}
 
 	// With the base points assigned, we can now derive the actual keys
@@ -1037,7 +1049,7 @@ func DeriveCommitmentKeys(commitPoint *btcec.PublicKey,
 
 	// If this commitment should omit the tweak for the remote point, then
 	// we'll use that directly, and ignore the commitPoint tweak.
-	if tweaklessCommit {
+	if chanType.IsTweakless() {
 		keyRing.NoDelayKey = noDelayBasePoint
 	} else {
 		keyRing.NoDelayKey = input.TweakPubKey(
@@ -1045,6 +1057,14 @@ func DeriveCommitmentKeys(commitPoint *btcec.PublicKey,
 		)
 	}
 
+	// The anchor outputs are locked to the funding keys of each party,
+	// which allows either side to spend their anchor without needing any
+	// state beyond the channel configuration.
+	if chanType.HasAnchors() {
+		keyRing.ToLocalAnchorKey = toLocalAnchorKey
+		keyRing.ToRemoteAnchorKey = toRemoteAnchorKey
+	}
+
 	return keyRing
 }
 
@@ -1537,7 +1557,8 @@ func (lc *LightningChannel) logUpdateToPayDesc(logUpdate *channeldb.LogUpdate,
 			wireMsg.Amount.ToSatoshis(), remoteDustLimit)
 		if !isDustRemote {
 			theirP2WSH, theirWitnessScript, err := genHtlcScript(
-				false, false, wireMsg.Expiry, wireMsg.PaymentHash,
+				lc.channelState.ChanType, false, false,
+				wireMsg.Expiry, wireMsg.PaymentHash,
 				remoteCommitKeys,
 			)
 			if err != nil {
@@ -1712,9 +1733,8 @@ func (lc *LightningChannel) restoreCommitState(
 
 		// We'll also re-create the set of commitment keys needed to
 		// fully re-derive the state.
-		tweaklessCommit := lc.channelState.ChanType.IsTweakless()
 		pendingRemoteKeyChain = DeriveCommitmentKeys(
-			pendingCommitPoint, false, tweaklessCommit,
+			pendingCommitPoint, false, lc.channelState.ChanType,
 			lc.localChanCfg, lc.remoteChanCfg,
 		)
 	}
@@ -1944,6 +1964,11 @@ type BreachRetribution struct {
 	// party) within the breach transaction.
 	LocalOutpoint wire.OutPoint
 
+	// LocalDelay is the CSV delay applied to the output paying to us
+	// within the breach transaction. This is only non-zero for channels
+	// using anchor outputs.
+	LocalDelay uint32
+
 	// RemoteOutputSignDesc is a SignDescriptor which is capable of
 	// generating the signature required to claim the funds as described
 	// within the revocation clause of the remote party's commitment
@@ -1999,9 +2024,8 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 
 	// With the commitment point generated, we can now generate the four
 	// keys we'll need to reconstruct the commitment state,
-	tweaklessCommit := chanState.ChanType.IsTweakless()
 	keyRing := DeriveCommitmentKeys(
-		commitmentPoint, false, tweaklessCommit,
+		commitmentPoint, false, chanState.ChanType,
 		&chanState.LocalChanCfg, &chanState.RemoteChanCfg,
 	)
 
@@ -2019,7 +2043,9 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 	if err != nil {
 		return nil, err
 	}
-	localPkScript, err := input.CommitScriptUnencumbered(keyRing.NoDelayKey)
+	localScript, localDelay, err := CommitScriptToRemote(
+		chanState.ChanType, keyRing.NoDelayKey,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -2034,7 +2060,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 	}
 	for i, txOut := range revokedSnapshot.CommitTx.TxOut {
 		switch {
-		case bytes.Equal(txOut.PkScript, localPkScript):
+		case bytes.Equal(txOut.PkScript, localScript.PkScript):
 			localOutpoint.Index = uint32(i)
 		case bytes.Equal(txOut.PkScript, remoteWitnessHash):
 			remoteOutpoint.Index = uint32(i)
@@ -2059,9 +2085,9 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 		localSignDesc = &input.SignDescriptor{
 			SingleTweak:   keyRing.LocalCommitKeyTweak,
 			KeyDesc:       chanState.LocalChanCfg.PaymentBasePoint,
-			WitnessScript: localPkScript,
+			WitnessScript: localScript.WitnessScript,
 			Output: &wire.TxOut{
-				PkScript: localPkScript,
+				PkScript: localScript.PkScript,
 				Value:    int64(localAmt),
 			},
 			HashType: txscript.SigHashAll | txscript.SigHashForkID,
@@ -2069,7 +2095,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 
 		// If this is a tweakless commitment, then we can safely blank
 		// out the SingleTweak value as it isn't needed.
-		if tweaklessCommit {
+		if chanState.ChanType.IsTweakless() {
 			localSignDesc.SingleTweak = nil
 		}
 	}
@@ -2127,6 +2153,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 			htlcWitnessScript, err = input.SenderHTLCScript(
 				keyRing.RemoteHtlcKey, keyRing.LocalHtlcKey,
 				keyRing.RevocationKey, htlc.RHash[:],
+				chanState.ChanType.HasAnchors(),
 			)
 			if err != nil {
 				return nil, err
@@ -2139,7 +2166,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 			htlcWitnessScript, err = input.ReceiverHTLCScript(
 				htlc.RefundTimeout, keyRing.LocalHtlcKey,
 				keyRing.RemoteHtlcKey, keyRing.RevocationKey,
-				htlc.RHash[:],
+				htlc.RHash[:], chanState.ChanType.HasAnchors(),
 			)
 			if err != nil {
 				return nil, err
@@ -2182,6 +2209,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 		PendingHTLCs:         revokedSnapshot.Htlcs,
 		LocalOutpoint:        localOutpoint,
 		LocalOutputSignDesc:  localSignDesc,
+		LocalDelay:           localDelay,
 		RemoteOutpoint:       remoteOutpoint,
 		RemoteOutputSignDesc: remoteSignDesc,
 		HtlcRetributions:     htlcRetributions,
@@ -2396,7 +2424,8 @@ func (lc *LightningChannel) createCommitmentTx(c *commitment,
 	// on its total weight. Once we have the total weight, we'll multiply
 	// by the current fee-per-kw, then divide by 1000 to get the proper
 	// fee.
-	totalCommitWeight := input.CommitWeight + (input.HtlcWeight * numHTLCs)
+	totalCommitWeight := CommitWeight(lc.channelState.ChanType) +
+		input.HtlcWeight*numHTLCs
 
 	// With the weight known, we can now calculate the commitment fee,
 	// ensuring that we account for any dust outputs trimmed above.
@@ -2437,8 +2466,10 @@ func (lc *LightningChannel) createCommitmentTx(c *commitment,
 
 	// Generate a new commitment transaction with all the latest
 	// unsettled/un-timed out HTLCs.
-	commitTx, err := CreateCommitTx(lc.fundingTxIn(), keyRing, delay,
-		delayBalance, p2wkhBalance, c.dustLimit)
+	commitTx, err := CreateCommitTx(
+		lc.channelState.ChanType, lc.fundingTxIn(), keyRing, delay,
+		delayBalance, p2wkhBalance, c.dustLimit, numHTLCs,
+	)
 	if err != nil {
 		return err
 	}
@@ -2794,7 +2825,8 @@ func processFeeUpdate(feeUpdate *PaymentDescriptor, nextHeight uint64,
 // generating a new commitment for the remote party. The jobs generated by the
 // signature can be submitted to the sigPool to generate all the signatures
 // asynchronously and in parallel.
-func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
+func genRemoteHtlcSigJobs(chanType channeldb.ChannelType,
+	keyRing *CommitmentKeyRing,
 	localChanCfg, remoteChanCfg *channeldb.ChannelConfig,
 	remoteCommitView *commitment) ([]SignJob, chan struct{}, error) {
 
@@ -2842,7 +2874,7 @@ func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
 			Index: uint32(htlc.remoteOutputIndex),
 		}
 		sigJob.Tx, err = createHtlcTimeoutTx(
-			op, outputAmt, htlc.Timeout,
+			chanType, op, outputAmt, htlc.Timeout,
 			uint32(remoteChanCfg.CsvDelay),
 			keyRing.RevocationKey, keyRing.DelayKey,
 		)
@@ -2894,7 +2926,7 @@ func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
 			Index: uint32(htlc.remoteOutputIndex),
 		}
 		sigJob.Tx, err = createHtlcSuccessTx(
-			op, outputAmt, uint32(remoteChanCfg.CsvDelay),
+			chanType, op, outputAmt, uint32(remoteChanCfg.CsvDelay),
 			keyRing.RevocationKey, keyRing.DelayKey,
 		)
 		if err != nil {
@@ -3257,7 +3289,7 @@ func (lc *LightningChannel) SignNextCommitment() (lnwire.Sig, []lnwire.Sig, []ch
 	// used within fetchCommitmentView to derive all the keys necessary to
 	// construct the commitment state.
 	keyRing := DeriveCommitmentKeys(
-		commitPoint, false, lc.channelState.ChanType.IsTweakless(),
+		commitPoint, false, lc.channelState.ChanType,
 		lc.localChanCfg, lc.remoteChanCfg,
 	)
 
@@ -3294,8 +3326,9 @@ func (lc *LightningChannel) SignNextCommitment() (lnwire.Sig, []lnwire.Sig, []ch
 	// need to generate signatures of each of them for the remote party's
 	// commitment state. We do so in two phases: first we generate and
 	// submit the set of signature jobs to the worker pool.
-	sigBatch, cancelChan, err := genRemoteHtlcSigJobs(keyRing,
-		lc.localChanCfg, lc.remoteChanCfg, newCommitView,
+	sigBatch, cancelChan, err := genRemoteHtlcSigJobs(
+		lc.channelState.ChanType, keyRing, lc.localChanCfg,
+		lc.remoteChanCfg, newCommitView,
 	)
 	if err != nil {
 		return sig, htlcSigs, nil, err
@@ -3750,7 +3783,8 @@ func (lc *LightningChannel) computeView(view *htlcView, remoteChain bool,
 		totalHtlcWeight += input.HtlcWeight
 	}
 
-	totalCommitWeight := input.CommitWeight + totalHtlcWeight
+	totalCommitWeight := CommitWeight(lc.channelState.ChanType) +
+		totalHtlcWeight
 	return ourBalance, theirBalance, totalCommitWeight, filteredHTLCView
 }
 
@@ -3758,8 +3792,8 @@ func (lc *LightningChannel) computeView(view *htlcView, remoteChain bool,
 // meant to verify all the signatures for HTLC's attached to a newly created
 // commitment state. The jobs generated are fully populated, and can be sent
 // directly into the pool of workers.
-func genHtlcSigValidationJobs(localCommitmentView *commitment,
-	keyRing *CommitmentKeyRing, htlcSigs []lnwire.Sig,
+func genHtlcSigValidationJobs(chanType channeldb.ChannelType,
+	localCommitmentView *commitment, keyRing *CommitmentKeyRing, htlcSigs []lnwire.Sig,
 	localChanCfg, remoteChanCfg *channeldb.ChannelConfig) ([]VerifyJob, error) {
 
 	txHash := localCommitmentView.txn.TxHash()
@@ -3806,8 +3840,8 @@ func genHtlcSigValidationJobs(localCommitmentView *commitment,
 				htlcFee := htlcSuccessFee(feePerKw)
 				outputAmt := htlc.Amount.ToSatoshis() - htlcFee
 
-				successTx, err := createHtlcSuccessTx(op,
-					outputAmt, uint32(localChanCfg.CsvDelay),
+				successTx, err := createHtlcSuccessTx(
+					chanType, op, outputAmt, uint32(localChanCfg.CsvDelay),
 					keyRing.RevocationKey, keyRing.DelayKey)
 				if err != nil {
 					return nil, err
@@ -3858,8 +3892,8 @@ func genHtlcSigValidationJobs(localCommitmentView *commitment,
 				htlcFee := htlcTimeoutFee(feePerKw)
 				outputAmt := htlc.Amount.ToSatoshis() - htlcFee
 
-				timeoutTx, err := createHtlcTimeoutTx(op,
-					outputAmt, htlc.Timeout,
+				timeoutTx, err := createHtlcTimeoutTx(
+					chanType, op, outputAmt, htlc.Timeout,
 					uint32(localChanCfg.CsvDelay),
 					keyRing.RevocationKey, keyRing.DelayKey,
 				)
@@ -4014,7 +4048,7 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSig lnwire.Sig,
 	}
 	commitPoint := input.ComputeCommitmentPoint(commitSecret[:])
 	keyRing := DeriveCommitmentKeys(
-		commitPoint, true, lc.channelState.ChanType.IsTweakless(),
+		commitPoint, true, lc.channelState.ChanType,
 		lc.localChanCfg, lc.remoteChanCfg,
 	)
 
@@ -4063,8 +4097,8 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSig lnwire.Sig,
 	// pool to verify each of the HTLc signatures presented. Once
 	// generated, we'll submit these jobs to the worker pool.
 	verifyJobs, err := genHtlcSigValidationJobs(
-		localCommitmentView, keyRing, htlcSigs, lc.localChanCfg,
-		lc.remoteChanCfg,
+		lc.channelState.ChanType, localCommitmentView, keyRing,
+		htlcSigs, lc.localChanCfg, lc.remoteChanCfg,
 	)
 	if err != nil {
 		return err
@@ -4846,8 +4880,11 @@ func (lc *LightningChannel) ShortChanID() lnwire.ShortChannelID {
 
 // genHtlcScript generates the proper P2WSH public key scripts for the HTLC
 // output modified by two-bits denoting if this is an incoming HTLC, and if the
-// HTLC is being applied to their commitment transaction or ours.
-func genHtlcScript(isIncoming, ourCommit bool, timeout uint32, rHash [32]byte,
+// HTLC is being applied to their commitment transaction or ours. For channels
+// using anchor outputs, the scripts additionally carry a CSV delay of one
+// block on all non-revocation spend paths.
+func genHtlcScript(chanType channeldb.ChannelType, isIncoming, ourCommit bool,
+	timeout uint32, rHash [32]byte,
 	keyRing *CommitmentKeyRing) ([]byte, []byte, error) {
 
 	var (
@@ -4855,6 +4892,8 @@ func genHtlcScript(isIncoming, ourCommit bool, timeout uint32, rHash [32]byte,
 		err           error
 	)
 
+	confirmedSpend := chanType.HasAnchors()
+
 	// Generate the proper redeem scripts for the HTLC output modified by
 	// two-bits denoting if this is an incoming HTLC, and if the HTLC is
 	// being applied to their commitment transaction or ours.
@@ -4865,28 +4904,31 @@ func genHtlcScript(isIncoming, ourCommit bool, timeout uint32, rHash [32]byte,
 	case isIncoming && ourCommit:
 		witnessScript, err = input.ReceiverHTLCScript(timeout,
 			keyRing.RemoteHtlcKey, keyRing.LocalHtlcKey,
-			keyRing.RevocationKey, rHash[:])
+			keyRing.RevocationKey, rHash[:], confirmedSpend)
 
 	// We're being paid via an HTLC by the remote party, and the HTLC is
 	// being added to their commitment transaction, so we use the sender's
 	// version of the HTLC script.
 	case isIncoming && !ourCommit:
 		witnessScript, err = input.SenderHTLCScript(keyRing.RemoteHtlcKey,
-			keyRing.LocalHtlcKey, keyRing.RevocationKey, rHash[:])
+			keyRing.LocalHtlcKey, keyRing.RevocationKey, rHash[:],
+			confirmedSpend)
 
 	// We're sending an HTLC which is being added to our commitment
 	// transaction. Therefore, we need to use the sender's version of the
 	// HTLC script.
 	case !isIncoming && ourCommit:
 		witnessScript, err = input.SenderHTLCScript(keyRing.LocalHtlcKey,
-			keyRing.RemoteHtlcKey, keyRing.RevocationKey, rHash[:])
+			keyRing.RemoteHtlcKey, keyRing.RevocationKey, rHash[:],
+			confirmedSpend)
 
 	// Finally, we're paying the remote party via an HTLC, which is being
 	// added to their commitment transaction. Therefore, we use the
 	// receiver's version of the HTLC script.
 	case !isIncoming && !ourCommit:
 		witnessScript, err = input.ReceiverHTLCScript(timeout, keyRing.LocalHtlcKey,
-			keyRing.RemoteHtlcKey, keyRing.RevocationKey, rHash[:])
+			keyRing.RemoteHtlcKey, keyRing.RevocationKey, rHash[:],
+			confirmedSpend)
 	}
 	if err != nil {
 		return nil, nil, err
@@ -4916,8 +4958,10 @@ func (lc *LightningChannel) addHTLC(commitTx *wire.MsgTx, ourCommit bool,
 	timeout := paymentDesc.Timeout
 	rHash := paymentDesc.RHash
 
-	p2wsh, witnessScript, err := genHtlcScript(isIncoming, ourCommit,
-		timeout, rHash, keyRing)
+	p2wsh, witnessScript, err := genHtlcScript(
+		lc.channelState.ChanType, isIncoming, ourCommit, timeout,
+		rHash, keyRing,
+	)
 	if err != nil {
 		return err
 	}
@@ -4990,6 +5034,16 @@ type CommitOutputResolution struct {
 	MaturityDelay uint32
 }
 
+// AnchorResolution holds the information necessary to spend our anchor output
+// on a commitment transaction.
+type AnchorResolution struct {
+	// AnchorSignDescriptor is the sign descriptor for our anchor.
+	AnchorSignDescriptor input.SignDescriptor
+
+	// CommitAnchor is the anchor outpoint on the commitment transaction.
+	CommitAnchor wire.OutPoint
+}
+
 // UnilateralCloseSummary describes the details of a detected unilateral
 // channel closure. This includes the information about with which
 // transactions, and block the channel was unilaterally closed, as well as
@@ -5044,9 +5098,8 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 
 	// First, we'll generate the commitment point and the revocation point
 	// so we can re-construct the HTLC state and also our payment key.
-	tweaklessCommit := chanState.ChanType.IsTweakless()
 	keyRing := DeriveCommitmentKeys(
-		commitPoint, false, tweaklessCommit, &chanState.LocalChanCfg,
+		commitPoint, false, chanState.ChanType, &chanState.LocalChanCfg,
 		&chanState.RemoteChanCfg,
 	)
 
@@ -5056,6 +5109,7 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 		SatPerKWeight(remoteCommit.FeePerKw), false, signer,
 		remoteCommit.Htlcs, keyRing, &chanState.LocalChanCfg,
 		&chanState.RemoteChanCfg, *commitSpend.SpenderTxHash,
+		chanState.ChanType,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create htlc "+
@@ -5067,7 +5121,9 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 	// Before we can generate the proper sign descriptor, we'll need to
 	// locate the output index of our non-delayed output on the commitment
 	// transaction.
-	selfP2WKH, err := input.CommitScriptUnencumbered(keyRing.NoDelayKey)
+	selfScript, maturityDelay, err := CommitScriptToRemote(
+		chanState.ChanType, keyRing.NoDelayKey,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create self commit "+
 			"script: %v", err)
@@ -5079,7 +5135,7 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 	)
 
 	for outputIndex, txOut := range commitTxBroadcast.TxOut {
-		if bytes.Equal(txOut.PkScript, selfP2WKH) {
+		if bytes.Equal(txOut.PkScript, selfScript.PkScript) {
 			selfPoint = &wire.OutPoint{
 				Hash:  *commitSpend.SpenderTxHash,
 				Index: uint32(outputIndex),
@@ -5100,19 +5156,19 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 			SelfOutputSignDesc: input.SignDescriptor{
 				KeyDesc:       localPayBase,
 				SingleTweak:   keyRing.LocalCommitKeyTweak,
-				WitnessScript: selfP2WKH,
+				WitnessScript: selfScript.WitnessScript,
 				Output: &wire.TxOut{
 					Value:    localBalance,
-					PkScript: selfP2WKH,
+					PkScript: selfScript.PkScript,
 				},
 				HashType: txscript.SigHashAll | txscript.SigHashForkID,
 			},
-			MaturityDelay: 0,
+			MaturityDelay: maturityDelay,
 		}
 
 		// If this is a tweakless commitment, then we can safely blank
 		// out the SingleTweak value as it isn't needed.
-		if tweaklessCommit {
+		if chanState.ChanType.IsTweakless() {
 			commitResolution.SelfOutputSignDesc.SingleTweak = nil
 		}
 	}
@@ -5253,7 +5309,7 @@ type HtlcResolutions struct {
 func newOutgoingHtlcResolution(signer input.Signer, localChanCfg *channeldb.ChannelConfig,
 	commitHash chainhash.Hash, htlc *channeldb.HTLC, keyRing *CommitmentKeyRing,
 	feePerKw SatPerKWeight, dustLimit btcutil.Amount, csvDelay uint32, localCommit bool,
-) (*OutgoingHtlcResolution, error) {
+	chanType channeldb.ChannelType) (*OutgoingHtlcResolution, error) {
 
 	op := wire.OutPoint{
 		Hash:  commitHash,
@@ -5268,7 +5324,7 @@ func newOutgoingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 		// the remote party within their commitment transaction.
 		htlcReceiverScript, err := input.ReceiverHTLCScript(htlc.RefundTimeout,
 			keyRing.LocalHtlcKey, keyRing.RemoteHtlcKey,
-			keyRing.RevocationKey, htlc.RHash[:],
+			keyRing.RevocationKey, htlc.RHash[:], chanType.HasAnchors(),
 		)
 		if err != nil {
 			return nil, err
@@ -5279,10 +5335,13 @@ func newOutgoingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 		}
 
 		// With the script generated, we can completely populated the
-		// SignDescriptor needed to sweep the output.
+		// SignDescriptor needed to sweep the output. For anchor
+		// channels, the output can only be swept after a CSV delay of
+		// one block.
 		return &OutgoingHtlcResolution{
 			Expiry:        htlc.RefundTimeout,
 			ClaimOutpoint: op,
+			CsvDelay:      HtlcSecondLevelInputSequence(chanType),
 			SweepSignDesc: input.SignDescriptor{
 				KeyDesc:       localChanCfg.HtlcBasePoint,
 				SingleTweak:   keyRing.LocalHtlcKeyTweak,
@@ -5308,8 +5367,8 @@ func newOutgoingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 	// With the fee calculated, re-construct the second level timeout
 	// transaction.
 	timeoutTx, err := createHtlcTimeoutTx(
-		op, secondLevelOutputAmt, htlc.RefundTimeout, csvDelay,
-		keyRing.RevocationKey, keyRing.DelayKey,
+		chanType, op, secondLevelOutputAmt, htlc.RefundTimeout,
+		csvDelay, keyRing.RevocationKey, keyRing.DelayKey,
 	)
 	if err != nil {
 		return nil, err
@@ -5319,7 +5378,8 @@ func newOutgoingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 	// that's capable of generating the signature required to spend the
 	// HTLC output using the timeout transaction.
 	htlcCreationScript, err := input.SenderHTLCScript(keyRing.LocalHtlcKey,
-		keyRing.RemoteHtlcKey, keyRing.RevocationKey, htlc.RHash[:])
+		keyRing.RemoteHtlcKey, keyRing.RevocationKey, htlc.RHash[:],
+		chanType.HasAnchors())
 	if err != nil {
 		return nil, err
 	}
@@ -5393,7 +5453,8 @@ func newOutgoingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 func newIncomingHtlcResolution(signer input.Signer, localChanCfg *channeldb.ChannelConfig,
 	commitHash chainhash.Hash, htlc *channeldb.HTLC, keyRing *CommitmentKeyRing,
 	feePerKw SatPerKWeight, dustLimit btcutil.Amount, csvDelay uint32,
-	localCommit bool) (*IncomingHtlcResolution, error) {
+	localCommit bool,
+	chanType channeldb.ChannelType) (*IncomingHtlcResolution, error) {
 
 	op := wire.OutPoint{
 		Hash:  commitHash,
@@ -5408,6 +5469,7 @@ func newIncomingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 		htlcSenderScript, err := input.SenderHTLCScript(
 			keyRing.RemoteHtlcKey, keyRing.LocalHtlcKey,
 			keyRing.RevocationKey, htlc.RHash[:],
+			chanType.HasAnchors(),
 		)
 		if err != nil {
 			return nil, err
@@ -5418,10 +5480,12 @@ func newIncomingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 		}
 
 		// With the script generated, we can completely populated the
-		// SignDescriptor needed to sweep the output.
+		// SignDescriptor needed to sweep the output. For anchor
+		// channels, the output can only be swept after a CSV delay of
+		// one block.
 		return &IncomingHtlcResolution{
 			ClaimOutpoint: op,
-			CsvDelay:      csvDelay,
+			CsvDelay:      HtlcSecondLevelInputSequence(chanType),
 			SweepSignDesc: input.SignDescriptor{
 				KeyDesc:       localChanCfg.HtlcBasePoint,
 				SingleTweak:   keyRing.LocalHtlcKeyTweak,
@@ -5442,7 +5506,7 @@ func newIncomingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 	htlcFee := htlcSuccessFee(feePerKw)
 	secondLevelOutputAmt := htlc.Amt.ToSatoshis() - htlcFee
 	successTx, err := createHtlcSuccessTx(
-		op, secondLevelOutputAmt, csvDelay,
+		chanType, op, secondLevelOutputAmt, csvDelay,
 		keyRing.RevocationKey, keyRing.DelayKey,
 	)
 	if err != nil {
@@ -5453,7 +5517,7 @@ func newIncomingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 	// SignDesc needed spend the HTLC output using the success transaction.
 	htlcCreationScript, err := input.ReceiverHTLCScript(htlc.RefundTimeout,
 		keyRing.RemoteHtlcKey, keyRing.LocalHtlcKey,
-		keyRing.RevocationKey, htlc.RHash[:],
+		keyRing.RevocationKey, htlc.RHash[:], chanType.HasAnchors(),
 	)
 	if err != nil {
 		return nil, err
@@ -5549,7 +5613,8 @@ func (r *OutgoingHtlcResolution) HtlcPoint() wire.OutPoint {
 func extractHtlcResolutions(feePerKw SatPerKWeight, ourCommit bool,
 	signer input.Signer, htlcs []channeldb.HTLC, keyRing *CommitmentKeyRing,
 	localChanCfg, remoteChanCfg *channeldb.ChannelConfig,
-	commitHash chainhash.Hash) (*HtlcResolutions, error) {
+	commitHash chainhash.Hash,
+	chanType channeldb.ChannelType) (*HtlcResolutions, error) {
 
 	// TODO(roasbeef): don't need to swap csv delay?
 	dustLimit := remoteChanCfg.DustLimit
@@ -5578,6 +5643,7 @@ func extractHtlcResolutions(feePerKw SatPerKWeight, ourCommit bool,
 			ihr, err := newIncomingHtlcResolution(
 				signer, localChanCfg, commitHash, &htlc, keyRing,
 				feePerKw, dustLimit, uint32(csvDelay), ourCommit,
+				chanType,
 			)
 			if err != nil {
 				return nil, err
@@ -5590,6 +5656,7 @@ func extractHtlcResolutions(feePerKw SatPerKWeight, ourCommit bool,
 		ohr, err := newOutgoingHtlcResolution(
 			signer, localChanCfg, commitHash, &htlc, keyRing,
 			feePerKw, dustLimit, uint32(csvDelay), ourCommit,
+			chanType,
 		)
 		if err != nil {
 			return nil, err
@@ -5633,6 +5700,14 @@ type LocalForceCloseSummary struct {
 	// HTLC's, we'll need to go to the second level to sweep them fully.
 	HtlcResolutions *HtlcResolutions
 
+	// AnchorResolution contains the data required to sweep our anchor
+	// output, which can be used to bump the fee of the commitment
+	// transaction through CPFP.
+	//
+	// NOTE: This will be nil if the channel doesn't use anchor outputs,
+	// or if our anchor isn't present on the commitment.
+	AnchorResolution *AnchorResolution
+
 	// ChanSnapshot is a snapshot of the final state of the channel at the
 	// time the summary was created.
 	ChanSnapshot channeldb.ChannelSnapshot
@@ -5701,7 +5776,7 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 	}
 	commitPoint := input.ComputeCommitmentPoint(revocation[:])
 	keyRing := DeriveCommitmentKeys(
-		commitPoint, true, chanState.ChanType.IsTweakless(),
+		commitPoint, true, chanState.ChanType,
 		&chanState.LocalChanCfg, &chanState.RemoteChanCfg,
 	)
 	selfScript, err := input.CommitScriptToSelf(csvTimeout, keyRing.DelayKey,
@@ -5769,21 +5844,80 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 	htlcResolutions, err := extractHtlcResolutions(
 		SatPerKWeight(localCommit.FeePerKw), true, signer,
 		localCommit.Htlcs, keyRing, &chanState.LocalChanCfg,
-		&chanState.RemoteChanCfg, txHash,
+		&chanState.RemoteChanCfg, txHash, chanState.ChanType,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// Finally, if this channel has anchor outputs, we'll locate our anchor
+	// such that it can be used to bump the fee of the commitment.
+	anchorResolution, err := NewAnchorResolution(chanState, commitTx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &LocalForceCloseSummary{
 		ChanPoint:        chanState.FundingOutpoint,
 		CloseTx:          commitTx,
 		CommitResolution: commitResolution,
 		HtlcResolutions:  htlcResolutions,
+		AnchorResolution: anchorResolution,
 		ChanSnapshot:     *chanState.Snapshot(),
 	}, nil
 }
 
+// NewAnchorResolution returns the information that is required to spend our
+// anchor output on the passed commitment transaction. If the channel doesn't
+// use anchor outputs, or our anchor isn't present on the commitment, nil is
+// returned.
+func NewAnchorResolution(chanState *channeldb.OpenChannel,
+	commitTx *wire.MsgTx) (*AnchorResolution, error) {
+
+	if !chanState.ChanType.HasAnchors() {
+		return nil, nil
+	}
+
+	// Our anchor is locked to our funding key, so we'll re-create the
+	// script to locate it within the commitment transaction.
+	localAnchor, err := CommitScriptAnchor(
+		chanState.LocalChanCfg.MultiSigKey.PubKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorIndex := -1
+	for i, txOut := range commitTx.TxOut {
+		if bytes.Equal(txOut.PkScript, localAnchor.PkScript) {
+			anchorIndex = i
+			break
+		}
+	}
+
+	// If our anchor was trimmed from the commitment, there's nothing for
+	// us to spend.
+	if anchorIndex == -1 {
+		return nil, nil
+	}
+
+	return &AnchorResolution{
+		CommitAnchor: wire.OutPoint{
+			Hash:  commitTx.TxHash(),
+			Index: uint32(anchorIndex),
+		},
+		AnchorSignDescriptor: input.SignDescriptor{
+			KeyDesc:       chanState.LocalChanCfg.MultiSigKey,
+			WitnessScript: localAnchor.WitnessScript,
+			Output: &wire.TxOut{
+				PkScript: localAnchor.PkScript,
+				Value:    int64(anchorSize),
+			},
+			HashType: txscript.SigHashAll | txscript.SigHashForkID,
+		},
+	}, nil
+}
+
 // CreateCloseProposal is used by both parties in a cooperative channel close
 // workflow to generate proposed close transactions and signatures. This method
 // should only be executed once all pending HTLCs (if any) on the channel have
@@ -5815,8 +5949,10 @@ func (lc *LightningChannel) CreateCloseProposal(proposedFee btcutil.Amount,
 	theirBalance := localCommit.RemoteBalance.ToSatoshis()
 
 	// We'll make sure we account for the complete balance by adding the
-	// current dangling commitment fee to the balance of the initiator.
-	commitFee := localCommit.CommitFee
+	// current dangling commitment fee, and the value of any anchor
+	// outputs, to the balance of the initiator.
+	commitFee := localCommit.CommitFee +
+		anchorsValue(lc.channelState.ChanType)
 	if lc.channelState.IsInitiator {
 		ourBalance = ourBalance - proposedFee + commitFee
 	} else {
@@ -5882,8 +6018,10 @@ func (lc *LightningChannel) CompleteCooperativeClose(localSig, remoteSig []byte,
 	theirBalance := localCommit.RemoteBalance.ToSatoshis()
 
 	// We'll make sure we account for the complete balance by adding the
-	// current dangling commitment fee to the balance of the initiator.
-	commitFee := localCommit.CommitFee
+	// current dangling commitment fee, and the value of any anchor
+	// outputs, to the balance of the initiator.
+	commitFee := localCommit.CommitFee +
+		anchorsValue(lc.channelState.ChanType)
 	if lc.channelState.IsInitiator {
 		ourBalance = ourBalance - proposedFee + commitFee
 	} else {
@@ -6127,10 +6265,14 @@ func (lc *LightningChannel) generateRevocation(height uint64) (*lnwire.RevokeAnd
 // funding output. The commitment transaction contains two outputs: one paying
 // to the "owner" of the commitment transaction which can be spent after a
 // relative block delay or revocation event, and the other paying the
-// counterparty within the channel, which can be spent immediately.
-func CreateCommitTx(fundingOutput wire.TxIn,
+// counterparty within the channel, which can be spent immediately. For
+// channels using anchor outputs, the output paying the counterparty is
+// delayed by one block, and an anchor output is added for each party that
+// has an output on the commitment, or if numHTLCs is non-zero.
+func CreateCommitTx(chanType channeldb.ChannelType, fundingOutput wire.TxIn,
 	keyRing *CommitmentKeyRing, csvTimeout uint32,
-	amountToSelf, amountToThem, dustLimit btcutil.Amount) (*wire.MsgTx, error) {
+	amountToSelf, amountToThem, dustLimit btcutil.Amount,
+	numHTLCs int64) (*wire.MsgTx, error) {
 
 	// First, we create the script for the delayed "pay-to-self" output.
 	// This output has 2 main redemption clauses: either we can redeem the
@@ -6148,8 +6290,11 @@ func CreateCommitTx(fundingOutput wire.TxIn,
 	}
 
 	// Next, we create the script paying to them. This is just a regular
-	// P2WPKH output, without any added CSV delay.
-	theirWitnessKeyHash, err := input.CommitScriptUnencumbered(keyRing.NoDelayKey)
+	// P2WPKH output, without any added CSV delay, unless this channel uses
+	// anchor outputs.
+	toRemoteScript, _, err := CommitScriptToRemote(
+		chanType, keyRing.NoDelayKey,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -6161,19 +6306,51 @@ func CreateCommitTx(fundingOutput wire.TxIn,
 	commitTx.AddTxIn(&fundingOutput)
 
 	// Avoid creating dust outputs within the commitment transaction.
-	if amountToSelf >= dustLimit {
+	localOutput := amountToSelf >= dustLimit
+	if localOutput {
 		commitTx.AddTxOut(&wire.TxOut{
 			PkScript: payToUsScriptHash,
 			Value:    int64(amountToSelf),
 		})
 	}
-	if amountToThem >= dustLimit {
+	remoteOutput := amountToThem >= dustLimit
+	if remoteOutput {
 		commitTx.AddTxOut(&wire.TxOut{
-			PkScript: theirWitnessKeyHash,
+			PkScript: toRemoteScript.PkScript,
 			Value:    int64(amountToThem),
 		})
 	}
 
+	// If this channel type has anchors, we'll also add those. An anchor is
+	// only added for a party if they have an output on the commitment, or
+	// if there are any HTLCs outstanding, such that both parties are able
+	// to bump the fee of the commitment.
+	if chanType.HasAnchors() {
+		localAnchor, err := CommitScriptAnchor(keyRing.ToLocalAnchorKey)
+		if err != nil {
+			return nil, err
+		}
+		remoteAnchor, err := CommitScriptAnchor(
+			keyRing.ToRemoteAnchorKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if localOutput || numHTLCs > 0 {
+			commitTx.AddTxOut(&wire.TxOut{
+				PkScript: localAnchor.PkScript,
+				Value:    int64(anchorSize),
+			})
+		}
+		if remoteOutput || numHTLCs > 0 {
+			commitTx.AddTxOut(&wire.TxOut{
+				PkScript: remoteAnchor.PkScript,
+				Value:    int64(anchorSize),
+			})
+		}
+	}
+
 	return commitTx, nil
 }
 
@@ -6218,7 +6395,7 @@ func CreateCooperativeCloseTx(fundingTxIn wire.TxIn,
 // CalcFee returns the commitment fee to use for the given
 // fee rate (fee-per-kw).
 func (lc *LightningChannel) CalcFee(feeRate SatPerKWeight) btcutil.Amount {
-	return feeRate.FeeForWeight(input.CommitWeight)
+	return feeRate.FeeForWeight(CommitWeight(lc.channelState.ChanType))
 }
 
 // MaxFeeRate returns the maximum fee rate given an allocation of the channel
@@ -6285,6 +6462,18 @@ func (lc *LightningChannel) State() *channeldb.OpenChannel {
 	return lc.channelState
 }
 
+// LocalUpfrontShutdownScript returns the local upfront shutdown script for the
+// channel. If it was not set, an empty byte array is returned.
+func (lc *LightningChannel) LocalUpfrontShutdownScript() lnwire.DeliveryAddress {
+	return lc.channelState.LocalShutdownScript
+}
+
+// RemoteUpfrontShutdownScript returns the remote upfront shutdown script for
+// the channel. If it was not set, an empty byte array is returned.
+func (lc *LightningChannel) RemoteUpfrontShutdownScript() lnwire.DeliveryAddress {
+	return lc.channelState.RemoteShutdownScript
+}
+
 // MarkBorked marks the event when the channel as reached an irreconcilable
 // state, such as a channel breach or state desynchronization. Borked channels
 // should never be added to the switch.
@@ -142,6 +142,32 @@ func ErrChanTooSmall(chanSize, minChanSize btcutil.Amount) ReservationError {
 	}
 }
 
+// ErrInvalidUpfrontShutdown returns an error indicating that the upfront
+// shutdown script committed to by the remote peer doesn't pay to any of the
+// standard output types that a cooperative close may pay out to.
+func ErrInvalidUpfrontShutdown(script lnwire.DeliveryAddress) ReservationError {
+	return ReservationError{
+		fmt.Errorf("invalid upfront shutdown script: %x",
+			[]byte(script)),
+	}
+}
+
+// ErrZeroConfNotRequested returns an error indicating that the responder
+// doesn't require any confirmations for a channel that we didn't request to
+// be a zero-conf channel.
+func ErrZeroConfNotRequested() ReservationError {
+	return ReservationError{
+		errors.New("minimum depth of zero is only accepted for " +
+			"zero-conf channels"),
+	}
+}
+
+// ErrChanRejected returns an error carrying the reason for which we rejected
+// a channel opening request from the remote peer.
+func ErrChanRejected(reason string) ReservationError {
+	return ReservationError{errors.New(reason)}
+}
+
 // ErrHtlcIndexAlreadyFailed is returned when the HTLC index has already been
 // failed, but has not been committed by our commitment state.
 type ErrHtlcIndexAlreadyFailed uint64
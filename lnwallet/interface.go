@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/btgsuite/btgd/btcec"
 	"github.com/btgsuite/btgd/chaincfg/chainhash"
@@ -46,8 +47,30 @@ var (
 	// ErrNotMine is an error denoting that a WalletController instance is
 	// unable to spend a specified output.
 	ErrNotMine = errors.New("the passed output doesn't belong to the wallet")
+
+	// ErrOutputAlreadyLeased is returned when attempting to lease an output
+	// that is already leased under a different ID, or locked by the wallet
+	// itself.
+	ErrOutputAlreadyLeased = errors.New("output already leased")
+
+	// ErrOutputUnlockNotAllowed is returned when attempting to release an
+	// output that is leased under a different ID.
+	ErrOutputUnlockNotAllowed = errors.New("output lease not owned by " +
+		"the given ID")
+
+	// ErrUnknownOutput is returned when attempting to lease an output that
+	// isn't a known unspent output of the wallet.
+	ErrUnknownOutput = errors.New("unknown unspent output")
 )
 
+// DefaultLeaseDuration is the duration an output is leased for if the caller
+// doesn't specify one.
+const DefaultLeaseDuration = 10 * time.Minute
+
+// LockID is an arbitrary identifier chosen by the party leasing an output. An
+// output can only be released again by the owner of the ID it was leased with.
+type LockID [32]byte
+
 // ErrNoOutputs is returned if we try to create a transaction with no outputs
 // or send coins to a set of outputs that is empty.
 var ErrNoOutputs = errors.New("no outputs")
@@ -207,9 +230,28 @@ type WalletController interface {
 	LockOutpoint(o wire.OutPoint)
 
 	// UnlockOutpoint unlocks a previously locked output, marking it
-	// eligible for coin selection.
+	// eligible for coin selection. Outputs that are currently leased
+	// remain locked until their lease is released or expires.
 	UnlockOutpoint(o wire.OutPoint)
 
+	// LeaseOutput locks an output to the given ID for the given duration,
+	// preventing it from being available for coin selection. Unlike
+	// LockOutpoint, leases are persisted and survive restarts. Leasing an
+	// output that is already leased under the same ID extends the lease.
+	// The absolute time at which the lease expires is returned.
+	//
+	// ErrOutputAlreadyLeased is returned if the output is leased under a
+	// different ID or locked by the wallet itself, and ErrUnknownOutput is
+	// returned if the output isn't a known unspent output of the wallet.
+	LeaseOutput(id LockID, op wire.OutPoint,
+		duration time.Duration) (time.Time, error)
+
+	// ReleaseOutput unlocks an output previously leased under the given ID,
+	// allowing it to be used for coin selection again. Releasing an output
+	// that isn't leased is a no-op. ErrOutputUnlockNotAllowed is returned
+	// if the output is leased under a different ID.
+	ReleaseOutput(id LockID, op wire.OutPoint) error
+
 	// PublishTransaction performs cursory validation (dust checks, etc),
 	// then finally broadcasts the passed transaction to the Bitcoin network.
 	// If the transaction is rejected because it is conflicting with an
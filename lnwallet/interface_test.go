@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"math/rand"
 	"net"
 	"os"
@@ -20,6 +21,7 @@ import (
 	"github.com/BTCGPU/lnd/chainntnfs"
 	"github.com/BTCGPU/lnd/chainntnfs/btcdnotify"
 	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/input"
 	"github.com/BTCGPU/lnd/keychain"
 	"github.com/BTCGPU/lnd/lnwallet"
@@ -39,7 +41,6 @@ import (
 	"github.com/btgsuite/btgwallet/chain"
 	"github.com/btgsuite/btgwallet/walletdb"
 	_ "github.com/btgsuite/btgwallet/walletdb/bdb"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 )
 
@@ -698,7 +699,7 @@ func testCancelNonExistentReservation(miner *rpctest.Harness,
 	// Create our own reservation, give it some ID.
 	res, err := lnwallet.NewChannelReservation(
 		10000, 10000, feePerKw, alice, 22, 10, &testHdSeed,
-		lnwire.FFAnnounceChannel, true,
+		lnwire.FFAnnounceChannel, true, false,
 	)
 	if err != nil {
 		t.Fatalf("unable to create res: %v", err)
@@ -2581,6 +2582,90 @@ var walletTests = []walletTestCase{
 		name: "test sign create account",
 		test: testSignOutputCreateAccount,
 	},
+	{
+		name: "lease output",
+		test: testLeaseOutput,
+	},
+}
+
+// testLeaseOutput tests that leased outputs are excluded from coin selection
+// until they're released, and that only the owner of a lease can release it.
+func testLeaseOutput(r *rpctest.Harness, w *lnwallet.LightningWallet,
+	_ *lnwallet.LightningWallet, t *testing.T) {
+
+	// Send some money from the miner to the wallet.
+	err := loadTestCredits(r, w, 20, 4)
+	if err != nil {
+		t.Fatalf("unable to send money to lnwallet: %v", err)
+	}
+
+	utxos, err := w.ListUnspentWitness(0, math.MaxInt32)
+	if err != nil {
+		t.Fatalf("unable to list utxos: %v", err)
+	}
+	if len(utxos) == 0 {
+		t.Fatalf("expected wallet utxos")
+	}
+	op := utxos[0].OutPoint
+
+	isListed := func() bool {
+		utxos, err := w.ListUnspentWitness(0, math.MaxInt32)
+		if err != nil {
+			t.Fatalf("unable to list utxos: %v", err)
+		}
+
+		for _, utxo := range utxos {
+			if utxo.OutPoint == op {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	// Once leased, the output should no longer be available for coin
+	// selection.
+	id1 := lnwallet.LockID{1}
+	_, err = w.LeaseOutput(id1, op, time.Minute)
+	if err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+	if isListed() {
+		t.Fatalf("leased output %v still listed", op)
+	}
+
+	// The output can't be leased or released under a different ID.
+	id2 := lnwallet.LockID{2}
+	_, err = w.LeaseOutput(id2, op, time.Minute)
+	if err != lnwallet.ErrOutputAlreadyLeased {
+		t.Fatalf("expected ErrOutputAlreadyLeased, got %v", err)
+	}
+	err = w.ReleaseOutput(id2, op)
+	if err != lnwallet.ErrOutputUnlockNotAllowed {
+		t.Fatalf("expected ErrOutputUnlockNotAllowed, got %v", err)
+	}
+
+	// A regular unlock shouldn't release the lease either.
+	w.UnlockOutpoint(op)
+	if isListed() {
+		t.Fatalf("leased output %v listed after unlock", op)
+	}
+
+	// After releasing the output with the original ID, it should be
+	// available again.
+	if err := w.ReleaseOutput(id1, op); err != nil {
+		t.Fatalf("unable to release output: %v", err)
+	}
+	if !isListed() {
+		t.Fatalf("released output %v not listed", op)
+	}
+
+	// Finally, leasing an output unknown to the wallet should fail.
+	unknownOp := wire.OutPoint{Index: 1}
+	_, err = w.LeaseOutput(id1, unknownOp, time.Minute)
+	if err != lnwallet.ErrUnknownOutput {
+		t.Fatalf("expected ErrUnknownOutput, got %v", err)
+	}
 }
 
 func clearWalletStates(a, b *lnwallet.LightningWallet) error {
@@ -3008,7 +3093,7 @@ func runTests(t *testing.T, walletDriver *lnwallet.WalletDriver,
 		// node's chainstate to initial level, cleanly
 		// wipe buckets
 		if err := clearWalletStates(alice, bob); err !=
-			nil && err != bbolt.ErrBucketNotFound {
+			nil && err != kvdb.ErrBucketNotFound {
 			t.Fatalf("unable to wipe wallet state: %v", err)
 		}
 	}
@@ -0,0 +1,167 @@
+package lnwallet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btgsuite/btgd/wire"
+)
+
+const (
+	// psbtGlobalUnsignedTx is the key type of the global unsigned
+	// transaction within a PSBT.
+	psbtGlobalUnsignedTx = 0x00
+
+	// psbtInFinalScriptSig is the key type of the finalized signature
+	// script of an input within a PSBT.
+	psbtInFinalScriptSig = 0x07
+
+	// psbtInFinalScriptWitness is the key type of the finalized witness
+	// of an input within a PSBT.
+	psbtInFinalScriptWitness = 0x08
+
+	// psbtMaxFieldSize is the maximum size of a single key or value that
+	// we'll read from a PSBT.
+	psbtMaxFieldSize = wire.MaxMessagePayload
+
+	// psbtMaxWitnessItems is the maximum number of items that we'll read
+	// from a single finalized witness within a PSBT.
+	psbtMaxWitnessItems = 500000
+)
+
+var (
+	// psbtMagic is the magic byte sequence that every PSBT starts with.
+	psbtMagic = []byte{0x70, 0x73, 0x62, 0x74, 0xff}
+
+	// ErrPsbtNotFinalized is returned when a PSBT is passed to the wallet
+	// that doesn't carry a finalized script for each of its inputs.
+	ErrPsbtNotFinalized = errors.New("psbt is not finalized")
+)
+
+// psbtMap is a single key-value map of a PSBT, indexed by the key type.
+type psbtMap map[byte][]byte
+
+// readPsbtMap reads a single key-value map from a PSBT, up to and including
+// its separator. Only keys that consist of the key type alone are recorded,
+// all other keys are irrelevant for extracting the final transaction.
+func readPsbtMap(r io.Reader) (psbtMap, error) {
+	m := make(psbtMap)
+	for {
+		key, err := wire.ReadVarBytes(r, 0, psbtMaxFieldSize, "key")
+		if err != nil {
+			return nil, err
+		}
+
+		// A zero length key marks the end of the map.
+		if len(key) == 0 {
+			return m, nil
+		}
+
+		value, err := wire.ReadVarBytes(r, 0, psbtMaxFieldSize, "value")
+		if err != nil {
+			return nil, err
+		}
+
+		if len(key) != 1 {
+			continue
+		}
+		if _, ok := m[key[0]]; ok {
+			return nil, fmt.Errorf("duplicate psbt key type %x",
+				key[0])
+		}
+		m[key[0]] = value
+	}
+}
+
+// extractFinalPsbtTx parses the given serialized PSBT and returns the final
+// transaction it describes. Every input of the PSBT must be finalized, such
+// that the returned transaction is fully signed and ready to be published.
+func extractFinalPsbtTx(packet []byte) (*wire.MsgTx, error) {
+	r := bytes.NewReader(packet)
+
+	magic := make([]byte, len(psbtMagic))
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(magic, psbtMagic) {
+		return nil, errors.New("invalid psbt magic bytes")
+	}
+
+	globals, err := readPsbtMap(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read psbt globals: %v", err)
+	}
+	rawTx, ok := globals[psbtGlobalUnsignedTx]
+	if !ok {
+		return nil, errors.New("psbt is missing the unsigned tx")
+	}
+
+	tx := wire.NewMsgTx(2)
+	if err := tx.DeserializeNoWitness(bytes.NewReader(rawTx)); err != nil {
+		return nil, fmt.Errorf("unable to decode unsigned tx: %v", err)
+	}
+
+	// The input maps follow the globals in the order of the inputs of the
+	// unsigned transaction. We'll attach the finalized scripts of each of
+	// them to the transaction.
+	for i, txIn := range tx.TxIn {
+		inputMap, err := readPsbtMap(r)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read psbt input "+
+				"%d: %v", i, err)
+		}
+
+		sigScript, hasSigScript := inputMap[psbtInFinalScriptSig]
+		rawWitness, hasWitness := inputMap[psbtInFinalScriptWitness]
+		if !hasSigScript && !hasWitness {
+			return nil, ErrPsbtNotFinalized
+		}
+
+		txIn.SignatureScript = sigScript
+		if hasWitness {
+			txIn.Witness, err = readPsbtWitness(rawWitness)
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode "+
+					"witness of input %d: %v", i, err)
+			}
+		}
+	}
+
+	// The output maps don't carry anything we need for the final
+	// transaction, so we only make sure that they are present.
+	for i := range tx.TxOut {
+		if _, err := readPsbtMap(r); err != nil {
+			return nil, fmt.Errorf("unable to read psbt output "+
+				"%d: %v", i, err)
+		}
+	}
+
+	return tx, nil
+}
+
+// readPsbtWitness decodes a finalized witness stack from a PSBT.
+func readPsbtWitness(rawWitness []byte) (wire.TxWitness, error) {
+	r := bytes.NewReader(rawWitness)
+
+	numItems, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	if numItems > psbtMaxWitnessItems {
+		return nil, fmt.Errorf("too many witness items: %v", numItems)
+	}
+
+	witness := make(wire.TxWitness, numItems)
+	for i := range witness {
+		witness[i], err = wire.ReadVarBytes(
+			r, 0, psbtMaxFieldSize, "witness item",
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return witness, nil
+}
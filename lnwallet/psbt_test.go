@@ -0,0 +1,140 @@
+package lnwallet
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/btgsuite/btgd/wire"
+)
+
+// psbtField is a single key-value pair of a PSBT map.
+type psbtField struct {
+	key   []byte
+	value []byte
+}
+
+// serializePsbt serializes a PSBT for the given unsigned transaction, with the
+// given fields for each of its inputs.
+func serializePsbt(t *testing.T, tx *wire.MsgTx,
+	inputs [][]psbtField) []byte {
+
+	var b bytes.Buffer
+	b.Write(psbtMagic)
+
+	writeMap := func(fields []psbtField) {
+		for _, field := range fields {
+			if err := wire.WriteVarBytes(&b, 0, field.key); err != nil {
+				t.Fatalf("unable to write key: %v", err)
+			}
+			err := wire.WriteVarBytes(&b, 0, field.value)
+			if err != nil {
+				t.Fatalf("unable to write value: %v", err)
+			}
+		}
+		b.WriteByte(0x00)
+	}
+
+	var rawTx bytes.Buffer
+	if err := tx.SerializeNoWitness(&rawTx); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+	writeMap([]psbtField{{
+		key:   []byte{psbtGlobalUnsignedTx},
+		value: rawTx.Bytes(),
+	}})
+
+	for _, fields := range inputs {
+		writeMap(fields)
+	}
+	for range tx.TxOut {
+		writeMap(nil)
+	}
+
+	return b.Bytes()
+}
+
+// serializeWitness serializes a witness stack in the format used by PSBTs.
+func serializeWitness(t *testing.T, witness wire.TxWitness) []byte {
+	var b bytes.Buffer
+	if err := wire.WriteVarInt(&b, 0, uint64(len(witness))); err != nil {
+		t.Fatalf("unable to write witness: %v", err)
+	}
+	for _, item := range witness {
+		if err := wire.WriteVarBytes(&b, 0, item); err != nil {
+			t.Fatalf("unable to write witness: %v", err)
+		}
+	}
+
+	return b.Bytes()
+}
+
+// TestExtractFinalPsbtTx tests that the final transaction is extracted from a
+// finalized PSBT, and that PSBTs that aren't finalized are rejected.
+func TestExtractFinalPsbtTx(t *testing.T) {
+	t.Parallel()
+
+	unsignedTx := wire.NewMsgTx(2)
+	unsignedTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: 1},
+	})
+	unsignedTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: 2},
+	})
+	unsignedTx.AddTxOut(&wire.TxOut{
+		Value:    100000,
+		PkScript: []byte{0x00, 0x20},
+	})
+
+	witness := wire.TxWitness{{0x01, 0x02}, {0x03}}
+	sigScript := []byte{0x04, 0x05}
+
+	finalizedInputs := [][]psbtField{
+		{
+			// Fields that aren't needed for the final transaction
+			// should be skipped.
+			{key: []byte{0x06, 0x01}, value: []byte{0x01}},
+			{
+				key:   []byte{psbtInFinalScriptWitness},
+				value: serializeWitness(t, witness),
+			},
+		},
+		{
+			{key: []byte{psbtInFinalScriptSig}, value: sigScript},
+		},
+	}
+
+	tx, err := extractFinalPsbtTx(
+		serializePsbt(t, unsignedTx, finalizedInputs),
+	)
+	if err != nil {
+		t.Fatalf("unable to extract tx: %v", err)
+	}
+
+	expectedTx := unsignedTx.Copy()
+	expectedTx.TxIn[0].Witness = witness
+	expectedTx.TxIn[1].SignatureScript = sigScript
+	if !reflect.DeepEqual(tx, expectedTx) {
+		t.Fatalf("expected tx %v, got %v", expectedTx, tx)
+	}
+
+	// A PSBT that misses the final scripts of one of its inputs must be
+	// rejected.
+	_, err = extractFinalPsbtTx(
+		serializePsbt(t, unsignedTx, [][]psbtField{
+			finalizedInputs[0], nil,
+		}),
+	)
+	if err != ErrPsbtNotFinalized {
+		t.Fatalf("expected ErrPsbtNotFinalized, got: %v", err)
+	}
+
+	// Finally, anything that isn't a PSBT must be rejected as well.
+	var rawTx bytes.Buffer
+	if err := unsignedTx.Serialize(&rawTx); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+	if _, err := extractFinalPsbtTx(rawTx.Bytes()); err == nil {
+		t.Fatalf("expected raw tx to be rejected")
+	}
+}
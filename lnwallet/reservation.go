@@ -1,6 +1,7 @@
 package lnwallet
 
 import (
+	"errors"
 	"net"
 	"sync"
 
@@ -42,6 +43,10 @@ type ChannelContribution struct {
 	// such as the min HTLC, and also all the keys which will be used for
 	// the duration of the channel.
 	*channeldb.ChannelConfig
+
+	// UpfrontShutdown is an optional address to which the channel should
+	// be paid out to on cooperative close.
+	UpfrontShutdown lnwire.DeliveryAddress
 }
 
 // toChanConfig returns the raw channel configuration generated by a node's
@@ -104,6 +109,11 @@ type ChannelReservation struct {
 	partialState *channeldb.OpenChannel
 	nodeAddr     net.Addr
 
+	// externalFunding is true if the funding transaction of this
+	// reservation is assembled and signed outside of the reservation,
+	// either through a PSBT or as part of a batch of channels.
+	externalFunding bool
+
 	// The ID of this reservation, used to uniquely track the reservation
 	// throughout its lifetime.
 	reservationID uint64
@@ -130,20 +140,35 @@ type ChannelReservation struct {
 func NewChannelReservation(capacity, localFundingAmt btcutil.Amount,
 	commitFeePerKw SatPerKWeight, wallet *LightningWallet,
 	id uint64, pushMSat lnwire.MilliSatoshi, chainHash *chainhash.Hash,
-	flags lnwire.FundingFlag,
-	tweaklessCommit bool) (*ChannelReservation, error) {
+	flags lnwire.FundingFlag, tweaklessCommit,
+	anchors bool) (*ChannelReservation, error) {
 	var (
 		ourBalance   lnwire.MilliSatoshi
 		theirBalance lnwire.MilliSatoshi
 		initiator    bool
 	)
 
-	commitFee := commitFeePerKw.FeeForWeight(input.CommitWeight)
+	// Channels using anchor outputs are always tweakless, and are in
+	// addition charged the value of the two anchors, which is paid for by
+	// the initiator alongside the commitment fee.
+	singleFunderType := channeldb.SingleFunder
+	switch {
+	case anchors:
+		singleFunderType = channeldb.SingleFunderAnchors
+	case tweaklessCommit:
+		singleFunderType = channeldb.SingleFunderTweakless
+	}
+
+	commitFee := commitFeePerKw.FeeForWeight(
+		CommitWeight(singleFunderType),
+	)
 	localFundingMSat := lnwire.NewMSatFromSatoshis(localFundingAmt)
 	// TODO(halseth): make method take remote funding amount directly
 	// instead of inferring it from capacity and local amt.
 	capacityMSat := lnwire.NewMSatFromSatoshis(capacity)
-	feeMSat := lnwire.NewMSatFromSatoshis(commitFee)
+	feeMSat := lnwire.NewMSatFromSatoshis(
+		commitFee + anchorsValue(singleFunderType),
+	)
 
 	// If we're the responder to a single-funder reservation, then we have
 	// no initial balance in the channel unless the remote party is pushing
@@ -213,11 +238,7 @@ func NewChannelReservation(capacity, localFundingAmt btcutil.Amount,
 	// non-zero push amt (there's no pushing for dual funder), then this is
 	// a single-funder channel.
 	if ourBalance == 0 || theirBalance == 0 || pushMSat != 0 {
-		if tweaklessCommit {
-			chanType = channeldb.SingleFunderTweakless
-		} else {
-			chanType = channeldb.SingleFunder
-		}
+		chanType = singleFunderType
 	} else {
 		// Otherwise, this is a dual funder channel, and no side is
 		// technically the "initiator"
@@ -275,6 +296,25 @@ func (r *ChannelReservation) SetNumConfsRequired(numConfs uint16) {
 	r.partialState.NumConfsRequired = numConfs
 }
 
+// SetZeroConfAlias marks the channel as a zero-conf channel, which is used
+// before its funding transaction confirms. Until then, the channel is
+// identified by the given alias short channel ID.
+func (r *ChannelReservation) SetZeroConfAlias(alias lnwire.ShortChannelID) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.partialState.ZeroConfAlias = alias
+}
+
+// ZeroConfAlias returns the alias of the channel if it is a zero-conf channel,
+// and an empty short channel ID otherwise.
+func (r *ChannelReservation) ZeroConfAlias() lnwire.ShortChannelID {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.partialState.ZeroConfAlias
+}
+
 // CommitConstraints takes the constraints that the remote party specifies for
 // the type of commitments that we can generate for them. These constraints
 // include several parameters that serve as flow control restricting the amount
@@ -364,6 +404,17 @@ func (r *ChannelReservation) OurContribution() *ChannelContribution {
 	return r.ourContribution
 }
 
+// SetOurUpfrontShutdown sets the upfront shutdown address on our
+// contribution.
+func (r *ChannelReservation) SetOurUpfrontShutdown(
+	shutdown lnwire.DeliveryAddress) {
+
+	r.Lock()
+	defer r.Unlock()
+
+	r.ourContribution.UpfrontShutdown = shutdown
+}
+
 // ProcessContribution verifies the counterparty's contribution to the pending
 // payment channel. As a result of this incoming message, lnwallet is able to
 // build the funding transaction, and both commitment transactions. Once this
@@ -383,6 +434,43 @@ func (r *ChannelReservation) ProcessContribution(theirContribution *ChannelContr
 	return <-errChan
 }
 
+// ProcessPsbt hands the finalized PSBT of the funding transaction to a
+// reservation that is funded through a PSBT. The PSBT must create the funding
+// output returned by .FundingOutput(). Once this method returns, the funding
+// outpoint and our signature for the counterparty's version of the
+// commitment transaction are available. The funding transaction itself is
+// only published by the caller once the reservation has been completed.
+//
+// NOTE: This method MUST only be called after .ProcessContribution().
+func (r *ChannelReservation) ProcessPsbt(packet []byte) error {
+	fundingTx, err := extractFinalPsbtTx(packet)
+	if err != nil {
+		return err
+	}
+
+	return r.ProcessFundingTx(fundingTx)
+}
+
+// ProcessFundingTx hands the signed funding transaction to a reservation whose
+// funding transaction is assembled outside of the reservation. The
+// transaction must create the funding output returned by .FundingOutput(), and
+// may create outputs for other channels as well. Once this method returns, the
+// funding outpoint and our signature for the counterparty's version of the
+// commitment transaction are available.
+//
+// NOTE: This method MUST only be called after .ProcessContribution().
+func (r *ChannelReservation) ProcessFundingTx(fundingTx *wire.MsgTx) error {
+	errChan := make(chan error, 1)
+
+	r.wallet.msgChan <- &addFundingTxMsg{
+		pendingFundingID: r.reservationID,
+		fundingTx:        fundingTx,
+		err:              errChan,
+	}
+
+	return <-errChan
+}
+
 // ProcessSingleContribution verifies, and records the initiator's contribution
 // to this pending single funder channel. Internally, no further action is
 // taken other than recording the initiator's contribution to the single funder
@@ -519,6 +607,38 @@ func (r *ChannelReservation) FundingOutpoint() *wire.OutPoint {
 	return &r.partialState.FundingOutpoint
 }
 
+// IsExternallyFunded returns true if the funding transaction of this
+// reservation is assembled and signed outside of the reservation.
+func (r *ChannelReservation) IsExternallyFunded() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return r.externalFunding
+}
+
+// FundingOutput returns the 2-of-2 multi-sig output that the funding
+// transaction of this reservation must create.
+//
+// NOTE: The output is only available after a call to .ProcessContribution(),
+// as it commits to the multi-sig key of the counterparty.
+func (r *ChannelReservation) FundingOutput() (*wire.TxOut, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.theirContribution == nil {
+		return nil, errors.New("counterparty contribution not yet " +
+			"processed")
+	}
+
+	ourKey := r.ourContribution.MultiSigKey
+	theirKey := r.theirContribution.MultiSigKey
+	_, fundingOutput, err := input.GenFundingPkScript(
+		ourKey.PubKey.SerializeCompressed(),
+		theirKey.PubKey.SerializeCompressed(),
+		int64(r.partialState.Capacity),
+	)
+	return fundingOutput, err
+}
+
 // Capacity returns the channel capacity for this reservation.
 func (r *ChannelReservation) Capacity() btcutil.Amount {
 	r.RLock()
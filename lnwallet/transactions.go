@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 
+	"github.com/BTCGPU/lnd/channeldb"
 	"github.com/BTCGPU/lnd/input"
 	"github.com/btgsuite/btgd/btcec"
 	"github.com/btgsuite/btgd/wire"
@@ -20,6 +21,11 @@ const (
 	// StateHintSize bytes amongst the sequence number and locktime fields
 	// of the commitment transaction.
 	maxStateHint uint64 = (1 << 48) - 1
+
+	// anchorSize is the constant value of each of the two anchor outputs
+	// found on commitment transactions of channels using the anchor
+	// commitment format.
+	anchorSize = btcutil.Amount(330)
 )
 
 var (
@@ -34,6 +40,107 @@ var (
 	TimelockShift = uint32(1 << 29)
 )
 
+// CommitWeight returns the base weight of a commitment transaction of the
+// given channel type, before any HTLC outputs are added.
+func CommitWeight(chanType channeldb.ChannelType) int64 {
+	if chanType.HasAnchors() {
+		return input.AnchorCommitWeight
+	}
+
+	return input.CommitWeight
+}
+
+// anchorsValue returns the total value that is locked up within the anchor
+// outputs of a commitment transaction of the given channel type. This value
+// is paid for by the channel initiator.
+func anchorsValue(chanType channeldb.ChannelType) btcutil.Amount {
+	if chanType.HasAnchors() {
+		return 2 * anchorSize
+	}
+
+	return 0
+}
+
+// ScriptInfo holds a redeem script and its corresponding public key script of
+// an output found on a commitment transaction. For p2wkh outputs the witness
+// script is set to the public key script itself.
+type ScriptInfo struct {
+	// PkScript is the output's public key script.
+	PkScript []byte
+
+	// WitnessScript is the script that must be satisfied in order to spend
+	// the output.
+	WitnessScript []byte
+}
+
+// CommitScriptToRemote creates the script info for the output paying to the
+// non-owner of a commitment transaction of the given channel type. The CSV
+// delay that must be satisfied to spend the output is returned as well. For
+// channels using anchor outputs, the to_remote output is a p2wsh output with
+// a CSV delay of one block, ensuring it can't be spent before the commitment
+// transaction has confirmed.
+func CommitScriptToRemote(chanType channeldb.ChannelType,
+	key *btcec.PublicKey) (*ScriptInfo, uint32, error) {
+
+	if chanType.HasAnchors() {
+		witnessScript, err := input.CommitScriptToRemoteConfirmed(key)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		pkScript, err := input.WitnessScriptHash(witnessScript)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return &ScriptInfo{
+			PkScript:      pkScript,
+			WitnessScript: witnessScript,
+		}, 1, nil
+	}
+
+	p2wkh, err := input.CommitScriptUnencumbered(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return &ScriptInfo{
+		PkScript:      p2wkh,
+		WitnessScript: p2wkh,
+	}, 0, nil
+}
+
+// CommitScriptAnchor creates the script info for an anchor output locked to
+// the given funding key.
+func CommitScriptAnchor(key *btcec.PublicKey) (*ScriptInfo, error) {
+	witnessScript, err := input.CommitScriptAnchor(key)
+	if err != nil {
+		return nil, err
+	}
+
+	pkScript, err := input.WitnessScriptHash(witnessScript)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScriptInfo{
+		PkScript:      pkScript,
+		WitnessScript: witnessScript,
+	}, nil
+}
+
+// HtlcSecondLevelInputSequence returns the sequence number that must be set
+// on the input of a second level HTLC transaction. For channels using anchor
+// outputs, the HTLC outputs on the commitment transaction carry a CSV delay
+// of one block, which needs to be satisfied by the spending input.
+func HtlcSecondLevelInputSequence(chanType channeldb.ChannelType) uint32 {
+	if chanType.HasAnchors() {
+		return 1
+	}
+
+	return 0
+}
+
 // createHtlcSuccessTx creates a transaction that spends the output on the
 // commitment transaction of the peer that receives an HTLC. This transaction
 // essentially acts as an off-chain covenant as it's only permitted to spend
@@ -44,8 +151,8 @@ var (
 // In order to spend the HTLC output, the witness for the passed transaction
 // should be:
 //   * <0> <sender sig> <recvr sig> <preimage>
-func createHtlcSuccessTx(htlcOutput wire.OutPoint, htlcAmt btcutil.Amount,
-	csvDelay uint32,
+func createHtlcSuccessTx(chanType channeldb.ChannelType,
+	htlcOutput wire.OutPoint, htlcAmt btcutil.Amount, csvDelay uint32,
 	revocationKey, delayKey *btcec.PublicKey) (*wire.MsgTx, error) {
 
 	// Create a version two transaction (as the success version of this
@@ -56,6 +163,7 @@ func createHtlcSuccessTx(htlcOutput wire.OutPoint, htlcAmt btcutil.Amount,
 	// original HTLC on the sender's commitment transaction.
 	successTx.AddTxIn(&wire.TxIn{
 		PreviousOutPoint: htlcOutput,
+		Sequence:         HtlcSecondLevelInputSequence(chanType),
 	})
 
 	// Next, we'll generate the script used as the output for all second
@@ -97,7 +205,8 @@ func createHtlcSuccessTx(htlcOutput wire.OutPoint, htlcAmt btcutil.Amount,
 // NOTE: The passed amount for the HTLC should take into account the required
 // fee rate at the time the HTLC was created. The fee should be able to
 // entirely pay for this (tiny: 1-in 1-out) transaction.
-func createHtlcTimeoutTx(htlcOutput wire.OutPoint, htlcAmt btcutil.Amount,
+func createHtlcTimeoutTx(chanType channeldb.ChannelType,
+	htlcOutput wire.OutPoint, htlcAmt btcutil.Amount,
 	cltvExpiry, csvDelay uint32,
 	revocationKey, delayKey *btcec.PublicKey) (*wire.MsgTx, error) {
 
@@ -111,6 +220,7 @@ func createHtlcTimeoutTx(htlcOutput wire.OutPoint, htlcAmt btcutil.Amount,
 	// original HTLC on the sender's commitment transaction.
 	timeoutTx.AddTxIn(&wire.TxIn{
 		PreviousOutPoint: htlcOutput,
+		Sequence:         HtlcSecondLevelInputSequence(chanType),
 	})
 
 	// Next, we'll generate the script used as the output for all second
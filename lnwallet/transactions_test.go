@@ -845,7 +845,7 @@ func TestCommitmentAndHTLCTransactions(t *testing.T) {
 		htlcResolutions, err := extractHtlcResolutions(
 			SatPerKWeight(test.commitment.FeePerKw), true, signer,
 			htlcs, keys, channel.localChanCfg, channel.remoteChanCfg,
-			commitTx.TxHash(),
+			commitTx.TxHash(), channelState.ChanType,
 		)
 		if err != nil {
 			t.Errorf("Case %d: Failed to extract HTLC resolutions: %v", i, err)
@@ -1063,9 +1063,13 @@ func testSpendValidation(t *testing.T, tweakless bool) {
 		RevocationKey: revokePubKey,
 		NoDelayKey:    bobPayKey,
 	}
+	chanType := channeldb.SingleFunderTweakless
+	if !tweakless {
+		chanType = channeldb.SingleFunder
+	}
 	commitmentTx, err := CreateCommitTx(
-		*fakeFundingTxIn, keyRing, csvTimeout, channelBalance,
-		channelBalance, DefaultDustLimit(),
+		chanType, *fakeFundingTxIn, keyRing, csvTimeout,
+		channelBalance, channelBalance, DefaultDustLimit(), 0,
 	)
 	if err != nil {
 		t.Fatalf("unable to create commitment transaction: %v", nil)
@@ -111,6 +111,18 @@ type InitFundingReserveMsg struct {
 	// commitment format or not.
 	Tweakless bool
 
+	// Anchors indicates if the channel should use the anchor output
+	// commitment format. Anchor channels are always tweakless.
+	Anchors bool
+
+	// ExternalFunding indicates that the funding transaction will be
+	// assembled and signed outside of the reservation, and handed back
+	// once the counterparty's contribution is known. This is the case for
+	// channels funded through a PSBT, and for channels that share their
+	// funding transaction with other channels. If set, no coin selection
+	// is performed for this reservation.
+	ExternalFunding bool
+
 	// err is a channel in which all errors will be sent across. Will be
 	// nil if this initial set is successful.
 	//
@@ -153,6 +165,22 @@ type addContributionMsg struct {
 	err chan error
 }
 
+// addFundingTxMsg represents a message executing the second phase of a
+// single funder channel reservation workflow whose funding transaction is
+// assembled outside of the reservation. This message carries the signed
+// funding transaction that creates the funding output. Once processed, the
+// reservation is able to construct both commitment transactions, and generate
+// a signature for the remote node's version of the commitment transaction.
+type addFundingTxMsg struct {
+	pendingFundingID uint64
+
+	// fundingTx is the fully signed funding transaction.
+	fundingTx *wire.MsgTx
+
+	// NOTE: In order to avoid deadlocks, this channel MUST be buffered.
+	err chan error
+}
+
 // addSingleContributionMsg represents a message executing the second phase of
 // a single funder channel reservation workflow. This messages carries the
 // counterparty's "contribution" to the payment channel. As this message is
@@ -390,6 +418,8 @@ out:
 				l.handleSingleContribution(msg)
 			case *addContributionMsg:
 				l.handleContributionMsg(msg)
+			case *addFundingTxMsg:
+				l.handleFundingTx(msg)
 			case *addSingleFunderSigsMsg:
 				l.handleSingleFunderSigs(msg)
 			case *addCounterPartySigsMsg:
@@ -467,9 +497,10 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 
 	// If we're on the receiving end of a single funder channel then we
 	// don't need to perform any coin selection, and the remote contributes
-	// all funds. Otherwise, attempt to obtain enough coins to meet the
-	// required funding amount.
-	if req.LocalFundingAmt != 0 {
+	// all funds. The same is true if the funding transaction is assembled
+	// outside of the reservation. Otherwise, attempt to obtain enough
+	// coins to meet the required funding amount.
+	if req.LocalFundingAmt != 0 && !req.ExternalFunding {
 		// Coin selection is done on the basis of sat/kw, so we'll use
 		// the fee rate passed in to perform coin selection.
 		var err error
@@ -494,7 +525,7 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 	reservation, err := NewChannelReservation(
 		capacity, localFundingAmt, req.CommitFeePerKw, l, id,
 		req.PushMSat, l.Cfg.NetParams.GenesisHash, req.Flags,
-		req.Tweakless,
+		req.Tweakless, req.Anchors,
 	)
 	if err != nil {
 		selected.unlockCoins()
@@ -502,6 +533,7 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 		req.resp <- nil
 		return
 	}
+	reservation.externalFunding = req.ExternalFunding
 
 	err = l.initOurContribution(
 		reservation, selected, req.NodeAddr, req.NodeID,
@@ -662,19 +694,19 @@ func CreateCommitmentTxns(localBalance, remoteBalance btcutil.Amount,
 	ourChanCfg, theirChanCfg *channeldb.ChannelConfig,
 	localCommitPoint, remoteCommitPoint *btcec.PublicKey,
 	fundingTxIn wire.TxIn,
-	tweaklessCommit bool) (*wire.MsgTx, *wire.MsgTx, error) {
+	chanType channeldb.ChannelType) (*wire.MsgTx, *wire.MsgTx, error) {
 	localCommitmentKeys := DeriveCommitmentKeys(
-		localCommitPoint, true, tweaklessCommit, ourChanCfg,
-		theirChanCfg,
+		localCommitPoint, true, chanType, ourChanCfg, theirChanCfg,
 	)
 	remoteCommitmentKeys := DeriveCommitmentKeys(
-		remoteCommitPoint, false, tweaklessCommit, ourChanCfg,
-		theirChanCfg,
+		remoteCommitPoint, false, chanType, ourChanCfg, theirChanCfg,
 	)
 
-	ourCommitTx, err := CreateCommitTx(fundingTxIn, localCommitmentKeys,
+	ourCommitTx, err := CreateCommitTx(
+		chanType, fundingTxIn, localCommitmentKeys,
 		uint32(ourChanCfg.CsvDelay), localBalance, remoteBalance,
-		ourChanCfg.DustLimit)
+		ourChanCfg.DustLimit, 0,
+	)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -684,9 +716,11 @@ func CreateCommitmentTxns(localBalance, remoteBalance btcutil.Amount,
 		return nil, nil, err
 	}
 
-	theirCommitTx, err := CreateCommitTx(fundingTxIn, remoteCommitmentKeys,
+	theirCommitTx, err := CreateCommitTx(
+		chanType, fundingTxIn, remoteCommitmentKeys,
 		uint32(theirChanCfg.CsvDelay), remoteBalance, localBalance,
-		theirChanCfg.DustLimit)
+		theirChanCfg.DustLimit, 0,
+	)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -718,16 +752,24 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	pendingReservation.Lock()
 	defer pendingReservation.Unlock()
 
-	// Create a blank, fresh transaction. Soon to be a complete funding
-	// transaction which will allow opening a lightning channel.
-	pendingReservation.fundingTx = wire.NewMsgTx(1)
-	fundingTx := pendingReservation.fundingTx
-
 	// Some temporary variables to cut down on the resolution verbosity.
 	pendingReservation.theirContribution = req.contribution
 	theirContribution := req.contribution
 	ourContribution := pendingReservation.ourContribution
 
+	// If the funding transaction is assembled outside of the reservation,
+	// we can't construct the commitment transactions yet. We'll continue
+	// once the signed funding transaction is handed to us.
+	if pendingReservation.externalFunding {
+		req.err <- nil
+		return
+	}
+
+	// Create a blank, fresh transaction. Soon to be a complete funding
+	// transaction which will allow opening a lightning channel.
+	pendingReservation.fundingTx = wire.NewMsgTx(1)
+	fundingTx := pendingReservation.fundingTx
+
 	// Add all multi-party inputs and outputs to the transaction.
 	for _, ourInput := range ourContribution.Inputs {
 		fundingTx.AddTxIn(ourInput)
@@ -802,11 +844,116 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		)
 	}
 
+	req.err <- l.createCommitments(
+		pendingReservation, multiSigOut, witnessScript,
+	)
+}
+
+// handleFundingTx processes the second workflow step for the lifetime of a
+// channel reservation whose funding transaction is assembled outside of the
+// reservation. The funding transaction is verified to create the expected
+// funding output, after which both versions of the commitment transaction and
+// our signature for their version are created. The funding transaction isn't
+// published by this step.
+func (l *LightningWallet) handleFundingTx(req *addFundingTxMsg) {
+	l.limboMtx.Lock()
+	pendingReservation, ok := l.fundingLimbo[req.pendingFundingID]
+	l.limboMtx.Unlock()
+	if !ok {
+		req.err <- fmt.Errorf("attempted to update non-existent funding state")
+		return
+	}
+
+	// Grab the mutex on the ChannelReservation to ensure thread-safety
+	pendingReservation.Lock()
+	defer pendingReservation.Unlock()
+
+	switch {
+	case !pendingReservation.externalFunding:
+		req.err <- fmt.Errorf("reservation is not funded externally")
+		return
+
+	case pendingReservation.theirContribution == nil:
+		req.err <- fmt.Errorf("counterparty contribution not yet " +
+			"processed")
+		return
+
+	case pendingReservation.fundingTx != nil:
+		req.err <- fmt.Errorf("funding transaction already processed")
+		return
+	}
+
+	fundingTx := req.fundingTx
+	ourKey := pendingReservation.ourContribution.MultiSigKey
+	theirKey := pendingReservation.theirContribution.MultiSigKey
+	witnessScript, multiSigOut, err := input.GenFundingPkScript(
+		ourKey.PubKey.SerializeCompressed(),
+		theirKey.PubKey.SerializeCompressed(),
+		int64(pendingReservation.partialState.Capacity),
+	)
+	if err != nil {
+		req.err <- err
+		return
+	}
+
+	// Before we accept the transaction, we'll make sure that it actually
+	// creates the funding output with the exact value of the channel.
+	if _, found := findFundingOutput(fundingTx, multiSigOut); !found {
+		req.err <- fmt.Errorf("funding output paying %v to %x not "+
+			"found in funding transaction",
+			btcutil.Amount(multiSigOut.Value), multiSigOut.PkScript)
+		return
+	}
+
+	pendingReservation.fundingTx = fundingTx
+
+	err = l.createCommitments(pendingReservation, multiSigOut, witnessScript)
+	if err != nil {
+		pendingReservation.fundingTx = nil
+		req.err <- err
+		return
+	}
+
+	req.err <- nil
+}
+
+// findFundingOutput returns the index of the output of the funding transaction
+// that matches both the script and the value of the passed multi-sig output.
+// As a funding transaction may be shared by several channels, the value is
+// also taken into account.
+func findFundingOutput(fundingTx *wire.MsgTx,
+	multiSigOut *wire.TxOut) (uint32, bool) {
+
+	for i, txOut := range fundingTx.TxOut {
+		if txOut.Value == multiSigOut.Value &&
+			bytes.Equal(txOut.PkScript, multiSigOut.PkScript) {
+
+			return uint32(i), true
+		}
+	}
+
+	return 0, false
+}
+
+// createCommitments records the funding outpoint of the reservation's funding
+// transaction, then creates both versions of the commitment transaction and
+// our signature for the remote node's version.
+//
+// NOTE: The reservation's mutex MUST be held when calling this method.
+func (l *LightningWallet) createCommitments(
+	pendingReservation *ChannelReservation, multiSigOut *wire.TxOut,
+	witnessScript []byte) error {
+
+	fundingTx := pendingReservation.fundingTx
+	theirContribution := pendingReservation.theirContribution
+	ourContribution := pendingReservation.ourContribution
+	ourKey := ourContribution.MultiSigKey
+
 	// Locate the index of the multi-sig outpoint in order to record it
 	// since the outputs are canonically sorted. If this is a single funder
 	// workflow, then we'll also need to send this to the remote node.
 	fundingTxID := fundingTx.TxHash()
-	_, multiSigIndex := input.FindScriptOutputIndex(fundingTx, multiSigOut.PkScript)
+	multiSigIndex, _ := findFundingOutput(fundingTx, multiSigOut)
 	fundingOutpoint := wire.NewOutPoint(&fundingTxID, multiSigIndex)
 	pendingReservation.partialState.FundingOutpoint = *fundingOutpoint
 
@@ -837,17 +984,15 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	// With the funding tx complete, create both commitment transactions.
 	localBalance := pendingReservation.partialState.LocalCommitment.LocalBalance.ToSatoshis()
 	remoteBalance := pendingReservation.partialState.LocalCommitment.RemoteBalance.ToSatoshis()
-	tweaklessCommits := pendingReservation.partialState.ChanType.IsTweakless()
 	ourCommitTx, theirCommitTx, err := CreateCommitmentTxns(
 		localBalance, remoteBalance, ourContribution.ChannelConfig,
 		theirContribution.ChannelConfig,
 		ourContribution.FirstCommitmentPoint,
 		theirContribution.FirstCommitmentPoint, fundingTxIn,
-		tweaklessCommits,
+		pendingReservation.partialState.ChanType,
 	)
 	if err != nil {
-		req.err <- err
-		return
+		return err
 	}
 
 	// With both commitment transactions constructed, generate the state
@@ -877,8 +1022,7 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	}
 	err = initStateHints(ourCommitTx, theirCommitTx, stateObfuscator)
 	if err != nil {
-		req.err <- err
-		return
+		return err
 	}
 
 	// Sort both transactions according to the agreed upon canonical
@@ -899,7 +1043,7 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 
 	// Generate a signature for their version of the initial commitment
 	// transaction.
-	signDesc = input.SignDescriptor{
+	signDesc := input.SignDescriptor{
 		WitnessScript: witnessScript,
 		KeyDesc:       ourKey,
 		Output:        multiSigOut,
@@ -909,12 +1053,11 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	}
 	sigTheirCommit, err := l.Cfg.Signer.SignOutputRaw(theirCommitTx, &signDesc)
 	if err != nil {
-		req.err <- err
-		return
+		return err
 	}
 	pendingReservation.ourCommitmentSig = sigTheirCommit
 
-	req.err <- nil
+	return nil
 }
 
 // handleSingleContribution is called as the second step to a single funder
@@ -1117,6 +1260,13 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	res.partialState.LocalChanCfg = res.ourContribution.toChanConfig()
 	res.partialState.RemoteChanCfg = res.theirContribution.toChanConfig()
 
+	// Along with the channel configs, we'll store the upfront shutdown
+	// scripts that both sides committed to, if any.
+	res.partialState.LocalShutdownScript =
+		res.ourContribution.UpfrontShutdown
+	res.partialState.RemoteShutdownScript =
+		res.theirContribution.UpfrontShutdown
+
 	// We'll also record the finalized funding txn, which will allow us to
 	// rebroadcast on startup in case we fail.
 	res.partialState.FundingTxn = fundingTx
@@ -1163,14 +1313,13 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 	// remote node's commitment transactions.
 	localBalance := pendingReservation.partialState.LocalCommitment.LocalBalance.ToSatoshis()
 	remoteBalance := pendingReservation.partialState.LocalCommitment.RemoteBalance.ToSatoshis()
-	tweaklessCommits := pendingReservation.partialState.ChanType.IsTweakless()
 	ourCommitTx, theirCommitTx, err := CreateCommitmentTxns(
 		localBalance, remoteBalance,
 		pendingReservation.ourContribution.ChannelConfig,
 		pendingReservation.theirContribution.ChannelConfig,
 		pendingReservation.ourContribution.FirstCommitmentPoint,
 		pendingReservation.theirContribution.FirstCommitmentPoint,
-		*fundingTxIn, tweaklessCommits,
+		*fundingTxIn, pendingReservation.partialState.ChanType,
 	)
 	if err != nil {
 		req.err <- err
@@ -1281,6 +1430,10 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 	// which will be used for the lifetime of this channel.
 	chanState.LocalChanCfg = pendingReservation.ourContribution.toChanConfig()
 	chanState.RemoteChanCfg = pendingReservation.theirContribution.toChanConfig()
+	chanState.LocalShutdownScript =
+		pendingReservation.ourContribution.UpfrontShutdown
+	chanState.RemoteShutdownScript =
+		pendingReservation.theirContribution.UpfrontShutdown
 	err = chanState.SyncPending(pendingReservation.nodeAddr, uint32(bestHeight))
 	if err != nil {
 		req.err <- err
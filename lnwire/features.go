@@ -30,6 +30,16 @@ const (
 	// data to recover their settled funds of the latest commitment state.
 	DataLossProtectOptional FeatureBit = 1
 
+	// UpfrontShutdownScriptRequired is a feature bit which indicates that a
+	// peer *requires* that the remote peer accept an upfront shutdown
+	// script to which payout is enforced on cooperative closes.
+	UpfrontShutdownScriptRequired FeatureBit = 4
+
+	// UpfrontShutdownScriptOptional is an optional feature bit which
+	// indicates that the peer will accept an upfront shutdown script to
+	// which payout is enforced on cooperative closes.
+	UpfrontShutdownScriptOptional FeatureBit = 5
+
 	// InitialRoutingSync is a local feature bit meaning that the receiving
 	// node should send a complete dump of routing information when a new
 	// connection is established.
@@ -65,6 +75,36 @@ const (
 	// party's non-delay output should not be tweaked.
 	StaticRemoteKeyOptional FeatureBit = 13
 
+	// PaymentAddrRequired is a required feature bit that signals that a
+	// node requires payment addresses, which are used to mitigate probing
+	// attacks on the receiver of a payment.
+	PaymentAddrRequired FeatureBit = 14
+
+	// PaymentAddrOptional is an optional feature bit that signals that a
+	// node supports payment addresses, which are used to mitigate probing
+	// attacks on the receiver of a payment.
+	PaymentAddrOptional FeatureBit = 15
+
+	// WumboChannelsRequired is a required feature bit that signals that a
+	// node requires its peers to know of channels above the soft-limit of
+	// 2^24-1 satoshis.
+	WumboChannelsRequired FeatureBit = 18
+
+	// WumboChannelsOptional is an optional feature bit that signals that a
+	// node is willing to open and accept channels above the soft-limit of
+	// 2^24-1 satoshis with peers that signal support for them as well.
+	WumboChannelsOptional FeatureBit = 19
+
+	// AnchorsRequired is a required feature bit that signals that the
+	// node requires channels to be made using commitments having anchor
+	// outputs.
+	AnchorsRequired FeatureBit = 20
+
+	// AnchorsOptional is an optional feature bit that signals that the
+	// node supports channels to be made using commitments having anchor
+	// outputs.
+	AnchorsOptional FeatureBit = 21
+
 	// maxAllowedSize is a maximum allowed size of feature vector.
 	//
 	// NOTE: Within the protocol, the maximum allowed message size is 65535
@@ -84,11 +124,15 @@ const (
 // not advertised to the entire network. A full description of these feature
 // bits is provided in the BOLT-09 specification.
 var LocalFeatures = map[FeatureBit]string{
-	DataLossProtectRequired: "data-loss-protect",
-	DataLossProtectOptional: "data-loss-protect",
-	InitialRoutingSync:      "initial-routing-sync",
-	GossipQueriesRequired:   "gossip-queries",
-	GossipQueriesOptional:   "gossip-queries",
+	DataLossProtectRequired:       "data-loss-protect",
+	DataLossProtectOptional:       "data-loss-protect",
+	InitialRoutingSync:            "initial-routing-sync",
+	UpfrontShutdownScriptRequired: "upfront-shutdown-script",
+	UpfrontShutdownScriptOptional: "upfront-shutdown-script",
+	GossipQueriesRequired:         "gossip-queries",
+	GossipQueriesOptional:         "gossip-queries",
+	WumboChannelsRequired:         "wumbo-channels",
+	WumboChannelsOptional:         "wumbo-channels",
 }
 
 // GlobalFeatures is a mapping of known global feature bits to a descriptive
@@ -96,10 +140,26 @@ var LocalFeatures = map[FeatureBit]string{
 // Global features are those which are advertised to the entire network. A full
 // description of these feature bits is provided in the BOLT-09 specification.
 var GlobalFeatures = map[FeatureBit]string{
-	TLVOnionPayloadRequired: "tlv-onion",
-	TLVOnionPayloadOptional: "tlv-onion",
-	StaticRemoteKeyOptional: "static-remote-key",
-	StaticRemoteKeyRequired: "static-remote-key",
+	UpfrontShutdownScriptRequired: "upfront-shutdown-script",
+	UpfrontShutdownScriptOptional: "upfront-shutdown-script",
+	TLVOnionPayloadRequired:       "tlv-onion",
+	TLVOnionPayloadOptional:       "tlv-onion",
+	StaticRemoteKeyOptional:       "static-remote-key",
+	StaticRemoteKeyRequired:       "static-remote-key",
+	PaymentAddrRequired:           "payment-addr",
+	PaymentAddrOptional:           "payment-addr",
+	WumboChannelsRequired:         "wumbo-channels",
+	WumboChannelsOptional:         "wumbo-channels",
+	AnchorsRequired:               "anchor-commitments",
+	AnchorsOptional:               "anchor-commitments",
+}
+
+// featureDependencies maps features to the features they depend on. A feature
+// may only be set in a vector if all of its dependencies are set as well.
+// Features are identified by their optional bit, the dependencies are checked
+// for either bit of the pair.
+var featureDependencies = map[FeatureBit][]FeatureBit{
+	PaymentAddrOptional: {TLVOnionPayloadOptional},
 }
 
 // RawFeatureVector represents a set of feature bits as defined in BOLT-09.  A
@@ -297,6 +357,27 @@ func (fv *FeatureVector) UnknownRequiredFeatures() []FeatureBit {
 	return unknown
 }
 
+// ValidateDeps checks that all known features set in the vector have their
+// dependencies set as well. An error is returned for the first feature found
+// to be missing a dependency.
+func (fv *FeatureVector) ValidateDeps() error {
+	for feature := range fv.features {
+		if !fv.IsKnown(feature) {
+			continue
+		}
+
+		for _, dep := range featureDependencies[feature|1] {
+			if !fv.HasFeature(dep) {
+				return fmt.Errorf("feature %v is missing "+
+					"dependency %v", fv.Name(feature),
+					fv.Name(dep))
+			}
+		}
+	}
+
+	return nil
+}
+
 // Name returns a string identifier for the feature represented by this bit. If
 // the bit does not represent a known feature, this returns a string indicating
 // as much.
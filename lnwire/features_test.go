@@ -260,3 +260,55 @@ func TestFeatureNames(t *testing.T) {
 		}
 	}
 }
+
+// TestFeatureVectorValidateDeps asserts that features are only accepted if
+// all of their dependencies are set as well.
+func TestFeatureVectorValidateDeps(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		bits  []FeatureBit
+		valid bool
+	}{
+		{
+			bits:  nil,
+			valid: true,
+		},
+		{
+			bits:  []FeatureBit{TLVOnionPayloadOptional},
+			valid: true,
+		},
+		{
+			bits: []FeatureBit{
+				TLVOnionPayloadOptional, PaymentAddrOptional,
+			},
+			valid: true,
+		},
+		{
+			bits: []FeatureBit{
+				TLVOnionPayloadRequired, PaymentAddrRequired,
+			},
+			valid: true,
+		},
+		{
+			bits:  []FeatureBit{PaymentAddrOptional},
+			valid: false,
+		},
+		{
+			bits:  []FeatureBit{PaymentAddrRequired},
+			valid: false,
+		},
+	}
+
+	for i, test := range tests {
+		fv := NewFeatureVector(
+			NewRawFeatureVector(test.bits...), GlobalFeatures,
+		)
+
+		err := fv.ValidateDeps()
+		if (err == nil) != test.valid {
+			t.Errorf("case %d: expected valid=%v, got err=%v", i,
+				test.valid, err)
+		}
+	}
+}
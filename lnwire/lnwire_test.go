@@ -67,6 +67,22 @@ func randRawKey() ([33]byte, error) {
 	return n, nil
 }
 
+// randUpfrontShutdownScript returns a random script of up to 34 bytes, or an
+// empty script with some probability to cover peers that don't commit to one.
+func randUpfrontShutdownScript(r *rand.Rand) (DeliveryAddress, error) {
+	length := r.Intn(35)
+	if length == 0 {
+		return nil, nil
+	}
+
+	script := make(DeliveryAddress, length)
+	if _, err := r.Read(script); err != nil {
+		return nil, err
+	}
+
+	return script, nil
+}
+
 func randRawFeatureVector(r *rand.Rand) *RawFeatureVector {
 	featureVec := NewRawFeatureVector()
 	for i := 0; i < 10000; i++ {
@@ -353,6 +369,13 @@ func TestLightningWireProtocol(t *testing.T) {
 				return
 			}
 
+			req.UpfrontShutdownScript, err =
+				randUpfrontShutdownScript(r)
+			if err != nil {
+				t.Fatalf("unable to generate script: %v", err)
+				return
+			}
+
 			v[0] = reflect.ValueOf(req)
 		},
 		MsgAcceptChannel: func(v []reflect.Value, r *rand.Rand) {
@@ -403,6 +426,13 @@ func TestLightningWireProtocol(t *testing.T) {
 				return
 			}
 
+			req.UpfrontShutdownScript, err =
+				randUpfrontShutdownScript(r)
+			if err != nil {
+				t.Fatalf("unable to generate script: %v", err)
+				return
+			}
+
 			v[0] = reflect.ValueOf(req)
 		},
 		MsgFundingCreated: func(v []reflect.Value, r *rand.Rand) {
@@ -22,6 +22,27 @@ type Shutdown struct {
 // p2wpkh.
 type DeliveryAddress []byte
 
+// readUpfrontShutdownScript reads the optional upfront shutdown script that
+// trails the OpenChannel and AcceptChannel messages. Peers that don't commit
+// to a script may omit the field entirely, in which case the script is left
+// empty.
+func readUpfrontShutdownScript(r io.Reader, script *DeliveryAddress) error {
+	err := ReadElement(r, script)
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// An empty script is equivalent to an omitted one.
+	if len(*script) == 0 {
+		*script = nil
+	}
+
+	return nil
+}
+
 // NewShutdown creates a new Shutdown message.
 func NewShutdown(cid ChannelID, addr DeliveryAddress) *Shutdown {
 	return &Shutdown{
@@ -16,11 +16,14 @@ import (
 	"github.com/BTCGPU/lnd/channelnotifier"
 	"github.com/BTCGPU/lnd/contractcourt"
 	"github.com/BTCGPU/lnd/discovery"
+	"github.com/BTCGPU/lnd/feepolicy"
 	"github.com/BTCGPU/lnd/htlcswitch"
 	"github.com/BTCGPU/lnd/invoices"
 	"github.com/BTCGPU/lnd/lnrpc/autopilotrpc"
 	"github.com/BTCGPU/lnd/lnrpc/chainrpc"
+	"github.com/BTCGPU/lnd/lnrpc/feepolicyrpc"
 	"github.com/BTCGPU/lnd/lnrpc/invoicesrpc"
+	"github.com/BTCGPU/lnd/lnrpc/rebalancerrpc"
 	"github.com/BTCGPU/lnd/lnrpc/routerrpc"
 	"github.com/BTCGPU/lnd/lnrpc/signrpc"
 	"github.com/BTCGPU/lnd/lnrpc/walletrpc"
@@ -29,6 +32,7 @@ import (
 	"github.com/BTCGPU/lnd/monitoring"
 	"github.com/BTCGPU/lnd/netann"
 	"github.com/BTCGPU/lnd/peernotifier"
+	"github.com/BTCGPU/lnd/rebalancer"
 	"github.com/BTCGPU/lnd/routing"
 	"github.com/BTCGPU/lnd/signal"
 	"github.com/BTCGPU/lnd/sweep"
@@ -127,6 +131,10 @@ func init() {
 
 	addSubLogger(routerrpc.Subsystem, routerrpc.UseLogger)
 	addSubLogger(wtclientrpc.Subsystem, wtclientrpc.UseLogger)
+	addSubLogger(rebalancer.Subsystem, rebalancer.UseLogger)
+	addSubLogger(rebalancerrpc.Subsystem, rebalancerrpc.UseLogger)
+	addSubLogger(feepolicy.Subsystem, feepolicy.UseLogger)
+	addSubLogger(feepolicyrpc.Subsystem, feepolicyrpc.UseLogger)
 }
 
 // addSubLogger is a helper method to conveniently register the logger of a sub
@@ -4,14 +4,27 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
+	btcutil "github.com/btgsuite/btgutil"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 
 	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
 	macaroon "gopkg.in/macaroon.v2"
 )
 
+const (
+	// CondMethods is the name of the caveat condition that restricts the
+	// gRPC methods a macaroon can be used for.
+	CondMethods = "methods"
+
+	// CondSpendLimit is the name of the caveat condition that caps the
+	// amount a macaroon can be used to spend within a time window.
+	CondSpendLimit = "spend-limit"
+)
+
 // Constraint type adds a layer of indirection over macaroon caveats.
 type Constraint func(*macaroon.Macaroon) error
 
@@ -87,3 +100,90 @@ func IPLockChecker() (string, checkers.Func) {
 		return nil
 	}
 }
+
+// MethodConstraint restricts the macaroon to calls of the given gRPC methods.
+// Methods are specified by their full URI, for example
+// "/lnrpc.Lightning/GetInfo".
+func MethodConstraint(methods ...string) func(*macaroon.Macaroon) error {
+	return func(mac *macaroon.Macaroon) error {
+		if len(methods) == 0 {
+			return fmt.Errorf("at least one method is required")
+		}
+		for _, method := range methods {
+			if !strings.HasPrefix(method, "/") ||
+				strings.ContainsAny(method, " \t\n") {
+
+				return fmt.Errorf("invalid method URI: %q",
+					method)
+			}
+		}
+
+		caveat := checkers.Condition(
+			CondMethods, strings.Join(methods, " "),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// MethodChecker accepts the gRPC method being called from the validation
+// context and checks that it is one of the methods the macaroon is restricted
+// to. It is of the `Checker` type.
+func MethodChecker() (string, checkers.Func) {
+	return CondMethods, func(ctx context.Context, cond, arg string) error {
+		method, ok := methodFromContext(ctx)
+		if !ok {
+			return fmt.Errorf("unable to get method from context")
+		}
+
+		for _, allowed := range strings.Split(arg, " ") {
+			if allowed == method {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("macaroon not allowed to call method %v",
+			method)
+	}
+}
+
+// SpendLimitConstraint caps the total amount that can be spent with the
+// macaroon within any time window of the given duration. Spends are accounted
+// for by the macaroon service, so the limit holds across restarts.
+func SpendLimitConstraint(limit btcutil.Amount,
+	window time.Duration) func(*macaroon.Macaroon) error {
+
+	return func(mac *macaroon.Macaroon) error {
+		if limit < 0 {
+			return fmt.Errorf("spend limit must not be negative")
+		}
+		if window < time.Second {
+			return fmt.Errorf("spend limit window must be at " +
+				"least one second")
+		}
+
+		caveat := checkers.Condition(CondSpendLimit, fmt.Sprintf(
+			"%d %d", int64(limit), int64(window/time.Second),
+		))
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// methodContextKey is the type of the context key under which the gRPC method
+// being called is passed to the caveat checkers.
+type methodContextKey struct{}
+
+// contextWithMethod returns a copy of the passed context that carries the
+// given gRPC method.
+func contextWithMethod(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, methodContextKey{}, method)
+}
+
+// methodFromContext returns the gRPC method carried by the passed context. If
+// the method wasn't set explicitly, the one set by the gRPC server is used.
+func methodFromContext(ctx context.Context) (string, bool) {
+	if method, ok := ctx.Value(methodContextKey{}).(string); ok {
+		return method, true
+	}
+
+	return grpc.Method(ctx)
+}
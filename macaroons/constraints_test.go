@@ -107,3 +107,51 @@ func TestIPLockBadIP(t *testing.T) {
 		t.Fatalf("IPLockConstraint with bad IP should fail.")
 	}
 }
+
+// TestMethodConstraint tests that a caveat restricting the callable methods
+// of a macaroon is created.
+func TestMethodConstraint(t *testing.T) {
+	constraintFunc := macaroons.MethodConstraint(
+		"/lnrpc.Lightning/GetInfo", "/lnrpc.Lightning/ListChannels",
+	)
+	testMacaroon := createDummyMacaroon(t)
+	err := constraintFunc(testMacaroon)
+	if err != nil {
+		t.Fatalf("Error applying method constraint: %v", err)
+	}
+
+	expected := "methods /lnrpc.Lightning/GetInfo " +
+		"/lnrpc.Lightning/ListChannels"
+	if string(testMacaroon.Caveats()[0].Id) != expected {
+		t.Fatalf("Added caveat '%s' does not meet the expectations!",
+			testMacaroon.Caveats()[0].Id)
+	}
+
+	// A method that isn't a full URI can't be added.
+	constraintFunc = macaroons.MethodConstraint("GetInfo")
+	if err := constraintFunc(createDummyMacaroon(t)); err == nil {
+		t.Fatalf("MethodConstraint with bad method should fail.")
+	}
+}
+
+// TestSpendLimitConstraint tests that a caveat capping the amount a macaroon
+// can spend is created.
+func TestSpendLimitConstraint(t *testing.T) {
+	constraintFunc := macaroons.SpendLimitConstraint(50000, time.Hour)
+	testMacaroon := createDummyMacaroon(t)
+	err := constraintFunc(testMacaroon)
+	if err != nil {
+		t.Fatalf("Error applying spend limit constraint: %v", err)
+	}
+
+	if string(testMacaroon.Caveats()[0].Id) != "spend-limit 50000 3600" {
+		t.Fatalf("Added caveat '%s' does not meet the expectations!",
+			testMacaroon.Caveats()[0].Id)
+	}
+
+	// A window shorter than a second can't be added.
+	constraintFunc = macaroons.SpendLimitConstraint(50000, time.Millisecond)
+	if err := constraintFunc(createDummyMacaroon(t)); err == nil {
+		t.Fatalf("SpendLimitConstraint with bad window should fail.")
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"sync"
 
-	"github.com/coreos/bbolt"
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
+	btcutil "github.com/btgsuite/btgutil"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
@@ -29,6 +31,8 @@ type Service struct {
 	bakery.Bakery
 
 	rks *RootKeyStorage
+
+	spendLimits *spendLimitStore
 }
 
 // NewService returns a service backed by the macaroon Bolt DB stored in the
@@ -48,8 +52,8 @@ func NewService(dir string, checks ...Checker) (*Service, error) {
 
 	// Open the database that we'll use to store the primary macaroon key,
 	// and all generated macaroons+caveats.
-	macaroonDB, err := bbolt.Open(
-		path.Join(dir, DBFilename), 0600, bbolt.DefaultOptions,
+	macaroonDB, err := kvdb.Create(
+		kvdb.BoltBackendName, path.Join(dir, DBFilename),
 	)
 	if err != nil {
 		return nil, err
@@ -69,11 +73,19 @@ func NewService(dir string, checks ...Checker) (*Service, error) {
 		Key:     nil,
 	}
 
+	spendLimits, err := newSpendLimitStore(macaroonDB)
+	if err != nil {
+		return nil, err
+	}
+
 	svc := bakery.New(macaroonParams)
 
-	// Register all custom caveat checkers with the bakery's checker.
+	// Register all custom caveat checkers with the bakery's checker. The
+	// spend limit checker needs access to the database, so it's always
+	// registered by the service itself.
 	// TODO(aakselrod): Add more checks as required.
 	checker := svc.Checker.FirstPartyCaveatChecker.(*checkers.Checker)
+	checks = append(checks, spendLimits.checker)
 	for _, check := range checks {
 		cond, fun := check()
 		if !isRegistered(checker, cond) {
@@ -81,7 +93,11 @@ func NewService(dir string, checks ...Checker) (*Service, error) {
 		}
 	}
 
-	return &Service{*svc, rootKeyStore}, nil
+	return &Service{
+		Bakery:      *svc,
+		rks:         rootKeyStore,
+		spendLimits: spendLimits,
+	}, nil
 }
 
 // isRegistered checks to see if the required checker has already been
@@ -103,9 +119,13 @@ func isRegistered(c *checkers.Checker, name string) bool {
 }
 
 // UnaryServerInterceptor is a GRPC interceptor that checks whether the
-// request is authorized by the included macaroons.
+// request is authorized by the included macaroons. If spendAmount is non-nil,
+// it is used to determine the amount each request can spend, which is then
+// accounted against any spend limit caveats of the macaroon. If spendResult is
+// non-nil, the amount is credited back if the request failed to spend it.
 func (svc *Service) UnaryServerInterceptor(
-	permissionMap map[string][]bakery.Op) grpc.UnaryServerInterceptor {
+	permissionMap map[string][]bakery.Op, spendAmount SpendAmountFunc,
+	spendResult SpendResultFunc) grpc.UnaryServerInterceptor {
 
 	return func(ctx context.Context, req interface{},
 		info *grpc.UnaryServerInfo,
@@ -116,19 +136,52 @@ func (svc *Service) UnaryServerInterceptor(
 				"required for method", info.FullMethod)
 		}
 
-		err := svc.ValidateMacaroon(ctx, permissionMap[info.FullMethod])
+		var amount btcutil.Amount
+		if spendAmount != nil {
+			var err error
+			amount, err = spendAmount(info.FullMethod, req)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		spendReq, err := svc.validateMacaroon(
+			ctx, permissionMap[info.FullMethod], info.FullMethod,
+			amount,
+		)
 		if err != nil {
 			return nil, err
 		}
 
-		return handler(ctx, req)
+		resp, err := handler(ctx, req)
+		if spendResult == nil || spendReq == nil ||
+			len(spendReq.reservations) == 0 {
+
+			return resp, err
+		}
+
+		result := spendResult(info.FullMethod, req, resp, err)
+		if result == SpendFailed {
+			refundErr := svc.spendLimits.refundSpend(spendReq)
+			if refundErr != nil {
+				return nil, fmt.Errorf("unable to credit back "+
+					"failed spend: %v", refundErr)
+			}
+		}
+
+		return resp, err
 	}
 }
 
 // StreamServerInterceptor is a GRPC interceptor that checks whether the
-// request is authorized by the included macaroons.
+// request is authorized by the included macaroons. If spendAmount is non-nil,
+// every message received on the stream that can spend funds is validated
+// again, so its amount is accounted against any spend limit caveats of the
+// macaroon. If spendResult is non-nil, the messages sent on the stream are
+// used to credit back the amounts of requests that failed to spend them.
 func (svc *Service) StreamServerInterceptor(
-	permissionMap map[string][]bakery.Op) grpc.StreamServerInterceptor {
+	permissionMap map[string][]bakery.Op, spendAmount SpendAmountFunc,
+	spendResult SpendResultFunc) grpc.StreamServerInterceptor {
 
 	return func(srv interface{}, ss grpc.ServerStream,
 		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
@@ -138,15 +191,165 @@ func (svc *Service) StreamServerInterceptor(
 				"for method", info.FullMethod)
 		}
 
-		err := svc.ValidateMacaroon(
+		_, err := svc.validateMacaroon(
 			ss.Context(), permissionMap[info.FullMethod],
+			info.FullMethod, 0,
 		)
 		if err != nil {
 			return err
 		}
 
-		return handler(srv, ss)
+		if spendAmount == nil {
+			return handler(srv, ss)
+		}
+
+		stream := &spendLimitedStream{
+			ServerStream: ss,
+			svc:          svc,
+			fullMethod:   info.FullMethod,
+			permissions:  permissionMap[info.FullMethod],
+			spendAmount:  spendAmount,
+			spendResult:  spendResult,
+		}
+		err = handler(srv, stream)
+
+		// Requests that didn't get any response before the stream
+		// ended are resolved with the error the stream ended with.
+		resolveErr := stream.resolveUnanswered(err)
+		if resolveErr != nil {
+			return resolveErr
+		}
+
+		return err
+	}
+}
+
+// pendingSpend is a request received on a stream whose spend was recorded,
+// but whose outcome isn't known yet.
+type pendingSpend struct {
+	req   interface{}
+	spend *spendRequest
+
+	// answered is set once a message was sent on the stream while the
+	// spend was pending.
+	answered bool
+}
+
+// spendLimitedStream wraps a grpc.ServerStream and validates the macaroon of
+// the stream again for each received message that can spend funds. The spends
+// of received messages are resolved by the messages sent on the stream.
+type spendLimitedStream struct {
+	grpc.ServerStream
+
+	svc         *Service
+	fullMethod  string
+	permissions []bakery.Op
+	spendAmount SpendAmountFunc
+	spendResult SpendResultFunc
+
+	// pending holds the requests whose outcome isn't known yet. It is
+	// guarded by mtx, as messages may be sent and received concurrently.
+	pending []*pendingSpend
+	mtx     sync.Mutex
+}
+
+// RecvMsg receives a message from the wrapped stream and validates the
+// macaroon of the stream against the amount the message can spend.
+func (s *spendLimitedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	amount, err := s.spendAmount(s.fullMethod, m)
+	if err != nil {
+		return err
+	}
+	if amount == 0 {
+		return nil
+	}
+
+	spendReq, err := s.svc.validateMacaroon(
+		s.Context(), s.permissions, s.fullMethod, amount,
+	)
+	if err != nil {
+		return err
+	}
+
+	if s.spendResult != nil && len(spendReq.reservations) > 0 {
+		s.mtx.Lock()
+		s.pending = append(s.pending, &pendingSpend{
+			req:   m,
+			spend: spendReq,
+		})
+		s.mtx.Unlock()
+	}
+
+	return nil
+}
+
+// SendMsg resolves the pending spends of the stream with the given message,
+// before sending it on the wrapped stream. The amounts of requests that failed
+// to spend their funds are credited back.
+func (s *spendLimitedStream) SendMsg(m interface{}) error {
+	if err := s.resolve(m, nil); err != nil {
+		return err
+	}
+
+	return s.ServerStream.SendMsg(m)
+}
+
+// resolve resolves the pending spends of the stream with the given response.
+func (s *spendLimitedStream) resolve(resp interface{}, err error) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.resolvePending(func(p *pendingSpend) SpendResult {
+		p.answered = true
+		return s.spendResult(s.fullMethod, p.req, resp, err)
+	})
+}
+
+// resolveUnanswered resolves the pending spends of requests that didn't get
+// any response with the error the stream ended with. Spends that did get a
+// response remain accounted for, as their outcome can't be known anymore.
+func (s *spendLimitedStream) resolveUnanswered(err error) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.resolvePending(func(p *pendingSpend) SpendResult {
+		if p.answered {
+			return SpendPending
+		}
+		return s.spendResult(s.fullMethod, p.req, nil, err)
+	})
+}
+
+// resolvePending determines the result of each pending spend with the given
+// function, and credits back the amounts of the ones that failed. The caller
+// MUST hold mtx.
+func (s *spendLimitedStream) resolvePending(
+	result func(*pendingSpend) SpendResult) error {
+
+	var (
+		pending   []*pendingSpend
+		refundErr error
+	)
+	for _, p := range s.pending {
+		switch result(p) {
+		case SpendPending:
+			pending = append(pending, p)
+
+		case SpendFailed:
+			err := s.svc.spendLimits.refundSpend(p.spend)
+			if err != nil && refundErr == nil {
+				refundErr = fmt.Errorf("unable to credit "+
+					"back failed spend: %v", err)
+			}
+		}
 	}
+	s.pending = pending
+
+	return refundErr
 }
 
 // ValidateMacaroon validates the capabilities of a given request given a
@@ -156,13 +359,26 @@ func (svc *Service) StreamServerInterceptor(
 func (svc *Service) ValidateMacaroon(ctx context.Context,
 	requiredPermissions []bakery.Op) error {
 
+	_, err := svc.validateMacaroon(ctx, requiredPermissions, "", 0)
+	return err
+}
+
+// validateMacaroon validates the macaroon included in the request metadata
+// of the passed context against the required permissions and all caveats of
+// the macaroon. If the request can spend funds, the amount is recorded
+// against the spend limit caveats of the macaroon once it is authorized, and
+// the recorded spend is returned so it can be credited back later.
+func (svc *Service) validateMacaroon(ctx context.Context,
+	requiredPermissions []bakery.Op, fullMethod string,
+	amount btcutil.Amount) (*spendRequest, error) {
+
 	// Get macaroon bytes from context and unmarshal into macaroon.
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return fmt.Errorf("unable to get metadata from context")
+		return nil, fmt.Errorf("unable to get metadata from context")
 	}
 	if len(md["macaroon"]) != 1 {
-		return fmt.Errorf("expected 1 macaroon, got %d",
+		return nil, fmt.Errorf("expected 1 macaroon, got %d",
 			len(md["macaroon"]))
 	}
 
@@ -171,19 +387,48 @@ func (svc *Service) ValidateMacaroon(ctx context.Context,
 	// representation.
 	macBytes, err := hex.DecodeString(md["macaroon"][0])
 	if err != nil {
-		return err
+		return nil, err
 	}
 	mac := &macaroon.Macaroon{}
 	err = mac.UnmarshalBinary(macBytes)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if fullMethod != "" {
+		ctx = contextWithMethod(ctx, fullMethod)
+	}
+
+	// Requests that can spend funds are checked and recorded while
+	// holding the spend limit mutex, so concurrent requests can't exceed
+	// a limit together.
+	var spendReq *spendRequest
+	if amount > 0 {
+		svc.spendLimits.mtx.Lock()
+		defer svc.spendLimits.mtx.Unlock()
+
+		spendReq = &spendRequest{
+			macID:  mac.Id(),
+			amount: amount,
+		}
+		ctx = context.WithValue(ctx, spendRequestContextKey{}, spendReq)
 	}
 
 	// Check the method being called against the permitted operation and
 	// the expiration time and IP address and return the result.
 	authChecker := svc.Checker.Auth(macaroon.Slice{mac})
 	_, err = authChecker.Allow(ctx, requiredPermissions...)
-	return err
+	if err != nil {
+		return nil, err
+	}
+
+	if spendReq != nil {
+		if err := svc.spendLimits.recordSpend(spendReq); err != nil {
+			return nil, err
+		}
+	}
+
+	return spendReq, nil
 }
 
 // Close closes the database that underlies the RootKeyStore and zeroes the
@@ -197,3 +442,16 @@ func (svc *Service) Close() error {
 func (svc *Service) CreateUnlock(password *[]byte) error {
 	return svc.rks.CreateUnlock(password)
 }
+
+// ListMacaroonIDs returns all the root key IDs that are currently in use.
+func (svc *Service) ListMacaroonIDs(ctxt context.Context) ([][]byte, error) {
+	return svc.rks.ListMacaroonIDs(ctxt)
+}
+
+// DeleteMacaroonID removes the root key with the given ID, revoking all
+// macaroons that were derived from it.
+func (svc *Service) DeleteMacaroonID(ctxt context.Context,
+	rootKeyID []byte) error {
+
+	return svc.rks.DeleteMacaroonID(ctxt, rootKeyID)
+}
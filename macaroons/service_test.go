@@ -3,13 +3,17 @@ package macaroons_test
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"io/ioutil"
 	"os"
 	"path"
 	"testing"
+	"time"
 
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/macaroons"
-	"github.com/coreos/bbolt"
+	btcutil "github.com/btgsuite/btgutil"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"gopkg.in/macaroon-bakery.v2/bakery"
 	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
@@ -33,8 +37,9 @@ func setupTestRootKeyStorage(t *testing.T) string {
 	if err != nil {
 		t.Fatalf("Error creating temp dir: %v", err)
 	}
-	db, err := bbolt.Open(path.Join(tempDir, "macaroons.db"), 0600,
-		bbolt.DefaultOptions)
+	db, err := kvdb.Create(
+		kvdb.BoltBackendName, path.Join(tempDir, "macaroons.db"),
+	)
 	if err != nil {
 		t.Fatalf("Error opening store DB: %v", err)
 	}
@@ -137,3 +142,318 @@ func TestValidateMacaroon(t *testing.T) {
 		t.Fatalf("Error validating the macaroon: %v", err)
 	}
 }
+
+// TestMethodAndSpendLimitCaveats tests that macaroons restricted to certain
+// methods and capped in the amount they can spend are enforced by the
+// interceptor, that the amounts of failed requests are credited back, and that
+// the spent amounts persist across restarts.
+func TestMethodAndSpendLimitCaveats(t *testing.T) {
+	const (
+		spendMethod = "/test.Service/Spend"
+		otherMethod = "/test.Service/Other"
+	)
+
+	// First, initialize the service and unlock it.
+	tempDir := setupTestRootKeyStorage(t)
+	defer os.RemoveAll(tempDir)
+
+	newService := func() *macaroons.Service {
+		service, err := macaroons.NewService(
+			tempDir, macaroons.MethodChecker,
+		)
+		if err != nil {
+			t.Fatalf("Error creating new service: %v", err)
+		}
+		err = service.CreateUnlock(&defaultPw)
+		if err != nil {
+			t.Fatalf("Error unlocking root key storage: %v", err)
+		}
+
+		return service
+	}
+	service := newService()
+
+	// Then, create a new macaroon that may only spend 1000 satoshis per
+	// hour through the spend method.
+	mac, err := service.Oven.NewMacaroon(nil, bakery.LatestVersion,
+		nil, testOperation)
+	if err != nil {
+		t.Fatalf("Error creating macaroon from service: %v", err)
+	}
+	constrainedMac, err := macaroons.AddConstraints(
+		mac.M(), macaroons.MethodConstraint(spendMethod),
+		macaroons.SpendLimitConstraint(1000, time.Hour),
+	)
+	if err != nil {
+		t.Fatalf("Error adding constraints: %v", err)
+	}
+	macaroonBinary, err := constrainedMac.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Error serializing macaroon: %v", err)
+	}
+	md := metadata.New(map[string]string{
+		"macaroon": hex.EncodeToString(macaroonBinary),
+	})
+	mockContext := metadata.NewIncomingContext(context.Background(), md)
+
+	// The requests in this test are just the amounts they spend.
+	permissions := map[string][]bakery.Op{
+		spendMethod: {testOperation},
+		otherMethod: {testOperation},
+	}
+	spendAmount := func(_ string, req interface{}) (btcutil.Amount, error) {
+		return req.(btcutil.Amount), nil
+	}
+	spendResult := func(_ string, _, _ interface{},
+		err error) macaroons.SpendResult {
+
+		if err != nil {
+			return macaroons.SpendFailed
+		}
+		return macaroons.SpendSettled
+	}
+	errSpendFailed := errors.New("spend failed")
+	callWithResult := func(service *macaroons.Service, method string,
+		amt btcutil.Amount, handlerErr error) error {
+
+		interceptor := service.UnaryServerInterceptor(
+			permissions, spendAmount, spendResult,
+		)
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+		handler := func(context.Context, interface{}) (interface{},
+			error) {
+
+			return nil, handlerErr
+		}
+		_, err := interceptor(mockContext, amt, info, handler)
+		return err
+	}
+	call := func(service *macaroons.Service, method string,
+		amt btcutil.Amount) error {
+
+		return callWithResult(service, method, amt, nil)
+	}
+
+	// Calling any other method should fail, even if it spends nothing.
+	if err := call(service, otherMethod, 0); err == nil {
+		t.Fatalf("Expected call to other method to fail")
+	}
+
+	// A failed request is credited back, so it doesn't count against the
+	// limit.
+	err = callWithResult(service, spendMethod, 1000, errSpendFailed)
+	if err != errSpendFailed {
+		t.Fatalf("Expected handler error, got %v", err)
+	}
+
+	// Spending up to the limit should succeed, going beyond it fails.
+	if err := call(service, spendMethod, 600); err != nil {
+		t.Fatalf("Error spending within limit: %v", err)
+	}
+	if err := call(service, spendMethod, 600); err == nil {
+		t.Fatalf("Expected spend beyond limit to fail")
+	}
+	if err := call(service, spendMethod, 400); err != nil {
+		t.Fatalf("Error spending within limit: %v", err)
+	}
+
+	// Requests that don't spend anything are still allowed.
+	if err := call(service, spendMethod, 0); err != nil {
+		t.Fatalf("Error calling method without spending: %v", err)
+	}
+
+	// Finally, the spent amount should still be accounted for after
+	// restarting the service.
+	service.Close()
+	service = newService()
+	defer service.Close()
+
+	if err := call(service, spendMethod, 1); err == nil {
+		t.Fatalf("Expected spend beyond limit after restart to fail")
+	}
+}
+
+// mockServerStream is a grpc.ServerStream that receives the amounts it is
+// given and discards the messages sent on it.
+type mockServerStream struct {
+	grpc.ServerStream
+
+	ctx  context.Context
+	reqs []btcutil.Amount
+}
+
+func (m *mockServerStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockServerStream) RecvMsg(msg interface{}) error {
+	if len(m.reqs) == 0 {
+		return errors.New("no more requests")
+	}
+
+	*msg.(*btcutil.Amount) = m.reqs[0]
+	m.reqs = m.reqs[1:]
+
+	return nil
+}
+
+func (m *mockServerStream) SendMsg(interface{}) error {
+	return nil
+}
+
+// testSpendResponse is the response to a spend request in the stream test.
+type testSpendResponse struct {
+	amt    btcutil.Amount
+	failed bool
+}
+
+// TestSpendLimitStream tests that the amounts of requests received on a stream
+// are credited back if their response reports a failure, or if the stream
+// ends with an error before they are answered.
+func TestSpendLimitStream(t *testing.T) {
+	const streamMethod = "/test.Service/Stream"
+
+	tempDir := setupTestRootKeyStorage(t)
+	defer os.RemoveAll(tempDir)
+
+	service, err := macaroons.NewService(tempDir)
+	if err != nil {
+		t.Fatalf("Error creating new service: %v", err)
+	}
+	defer service.Close()
+	err = service.CreateUnlock(&defaultPw)
+	if err != nil {
+		t.Fatalf("Error unlocking root key storage: %v", err)
+	}
+
+	// Create a macaroon that may only spend 1000 satoshis per hour.
+	mac, err := service.Oven.NewMacaroon(nil, bakery.LatestVersion,
+		nil, testOperation)
+	if err != nil {
+		t.Fatalf("Error creating macaroon from service: %v", err)
+	}
+	constrainedMac, err := macaroons.AddConstraints(
+		mac.M(), macaroons.SpendLimitConstraint(1000, time.Hour),
+	)
+	if err != nil {
+		t.Fatalf("Error adding constraints: %v", err)
+	}
+	macaroonBinary, err := constrainedMac.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Error serializing macaroon: %v", err)
+	}
+	md := metadata.New(map[string]string{
+		"macaroon": hex.EncodeToString(macaroonBinary),
+	})
+	mockContext := metadata.NewIncomingContext(context.Background(), md)
+
+	permissions := map[string][]bakery.Op{
+		streamMethod: {testOperation},
+	}
+	spendAmount := func(_ string, req interface{}) (btcutil.Amount, error) {
+		return *req.(*btcutil.Amount), nil
+	}
+	spendResult := func(_ string, req, resp interface{},
+		err error) macaroons.SpendResult {
+
+		if resp == nil {
+			if err != nil {
+				return macaroons.SpendFailed
+			}
+			return macaroons.SpendPending
+		}
+
+		r := resp.(*testSpendResponse)
+		switch {
+		case r.amt != *req.(*btcutil.Amount):
+			return macaroons.SpendPending
+
+		case r.failed:
+			return macaroons.SpendFailed
+
+		default:
+			return macaroons.SpendSettled
+		}
+	}
+	interceptor := service.StreamServerInterceptor(
+		permissions, spendAmount, spendResult,
+	)
+	info := &grpc.StreamServerInfo{FullMethod: streamMethod}
+	errStream := errors.New("stream failed")
+
+	// runStream runs a stream that receives the given amounts and answers
+	// each of them with the responses returned by respond. The stream
+	// ends with the given error once all amounts were received.
+	runStream := func(reqs []btcutil.Amount,
+		respond func(btcutil.Amount) []*testSpendResponse,
+		streamErr error) error {
+
+		ss := &mockServerStream{ctx: mockContext, reqs: reqs}
+		return interceptor(nil, ss, info, func(_ interface{},
+			stream grpc.ServerStream) error {
+
+			for range reqs {
+				var amt btcutil.Amount
+				if err := stream.RecvMsg(&amt); err != nil {
+					return err
+				}
+
+				for _, resp := range respond(amt) {
+					err := stream.SendMsg(resp)
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			return streamErr
+		})
+	}
+
+	// A request whose response reports a failure is credited back, so a
+	// second request of 600 satoshis fits within the limit.
+	err = runStream(
+		[]btcutil.Amount{600, 600},
+		func(amt btcutil.Amount) []*testSpendResponse {
+			return []*testSpendResponse{{amt: amt, failed: true}}
+		}, nil,
+	)
+	if err != nil {
+		t.Fatalf("Error running stream: %v", err)
+	}
+	err = runStream(
+		[]btcutil.Amount{600},
+		func(amt btcutil.Amount) []*testSpendResponse {
+			return []*testSpendResponse{{amt: amt}}
+		}, nil,
+	)
+	if err != nil {
+		t.Fatalf("Error running stream: %v", err)
+	}
+
+	// A request that isn't answered before the stream fails is credited
+	// back as well.
+	noResponse := func(btcutil.Amount) []*testSpendResponse {
+		return nil
+	}
+	err = runStream([]btcutil.Amount{400}, noResponse, errStream)
+	if err != errStream {
+		t.Fatalf("Expected stream error, got %v", err)
+	}
+
+	// A request that was answered without a final result remains
+	// accounted for once the stream fails, which exhausts the limit.
+	err = runStream(
+		[]btcutil.Amount{400},
+		func(btcutil.Amount) []*testSpendResponse {
+			return []*testSpendResponse{{amt: 0}}
+		}, errStream,
+	)
+	if err != errStream {
+		t.Fatalf("Expected stream error, got %v", err)
+	}
+	err = runStream([]btcutil.Amount{1}, noResponse, nil)
+	if err == nil {
+		t.Fatalf("Expected spend beyond limit to fail")
+	}
+}
@@ -0,0 +1,318 @@
+package macaroons
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
+	btcutil "github.com/btgsuite/btgutil"
+	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
+)
+
+var (
+	// spendLimitBucketName is the name of the bucket that stores the
+	// spends accounted against macaroons with a spend limit caveat. Each
+	// caveat has its own sub-bucket, keyed by the hash of the macaroon ID
+	// and the caveat argument.
+	spendLimitBucketName = []byte("macspendlimits")
+
+	// ErrSpendLimitExceeded is returned when a request would exceed the
+	// amount a macaroon is allowed to spend within its time window.
+	ErrSpendLimitExceeded = fmt.Errorf("macaroon spend limit exceeded")
+)
+
+// SpendAmountFunc returns the maximum amount the given request to the gRPC
+// method with the passed full URI can spend. Requests that can't spend any
+// funds should return zero.
+type SpendAmountFunc func(fullMethod string,
+	req interface{}) (btcutil.Amount, error)
+
+// SpendResult is the outcome of a request that can spend funds.
+type SpendResult uint8
+
+const (
+	// SpendPending indicates that it isn't known yet whether the request
+	// spent its funds.
+	SpendPending SpendResult = iota
+
+	// SpendSettled indicates that the request spent its funds.
+	SpendSettled
+
+	// SpendFailed indicates that the request didn't spend any funds, so
+	// its amount is credited back to the spend limits of the macaroon.
+	SpendFailed
+)
+
+// SpendResultFunc returns the outcome of the given request to the gRPC method
+// with the passed full URI, judging by a response sent for it. For unary
+// methods it is called once with the response and error of the handler. For
+// streaming methods it is called with every message sent on the stream, until
+// it returns a final result. Spends of requests whose stream ends before
+// their outcome is known remain accounted for.
+type SpendResultFunc func(fullMethod string, req, resp interface{},
+	err error) SpendResult
+
+// spendLimit is a parsed spend limit caveat.
+type spendLimit struct {
+	// limit is the maximum amount that can be spent within the window.
+	limit btcutil.Amount
+
+	// window is the duration of the sliding window the limit applies to.
+	window time.Duration
+}
+
+// parseSpendLimit parses the argument of a spend limit caveat, which is the
+// limit in satoshis followed by the window in seconds.
+func parseSpendLimit(arg string) (*spendLimit, error) {
+	parts := strings.Split(arg, " ")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid spend limit caveat: %q", arg)
+	}
+
+	limit, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || limit < 0 {
+		return nil, fmt.Errorf("invalid spend limit: %q", parts[0])
+	}
+	window, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || window <= 0 {
+		return nil, fmt.Errorf("invalid spend limit window: %q",
+			parts[1])
+	}
+
+	return &spendLimit{
+		limit:  btcutil.Amount(limit),
+		window: time.Duration(window) * time.Second,
+	}, nil
+}
+
+// spendReservation is a spend that was approved by the spend limit checker
+// but hasn't been recorded yet.
+type spendReservation struct {
+	key    [sha256.Size]byte
+	window time.Duration
+
+	// entry is the key of the spend within the bucket of the caveat. It
+	// is set once the spend is recorded.
+	entry []byte
+}
+
+// spendRequest carries the information about a request that spends funds
+// through the caveat checkers. The checker fills in the reservations, which
+// are recorded once all caveats have been satisfied.
+type spendRequest struct {
+	macID        []byte
+	amount       btcutil.Amount
+	reservations []spendReservation
+}
+
+// spendRequestContextKey is the type of the context key under which a
+// spendRequest is passed to the caveat checkers.
+type spendRequestContextKey struct{}
+
+// spendLimitStore keeps track of the amounts spent by macaroons with a spend
+// limit caveat.
+type spendLimitStore struct {
+	db kvdb.Backend
+
+	// mtx serializes the checking and recording of spends so that
+	// concurrent requests can't exceed the limit together.
+	mtx sync.Mutex
+}
+
+// newSpendLimitStore creates a spendLimitStore backed by the given database.
+func newSpendLimitStore(db kvdb.Backend) (*spendLimitStore, error) {
+	err := db.Update(func(tx kvdb.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(spendLimitBucketName)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &spendLimitStore{db: db}, nil
+}
+
+// checker returns the caveat checker for spend limit caveats. The checker
+// verifies that the amount of the request in the context doesn't exceed the
+// limit and reserves it for recording. Requests that don't spend any funds
+// always satisfy the caveat.
+func (s *spendLimitStore) checker() (string, checkers.Func) {
+	return CondSpendLimit, func(ctx context.Context, cond, arg string) error {
+		limit, err := parseSpendLimit(arg)
+		if err != nil {
+			return err
+		}
+
+		req, ok := ctx.Value(spendRequestContextKey{}).(*spendRequest)
+		if !ok || req.amount == 0 {
+			return nil
+		}
+
+		key := spendLimitKey(req.macID, arg)
+		cutoff := time.Now().Add(-limit.window)
+		err = s.db.View(func(tx kvdb.Tx) error {
+			spent, err := spentSince(tx, key, cutoff)
+			if err != nil {
+				return err
+			}
+
+			if spent+req.amount > limit.limit {
+				return ErrSpendLimitExceeded
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		req.reservations = append(req.reservations, spendReservation{
+			key:    key,
+			window: limit.window,
+		})
+
+		return nil
+	}
+}
+
+// recordSpend records the amount of the request against all the spend limit
+// caveats that approved it. Entries that have fallen out of their window are
+// removed along the way.
+func (s *spendLimitStore) recordSpend(req *spendRequest) error {
+	if len(req.reservations) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	return s.db.Update(func(tx kvdb.Tx) error {
+		spends := tx.Bucket(spendLimitBucketName)
+		for i := range req.reservations {
+			r := &req.reservations[i]
+			bucket, err := spends.CreateBucketIfNotExists(r.key[:])
+			if err != nil {
+				return err
+			}
+
+			err = pruneSpends(bucket, now.Add(-r.window))
+			if err != nil {
+				return err
+			}
+
+			seq, err := bucket.NextSequence()
+			if err != nil {
+				return err
+			}
+
+			var k [16]byte
+			binary.BigEndian.PutUint64(k[:8], uint64(now.UnixNano()))
+			binary.BigEndian.PutUint64(k[8:], seq)
+
+			var v [8]byte
+			binary.BigEndian.PutUint64(v[:], uint64(req.amount))
+
+			if err := bucket.Put(k[:], v[:]); err != nil {
+				return err
+			}
+			r.entry = k[:]
+		}
+
+		return nil
+	})
+}
+
+// refundSpend removes the recorded spends of the request, crediting its
+// amount back to the spend limit caveats that approved it.
+func (s *spendLimitStore) refundSpend(req *spendRequest) error {
+	return s.db.Update(func(tx kvdb.Tx) error {
+		spends := tx.Bucket(spendLimitBucketName)
+		for _, r := range req.reservations {
+			if r.entry == nil {
+				continue
+			}
+
+			bucket := spends.Bucket(r.key[:])
+			if bucket == nil {
+				continue
+			}
+			if err := bucket.Delete(r.entry); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// spendLimitKey returns the key under which the spends accounted against the
+// spend limit caveat with the given argument are stored. The caveat argument
+// is part of the key, so that adding another spend limit caveat to an
+// existing macaroon doesn't reset the accounting of the original one.
+func spendLimitKey(macID []byte, arg string) [sha256.Size]byte {
+	h := sha256.New()
+	h.Write(macID)
+	h.Write([]byte(arg))
+
+	var key [sha256.Size]byte
+	copy(key[:], h.Sum(nil))
+
+	return key
+}
+
+// spentSince returns the total amount recorded under the given key since the
+// cutoff time.
+func spentSince(tx kvdb.Tx, key [sha256.Size]byte,
+	cutoff time.Time) (btcutil.Amount, error) {
+
+	bucket := tx.Bucket(spendLimitBucketName).Bucket(key[:])
+	if bucket == nil {
+		return 0, nil
+	}
+
+	var start [8]byte
+	binary.BigEndian.PutUint64(start[:], uint64(cutoff.UnixNano()))
+
+	var total btcutil.Amount
+	c := bucket.Cursor()
+	for k, v := c.Seek(start[:]); k != nil; k, v = c.Next() {
+		if len(v) != 8 {
+			return 0, fmt.Errorf("invalid spend entry length: %v",
+				len(v))
+		}
+
+		total += btcutil.Amount(binary.BigEndian.Uint64(v))
+	}
+
+	return total, nil
+}
+
+// pruneSpends removes all spends recorded before the cutoff time from the
+// given bucket.
+func pruneSpends(bucket kvdb.Bucket, cutoff time.Time) error {
+	var end [8]byte
+	binary.BigEndian.PutUint64(end[:], uint64(cutoff.UnixNano()))
+
+	var stale [][]byte
+	c := bucket.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		if bytes.Compare(k[:8], end[:]) >= 0 {
+			break
+		}
+
+		stale = append(stale, k)
+	}
+
+	for _, k := range stale {
+		if err := bucket.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,12 +1,13 @@
 package macaroons
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"fmt"
 	"io"
 
-	"github.com/coreos/bbolt"
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 
 	"github.com/btgsuite/btgwallet/snacl"
 )
@@ -20,11 +21,9 @@ var (
 	// rootKeyBucketName is the name of the root key store bucket.
 	rootKeyBucketName = []byte("macrootkeys")
 
-	// defaultRootKeyID is the ID of the default root key. The first is
+	// DefaultRootKeyID is the ID of the default root key. The first is
 	// just 0, to emulate the memory storage that comes with bakery.
-	//
-	// TODO(aakselrod): Add support for key rotation.
-	defaultRootKeyID = []byte("0")
+	DefaultRootKeyID = []byte("0")
 
 	// encryptedKeyID is the name of the database key that stores the
 	// encryption key, encrypted with a salted + hashed password. The
@@ -41,20 +40,70 @@ var (
 
 	// ErrPasswordRequired specifies that a nil password has been passed.
 	ErrPasswordRequired = fmt.Errorf("a non-nil password is required")
+
+	// ErrMissingRootKeyID specifies that an empty root key ID has been
+	// passed.
+	ErrMissingRootKeyID = fmt.Errorf("missing root key ID")
+
+	// ErrDeletionForbidden is returned when attempting to delete the
+	// default root key ID or the encryption key.
+	ErrDeletionForbidden = fmt.Errorf("the specified ID cannot be deleted")
+
+	// ErrRootKeyIDNotFound is returned when the root key ID to delete
+	// doesn't exist.
+	ErrRootKeyIDNotFound = fmt.Errorf("root key ID not found")
 )
 
+// rootKeyIDContextKey is the type of the context key under which a root key ID
+// is passed to the RootKey method.
+type rootKeyIDContextKey struct{}
+
+// ContextWithRootKeyID returns a copy of the passed context that carries the
+// given root key ID. Macaroons baked with the returned context are derived
+// from the root key with that ID, which is created if it doesn't exist yet.
+func ContextWithRootKeyID(ctx context.Context,
+	rootKeyID []byte) context.Context {
+
+	return context.WithValue(ctx, rootKeyIDContextKey{}, rootKeyID)
+}
+
+// rootKeyIDFromContext returns the root key ID carried by the passed context.
+// If the context doesn't carry one, the default root key ID is returned.
+func rootKeyIDFromContext(ctx context.Context) ([]byte, error) {
+	if ctx == nil {
+		return DefaultRootKeyID, nil
+	}
+
+	id, ok := ctx.Value(rootKeyIDContextKey{}).([]byte)
+	if !ok {
+		return DefaultRootKeyID, nil
+	}
+
+	// The encryption key is stored within the same bucket, so it must
+	// never be handed out as a root key.
+	switch {
+	case len(id) == 0:
+		return nil, ErrMissingRootKeyID
+
+	case bytes.Equal(id, encryptedKeyID):
+		return nil, fmt.Errorf("root key ID %s is reserved", id)
+	}
+
+	return id, nil
+}
+
 // RootKeyStorage implements the bakery.RootKeyStorage interface.
 type RootKeyStorage struct {
-	*bbolt.DB
+	kvdb.Backend
 
 	encKey *snacl.SecretKey
 }
 
 // NewRootKeyStorage creates a RootKeyStorage instance.
 // TODO(aakselrod): Add support for encryption of data with passphrase.
-func NewRootKeyStorage(db *bbolt.DB) (*RootKeyStorage, error) {
+func NewRootKeyStorage(db kvdb.Backend) (*RootKeyStorage, error) {
 	// If the store's bucket doesn't exist, create it.
-	err := db.Update(func(tx *bbolt.Tx) error {
+	err := db.Update(func(tx kvdb.Tx) error {
 		_, err := tx.CreateBucketIfNotExists(rootKeyBucketName)
 		return err
 	})
@@ -79,7 +128,7 @@ func (r *RootKeyStorage) CreateUnlock(password *[]byte) error {
 		return ErrPasswordRequired
 	}
 
-	return r.Update(func(tx *bbolt.Tx) error {
+	return r.Update(func(tx kvdb.Tx) error {
 		bucket := tx.Bucket(rootKeyBucketName)
 		dbKey := bucket.Get(encryptedKeyID)
 		if len(dbKey) > 0 {
@@ -123,7 +172,7 @@ func (r *RootKeyStorage) Get(_ context.Context, id []byte) ([]byte, error) {
 		return nil, ErrStoreLocked
 	}
 	var rootKey []byte
-	err := r.View(func(tx *bbolt.Tx) error {
+	err := r.View(func(tx kvdb.Tx) error {
 		dbKey := tx.Bucket(rootKeyBucketName).Get(id)
 		if len(dbKey) == 0 {
 			return fmt.Errorf("root key with id %s doesn't exist",
@@ -147,15 +196,20 @@ func (r *RootKeyStorage) Get(_ context.Context, id []byte) ([]byte, error) {
 }
 
 // RootKey implements the RootKey method for the bakery.RootKeyStorage
-// interface.
-// TODO(aakselrod): Add support for key rotation.
-func (r *RootKeyStorage) RootKey(_ context.Context) ([]byte, []byte, error) {
+// interface. The root key ID is taken from the passed context, see
+// ContextWithRootKeyID. If the context doesn't carry an ID, the default root
+// key is used.
+func (r *RootKeyStorage) RootKey(ctx context.Context) ([]byte, []byte, error) {
 	if r.encKey == nil {
 		return nil, nil, ErrStoreLocked
 	}
+	id, err := rootKeyIDFromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var rootKey []byte
-	id := defaultRootKeyID
-	err := r.Update(func(tx *bbolt.Tx) error {
+	err = r.Update(func(tx kvdb.Tx) error {
 		ns := tx.Bucket(rootKeyBucketName)
 		dbKey := ns.Get(id)
 
@@ -192,11 +246,72 @@ func (r *RootKeyStorage) RootKey(_ context.Context) ([]byte, []byte, error) {
 	return rootKey, id, nil
 }
 
+// ListMacaroonIDs returns all the root key IDs that are currently in use.
+func (r *RootKeyStorage) ListMacaroonIDs(_ context.Context) ([][]byte, error) {
+	if r.encKey == nil {
+		return nil, ErrStoreLocked
+	}
+
+	var rootKeyIDs [][]byte
+	err := r.View(func(tx kvdb.Tx) error {
+		return tx.Bucket(rootKeyBucketName).ForEach(
+			func(k, _ []byte) error {
+				// The encryption key is stored within the same
+				// bucket, so we skip it.
+				if bytes.Equal(k, encryptedKeyID) {
+					return nil
+				}
+
+				id := make([]byte, len(k))
+				copy(id, k)
+				rootKeyIDs = append(rootKeyIDs, id)
+
+				return nil
+			},
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rootKeyIDs, nil
+}
+
+// DeleteMacaroonID removes the root key with the given ID. All macaroons that
+// were derived from it are revoked, as they can no longer be verified. The
+// default root key ID can't be deleted.
+func (r *RootKeyStorage) DeleteMacaroonID(_ context.Context,
+	rootKeyID []byte) error {
+
+	if r.encKey == nil {
+		return ErrStoreLocked
+	}
+
+	switch {
+	case len(rootKeyID) == 0:
+		return ErrMissingRootKeyID
+
+	case bytes.Equal(rootKeyID, DefaultRootKeyID),
+		bytes.Equal(rootKeyID, encryptedKeyID):
+
+		return ErrDeletionForbidden
+	}
+
+	return r.Update(func(tx kvdb.Tx) error {
+		bucket := tx.Bucket(rootKeyBucketName)
+		if bucket.Get(rootKeyID) == nil {
+			return ErrRootKeyIDNotFound
+		}
+
+		return bucket.Delete(rootKeyID)
+	})
+}
+
 // Close closes the underlying database and zeroes the encryption key stored
 // in memory.
 func (r *RootKeyStorage) Close() error {
 	if r.encKey != nil {
 		r.encKey.Zero()
 	}
-	return r.DB.Close()
+	return r.Backend.Close()
 }
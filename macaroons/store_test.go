@@ -2,12 +2,14 @@ package macaroons_test
 
 import (
 	"bytes"
+	"context"
 	"io/ioutil"
 	"os"
 	"path"
+	"reflect"
 	"testing"
 
-	"github.com/coreos/bbolt"
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 
 	"github.com/BTCGPU/lnd/macaroons"
 
@@ -21,8 +23,9 @@ func TestStore(t *testing.T) {
 	}
 	defer os.RemoveAll(tempDir)
 
-	db, err := bbolt.Open(path.Join(tempDir, "weks.db"), 0600,
-		bbolt.DefaultOptions)
+	db, err := kvdb.Create(
+		kvdb.BoltBackendName, path.Join(tempDir, "weks.db"),
+	)
 	if err != nil {
 		t.Fatalf("Error opening store DB: %v", err)
 	}
@@ -75,8 +78,9 @@ func TestStore(t *testing.T) {
 	// Between here and the re-opening of the store, it's possible to get
 	// a double-close, but that's not such a big deal since the tests will
 	// fail anyway in that case.
-	db, err = bbolt.Open(path.Join(tempDir, "weks.db"), 0600,
-		bbolt.DefaultOptions)
+	db, err = kvdb.Create(
+		kvdb.BoltBackendName, path.Join(tempDir, "weks.db"),
+	)
 	if err != nil {
 		t.Fatalf("Error opening store DB: %v", err)
 	}
@@ -135,3 +139,112 @@ func TestStore(t *testing.T) {
 			rootID, id)
 	}
 }
+
+// TestStoreRootKeyIDs tests that root keys can be created under different IDs,
+// listed, and that deleting a root key ID makes its root key unavailable.
+func TestStoreRootKeyIDs(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "macaroonstore-")
+	if err != nil {
+		t.Fatalf("Error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := kvdb.Create(
+		kvdb.BoltBackendName, path.Join(tempDir, "weks.db"),
+	)
+	if err != nil {
+		t.Fatalf("Error opening store DB: %v", err)
+	}
+
+	store, err := macaroons.NewRootKeyStorage(db)
+	if err != nil {
+		db.Close()
+		t.Fatalf("Error creating root key store: %v", err)
+	}
+	defer store.Close()
+
+	pw := []byte("weks")
+	err = store.CreateUnlock(&pw)
+	if err != nil {
+		t.Fatalf("Error creating store encryption key: %v", err)
+	}
+
+	// Create a root key under the default ID and two custom IDs. Each of
+	// them should be distinct.
+	ids := [][]byte{macaroons.DefaultRootKeyID, []byte("1"), []byte("2")}
+	keys := make(map[string][]byte)
+	for _, rootKeyID := range ids {
+		ctx := macaroons.ContextWithRootKeyID(
+			context.Background(), rootKeyID,
+		)
+		key, id, err := store.RootKey(ctx)
+		if err != nil {
+			t.Fatalf("Error getting root key from store: %v", err)
+		}
+		if !bytes.Equal(id, rootKeyID) {
+			t.Fatalf("Root ID doesn't match: expected %v, got %v",
+				rootKeyID, id)
+		}
+		for _, otherKey := range keys {
+			if bytes.Equal(key, otherKey) {
+				t.Fatalf("Root key for ID %s not unique", id)
+			}
+		}
+		keys[string(id)] = key
+	}
+
+	// An empty ID shouldn't be accepted.
+	ctx := macaroons.ContextWithRootKeyID(context.Background(), []byte{})
+	_, _, err = store.RootKey(ctx)
+	if err != macaroons.ErrMissingRootKeyID {
+		t.Fatalf("Received %v instead of ErrMissingRootKeyID", err)
+	}
+
+	listedIDs, err := store.ListMacaroonIDs(nil)
+	if err != nil {
+		t.Fatalf("Error listing root key IDs: %v", err)
+	}
+	if !reflect.DeepEqual(listedIDs, ids) {
+		t.Fatalf("Root key IDs don't match: expected %v, got %v",
+			ids, listedIDs)
+	}
+
+	// The default root key ID can't be deleted, and deleting unknown IDs
+	// should fail.
+	err = store.DeleteMacaroonID(nil, macaroons.DefaultRootKeyID)
+	if err != macaroons.ErrDeletionForbidden {
+		t.Fatalf("Received %v instead of ErrDeletionForbidden", err)
+	}
+	err = store.DeleteMacaroonID(nil, []byte("3"))
+	if err != macaroons.ErrRootKeyIDNotFound {
+		t.Fatalf("Received %v instead of ErrRootKeyIDNotFound", err)
+	}
+
+	// After deleting an ID, its root key should no longer be available,
+	// while the others remain untouched.
+	err = store.DeleteMacaroonID(nil, []byte("1"))
+	if err != nil {
+		t.Fatalf("Error deleting root key ID: %v", err)
+	}
+	if _, err := store.Get(nil, []byte("1")); err == nil {
+		t.Fatalf("Expected root key of deleted ID to be unavailable")
+	}
+	key, err := store.Get(nil, []byte("2"))
+	if err != nil {
+		t.Fatalf("Error getting key with ID 2: %v", err)
+	}
+	if !bytes.Equal(key, keys["2"]) {
+		t.Fatalf("Root key doesn't match: expected %v, got %v",
+			keys["2"], key)
+	}
+
+	listedIDs, err = store.ListMacaroonIDs(nil)
+	if err != nil {
+		t.Fatalf("Error listing root key IDs: %v", err)
+	}
+	expectedIDs := [][]byte{macaroons.DefaultRootKeyID, []byte("2")}
+	if !reflect.DeepEqual(listedIDs, expectedIDs) {
+		t.Fatalf("Root key IDs don't match: expected %v, got %v",
+			expectedIDs, listedIDs)
+	}
+}
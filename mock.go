@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/btgsuite/btgd/btcec"
 	"github.com/btgsuite/btgd/chaincfg"
@@ -276,10 +277,26 @@ func (*mockWalletController) SendOutputs(outputs []*wire.TxOut,
 	return nil, nil
 }
 
+// CreateSimpleTx returns a transaction spending a single dummy input to the
+// given outputs, without any change.
 func (*mockWalletController) CreateSimpleTx(outputs []*wire.TxOut,
 	_ lnwallet.SatPerKWeight, _ bool) (*txauthor.AuthoredTx, error) {
 
-	return nil, nil
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  chainhash.Hash{1},
+			Index: 0,
+		},
+	})
+	for _, output := range outputs {
+		tx.AddTxOut(output)
+	}
+
+	return &txauthor.AuthoredTx{
+		Tx:          tx,
+		ChangeIndex: -1,
+	}, nil
 }
 
 // ListUnspentWitness is called by the wallet when doing coin selection. We just
@@ -312,6 +329,14 @@ func (*mockWalletController) ListTransactionDetails() ([]*lnwallet.TransactionDe
 }
 func (*mockWalletController) LockOutpoint(o wire.OutPoint)   {}
 func (*mockWalletController) UnlockOutpoint(o wire.OutPoint) {}
+func (*mockWalletController) LeaseOutput(lnwallet.LockID, wire.OutPoint,
+	time.Duration) (time.Time, error) {
+
+	return time.Now(), nil
+}
+func (*mockWalletController) ReleaseOutput(lnwallet.LockID, wire.OutPoint) error {
+	return nil
+}
 func (m *mockWalletController) PublishTransaction(tx *wire.MsgTx) error {
 	m.publishedTransactions <- tx
 	return nil
@@ -115,6 +115,10 @@ type peer struct {
 	// our last ping message.  To be used atomically.
 	pingLastSend int64
 
+	// lastSeen is the Unix time expressed in nanoseconds when we last
+	// received a message from the peer. To be used atomically.
+	lastSeen int64
+
 	connReq *connmgr.ConnReq
 	conn    net.Conn
 
@@ -628,6 +632,7 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 		MaxFeeAllocation:        cfg.MaxChannelFeeAllocation,
 		NotifyActiveChannel:     p.server.channelNotifier.NotifyActiveChannelEvent,
 		NotifyInactiveChannel:   p.server.channelNotifier.NotifyInactiveChannelEvent,
+		HtlcNotifier:            p.server.htlcNotifier,
 	}
 
 	link := htlcswitch.NewChannelLink(linkCfg, lnChan)
@@ -725,6 +730,7 @@ func (p *peer) readNextMessage() (lnwire.Message, error) {
 	if err != nil {
 		return nil, err
 	}
+	atomic.StoreInt64(&p.lastSeen, time.Now().UnixNano())
 
 	// Next, create a new io.Reader implementation from the raw message,
 	// and use this to decode the message directly from.
@@ -1726,6 +1732,20 @@ func (p *peer) ChannelSnapshots() []*channeldb.ChannelSnapshot {
 	return snapshots
 }
 
+// chooseDeliveryScript returns the script that our funds are paid to on a
+// cooperative close of the given channel. If we committed to an upfront
+// shutdown script when the channel was opened, we're bound to it, otherwise a
+// new script is generated.
+func (p *peer) chooseDeliveryScript(
+	channel *lnwallet.LightningChannel) ([]byte, error) {
+
+	if script := channel.LocalUpfrontShutdownScript(); len(script) > 0 {
+		return script, nil
+	}
+
+	return p.genDeliveryScript()
+}
+
 // genDeliveryScript returns a new script to be used to send our funds to in
 // the case of a cooperative channel close negotiation.
 func (p *peer) genDeliveryScript() ([]byte, error) {
@@ -1924,6 +1944,19 @@ out:
 				closeMsg.msg,
 			)
 			if err != nil {
+				// If the remote party attempted to close out
+				// to a script other than the one it committed
+				// to, we'll let it know why we won't continue.
+				if err == errUpfrontShutdownScriptMismatch {
+					errMsg := &lnwire.Error{
+						ChanID: closeMsg.cid,
+						Data: lnwire.ErrorData(
+							err.Error(),
+						),
+					}
+					p.queueMsg(errMsg, nil)
+				}
+
 				err := fmt.Errorf("unable to process close "+
 					"msg: %v", err)
 				peerLog.Error(err)
@@ -2064,7 +2097,7 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 
 		// We'll create a valid closing state machine in order to
 		// respond to the initiated cooperative channel closure.
-		deliveryAddr, err := p.genDeliveryScript()
+		deliveryAddr, err := p.chooseDeliveryScript(channel)
 		if err != nil {
 			peerLog.Errorf("unable to gen delivery script: %v", err)
 
@@ -2131,7 +2164,7 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 		// First, we'll fetch a fresh delivery address that we'll use
 		// to send the funds to in the case of a successful
 		// negotiation.
-		deliveryAddr, err := p.genDeliveryScript()
+		deliveryAddr, err := p.chooseDeliveryScript(channel)
 		if err != nil {
 			peerLog.Errorf(err.Error())
 			req.Err <- err
@@ -2435,6 +2468,22 @@ func (p *peer) RemoteGlobalFeatures() *lnwire.FeatureVector {
 	return p.remoteGlobalFeatures
 }
 
+// LocalFeatures returns the set of connection-local features that has been
+// sent to the remote node in the init message.
+//
+// NOTE: Part of the lnpeer.Peer interface.
+func (p *peer) LocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(p.localFeatures, lnwire.LocalFeatures)
+}
+
+// RemoteFeatures returns the set of connection-local features that has been
+// received from the remote node in the init message.
+//
+// NOTE: Part of the lnpeer.Peer interface.
+func (p *peer) RemoteFeatures() *lnwire.FeatureVector {
+	return p.remoteLocalFeatures
+}
+
 // sendInitMsg sends init message to remote peer which contains our currently
 // supported local and global features.
 func (p *peer) sendInitMsg() error {
@@ -2596,6 +2645,17 @@ func (p *peer) AddNewChannel(channel *channeldb.OpenChannel,
 	}
 }
 
+// LastSeen returns the time at which we last received a message from the
+// peer, and zero if we haven't received any message yet.
+func (p *peer) LastSeen() time.Time {
+	lastSeen := atomic.LoadInt64(&p.lastSeen)
+	if lastSeen == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, lastSeen)
+}
+
 // StartTime returns the time at which the connection was established if the
 // peer started successfully, and zero otherwise.
 func (p *peer) StartTime() time.Time {
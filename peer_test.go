@@ -588,3 +588,73 @@ func TestPeerChannelClosureFeeNegotiationsInitiator(t *testing.T) {
 		t.Fatalf("closing tx not broadcast")
 	}
 }
+
+// TestPeerUpfrontShutdownMismatch tests that a Shutdown message paying out to
+// a script other than the upfront shutdown script that the remote peer
+// committed to is rejected with an Error, while the committed script is
+// accepted.
+func TestPeerUpfrontShutdownMismatch(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotfier{
+		confChannel: make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	responder, responderChan, _, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	chanID := lnwire.NewChanIDFromOutPoint(responderChan.ChannelPoint())
+
+	// The remote peer committed to paying out to its upfront shutdown
+	// script, which differs from the dummy delivery script.
+	upfrontScript := append([]byte{0x00, 0x14}, dummyDeliveryScript[:20]...)
+	responderChan.State().RemoteShutdownScript = upfrontScript
+
+	// We send a shutdown request to Alice that pays out to the dummy
+	// delivery script. She must reject it with an Error.
+	responder.chanCloseMsgs <- &closeMsg{
+		cid: chanID,
+		msg: lnwire.NewShutdown(chanID, dummyDeliveryScript),
+	}
+
+	var msg lnwire.Message
+	select {
+	case outMsg := <-responder.outgoingQueue:
+		msg = outMsg.msg
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive error message")
+	}
+
+	errMsg, ok := msg.(*lnwire.Error)
+	if !ok {
+		t.Fatalf("expected Error message, got %T", msg)
+	}
+	if string(errMsg.Data) != errUpfrontShutdownScriptMismatch.Error() {
+		t.Fatalf("expected upfront shutdown mismatch, got: %v",
+			errMsg.Error())
+	}
+
+	// A shutdown request paying out to the committed script is accepted,
+	// so Alice answers it with a Shutdown message of her own.
+	responder.chanCloseMsgs <- &closeMsg{
+		cid: chanID,
+		msg: lnwire.NewShutdown(chanID, upfrontScript),
+	}
+
+	select {
+	case outMsg := <-responder.outgoingQueue:
+		msg = outMsg.msg
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive shutdown message")
+	}
+
+	if _, ok := msg.(*lnwire.Shutdown); !ok {
+		t.Fatalf("expected Shutdown message, got %T", msg)
+	}
+}
@@ -2,10 +2,18 @@ package peernotifier
 
 import (
 	"sync"
+	"time"
 
 	"github.com/BTCGPU/lnd/subscribe"
 )
 
+const (
+	// maxFlapPeers is the maximum number of peers whose flaps are
+	// tracked. Once it is reached, the peer that has been offline for the
+	// longest time is forgotten to make room for a new one.
+	maxFlapPeers = 10000
+)
+
 // PeerNotifier is a subsystem which observes peer offline and online events.
 // It takes subscriptions for its events, and whenever it observes a new event
 // it notifies its subscribers over the proper channel.
@@ -14,6 +22,35 @@ type PeerNotifier struct {
 	stopped sync.Once
 
 	ntfnServer *subscribe.Server
+
+	// flapMtx guards the flaps map.
+	flapMtx sync.Mutex
+
+	// flaps tracks the online and offline events of the peers that we
+	// have seen since the notifier was created. It holds at most
+	// maxFlapPeers entries.
+	flaps map[[33]byte]*FlapInfo
+
+	// maxFlapPeers is the maximum number of entries in the flaps map.
+	maxFlapPeers int
+
+	// now returns the current time.
+	now func() time.Time
+}
+
+// FlapInfo describes how often a peer has come online or gone offline.
+type FlapInfo struct {
+	// Count is the number of times the peer has come online or gone
+	// offline.
+	Count int
+
+	// LastFlap is the time of the most recent online or offline event of
+	// the peer.
+	LastFlap time.Time
+
+	// Online is true if the most recent event of the peer was an online
+	// event.
+	Online bool
 }
 
 // PeerOnlineEvent represents a new event where a peer comes online.
@@ -32,7 +69,10 @@ type PeerOfflineEvent struct {
 // and offline events.
 func New() *PeerNotifier {
 	return &PeerNotifier{
-		ntfnServer: subscribe.NewServer(),
+		ntfnServer:   subscribe.NewServer(),
+		flaps:        make(map[[33]byte]*FlapInfo),
+		maxFlapPeers: maxFlapPeers,
+		now:          time.Now,
 	}
 }
 
@@ -62,9 +102,78 @@ func (p *PeerNotifier) SubscribePeerEvents() (*subscribe.Client, error) {
 	return p.ntfnServer.Subscribe()
 }
 
+// PeerFlapInfo returns the flap information of the given peer. False is
+// returned if no online or offline event has been recorded for the peer.
+func (p *PeerNotifier) PeerFlapInfo(pubKey [33]byte) (FlapInfo, bool) {
+	p.flapMtx.Lock()
+	defer p.flapMtx.Unlock()
+
+	info, ok := p.flaps[pubKey]
+	if !ok {
+		return FlapInfo{}, false
+	}
+
+	return *info, true
+}
+
+// recordFlap records an online or offline event for the given peer.
+func (p *PeerNotifier) recordFlap(pubKey [33]byte, online bool) {
+	p.flapMtx.Lock()
+	defer p.flapMtx.Unlock()
+
+	info, ok := p.flaps[pubKey]
+	if !ok {
+		if len(p.flaps) >= p.maxFlapPeers {
+			p.evictFlapInfo()
+		}
+
+		info = &FlapInfo{}
+		p.flaps[pubKey] = info
+	}
+
+	info.Count++
+	info.LastFlap = p.now()
+	info.Online = online
+}
+
+// evictFlapInfo removes the flap information of the peer that has been
+// offline for the longest time. If all peers are online, the peer whose last
+// flap is the oldest is removed instead.
+//
+// NOTE: The flapMtx MUST be held when calling this method.
+func (p *PeerNotifier) evictFlapInfo() {
+	var (
+		oldest     [33]byte
+		oldestInfo *FlapInfo
+	)
+	for pubKey, info := range p.flaps {
+		if oldestInfo != nil && !evictBefore(info, oldestInfo) {
+			continue
+		}
+
+		oldest = pubKey
+		oldestInfo = info
+	}
+
+	delete(p.flaps, oldest)
+}
+
+// evictBefore returns whether the flap information a should be evicted before
+// b. Offline peers are evicted before online peers, and peers that flapped
+// less recently before peers that flapped more recently.
+func evictBefore(a, b *FlapInfo) bool {
+	if a.Online != b.Online {
+		return !a.Online
+	}
+
+	return a.LastFlap.Before(b.LastFlap)
+}
+
 // NotifyPeerOnline sends a peer online event to all clients subscribed to the
 // peer notifier.
 func (p *PeerNotifier) NotifyPeerOnline(pubKey [33]byte) {
+	p.recordFlap(pubKey, true)
+
 	event := PeerOnlineEvent{PubKey: pubKey}
 
 	log.Debugf("PeerNotifier notifying peer: %x online", pubKey)
@@ -77,6 +186,8 @@ func (p *PeerNotifier) NotifyPeerOnline(pubKey [33]byte) {
 // NotifyPeerOffline sends a peer offline event to all the clients subscribed
 // to the peer notifier.
 func (p *PeerNotifier) NotifyPeerOffline(pubKey [33]byte) {
+	p.recordFlap(pubKey, false)
+
 	event := PeerOfflineEvent{PubKey: pubKey}
 
 	log.Debugf("PeerNotifier notifying peer: %x offline", pubKey)
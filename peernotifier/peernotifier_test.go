@@ -0,0 +1,109 @@
+package peernotifier
+
+import (
+	"testing"
+	"time"
+)
+
+// TestPeerFlapInfo asserts that every online and offline event of a peer is
+// counted, and that the time of the last event is recorded.
+func TestPeerFlapInfo(t *testing.T) {
+	t.Parallel()
+
+	notifier := New()
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	now := time.Unix(1000, 0)
+	notifier.now = func() time.Time {
+		return now
+	}
+
+	alice := [33]byte{1}
+	bob := [33]byte{2}
+
+	if _, ok := notifier.PeerFlapInfo(alice); ok {
+		t.Fatalf("expected no flap info for unseen peer")
+	}
+
+	notifier.NotifyPeerOnline(alice)
+	now = now.Add(time.Minute)
+	notifier.NotifyPeerOffline(alice)
+	now = now.Add(time.Minute)
+	notifier.NotifyPeerOnline(alice)
+	notifier.NotifyPeerOnline(bob)
+
+	info, ok := notifier.PeerFlapInfo(alice)
+	if !ok {
+		t.Fatalf("expected flap info for alice")
+	}
+	if info.Count != 3 {
+		t.Fatalf("expected 3 flaps, got %v", info.Count)
+	}
+	if !info.LastFlap.Equal(now) {
+		t.Fatalf("expected last flap at %v, got %v", now,
+			info.LastFlap)
+	}
+	if !info.Online {
+		t.Fatalf("expected alice to be online")
+	}
+
+	info, ok = notifier.PeerFlapInfo(bob)
+	if !ok {
+		t.Fatalf("expected flap info for bob")
+	}
+	if info.Count != 1 {
+		t.Fatalf("expected 1 flap, got %v", info.Count)
+	}
+}
+
+// TestPeerFlapInfoEviction asserts that the number of peers whose flaps are
+// tracked is bounded, and that offline peers are evicted first.
+func TestPeerFlapInfoEviction(t *testing.T) {
+	t.Parallel()
+
+	notifier := New()
+	notifier.maxFlapPeers = 2
+
+	now := time.Unix(1000, 0)
+	notifier.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	alice := [33]byte{1}
+	bob := [33]byte{2}
+	carol := [33]byte{3}
+	dave := [33]byte{4}
+
+	// Alice went offline after bob came online, but as she is offline
+	// she is evicted to make room for carol.
+	notifier.recordFlap(bob, true)
+	notifier.recordFlap(alice, false)
+	notifier.recordFlap(carol, true)
+
+	if _, ok := notifier.PeerFlapInfo(alice); ok {
+		t.Fatalf("expected alice to be evicted")
+	}
+	if _, ok := notifier.PeerFlapInfo(bob); !ok {
+		t.Fatalf("expected flap info for bob")
+	}
+
+	// With all peers online, the peer with the oldest flap is evicted.
+	notifier.recordFlap(dave, true)
+
+	if _, ok := notifier.PeerFlapInfo(bob); ok {
+		t.Fatalf("expected bob to be evicted")
+	}
+	for _, peer := range [][33]byte{carol, dave} {
+		if _, ok := notifier.PeerFlapInfo(peer); !ok {
+			t.Fatalf("expected flap info for %x", peer)
+		}
+	}
+	if len(notifier.flaps) != 2 {
+		t.Fatalf("expected 2 tracked peers, got %v",
+			len(notifier.flaps))
+	}
+}
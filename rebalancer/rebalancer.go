@@ -0,0 +1,525 @@
+package rebalancer
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/lntypes"
+	"github.com/BTCGPU/lnd/lnwire"
+	"github.com/BTCGPU/lnd/routing"
+	"github.com/BTCGPU/lnd/routing/route"
+	"github.com/BTCGPU/lnd/ticker"
+	btcutil "github.com/btgsuite/btgutil"
+)
+
+const (
+	// invoiceExpiry is the expiry of the invoices that we create to
+	// receive our own rebalance payments. Invoices of failed rebalances
+	// are canceled right away, so this only serves as a backstop in case
+	// we shut down while a rebalance is in flight.
+	invoiceExpiry = time.Hour
+
+	// invoiceMemo is the memo of the invoices that we create to receive
+	// our own rebalance payments.
+	invoiceMemo = "rebalance"
+)
+
+var (
+	// ErrInvalidTarget is returned when a target is set whose local balance
+	// ratios are out of range.
+	ErrInvalidTarget = errors.New("local balance ratios must satisfy " +
+		"0 <= min_local_ratio < max_local_ratio <= 1")
+)
+
+// Target is the range of local balance ratios that is acceptable for a
+// channel. Once the share of the capacity of a channel that is on our side
+// drops below MinLocalRatio or exceeds MaxLocalRatio, the channel is
+// rebalanced towards the middle of the range.
+type Target struct {
+	// MinLocalRatio is the lowest acceptable share of the channel
+	// capacity that is on our side of the channel.
+	MinLocalRatio float64
+
+	// MaxLocalRatio is the highest acceptable share of the channel
+	// capacity that is on our side of the channel.
+	MaxLocalRatio float64
+}
+
+// Validate checks that the ratios of the target are within range.
+func (t Target) Validate() error {
+	if t.MinLocalRatio < 0 || t.MaxLocalRatio > 1 ||
+		t.MinLocalRatio >= t.MaxLocalRatio {
+
+		return ErrInvalidTarget
+	}
+
+	return nil
+}
+
+// desiredLocal returns the local balance that the rebalancer aims for when
+// rebalancing a channel of the given capacity.
+func (t Target) desiredLocal(capacity lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+	ratio := (t.MinLocalRatio + t.MaxLocalRatio) / 2
+	return lnwire.MilliSatoshi(float64(capacity) * ratio)
+}
+
+// RebalanceLog stores the outcome of all rebalances, along with the targets
+// that were set at runtime.
+type RebalanceLog interface {
+	// AddRebalance stores a rebalance event.
+	AddRebalance(event *channeldb.RebalanceEvent) error
+
+	// PutRebalanceTarget stores the target of the given channel, or the
+	// default target if the channel id is zero.
+	PutRebalanceTarget(chanID lnwire.ShortChannelID,
+		target *channeldb.RebalanceTarget) error
+
+	// FetchRebalanceTargets returns all stored targets, keyed by the
+	// channel they apply to.
+	FetchRebalanceTargets() (
+		map[lnwire.ShortChannelID]channeldb.RebalanceTarget, error)
+}
+
+// Config houses all the dependencies of the rebalancer.
+type Config struct {
+	// SelfNode is our own node, which is both the source and the
+	// destination of the rebalance payments.
+	SelfNode route.Vertex
+
+	// FetchChannels returns all of our open channels.
+	FetchChannels func() ([]*channeldb.OpenChannel, error)
+
+	// IsChannelActive checks whether the channel identified by the
+	// provided ChannelID is active and can thus be used to send payments.
+	IsChannelActive func(lnwire.ChannelID) bool
+
+	// AddInvoice adds the invoice that we'll pay to ourselves to move
+	// liquidity between our channels.
+	AddInvoice func(*channeldb.Invoice, lntypes.Hash) (uint64, error)
+
+	// CancelInvoice cancels the invoice of a failed rebalance.
+	CancelInvoice func(lntypes.Hash) error
+
+	// SendPayment sends a payment and blocks until it either settles or
+	// fails.
+	SendPayment func(*routing.LightningPayment) ([32]byte, *route.Route,
+		error)
+
+	// Log is used to persist the outcome of rebalances, and the targets
+	// that are set at runtime.
+	Log RebalanceLog
+
+	// Ticker determines how often the channels are checked for balances
+	// that are out of their target range.
+	Ticker ticker.Ticker
+
+	// DefaultTarget is the target of all channels that don't have a
+	// target of their own, unless a default target was set at runtime.
+	DefaultTarget Target
+
+	// MaxAmount is the maximum amount that is moved by a single rebalance.
+	MaxAmount btcutil.Amount
+
+	// MaxFee is the maximum fee that is paid for a single rebalance.
+	MaxFee btcutil.Amount
+
+	// FinalCLTVDelta is the cltv delta that is required for the final hop
+	// of the rebalance payments.
+	FinalCLTVDelta uint16
+
+	// MaxTotalTimelock is the maximum total time lock that a rebalance
+	// payment may use.
+	MaxTotalTimelock uint32
+}
+
+// Rebalancer keeps the balances of our channels within their target ranges.
+// Once the local balance of a channel crosses one of the bounds of its target,
+// the rebalancer pairs it with a channel that is out of balance in the
+// opposite direction, and sends a circular payment to ourselves that leaves
+// through the channel with the excess local balance and returns through the
+// channel that lacks local balance.
+type Rebalancer struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// defaultTarget is the target of all channels without a target of
+	// their own.
+	defaultTarget Target
+
+	// targets holds the targets of channels that override the default
+	// target.
+	targets map[lnwire.ShortChannelID]Target
+
+	// targetsMtx guards defaultTarget and targets.
+	targetsMtx sync.Mutex
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// New creates a new rebalancer from the given config.
+func New(cfg *Config) (*Rebalancer, error) {
+	if err := cfg.DefaultTarget.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Rebalancer{
+		cfg:           cfg,
+		defaultTarget: cfg.DefaultTarget,
+		targets:       make(map[lnwire.ShortChannelID]Target),
+		quit:          make(chan struct{}),
+	}, nil
+}
+
+// Start starts the rebalancer, after restoring the targets that were set at
+// runtime before.
+func (r *Rebalancer) Start() error {
+	var startErr error
+	r.started.Do(func() {
+		log.Info("Rebalancer starting")
+
+		if err := r.loadTargets(); err != nil {
+			startErr = err
+			return
+		}
+
+		r.cfg.Ticker.Resume()
+
+		r.wg.Add(1)
+		go r.rebalanceLoop()
+	})
+
+	return startErr
+}
+
+// loadTargets restores the targets that were set at runtime from the log. A
+// stored default target takes precedence over the configured one.
+func (r *Rebalancer) loadTargets() error {
+	stored, err := r.cfg.Log.FetchRebalanceTargets()
+	if err != nil {
+		return fmt.Errorf("unable to fetch rebalance targets: %v", err)
+	}
+
+	r.targetsMtx.Lock()
+	defer r.targetsMtx.Unlock()
+
+	for chanID, storedTarget := range stored {
+		target := Target{
+			MinLocalRatio: storedTarget.MinLocalRatio,
+			MaxLocalRatio: storedTarget.MaxLocalRatio,
+		}
+		if err := target.Validate(); err != nil {
+			log.Warnf("Ignoring stored rebalance target of %v: %v",
+				targetName(chanID), err)
+			continue
+		}
+
+		if chanID.ToUint64() == 0 {
+			r.defaultTarget = target
+		} else {
+			r.targets[chanID] = target
+		}
+	}
+
+	log.Infof("Restored %v rebalance targets", len(stored))
+
+	return nil
+}
+
+// Stop signals the rebalancer for a graceful shutdown. A rebalance that is in
+// flight is completed first.
+func (r *Rebalancer) Stop() error {
+	r.stopped.Do(func() {
+		log.Info("Rebalancer shutting down")
+
+		close(r.quit)
+		r.wg.Wait()
+
+		r.cfg.Ticker.Stop()
+	})
+
+	return nil
+}
+
+// SetTarget sets the target of the given channel. If the channel id is zero,
+// the default target that applies to all channels without a target of their
+// own is set instead. The target is persisted, so that it's kept across
+// restarts.
+func (r *Rebalancer) SetTarget(chanID lnwire.ShortChannelID,
+	target Target) error {
+
+	if err := target.Validate(); err != nil {
+		return err
+	}
+
+	r.targetsMtx.Lock()
+	defer r.targetsMtx.Unlock()
+
+	err := r.cfg.Log.PutRebalanceTarget(chanID, &channeldb.RebalanceTarget{
+		MinLocalRatio: target.MinLocalRatio,
+		MaxLocalRatio: target.MaxLocalRatio,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to store rebalance target: %v", err)
+	}
+
+	if chanID.ToUint64() == 0 {
+		r.defaultTarget = target
+	} else {
+		r.targets[chanID] = target
+	}
+
+	log.Infof("Set rebalance target of %v to [%v, %v]",
+		targetName(chanID), target.MinLocalRatio, target.MaxLocalRatio)
+
+	return nil
+}
+
+// Targets returns the default target and the targets of all channels that
+// override it.
+func (r *Rebalancer) Targets() (Target, map[lnwire.ShortChannelID]Target) {
+	r.targetsMtx.Lock()
+	defer r.targetsMtx.Unlock()
+
+	targets := make(map[lnwire.ShortChannelID]Target, len(r.targets))
+	for chanID, target := range r.targets {
+		targets[chanID] = target
+	}
+
+	return r.defaultTarget, targets
+}
+
+// targetOf returns the target that applies to the given channel.
+func (r *Rebalancer) targetOf(chanID lnwire.ShortChannelID) Target {
+	r.targetsMtx.Lock()
+	defer r.targetsMtx.Unlock()
+
+	if target, ok := r.targets[chanID]; ok {
+		return target
+	}
+
+	return r.defaultTarget
+}
+
+// rebalanceLoop checks the balances of our channels every time the ticker
+// fires.
+//
+// NOTE: This MUST be run as a goroutine.
+func (r *Rebalancer) rebalanceLoop() {
+	defer r.wg.Done()
+
+	for {
+		select {
+		case <-r.cfg.Ticker.Ticks():
+			if err := r.rebalance(); err != nil {
+				log.Errorf("Unable to rebalance channels: %v",
+					err)
+			}
+
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// channelBalance describes the balance of one of our channels relative to the
+// balance that it should have.
+type channelBalance struct {
+	// chanID is the short channel id of the channel.
+	chanID lnwire.ShortChannelID
+
+	// offset is the amount by which the local balance of the channel
+	// exceeds or falls short of the local balance that we aim for.
+	offset lnwire.MilliSatoshi
+}
+
+// rebalance looks for channels whose balances are out of their target ranges,
+// and moves liquidity from channels with excess local balance to channels
+// that lack local balance.
+func (r *Rebalancer) rebalance() error {
+	channels, err := r.cfg.FetchChannels()
+	if err != nil {
+		return err
+	}
+
+	// Split the channels that are out of range into the ones that hold
+	// too much and the ones that hold too little local balance.
+	var sources, sinks []*channelBalance
+	for _, channel := range channels {
+		fundingPoint := channel.FundingOutpoint
+		if !r.cfg.IsChannelActive(lnwire.NewChanIDFromOutPoint(
+			&fundingPoint,
+		)) {
+			continue
+		}
+
+		chanID := channel.ShortChannelID
+		capacity := lnwire.NewMSatFromSatoshis(channel.Capacity)
+		local := channel.LocalCommitment.LocalBalance
+		target := r.targetOf(chanID)
+
+		ratio := float64(local) / float64(capacity)
+		desired := target.desiredLocal(capacity)
+
+		switch {
+		case ratio > target.MaxLocalRatio:
+			sources = append(sources, &channelBalance{
+				chanID: chanID,
+				offset: local - desired,
+			})
+
+		case ratio < target.MinLocalRatio:
+			sinks = append(sinks, &channelBalance{
+				chanID: chanID,
+				offset: desired - local,
+			})
+		}
+	}
+
+	if len(sources) == 0 || len(sinks) == 0 {
+		return nil
+	}
+
+	// Handle the channels that are furthest out of balance first.
+	sortByOffset := func(balances []*channelBalance) {
+		sort.Slice(balances, func(i, j int) bool {
+			return balances[i].offset > balances[j].offset
+		})
+	}
+	sortByOffset(sources)
+	sortByOffset(sinks)
+
+	maxAmount := lnwire.NewMSatFromSatoshis(r.cfg.MaxAmount)
+	for _, sink := range sinks {
+		// Pick the source with the largest remaining excess. As the
+		// excess only ever decreases, the sources are re-sorted after
+		// each rebalance.
+		source := sources[0]
+		if source.offset == 0 {
+			break
+		}
+
+		amt := sink.offset
+		if source.offset < amt {
+			amt = source.offset
+		}
+		if maxAmount != 0 && maxAmount < amt {
+			amt = maxAmount
+		}
+
+		succeeded, err := r.rebalancePair(source, sink, amt)
+		if err != nil {
+			return err
+		}
+		if succeeded {
+			source.offset -= amt
+			sortByOffset(sources)
+		}
+
+		select {
+		case <-r.quit:
+			return nil
+		default:
+		}
+	}
+
+	return nil
+}
+
+// rebalancePair moves the given amount from the source channel to the sink
+// channel by sending a circular payment to ourselves. It returns whether the
+// payment succeeded. An error is only returned if the rebalance couldn't be
+// attempted or recorded.
+func (r *Rebalancer) rebalancePair(source, sink *channelBalance,
+	amt lnwire.MilliSatoshi) (bool, error) {
+
+	var preimage lntypes.Preimage
+	if _, err := rand.Read(preimage[:]); err != nil {
+		return false, err
+	}
+	hash := preimage.Hash()
+
+	invoice := &channeldb.Invoice{
+		Memo:           []byte(invoiceMemo),
+		CreationDate:   time.Now(),
+		Expiry:         invoiceExpiry,
+		FinalCltvDelta: int32(r.cfg.FinalCLTVDelta),
+		Terms: channeldb.ContractTerm{
+			Value:           amt,
+			PaymentPreimage: preimage,
+		},
+	}
+	if _, err := r.cfg.AddInvoice(invoice, hash); err != nil {
+		return false, err
+	}
+
+	log.Infof("Rebalancing %v from channel %v to channel %v, payment "+
+		"hash %v", amt, source.chanID, sink.chanID, hash)
+
+	// Pin both legs of the circular payment to the exact channels, as we
+	// may have multiple channels with the peer of the sink channel.
+	outgoingChanID := source.chanID.ToUint64()
+	incomingChanID := sink.chanID.ToUint64()
+	payment := &routing.LightningPayment{
+		Target:            r.cfg.SelfNode,
+		Amount:            amt,
+		FeeLimit:          lnwire.NewMSatFromSatoshis(r.cfg.MaxFee),
+		CltvLimit:         r.cfg.MaxTotalTimelock,
+		PaymentHash:       hash,
+		FinalCLTVDelta:    r.cfg.FinalCLTVDelta,
+		OutgoingChannelID: &outgoingChanID,
+		IncomingChannelID: &incomingChanID,
+		PayAttemptTimeout: routing.DefaultPayAttemptTimeout,
+	}
+
+	event := &channeldb.RebalanceEvent{
+		PaymentHash:    hash,
+		OutgoingChanID: source.chanID,
+		Amount:         amt,
+	}
+
+	_, rt, err := r.cfg.SendPayment(payment)
+	if err != nil {
+		log.Infof("Rebalance %v failed: %v", hash, err)
+
+		err := r.cfg.CancelInvoice(hash)
+		if err != nil && err != channeldb.ErrInvoiceAlreadySettled {
+			log.Errorf("Unable to cancel invoice of rebalance "+
+				"%v: %v", hash, err)
+		}
+	} else {
+		lastChan := rt.Hops[len(rt.Hops)-1].ChannelID
+
+		event.IncomingChanID = lnwire.NewShortChanIDFromInt(lastChan)
+		event.Fee = rt.TotalFees()
+		event.Succeeded = true
+
+		log.Infof("Rebalanced %v from channel %v to channel %v for "+
+			"a fee of %v", amt, source.chanID,
+			event.IncomingChanID, event.Fee)
+	}
+
+	event.Timestamp = time.Now()
+	if err := r.cfg.Log.AddRebalance(event); err != nil {
+		return false, fmt.Errorf("unable to record rebalance: %v", err)
+	}
+
+	return event.Succeeded, nil
+}
+
+// targetName returns a human readable description of the channel that a
+// target applies to.
+func targetName(chanID lnwire.ShortChannelID) string {
+	if chanID.ToUint64() == 0 {
+		return "all channels"
+	}
+
+	return fmt.Sprintf("channel %v", chanID)
+}
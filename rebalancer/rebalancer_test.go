@@ -0,0 +1,441 @@
+package rebalancer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/lntypes"
+	"github.com/BTCGPU/lnd/lnwire"
+	"github.com/BTCGPU/lnd/routing"
+	"github.com/BTCGPU/lnd/routing/route"
+	"github.com/BTCGPU/lnd/ticker"
+	"github.com/btgsuite/btgd/btcec"
+	"github.com/btgsuite/btgd/wire"
+	btcutil "github.com/btgsuite/btgutil"
+)
+
+const testTimeout = 5 * time.Second
+
+var (
+	selfNode = route.Vertex{1}
+
+	testDefaultTarget = Target{
+		MinLocalRatio: 0.2,
+		MaxLocalRatio: 0.8,
+	}
+)
+
+// mockRebalanceLog is an in-memory implementation of the RebalanceLog
+// interface.
+type mockRebalanceLog struct {
+	events  chan channeldb.RebalanceEvent
+	targets map[lnwire.ShortChannelID]channeldb.RebalanceTarget
+}
+
+func newMockRebalanceLog() *mockRebalanceLog {
+	return &mockRebalanceLog{
+		events: make(chan channeldb.RebalanceEvent, 10),
+		targets: make(
+			map[lnwire.ShortChannelID]channeldb.RebalanceTarget,
+		),
+	}
+}
+
+func (m *mockRebalanceLog) AddRebalance(event *channeldb.RebalanceEvent) error {
+	m.events <- *event
+	return nil
+}
+
+func (m *mockRebalanceLog) PutRebalanceTarget(chanID lnwire.ShortChannelID,
+	target *channeldb.RebalanceTarget) error {
+
+	m.targets[chanID] = *target
+	return nil
+}
+
+func (m *mockRebalanceLog) FetchRebalanceTargets() (
+	map[lnwire.ShortChannelID]channeldb.RebalanceTarget, error) {
+
+	targets := make(map[lnwire.ShortChannelID]channeldb.RebalanceTarget)
+	for chanID, target := range m.targets {
+		targets[chanID] = target
+	}
+	return targets, nil
+}
+
+// rebalancerTestContext holds the rebalancer under test along with the
+// channels it sees and the payments it sends.
+type rebalancerTestContext struct {
+	t *testing.T
+
+	rebalancer *Rebalancer
+	ticker     *ticker.Force
+	log        *mockRebalanceLog
+
+	payments chan *routing.LightningPayment
+	results  chan error
+	invoices map[lntypes.Hash]*channeldb.Invoice
+	canceled chan lntypes.Hash
+}
+
+// newTestChannel returns an open channel with the given short channel id,
+// capacity and local balance, whose remote node is derived from the channel
+// id.
+func newTestChannel(chanID uint64, capacity,
+	local btcutil.Amount) *channeldb.OpenChannel {
+
+	_, pubKey := btcec.PrivKeyFromBytes(btcec.S256(), []byte{byte(chanID)})
+
+	return &channeldb.OpenChannel{
+		ShortChannelID:  lnwire.NewShortChanIDFromInt(chanID),
+		FundingOutpoint: wire.OutPoint{Index: uint32(chanID)},
+		IdentityPub:     pubKey,
+		Capacity:        capacity,
+		LocalCommitment: channeldb.ChannelCommitment{
+			LocalBalance: lnwire.NewMSatFromSatoshis(local),
+		},
+	}
+}
+
+func newRebalancerTestContext(t *testing.T,
+	channels []*channeldb.OpenChannel) *rebalancerTestContext {
+
+	ctx := &rebalancerTestContext{
+		t:        t,
+		ticker:   ticker.NewForce(time.Hour),
+		log:      newMockRebalanceLog(),
+		payments: make(chan *routing.LightningPayment),
+		results:  make(chan error),
+		invoices: make(map[lntypes.Hash]*channeldb.Invoice),
+		canceled: make(chan lntypes.Hash, 10),
+	}
+
+	sendPayment := func(payment *routing.LightningPayment) ([32]byte,
+		*route.Route, error) {
+
+		ctx.payments <- payment
+		if err := <-ctx.results; err != nil {
+			return [32]byte{}, nil, err
+		}
+
+		hops := []*route.Hop{
+			{
+				ChannelID:    *payment.OutgoingChannelID,
+				AmtToForward: payment.Amount,
+			},
+			{
+				ChannelID:    *payment.IncomingChannelID,
+				AmtToForward: payment.Amount,
+			},
+		}
+		rt, err := route.NewRouteFromHops(
+			payment.Amount+1000, 100, selfNode, hops,
+		)
+		if err != nil {
+			return [32]byte{}, nil, err
+		}
+
+		invoice := ctx.invoices[payment.PaymentHash]
+		return invoice.Terms.PaymentPreimage, rt, nil
+	}
+
+	rebalancer, err := New(&Config{
+		SelfNode: selfNode,
+		FetchChannels: func() ([]*channeldb.OpenChannel, error) {
+			return channels, nil
+		},
+		IsChannelActive: func(lnwire.ChannelID) bool {
+			return true
+		},
+		AddInvoice: func(invoice *channeldb.Invoice,
+			hash lntypes.Hash) (uint64, error) {
+
+			ctx.invoices[hash] = invoice
+			return 0, nil
+		},
+		CancelInvoice: func(hash lntypes.Hash) error {
+			ctx.canceled <- hash
+			return nil
+		},
+		SendPayment:      sendPayment,
+		Log:              ctx.log,
+		Ticker:           ctx.ticker,
+		DefaultTarget:    testDefaultTarget,
+		MaxAmount:        300000,
+		MaxFee:           5000,
+		FinalCLTVDelta:   40,
+		MaxTotalTimelock: 1000,
+	})
+	if err != nil {
+		t.Fatalf("unable to create rebalancer: %v", err)
+	}
+	ctx.rebalancer = rebalancer
+
+	return ctx
+}
+
+// tick forces the rebalancer to check the channel balances.
+func (ctx *rebalancerTestContext) tick() {
+	select {
+	case ctx.ticker.Force <- time.Now():
+	case <-time.After(testTimeout):
+		ctx.t.Fatalf("rebalancer did not accept tick")
+	}
+}
+
+// assertPayment asserts that the rebalancer sends a payment of the given
+// amount out through the given outgoing channel and back through the given
+// incoming channel. The payment is completed with the given result.
+func (ctx *rebalancerTestContext) assertPayment(outgoingChan uint64,
+	incomingChan uint64, amt btcutil.Amount,
+	result error) *routing.LightningPayment {
+
+	ctx.t.Helper()
+
+	var payment *routing.LightningPayment
+	select {
+	case payment = <-ctx.payments:
+	case <-time.After(testTimeout):
+		ctx.t.Fatalf("no payment sent")
+	}
+
+	if payment.Target != selfNode {
+		ctx.t.Fatalf("expected payment to self, got %v",
+			payment.Target)
+	}
+	if *payment.OutgoingChannelID != outgoingChan {
+		ctx.t.Fatalf("expected outgoing channel %v, got %v",
+			outgoingChan, *payment.OutgoingChannelID)
+	}
+	if *payment.IncomingChannelID != incomingChan {
+		ctx.t.Fatalf("expected incoming channel %v, got %v",
+			incomingChan, *payment.IncomingChannelID)
+	}
+	if payment.LastHop != nil {
+		ctx.t.Fatalf("unexpected last hop %v", *payment.LastHop)
+	}
+	if payment.Amount != lnwire.NewMSatFromSatoshis(amt) {
+		ctx.t.Fatalf("expected amount %v, got %v", amt,
+			payment.Amount)
+	}
+	if payment.FeeLimit != lnwire.NewMSatFromSatoshis(5000) {
+		ctx.t.Fatalf("unexpected fee limit %v", payment.FeeLimit)
+	}
+
+	invoice, ok := ctx.invoices[payment.PaymentHash]
+	if !ok {
+		ctx.t.Fatalf("no invoice added for payment")
+	}
+	if invoice.Terms.Value != payment.Amount {
+		ctx.t.Fatalf("expected invoice of %v, got %v",
+			payment.Amount, invoice.Terms.Value)
+	}
+
+	select {
+	case ctx.results <- result:
+	case <-time.After(testTimeout):
+		ctx.t.Fatalf("payment result not consumed")
+	}
+
+	return payment
+}
+
+// assertEvent asserts that the outcome of the given payment is recorded.
+func (ctx *rebalancerTestContext) assertEvent(
+	payment *routing.LightningPayment, succeeded bool) {
+
+	ctx.t.Helper()
+
+	var event channeldb.RebalanceEvent
+	select {
+	case event = <-ctx.log.events:
+	case <-time.After(testTimeout):
+		ctx.t.Fatalf("no rebalance recorded")
+	}
+
+	if event.PaymentHash != payment.PaymentHash {
+		ctx.t.Fatalf("unexpected payment hash %v", event.PaymentHash)
+	}
+	if event.Amount != payment.Amount {
+		ctx.t.Fatalf("expected amount %v, got %v", payment.Amount,
+			event.Amount)
+	}
+	if event.Succeeded != succeeded {
+		ctx.t.Fatalf("expected succeeded=%v", succeeded)
+	}
+	if succeeded && event.IncomingChanID.ToUint64() !=
+		*payment.IncomingChannelID {
+
+		ctx.t.Fatalf("expected incoming channel %v, got %v",
+			*payment.IncomingChannelID, event.IncomingChanID)
+	}
+
+	var fee lnwire.MilliSatoshi
+	if succeeded {
+		fee = 1000
+	}
+	if event.Fee != fee {
+		ctx.t.Fatalf("expected fee %v, got %v", fee, event.Fee)
+	}
+}
+
+// TestRebalance tests that channels that are out of their target range are
+// rebalanced by circular payments from channels with excess local balance.
+func TestRebalance(t *testing.T) {
+	t.Parallel()
+
+	channels := []*channeldb.OpenChannel{
+		newTestChannel(1, 1000000, 900000),
+		newTestChannel(2, 1000000, 100000),
+		newTestChannel(3, 1000000, 500000),
+	}
+	ctx := newRebalancerTestContext(t, channels)
+
+	if err := ctx.rebalancer.Start(); err != nil {
+		t.Fatalf("unable to start rebalancer: %v", err)
+	}
+	defer ctx.rebalancer.Stop()
+
+	// Raise the target of channel 3, so that it also lacks local balance.
+	err := ctx.rebalancer.SetTarget(
+		lnwire.NewShortChanIDFromInt(3), Target{
+			MinLocalRatio: 0.6,
+			MaxLocalRatio: 0.9,
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to set target: %v", err)
+	}
+
+	ctx.tick()
+
+	// Channel 2 is furthest out of balance, so it is rebalanced first.
+	// The amount is limited by the max amount.
+	payment := ctx.assertPayment(1, 2, 300000, nil)
+	ctx.assertEvent(payment, true)
+
+	// Channel 3 receives the remaining excess of channel 1. The payment
+	// fails, which must cancel the invoice.
+	payment = ctx.assertPayment(1, 3, 100000,
+		errors.New("no route"))
+	ctx.assertEvent(payment, false)
+
+	select {
+	case hash := <-ctx.canceled:
+		if hash != payment.PaymentHash {
+			t.Fatalf("unexpected invoice canceled")
+		}
+	case <-time.After(testTimeout):
+		t.Fatalf("invoice not canceled")
+	}
+}
+
+// TestSetTarget tests that invalid targets are rejected and that channel
+// targets override the default target.
+func TestSetTarget(t *testing.T) {
+	t.Parallel()
+
+	ctx := newRebalancerTestContext(t, nil)
+
+	invalidTargets := []Target{
+		{MinLocalRatio: -0.1, MaxLocalRatio: 0.5},
+		{MinLocalRatio: 0.5, MaxLocalRatio: 1.1},
+		{MinLocalRatio: 0.5, MaxLocalRatio: 0.5},
+	}
+	for _, target := range invalidTargets {
+		err := ctx.rebalancer.SetTarget(
+			lnwire.ShortChannelID{}, target,
+		)
+		if err != ErrInvalidTarget {
+			t.Fatalf("expected invalid target for %v", target)
+		}
+	}
+
+	defaultTarget := Target{MinLocalRatio: 0.1, MaxLocalRatio: 0.9}
+	err := ctx.rebalancer.SetTarget(lnwire.ShortChannelID{}, defaultTarget)
+	if err != nil {
+		t.Fatalf("unable to set default target: %v", err)
+	}
+
+	chanID := lnwire.NewShortChanIDFromInt(5)
+	chanTarget := Target{MinLocalRatio: 0.4, MaxLocalRatio: 0.6}
+	if err := ctx.rebalancer.SetTarget(chanID, chanTarget); err != nil {
+		t.Fatalf("unable to set channel target: %v", err)
+	}
+
+	target, targets := ctx.rebalancer.Targets()
+	if target != defaultTarget {
+		t.Fatalf("unexpected default target %v", target)
+	}
+	if len(targets) != 1 || targets[chanID] != chanTarget {
+		t.Fatalf("unexpected channel targets %v", targets)
+	}
+
+	if ctx.rebalancer.targetOf(chanID) != chanTarget {
+		t.Fatalf("expected channel target to apply")
+	}
+	otherChan := lnwire.NewShortChanIDFromInt(6)
+	if ctx.rebalancer.targetOf(otherChan) != defaultTarget {
+		t.Fatalf("expected default target to apply")
+	}
+}
+
+// TestRestoreTargets tests that targets that are set are persisted, and
+// restored when the rebalancer starts, with a stored default target taking
+// precedence over the configured one.
+func TestRestoreTargets(t *testing.T) {
+	t.Parallel()
+
+	ctx := newRebalancerTestContext(t, nil)
+
+	defaultTarget := Target{MinLocalRatio: 0.1, MaxLocalRatio: 0.9}
+	err := ctx.rebalancer.SetTarget(lnwire.ShortChannelID{}, defaultTarget)
+	if err != nil {
+		t.Fatalf("unable to set default target: %v", err)
+	}
+
+	chanID := lnwire.NewShortChanIDFromInt(5)
+	chanTarget := Target{MinLocalRatio: 0.4, MaxLocalRatio: 0.6}
+	if err := ctx.rebalancer.SetTarget(chanID, chanTarget); err != nil {
+		t.Fatalf("unable to set channel target: %v", err)
+	}
+
+	if len(ctx.log.targets) != 2 {
+		t.Fatalf("expected 2 stored targets, got %v",
+			len(ctx.log.targets))
+	}
+
+	// An invalid stored target is ignored on restore.
+	invalidChanID := lnwire.NewShortChanIDFromInt(6)
+	ctx.log.targets[invalidChanID] = channeldb.RebalanceTarget{
+		MinLocalRatio: 0.6,
+		MaxLocalRatio: 0.4,
+	}
+
+	// Create a new rebalancer on top of the same log, which must restore
+	// the targets once it's started.
+	restarted := newRebalancerTestContext(t, nil)
+	restarted.log = ctx.log
+	restarted.rebalancer.cfg.Log = ctx.log
+
+	target, targets := restarted.rebalancer.Targets()
+	if target != testDefaultTarget || len(targets) != 0 {
+		t.Fatalf("expected no targets to be restored before start")
+	}
+
+	if err := restarted.rebalancer.Start(); err != nil {
+		t.Fatalf("unable to start rebalancer: %v", err)
+	}
+	defer restarted.rebalancer.Stop()
+
+	target, targets = restarted.rebalancer.Targets()
+	if target != defaultTarget {
+		t.Fatalf("expected default target %v, got %v", defaultTarget,
+			target)
+	}
+	if len(targets) != 1 || targets[chanID] != chanTarget {
+		t.Fatalf("unexpected channel targets %v", targets)
+	}
+}
@@ -0,0 +1,16 @@
+package record
+
+import (
+	"github.com/BTCGPU/lnd/tlv"
+)
+
+// KeySendType is the type used in the onion to reference the preimage of a
+// spontaneous key send payment. Because the sender knows the preimage before
+// the payment is made, the receiver doesn't need to issue an invoice up front.
+const KeySendType tlv.Type = 5482373484
+
+// NewKeySendRecord creates a tlv.Record that encodes the key send preimage
+// (type 5482373484) for an onion payload.
+func NewKeySendRecord(preimage *[32]byte) tlv.Record {
+	return tlv.MakePrimitiveRecord(KeySendType, preimage)
+}
@@ -23,6 +23,14 @@ type ControlTower interface {
 	// RegisterAttempt atomically records the provided PaymentAttemptInfo.
 	RegisterAttempt(lntypes.Hash, *channeldb.PaymentAttemptInfo) error
 
+	// SettleAttempt marks the given attempt settled with the preimage. If
+	// this is a multi shard payment, this might implicitly mean that the
+	// full payment succeeded.
+	SettleAttempt(lntypes.Hash, uint64, *channeldb.HTLCSettleInfo) error
+
+	// FailAttempt marks the given payment attempt failed.
+	FailAttempt(lntypes.Hash, uint64, *channeldb.HTLCFailInfo) error
+
 	// Success transitions a payment into the Succeeded state. After
 	// invoking this method, InitPayment should always return an error to
 	// prevent us from making duplicate payments to the same payment hash.
@@ -100,6 +108,20 @@ func (p *controlTower) RegisterAttempt(paymentHash lntypes.Hash,
 	return p.db.RegisterAttempt(paymentHash, attempt)
 }
 
+// SettleAttempt marks the given attempt settled with the preimage.
+func (p *controlTower) SettleAttempt(paymentHash lntypes.Hash,
+	attemptID uint64, settleInfo *channeldb.HTLCSettleInfo) error {
+
+	return p.db.SettleAttempt(paymentHash, attemptID, settleInfo)
+}
+
+// FailAttempt marks the given payment attempt failed.
+func (p *controlTower) FailAttempt(paymentHash lntypes.Hash,
+	attemptID uint64, failInfo *channeldb.HTLCFailInfo) error {
+
+	return p.db.FailAttempt(paymentHash, attemptID, failInfo)
+}
+
 // Success transitions a payment into the Succeeded state. After invoking this
 // method, InitPayment should always return an error to prevent us from making
 // duplicate payments to the same payment hash. The provided preimage is
@@ -7,9 +7,9 @@ import (
 	"time"
 
 	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/lnwire"
 	"github.com/btgsuite/btgd/wire"
-	"github.com/coreos/bbolt"
 )
 
 var (
@@ -35,19 +35,19 @@ const (
 // Also changes to mission control parameters can be applied to historical data.
 // Finally, it enables importing raw data from an external source.
 type missionControlStore struct {
-	db         *bbolt.DB
+	db         kvdb.Backend
 	maxRecords int
 	numRecords int
 }
 
-func newMissionControlStore(db *bbolt.DB, maxRecords int) (*missionControlStore, error) {
+func newMissionControlStore(db kvdb.Backend, maxRecords int) (*missionControlStore, error) {
 	store := &missionControlStore{
 		db:         db,
 		maxRecords: maxRecords,
 	}
 
 	// Create buckets if not yet existing.
-	err := db.Update(func(tx *bbolt.Tx) error {
+	err := db.Update(func(tx kvdb.Tx) error {
 		resultsBucket, err := tx.CreateBucketIfNotExists(resultsKey)
 		if err != nil {
 			return fmt.Errorf("cannot create results bucket: %v",
@@ -74,7 +74,7 @@ func newMissionControlStore(db *bbolt.DB, maxRecords int) (*missionControlStore,
 
 // clear removes all results from the db.
 func (b *missionControlStore) clear() error {
-	return b.db.Update(func(tx *bbolt.Tx) error {
+	return b.db.Update(func(tx kvdb.Tx) error {
 		if err := tx.DeleteBucket(resultsKey); err != nil {
 			return err
 		}
@@ -88,7 +88,7 @@ func (b *missionControlStore) clear() error {
 func (b *missionControlStore) fetchAll() ([]*paymentResult, error) {
 	var results []*paymentResult
 
-	err := b.db.View(func(tx *bbolt.Tx) error {
+	err := b.db.View(func(tx kvdb.Tx) error {
 		resultBucket := tx.Bucket(resultsKey)
 		results = make([]*paymentResult, 0)
 
@@ -218,7 +218,7 @@ func deserializeResult(k, v []byte) (*paymentResult, error) {
 
 // AddResult adds a new result to the db.
 func (b *missionControlStore) AddResult(rp *paymentResult) error {
-	return b.db.Update(func(tx *bbolt.Tx) error {
+	return b.db.Update(func(tx kvdb.Tx) error {
 		bucket := tx.Bucket(resultsKey)
 
 		// Prune oldest entries.
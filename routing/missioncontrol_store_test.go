@@ -10,8 +10,8 @@ import (
 	"github.com/BTCGPU/lnd/lnwire"
 	"github.com/davecgh/go-spew/spew"
 
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/routing/route"
-	"github.com/coreos/bbolt"
 )
 
 const testMaxRecords = 2
@@ -27,7 +27,7 @@ func TestMissionControlStore(t *testing.T) {
 
 	dbPath := file.Name()
 
-	db, err := bbolt.Open(dbPath, 0600, nil)
+	db, err := kvdb.Create(kvdb.BoltBackendName, dbPath)
 	if err != nil {
 		t.Fatal(err)
 	}
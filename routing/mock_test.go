@@ -16,6 +16,8 @@ import (
 type mockPaymentAttemptDispatcher struct {
 	onPayment func(firstHop lnwire.ShortChannelID) ([32]byte, error)
 	results   map[uint64]*htlcswitch.PaymentResult
+
+	sync.Mutex
 }
 
 var _ PaymentAttemptDispatcher = (*mockPaymentAttemptDispatcher)(nil)
@@ -28,6 +30,9 @@ func (m *mockPaymentAttemptDispatcher) SendHTLC(firstHop lnwire.ShortChannelID,
 		return nil
 	}
 
+	m.Lock()
+	defer m.Unlock()
+
 	if m.results == nil {
 		m.results = make(map[uint64]*htlcswitch.PaymentResult)
 	}
@@ -55,6 +60,9 @@ func (m *mockPaymentAttemptDispatcher) GetPaymentResult(paymentID uint64,
 	_ lntypes.Hash, _ htlcswitch.ErrorDecrypter) (
 	<-chan *htlcswitch.PaymentResult, error) {
 
+	m.Lock()
+	defer m.Unlock()
+
 	c := make(chan *htlcswitch.PaymentResult, 1)
 	res, ok := m.results[paymentID]
 	if !ok {
@@ -123,8 +131,9 @@ type mockPaymentSession struct {
 
 var _ PaymentSession = (*mockPaymentSession)(nil)
 
-func (m *mockPaymentSession) RequestRoute(payment *LightningPayment,
-	height uint32, finalCltvDelta uint16) (*route.Route, error) {
+func (m *mockPaymentSession) RequestRoute(payment *LightningPayment, amt,
+	feeLimit lnwire.MilliSatoshi, height uint32,
+	finalCltvDelta uint16) (*route.Route, error) {
 
 	if len(m.routes) == 0 {
 		return nil, fmt.Errorf("no routes")
@@ -253,6 +262,53 @@ func (m *mockControlTower) RegisterAttempt(phash lntypes.Hash,
 	}
 
 	p.Attempt = a
+	p.Attempts = append(p.Attempts, *a)
+	m.inflights[phash] = p
+
+	return nil
+}
+
+func (m *mockControlTower) SettleAttempt(phash lntypes.Hash,
+	attemptID uint64, _ *channeldb.HTLCSettleInfo) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	return m.removeAttempt(phash, attemptID)
+}
+
+func (m *mockControlTower) FailAttempt(phash lntypes.Hash,
+	attemptID uint64, _ *channeldb.HTLCFailInfo) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	return m.removeAttempt(phash, attemptID)
+}
+
+// removeAttempt removes the attempt with the given ID from the set of
+// in-flight attempts of the payment. The caller must hold the mutex.
+func (m *mockControlTower) removeAttempt(phash lntypes.Hash,
+	attemptID uint64) error {
+
+	p, ok := m.inflights[phash]
+	if !ok {
+		return fmt.Errorf("not in flight")
+	}
+
+	var attempts []channeldb.PaymentAttemptInfo
+	for _, a := range p.Attempts {
+		if a.PaymentID == attemptID {
+			continue
+		}
+		attempts = append(attempts, a)
+	}
+
+	if len(attempts) == len(p.Attempts) {
+		return fmt.Errorf("attempt not found")
+	}
+
+	p.Attempts = attempts
 	m.inflights[phash] = p
 
 	return nil
@@ -2,11 +2,12 @@ package routing
 
 import (
 	"container/heap"
+	"errors"
 	"fmt"
 	"math"
 	"time"
 
-	"github.com/coreos/bbolt"
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 
 	"github.com/BTCGPU/lnd/channeldb"
 	"github.com/BTCGPU/lnd/lnwire"
@@ -57,6 +58,25 @@ var (
 	// DefaultAprioriHopProbability is the default a priori probability for
 	// a hop.
 	DefaultAprioriHopProbability = float64(0.6)
+
+	// DefaultAssumedFeatures is the feature vector assumed for
+	// destinations whose features are unknown, because neither the
+	// payment nor a node announcement provides them. These are commonly
+	// private nodes, which we assume to understand the TLV onion payload.
+	DefaultAssumedFeatures = lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(lnwire.TLVOnionPayloadOptional),
+		lnwire.GlobalFeatures,
+	)
+
+	// errNoTlvPayload is returned when the destination hop does not
+	// support a tlv payload.
+	errNoTlvPayload = errors.New("destination hop doesn't " +
+		"understand new TLV payloads")
+
+	// errNoPaymentAddr is returned when the destination hop does not
+	// support payment addresses.
+	errNoPaymentAddr = errors.New("destination hop doesn't " +
+		"understand payment addresses")
 )
 
 // edgePolicyWithSource is a helper struct to keep track of the source node
@@ -174,9 +194,12 @@ func newRoute(amtToSend lnwire.MilliSatoshi, sourceVertex route.Vertex,
 		}
 
 		// If this is the last hop, then we'll populate any TLV records
-		// destined for it.
+		// destined for it. They can only be carried by the TLV
+		// payload, which path finding made sure the destination
+		// understands.
 		if i == len(pathEdges)-1 && len(finalDestRecords) != 0 {
 			currentHop.TLVRecords = finalDestRecords
+			currentHop.LegacyPayload = false
 		}
 
 		hops = append([]*route.Hop{currentHop}, hops...)
@@ -221,7 +244,7 @@ func edgeWeight(lockedAmt lnwire.MilliSatoshi, fee lnwire.MilliSatoshi,
 type graphParams struct {
 	// tx can be set to an existing db transaction. If not set, a new
 	// transaction will be started.
-	tx *bbolt.Tx
+	tx kvdb.Tx
 
 	// graph is the ChannelGraph to be used during path finding.
 	graph *channeldb.ChannelGraph
@@ -257,15 +280,43 @@ type RestrictParams struct {
 	// hop. If nil, any channel may be used.
 	OutgoingChannelID *uint64
 
+	// LastHop is the node that the path must pass through right before
+	// reaching the target. If nil, any node may be the last hop. Together
+	// with OutgoingChannelID, this allows to route a payment to ourselves
+	// in order to rebalance our channels.
+	LastHop *route.Vertex
+
+	// IncomingChannelID is the channel that the path must take into the
+	// target. If nil, any channel may be used. Unlike LastHop, this also
+	// selects between multiple channels with the same last hop.
+	IncomingChannelID *uint64
+
+	// IgnoredNodes is a set of nodes that the path must not pass through.
+	IgnoredNodes map[route.Vertex]struct{}
+
+	// IgnoredPairs is a set of directed node pairs whose channels must not
+	// be used by the path.
+	IgnoredPairs map[DirectedNodePair]struct{}
+
 	// CltvLimit is the maximum time lock of the route excluding the final
 	// ctlv. After path finding is complete, the caller needs to increase
 	// all cltv expiry heights with the required final cltv delta.
 	CltvLimit uint32
 
-	// DestPayloadTLV should be set to true if we need to drop off a TLV
-	// payload at the final hop in order to properly complete this payment
-	// attempt.
-	DestPayloadTLV bool
+	// DestFeatures is the feature vector of the destination, usually
+	// taken from its invoice. If nil, the features from the destination's
+	// node announcement are used instead.
+	DestFeatures *lnwire.FeatureVector
+
+	// FinalDestRecords are the TLV records that are to be dropped off at
+	// the final hop. If any are set, the destination must understand the
+	// TLV payload.
+	FinalDestRecords []tlv.Record
+
+	// PaymentAddr is the payment address that is to be dropped off at the
+	// final hop. If set, the destination must understand payment
+	// addresses.
+	PaymentAddr *[32]byte
 }
 
 // PathFindingConfig defines global parameters that control the trade-off in
@@ -282,6 +333,43 @@ type PathFindingConfig struct {
 	MinProbability float64
 }
 
+// validateDestFeatures checks that the destination's feature vector is sane
+// and that the destination supports all features needed to complete the
+// payment with the given restrictions.
+func validateDestFeatures(features *lnwire.FeatureVector,
+	r *RestrictParams) error {
+
+	// We can't pay a destination that requires features we don't know
+	// about.
+	unknown := features.UnknownRequiredFeatures()
+	if len(unknown) > 0 {
+		return fmt.Errorf("destination requires unknown features: %v",
+			unknown)
+	}
+
+	if err := features.ValidateDeps(); err != nil {
+		return err
+	}
+
+	// If we have any records for the final hop, then we'll check to
+	// ensure that they are actually able to interpret them.
+	supportsTLV := features.HasFeature(lnwire.TLVOnionPayloadOptional)
+	if len(r.FinalDestRecords) != 0 && !supportsTLV {
+		return errNoTlvPayload
+	}
+
+	// Support for payment addresses implies support for the TLV payload
+	// they are carried in, which is enforced by the dependency check
+	// above.
+	if r.PaymentAddr != nil &&
+		!features.HasFeature(lnwire.PaymentAddrOptional) {
+
+		return errNoPaymentAddr
+	}
+
+	return nil
+}
+
 // findPath attempts to find a path from the source node within the
 // ChannelGraph to the target node that's capable of supporting a payment of
 // `amt` value. The current approach implemented is modified version of
@@ -309,6 +397,10 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			"time=%v", nodesVisited, edgesExpanded, timeElapsed)
 	}()
 
+	// A payment to ourselves leaves over one of our channels and comes
+	// back in over another one, which is used to rebalance channels.
+	isSelfPayment := source == target
+
 	var err error
 	tx := g.tx
 	if tx == nil {
@@ -328,8 +420,12 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	// for the node set with a distance of "infinity". graph.ForEachNode
 	// also returns the source node, so there is no need to add the source
 	// node explicitly.
+	//
+	// If the caller didn't provide the features of the destination, we'll
+	// pick up the ones from its node announcement along the way.
+	features := r.DestFeatures
 	distance := make(map[route.Vertex]nodeWithDist)
-	if err := g.graph.ForEachNode(tx, func(_ *bbolt.Tx,
+	if err := g.graph.ForEachNode(tx, func(_ kvdb.Tx,
 		node *channeldb.LightningNode) error {
 		// TODO(roasbeef): with larger graph can just use disk seeks
 		// with a visited map
@@ -339,26 +435,8 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			node: route.Vertex(node.PubKeyBytes),
 		}
 
-		// If we don't have any features for this node, then we can
-		// stop here.
-		if node.Features == nil || !r.DestPayloadTLV {
-			return nil
-		}
-
-		// We only need to perform this check for the final node, so we
-		// can exit here if this isn't them.
-		if vertex != target {
-			return nil
-		}
-
-		// If we have any records for the final hop, then we'll check
-		// not to ensure that they are actually able to interpret them.
-		supportsTLV := node.Features.HasFeature(
-			lnwire.TLVOnionPayloadOptional,
-		)
-		if !supportsTLV {
-			return fmt.Errorf("destination hop doesn't " +
-				"understand new TLV paylods")
+		if r.DestFeatures == nil && vertex == target {
+			features = node.Features
 		}
 
 		return nil
@@ -366,6 +444,18 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		return nil, err
 	}
 
+	// Without any knowledge of the destination's features, we'll fall
+	// back to the ones we assume every destination supports.
+	if features == nil {
+		features = DefaultAssumedFeatures
+	}
+
+	// Before searching for a path, make sure that the destination is able
+	// to process the payment at all.
+	if err := validateDestFeatures(features, r); err != nil {
+		return nil, err
+	}
+
 	additionalEdgesWithSrc := make(map[route.Vertex][]*edgePolicyWithSource)
 	for vertex, outgoingEdgePolicies := range g.additionalEdges {
 		// We'll also include all the nodes found within the additional
@@ -438,6 +528,32 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			return
 		}
 
+		// If we have a last hop restriction, only edges coming from
+		// the specified node may lead into the target.
+		if toNode == target && r.LastHop != nil &&
+			*r.LastHop != fromVertex {
+
+			return
+		}
+
+		// If we have an incoming channel restriction, only the
+		// specified channel may lead into the target.
+		if toNode == target && r.IncomingChannelID != nil &&
+			*r.IncomingChannelID != edge.ChannelID {
+
+			return
+		}
+
+		// Skip nodes and directed pairs that the caller asked us to
+		// ignore. The source node itself can't be ignored.
+		if _, ok := r.IgnoredNodes[fromVertex]; ok && !isSourceChan {
+			return
+		}
+		pair := NewDirectedNodePair(fromVertex, toNode)
+		if _, ok := r.IgnoredPairs[pair]; ok {
+			return
+		}
+
 		// Calculate amount that the candidate node would have to sent
 		// out.
 		toNodeDist := distance[toNode]
@@ -597,12 +713,13 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 
 		// If we've reached our source (or we don't have any incoming
 		// edges), then we're done here and can exit the graph
-		// traversal early.
-		if pivot == source {
+		// traversal early. For a payment to ourselves, the first pivot
+		// is the target rather than the source.
+		if pivot == source && !(isSelfPayment && nodesVisited == 1) {
 			break
 		}
 
-		cb := func(_ *bbolt.Tx, edgeInfo *channeldb.ChannelEdgeInfo, _,
+		cb := func(_ kvdb.Tx, edgeInfo *channeldb.ChannelEdgeInfo, _,
 			inEdge *channeldb.ChannelEdgePolicy) error {
 
 			// If there is no edge policy for this candidate
@@ -664,6 +781,17 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			processEdge(reverseEdge.sourceNode, bandWidth,
 				reverseEdge.edge, pivot)
 		}
+
+		// For a payment to ourselves, the distance entry of the target
+		// is also the one of the source. Now that all edges into the
+		// target have been processed, it is reset such that the source
+		// can be reached as the start of the path.
+		if isSelfPayment && nodesVisited == 1 {
+			distance[source] = nodeWithDist{
+				dist: infinity,
+				node: source,
+			}
+		}
 	}
 
 	// If the source node isn't found in the next hop map, then a path
@@ -674,10 +802,11 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	}
 
 	// Use the nextHop map to unravel the forward path from source to
-	// target.
+	// target. The loop always takes at least one step, as the source and
+	// target are the same for a payment to ourselves.
 	pathEdges := make([]*channeldb.ChannelEdgePolicy, 0, len(next))
 	currentNode := source
-	for currentNode != target { // TODO(roasbeef): assumes no cycles
+	for { // TODO(roasbeef): assumes no cycles
 		// Determine the next hop forward using the next map.
 		nextNode := next[currentNode]
 
@@ -686,6 +815,9 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 
 		// Advance current node.
 		currentNode = route.Vertex(nextNode.Node.PubKeyBytes)
+		if currentNode == target {
+			break
+		}
 	}
 
 	// The route is invalid if it spans more than 20 hops. The current
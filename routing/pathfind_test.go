@@ -13,6 +13,7 @@ import (
 	"math/big"
 	"net"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"github.com/BTCGPU/lnd/channeldb"
 	"github.com/BTCGPU/lnd/lnwire"
 	"github.com/BTCGPU/lnd/routing/route"
+	"github.com/BTCGPU/lnd/tlv"
 	"github.com/BTCGPU/lnd/zpay32"
 	"github.com/btgsuite/btgd/btcec"
 	"github.com/btgsuite/btgd/chaincfg/chainhash"
@@ -671,6 +673,174 @@ func TestFindLowestFeePath(t *testing.T) {
 	}
 }
 
+// TestDestFeatures asserts that path finding checks the features of the
+// destination, taken either from the restrictions or from the graph, against
+// the requirements of the payment.
+func TestDestFeatures(t *testing.T) {
+	t.Parallel()
+
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "target", 100000,
+			&testChannelPolicy{
+				Expiry:  144,
+				MinHTLC: 1,
+				MaxHTLC: 100000000,
+			},
+		),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(
+		testChannels, "roasbeef",
+	)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+	target := testGraphInstance.aliasMap["target"]
+
+	// The private node is only reachable through a route hint, so its
+	// features are unknown unless they're provided by the payment.
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	private := &channeldb.LightningNode{}
+	private.AddPubKey(privKey.PubKey())
+	additionalEdges := map[route.Vertex][]*channeldb.ChannelEdgePolicy{
+		target: {{
+			Node:          private,
+			ChannelID:     1337,
+			TimeLockDelta: 9,
+		}},
+	}
+
+	newFeatures := func(bits ...lnwire.FeatureBit) *lnwire.FeatureVector {
+		return lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(bits...),
+			zpay32.InvoiceFeatures,
+		)
+	}
+
+	destRecords := []tlv.Record{
+		tlv.MakePrimitiveRecord(tlv.Type(70000), new(uint64)),
+	}
+	var payAddr [32]byte
+
+	testCases := []struct {
+		name         string
+		destFeatures *lnwire.FeatureVector
+		destRecords  []tlv.Record
+		payAddr      *[32]byte
+		private      bool
+		expectedErr  error
+	}{
+		{
+			name: "no requirements",
+		},
+		{
+			// The features of the private node are unknown, so
+			// it is assumed to understand the TLV payload.
+			name:        "unknown features tlv",
+			destRecords: destRecords,
+			private:     true,
+		},
+		{
+			name:        "unknown features payment addr",
+			payAddr:     &payAddr,
+			private:     true,
+			expectedErr: errNoPaymentAddr,
+		},
+		{
+			// The target doesn't announce any features in the
+			// graph.
+			name:        "graph no tlv",
+			destRecords: destRecords,
+			expectedErr: errNoTlvPayload,
+		},
+		{
+			name: "invoice tlv",
+			destFeatures: newFeatures(
+				lnwire.TLVOnionPayloadOptional,
+			),
+			destRecords: destRecords,
+		},
+		{
+			name: "invoice no payment addr",
+			destFeatures: newFeatures(
+				lnwire.TLVOnionPayloadOptional,
+			),
+			payAddr:     &payAddr,
+			expectedErr: errNoPaymentAddr,
+		},
+		{
+			name: "invoice payment addr",
+			destFeatures: newFeatures(
+				lnwire.TLVOnionPayloadRequired,
+				lnwire.PaymentAddrRequired,
+			),
+			destRecords: destRecords,
+			payAddr:     &payAddr,
+		},
+		{
+			name: "invoice missing dependency",
+			destFeatures: newFeatures(
+				lnwire.PaymentAddrOptional,
+			),
+			payAddr:     &payAddr,
+			expectedErr: errors.New("missing dependency"),
+		},
+		{
+			name:         "invoice unknown required",
+			destFeatures: newFeatures(100),
+			expectedErr:  errors.New("unknown features"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		restrictions := *noRestrictions
+		restrictions.DestFeatures = tc.destFeatures
+		restrictions.FinalDestRecords = tc.destRecords
+		restrictions.PaymentAddr = tc.payAddr
+
+		dest := target
+		if tc.private {
+			dest = private.PubKeyBytes
+		}
+
+		_, err := findPath(
+			&graphParams{
+				graph:           testGraphInstance.graph,
+				additionalEdges: additionalEdges,
+			},
+			&restrictions, testPathFindingConfig,
+			sourceNode.PubKeyBytes, dest,
+			lnwire.NewMSatFromSatoshis(100),
+		)
+
+		switch {
+		case tc.expectedErr == nil && err != nil:
+			t.Fatalf("%v: unable to find path: %v", tc.name, err)
+
+		case tc.expectedErr == nil:
+
+		case err == nil:
+			t.Fatalf("%v: expected error %v", tc.name,
+				tc.expectedErr)
+
+		case !strings.Contains(err.Error(), tc.expectedErr.Error()):
+			t.Fatalf("%v: expected error %v, got %v", tc.name,
+				tc.expectedErr, err)
+		}
+	}
+}
+
 func getAliasFromPubKey(pubKey route.Vertex,
 	aliases map[string]route.Vertex) string {
 
@@ -1942,6 +2112,206 @@ func TestRestrictOutgoingChannel(t *testing.T) {
 	}
 }
 
+// TestPathRestrictions asserts that path finding obeys last hop and incoming
+// channel restrictions and ignored nodes and pairs, and that it is able to
+// find circular routes back to ourselves.
+func TestPathRestrictions(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph in which the cheapest path from roasbeef to
+	// target leads through a. Nodes a and b are also connected, which
+	// allows roasbeef to route a payment to itself.
+	policy := func(feeRate lnwire.MilliSatoshi) *testChannelPolicy {
+		return &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: feeRate,
+			MinHTLC: 1,
+		}
+	}
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, policy(0), 1),
+		symmetricTestChannel("roasbeef", "b", 100000, policy(0), 2),
+		symmetricTestChannel("a", "target", 100000, policy(400), 3),
+		symmetricTestChannel("b", "target", 100000, policy(800), 4),
+		symmetricTestChannel("a", "b", 100000, policy(100), 5),
+		symmetricTestChannel("b", "target", 100000, policy(1000), 6),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(
+		testChannels, "roasbeef",
+	)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	alias := testGraphInstance.aliasMap
+	source := alias["roasbeef"]
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+
+	outgoingChannelID := uint64(1)
+	lastHopB := alias["b"]
+	incomingChannelID := uint64(6)
+
+	testCases := []struct {
+		name          string
+		target        route.Vertex
+		restrictions  func(r *RestrictParams)
+		expectedChans []uint64
+	}{
+		{
+			name:          "no restrictions",
+			target:        alias["target"],
+			restrictions:  func(r *RestrictParams) {},
+			expectedChans: []uint64{1, 3},
+		},
+		{
+			name:   "last hop",
+			target: alias["target"],
+			restrictions: func(r *RestrictParams) {
+				r.LastHop = &lastHopB
+			},
+			expectedChans: []uint64{2, 4},
+		},
+		{
+			name:   "incoming channel",
+			target: alias["target"],
+			restrictions: func(r *RestrictParams) {
+				r.IncomingChannelID = &incomingChannelID
+			},
+			expectedChans: []uint64{2, 6},
+		},
+		{
+			name:   "ignored node",
+			target: alias["target"],
+			restrictions: func(r *RestrictParams) {
+				r.IgnoredNodes = map[route.Vertex]struct{}{
+					alias["a"]: {},
+				}
+			},
+			expectedChans: []uint64{2, 4},
+		},
+		{
+			name:   "ignored pair",
+			target: alias["target"],
+			restrictions: func(r *RestrictParams) {
+				pair := NewDirectedNodePair(
+					alias["a"], alias["target"],
+				)
+				r.IgnoredPairs = map[DirectedNodePair]struct{}{
+					pair: {},
+				}
+			},
+			expectedChans: []uint64{2, 4},
+		},
+		{
+			name:   "ignored last hop pair",
+			target: alias["target"],
+			restrictions: func(r *RestrictParams) {
+				pair := NewDirectedNodePair(
+					alias["b"], alias["target"],
+				)
+				r.LastHop = &lastHopB
+				r.IgnoredPairs = map[DirectedNodePair]struct{}{
+					pair: {},
+				}
+			},
+			expectedChans: nil,
+		},
+		{
+			name:   "ignored reverse pair",
+			target: alias["target"],
+			restrictions: func(r *RestrictParams) {
+				pair := NewDirectedNodePair(
+					alias["target"], alias["a"],
+				)
+				r.IgnoredPairs = map[DirectedNodePair]struct{}{
+					pair: {},
+				}
+			},
+			expectedChans: []uint64{1, 3},
+		},
+		{
+			name:   "self payment",
+			target: source,
+			restrictions: func(r *RestrictParams) {
+				r.OutgoingChannelID = &outgoingChannelID
+				r.LastHop = &lastHopB
+			},
+			expectedChans: []uint64{1, 5, 2},
+		},
+		{
+			name:   "self payment incoming channel",
+			target: source,
+			restrictions: func(r *RestrictParams) {
+				selfIncomingChannelID := uint64(2)
+				r.OutgoingChannelID = &outgoingChannelID
+				r.IncomingChannelID = &selfIncomingChannelID
+			},
+			expectedChans: []uint64{1, 5, 2},
+		},
+		{
+			name:   "self payment ignored pair",
+			target: source,
+			restrictions: func(r *RestrictParams) {
+				pair := NewDirectedNodePair(
+					alias["a"], alias["b"],
+				)
+				r.OutgoingChannelID = &outgoingChannelID
+				r.LastHop = &lastHopB
+				r.IgnoredPairs = map[DirectedNodePair]struct{}{
+					pair: {},
+				}
+			},
+			expectedChans: []uint64{1, 3, 4, 2},
+		},
+		{
+			name:   "self payment ignored node",
+			target: source,
+			restrictions: func(r *RestrictParams) {
+				r.OutgoingChannelID = &outgoingChannelID
+				r.LastHop = &lastHopB
+				r.IgnoredNodes = map[route.Vertex]struct{}{
+					alias["a"]: {},
+				}
+			},
+			expectedChans: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		restrictions := *noRestrictions
+		tc.restrictions(&restrictions)
+
+		path, err := findPath(
+			&graphParams{
+				graph: testGraphInstance.graph,
+			},
+			&restrictions, testPathFindingConfig,
+			source, tc.target, paymentAmt,
+		)
+		if tc.expectedChans == nil {
+			if err == nil || !IsError(err, ErrNoPathFound) {
+				t.Fatalf("%v: expected no path found, but got "+
+					"%v", tc.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%v: unable to find path: %v", tc.name, err)
+		}
+
+		var chans []uint64
+		for _, edge := range path {
+			chans = append(chans, edge.ChannelID)
+		}
+		if !reflect.DeepEqual(chans, tc.expectedChans) {
+			t.Fatalf("%v: expected channels %v, got %v", tc.name,
+				tc.expectedChans, chans)
+		}
+	}
+}
+
 // TestCltvLimit asserts that a cltv limit is obeyed by the path finding
 // algorithm.
 func TestCltvLimit(t *testing.T) {
@@ -7,11 +7,17 @@ import (
 	sphinx "github.com/BTCGPU/lightning-onion"
 	"github.com/BTCGPU/lnd/channeldb"
 	"github.com/BTCGPU/lnd/htlcswitch"
+	"github.com/BTCGPU/lnd/lntypes"
 	"github.com/BTCGPU/lnd/lnwire"
 	"github.com/BTCGPU/lnd/routing/route"
 	"github.com/davecgh/go-spew/spew"
 )
 
+// minShardAmt is the lower limit on the amount of a single shard of a
+// multi-path payment. When no route can be found for a shard, we won't split
+// it up any further below this amount.
+const minShardAmt = lnwire.MilliSatoshi(10000)
+
 // errNoRoute is returned when all routes from the payment session have been
 // attempted.
 type errNoRoute struct {
@@ -26,6 +32,34 @@ func (e errNoRoute) Error() string {
 		e.lastError)
 }
 
+// paymentShard is a single HTLC attempt that is in flight for a payment. A
+// regular payment only has a single shard in flight at a time, while a
+// multi-path payment can have several.
+type paymentShard struct {
+	// attempt is the attempt information that was registered with the
+	// control tower for this shard.
+	attempt *channeldb.PaymentAttemptInfo
+
+	// amt is the amount this shard delivers to the receiver.
+	amt lnwire.MilliSatoshi
+
+	// fee is the fee paid to route this shard.
+	fee lnwire.MilliSatoshi
+}
+
+// shardResult is the outcome of a shard as retrieved from the switch.
+type shardResult struct {
+	// shard is the shard this result is for.
+	shard *paymentShard
+
+	// result is the payment result returned by the switch. It is only set
+	// if err is nil.
+	result *htlcswitch.PaymentResult
+
+	// err is set if we were unable to retrieve the result from the switch.
+	err error
+}
+
 // paymentLifecycle holds all information about the current state of a payment
 // needed to resume if from any point.
 type paymentLifecycle struct {
@@ -35,271 +69,434 @@ type paymentLifecycle struct {
 	timeoutChan    <-chan time.Time
 	currentHeight  int32
 	finalCLTVDelta uint16
-	attempt        *channeldb.PaymentAttemptInfo
-	circuit        *sphinx.Circuit
-	lastError      error
+
+	// existingAttempts are the attempts that were in flight for this
+	// payment before a restart, and whose results must be retrieved.
+	existingAttempts []channeldb.PaymentAttemptInfo
+
+	// shards holds all shards currently in flight, keyed by their payment
+	// ID.
+	shards map[uint64]*paymentShard
+
+	// shardResults is the channel on which the results of the shards in
+	// flight are delivered.
+	shardResults chan *shardResult
+
+	// quit is closed when the payment lifecycle exits, signalling the
+	// result collectors to stop.
+	quit chan struct{}
+
+	lastError error
 }
 
 // resumePayment resumes the paymentLifecycle from the current state.
 func (p *paymentLifecycle) resumePayment() ([32]byte, *route.Route, error) {
+	// Make sure any result collectors still running exit when we return.
+	defer close(p.quit)
+
+	// If this payment had attempts in flight before a restart, we'll start
+	// by waiting for their results.
+	for i := range p.existingAttempts {
+		attempt := &p.existingAttempts[i]
+
+		shard := &paymentShard{
+			attempt: attempt,
+			amt:     attempt.Route.ReceiverAmt(),
+			fee:     attempt.Route.TotalFees(),
+		}
+		p.shards[attempt.PaymentID] = shard
+
+		// Since this attempt was resumed, we must regenerate the
+		// circuit, which is done by passing a nil circuit.
+		p.collectResult(shard, nil)
+	}
+
+	var (
+		// shardAmt is the amount we'll attempt to send with the next
+		// shard. A zero value means that the full remaining amount
+		// should be attempted.
+		shardAmt lnwire.MilliSatoshi
+
+		// failReason is set when the payment has reached a terminal
+		// failure. No new shards will be launched, and the payment is
+		// failed as soon as no more shards are in flight.
+		failReason *channeldb.FailureReason
+		failErr    error
+
+		// preimage and successRoute are set once a shard succeeds.
+		preimage     *lntypes.Preimage
+		successRoute *route.Route
+	)
+
 	// We'll continue until either our payment succeeds, or we encounter a
 	// critical error during path finding.
 	for {
+		// Launch new shards as long as part of the amount is left
+		// unsent, and we haven't reached a terminal state.
+	launchLoop:
+		for failReason == nil && preimage == nil {
+			// A payment with nothing in flight always gets a new
+			// attempt, even if its amount is zero, as is the case
+			// for some routes handed to SendToRoute.
+			remaining := p.remainingAmt()
+			if (remaining == 0 && len(p.shards) > 0) ||
+				p.maxShardsInFlight() {
+
+				break
+			}
 
-		// If this payment had no existing payment attempt, we create
-		// and send one now.
-		if p.attempt == nil {
-			firstHop, htlcAdd, err := p.createNewPaymentAttempt()
+			if shardAmt == 0 || shardAmt > remaining {
+				shardAmt = remaining
+			}
+
+			// Before we attempt this next shard, we'll check to
+			// see if either we've gone past the payment attempt
+			// timeout, or the router is exiting. In either case,
+			// we'll stop making attempts. If a timeout is not
+			// applicable, timeoutChan will be nil.
+			select {
+			case <-p.timeoutChan:
+				reason := channeldb.FailureReasonTimeout
+				failReason = &reason
+				failErr = newErr(
+					ErrPaymentAttemptTimeout, "payment "+
+						"attempt not completed before "+
+						"timeout",
+				)
+				continue
+
+			case <-p.router.quit:
+				// The payment will be resumed from the current
+				// state after restart.
+				return [32]byte{}, nil, ErrRouterShuttingDown
+
+			default:
+				// Fall through if we haven't hit our time
+				// limit, or are expiring.
+			}
+
+			// Create a new payment attempt from the given payment
+			// session.
+			rt, err := p.paySession.RequestRoute(
+				p.payment, shardAmt, p.remainingFeeLimit(),
+				uint32(p.currentHeight), p.finalCLTVDelta,
+			)
+			if err != nil {
+				log.Warnf("Failed to find route for payment "+
+					"%x: %v", p.payment.PaymentHash, err)
+
+				switch {
+
+				// If this is a multi-path payment, we'll try
+				// to split the amount into smaller shards.
+				case IsError(err, ErrNoPathFound) &&
+					p.canSplit(shardAmt):
+
+					shardAmt /= 2
+
+					log.Debugf("Splitting payment %x, "+
+						"next shard amount: %v",
+						p.payment.PaymentHash, shardAmt)
+
+					continue
+
+				// If other shards are still in flight, we'll
+				// wait for their outcome before trying again,
+				// as they might free up liquidity.
+				case len(p.shards) > 0:
+					break launchLoop
+				}
+
+				// If we're unable to successfully make a
+				// payment using any of the routes we've found,
+				// then mark the payment as permanently failed.
+				reason := channeldb.FailureReasonNoRoute
+				failReason = &reason
+				failErr = err
+
+				// If there was an error already recorded for
+				// this payment, we'll return that.
+				if p.lastError != nil {
+					failErr = errNoRoute{lastError: p.lastError}
+				}
+				continue
+			}
+
+			shard, htlcAdd, circuit, err := p.createNewPaymentAttempt(
+				rt, shardAmt,
+			)
 			if err != nil {
 				return [32]byte{}, nil, err
 			}
 
 			// Now that the attempt is created and checkpointed to
 			// the DB, we send it.
-			sendErr := p.sendPaymentAttempt(firstHop, htlcAdd)
+			sendErr := p.sendPaymentAttempt(shard, htlcAdd)
 			if sendErr != nil {
+				if err := p.failAttempt(shard); err != nil {
+					return [32]byte{}, nil, err
+				}
+
 				// We must inspect the error to know whether it
 				// was critical or not, to decide whether we
 				// should continue trying.
-				err := p.handleSendError(sendErr)
+				reason := p.handleSendError(shard, sendErr)
+				if reason != nil {
+					failReason = reason
+					failErr = sendErr
+				}
+				continue
+			}
+
+			// The shard is in flight, wait for its result in the
+			// background and move on to the remaining amount.
+			p.shards[shard.attempt.PaymentID] = shard
+			p.collectResult(shard, circuit)
+
+			shardAmt = 0
+		}
+
+		// If there are no shards left in flight, the payment has
+		// reached its final state.
+		if len(p.shards) == 0 {
+			switch {
+			case preimage != nil:
+				// In case of success we atomically store the
+				// db payment and move the payment to the
+				// success state.
+				err := p.router.cfg.Control.Success(
+					p.payment.PaymentHash, *preimage,
+				)
 				if err != nil {
+					log.Errorf("Unable to succeed payment "+
+						"attempt: %v", err)
 					return [32]byte{}, nil, err
 				}
 
-				// Error was handled successfully, reset the
-				// attempt to indicate we want to make a new
-				// attempt.
-				p.attempt = nil
-				continue
+				// Terminal state, return the preimage and the
+				// route taken.
+				return *preimage, successRoute, nil
+
+			case failReason == nil:
+				// This can only happen if the full amount was
+				// sent without a result, which should never
+				// happen.
+				reason := channeldb.FailureReasonError
+				failReason = &reason
+				failErr = fmt.Errorf("no shards in flight " +
+					"for payment")
 			}
-		} else {
-			// If this was a resumed attempt, we must regenerate the
-			// circuit.
-			_, c, err := generateSphinxPacket(
-				&p.attempt.Route, p.payment.PaymentHash[:],
-				p.attempt.SessionKey,
+
+			// Mark the payment failed with the recorded reason.
+			//
+			// TODO(halseth): make payment codes for the actual
+			// reason we don't continue path finding.
+			err := p.router.cfg.Control.Fail(
+				p.payment.PaymentHash, *failReason,
 			)
 			if err != nil {
 				return [32]byte{}, nil, err
 			}
-			p.circuit = c
+
+			// Terminal state, return the error we encountered.
+			return [32]byte{}, nil, failErr
 		}
 
-		// Using the created circuit, initialize the error decrypter so we can
-		// parse+decode any failures incurred by this payment within the
-		// switch.
-		errorDecryptor := &htlcswitch.SphinxErrorDecrypter{
-			OnionErrorDecrypter: sphinx.NewOnionErrorDecrypter(p.circuit),
+		// Wait for the next shard result to be available.
+		var res *shardResult
+		select {
+		case res = <-p.shardResults:
+
+		case <-p.router.quit:
+			return [32]byte{}, nil, ErrRouterShuttingDown
 		}
 
-		// Now ask the switch to return the result of the payment when
-		// available.
-		resultChan, err := p.router.cfg.Payer.GetPaymentResult(
-			p.attempt.PaymentID, p.payment.PaymentHash, errorDecryptor,
-		)
+		shard := res.shard
+		paymentID := shard.attempt.PaymentID
+		delete(p.shards, paymentID)
+
 		switch {
 
 		// If this payment ID is unknown to the Switch, it means it was
 		// never checkpointed and forwarded by the switch before a
 		// restart. In this case we can safely send a new payment
 		// attempt, and wait for its result to be available.
-		case err == htlcswitch.ErrPaymentIDNotFound:
+		case res.err == htlcswitch.ErrPaymentIDNotFound:
 			log.Debugf("Payment ID %v for hash %x not found in "+
-				"the Switch, retrying.", p.attempt.PaymentID,
+				"the Switch, retrying.", paymentID,
 				p.payment.PaymentHash)
 
-			// Reset the attempt to indicate we want to make a new
-			// attempt.
-			p.attempt = nil
-			continue
+			if err := p.failAttempt(shard); err != nil {
+				return [32]byte{}, nil, err
+			}
 
 		// A critical, unexpected error was encountered.
-		case err != nil:
+		case res.err != nil:
 			log.Errorf("Failed getting result for paymentID %d "+
-				"from switch: %v", p.attempt.PaymentID, err)
+				"from switch: %v", paymentID, res.err)
 
-			return [32]byte{}, nil, err
-		}
-
-		// The switch knows about this payment, we'll wait for a result
-		// to be available.
-		var (
-			result *htlcswitch.PaymentResult
-			ok     bool
-		)
-
-		select {
-		case result, ok = <-resultChan:
-			if !ok {
-				return [32]byte{}, nil, htlcswitch.ErrSwitchExiting
-			}
-
-		case <-p.router.quit:
-			return [32]byte{}, nil, ErrRouterShuttingDown
-		}
+			return [32]byte{}, nil, res.err
 
 		// In case of a payment failure, we use the error to decide
 		// whether we should retry.
-		if result.Error != nil {
+		case res.result.Error != nil:
 			log.Errorf("Attempt to send payment %x failed: %v",
-				p.payment.PaymentHash, result.Error)
+				p.payment.PaymentHash, res.result.Error)
+
+			if err := p.failAttempt(shard); err != nil {
+				return [32]byte{}, nil, err
+			}
 
 			// We must inspect the error to know whether it was
 			// critical or not, to decide whether we should
 			// continue trying.
-			if err := p.handleSendError(result.Error); err != nil {
-				return [32]byte{}, nil, err
+			reason := p.handleSendError(shard, res.result.Error)
+			if reason != nil && failReason == nil {
+				failReason = reason
+				failErr = res.result.Error
 			}
 
-			// Error was handled successfully, reset the attempt to
-			// indicate we want to make a new attempt.
-			p.attempt = nil
-			continue
-		}
-
 		// We successfully got a payment result back from the switch.
-		log.Debugf("Payment %x succeeded with pid=%v",
-			p.payment.PaymentHash, p.attempt.PaymentID)
+		default:
+			log.Debugf("Payment %x succeeded with pid=%v",
+				p.payment.PaymentHash, paymentID)
+
+			err := p.router.cfg.Control.SettleAttempt(
+				p.payment.PaymentHash, paymentID,
+				&channeldb.HTLCSettleInfo{
+					Preimage:   res.result.Preimage,
+					SettleTime: time.Now(),
+				},
+			)
+			if err != nil {
+				log.Errorf("Unable to settle payment "+
+					"attempt: %v", err)
+				return [32]byte{}, nil, err
+			}
 
-		// Report success to mission control.
-		err = p.router.cfg.MissionControl.ReportPaymentSuccess(
-			p.attempt.PaymentID, &p.attempt.Route,
-		)
-		if err != nil {
-			log.Errorf("Error reporting payment success to mc: %v",
-				err)
-		}
+			// Report success to mission control.
+			err = p.router.cfg.MissionControl.ReportPaymentSuccess(
+				paymentID, &shard.attempt.Route,
+			)
+			if err != nil {
+				log.Errorf("Error reporting payment success "+
+					"to mc: %v", err)
+			}
 
-		// In case of success we atomically store the db payment and
-		// move the payment to the success state.
-		err = p.router.cfg.Control.Success(p.payment.PaymentHash, result.Preimage)
-		if err != nil {
-			log.Errorf("Unable to succeed payment "+
-				"attempt: %v", err)
-			return [32]byte{}, nil, err
+			// All shards of the payment share the same payment
+			// hash, so the first preimage we learn is the one
+			// that settles the full payment.
+			if preimage == nil {
+				preimg := lntypes.Preimage(res.result.Preimage)
+				preimage = &preimg
+				successRoute = &shard.attempt.Route
+			}
 		}
+	}
+}
 
-		// Terminal state, return the preimage and the route
-		// taken.
-		return result.Preimage, &p.attempt.Route, nil
+// remainingAmt returns the part of the payment amount that isn't covered by
+// the shards currently in flight.
+func (p *paymentLifecycle) remainingAmt() lnwire.MilliSatoshi {
+	var inFlight lnwire.MilliSatoshi
+	for _, shard := range p.shards {
+		inFlight += shard.amt
 	}
 
+	if inFlight >= p.payment.Amount {
+		return 0
+	}
+
+	return p.payment.Amount - inFlight
 }
 
-// createNewPaymentAttempt creates and stores a new payment attempt to the
-// database.
-func (p *paymentLifecycle) createNewPaymentAttempt() (lnwire.ShortChannelID,
-	*lnwire.UpdateAddHTLC, error) {
+// remainingFeeLimit returns the part of the payment's fee limit that isn't
+// used by the shards currently in flight.
+func (p *paymentLifecycle) remainingFeeLimit() lnwire.MilliSatoshi {
+	var fees lnwire.MilliSatoshi
+	for _, shard := range p.shards {
+		fees += shard.fee
+	}
 
-	// Before we attempt this next payment, we'll check to see if either
-	// we've gone past the payment attempt timeout, or the router is
-	// exiting. In either case, we'll stop this payment attempt short. If a
-	// timeout is not applicable, timeoutChan will be nil.
-	select {
-	case <-p.timeoutChan:
-		// Mark the payment as failed because of the
-		// timeout.
-		err := p.router.cfg.Control.Fail(
-			p.payment.PaymentHash, channeldb.FailureReasonTimeout,
-		)
-		if err != nil {
-			return lnwire.ShortChannelID{}, nil, err
-		}
+	if fees >= p.payment.FeeLimit {
+		return 0
+	}
 
-		errStr := fmt.Sprintf("payment attempt not completed " +
-			"before timeout")
+	return p.payment.FeeLimit - fees
+}
 
-		return lnwire.ShortChannelID{}, nil,
-			newErr(ErrPaymentAttemptTimeout, errStr)
+// maxShardsInFlight returns true if no more shards can be launched before
+// one of the shards currently in flight has resolved.
+func (p *paymentLifecycle) maxShardsInFlight() bool {
+	maxParts := p.payment.MaxParts
+	if maxParts == 0 {
+		maxParts = 1
+	}
 
-	case <-p.router.quit:
-		// The payment will be resumed from the current state
-		// after restart.
-		return lnwire.ShortChannelID{}, nil, ErrRouterShuttingDown
+	return uint32(len(p.shards)) >= maxParts
+}
 
-	default:
-		// Fall through if we haven't hit our time limit, or
-		// are expiring.
+// canSplit returns true if a shard of the given amount can be split into two
+// smaller shards.
+func (p *paymentLifecycle) canSplit(amt lnwire.MilliSatoshi) bool {
+	// We'll need room for at least two more shards to split this one.
+	if uint32(len(p.shards))+2 > p.payment.MaxParts {
+		return false
 	}
 
-	// Create a new payment attempt from the given payment session.
-	route, err := p.paySession.RequestRoute(
-		p.payment, uint32(p.currentHeight), p.finalCLTVDelta,
-	)
-	if err != nil {
-		log.Warnf("Failed to find route for payment %x: %v",
-			p.payment.PaymentHash, err)
+	return amt/2 >= minShardAmt
+}
 
-		// If we're unable to successfully make a payment using
-		// any of the routes we've found, then mark the payment
-		// as permanently failed.
-		saveErr := p.router.cfg.Control.Fail(
-			p.payment.PaymentHash, channeldb.FailureReasonNoRoute,
-		)
-		if saveErr != nil {
-			return lnwire.ShortChannelID{}, nil, saveErr
-		}
-
-		// If there was an error already recorded for this
-		// payment, we'll return that.
-		if p.lastError != nil {
-			return lnwire.ShortChannelID{}, nil,
-				errNoRoute{lastError: p.lastError}
-		}
-		// Terminal state, return.
-		return lnwire.ShortChannelID{}, nil, err
-	}
+// createNewPaymentAttempt creates a new payment attempt for the given route
+// and stores it to the database. The shard amount is the amount the route
+// delivers to the receiver.
+func (p *paymentLifecycle) createNewPaymentAttempt(rt *route.Route,
+	amt lnwire.MilliSatoshi) (*paymentShard, *lnwire.UpdateAddHTLC,
+	*sphinx.Circuit, error) {
 
 	// Generate a new key to be used for this attempt.
 	sessionKey, err := generateNewSessionKey()
 	if err != nil {
-		return lnwire.ShortChannelID{}, nil, err
+		return nil, nil, nil, err
 	}
 
 	// Generate the raw encoded sphinx packet to be included along
 	// with the htlcAdd message that we send directly to the
 	// switch.
 	onionBlob, c, err := generateSphinxPacket(
-		route, p.payment.PaymentHash[:], sessionKey,
+		rt, p.payment.PaymentHash[:], sessionKey,
 	)
 	if err != nil {
-		return lnwire.ShortChannelID{}, nil, err
+		return nil, nil, nil, err
 	}
 
-	// Update our cached circuit with the newly generated
-	// one.
-	p.circuit = c
-
 	// Craft an HTLC packet to send to the layer 2 switch. The
 	// metadata within this packet will be used to route the
 	// payment through the network, starting with the first-hop.
 	htlcAdd := &lnwire.UpdateAddHTLC{
-		Amount:      route.TotalAmount,
-		Expiry:      route.TotalTimeLock,
+		Amount:      rt.TotalAmount,
+		Expiry:      rt.TotalTimeLock,
 		PaymentHash: p.payment.PaymentHash,
 	}
 	copy(htlcAdd.OnionBlob[:], onionBlob)
 
-	// Attempt to send this payment through the network to complete
-	// the payment. If this attempt fails, then we'll continue on
-	// to the next available route.
-	firstHop := lnwire.NewShortChanIDFromInt(
-		route.Hops[0].ChannelID,
-	)
-
 	// We generate a new, unique payment ID that we will use for
 	// this HTLC.
 	paymentID, err := p.router.cfg.NextPaymentID()
 	if err != nil {
-		return lnwire.ShortChannelID{}, nil, err
+		return nil, nil, nil, err
 	}
 
 	// We now have all the information needed to populate
 	// the current attempt information.
-	p.attempt = &channeldb.PaymentAttemptInfo{
-		PaymentID:  paymentID,
-		SessionKey: sessionKey,
-		Route:      *route,
+	shard := &paymentShard{
+		attempt: &channeldb.PaymentAttemptInfo{
+			PaymentID:  paymentID,
+			SessionKey: sessionKey,
+			Route:      *rt,
+		},
+		amt: amt,
+		fee: rt.TotalFees(),
 	}
 
 	// Before sending this HTLC to the switch, we checkpoint the
@@ -308,51 +505,155 @@ func (p *paymentLifecycle) createNewPaymentAttempt() (lnwire.ShortChannelID,
 	// such that we can query the Switch for its whereabouts. The
 	// route is needed to handle the result when it eventually
 	// comes back.
-	err = p.router.cfg.Control.RegisterAttempt(p.payment.PaymentHash, p.attempt)
+	err = p.router.cfg.Control.RegisterAttempt(
+		p.payment.PaymentHash, shard.attempt,
+	)
 	if err != nil {
-		return lnwire.ShortChannelID{}, nil, err
+		return nil, nil, nil, err
 	}
 
-	return firstHop, htlcAdd, nil
+	return shard, htlcAdd, c, nil
 }
 
-// sendPaymentAttempt attempts to send the current attempt to the switch.
-func (p *paymentLifecycle) sendPaymentAttempt(firstHop lnwire.ShortChannelID,
+// sendPaymentAttempt attempts to send the given shard to the switch.
+func (p *paymentLifecycle) sendPaymentAttempt(shard *paymentShard,
 	htlcAdd *lnwire.UpdateAddHTLC) error {
 
+	attempt := shard.attempt
+
 	log.Tracef("Attempting to send payment %x (pid=%v), "+
-		"using route: %v", p.payment.PaymentHash, p.attempt.PaymentID,
+		"using route: %v", p.payment.PaymentHash, attempt.PaymentID,
 		newLogClosure(func() string {
-			return spew.Sdump(p.attempt.Route)
+			return spew.Sdump(attempt.Route)
 		}),
 	)
 
+	// Attempt to send this payment through the network to complete
+	// the payment. If this attempt fails, then we'll continue on
+	// to the next available route.
+	firstHop := lnwire.NewShortChanIDFromInt(
+		attempt.Route.Hops[0].ChannelID,
+	)
+
 	// Send it to the Switch. When this method returns we assume
 	// the Switch successfully has persisted the payment attempt,
 	// such that we can resume waiting for the result after a
 	// restart.
 	err := p.router.cfg.Payer.SendHTLC(
-		firstHop, p.attempt.PaymentID, htlcAdd,
+		firstHop, attempt.PaymentID, htlcAdd,
 	)
 	if err != nil {
 		log.Errorf("Failed sending attempt %d for payment "+
-			"%x to switch: %v", p.attempt.PaymentID,
+			"%x to switch: %v", attempt.PaymentID,
 			p.payment.PaymentHash, err)
 		return err
 	}
 
 	log.Debugf("Payment %x (pid=%v) successfully sent to switch",
-		p.payment.PaymentHash, p.attempt.PaymentID)
+		p.payment.PaymentHash, attempt.PaymentID)
 
 	return nil
 }
 
+// collectResult launches a goroutine that waits for the result of the given
+// shard to be available from the switch, and delivers it to the main payment
+// loop. If the circuit is nil, it is regenerated from the attempt info.
+func (p *paymentLifecycle) collectResult(shard *paymentShard,
+	circuit *sphinx.Circuit) {
+
+	go func() {
+		result, err := p.waitForResult(shard, circuit)
+
+		select {
+		case p.shardResults <- &shardResult{
+			shard:  shard,
+			result: result,
+			err:    err,
+		}:
+
+		case <-p.quit:
+		case <-p.router.quit:
+		}
+	}()
+}
+
+// waitForResult asks the switch for the result of the given shard, and blocks
+// until it is available.
+func (p *paymentLifecycle) waitForResult(shard *paymentShard,
+	circuit *sphinx.Circuit) (*htlcswitch.PaymentResult, error) {
+
+	attempt := shard.attempt
+
+	// If this was a resumed attempt, we must regenerate the circuit.
+	if circuit == nil {
+		_, c, err := generateSphinxPacket(
+			&attempt.Route, p.payment.PaymentHash[:],
+			attempt.SessionKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+		circuit = c
+	}
+
+	// Using the created circuit, initialize the error decrypter so we can
+	// parse+decode any failures incurred by this payment within the
+	// switch.
+	errorDecryptor := &htlcswitch.SphinxErrorDecrypter{
+		OnionErrorDecrypter: sphinx.NewOnionErrorDecrypter(circuit),
+	}
+
+	// Now ask the switch to return the result of the payment when
+	// available.
+	resultChan, err := p.router.cfg.Payer.GetPaymentResult(
+		attempt.PaymentID, p.payment.PaymentHash, errorDecryptor,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The switch knows about this payment, we'll wait for a result to be
+	// available.
+	select {
+	case result, ok := <-resultChan:
+		if !ok {
+			return nil, htlcswitch.ErrSwitchExiting
+		}
+
+		return result, nil
+
+	case <-p.quit:
+		return nil, ErrRouterShuttingDown
+
+	case <-p.router.quit:
+		return nil, ErrRouterShuttingDown
+	}
+}
+
+// failAttempt records the given shard as failed with the control tower.
+func (p *paymentLifecycle) failAttempt(shard *paymentShard) error {
+	err := p.router.cfg.Control.FailAttempt(
+		p.payment.PaymentHash, shard.attempt.PaymentID,
+		&channeldb.HTLCFailInfo{
+			FailTime: time.Now(),
+		},
+	)
+	if err != nil {
+		log.Errorf("Unable to fail payment attempt %d: %v",
+			shard.attempt.PaymentID, err)
+	}
+
+	return err
+}
+
 // handleSendError inspects the given error from the Switch and determines
-// whether we should make another payment attempt.
-func (p *paymentLifecycle) handleSendError(sendErr error) error {
+// whether we should make another payment attempt. If the payment should be
+// failed, the final failure reason is returned.
+func (p *paymentLifecycle) handleSendError(shard *paymentShard,
+	sendErr error) *channeldb.FailureReason {
 
 	reason := p.router.processSendError(
-		p.attempt.PaymentID, &p.attempt.Route, sendErr,
+		shard.attempt.PaymentID, &shard.attempt.Route, sendErr,
 	)
 	if reason == nil {
 		// Save the forwarding error so it can be returned if
@@ -365,17 +666,5 @@ func (p *paymentLifecycle) handleSendError(sendErr error) error {
 	log.Debugf("Payment %x failed: final_outcome=%v, raw_err=%v",
 		p.payment.PaymentHash, *reason, sendErr)
 
-	// Mark the payment failed with no route.
-	//
-	// TODO(halseth): make payment codes for the actual reason we don't
-	// continue path finding.
-	err := p.router.cfg.Control.Fail(
-		p.payment.PaymentHash, *reason,
-	)
-	if err != nil {
-		return err
-	}
-
-	// Terminal state, return the error we encountered.
-	return sendErr
+	return reason
 }
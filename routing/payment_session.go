@@ -5,6 +5,7 @@ import (
 
 	"github.com/BTCGPU/lnd/channeldb"
 	"github.com/BTCGPU/lnd/lnwire"
+	"github.com/BTCGPU/lnd/record"
 	"github.com/BTCGPU/lnd/routing/route"
 )
 
@@ -17,9 +18,13 @@ const BlockPadding uint16 = 3
 // information learned during the previous attempts.
 type PaymentSession interface {
 	// RequestRoute returns the next route to attempt for routing the
-	// specified HTLC payment to the target node.
-	RequestRoute(payment *LightningPayment,
-		height uint32, finalCltvDelta uint16) (*route.Route, error)
+	// specified HTLC payment to the target node. The amount is the value
+	// that should be delivered to the target by this route, which for
+	// multi-path payments may be less than the full payment amount. The
+	// fee limit is the maximum fee the route may pay.
+	RequestRoute(payment *LightningPayment, amt,
+		feeLimit lnwire.MilliSatoshi, height uint32,
+		finalCltvDelta uint16) (*route.Route, error)
 }
 
 // paymentSession is used during an HTLC routings session to prune the local
@@ -52,8 +57,9 @@ type paymentSession struct {
 //
 // NOTE: This function is safe for concurrent access.
 // NOTE: Part of the PaymentSession interface.
-func (p *paymentSession) RequestRoute(payment *LightningPayment,
-	height uint32, finalCltvDelta uint16) (*route.Route, error) {
+func (p *paymentSession) RequestRoute(payment *LightningPayment, amt,
+	feeLimit lnwire.MilliSatoshi, height uint32,
+	finalCltvDelta uint16) (*route.Route, error) {
 
 	switch {
 
@@ -87,9 +93,16 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 
 	restrictions := &RestrictParams{
 		ProbabilitySource: ss.MissionControl.GetProbability,
-		FeeLimit:          payment.FeeLimit,
+		FeeLimit:          feeLimit,
 		OutgoingChannelID: payment.OutgoingChannelID,
+		LastHop:           payment.LastHop,
+		IncomingChannelID: payment.IncomingChannelID,
+		IgnoredNodes:      payment.IgnoredNodes,
+		IgnoredPairs:      payment.IgnoredPairs,
 		CltvLimit:         cltvLimit,
+		DestFeatures:      payment.DestFeatures,
+		FinalDestRecords:  payment.FinalDestRecords,
+		PaymentAddr:       payment.PaymentAddr,
 	}
 
 	// We'll also obtain a set of bandwidthHints from the lower layer for
@@ -110,8 +123,7 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 			bandwidthHints:  bandwidthHints,
 		},
 		restrictions, &ss.PathFindingConfig,
-		ss.SelfNode.PubKeyBytes, payment.Target,
-		payment.Amount,
+		ss.SelfNode.PubKeyBytes, payment.Target, amt,
 	)
 	if err != nil {
 		return nil, err
@@ -121,7 +133,7 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 	// a route by applying the time-lock and fee requirements.
 	sourceVertex := route.Vertex(ss.SelfNode.PubKeyBytes)
 	route, err := newRoute(
-		payment.Amount, sourceVertex, path, height, finalCltvDelta,
+		amt, sourceVertex, path, height, finalCltvDelta,
 		payment.FinalDestRecords,
 	)
 	if err != nil {
@@ -130,5 +142,27 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 		return nil, err
 	}
 
+	// If the features of the destination are known from its invoice,
+	// they take precedence over the ones from the graph that were used to
+	// determine the payload format of the final hop.
+	finalHop := route.Hops[len(route.Hops)-1]
+	if payment.DestFeatures != nil {
+		finalHop.LegacyPayload = !payment.DestFeatures.HasFeature(
+			lnwire.TLVOnionPayloadOptional,
+		)
+	}
+
+	// If a payment address is known for this payment, we'll include an
+	// MPP record for the final hop, carrying the total amount of the
+	// payment such that the receiver knows when the full set of HTLCs
+	// has arrived. Path finding made sure that the destination
+	// understands payment addresses and thus the TLV payload.
+	if payment.PaymentAddr != nil {
+		finalHop.LegacyPayload = false
+		finalHop.MPP = record.NewMPP(
+			payment.Amount, *payment.PaymentAddr,
+		)
+	}
+
 	return route, err
 }
@@ -57,12 +57,21 @@ func TestRequestRoute(t *testing.T) {
 	cltvLimit := uint32(30)
 	finalCltvDelta := uint16(8)
 
+	paymentAddr := [32]byte{1, 2, 3}
 	payment := &LightningPayment{
 		CltvLimit:      cltvLimit,
 		FinalCLTVDelta: finalCltvDelta,
+		Amount:         1000,
+		FeeLimit:       1000,
+		PaymentAddr:    &paymentAddr,
 	}
 
-	route, err := session.RequestRoute(payment, height, finalCltvDelta)
+	// Request a route for only part of the payment amount, as is done
+	// when sending a multi-path payment.
+	const shardAmt = 400
+	route, err := session.RequestRoute(
+		payment, shardAmt, payment.FeeLimit, height, finalCltvDelta,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -73,4 +82,29 @@ func TestRequestRoute(t *testing.T) {
 		t.Fatalf("unexpected total time lock of %v",
 			route.TotalTimeLock)
 	}
+
+	// The final hop should carry the shard amount, along with an MPP
+	// record for the total payment amount.
+	finalHop := route.Hops[len(route.Hops)-1]
+	if finalHop.AmtToForward != shardAmt {
+		t.Fatalf("unexpected amount to forward %v",
+			finalHop.AmtToForward)
+	}
+
+	if finalHop.LegacyPayload {
+		t.Fatalf("expected tlv payload for mpp final hop")
+	}
+
+	if finalHop.MPP == nil {
+		t.Fatalf("expected mpp record for final hop")
+	}
+
+	if finalHop.MPP.TotalMsat() != payment.Amount {
+		t.Fatalf("unexpected mpp total %v", finalHop.MPP.TotalMsat())
+	}
+
+	if finalHop.MPP.PaymentAddr() != paymentAddr {
+		t.Fatalf("unexpected mpp payment addr %x",
+			finalHop.MPP.PaymentAddr())
+	}
 }
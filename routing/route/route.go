@@ -94,6 +94,10 @@ type Hop struct {
 	// carries as a fee will be subtracted by the hop.
 	AmtToForward lnwire.MilliSatoshi
 
+	// MPP encapsulates the data required for option_mpp. This field should
+	// only be set for the final hop.
+	MPP *record.MPP
+
 	// TLVRecords if non-nil are a set of additional TLV records that
 	// should be included in the forwarding instructions for this node.
 	TLVRecords []tlv.Record
@@ -140,6 +144,11 @@ func (h *Hop) PackHopPayload(w io.Writer, nextChanID uint64) error {
 		)
 	}
 
+	// If an MPP record is destined for this hop, ensure that we encode it.
+	if h.MPP != nil {
+		records = append(records, h.MPP.Record())
+	}
+
 	// Append any custom types destined for this hop.
 	records = append(records, h.TLVRecords...)
 
@@ -210,6 +219,15 @@ func (r *Route) TotalFees() lnwire.MilliSatoshi {
 	return r.TotalAmount - r.Hops[len(r.Hops)-1].AmtToForward
 }
 
+// ReceiverAmt is the amount received by the final hop of this route.
+func (r *Route) ReceiverAmt() lnwire.MilliSatoshi {
+	if len(r.Hops) == 0 {
+		return 0
+	}
+
+	return r.Hops[len(r.Hops)-1].AmtToForward
+}
+
 // NewRouteFromHops creates a new Route structure from the minimally required
 // information to perform the payment. It infers fee amounts and populates the
 // node, chan and prev/next hop maps.
@@ -8,10 +8,10 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/btgsuite/btgd/btcec"
 	"github.com/btgsuite/btgd/wire"
 	btcutil "github.com/btgsuite/btgutil"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
 
@@ -51,6 +51,11 @@ var (
 	// ErrRouterShuttingDown is returned if the router is in the process of
 	// shutting down.
 	ErrRouterShuttingDown = fmt.Errorf("router shutting down")
+
+	// ErrPaymentAddrRequired is returned when a multi-path payment is
+	// requested without a payment address.
+	ErrPaymentAddrRequired = fmt.Errorf("payment address required for " +
+		"multi-path payments")
 )
 
 // ChannelGraphSource represents the source of information about the topology
@@ -523,17 +528,33 @@ func (r *ChannelRouter) Start() error {
 
 			// We create a dummy, empty payment session such that
 			// we won't make another payment attempt when the
-			// result for the in-flight attempt is received.
+			// result for the in-flight attempts are received.
 			//
-			// PayAttemptTime doesn't need to be set, as there is
-			// only a single attempt.
+			// PayAttemptTime doesn't need to be set, as there are
+			// no new attempts made.
 			paySession := r.cfg.SessionSource.NewPaymentSessionEmpty()
 
+			// The amount is set to what the in-flight attempts
+			// deliver, such that no new attempts are launched
+			// while waiting for their results. If no attempt was
+			// made at all, we use the full payment amount, which
+			// will make the payment fail as no route can be found.
+			amt := payment.Info.Value
+			if len(payment.Attempts) > 0 {
+				amt = 0
+				for _, a := range payment.Attempts {
+					amt += a.Route.ReceiverAmt()
+				}
+			}
+
 			lPayment := &LightningPayment{
 				PaymentHash: payment.Info.PaymentHash,
+				Amount:      amt,
 			}
 
-			_, _, err = r.sendPayment(payment.Attempt, lPayment, paySession)
+			_, _, err := r.sendPayment(
+				payment.Attempts, lPayment, paySession,
+			)
 			if err != nil {
 				log.Errorf("Resuming payment with hash %v "+
 					"failed: %v.", payment.Info.PaymentHash, err)
@@ -1592,6 +1613,24 @@ type LightningPayment struct {
 	// hop. If nil, any channel may be used.
 	OutgoingChannelID *uint64
 
+	// LastHop is the node that needs to be the last hop before the target.
+	// If nil, any node may be used. Setting the target to our own node
+	// along with an outgoing channel and a last hop allows to rebalance
+	// channels by paying ourselves.
+	LastHop *route.Vertex
+
+	// IncomingChannelID is the channel that needs to be taken into the
+	// target. If nil, any channel may be used.
+	IncomingChannelID *uint64
+
+	// IgnoredNodes is a set of nodes that must not be used for the
+	// payment.
+	IgnoredNodes map[route.Vertex]struct{}
+
+	// IgnoredPairs is a set of directed node pairs whose channels must not
+	// be used for the payment.
+	IgnoredPairs map[DirectedNodePair]struct{}
+
 	// PaymentRequest is an optional payment request that this payment is
 	// attempting to complete.
 	PaymentRequest []byte
@@ -1601,6 +1640,21 @@ type LightningPayment struct {
 	// understand this new onion payload format, then the payment will
 	// fail.
 	FinalDestRecords []tlv.Record
+
+	// MaxParts is the maximum number of partial payments (shards) that
+	// may be used to complete the full amount. A value of zero or one
+	// means that the payment will be sent as a single HTLC.
+	MaxParts uint32
+
+	// PaymentAddr is the payment address provided by the receiver. If set,
+	// an MPP record carrying the total payment amount is included in the
+	// final hop's payload. It is required for multi-path payments.
+	PaymentAddr *[32]byte
+
+	// DestFeatures specifies the set of features the destination
+	// advertises in its invoice. If nil, path finding falls back to the
+	// features of the destination's node announcement.
+	DestFeatures *lnwire.FeatureVector
 }
 
 // SendPayment attempts to send a payment as described within the passed
@@ -1619,7 +1673,7 @@ func (r *ChannelRouter) SendPayment(payment *LightningPayment) ([32]byte,
 	}
 
 	// Since this is the first time this payment is being made, we pass nil
-	// for the existing attempt.
+	// for the existing attempts.
 	return r.sendPayment(nil, payment, paySession)
 }
 
@@ -1632,7 +1686,7 @@ func (r *ChannelRouter) SendPaymentAsync(payment *LightningPayment) error {
 	}
 
 	// Since this is the first time this payment is being made, we pass nil
-	// for the existing attempt.
+	// for the existing attempts.
 	r.wg.Add(1)
 	go func() {
 		defer r.wg.Done()
@@ -1652,6 +1706,13 @@ func (r *ChannelRouter) SendPaymentAsync(payment *LightningPayment) error {
 func (r *ChannelRouter) preparePayment(payment *LightningPayment) (
 	PaymentSession, error) {
 
+	// A multi-path payment can only be completed if the receiver is able
+	// to tell the shards of this payment apart from other payments, which
+	// requires a payment address.
+	if payment.MaxParts > 1 && payment.PaymentAddr == nil {
+		return nil, ErrPaymentAddrRequired
+	}
+
 	// Before starting the HTLC routing attempt, we'll create a fresh
 	// payment session which will report our errors back to mission
 	// control.
@@ -1716,10 +1777,11 @@ func (r *ChannelRouter) SendToRoute(hash lntypes.Hash, route *route.Route) (
 	// attempt.
 	payment := &LightningPayment{
 		PaymentHash: hash,
+		Amount:      amt,
 	}
 
 	// Since this is the first time this payment is being made, we pass nil
-	// for the existing attempt.
+	// for the existing attempts.
 	preimage, _, err := r.sendPayment(nil, payment, paySession)
 	if err != nil {
 		// SendToRoute should return a structured error. In case the
@@ -1748,16 +1810,17 @@ func (r *ChannelRouter) SendToRoute(hash lntypes.Hash, route *route.Route) (
 // within the network to reach the destination. Additionally, the payment
 // preimage will also be returned.
 //
-// The existing attempt argument should be set to nil if this is a payment that
-// haven't had any payment attempt sent to the switch yet. If it has had an
-// attempt already, it should be passed such that the result can be retrieved.
+// The existing attempts argument should be nil if this is a payment that
+// haven't had any payment attempt sent to the switch yet. If it has had
+// attempts already, they should be passed such that their results can be
+// retrieved.
 //
 // This method relies on the ControlTower's internal payment state machine to
 // carry out its execution. After restarts it is safe, and assumed, that the
 // router will call this method for every payment still in-flight according to
 // the ControlTower.
 func (r *ChannelRouter) sendPayment(
-	existingAttempt *channeldb.PaymentAttemptInfo,
+	existingAttempts []channeldb.PaymentAttemptInfo,
 	payment *LightningPayment, paySession PaymentSession) (
 	[32]byte, *route.Route, error) {
 
@@ -1791,14 +1854,16 @@ func (r *ChannelRouter) sendPayment(
 	// Now set up a paymentLifecycle struct with these params, such that we
 	// can resume the payment from the current state.
 	p := &paymentLifecycle{
-		router:         r,
-		payment:        payment,
-		paySession:     paySession,
-		currentHeight:  currentHeight,
-		finalCLTVDelta: uint16(payment.FinalCLTVDelta),
-		attempt:        existingAttempt,
-		circuit:        nil,
-		lastError:      nil,
+		router:           r,
+		payment:          payment,
+		paySession:       paySession,
+		currentHeight:    currentHeight,
+		finalCLTVDelta:   uint16(payment.FinalCLTVDelta),
+		existingAttempts: existingAttempts,
+		shards:           make(map[uint64]*paymentShard),
+		shardResults:     make(chan *shardResult),
+		quit:             make(chan struct{}),
+		lastError:        nil,
 	}
 
 	// If a timeout is specified, create a timeout channel. If no timeout is
@@ -2078,7 +2143,7 @@ func (r *ChannelRouter) FetchLightningNode(node route.Vertex) (*channeldb.Lightn
 //
 // NOTE: This method is part of the ChannelGraphSource interface.
 func (r *ChannelRouter) ForEachNode(cb func(*channeldb.LightningNode) error) error {
-	return r.cfg.Graph.ForEachNode(nil, func(_ *bbolt.Tx, n *channeldb.LightningNode) error {
+	return r.cfg.Graph.ForEachNode(nil, func(_ kvdb.Tx, n *channeldb.LightningNode) error {
 		return cb(n)
 	})
 }
@@ -2090,7 +2155,7 @@ func (r *ChannelRouter) ForEachNode(cb func(*channeldb.LightningNode) error) err
 func (r *ChannelRouter) ForAllOutgoingChannels(cb func(*channeldb.ChannelEdgeInfo,
 	*channeldb.ChannelEdgePolicy) error) error {
 
-	return r.selfNode.ForEachChannel(nil, func(_ *bbolt.Tx, c *channeldb.ChannelEdgeInfo,
+	return r.selfNode.ForEachChannel(nil, func(_ kvdb.Tx, c *channeldb.ChannelEdgeInfo,
 		e, _ *channeldb.ChannelEdgePolicy) error {
 
 		if e == nil {
@@ -2231,7 +2296,7 @@ func generateBandwidthHints(sourceNode *channeldb.LightningNode,
 	// First, we'll collect the set of outbound edges from the target
 	// source node.
 	var localChans []*channeldb.ChannelEdgeInfo
-	err := sourceNode.ForEachChannel(nil, func(tx *bbolt.Tx,
+	err := sourceNode.ForEachChannel(nil, func(tx kvdb.Tx,
 		edgeInfo *channeldb.ChannelEdgeInfo,
 		_, _ *channeldb.ChannelEdgePolicy) error {
 
@@ -2413,7 +2478,7 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliSatoshi,
 			bestBandwidth lnwire.MilliSatoshi
 		)
 
-		cb := func(tx *bbolt.Tx,
+		cb := func(tx kvdb.Tx,
 			edgeInfo *channeldb.ChannelEdgeInfo,
 			_, inEdge *channeldb.ChannelEdgePolicy) error {
 			chanID := edgeInfo.ChannelID
@@ -12,6 +12,7 @@ import (
 	"net"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -27,6 +28,7 @@ import (
 	"github.com/BTCGPU/lnd/build"
 	"github.com/BTCGPU/lnd/chanbackup"
 	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/channelnotifier"
 	"github.com/BTCGPU/lnd/discovery"
 	"github.com/BTCGPU/lnd/htlcswitch"
@@ -35,11 +37,14 @@ import (
 	"github.com/BTCGPU/lnd/lncfg"
 	"github.com/BTCGPU/lnd/lnrpc"
 	"github.com/BTCGPU/lnd/lnrpc/invoicesrpc"
+	"github.com/BTCGPU/lnd/lnrpc/walletrpc"
 	"github.com/BTCGPU/lnd/lntypes"
 	"github.com/BTCGPU/lnd/lnwallet"
 	"github.com/BTCGPU/lnd/lnwire"
 	"github.com/BTCGPU/lnd/macaroons"
 	"github.com/BTCGPU/lnd/monitoring"
+	"github.com/BTCGPU/lnd/peernotifier"
+	"github.com/BTCGPU/lnd/record"
 	"github.com/BTCGPU/lnd/routing"
 	"github.com/BTCGPU/lnd/signal"
 	"github.com/BTCGPU/lnd/sweep"
@@ -51,7 +56,6 @@ import (
 	"github.com/btgsuite/btgd/wire"
 	btcutil "github.com/btgsuite/btgutil"
 	"github.com/btgsuite/btgwallet/wallet/txauthor"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -83,6 +87,11 @@ var (
 	// TODO: Make this configurable
 	defaultAcceptorTimeout = 15 * time.Second
 
+	// errMacaroonDisabled is returned when macaroon related RPCs are
+	// called while lnd was started with macaroons disabled.
+	errMacaroonDisabled = fmt.Errorf("macaroon authentication disabled, " +
+		"remove --no-macaroons flag to enable")
+
 	// readPermissions is a slice of all entities that allow read
 	// permissions for authorization purposes, all lowercase.
 	readPermissions = []bakery.Op{
@@ -114,6 +123,10 @@ var (
 			Entity: "invoices",
 			Action: "read",
 		},
+		{
+			Entity: "macaroon",
+			Action: "read",
+		},
 	}
 
 	// writePermissions is a slice of all entities that allow write
@@ -151,6 +164,14 @@ var (
 			Entity: "signer",
 			Action: "generate",
 		},
+		{
+			Entity: "macaroon",
+			Action: "generate",
+		},
+		{
+			Entity: "macaroon",
+			Action: "write",
+		},
 	}
 
 	// invoicePermissions is a slice of all the entities that allows a user
@@ -219,6 +240,20 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/BatchOpenChannel": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/FundingStateStep": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/OpenChannelSync": {{
 			Entity: "onchain",
 			Action: "write",
@@ -245,6 +280,10 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "peers",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/SubscribePeerEvents": {{
+			Entity: "peers",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/WalletBalance": {{
 			Entity: "onchain",
 			Action: "read",
@@ -396,6 +435,26 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/BakeMacaroon": {{
+			Entity: "macaroon",
+			Action: "generate",
+		}},
+		"/lnrpc.Lightning/ListPermissions": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ListMacaroonIDs": {{
+			Entity: "macaroon",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/DeleteMacaroonID": {{
+			Entity: "macaroon",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/DatabaseStats": {{
+			Entity: "info",
+			Action: "read",
+		}},
 	}
 }
 
@@ -448,6 +507,14 @@ type rpcServer struct {
 	// method.
 	chanPredicate *chanacceptor.ChainedAcceptor
 
+	// macService is the macaroon service used to bake new macaroons. It is
+	// nil if macaroons are disabled.
+	macService *macaroons.Service
+
+	// permissions is the merged set of main and sub-server RPC method
+	// permissions that are enforced by the macaroon interceptors.
+	permissions map[string][]bakery.Op
+
 	quit chan struct{}
 }
 
@@ -519,7 +586,8 @@ func newRPCServer(s *server, macService *macaroons.Service,
 		s.cc, networkDir, macService, atpl, invoiceRegistry,
 		s.htlcSwitch, activeNetParams.Params, s.chanRouter,
 		routerBackend, s.nodeSigner, s.chanDB, s.sweeper, tower,
-		s.towerClient, cfg.net.ResolveTCPAddr,
+		s.towerClient, cfg.net.ResolveTCPAddr, s.htlcNotifier,
+		s.invoiceFeatures, s.rebalancer, s.feePolicyMgr,
 	)
 	if err != nil {
 		return nil, err
@@ -565,10 +633,17 @@ func newRPCServer(s *server, macService *macaroons.Service,
 	macUnaryInterceptors := []grpc.UnaryServerInterceptor{}
 	macStrmInterceptors := []grpc.StreamServerInterceptor{}
 	if macService != nil {
-		unaryInterceptor := macService.UnaryServerInterceptor(permissions)
+		spendAmount := macaroonSpendAmount(s.cc.wallet)
+		spendResult := macaroonSpendResult()
+
+		unaryInterceptor := macService.UnaryServerInterceptor(
+			permissions, spendAmount, spendResult,
+		)
 		macUnaryInterceptors = append(macUnaryInterceptors, unaryInterceptor)
 
-		strmInterceptor := macService.StreamServerInterceptor(permissions)
+		strmInterceptor := macService.StreamServerInterceptor(
+			permissions, spendAmount, spendResult,
+		)
 		macStrmInterceptors = append(macStrmInterceptors, strmInterceptor)
 	}
 
@@ -621,6 +696,8 @@ func newRPCServer(s *server, macService *macaroons.Service,
 		server:          s,
 		routerBackend:   routerBackend,
 		chanPredicate:   chanPredicate,
+		macService:      macService,
+		permissions:     permissions,
 		quit:            make(chan struct{}, 1),
 	}
 	lnrpc.RegisterLightningServer(grpcServer, rootRPCServer)
@@ -1416,12 +1493,14 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 			"state must be below the local funding amount")
 	}
 
-	// Ensure that the user doesn't exceed the current soft-limit for
-	// channel size. If the funding amount is above the soft-limit, then
-	// we'll reject the request.
-	if localFundingAmt > MaxFundingAmount {
+	// Ensure that the user doesn't exceed the maximum channel size. If
+	// the funding amount is above it, then we'll reject the request. The
+	// funding manager additionally ensures that channels above the
+	// soft-limit are only opened with peers that support them.
+	maxChanSize := btcutil.Amount(cfg.MaxChanSize)
+	if localFundingAmt > maxChanSize {
 		return fmt.Errorf("funding amount is too large, the max "+
-			"channel size is: %v", MaxFundingAmount)
+			"channel size is: %v", maxChanSize)
 	}
 
 	// Restrict the size of the channel we'll actually open. At a later
@@ -1483,6 +1562,13 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 	rpcsLog.Debugf("[openchannel]: using fee of %v sat/kw for funding tx",
 		int64(feeRate))
 
+	// If a close address was specified, we'll commit to it as the upfront
+	// shutdown script of the channel.
+	shutdownScript, err := parseUpfrontShutdownAddress(in.CloseAddress)
+	if err != nil {
+		return fmt.Errorf("invalid close address: %v", err)
+	}
+
 	// Instruct the server to trigger the necessary events to attempt to
 	// open a new channel. A stream is returned in place, this stream will
 	// be used to consume updates of the state of the pending channel.
@@ -1496,6 +1582,9 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        minConfs,
+		psbtFunding:     in.PsbtFunding,
+		shutdownScript:  shutdownScript,
+		zeroConf:        in.ZeroConf,
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -1605,6 +1694,13 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 			"size is: %v SAT", int64(minChanFundingSize))
 	}
 
+	// The PSBT funding workflow requires the caller to act upon an
+	// intermediate update, which can only be delivered over the stream.
+	if in.PsbtFunding {
+		return nil, fmt.Errorf("psbt funding is only supported by the " +
+			"streaming OpenChannel call")
+	}
+
 	// Then, we'll extract the minimum number of confirmations that each
 	// output we use to fund the channel's funding transaction should
 	// satisfy.
@@ -1629,6 +1725,13 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	rpcsLog.Tracef("[openchannel] target sat/kw for funding tx: %v",
 		int64(feeRate))
 
+	// If a close address was specified, we'll commit to it as the upfront
+	// shutdown script of the channel.
+	shutdownScript, err := parseUpfrontShutdownAddress(in.CloseAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid close address: %v", err)
+	}
+
 	req := &openChanReq{
 		targetPubkey:    nodepubKey,
 		chainHash:       *activeNetParams.GenesisHash,
@@ -1639,6 +1742,8 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        minConfs,
+		shutdownScript:  shutdownScript,
+		zeroConf:        in.ZeroConf,
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -1672,6 +1777,173 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	}
 }
 
+// BatchOpenChannel attempts to open multiple singly funded channels to remote
+// peers, all funded by a single funding transaction. The call returns once all
+// channels are pending, or fails if any of them couldn't be opened, in which
+// case all channels of the batch are canceled.
+func (r *rpcServer) BatchOpenChannel(ctx context.Context,
+	in *lnrpc.BatchOpenChannelRequest) (*lnrpc.BatchOpenChannelResponse,
+	error) {
+
+	rpcsLog.Tracef("[batchopenchannel] request to open %v channels",
+		len(in.Channels))
+
+	if !r.server.Started() {
+		return nil, fmt.Errorf("chain backend is still syncing, server " +
+			"not active yet")
+	}
+
+	if len(in.Channels) == 0 {
+		return nil, fmt.Errorf("at least one channel must be specified")
+	}
+
+	// Based on the passed fee related parameters, we'll determine an
+	// appropriate fee rate for the shared funding transaction.
+	satPerKw := lnwallet.SatPerKVByte(in.SatPerByte * 1000).FeePerKWeight()
+	feeRate, err := sweep.DetermineFeePerKw(
+		r.server.cc.feeEstimator, sweep.FeePreference{
+			ConfTarget: uint32(in.TargetConf),
+			FeeRate:    satPerKw,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[batchopenchannel]: using fee of %v sat/kw for "+
+		"funding tx", int64(feeRate))
+
+	reqs := make([]*openChanReq, 0, len(in.Channels))
+	for _, channel := range in.Channels {
+		localFundingAmt := btcutil.Amount(channel.LocalFundingAmount)
+		remoteInitialBalance := btcutil.Amount(channel.PushSat)
+
+		// We'll apply the same restrictions to each channel of the
+		// batch as we do for a single channel.
+		if remoteInitialBalance >= localFundingAmt {
+			return nil, fmt.Errorf("amount pushed to remote peer " +
+				"for initial state must be below the local " +
+				"funding amount")
+		}
+		if localFundingAmt > btcutil.Amount(cfg.MaxChanSize) {
+			return nil, fmt.Errorf("funding amount is too large, "+
+				"the max channel size is: %v",
+				btcutil.Amount(cfg.MaxChanSize))
+		}
+		if localFundingAmt < minChanFundingSize {
+			return nil, fmt.Errorf("channel is too small, the "+
+				"minimum channel size is: %v SAT",
+				int64(minChanFundingSize))
+		}
+
+		if len(channel.NodePubkey) == 0 {
+			return nil, fmt.Errorf("NodePubKey is not set")
+		}
+		nodePubKey, err := btcec.ParsePubKey(
+			channel.NodePubkey, btcec.S256(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if nodePubKey.IsEqual(r.server.identityPriv.PubKey()) {
+			return nil, fmt.Errorf("cannot open channel to self")
+		}
+
+		shutdownScript, err := parseUpfrontShutdownAddress(
+			channel.CloseAddress,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid close address: %v", err)
+		}
+
+		reqs = append(reqs, &openChanReq{
+			targetPubkey:    nodePubKey,
+			chainHash:       *activeNetParams.GenesisHash,
+			localFundingAmt: localFundingAmt,
+			pushAmt: lnwire.NewMSatFromSatoshis(
+				remoteInitialBalance,
+			),
+			minHtlc:         lnwire.MilliSatoshi(channel.MinHtlcMsat),
+			fundingFeePerKw: feeRate,
+			private:         channel.Private,
+			remoteCsvDelay:  uint16(channel.RemoteCsvDelay),
+			minConfs:        1,
+			shutdownScript:  shutdownScript,
+		})
+	}
+
+	if err := r.server.BatchOpenChannel(reqs); err != nil {
+		return nil, err
+	}
+
+	// Wait for every channel of the batch to become pending. If any of
+	// them fails, the whole batch is canceled and we return the error.
+	resp := &lnrpc.BatchOpenChannelResponse{}
+	for _, req := range reqs {
+		select {
+		case err := <-req.err:
+			rpcsLog.Errorf("unable to open batch channel to "+
+				"NodeKey(%x): %v",
+				req.targetPubkey.SerializeCompressed(), err)
+			return nil, err
+
+		case fundingUpdate := <-req.updates:
+			update, ok := fundingUpdate.Update.(*lnrpc.OpenStatusUpdate_ChanPending)
+			if !ok {
+				return nil, fmt.Errorf("unexpected update: %v",
+					fundingUpdate)
+			}
+
+			resp.PendingChannels = append(
+				resp.PendingChannels, update.ChanPending,
+			)
+
+		case <-r.quit:
+			return nil, nil
+		}
+	}
+
+	return resp, nil
+}
+
+// FundingStateStep is an advanced funding related call that allows the caller
+// to step through the funding workflow of a channel that was opened with
+// psbt_funding set, by passing the finalized PSBT of the funding transaction.
+func (r *rpcServer) FundingStateStep(ctx context.Context,
+	in *lnrpc.FundingStateStepRequest) (*lnrpc.FundingStateStepResp, error) {
+
+	switch {
+	case in.GetPsbtVerify() != nil:
+		verify := in.GetPsbtVerify()
+
+		var pendingChanID [32]byte
+		if len(verify.PendingChanId) != len(pendingChanID) {
+			return nil, fmt.Errorf("pending channel ID must be %v "+
+				"bytes", len(pendingChanID))
+		}
+		copy(pendingChanID[:], verify.PendingChanId)
+
+		if len(verify.SignedPsbt) == 0 {
+			return nil, fmt.Errorf("signed psbt must be set")
+		}
+
+		rpcsLog.Debugf("[fundingstatestep] verifying psbt for "+
+			"pending_id=%x", pendingChanID[:])
+
+		err := r.server.fundingMgr.VerifyPsbt(
+			pendingChanID, verify.SignedPsbt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown funding state step trigger")
+	}
+
+	return &lnrpc.FundingStateStepResp{}, nil
+}
+
 // GetChanPointFundingTxid returns the given channel point's funding txid in
 // raw bytes.
 func GetChanPointFundingTxid(chanPoint *lnrpc.ChannelPoint) (*chainhash.Hash, error) {
@@ -2147,6 +2419,19 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 			SyncType:  lnrpcSyncType,
 		}
 
+		if lastSeen := serverPeer.LastSeen(); !lastSeen.IsZero() {
+			peer.LastSeenNs = lastSeen.UnixNano()
+		}
+
+		// Add the number of times the peer came online or went
+		// offline, and the time it last did so, if we've seen it
+		// flap.
+		flapInfo, ok := r.server.peerNotifier.PeerFlapInfo(nodePub)
+		if ok {
+			peer.FlapCount = int32(flapInfo.Count)
+			peer.LastFlapNs = flapInfo.LastFlap.UnixNano()
+		}
+
 		resp.Peers = append(resp.Peers, peer)
 	}
 
@@ -2155,6 +2440,63 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 	return resp, nil
 }
 
+// SubscribePeerEvents returns a uni-directional stream (server -> client)
+// for notifying the client of peer online and offline events.
+func (r *rpcServer) SubscribePeerEvents(req *lnrpc.PeerEventSubscription,
+	eventStream lnrpc.Lightning_SubscribePeerEventsServer) error {
+
+	peerEventSub, err := r.server.peerNotifier.SubscribePeerEvents()
+	if err != nil {
+		return err
+	}
+
+	// Ensure that the resources for the client is cleaned up once either
+	// the server, or client exits.
+	defer peerEventSub.Cancel()
+
+	for {
+		select {
+		// A new update has been sent by the peer notifier, we'll
+		// marshal it into the form expected by the gRPC client, then
+		// send it off to the client.
+		case e := <-peerEventSub.Updates():
+			var event *lnrpc.PeerEvent
+
+			switch peerEvent := e.(type) {
+			case peernotifier.PeerOfflineEvent:
+				event = &lnrpc.PeerEvent{
+					PubKey: hex.EncodeToString(
+						peerEvent.PubKey[:],
+					),
+					Type: lnrpc.PeerEvent_PEER_OFFLINE,
+				}
+
+			case peernotifier.PeerOnlineEvent:
+				event = &lnrpc.PeerEvent{
+					PubKey: hex.EncodeToString(
+						peerEvent.PubKey[:],
+					),
+					Type: lnrpc.PeerEvent_PEER_ONLINE,
+				}
+
+			default:
+				return fmt.Errorf("unexpected peer event: %v",
+					e)
+			}
+
+			if err := eventStream.Send(event); err != nil {
+				return err
+			}
+
+		case <-eventStream.Context().Done():
+			return eventStream.Context().Err()
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
 // WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
 // confirmed unspent outputs and all unconfirmed unspent outputs under control
 // by the wallet. This method can be modified by having the request specify
@@ -2687,6 +3029,17 @@ func createRPCOpenChannel(r *rpcServer, graph *channeldb.ChannelGraph,
 		StaticRemoteKey:       dbChannel.ChanType.IsTweakless(),
 	}
 
+	// If we committed to an upfront shutdown script, we'll display the
+	// address that it pays to.
+	if len(dbChannel.LocalShutdownScript) > 0 {
+		_, addresses, _, err := txscript.ExtractPkScriptAddrs(
+			dbChannel.LocalShutdownScript, activeNetParams.Params,
+		)
+		if err == nil && len(addresses) == 1 {
+			channel.CloseAddress = addresses[0].String()
+		}
+	}
+
 	for i, htlc := range localCommit.Htlcs {
 		var rHash [32]byte
 		copy(rHash[:], htlc.RHash[:])
@@ -2836,6 +3189,237 @@ type rpcPaymentRequest struct {
 	route *route.Route
 }
 
+// macaroonSpendAmount returns the function used by the macaroon service to
+// determine the maximum amount a request can spend, so it can be accounted
+// against the spend limit caveats of the macaroon. For payments, the fee limit
+// of the payment is included in the amount.
+func macaroonSpendAmount(
+	wallet *lnwallet.LightningWallet) macaroons.SpendAmountFunc {
+
+	// paymentAmount returns the amount of a payment that is either
+	// specified directly or through a payment request.
+	paymentAmount := func(amt int64,
+		payReqString string) (lnwire.MilliSatoshi, error) {
+
+		amtMsat := lnwire.NewMSatFromSatoshis(btcutil.Amount(amt))
+		if payReqString == "" {
+			return amtMsat, nil
+		}
+
+		payReq, err := zpay32.Decode(
+			payReqString, activeNetParams.Params,
+		)
+		if err != nil {
+			return 0, err
+		}
+		if payReq.MilliSat != nil {
+			amtMsat = *payReq.MilliSat
+		}
+
+		return amtMsat, nil
+	}
+
+	return func(fullMethod string, req interface{}) (btcutil.Amount,
+		error) {
+
+		switch r := req.(type) {
+		case *lnrpc.SendCoinsRequest:
+			if r.SendAll {
+				return wallet.ConfirmedBalance(0)
+			}
+			return btcutil.Amount(r.Amount), nil
+
+		case *lnrpc.SendManyRequest:
+			var total btcutil.Amount
+			for _, amt := range r.AddrToAmount {
+				total += btcutil.Amount(amt)
+			}
+			return total, nil
+
+		case *walletrpc.SendOutputsRequest:
+			var total btcutil.Amount
+			for _, output := range r.Outputs {
+				total += btcutil.Amount(output.Value)
+			}
+			return total, nil
+
+		case *lnrpc.SendRequest:
+			amt, err := paymentAmount(r.Amt, r.PaymentRequest)
+			if err != nil {
+				return 0, err
+			}
+			feeLimit := calculateFeeLimit(r.FeeLimit, amt)
+			return roundUpToSatoshis(amt + feeLimit), nil
+
+		case *routerrpc.SendPaymentRequest:
+			amt, err := paymentAmount(r.Amt, r.PaymentRequest)
+			if err != nil {
+				return 0, err
+			}
+			return roundUpToSatoshis(amt) +
+				btcutil.Amount(r.FeeLimitSat), nil
+
+		case *lnrpc.SendToRouteRequest:
+			return roundUpToSatoshis(lnwire.MilliSatoshi(
+				r.GetRoute().GetTotalAmtMsat(),
+			)), nil
+
+		case *routerrpc.SendToRouteRequest:
+			return roundUpToSatoshis(lnwire.MilliSatoshi(
+				r.GetRoute().GetTotalAmtMsat(),
+			)), nil
+
+		// Funds committed to a channel leave our control. The amount
+		// pushed to the remote party is part of the local funding
+		// amount, so it isn't counted separately.
+		case *lnrpc.OpenChannelRequest:
+			return btcutil.Amount(r.LocalFundingAmount), nil
+
+		case *lnrpc.BatchOpenChannelRequest:
+			var total btcutil.Amount
+			for _, channel := range r.Channels {
+				total += btcutil.Amount(
+					channel.LocalFundingAmount,
+				)
+			}
+			return total, nil
+
+		// Verifying the PSBT of a channel only completes a channel
+		// open that was started through OpenChannel, which already
+		// accounted for the funds of the channel.
+		case *lnrpc.FundingStateStepRequest:
+			return 0, nil
+
+		default:
+			return 0, nil
+		}
+	}
+}
+
+// roundUpToSatoshis converts the given amount to satoshis, rounding up any
+// fraction of a satoshi. Payment amounts are accounted against spend limits
+// this way, so that payments of less than a satoshi can't evade them.
+func roundUpToSatoshis(amt lnwire.MilliSatoshi) btcutil.Amount {
+	return btcutil.Amount((amt + 999) / 1000)
+}
+
+// macaroonSpendResult returns the function used by the macaroon service to
+// determine whether a request that can spend funds failed to do so, in which
+// case its amount is credited back to the spend limits of the macaroon.
+func macaroonSpendResult() macaroons.SpendResultFunc {
+	// paymentResult returns the result of a payment given the error
+	// message of its response. Payments that were interrupted by a
+	// shutdown are resumed on startup, so they remain accounted for.
+	paymentResult := func(paymentErr string) macaroons.SpendResult {
+		switch paymentErr {
+		case "":
+			return macaroons.SpendSettled
+
+		case routing.ErrRouterShuttingDown.Error():
+			return macaroons.SpendPending
+
+		default:
+			return macaroons.SpendFailed
+		}
+	}
+
+	return func(fullMethod string, req, resp interface{},
+		err error) macaroons.SpendResult {
+
+		// Requests without a response either failed, or belong to a
+		// stream that ended before they were answered.
+		if resp == nil {
+			switch {
+			// Payments that were interrupted by a shutdown are
+			// resumed on startup.
+			case err == routing.ErrRouterShuttingDown:
+				return macaroons.SpendPending
+
+			// The payment streams of the main RPC server dispatch
+			// payments in the background, so they may still be in
+			// flight once the stream ends.
+			case fullMethod == "/lnrpc.Lightning/SendPayment",
+				fullMethod == "/lnrpc.Lightning/SendToRoute":
+
+				return macaroons.SpendPending
+
+			case err != nil:
+				return macaroons.SpendFailed
+
+			default:
+				return macaroons.SpendPending
+			}
+		}
+
+		switch r := resp.(type) {
+		// Responses of the payment streams of the main RPC server
+		// carry the hash of the payment they belong to.
+		case *lnrpc.SendResponse:
+			var hash []byte
+			switch req := req.(type) {
+			case *lnrpc.SendRequest:
+				// Malformed payments are answered with an
+				// error without being dispatched.
+				payIntent, err := extractPaymentIntent(
+					&rpcPaymentRequest{SendRequest: req},
+				)
+				if err != nil {
+					return macaroons.SpendFailed
+				}
+				hash = payIntent.rHash[:]
+
+			case *lnrpc.SendToRouteRequest:
+				hash = req.PaymentHash
+				if req.PaymentHashString != "" {
+					hash, err = hex.DecodeString(
+						req.PaymentHashString,
+					)
+					if err != nil {
+						return macaroons.SpendPending
+					}
+				}
+			}
+
+			if !bytes.Equal(hash, r.PaymentHash) {
+				return macaroons.SpendPending
+			}
+
+			return paymentResult(r.PaymentError)
+
+		case *routerrpc.SendToRouteResponse:
+			if r.Failure != nil {
+				return macaroons.SpendFailed
+			}
+			return macaroons.SpendSettled
+
+		// A payment stream of the router RPC server only carries a
+		// single payment.
+		case *routerrpc.PaymentStatus:
+			switch r.State {
+			case routerrpc.PaymentState_IN_FLIGHT:
+				return macaroons.SpendPending
+
+			case routerrpc.PaymentState_SUCCEEDED:
+				return macaroons.SpendSettled
+
+			default:
+				return macaroons.SpendFailed
+			}
+
+		// The funding transaction of a channel is published once it
+		// is pending.
+		case *lnrpc.OpenStatusUpdate:
+			if r.GetChanPending() != nil {
+				return macaroons.SpendSettled
+			}
+			return macaroons.SpendPending
+
+		default:
+			return macaroons.SpendSettled
+		}
+	}
+}
+
 // calculateFeeLimit returns the fee limit in millisatoshis. If a percentage
 // based fee limit has been requested, we'll factor in the ratio provided with
 // the amount of the payment.
@@ -2946,7 +3530,12 @@ type rpcPaymentIntent struct {
 	cltvDelta         uint16
 	routeHints        [][]zpay32.HopHint
 	outgoingChannelID *uint64
+	lastHop           *route.Vertex
+	ignoredNodes      map[route.Vertex]struct{}
+	ignoredPairs      map[routing.DirectedNodePair]struct{}
 	payReq            []byte
+	paymentAddr       *[32]byte
+	destFeatures      *lnwire.FeatureVector
 
 	destTLV []tlv.Record
 
@@ -2987,6 +3576,32 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 		payIntent.outgoingChannelID = &rpcPayReq.OutgoingChanId
 	}
 
+	// Pass along a last hop restriction if specified.
+	if len(rpcPayReq.LastHopPubkey) > 0 {
+		lastHop, err := route.NewVertexFromBytes(
+			rpcPayReq.LastHopPubkey,
+		)
+		if err != nil {
+			return payIntent, err
+		}
+		payIntent.lastHop = &lastHop
+	}
+
+	// Pass along the nodes and node pairs that path finding must avoid.
+	var err error
+	payIntent.ignoredNodes, err = routerrpc.UnmarshallIgnoredNodes(
+		rpcPayReq.IgnoredNodes,
+	)
+	if err != nil {
+		return payIntent, err
+	}
+	payIntent.ignoredPairs, err = routerrpc.UnmarshallIgnoredPairs(
+		rpcPayReq.IgnoredPairs,
+	)
+	if err != nil {
+		return payIntent, err
+	}
+
 	// Take the CLTV limit from the request if set, otherwise use the max.
 	cltvLimit, err := routerrpc.ValidateCLTVLimit(
 		rpcPayReq.CltvLimit, cfg.MaxOutgoingCltvExpiry,
@@ -2996,11 +3611,14 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 	}
 	payIntent.cltvLimit = cltvLimit
 
-	if len(rpcPayReq.DestTlv) != 0 {
+	if len(rpcPayReq.DestCustomRecords) != 0 {
+		customRecords := record.CustomSet(rpcPayReq.DestCustomRecords)
+		if err := customRecords.Validate(); err != nil {
+			return payIntent, err
+		}
+
 		var err error
-		payIntent.destTLV, err = tlv.MapToRecords(
-			rpcPayReq.DestTlv,
-		)
+		payIntent.destTLV, err = tlv.MapToRecords(customRecords)
 		if err != nil {
 			return payIntent, err
 		}
@@ -3052,6 +3670,8 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 		payIntent.cltvDelta = uint16(payReq.MinFinalCLTVExpiry())
 		payIntent.routeHints = payReq.RouteHints
 		payIntent.payReq = []byte(rpcPayReq.PaymentRequest)
+		payIntent.paymentAddr = payReq.PaymentAddr
+		payIntent.destFeatures = payReq.Features
 
 		return payIntent, nil
 	}
@@ -3159,9 +3779,14 @@ func (r *rpcServer) dispatchPaymentIntent(
 			PaymentHash:       payIntent.rHash,
 			RouteHints:        payIntent.routeHints,
 			OutgoingChannelID: payIntent.outgoingChannelID,
+			LastHop:           payIntent.lastHop,
+			IgnoredNodes:      payIntent.ignoredNodes,
+			IgnoredPairs:      payIntent.ignoredPairs,
 			PaymentRequest:    payIntent.payReq,
 			PayAttemptTimeout: routing.DefaultPayAttemptTimeout,
 			FinalDestRecords:  payIntent.destTLV,
+			PaymentAddr:       payIntent.paymentAddr,
+			DestFeatures:      payIntent.destFeatures,
 		}
 
 		preImage, route, routerErr = r.server.chanRouter.SendPayment(
@@ -3451,6 +4076,7 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		MaxPaymentMSat:    MaxPaymentMSat,
 		DefaultCLTVExpiry: defaultDelta,
 		ChanDB:            r.server.chanDB,
+		Features:          r.server.invoiceFeatures,
 	}
 
 	addInvoiceData := &invoicesrpc.AddInvoiceData{
@@ -3743,7 +4369,7 @@ func (r *rpcServer) DescribeGraph(ctx context.Context,
 	// First iterate through all the known nodes (connected or unconnected
 	// within the graph), collating their current state into the RPC
 	// response.
-	err := graph.ForEachNode(nil, func(_ *bbolt.Tx, node *channeldb.LightningNode) error {
+	err := graph.ForEachNode(nil, func(_ kvdb.Tx, node *channeldb.LightningNode) error {
 		nodeAddrs := make([]*lnrpc.NodeAddress, 0)
 		for _, addr := range node.Addresses {
 			nodeAddr := &lnrpc.NodeAddress{
@@ -3904,7 +4530,7 @@ func (r *rpcServer) GetNodeInfo(ctx context.Context,
 		channels      []*lnrpc.ChannelEdge
 	)
 
-	if err := node.ForEachChannel(nil, func(_ *bbolt.Tx,
+	if err := node.ForEachChannel(nil, func(_ kvdb.Tx,
 		edge *channeldb.ChannelEdgeInfo,
 		c1, c2 *channeldb.ChannelEdgePolicy) error {
 
@@ -3999,7 +4625,7 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 	// network, tallying up the total number of nodes, and also gathering
 	// each node so we can measure the graph diameter and degree stats
 	// below.
-	if err := graph.ForEachNode(nil, func(tx *bbolt.Tx, node *channeldb.LightningNode) error {
+	if err := graph.ForEachNode(nil, func(tx kvdb.Tx, node *channeldb.LightningNode) error {
 		// Increment the total number of nodes with each iteration.
 		numNodes++
 
@@ -4009,7 +4635,7 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 		// through the db transaction from the outer view so we can
 		// re-use it within this inner view.
 		var outDegree uint32
-		if err := node.ForEachChannel(tx, func(_ *bbolt.Tx,
+		if err := node.ForEachChannel(tx, func(_ kvdb.Tx,
 			edge *channeldb.ChannelEdgeInfo, _, _ *channeldb.ChannelEdgePolicy) error {
 
 			// Bump up the out degree for this node for each
@@ -4245,6 +4871,17 @@ func (r *rpcServer) ListPayments(ctx context.Context,
 		return nil, err
 	}
 
+	// Fetch the payment hashes of all rebalances, so that we can mark the
+	// circular payments sent by the rebalancer.
+	rebalances, err := r.server.chanDB.RebalanceLog().FetchRebalances()
+	if err != nil {
+		return nil, err
+	}
+	rebalanceHashes := make(map[lntypes.Hash]struct{}, len(rebalances))
+	for _, rebalance := range rebalances {
+		rebalanceHashes[rebalance.PaymentHash] = struct{}{}
+	}
+
 	paymentsResp := &lnrpc.ListPaymentsResponse{}
 	for _, payment := range payments {
 		// To keep compatibility with the old API, we only return
@@ -4281,6 +4918,8 @@ func (r *rpcServer) ListPayments(ctx context.Context,
 		}
 
 		paymentHash := payment.Info.PaymentHash
+		_, isRebalance := rebalanceHashes[paymentHash]
+
 		paymentsResp.Payments = append(paymentsResp.Payments, &lnrpc.Payment{
 			PaymentHash:     hex.EncodeToString(paymentHash[:]),
 			Value:           satValue,
@@ -4294,6 +4933,7 @@ func (r *rpcServer) ListPayments(ctx context.Context,
 			PaymentPreimage: hex.EncodeToString(preimage[:]),
 			PaymentRequest:  string(payment.Info.PaymentRequest),
 			Status:          status,
+			Rebalance:       isRebalance,
 		})
 	}
 
@@ -4394,6 +5034,11 @@ func (r *rpcServer) DecodePayReq(ctx context.Context,
 		fallbackAddr = payReq.FallbackAddr.String()
 	}
 
+	var paymentAddr []byte
+	if payReq.PaymentAddr != nil {
+		paymentAddr = payReq.PaymentAddr[:]
+	}
+
 	// Expiry time will default to 3600 seconds if not specified
 	// explicitly.
 	expiry := int64(payReq.Expiry().Seconds())
@@ -4418,6 +5063,7 @@ func (r *rpcServer) DecodePayReq(ctx context.Context,
 		Expiry:          expiry,
 		CltvExpiry:      int64(payReq.MinFinalCLTVExpiry()),
 		RouteHints:      routeHints,
+		PaymentAddr:     paymentAddr,
 	}, nil
 }
 
@@ -4443,7 +5089,7 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 	}
 
 	var feeReports []*lnrpc.ChannelFeeReport
-	err = selfNode.ForEachChannel(nil, func(_ *bbolt.Tx, chanInfo *channeldb.ChannelEdgeInfo,
+	err = selfNode.ForEachChannel(nil, func(_ kvdb.Tx, chanInfo *channeldb.ChannelEdgeInfo,
 		edgePolicy, _ *channeldb.ChannelEdgePolicy) error {
 
 		// Self node should always have policies for its channels.
@@ -4734,6 +5380,24 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 		}
 	}
 
+	// To allow callers to weigh the routing income against the cost of
+	// keeping our channels balanced, we'll also report the fees paid for
+	// rebalances within the same time slice.
+	rebalances, err := r.server.chanDB.RebalanceLog().FetchRebalances()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch rebalances: %v", err)
+	}
+	for _, rebalance := range rebalances {
+		if !rebalance.Succeeded ||
+			rebalance.Timestamp.Before(startTime) ||
+			rebalance.Timestamp.After(endTime) {
+
+			continue
+		}
+
+		resp.RebalanceFeeMsat += uint64(rebalance.Fee)
+	}
+
 	return resp, nil
 }
 
@@ -5078,20 +5742,78 @@ func (r *rpcServer) SubscribeChannelBackups(req *lnrpc.ChannelBackupSubscription
 // RPCServer.
 type chanAcceptInfo struct {
 	chanReq      *chanacceptor.ChannelAcceptRequest
-	responseChan chan bool
+	responseChan chan *chanacceptor.ChannelAcceptResponse
+}
+
+// chanAcceptResult is used in the ChannelAcceptor bidirectional stream and
+// encapsulates the response of the RPC client to the request for the channel
+// with the given pending ID.
+type chanAcceptResult struct {
+	pendingChanID [32]byte
+	resp          *chanacceptor.ChannelAcceptResponse
+}
+
+// maxAcceptorErrorLength is the maximum length of the reason for rejecting a
+// channel that the RPC client may send to the initiator.
+const maxAcceptorErrorLength = 500
+
+// unmarshallChannelAcceptResponse converts the response of a ChannelAcceptor
+// RPC client into the response of a chanacceptor.ChannelAcceptor, ensuring
+// that the rejection reason and channel parameters are valid.
+func unmarshallChannelAcceptResponse(resp *lnrpc.ChannelAcceptResponse) (
+	*chanacceptor.ChannelAcceptResponse, error) {
+
+	if resp.Accept && resp.Error != "" {
+		return nil, fmt.Errorf("error can only be set if the channel " +
+			"is rejected")
+	}
+	if len(resp.Error) > maxAcceptorErrorLength {
+		return nil, fmt.Errorf("error of length %v exceeds maximum "+
+			"length %v", len(resp.Error), maxAcceptorErrorLength)
+	}
+	if resp.CsvDelay > math.MaxUint16 {
+		return nil, fmt.Errorf("csv delay %v exceeds maximum %v",
+			resp.CsvDelay, math.MaxUint16)
+	}
+	if resp.MaxHtlcCount > math.MaxUint16 {
+		return nil, fmt.Errorf("max htlc count %v exceeds maximum %v",
+			resp.MaxHtlcCount, math.MaxUint16)
+	}
+	if resp.MinAcceptDepth > math.MaxUint16 {
+		return nil, fmt.Errorf("min accept depth %v exceeds maximum %v",
+			resp.MinAcceptDepth, math.MaxUint16)
+	}
+	if resp.ReserveSat > math.MaxInt64 {
+		return nil, fmt.Errorf("reserve %v exceeds maximum %v",
+			resp.ReserveSat, int64(math.MaxInt64))
+	}
+
+	return &chanacceptor.ChannelAcceptResponse{
+		Accept:         resp.Accept,
+		Error:          resp.Error,
+		ZeroConf:       resp.ZeroConf,
+		CSVDelay:       uint16(resp.CsvDelay),
+		Reserve:        btcutil.Amount(resp.ReserveSat),
+		MaxHTLCs:       uint16(resp.MaxHtlcCount),
+		MinHTLC:        lnwire.MilliSatoshi(resp.MinHtlcIn),
+		MinAcceptDepth: uint16(resp.MinAcceptDepth),
+	}, nil
 }
 
 // ChannelAcceptor dispatches a bi-directional streaming RPC in which
 // OpenChannel requests are sent to the client and the client responds with
-// a boolean that tells LND whether or not to accept the channel. This allows
-// node operators to specify their own criteria for accepting inbound channels
-// through a single persistent connection.
+// a boolean that tells LND whether or not to accept the channel, and whether
+// the channel may be used before it confirms. Along with it, the client can
+// give the reason for rejecting a channel, or override the parameters that we
+// require from the initiator. This allows node operators to specify their own
+// criteria for accepting inbound channels through a single persistent
+// connection.
 func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer) error {
 	chainedAcceptor := r.chanPredicate
 
 	// Create two channels to handle requests and responses respectively.
 	newRequests := make(chan *chanAcceptInfo)
-	responses := make(chan lnrpc.ChannelAcceptResponse)
+	responses := make(chan *chanAcceptResult)
 
 	// Define a quit channel that will be used to signal to the RPCAcceptor's
 	// closure whether the stream still exists.
@@ -5100,8 +5822,15 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 
 	// demultiplexReq is a closure that will be passed to the RPCAcceptor and
 	// acts as an intermediary between the RPCAcceptor and the RPCServer.
-	demultiplexReq := func(req *chanacceptor.ChannelAcceptRequest) bool {
-		respChan := make(chan bool, 1)
+	demultiplexReq := func(
+		req *chanacceptor.ChannelAcceptRequest,
+	) *chanacceptor.ChannelAcceptResponse {
+
+		respChan := make(chan *chanacceptor.ChannelAcceptResponse, 1)
+
+		// reject is returned whenever no decision could be obtained
+		// from the RPC client.
+		reject := &chanacceptor.ChannelAcceptResponse{}
 
 		newRequest := &chanAcceptInfo{
 			chanReq:      req,
@@ -5117,26 +5846,26 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 		case <-timeout:
 			rpcsLog.Errorf("RPCAcceptor returned false - reached timeout of %d",
 				defaultAcceptorTimeout)
-			return false
+			return reject
 		case <-quit:
-			return false
+			return reject
 		case <-r.quit:
-			return false
+			return reject
 		}
 
 		// Receive the response and return it. If no response has been received
-		// in defaultAcceptorTimeout, then return false.
+		// in defaultAcceptorTimeout, then reject the channel.
 		select {
 		case resp := <-respChan:
 			return resp
 		case <-timeout:
 			rpcsLog.Errorf("RPCAcceptor returned false - reached timeout of %d",
 				defaultAcceptorTimeout)
-			return false
+			return reject
 		case <-quit:
-			return false
+			return reject
 		case <-r.quit:
-			return false
+			return reject
 		}
 	}
 
@@ -5163,13 +5892,18 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 				return
 			}
 
-			var pendingID [32]byte
-			copy(pendingID[:], resp.PendingChanId)
+			// An invalid response terminates the stream, as the
+			// client would otherwise believe its decision applied.
+			acceptResp, err := unmarshallChannelAcceptResponse(resp)
+			if err != nil {
+				errChan <- err
+				return
+			}
 
-			openChanResp := lnrpc.ChannelAcceptResponse{
-				Accept:        resp.Accept,
-				PendingChanId: pendingID[:],
+			openChanResp := &chanAcceptResult{
+				resp: acceptResp,
 			}
+			copy(openChanResp.pendingChanID[:], resp.PendingChanId)
 
 			// Now that we have the response from the RPC client, send it to
 			// the responses chan.
@@ -5183,7 +5917,9 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 		}
 	}()
 
-	acceptRequests := make(map[[32]byte]chan bool)
+	acceptRequests := make(
+		map[[32]byte]chan *chanacceptor.ChannelAcceptResponse,
+	)
 
 	for {
 		select {
@@ -5217,15 +5953,14 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 		case resp := <-responses:
 			// Look up the appropriate channel to send on given the pending ID.
 			// If a channel is found, send the response over it.
-			var pendingID [32]byte
-			copy(pendingID[:], resp.PendingChanId)
+			pendingID := resp.pendingChanID
 			respChan, ok := acceptRequests[pendingID]
 			if !ok {
 				continue
 			}
 
-			// Send the response boolean over the buffered response channel.
-			respChan <- resp.Accept
+			// Send the response over the buffered response channel.
+			respChan <- resp.resp
 
 			// Delete the channel from the acceptRequests map.
 			delete(acceptRequests, pendingID)
@@ -5237,3 +5972,206 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 		}
 	}
 }
+
+// BakeMacaroon allows the creation of a new macaroon with custom read and write
+// permissions. No first-party caveats are added since this can be done offline.
+func (r *rpcServer) BakeMacaroon(ctx context.Context,
+	req *lnrpc.BakeMacaroonRequest) (*lnrpc.BakeMacaroonResponse, error) {
+
+	rpcsLog.Debugf("[bakemacaroon]")
+
+	// If the --no-macaroons flag is used to start lnd, the macaroon service
+	// is not initialized. Therefore we can't bake new macaroons.
+	if r.macService == nil {
+		return nil, errMacaroonDisabled
+	}
+
+	// We don't allow an empty permission list, as a macaroon that isn't
+	// allowed to access any RPC doesn't make sense.
+	if len(req.Permissions) == 0 {
+		return nil, fmt.Errorf("permission list cannot be empty, " +
+			"specify at least one entity/action pair")
+	}
+
+	// Only permissions that are actually required by any of the RPC
+	// methods can be granted.
+	validOps := make(map[bakery.Op]struct{})
+	for _, ops := range r.permissions {
+		for _, op := range ops {
+			validOps[op] = struct{}{}
+		}
+	}
+
+	// Validate and map the permissions used by gRPC to the ones used by
+	// the bakery.
+	requestedPermissions := make([]bakery.Op, len(req.Permissions))
+	for idx, perm := range req.Permissions {
+		op := bakery.Op{
+			Entity: perm.Entity,
+			Action: perm.Action,
+		}
+		if _, ok := validOps[op]; !ok {
+			return nil, fmt.Errorf("invalid permission %v:%v, use "+
+				"ListPermissions to list all valid "+
+				"permissions", op.Entity, op.Action)
+		}
+
+		requestedPermissions[idx] = op
+	}
+
+	// Bake a new macaroon with the given permissions under the requested
+	// root key ID, and send it binary serialized and hex encoded to the
+	// client.
+	rootKeyID := []byte(strconv.FormatUint(req.RootKeyId, 10))
+	ctx = macaroons.ContextWithRootKeyID(ctx, rootKeyID)
+	newMac, err := r.macService.Oven.NewMacaroon(
+		ctx, bakery.LatestVersion, nil, requestedPermissions...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	newMacBytes, err := newMac.M().MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.BakeMacaroonResponse{
+		Macaroon: hex.EncodeToString(newMacBytes),
+	}, nil
+}
+
+// ListPermissions lists all RPC method URIs and their required macaroon
+// permissions to access them.
+func (r *rpcServer) ListPermissions(_ context.Context,
+	_ *lnrpc.ListPermissionsRequest) (*lnrpc.ListPermissionsResponse,
+	error) {
+
+	rpcsLog.Debugf("[listpermissions]")
+
+	permissionMap := make(map[string]*lnrpc.MacaroonPermissionList)
+	for uri, ops := range r.permissions {
+		rpcPerms := make([]*lnrpc.MacaroonPermission, len(ops))
+		for idx, op := range ops {
+			rpcPerms[idx] = &lnrpc.MacaroonPermission{
+				Entity: op.Entity,
+				Action: op.Action,
+			}
+		}
+
+		permissionMap[uri] = &lnrpc.MacaroonPermissionList{
+			Permissions: rpcPerms,
+		}
+	}
+
+	return &lnrpc.ListPermissionsResponse{
+		MethodPermissions: permissionMap,
+	}, nil
+}
+
+// ListMacaroonIDs returns a list of macaroon root key IDs in use.
+func (r *rpcServer) ListMacaroonIDs(ctx context.Context,
+	req *lnrpc.ListMacaroonIDsRequest) (
+	*lnrpc.ListMacaroonIDsResponse, error) {
+
+	rpcsLog.Debugf("[listmacaroonids]")
+
+	// If the --no-macaroons flag is used to start lnd, the macaroon service
+	// is not initialized. Therefore we can't show any IDs.
+	if r.macService == nil {
+		return nil, errMacaroonDisabled
+	}
+
+	rootKeyIDByteSlice, err := r.macService.ListMacaroonIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rootKeyIDs []uint64
+	for _, value := range rootKeyIDByteSlice {
+		// Convert bytes into uint64.
+		id, err := strconv.ParseUint(string(value), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		rootKeyIDs = append(rootKeyIDs, id)
+	}
+
+	return &lnrpc.ListMacaroonIDsResponse{RootKeyIds: rootKeyIDs}, nil
+}
+
+// DeleteMacaroonID removes a specific macaroon ID, revoking all macaroons that
+// were baked under it.
+func (r *rpcServer) DeleteMacaroonID(ctx context.Context,
+	req *lnrpc.DeleteMacaroonIDRequest) (
+	*lnrpc.DeleteMacaroonIDResponse, error) {
+
+	rpcsLog.Debugf("[deletemacaroonid]")
+
+	// If the --no-macaroons flag is used to start lnd, the macaroon service
+	// is not initialized. Therefore we can't delete any IDs.
+	if r.macService == nil {
+		return nil, errMacaroonDisabled
+	}
+
+	// Convert root key id from uint64 to bytes. Because the
+	// DefaultRootKeyID is a digit 0 expressed in a byte slice of a string
+	// "0", we will keep the IDs in the same format - all must be numeric,
+	// and must be a byte slice of string value of the digit.
+	rootKeyID := []byte(strconv.FormatUint(req.RootKeyId, 10))
+	err := r.macService.DeleteMacaroonID(ctx, rootKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.DeleteMacaroonIDResponse{
+		Deleted: true,
+	}, nil
+}
+
+// DatabaseStats returns the size of the channel database on disk along with
+// statistics for each of its top-level buckets.
+func (r *rpcServer) DatabaseStats(ctx context.Context,
+	req *lnrpc.DatabaseStatsRequest) (*lnrpc.DatabaseStatsResponse, error) {
+
+	rpcsLog.Debugf("[databasestats]")
+
+	fileSize, err := r.server.chanDB.FileSize()
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := r.server.chanDB.BucketStats()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.DatabaseStatsResponse{
+		FileSize: uint64(fileSize),
+		Buckets:  make([]*lnrpc.BucketStats, 0, len(stats)),
+	}
+	for _, s := range stats {
+		resp.DataSize += s.KeyBytes + s.ValueBytes + uint64(len(s.Name))
+		resp.Buckets = append(resp.Buckets, &lnrpc.BucketStats{
+			Name:       bucketName(s.Name),
+			NumKeys:    s.NumKeys,
+			NumBuckets: s.NumBuckets,
+			KeyBytes:   s.KeyBytes,
+			ValueBytes: s.ValueBytes,
+		})
+	}
+
+	return resp, nil
+}
+
+// bucketName returns the printable name of a database bucket. Names that
+// contain non-printable characters are hex encoded.
+func bucketName(name []byte) string {
+	for _, c := range name {
+		if c < 0x20 || c > 0x7e {
+			return hex.EncodeToString(name)
+		}
+	}
+
+	return string(name)
+}
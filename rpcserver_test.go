@@ -0,0 +1,510 @@
+// +build !rpctest
+
+package lnd
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/BTCGPU/lnd/lnrpc"
+	"github.com/BTCGPU/lnd/lnrpc/routerrpc"
+	"github.com/BTCGPU/lnd/macaroons"
+	"github.com/BTCGPU/lnd/routing"
+	btcutil "github.com/btgsuite/btgutil"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
+)
+
+var (
+	testMacPassword = []byte("hello")
+
+	testMacPermissions = map[string][]bakery.Op{
+		"/lnrpc.Lightning/GetInfo": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/BakeMacaroon": {{
+			Entity: "macaroon",
+			Action: "generate",
+		}},
+	}
+)
+
+// newTestMacaroonService creates an unlocked macaroon service whose database
+// is stored in a temporary directory. The returned cleanup function closes the
+// service and removes the directory.
+func newTestMacaroonService(t *testing.T) (*macaroons.Service, string,
+	func()) {
+
+	tempDir, err := ioutil.TempDir("", "macaroons")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+
+	svc, err := macaroons.NewService(tempDir)
+	if err != nil {
+		os.RemoveAll(tempDir)
+		t.Fatalf("unable to create macaroon service: %v", err)
+	}
+	if err := svc.CreateUnlock(&testMacPassword); err != nil {
+		svc.Close()
+		os.RemoveAll(tempDir)
+		t.Fatalf("unable to unlock macaroon service: %v", err)
+	}
+
+	return svc, tempDir, func() {
+		svc.Close()
+		os.RemoveAll(tempDir)
+	}
+}
+
+// assertMacaroonAllows asserts whether the macaroon grants the given
+// permission.
+func assertMacaroonAllows(t *testing.T, svc *macaroons.Service,
+	mac *macaroon.Macaroon, op bakery.Op, allowed bool) {
+
+	t.Helper()
+
+	authChecker := svc.Checker.Auth(macaroon.Slice{mac})
+	_, err := authChecker.Allow(context.Background(), op)
+	switch {
+	case allowed && err != nil:
+		t.Fatalf("expected %v:%v to be allowed: %v", op.Entity,
+			op.Action, err)
+
+	case !allowed && err == nil:
+		t.Fatalf("expected %v:%v to be denied", op.Entity, op.Action)
+	}
+}
+
+// TestBakeMacaroon asserts that BakeMacaroon only bakes macaroons with a
+// non-empty set of known permissions, and that the baked macaroon grants
+// exactly the requested permissions.
+func TestBakeMacaroon(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	infoRead := &lnrpc.MacaroonPermission{Entity: "info", Action: "read"}
+
+	// Without a macaroon service, no macaroons can be baked.
+	r := &rpcServer{permissions: testMacPermissions}
+	_, err := r.BakeMacaroon(ctx, &lnrpc.BakeMacaroonRequest{
+		Permissions: []*lnrpc.MacaroonPermission{infoRead},
+	})
+	if err != errMacaroonDisabled {
+		t.Fatalf("expected errMacaroonDisabled, got %v", err)
+	}
+
+	svc, _, cleanup := newTestMacaroonService(t)
+	defer cleanup()
+	r.macService = svc
+
+	// An empty permission list is rejected.
+	_, err = r.BakeMacaroon(ctx, &lnrpc.BakeMacaroonRequest{})
+	if err == nil {
+		t.Fatalf("expected empty permission list to be rejected")
+	}
+
+	// So is a permission that isn't required by any of the RPCs.
+	_, err = r.BakeMacaroon(ctx, &lnrpc.BakeMacaroonRequest{
+		Permissions: []*lnrpc.MacaroonPermission{
+			infoRead, {Entity: "onchain", Action: "write"},
+		},
+	})
+	if err == nil {
+		t.Fatalf("expected unknown permission to be rejected")
+	}
+
+	resp, err := r.BakeMacaroon(ctx, &lnrpc.BakeMacaroonRequest{
+		Permissions: []*lnrpc.MacaroonPermission{infoRead},
+	})
+	if err != nil {
+		t.Fatalf("unable to bake macaroon: %v", err)
+	}
+
+	macBytes, err := hex.DecodeString(resp.Macaroon)
+	if err != nil {
+		t.Fatalf("unable to decode macaroon: %v", err)
+	}
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		t.Fatalf("unable to unmarshal macaroon: %v", err)
+	}
+
+	assertMacaroonAllows(
+		t, svc, mac, bakery.Op{Entity: "info", Action: "read"}, true,
+	)
+	assertMacaroonAllows(
+		t, svc, mac, bakery.Op{Entity: "macaroon", Action: "generate"},
+		false,
+	)
+}
+
+// TestListPermissions asserts that ListPermissions returns the permissions of
+// all RPC methods.
+func TestListPermissions(t *testing.T) {
+	t.Parallel()
+
+	r := &rpcServer{permissions: testMacPermissions}
+	resp, err := r.ListPermissions(
+		context.Background(), &lnrpc.ListPermissionsRequest{},
+	)
+	if err != nil {
+		t.Fatalf("unable to list permissions: %v", err)
+	}
+
+	if len(resp.MethodPermissions) != len(testMacPermissions) {
+		t.Fatalf("expected permissions of %v methods, got %v",
+			len(testMacPermissions), len(resp.MethodPermissions))
+	}
+	for uri, ops := range testMacPermissions {
+		perms, ok := resp.MethodPermissions[uri]
+		if !ok {
+			t.Fatalf("permissions of %v missing", uri)
+		}
+		if len(perms.Permissions) != len(ops) {
+			t.Fatalf("expected %v permissions of %v, got %v",
+				len(ops), uri, len(perms.Permissions))
+		}
+		for i, op := range ops {
+			if perms.Permissions[i].Entity != op.Entity ||
+				perms.Permissions[i].Action != op.Action {
+
+				t.Fatalf("expected permission %v:%v of %v, "+
+					"got %v", op.Entity, op.Action, uri,
+					perms.Permissions[i])
+			}
+		}
+	}
+}
+
+// TestUpgradeAdminMacaroon asserts that admin macaroons created by earlier
+// versions are re-baked with the current admin permissions, while other
+// macaroons at the admin path are left untouched.
+func TestUpgradeAdminMacaroon(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	svc, tempDir, cleanup := newTestMacaroonService(t)
+	defer cleanup()
+
+	admFile := filepath.Join(tempDir, "admin.macaroon")
+	writeMac := func(ops ...bakery.Op) []byte {
+		mac, err := svc.Oven.NewMacaroon(
+			ctx, bakery.LatestVersion, nil, ops...,
+		)
+		if err != nil {
+			t.Fatalf("unable to bake macaroon: %v", err)
+		}
+		macBytes, err := mac.M().MarshalBinary()
+		if err != nil {
+			t.Fatalf("unable to marshal macaroon: %v", err)
+		}
+		err = ioutil.WriteFile(admFile, macBytes, 0600)
+		if err != nil {
+			t.Fatalf("unable to write macaroon: %v", err)
+		}
+		return macBytes
+	}
+	readMac := func() []byte {
+		macBytes, err := ioutil.ReadFile(admFile)
+		if err != nil {
+			t.Fatalf("unable to read macaroon: %v", err)
+		}
+		return macBytes
+	}
+
+	// A read-only macaroon isn't an admin macaroon and must not be
+	// upgraded.
+	roBytes := writeMac(readPermissions...)
+	if err := upgradeAdminMacaroon(ctx, svc, admFile); err != nil {
+		t.Fatalf("unable to upgrade admin macaroon: %v", err)
+	}
+	if !bytes.Equal(readMac(), roBytes) {
+		t.Fatalf("read-only macaroon was modified")
+	}
+
+	// An up to date admin macaroon is left as is.
+	admBytes := writeMac(adminPermissions()...)
+	if err := upgradeAdminMacaroon(ctx, svc, admFile); err != nil {
+		t.Fatalf("unable to upgrade admin macaroon: %v", err)
+	}
+	if !bytes.Equal(readMac(), admBytes) {
+		t.Fatalf("current admin macaroon was modified")
+	}
+
+	// An admin macaroon that lacks the macaroon permissions is re-baked
+	// with all of the current admin permissions.
+	var legacyPermissions []bakery.Op
+	for _, op := range adminPermissions() {
+		if op.Entity != "macaroon" {
+			legacyPermissions = append(legacyPermissions, op)
+		}
+	}
+	legacyBytes := writeMac(legacyPermissions...)
+	if err := upgradeAdminMacaroon(ctx, svc, admFile); err != nil {
+		t.Fatalf("unable to upgrade admin macaroon: %v", err)
+	}
+
+	upgraded := &macaroon.Macaroon{}
+	if err := upgraded.UnmarshalBinary(readMac()); err != nil {
+		t.Fatalf("unable to unmarshal macaroon: %v", err)
+	}
+	for _, op := range adminPermissions() {
+		assertMacaroonAllows(t, svc, upgraded, op, true)
+	}
+
+	// The legacy macaroon remains valid for the permissions it had.
+	legacy := &macaroon.Macaroon{}
+	if err := legacy.UnmarshalBinary(legacyBytes); err != nil {
+		t.Fatalf("unable to unmarshal macaroon: %v", err)
+	}
+	assertMacaroonAllows(
+		t, svc, legacy, bakery.Op{Entity: "onchain", Action: "write"},
+		true,
+	)
+}
+
+// TestMacaroonSpendAmountChannelOpens asserts that channel opens are accounted
+// against spend limits with their funding amounts, which include the amounts
+// pushed to the remote party.
+func TestMacaroonSpendAmountChannelOpens(t *testing.T) {
+	t.Parallel()
+
+	spendAmount := macaroonSpendAmount(nil)
+
+	tests := []struct {
+		name     string
+		req      interface{}
+		expected btcutil.Amount
+	}{
+		{
+			name: "open channel",
+			req: &lnrpc.OpenChannelRequest{
+				LocalFundingAmount: 100000,
+				PushSat:            1000,
+			},
+			expected: 100000,
+		},
+		{
+			name: "batch open channel",
+			req: &lnrpc.BatchOpenChannelRequest{
+				Channels: []*lnrpc.BatchOpenChannel{
+					{LocalFundingAmount: 100000},
+					{
+						LocalFundingAmount: 200000,
+						PushSat:            2000,
+					},
+				},
+			},
+			expected: 300000,
+		},
+	}
+
+	for _, test := range tests {
+		amt, err := spendAmount("", test.req)
+		if err != nil {
+			t.Fatalf("%v: unable to get spend amount: %v",
+				test.name, err)
+		}
+		if amt != test.expected {
+			t.Fatalf("%v: expected spend amount %v, got %v",
+				test.name, test.expected, amt)
+		}
+	}
+}
+
+// TestMacaroonSpendAmountPayments asserts that payments are accounted against
+// spend limits with their amounts rounded up to the next satoshi, so that
+// payments of less than a satoshi aren't skipped.
+func TestMacaroonSpendAmountPayments(t *testing.T) {
+	t.Parallel()
+
+	spendAmount := macaroonSpendAmount(nil)
+
+	tests := []struct {
+		name     string
+		req      interface{}
+		expected btcutil.Amount
+	}{
+		{
+			name: "send to route 999 msat",
+			req: &lnrpc.SendToRouteRequest{
+				Route: &lnrpc.Route{TotalAmtMsat: 999},
+			},
+			expected: 1,
+		},
+		{
+			name: "router send to route 999 msat",
+			req: &routerrpc.SendToRouteRequest{
+				Route: &lnrpc.Route{TotalAmtMsat: 999},
+			},
+			expected: 1,
+		},
+		{
+			name: "send to route 1001 msat",
+			req: &lnrpc.SendToRouteRequest{
+				Route: &lnrpc.Route{TotalAmtMsat: 1001},
+			},
+			expected: 2,
+		},
+		{
+			name: "send to route whole satoshis",
+			req: &lnrpc.SendToRouteRequest{
+				Route: &lnrpc.Route{TotalAmtMsat: 2000},
+			},
+			expected: 2,
+		},
+		{
+			name: "send payment with fee limit",
+			req: &routerrpc.SendPaymentRequest{
+				Amt:         1000,
+				FeeLimitSat: 10,
+			},
+			expected: 1010,
+		},
+		{
+			name: "send with fixed fee limit",
+			req: &lnrpc.SendRequest{
+				Amt: 1000,
+				FeeLimit: &lnrpc.FeeLimit{
+					Limit: &lnrpc.FeeLimit_Fixed{
+						Fixed: 10,
+					},
+				},
+			},
+			expected: 1010,
+		},
+	}
+
+	for _, test := range tests {
+		amt, err := spendAmount("", test.req)
+		if err != nil {
+			t.Fatalf("%v: unable to get spend amount: %v",
+				test.name, err)
+		}
+		if amt != test.expected {
+			t.Fatalf("%v: expected spend amount %v, got %v",
+				test.name, test.expected, amt)
+		}
+	}
+}
+
+// TestMacaroonSpendResult asserts that failed payments and channel opens are
+// recognized, so their amounts are credited back to spend limits.
+func TestMacaroonSpendResult(t *testing.T) {
+	t.Parallel()
+
+	spendResult := macaroonSpendResult()
+	hash := bytes.Repeat([]byte{1}, 32)
+	sendReq := &lnrpc.SendToRouteRequest{PaymentHash: hash}
+	shutdownErr := routing.ErrRouterShuttingDown.Error()
+
+	tests := []struct {
+		name     string
+		method   string
+		req      interface{}
+		resp     interface{}
+		err      error
+		expected macaroons.SpendResult
+	}{
+		{
+			name:   "failed sync payment",
+			method: "/lnrpc.Lightning/SendToRouteSync",
+			req:    sendReq,
+			resp: &lnrpc.SendResponse{
+				PaymentHash:  hash,
+				PaymentError: "fail",
+			},
+			expected: macaroons.SpendFailed,
+		},
+		{
+			name:   "failed streamed payment",
+			method: "/lnrpc.Lightning/SendToRoute",
+			req:    sendReq,
+			resp: &lnrpc.SendResponse{
+				PaymentHash:  hash,
+				PaymentError: "fail",
+			},
+			expected: macaroons.SpendFailed,
+		},
+		{
+			name:   "response to other payment",
+			method: "/lnrpc.Lightning/SendToRoute",
+			req:    sendReq,
+			resp: &lnrpc.SendResponse{
+				PaymentHash: bytes.Repeat([]byte{2}, 32),
+			},
+			expected: macaroons.SpendPending,
+		},
+		{
+			name:   "payment interrupted by shutdown",
+			method: "/lnrpc.Lightning/SendToRouteSync",
+			req:    sendReq,
+			resp: &lnrpc.SendResponse{
+				PaymentHash:  hash,
+				PaymentError: shutdownErr,
+			},
+			expected: macaroons.SpendPending,
+		},
+		{
+			name:     "payment stream ended",
+			method:   "/lnrpc.Lightning/SendToRoute",
+			req:      sendReq,
+			err:      errors.New("stream failed"),
+			expected: macaroons.SpendPending,
+		},
+		{
+			name:   "router payment failed",
+			method: "/routerrpc.Router/SendPayment",
+			req:    &routerrpc.SendPaymentRequest{},
+			resp: &routerrpc.PaymentStatus{
+				State: routerrpc.PaymentState_FAILED_NO_ROUTE,
+			},
+			expected: macaroons.SpendFailed,
+		},
+		{
+			name:   "router payment in flight",
+			method: "/routerrpc.Router/SendPayment",
+			req:    &routerrpc.SendPaymentRequest{},
+			resp: &routerrpc.PaymentStatus{
+				State: routerrpc.PaymentState_IN_FLIGHT,
+			},
+			expected: macaroons.SpendPending,
+		},
+		{
+			name:     "channel open failed",
+			method:   "/lnrpc.Lightning/OpenChannel",
+			req:      &lnrpc.OpenChannelRequest{},
+			err:      errors.New("open failed"),
+			expected: macaroons.SpendFailed,
+		},
+		{
+			name:   "channel pending",
+			method: "/lnrpc.Lightning/OpenChannel",
+			req:    &lnrpc.OpenChannelRequest{},
+			resp: &lnrpc.OpenStatusUpdate{
+				Update: &lnrpc.OpenStatusUpdate_ChanPending{
+					ChanPending: &lnrpc.PendingUpdate{},
+				},
+			},
+			expected: macaroons.SpendSettled,
+		},
+	}
+
+	for _, test := range tests {
+		result := spendResult(
+			test.method, test.req, test.resp, test.err,
+		)
+		if result != test.expected {
+			t.Fatalf("%v: expected result %v, got %v", test.name,
+				test.expected, result)
+		}
+	}
+}
@@ -23,9 +23,11 @@ import (
 	"github.com/BTCGPU/lnd/chanacceptor"
 	"github.com/BTCGPU/lnd/chanbackup"
 	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 	"github.com/BTCGPU/lnd/channelnotifier"
 	"github.com/BTCGPU/lnd/contractcourt"
 	"github.com/BTCGPU/lnd/discovery"
+	"github.com/BTCGPU/lnd/feepolicy"
 	"github.com/BTCGPU/lnd/htlcswitch"
 	"github.com/BTCGPU/lnd/htlcswitch/hop"
 	"github.com/BTCGPU/lnd/input"
@@ -40,6 +42,7 @@ import (
 	"github.com/BTCGPU/lnd/netann"
 	"github.com/BTCGPU/lnd/peernotifier"
 	"github.com/BTCGPU/lnd/pool"
+	"github.com/BTCGPU/lnd/rebalancer"
 	"github.com/BTCGPU/lnd/routing"
 	"github.com/BTCGPU/lnd/routing/localchans"
 	"github.com/BTCGPU/lnd/routing/route"
@@ -50,13 +53,13 @@ import (
 	"github.com/BTCGPU/lnd/watchtower/wtclient"
 	"github.com/BTCGPU/lnd/watchtower/wtdb"
 	"github.com/BTCGPU/lnd/watchtower/wtpolicy"
+	"github.com/BTCGPU/lnd/zpay32"
 	"github.com/btgsuite/btgd/btcec"
 	"github.com/btgsuite/btgd/chaincfg/chainhash"
 	"github.com/btgsuite/btgd/connmgr"
 	"github.com/btgsuite/btgd/txscript"
 	"github.com/btgsuite/btgd/wire"
 	btcutil "github.com/btgsuite/btgutil"
-	"github.com/coreos/bbolt"
 	"github.com/go-errors/errors"
 )
 
@@ -193,6 +196,8 @@ type server struct {
 
 	peerNotifier *peernotifier.PeerNotifier
 
+	htlcNotifier *htlcswitch.HtlcNotifier
+
 	witnessBeacon contractcourt.WitnessBeacon
 
 	breachArbiter *breachArbiter
@@ -217,6 +222,10 @@ type server struct {
 
 	towerClient wtclient.Client
 
+	rebalancer *rebalancer.Rebalancer
+
+	feePolicyMgr *feepolicy.Manager
+
 	connMgr *connmgr.ConnManager
 
 	sigPool *lnwallet.SigPool
@@ -229,6 +238,10 @@ type server struct {
 	// advertised to other nodes.
 	globalFeatures *lnwire.FeatureVector
 
+	// invoiceFeatures is the feature vector that is advertised in the
+	// invoices we create.
+	invoiceFeatures *lnwire.FeatureVector
+
 	// currentNodeAnn is the node announcement that has been broadcast to
 	// the network upon startup, if the attributes of the node (us) has
 	// changed since last start.
@@ -316,11 +329,24 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 	}
 
 	globalFeatures := lnwire.NewRawFeatureVector()
+	invoiceFeatures := lnwire.NewRawFeatureVector()
 
 	// Only if we're not being forced to use the legacy onion format, will
-	// we signal our knowledge of the new TLV onion format.
+	// we signal our knowledge of the new TLV onion format and the payment
+	// addresses carried within it. Our invoices always include a payment
+	// address in that case, but payers are only required to include it if
+	// we've been configured to require it.
 	if !cfg.LegacyProtocol.LegacyOnion() {
 		globalFeatures.Set(lnwire.TLVOnionPayloadOptional)
+		globalFeatures.Set(lnwire.PaymentAddrOptional)
+
+		if cfg.RequirePaymentAddr {
+			invoiceFeatures.Set(lnwire.TLVOnionPayloadRequired)
+			invoiceFeatures.Set(lnwire.PaymentAddrRequired)
+		} else {
+			invoiceFeatures.Set(lnwire.TLVOnionPayloadOptional)
+			invoiceFeatures.Set(lnwire.PaymentAddrOptional)
+		}
 	}
 
 	// Similarly, we default to the new modern commitment format unless the
@@ -329,6 +355,23 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		globalFeatures.Set(lnwire.StaticRemoteKeyOptional)
 	}
 
+	// We'll only signal support for anchor commitments if the
+	// experimental protocol option has been set.
+	if cfg.ExperimentalProtocol.AnchorCommitments() {
+		globalFeatures.Set(lnwire.AnchorsOptional)
+	}
+
+	// We always signal support for upfront shutdown scripts, so that our
+	// peers may commit to the address their funds are paid out to on
+	// cooperative close.
+	globalFeatures.Set(lnwire.UpfrontShutdownScriptOptional)
+
+	// Channels above the soft-limit for channel size are only signaled
+	// if the user opted into them.
+	if cfg.WumboChans {
+		globalFeatures.Set(lnwire.WumboChannelsOptional)
+	}
+
 	var serializedPubKey [33]byte
 	copy(serializedPubKey[:], privKey.PubKey().SerializeCompressed())
 
@@ -366,7 +409,13 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		chansToRestore: chansToRestore,
 
 		invoices: invoices.NewRegistry(
-			chanDB, defaultFinalCltvRejectDelta,
+			chanDB, invoices.NewInvoiceExpiryWatcher(
+				cfg.HoldExpiryDelta, cc.chainNotifier,
+			),
+			&invoices.RegistryConfig{
+				FinalCltvRejectDelta: defaultFinalCltvRejectDelta,
+				AcceptKeySend:        cfg.AcceptKeySend,
+			},
 		),
 
 		channelNotifier: channelnotifier.New(chanDB),
@@ -396,6 +445,9 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		globalFeatures: lnwire.NewFeatureVector(
 			globalFeatures, lnwire.GlobalFeatures,
 		),
+		invoiceFeatures: lnwire.NewFeatureVector(
+			invoiceFeatures, zpay32.InvoiceFeatures,
+		),
 		quit: make(chan struct{}),
 	}
 
@@ -409,6 +461,8 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		return nil, err
 	}
 
+	s.htlcNotifier = htlcswitch.NewHtlcNotifier(time.Now)
+
 	s.htlcSwitch, err = htlcswitch.New(htlcswitch.Config{
 		DB: chanDB,
 		LocalChannelClose: func(pubKey []byte,
@@ -442,6 +496,8 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		LogEventTicker:         ticker.New(htlcswitch.DefaultLogInterval),
 		AckEventTicker:         ticker.New(htlcswitch.DefaultAckInterval),
 		RejectHTLC:             cfg.RejectHTLC,
+		HtlcNotifier:           s.htlcNotifier,
+		InterceptHoldTimeout:   htlcswitch.DefaultInterceptHoldTimeout,
 	}, uint32(currentHeight))
 	if err != nil {
 		return nil, err
@@ -654,7 +710,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 	routingConfig := routerrpc.GetRoutingConfig(cfg.SubRPCServers.RouterRPC)
 
 	s.missionControl, err = routing.NewMissionControl(
-		chanDB.DB,
+		chanDB.Backend,
 		&routing.MissionControlConfig{
 			AprioriHopProbability: routingConfig.AprioriHopProbability,
 			PenaltyHalfLife:       routingConfig.PenaltyHalfLife,
@@ -774,6 +830,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		FeeEstimator:       cc.feeEstimator,
 		GenSweepScript:     newSweepPkScriptGen(cc.wallet),
 		Signer:             cc.wallet.Cfg.Signer,
+		Wallet:             cc.wallet,
 		PublishTransaction: cc.wallet.PublishTransaction,
 		NewBatchTimer: func() <-chan time.Time {
 			return time.NewTimer(sweep.DefaultBatchWindowDuration).C
@@ -917,6 +974,21 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		return nil, err
 	}
 
+	// If the user specified a default close address, we'll commit to it
+	// as the upfront shutdown script of all channels that we open or
+	// accept, unless another address was requested.
+	defaultUpfrontShutdown, err := parseUpfrontShutdownAddress(
+		cfg.CloseAddress,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid close address: %v", err)
+	}
+
+	zeroConfPeers, err := parseZeroConfPeers(cfg.ZeroConfPeers)
+	if err != nil {
+		return nil, fmt.Errorf("invalid zero-conf peer: %v", err)
+	}
+
 	s.fundingMgr, err = newFundingManager(fundingConfig{
 		IDKey:              privKey.PubKey(),
 		Wallet:             cc.wallet,
@@ -1016,7 +1088,13 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 				return defaultDelay
 			}
 
-			// If not we scale according to channel size.
+			// If not we scale according to channel size. Large
+			// channels get the same delay as channels of size
+			// MaxFundingAmount, which also prevents the scaled
+			// delay from overflowing.
+			if chanAmt > MaxFundingAmount {
+				chanAmt = MaxFundingAmount
+			}
 			delay := uint16(btcutil.Amount(maxRemoteDelay) *
 				chanAmt / MaxFundingAmount)
 			if delay < minRemoteDelay {
@@ -1027,6 +1105,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 			}
 			return delay
 		},
+		MaxRemoteDelay: maxRemoteDelay,
 		WatchNewChannel: func(channel *channeldb.OpenChannel,
 			peerKey *btcec.PublicKey) error {
 
@@ -1081,10 +1160,14 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		ZombieSweeperInterval:  1 * time.Minute,
 		ReservationTimeout:     10 * time.Minute,
 		MinChanSize:            btcutil.Amount(cfg.MinChanSize),
+		MaxChanSize:            btcutil.Amount(cfg.MaxChanSize),
 		MaxPendingChannels:     cfg.MaxPendingChannels,
 		RejectPush:             cfg.RejectPush,
+		DefaultUpfrontShutdown: defaultUpfrontShutdown,
 		NotifyOpenChannelEvent: s.channelNotifier.NotifyOpenChannelEvent,
 		OpenChannelPredicate:   chanPredicate,
+		ZeroConfPeers:          zeroConfPeers,
+		ShortChanIDInUse:       s.htlcSwitch.HasShortChanID,
 	})
 	if err != nil {
 		return nil, err
@@ -1146,6 +1229,62 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		}
 	}
 
+	// If the rebalancer is enabled, we'll create it now so that it keeps
+	// the balances of our channels within the configured range by paying
+	// ourselves.
+	if cfg.Rebalancer.Active {
+		s.rebalancer, err = rebalancer.New(&rebalancer.Config{
+			SelfNode:        selfNode.PubKeyBytes,
+			FetchChannels:   chanDB.FetchAllOpenChannels,
+			IsChannelActive: s.htlcSwitch.HasActiveLink,
+			AddInvoice:      s.invoices.AddInvoice,
+			CancelInvoice:   s.invoices.CancelInvoice,
+			SendPayment:     s.chanRouter.SendPayment,
+			Log:             chanDB.RebalanceLog(),
+			Ticker:          ticker.New(cfg.Rebalancer.Interval),
+			DefaultTarget: rebalancer.Target{
+				MinLocalRatio: cfg.Rebalancer.MinLocalRatio,
+				MaxLocalRatio: cfg.Rebalancer.MaxLocalRatio,
+			},
+			MaxAmount: btcutil.Amount(cfg.Rebalancer.MaxAmount),
+			MaxFee:    btcutil.Amount(cfg.Rebalancer.MaxFee),
+			FinalCLTVDelta: uint16(
+				chainCfg.TimeLockDelta,
+			),
+			MaxTotalTimelock: cfg.MaxOutgoingCltvExpiry,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// If the fee policy manager is enabled, we'll load the fee rules of
+	// our channels and create the manager that keeps their fees in line
+	// with the rules.
+	if cfg.FeePolicy.Active {
+		feeRules, err := feepolicy.LoadRules(cfg.FeePolicy.RulesFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load fee rules: %v",
+				err)
+		}
+
+		s.feePolicyMgr, err = feepolicy.New(&feepolicy.Config{
+			FetchChannels:          chanDB.FetchAllOpenChannels,
+			ForAllOutgoingChannels: s.chanRouter.ForAllOutgoingChannels,
+			UpdatePolicy:           s.localChanMgr.UpdatePolicy,
+			ForwardingLog:          chanDB.ForwardingLog(),
+			Ticker:                 ticker.New(cfg.FeePolicy.Interval),
+			Now:                    time.Now,
+			Rules:                  feeRules,
+			RulesFile:              cfg.FeePolicy.RulesFile,
+			MinUpdateInterval:      cfg.FeePolicy.MinUpdateInterval,
+			MinRelativeChange:      cfg.FeePolicy.MinRelativeChange,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Create the connection manager which will be responsible for
 	// maintaining persistent outbound connections and also accepting new
 	// incoming connections
@@ -1218,6 +1357,10 @@ func (s *server) Start() error {
 			startErr = err
 			return
 		}
+		if err := s.htlcNotifier.Start(); err != nil {
+			startErr = err
+			return
+		}
 		if err := s.sphinx.Start(); err != nil {
 			startErr = err
 			return
@@ -1268,6 +1411,18 @@ func (s *server) Start() error {
 			startErr = err
 			return
 		}
+		if s.rebalancer != nil {
+			if err := s.rebalancer.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+		if s.feePolicyMgr != nil {
+			if err := s.feePolicyMgr.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
 
 		// Before we start the connMgr, we'll check to see if we have
 		// any backups to recover. We do this now as we want to ensure
@@ -1364,6 +1519,15 @@ func (s *server) Stop() error {
 			s.torController.Stop()
 		}
 
+		// The rebalancer and the fee policy manager use the router, so
+		// they're stopped first to let an in-flight rebalance finish.
+		if s.rebalancer != nil {
+			s.rebalancer.Stop()
+		}
+		if s.feePolicyMgr != nil {
+			s.feePolicyMgr.Stop()
+		}
+
 		// Shutdown the wallet, funding manager, and the rpc server.
 		s.chanStatusMgr.Stop()
 		s.cc.chainNotifier.Stop()
@@ -1377,6 +1541,7 @@ func (s *server) Stop() error {
 		s.sweeper.Stop()
 		s.channelNotifier.Stop()
 		s.peerNotifier.Stop()
+		s.htlcNotifier.Stop()
 		s.cc.wallet.Shutdown()
 		s.cc.chainView.Stop()
 		s.connMgr.Stop()
@@ -2043,7 +2208,7 @@ func (s *server) establishPersistentConnections() error {
 	// each of the nodes.
 	selfPub := s.identityPriv.PubKey().SerializeCompressed()
 	err = sourceNode.ForEachChannel(nil, func(
-		tx *bbolt.Tx,
+		tx kvdb.Tx,
 		chanInfo *channeldb.ChannelEdgeInfo,
 		policy, _ *channeldb.ChannelEdgePolicy) error {
 
@@ -2711,6 +2876,14 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 	localFeatures.Set(lnwire.DataLossProtectRequired)
 	localFeatures.Set(lnwire.GossipQueriesOptional)
 
+	// Support for upfront shutdown scripts and large channels is
+	// negotiated through the init message, so it's signaled in the local
+	// features as well.
+	localFeatures.Set(lnwire.UpfrontShutdownScriptOptional)
+	if cfg.WumboChans {
+		localFeatures.Set(lnwire.WumboChannelsOptional)
+	}
+
 	// Now that we've established a connection, create a peer, and it to the
 	// set of currently active peers. Configure the peer with the incoming
 	// and outgoing broadcast deltas to prevent htlcs from being accepted or
@@ -3059,6 +3232,20 @@ type openChanReq struct {
 	// output selected to fund the channel should satisfy.
 	minConfs int32
 
+	// psbtFunding indicates that the funding transaction is assembled
+	// outside of the wallet through a PSBT, rather than funded by the
+	// wallet itself.
+	psbtFunding bool
+
+	// shutdownScript is the script that our funds are paid out to on
+	// cooperative close, which we commit to when opening the channel. If
+	// empty, the default upfront shutdown script is used, if any.
+	shutdownScript lnwire.DeliveryAddress
+
+	// zeroConf indicates that the channel should be used before its
+	// funding transaction confirms, if the remote peer allows it.
+	zeroConf bool
+
 	// TODO(roasbeef): add ability to specify channel constraints as well
 
 	updates chan *lnrpc.OpenStatusUpdate
@@ -3205,10 +3392,11 @@ func (s *server) DisconnectPeer(pubKey *btcec.PublicKey) error {
 func (s *server) OpenChannel(
 	req *openChanReq) (chan *lnrpc.OpenStatusUpdate, chan error) {
 
-	// The updateChan will have a buffer of 2, since we expect a ChanPending
-	// + a ChanOpen update, and we want to make sure the funding process is
-	// not blocked if the caller is not reading the updates.
-	req.updates = make(chan *lnrpc.OpenStatusUpdate, 2)
+	// The updateChan will have a buffer of 3, since we expect a PsbtFund
+	// (only for PSBT funded channels), a ChanPending and a ChanOpen
+	// update, and we want to make sure the funding process is not blocked
+	// if the caller is not reading the updates.
+	req.updates = make(chan *lnrpc.OpenStatusUpdate, 3)
 	req.err = make(chan error, 1)
 
 	// First attempt to locate the target peer to open a channel with, if
@@ -3257,6 +3445,70 @@ func (s *server) OpenChannel(
 	return req.updates, req.err
 }
 
+// BatchOpenChannel sends a request to the server to open a channel with each
+// of the specified peers, all funded by a single funding transaction. The
+// updates and errors of each channel are delivered over the channels of its
+// request. An error is returned if any of the peers can't be reached, in
+// which case none of the channels are opened.
+func (s *server) BatchOpenChannel(reqs []*openChanReq) error {
+	if len(reqs) == 0 {
+		return fmt.Errorf("no channels to open")
+	}
+
+	// All channels share the funding transaction, so they can't ask for
+	// different fee rates.
+	for _, req := range reqs[1:] {
+		if req.fundingFeePerKw != reqs[0].fundingFeePerKw {
+			return ErrBatchFeeRateMismatch
+		}
+	}
+
+	peers := make([]lnpeer.Peer, 0, len(reqs))
+	for _, req := range reqs {
+		req.updates = make(chan *lnrpc.OpenStatusUpdate, 3)
+		req.err = make(chan error, 1)
+
+		pubKeyBytes := req.targetPubkey.SerializeCompressed()
+		s.mu.RLock()
+		peer, ok := s.peersByPub[string(pubKeyBytes)]
+		s.mu.RUnlock()
+		if !ok {
+			return fmt.Errorf("peer %x is not online", pubKeyBytes)
+		}
+
+		// We'll wait until the peer is active before beginning the
+		// channel opening process.
+		select {
+		case <-peer.activeSignal:
+		case <-peer.quit:
+			return fmt.Errorf("peer %x disconnected", pubKeyBytes)
+		case <-s.quit:
+			return ErrServerShuttingDown
+		}
+
+		peers = append(peers, peer)
+	}
+
+	// The funding transaction of the batch is created with a single fee
+	// rate, which falls back to a default confirmation target if it
+	// wasn't specified.
+	feeRate := reqs[0].fundingFeePerKw
+	if feeRate == 0 {
+		var err error
+		feeRate, err = s.cc.feeEstimator.EstimateFeePerKW(6)
+		if err != nil {
+			return err
+		}
+	}
+	for _, req := range reqs {
+		req.fundingFeePerKw = feeRate
+	}
+
+	go s.fundingMgr.initBatchFundingWorkflow(peers, reqs)
+
+	return nil
+}
+
 // Peers returns a slice of all active peers.
 //
 // NOTE: This function is safe for concurrent access.
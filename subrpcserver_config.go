@@ -6,19 +6,24 @@ import (
 
 	"github.com/BTCGPU/lnd/autopilot"
 	"github.com/BTCGPU/lnd/channeldb"
+	"github.com/BTCGPU/lnd/feepolicy"
 	"github.com/BTCGPU/lnd/htlcswitch"
 	"github.com/BTCGPU/lnd/invoices"
 	"github.com/BTCGPU/lnd/lncfg"
 	"github.com/BTCGPU/lnd/lnrpc/autopilotrpc"
 	"github.com/BTCGPU/lnd/lnrpc/chainrpc"
+	"github.com/BTCGPU/lnd/lnrpc/feepolicyrpc"
 	"github.com/BTCGPU/lnd/lnrpc/invoicesrpc"
+	"github.com/BTCGPU/lnd/lnrpc/rebalancerrpc"
 	"github.com/BTCGPU/lnd/lnrpc/routerrpc"
 	"github.com/BTCGPU/lnd/lnrpc/signrpc"
 	"github.com/BTCGPU/lnd/lnrpc/walletrpc"
 	"github.com/BTCGPU/lnd/lnrpc/watchtowerrpc"
 	"github.com/BTCGPU/lnd/lnrpc/wtclientrpc"
+	"github.com/BTCGPU/lnd/lnwire"
 	"github.com/BTCGPU/lnd/macaroons"
 	"github.com/BTCGPU/lnd/netann"
+	"github.com/BTCGPU/lnd/rebalancer"
 	"github.com/BTCGPU/lnd/routing"
 	"github.com/BTCGPU/lnd/sweep"
 	"github.com/BTCGPU/lnd/watchtower"
@@ -71,6 +76,15 @@ type subRPCServerConfigs struct {
 	// instance within lnd in order to add, remove, list registered client
 	// towers, etc.
 	WatchtowerClientRPC *wtclientrpc.Config `group:"wtclientrpc" namespace:"wtclientrpc"`
+
+	// RebalancerRPC is a sub-RPC server that exposes functionality
+	// allowing clients to manage the targets of the channel rebalancer
+	// and to inspect past rebalances.
+	RebalancerRPC *rebalancerrpc.Config `group:"rebalancerrpc" namespace:"rebalancerrpc"`
+
+	// FeePolicyRPC is a sub-RPC server that exposes the fee rules that
+	// the fee policy manager applies to our channels.
+	FeePolicyRPC *feepolicyrpc.Config `group:"feepolicyrpc" namespace:"feepolicyrpc"`
 }
 
 // PopulateDependencies attempts to iterate through all the sub-server configs
@@ -92,7 +106,11 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 	sweeper *sweep.UtxoSweeper,
 	tower *watchtower.Standalone,
 	towerClient wtclient.Client,
-	tcpResolver lncfg.TCPResolver) error {
+	tcpResolver lncfg.TCPResolver,
+	htlcNotifier *htlcswitch.HtlcNotifier,
+	invoiceFeatures *lnwire.FeatureVector,
+	chanRebalancer *rebalancer.Rebalancer,
+	feePolicyMgr *feepolicy.Manager) error {
 
 	// First, we'll use reflect to obtain a version of the config struct
 	// that allows us to programmatically inspect its fields.
@@ -210,6 +228,9 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("ChanDB").Set(
 				reflect.ValueOf(chanDB),
 			)
+			subCfgValue.FieldByName("Features").Set(
+				reflect.ValueOf(invoiceFeatures),
+			)
 
 		case *routerrpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
@@ -226,6 +247,12 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("RouterBackend").Set(
 				reflect.ValueOf(routerBackend),
 			)
+			subCfgValue.FieldByName("InterceptableForwarder").Set(
+				reflect.ValueOf(htlcSwitch),
+			)
+			subCfgValue.FieldByName("HtlcNotifier").Set(
+				reflect.ValueOf(htlcNotifier),
+			)
 
 		case *watchtowerrpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
@@ -252,6 +279,33 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 				reflect.ValueOf(tcpResolver),
 			)
 
+		case *rebalancerrpc.Config:
+			subCfgValue := extractReflectValue(subCfg)
+
+			if chanRebalancer != nil {
+				subCfgValue.FieldByName("Active").Set(
+					reflect.ValueOf(true),
+				)
+				subCfgValue.FieldByName("Rebalancer").Set(
+					reflect.ValueOf(chanRebalancer),
+				)
+			}
+			subCfgValue.FieldByName("RebalanceLog").Set(
+				reflect.ValueOf(chanDB.RebalanceLog()),
+			)
+
+		case *feepolicyrpc.Config:
+			subCfgValue := extractReflectValue(subCfg)
+
+			if feePolicyMgr != nil {
+				subCfgValue.FieldByName("Active").Set(
+					reflect.ValueOf(true),
+				)
+				subCfgValue.FieldByName("FeePolicyManager").Set(
+					reflect.ValueOf(feePolicyMgr),
+				)
+			}
+
 		default:
 			return fmt.Errorf("unknown field: %v, %T", fieldName,
 				cfg)
@@ -162,6 +162,10 @@ type UtxoSweeper struct {
 	newInputs chan *sweepInputMessage
 	spendChan chan *chainntnfs.SpendDetail
 
+	// parentConfChan receives the outpoints of inputs of which the
+	// unconfirmed parent has confirmed.
+	parentConfChan chan wire.OutPoint
+
 	// pendingSweepsReq is a channel that will be sent requests by external
 	// callers in order to retrieve the set of pending inputs the
 	// UtxoSweeper is attempting to sweep.
@@ -188,6 +192,13 @@ type UtxoSweeper struct {
 	wg   sync.WaitGroup
 }
 
+// Wallet contains all wallet related functionality required by the
+// UtxoSweeper.
+type Wallet interface {
+	UtxoSource
+	CoinSelectionLocker
+}
+
 // UtxoSweeperConfig contains dependencies of UtxoSweeper.
 type UtxoSweeperConfig struct {
 	// GenSweepScript generates a P2WKH script belonging to the wallet where
@@ -222,6 +233,11 @@ type UtxoSweeperConfig struct {
 	// time the incubated outputs need to be spent.
 	Signer input.Signer
 
+	// Wallet is used to supplement inputs that bump the fee of an
+	// unconfirmed parent with wallet funds. If nil, such inputs are only
+	// swept when they carry sufficient value on their own.
+	Wallet Wallet
+
 	// MaxInputsPerTx specifies the default maximum number of inputs allowed
 	// in a single sweep tx. If more need to be swept, multiple txes are
 	// created and published.
@@ -280,6 +296,7 @@ func New(cfg *UtxoSweeperConfig) *UtxoSweeper {
 		cfg:               cfg,
 		newInputs:         make(chan *sweepInputMessage),
 		spendChan:         make(chan *chainntnfs.SpendDetail),
+		parentConfChan:    make(chan wire.OutPoint),
 		bumpFeeReqs:       make(chan *bumpFeeReq),
 		pendingSweepsReqs: make(chan *pendingSweepsReq),
 		quit:              make(chan struct{}),
@@ -494,6 +511,25 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch,
 			}
 			pendInput.ntfnRegCancel = cancel
 
+			// If the input spends from an unconfirmed parent, we'll
+			// also watch for the parent to confirm, after which it
+			// no longer needs to be bumped.
+			if input.input.UnconfParent() != nil {
+				err := s.waitForParentConf(
+					outpoint,
+					input.input.SignDesc().Output.PkScript,
+					input.input.HeightHint(),
+				)
+				if err != nil {
+					err := fmt.Errorf("wait for parent "+
+						"conf: %v", err)
+					s.signalAndRemove(
+						&outpoint, Result{Err: err},
+					)
+					continue
+				}
+			}
+
 			// Check to see if with this new input a sweep tx can be
 			// formed.
 			if err := s.scheduleSweep(bestHeight); err != nil {
@@ -560,6 +596,21 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch,
 				log.Errorf("schedule sweep: %v", err)
 			}
 
+		// The unconfirmed parent of one of our inputs has confirmed.
+		// From now on, the input is swept like any other input, as
+		// there's no need to bump the parent anymore.
+		case outpoint := <-s.parentConfChan:
+			pendInput, ok := s.pendingInputs[outpoint]
+			if !ok {
+				continue
+			}
+
+			log.Debugf("Parent of input %v confirmed", outpoint)
+
+			pendInput.input = &confirmedParentInput{
+				Input: pendInput.input,
+			}
+
 		// A new external request has been received to retrieve all of
 		// the inputs we're currently attempting to sweep.
 		case req := <-s.pendingSweepsReqs:
@@ -793,7 +844,10 @@ func (s *UtxoSweeper) getInputLists(cluster inputCluster,
 	// contain inputs that failed before. Therefore we also add sets
 	// consisting of only new inputs to the list, to make sure that new
 	// inputs are given a good, isolated chance of being published.
-	var newInputs, retryInputs []input.Input
+	//
+	// Inputs that spend from an unconfirmed parent are kept apart, as each
+	// of them is swept in a dedicated tx that bumps the fee of its parent.
+	var newInputs, retryInputs, cpfpInputs []input.Input
 	for _, input := range cluster.inputs {
 		// Skip inputs that have a minimum publish height that is not
 		// yet reached.
@@ -802,9 +856,14 @@ func (s *UtxoSweeper) getInputLists(cluster inputCluster,
 		}
 
 		// Add input to the either one of the lists.
-		if input.publishAttempts == 0 {
+		switch {
+		case input.input.UnconfParent() != nil:
+			cpfpInputs = append(cpfpInputs, input.input)
+
+		case input.publishAttempts == 0:
 			newInputs = append(newInputs, input.input)
-		} else {
+
+		default:
 			retryInputs = append(retryInputs, input.input)
 		}
 	}
@@ -832,12 +891,83 @@ func (s *UtxoSweeper) getInputLists(cluster inputCluster,
 		return nil, fmt.Errorf("input partitionings: %v", err)
 	}
 
+	// Create a set for each input that bumps an unconfirmed parent.
+	cpfpSets, err := s.getCpfpInputSets(cpfpInputs, cluster.sweepFeeRate)
+	if err != nil {
+		return nil, fmt.Errorf("cpfp input sets: %v", err)
+	}
+
 	log.Debugf("Sweep candidates at height=%v: total_num_pending=%v, "+
-		"total_num_new=%v", currentHeight, len(allSets), len(newSets))
+		"total_num_new=%v, total_num_cpfp=%v", currentHeight,
+		len(allSets), len(newSets), len(cpfpSets))
 
 	// Append the new sets at the end of the list, because those tx likely
-	// have a higher fee per input.
-	return append(allSets, newSets...), nil
+	// have a higher fee per input. The cpfp sets come first, as those are
+	// the most time sensitive ones.
+	sets := append(cpfpSets, allSets...)
+	return append(sets, newSets...), nil
+}
+
+// getCpfpInputSets constructs a dedicated input set for each of the given
+// inputs that spend from an unconfirmed parent. As these inputs usually don't
+// carry enough value to pay for the fee bump of their parent, the sets are
+// supplemented with utxos from the wallet.
+func (s *UtxoSweeper) getCpfpInputSets(inputs []input.Input,
+	feeRate lnwallet.SatPerKWeight) ([]inputSet, error) {
+
+	if len(inputs) == 0 || s.cfg.Wallet == nil {
+		return nil, nil
+	}
+
+	// Fetch the confirmed utxos of the wallet while holding the coin
+	// selection lock, so we don't race with any funding flows.
+	var walletUtxos []*lnwallet.Utxo
+	err := s.cfg.Wallet.WithCoinSelectLock(func() error {
+		utxos, err := s.cfg.Wallet.ListUnspentWitness(
+			1, math.MaxInt32,
+		)
+		if err != nil {
+			return err
+		}
+
+		walletUtxos = utxos
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch wallet utxos: %v", err)
+	}
+
+	var sets []inputSet
+	for _, inp := range inputs {
+		set, err := generateCpfpInputSet(
+			inp, walletUtxos, s.relayFeeRate, feeRate,
+			s.cfg.MaxInputsPerTx,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if set == nil {
+			continue
+		}
+
+		// Make sure the wallet utxos used for this set aren't used
+		// again for any of the other sets.
+		used := make(map[wire.OutPoint]struct{}, len(set))
+		for _, setInput := range set {
+			used[*setInput.OutPoint()] = struct{}{}
+		}
+		remaining := walletUtxos[:0]
+		for _, utxo := range walletUtxos {
+			if _, ok := used[utxo.OutPoint]; !ok {
+				remaining = append(remaining, utxo)
+			}
+		}
+		walletUtxos = remaining
+
+		sets = append(sets, set)
+	}
+
+	return sets, nil
 }
 
 // sweep takes a set of preselected inputs, creates a sweep tx and publishes the
@@ -976,6 +1106,53 @@ func (s *UtxoSweeper) waitForSpend(outpoint wire.OutPoint,
 	return spendEvent.Cancel, nil
 }
 
+// waitForParentConf registers a confirmation notification for the parent tx
+// of the given input. Once confirmed, the outpoint of the input is delivered
+// to the main event loop.
+func (s *UtxoSweeper) waitForParentConf(outpoint wire.OutPoint,
+	script []byte, heightHint uint32) error {
+
+	log.Debugf("Wait for parent conf of %v", outpoint)
+
+	confEvent, err := s.cfg.Notifier.RegisterConfirmationsNtfn(
+		&outpoint.Hash, script, 1, heightHint,
+	)
+	if err != nil {
+		return fmt.Errorf("register conf ntfn: %v", err)
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		select {
+		case _, ok := <-confEvent.Confirmed:
+			if !ok {
+				return
+			}
+
+			select {
+			case s.parentConfChan <- outpoint:
+			case <-s.quit:
+			}
+
+		case <-s.quit:
+		}
+	}()
+
+	return nil
+}
+
+// confirmedParentInput wraps an input of which the parent has confirmed, so
+// that the sweeper no longer takes the parent into account.
+type confirmedParentInput struct {
+	input.Input
+}
+
+// UnconfParent returns nil, as the parent of the input has confirmed.
+func (c *confirmedParentInput) UnconfParent() *input.TxInfo {
+	return nil
+}
+
 // PendingInputs returns the set of inputs that the UtxoSweeper is currently
 // attempting to sweep.
 func (s *UtxoSweeper) PendingInputs() (map[wire.OutPoint]*PendingInput, error) {
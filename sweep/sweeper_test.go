@@ -74,7 +74,7 @@ func createTestInput(value int64, witnessType input.WitnessType) input.BaseInput
 				PubKey: testPubKey,
 			},
 		},
-		0,
+		0, nil,
 	)
 
 	testInputCount++
@@ -1170,3 +1170,127 @@ func TestBumpFeeRBF(t *testing.T) {
 
 	ctx.finish(1)
 }
+
+// TestParentInfoTxFee asserts that the fee of a sweep tx spending from an
+// unconfirmed parent is raised such that the package reaches the target fee
+// rate, but never drops below the fee rate of the sweep tx itself.
+func TestParentInfoTxFee(t *testing.T) {
+	t.Parallel()
+
+	const (
+		feeRate  = lnwallet.SatPerKWeight(10000)
+		txWeight = 500
+	)
+
+	tests := []struct {
+		name        string
+		parent      *input.TxInfo
+		expectedFee btcutil.Amount
+	}{
+		{
+			name:        "no parent",
+			expectedFee: 5000,
+		},
+		{
+			name: "underpaying parent",
+			parent: &input.TxInfo{
+				Fee:    1000,
+				Weight: 1000,
+			},
+			expectedFee: 14000,
+		},
+		{
+			name: "overpaying parent",
+			parent: &input.TxInfo{
+				Fee:    20000,
+				Weight: 1000,
+			},
+			expectedFee: 5000,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			inp := input.MakeBaseInput(
+				&wire.OutPoint{}, input.CommitmentAnchor,
+				&input.SignDescriptor{}, 0, test.parent,
+			)
+
+			var parents parentInfo
+			parents.add(&inp)
+
+			fee := parents.txFee(feeRate, txWeight)
+			if fee != test.expectedFee {
+				t.Fatalf("expected fee %v, got %v",
+					test.expectedFee, fee)
+			}
+		})
+	}
+}
+
+// TestCpfpInputSet asserts that wallet utxos are added to an input spending
+// from an unconfirmed parent until the set is able to pay for the package.
+func TestCpfpInputSet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		relayFeeRate = lnwallet.SatPerKWeight(253)
+		feeRate      = lnwallet.SatPerKWeight(10000)
+	)
+
+	anchor := input.MakeBaseInput(
+		&wire.OutPoint{Index: 1}, input.CommitmentAnchor,
+		&input.SignDescriptor{
+			Output: &wire.TxOut{
+				Value: 330,
+			},
+		}, 0, &input.TxInfo{
+			Fee:    1000,
+			Weight: 1000,
+		},
+	)
+
+	makeUtxo := func(index uint32, value btcutil.Amount) *lnwallet.Utxo {
+		return &lnwallet.Utxo{
+			AddressType: lnwallet.WitnessPubKey,
+			Value:       value,
+			PkScript:    make([]byte, input.P2WPKHSize),
+			OutPoint: wire.OutPoint{
+				Index: index,
+			},
+		}
+	}
+
+	// With only a small utxo available, the package can't be paid for.
+	set, err := generateCpfpInputSet(
+		&anchor, []*lnwallet.Utxo{makeUtxo(2, 1000)}, relayFeeRate,
+		feeRate, testMaxInputsPerTx,
+	)
+	if err != nil {
+		t.Fatalf("unable to generate input set: %v", err)
+	}
+	if set != nil {
+		t.Fatalf("expected no input set, got %v inputs", len(set))
+	}
+
+	// Adding a large utxo should make the set sufficient. Only the
+	// largest utxo is expected to be added.
+	utxos := []*lnwallet.Utxo{makeUtxo(2, 1000), makeUtxo(3, 100000)}
+	set, err = generateCpfpInputSet(
+		&anchor, utxos, relayFeeRate, feeRate, testMaxInputsPerTx,
+	)
+	if err != nil {
+		t.Fatalf("unable to generate input set: %v", err)
+	}
+	if len(set) != 2 {
+		t.Fatalf("expected 2 inputs, got %v", len(set))
+	}
+	if *set[0].OutPoint() != *anchor.OutPoint() {
+		t.Fatalf("expected anchor as first input")
+	}
+	if *set[1].OutPoint() != utxos[1].OutPoint {
+		t.Fatalf("expected largest utxo to be added")
+	}
+}
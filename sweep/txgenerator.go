@@ -122,7 +122,10 @@ func getPositiveYieldInputs(sweepableInputs []input.Input, maxInputs int,
 	// Add the sweep tx output to the weight estimate.
 	weightEstimate.AddP2WKHOutput()
 
-	var total, outputValue btcutil.Amount
+	var (
+		total, outputValue btcutil.Amount
+		parents            parentInfo
+	)
 	for idx, input := range sweepableInputs {
 		// Can ignore error, because it has already been checked when
 		// calculating the yields.
@@ -137,8 +140,12 @@ func getPositiveYieldInputs(sweepableInputs []input.Input, maxInputs int,
 
 		newTotal := total + btcutil.Amount(input.SignDesc().Output.Value)
 
+		// Account for the fee needed to bump any unconfirmed parent of
+		// the input through CPFP.
+		parents.add(input)
+
 		weight := weightEstimate.Weight()
-		fee := feePerKW.FeeForWeight(int64(weight))
+		fee := parents.txFee(feePerKW, int64(weight))
 
 		// Calculate the output value if the current input would be
 		// added to the set.
@@ -177,7 +184,13 @@ func createSweepTx(inputs []input.Input, outputPkScript []byte,
 		"using %v sat/kw", len(inputs), csvCount, cltvCount,
 		int64(feePerKw))
 
-	txFee := feePerKw.FeeForWeight(txWeight)
+	// If any of the inputs spend from an unconfirmed parent, the fee is
+	// raised such that the package as a whole pays the target fee rate.
+	var parents parentInfo
+	for _, inp := range inputs {
+		parents.add(inp)
+	}
+	txFee := parents.txFee(feePerKw, txWeight)
 
 	// Sum up the total value contained in the inputs.
 	var totalSum btcutil.Amount
@@ -258,6 +271,16 @@ func createSweepTx(inputs []input.Input, outputPkScript []byte,
 func getInputWitnessSizeUpperBound(inp input.Input) (int, bool, error) {
 	switch inp.WitnessType() {
 
+	// Outputs on a remote commitment transaction of an anchor channel
+	// that pay to us after a single confirmation.
+	case input.CommitmentToRemoteConfirmed:
+		return input.ToRemoteConfirmedWitnessSize, false, nil
+
+	// Our anchor output on a commitment transaction, which is used to bump
+	// the fee of the commitment through CPFP.
+	case input.CommitmentAnchor:
+		return input.AnchorWitnessSize, false, nil
+
 	// Outputs on a remote commitment transaction that pay directly to us.
 	case input.CommitSpendNoDelayTweakless:
 		fallthrough
@@ -350,6 +373,7 @@ func getWeightEstimate(inputs []input.Input) ([]input.Input, int64, int, int) {
 
 		switch inp.WitnessType() {
 		case input.CommitmentTimeLock,
+			input.CommitmentToRemoteConfirmed,
 			input.HtlcOfferedTimeoutSecondLevel,
 			input.HtlcAcceptedSuccessSecondLevel:
 			csvCount++
@@ -363,3 +387,105 @@ func getWeightEstimate(inputs []input.Input) ([]input.Input, int64, int, int) {
 
 	return sweepInputs, txWeight, csvCount, cltvCount
 }
+
+// parentInfo keeps track of the unconfirmed parents of a set of inputs, so
+// that the fee of a sweep tx can be raised to bump them through CPFP.
+type parentInfo struct {
+	// weight is the total weight of the unconfirmed parents.
+	weight int64
+
+	// fee is the total fee already paid by the unconfirmed parents.
+	fee btcutil.Amount
+}
+
+// add records the unconfirmed parent of the given input, if it has one.
+func (p *parentInfo) add(inp input.Input) {
+	parent := inp.UnconfParent()
+	if parent == nil {
+		return
+	}
+
+	p.weight += parent.Weight
+	p.fee += parent.Fee
+}
+
+// txFee returns the fee a sweep tx of the given weight needs to pay, such that
+// the package of the sweep tx and its unconfirmed parents reaches the given fee
+// rate. The sweep tx itself always pays at least the given fee rate.
+func (p *parentInfo) txFee(feePerKw lnwallet.SatPerKWeight,
+	txWeight int64) btcutil.Amount {
+
+	txFee := feePerKw.FeeForWeight(txWeight)
+	if p.weight == 0 {
+		return txFee
+	}
+
+	packageFee := feePerKw.FeeForWeight(txWeight+p.weight) - p.fee
+	if packageFee > txFee {
+		return packageFee
+	}
+
+	return txFee
+}
+
+// generateCpfpInputSet constructs an input set for an input that spends from
+// an unconfirmed parent, such as an anchor output. Inputs like these usually
+// don't carry enough value to pay for bumping their parent. Therefore wallet
+// utxos are added, largest first, until the set reaches a positive yield with
+// an output above the dust limit. A nil set is returned if the wallet doesn't
+// hold sufficient funds.
+func generateCpfpInputSet(inp input.Input, walletUtxos []*lnwallet.Utxo,
+	relayFeePerKW, feePerKW lnwallet.SatPerKWeight,
+	maxInputsPerTx int) (inputSet, error) {
+
+	dustLimit := txrules.GetDustThreshold(
+		input.P2WPKHSize,
+		btcutil.Amount(relayFeePerKW.FeePerKVByte()),
+	)
+
+	// Start out with the input itself, and try to add the largest wallet
+	// utxos first to keep the size of the sweep tx small.
+	utxos := make([]*lnwallet.Utxo, len(walletUtxos))
+	copy(utxos, walletUtxos)
+	sort.Slice(utxos, func(i, j int) bool {
+		return utxos[i].Value > utxos[j].Value
+	})
+
+	set := inputSet{inp}
+	for {
+		_, txWeight, _, _ := getWeightEstimate(set)
+
+		var (
+			parents  parentInfo
+			totalAmt btcutil.Amount
+		)
+		for _, setInput := range set {
+			parents.add(setInput)
+			totalAmt += btcutil.Amount(
+				setInput.SignDesc().Output.Value,
+			)
+		}
+
+		outputValue := totalAmt - parents.txFee(feePerKW, txWeight)
+		if outputValue >= dustLimit {
+			return set, nil
+		}
+
+		// The set doesn't pay for itself yet, so we'll need to add
+		// another wallet utxo if there's one left.
+		if len(utxos) == 0 || len(set) >= maxInputsPerTx {
+			log.Debugf("Insufficient wallet funds to bump parent "+
+				"of input %v", inp.OutPoint())
+
+			return nil, nil
+		}
+
+		walletInput, err := makeWalletInput(utxos[0])
+		if err != nil {
+			return nil, err
+		}
+		utxos = utxos[1:]
+
+		set = append(set, walletInput)
+	}
+}
@@ -212,50 +212,13 @@ func CraftSweepAllTx(feeRate lnwallet.SatPerKWeight, blockHeight uint32,
 	// sweeper to generate and sign a transaction for us.
 	var inputsToSweep []input.Input
 	for _, output := range allOutputs {
-		// As we'll be signing for outputs under control of the wallet,
-		// we only need to populate the output value and output script.
-		// The rest of the items will be populated internally within
-		// the sweeper via the witness generation function.
-		signDesc := &input.SignDescriptor{
-			Output: &wire.TxOut{
-				PkScript: output.PkScript,
-				Value:    int64(output.Value),
-			},
-			HashType: txscript.SigHashAll | txscript.SigHashForkID,
-		}
-
-		pkScript := output.PkScript
-
-		// Based on the output type, we'll map it to the proper witness
-		// type so we can generate the set of input scripts needed to
-		// sweep the output.
-		var witnessType input.WitnessType
-		switch output.AddressType {
-
-		// If this is a p2wkh output, then we'll assume it's a witness
-		// key hash witness type.
-		case lnwallet.WitnessPubKey:
-			witnessType = input.WitnessKeyHash
-
-		// If this is a p2sh output, then as since it's under control
-		// of the wallet, we'll assume it's a nested p2sh output.
-		case lnwallet.NestedWitnessPubKey:
-			witnessType = input.NestedWitnessKeyHash
-
-		// All other output types we count as unknown and will fail to
-		// sweep.
-		default:
+		input, err := makeWalletInput(output)
+		if err != nil {
 			unlockOutputs()
 
-			return nil, fmt.Errorf("unable to sweep coins, "+
-				"unknown script: %x", pkScript[:])
+			return nil, err
 		}
-
-		// Now that we've constructed the items required, we'll make an
-		// input which can be passed to the sweeper for ultimate
-		// sweeping.
-		input := input.MakeBaseInput(&output.OutPoint, witnessType, signDesc, 0)
-		inputsToSweep = append(inputsToSweep, &input)
+		inputsToSweep = append(inputsToSweep, input)
 	}
 
 	// Next, we'll convert the delivery addr to a pkScript that we can use
@@ -283,3 +246,46 @@ func CraftSweepAllTx(feeRate lnwallet.SatPerKWeight, blockHeight uint32,
 		CancelSweepAttempt: unlockOutputs,
 	}, nil
 }
+
+// makeWalletInput creates an input that spends the given wallet utxo, which
+// can be handed off to the sweeper for signing.
+func makeWalletInput(output *lnwallet.Utxo) (input.Input, error) {
+	// As we'll be signing for outputs under control of the wallet, we only
+	// need to populate the output value and output script. The rest of the
+	// items will be populated internally within the sweeper via the
+	// witness generation function.
+	signDesc := &input.SignDescriptor{
+		Output: &wire.TxOut{
+			PkScript: output.PkScript,
+			Value:    int64(output.Value),
+		},
+		HashType: txscript.SigHashAll | txscript.SigHashForkID,
+	}
+
+	pkScript := output.PkScript
+
+	// Based on the output type, we'll map it to the proper witness type so
+	// we can generate the set of input scripts needed to sweep the output.
+	var witnessType input.WitnessType
+	switch output.AddressType {
+
+	// If this is a p2wkh output, then we'll assume it's a witness key hash
+	// witness type.
+	case lnwallet.WitnessPubKey:
+		witnessType = input.WitnessKeyHash
+
+	// If this is a p2sh output, then as since it's under control of the
+	// wallet, we'll assume it's a nested p2sh output.
+	case lnwallet.NestedWitnessPubKey:
+		witnessType = input.NestedWitnessKeyHash
+
+	// All other output types we count as unknown and will fail to sweep.
+	default:
+		return nil, fmt.Errorf("unable to sweep coins, "+
+			"unknown script: %x", pkScript[:])
+	}
+
+	// Now that we've constructed the items required, we'll make an input
+	// which can be passed to the sweeper for ultimate sweeping.
+	return input.NewBaseInput(&output.OutPoint, witnessType, signDesc, 0), nil
+}
@@ -144,21 +144,35 @@ func (s *Stream) Encode(w io.Writer) error {
 // the last record was read cleanly and we should stop parsing. All other io.EOF
 // or io.ErrUnexpectedEOF errors are returned.
 func (s *Stream) Decode(r io.Reader) error {
-	_, err := s.decode(r, nil)
+	_, err := s.decode(r, nil, false)
 	return err
 }
 
 // DecodeWithParsedTypes is identical to Decode, but if successful, returns a
-// TypeSet containing the types of all records that were decoded or ignored from
-// the stream.
-func (s *Stream) DecodeWithParsedTypes(r io.Reader) (TypeSet, error) {
-	return s.decode(r, make(TypeSet))
+// TypeMap containing the types of all records that were decoded or ignored from
+// the stream. The values of unknown records are retained in the TypeMap, such
+// that the caller is able to interpret them.
+func (s *Stream) DecodeWithParsedTypes(r io.Reader) (TypeMap, error) {
+	return s.decode(r, make(TypeMap), false)
+}
+
+// DecodeWithParsedTypesLenient is identical to DecodeWithParsedTypes, except
+// that unknown even types don't result in an error. Instead, they are returned
+// in the TypeMap along with their values, and the caller is responsible for
+// rejecting the required types it doesn't understand. This allows a caller to
+// hand required types within a range it doesn't interpret itself, such as
+// custom records, to a higher level application.
+func (s *Stream) DecodeWithParsedTypesLenient(r io.Reader) (TypeMap, error) {
+	return s.decode(r, make(TypeMap), true)
 }
 
 // decode is a helper function that performs the basis of stream decoding. If
 // the caller needs the set of parsed types, it must provide an initialized
-// parsedTypes, otherwise the returned TypeSet will be nil.
-func (s *Stream) decode(r io.Reader, parsedTypes TypeSet) (TypeSet, error) {
+// parsedTypes, otherwise the returned TypeMap will be nil. Unknown even types
+// are only tolerated if allowUnknownEven is true.
+func (s *Stream) decode(r io.Reader, parsedTypes TypeMap,
+	allowUnknownEven bool) (TypeMap, error) {
+
 	var (
 		typ       Type
 		min       Type
@@ -220,6 +234,11 @@ func (s *Stream) decode(r io.Reader, parsedTypes TypeSet) (TypeSet, error) {
 		// begin the search and recordIdx and walk forward until we find
 		// it or the next record's type is larger.
 		rec, newIdx, ok := s.getRecord(typ, recordIdx)
+
+		// The value of an unknown record is only kept if the caller
+		// provided an initialized TypeMap.
+		var value []byte
+
 		switch {
 
 		// We know of this record type, proceed to decode the value.
@@ -241,14 +260,26 @@ func (s *Stream) decode(r io.Reader, parsedTypes TypeSet) (TypeSet, error) {
 			}
 
 		// This record type is unknown to the stream, fail if the type
-		// is even meaning that we are required to understand it.
-		case typ%2 == 0:
+		// is even meaning that we are required to understand it, unless
+		// the caller explicitly takes over that decision.
+		case typ%2 == 0 && !allowUnknownEven:
 			return nil, ErrUnknownRequiredType(typ)
 
-		// Otherwise, the record type is unknown and is odd, discard the
-		// number of bytes specified by length.
+		// Otherwise, the record type is unknown. If the caller is
+		// interested in the parsed types, read the value so that it
+		// can be returned. Else discard the number of bytes specified
+		// by length.
 		default:
-			_, err := io.CopyN(ioutil.Discard, r, int64(length))
+			var err error
+			if parsedTypes != nil {
+				value = make([]byte, length)
+				_, err = io.ReadFull(r, value)
+			} else {
+				_, err = io.CopyN(
+					ioutil.Discard, r, int64(length),
+				)
+			}
+
 			switch {
 
 			// We'll convert any EOFs to ErrUnexpectedEOF, since this
@@ -263,9 +294,9 @@ func (s *Stream) decode(r io.Reader, parsedTypes TypeSet) (TypeSet, error) {
 		}
 
 		// Record the successfully decoded or ignored type if the
-		// caller provided an initialized TypeSet.
+		// caller provided an initialized TypeMap.
 		if parsedTypes != nil {
-			parsedTypes[typ] = struct{}{}
+			parsedTypes[typ] = value
 		}
 
 		// Update our record index so that we can begin our next search
@@ -9,6 +9,7 @@ import (
 
 // TestParsedTypes asserts that a Stream will properly return the set of types
 // that it encounters when the type is known-and-decoded or unknown-and-ignored.
+// For unknown types, the encoded value is expected to be returned as well.
 func TestParsedTypes(t *testing.T) {
 	const (
 		knownType   = 1
@@ -17,9 +18,10 @@ func TestParsedTypes(t *testing.T) {
 
 	// Construct a stream that will encode two types, one that will be known
 	// to the decoder and another that will be unknown.
+	unknownValue := uint64(0x0102030405060708)
 	encStream := tlv.MustNewStream(
 		tlv.MakePrimitiveRecord(knownType, new(uint64)),
-		tlv.MakePrimitiveRecord(unknownType, new(uint64)),
+		tlv.MakePrimitiveRecord(unknownType, &unknownValue),
 	)
 
 	var b bytes.Buffer
@@ -48,4 +50,57 @@ func TestParsedTypes(t *testing.T) {
 		t.Fatalf("unknown type %d should be in parsed types",
 			unknownType)
 	}
+
+	// Only the value of the unknown type should have been retained.
+	if parsedTypes[knownType] != nil {
+		t.Fatalf("known type %d should not have a value", knownType)
+	}
+	expValue := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	if !bytes.Equal(parsedTypes[unknownType], expValue) {
+		t.Fatalf("unknown type %d value mismatch, want: %x, got: %x",
+			unknownType, expValue, parsedTypes[unknownType])
+	}
+}
+
+// TestParsedTypesUnknownEven asserts that DecodeWithParsedTypes rejects
+// unknown even types, while DecodeWithParsedTypesLenient returns them to the
+// caller along with their values.
+func TestParsedTypesUnknownEven(t *testing.T) {
+	const (
+		knownType       = 1
+		unknownEvenType = 2
+	)
+
+	unknownValue := uint64(0x0102030405060708)
+	encStream := tlv.MustNewStream(
+		tlv.MakePrimitiveRecord(knownType, new(uint64)),
+		tlv.MakePrimitiveRecord(unknownEvenType, &unknownValue),
+	)
+
+	var b bytes.Buffer
+	if err := encStream.Encode(&b); err != nil {
+		t.Fatalf("unable to encode stream: %v", err)
+	}
+
+	decStream := tlv.MustNewStream(
+		tlv.MakePrimitiveRecord(knownType, new(uint64)),
+	)
+
+	_, err := decStream.DecodeWithParsedTypes(bytes.NewReader(b.Bytes()))
+	if err != tlv.ErrUnknownRequiredType(unknownEvenType) {
+		t.Fatalf("expected unknown required type error, got: %v", err)
+	}
+
+	parsedTypes, err := decStream.DecodeWithParsedTypesLenient(
+		bytes.NewReader(b.Bytes()),
+	)
+	if err != nil {
+		t.Fatalf("unable to decode stream: %v", err)
+	}
+
+	expValue := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	if !bytes.Equal(parsedTypes[unknownEvenType], expValue) {
+		t.Fatalf("unknown type %d value mismatch, want: %x, got: %x",
+			unknownEvenType, expValue, parsedTypes[unknownEvenType])
+	}
 }
@@ -154,6 +154,16 @@ func ETUint64(w io.Writer, val interface{}, buf *[8]byte) error {
 	return NewTypeForEncodingErr(val, "uint64")
 }
 
+// ETUint64T is an Encoder for truncated uint64 values, where leading zeros will
+// be omitted. This method is exposed so that encodings for custom uint64-like
+// types can be created without incurring an extra heap allocation.
+func ETUint64T(w io.Writer, val uint64, buf *[8]byte) error {
+	binary.BigEndian.PutUint64(buf[:], val)
+	numZeros := numLeadingZeroBytes64(val)
+	_, err := w.Write(buf[numZeros:])
+	return err
+}
+
 // DTUint64 is an Decoder for truncated uint64 values, where leading zeros will
 // be resurrected. An error is returned if val is not a *uint64.
 func DTUint64(r io.Reader, val interface{}, buf *[8]byte, l uint64) error {
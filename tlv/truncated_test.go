@@ -342,6 +342,20 @@ func TestTUint64(t *testing.T) {
 					test.bytes, b.Bytes())
 			}
 
+			// Encoding the raw value should yield the same
+			// truncated bytes.
+			var bT bytes.Buffer
+			err = tlv.ETUint64T(&bT, test.value, &buf)
+			if err != nil {
+				t.Fatalf("unable to encode tuint64: %v", err)
+			}
+
+			if bytes.Compare(bT.Bytes(), test.bytes) != 0 {
+				t.Fatalf("encoding mismatch, "+
+					"expected: %x, got: %x",
+					test.bytes, bT.Bytes())
+			}
+
 			var value uint64
 			r := bytes.NewReader(b.Bytes())
 			err = tlv.DTUint64(r, &value, &buf, test.size)
@@ -6,12 +6,7 @@ import (
 	"os"
 	"path/filepath"
 
-	"github.com/coreos/bbolt"
-)
-
-const (
-	// dbFilePermission requests read+write access to the db file.
-	dbFilePermission = 0600
+	"github.com/BTCGPU/lnd/channeldb/kvdb"
 )
 
 var (
@@ -49,7 +44,7 @@ func fileExists(path string) bool {
 // one doesn't exist. The boolean returned indicates if the database did not
 // exist before, or if it has been created but no version metadata exists within
 // it.
-func createDBIfNotExist(dbPath, name string) (*bbolt.DB, bool, error) {
+func createDBIfNotExist(dbPath, name string) (kvdb.Backend, bool, error) {
 	path := filepath.Join(dbPath, name)
 
 	// If the database file doesn't exist, this indicates we much initialize
@@ -63,14 +58,9 @@ func createDBIfNotExist(dbPath, name string) (*bbolt.DB, bool, error) {
 		}
 	}
 
-	// Specify bbolt freelist options to reduce heap pressure in case the
-	// freelist grows to be very large.
-	options := &bbolt.Options{
-		NoFreelistSync: true,
-		FreelistType:   bbolt.FreelistMapType,
-	}
-
-	bdb, err := bbolt.Open(path, dbFilePermission, options)
+	// The freelist isn't synced to disk to reduce write overhead, as it
+	// can be rebuilt when the database is opened.
+	bdb, err := kvdb.Create(kvdb.BoltBackendName, path, true)
 	if err != nil {
 		return nil, false, err
 	}
@@ -82,7 +72,7 @@ func createDBIfNotExist(dbPath, name string) (*bbolt.DB, bool, error) {
 	// set firstInit to true so that we can treat is initialize the bucket.
 	if !firstInit {
 		var metadataExists bool
-		err = bdb.View(func(tx *bbolt.Tx) error {
+		err = bdb.View(func(tx kvdb.Tx) error {
 			metadataExists = tx.Bucket(metadataBkt) != nil
 			return nil
 		})
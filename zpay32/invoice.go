@@ -48,6 +48,10 @@ const (
 	// fieldTypeP is the field containing the payment hash.
 	fieldTypeP = 1
 
+	// fieldTypeS is the field containing the payment address, also known
+	// as the payment secret.
+	fieldTypeS = 16
+
 	// fieldTypeD contains a short description of the payment.
 	fieldTypeD = 13
 
@@ -82,7 +86,12 @@ const (
 var (
 	// InvoiceFeatures holds the set of all known feature bits that are
 	// exposed as BOLT 11 features.
-	InvoiceFeatures = map[lnwire.FeatureBit]string{}
+	InvoiceFeatures = map[lnwire.FeatureBit]string{
+		lnwire.TLVOnionPayloadRequired: "tlv-onion",
+		lnwire.TLVOnionPayloadOptional: "tlv-onion",
+		lnwire.PaymentAddrRequired:     "payment-addr",
+		lnwire.PaymentAddrOptional:     "payment-addr",
+	}
 
 	// ErrInvoiceTooLarge is returned when an invoice exceeds maxInvoiceLength.
 	ErrInvoiceTooLarge = errors.New("invoice is too large")
@@ -118,6 +127,13 @@ type Invoice struct {
 	// invoice.
 	PaymentHash *[32]byte
 
+	// PaymentAddr is the payment address, also known as the payment
+	// secret, that must be included in the final hop's payload of a
+	// payment to this invoice. It prevents intermediate nodes from probing
+	// the receiver with the payment hash alone.
+	// Optional.
+	PaymentAddr *[32]byte
+
 	// Destination is the public key of the target node. This will always
 	// be set after decoding, and can optionally be set before encoding to
 	// include the pubkey as an 'n' field. If this is not set before
@@ -179,6 +195,22 @@ func Amount(milliSat lnwire.MilliSatoshi) func(*Invoice) {
 	}
 }
 
+// PaymentAddr is a functional option that allows callers of NewInvoice to set
+// the payment address of the created Invoice.
+func PaymentAddr(addr [32]byte) func(*Invoice) {
+	return func(i *Invoice) {
+		i.PaymentAddr = &addr
+	}
+}
+
+// Features is a functional option that allows callers of NewInvoice to set
+// the feature bits signaled by the created Invoice.
+func Features(features *lnwire.FeatureVector) func(*Invoice) {
+	return func(i *Invoice) {
+		i.Features = features
+	}
+}
+
 // Destination is a functional option that allows callers of NewInvoice to
 // explicitly set the pubkey of the Invoice's destination node.
 func Destination(destination *btcec.PublicKey) func(*Invoice) {
@@ -625,6 +657,14 @@ func parseTaggedFields(invoice *Invoice, fields []byte, net *chaincfg.Params) er
 			}
 
 			invoice.PaymentHash, err = parsePaymentHash(base32Data)
+		case fieldTypeS:
+			if invoice.PaymentAddr != nil {
+				// We skip the field if we have already seen a
+				// supported one.
+				continue
+			}
+
+			invoice.PaymentAddr, err = parsePaymentAddr(base32Data)
 		case fieldTypeD:
 			if invoice.Description != nil {
 				// We skip the field if we have already seen a
@@ -736,6 +776,27 @@ func parsePaymentHash(data []byte) (*[32]byte, error) {
 	return &paymentHash, nil
 }
 
+// parsePaymentAddr converts a 256-bit payment address (encoded in base32) to
+// *[32]byte.
+func parsePaymentAddr(data []byte) (*[32]byte, error) {
+	var paymentAddr [32]byte
+
+	// As BOLT-11 states, a reader must skip over the payment address field
+	// if it does not have a length of 52, so avoid returning an error.
+	if len(data) != hashBase32Len {
+		return nil, nil
+	}
+
+	addr, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+
+	copy(paymentAddr[:], addr)
+
+	return &paymentAddr, nil
+}
+
 // parseDescription converts the data (encoded in base32) into a string to use
 // as the description.
 func parseDescription(data []byte) (*string, error) {
@@ -943,6 +1004,24 @@ func writeTaggedFields(bufferBase32 *bytes.Buffer, invoice *Invoice) error {
 		}
 	}
 
+	if invoice.PaymentAddr != nil {
+		// Convert 32 byte address to 52 5-bit groups.
+		base32, err := bech32.ConvertBits(invoice.PaymentAddr[:], 8, 5,
+			true)
+		if err != nil {
+			return err
+		}
+		if len(base32) != hashBase32Len {
+			return fmt.Errorf("invalid payment address length: %d",
+				len(invoice.PaymentAddr))
+		}
+
+		err = writeTaggedField(bufferBase32, fieldTypeS, base32)
+		if err != nil {
+			return err
+		}
+	}
+
 	if invoice.Description != nil {
 		base32, err := bech32.ConvertBits([]byte(*invoice.Description),
 			8, 5, true)
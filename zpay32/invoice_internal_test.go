@@ -364,6 +364,51 @@ func TestParsePaymentHash(t *testing.T) {
 	}
 }
 
+// TestParsePaymentAddr checks that the payment address is properly parsed.
+// If the data does not have a length of 52 bytes, we skip over parsing the
+// field and do not return an error.
+func TestParsePaymentAddr(t *testing.T) {
+	t.Parallel()
+
+	testPaymentAddrData, _ := bech32.ConvertBits(testPaymentAddr[:], 8, 5, true)
+
+	tests := []struct {
+		data   []byte
+		valid  bool
+		result *[32]byte
+	}{
+		{
+			data:   []byte{},
+			valid:  true,
+			result: nil, // skip unknown length, not 52 bytes
+		},
+		{
+			data:   testPaymentAddrData,
+			valid:  true,
+			result: &testPaymentAddr,
+		},
+		{
+			data:   append(testPaymentAddrData, 0x0),
+			valid:  true,
+			result: nil, // skip unknown length, not 52 bytes
+		},
+	}
+
+	for i, test := range tests {
+		paymentAddr, err := parsePaymentAddr(test.data)
+		if (err == nil) != test.valid {
+			t.Errorf("payment addr decoding test %d failed: %v", i, err)
+			return
+		}
+		if test.valid && !compareHashes(paymentAddr, test.result) {
+			t.Fatalf("test %d failed decoding payment addr: "+
+				"expected %x, got %x", i, test.result,
+				paymentAddr)
+			return
+		}
+	}
+}
+
 // TestParseDescription checks that the description is properly parsed.
 func TestParseDescription(t *testing.T) {
 	t.Parallel()
@@ -92,6 +92,13 @@ var (
 		},
 	}
 
+	testPaymentAddr = [32]byte{
+		0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+		0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+		0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+		0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+	}
+
 	// Must be initialized in init().
 	testPaymentHash     [32]byte
 	testDescriptionHash [32]byte
@@ -496,6 +503,33 @@ func TestDecodeEncode(t *testing.T) {
 				i.Destination = nil
 			},
 		},
+		{
+			// On mainnet, please send $30 coffee beans to the given
+			// payment address, requiring features 9 and 15.
+			encodedInvoice: "lnbtg25m1pvjluezpp5qqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqypqsp5zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3zygsdq5vdhkven9v5sxyetpdees9qypqsqljgvapzd63dy29efjs5sflrcp40j0jw8l0247dk3zgnutzztme08puh0ru8rwnl6y5p3xn6c86nqtwjmh7ldkj05q773excuruecjgspp4pxu3",
+			valid:          true,
+			decodedInvoice: func() *Invoice {
+				return &Invoice{
+					Net:         &chaincfg.MainNetParams,
+					MilliSat:    &testMillisat25mBTC,
+					Timestamp:   time.Unix(1496314658, 0),
+					PaymentHash: &testPaymentHash,
+					PaymentAddr: &testPaymentAddr,
+					Description: &testCoffeeBeans,
+					Destination: testPubKey,
+					Features: lnwire.NewFeatureVector(
+						lnwire.NewRawFeatureVector(9, 15),
+						InvoiceFeatures,
+					),
+				}
+			},
+			beforeEncoding: func(i *Invoice) {
+				// Since this destination pubkey was recovered
+				// from the signature, we must set it nil before
+				// encoding to get back the same invoice string.
+				i.Destination = nil
+			},
+		},
 		{
 			// On mainnet, please send $30 coffee beans supporting
 			// features 1, 9, and 100.
@@ -741,6 +775,23 @@ func TestNewInvoice(t *testing.T) {
 			valid:          true,
 			encodedInvoice: "lnbtg20m1pvjluezpp5qqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqypqhp58yjmdan79s6qqdhdzgynm4zwqd5d7xmw5fk98klysy043l2ahrqsfpp3qjmp7lwpagxun9pygexvgpjdc4jdj85fr9yq20q82gphp2nflc7jtzrcazrra7wwgzxqc8u7754cdlpfrmccae92qgzqvzq2ps8pqqqqqqpqqqqq9qqqvpeuqafqxu92d8lr6fvg0r5gv0heeeqgcrqlnm6jhphu9y00rrhy4grqszsvpcgpy9qqqqqqgqqqqq7qqzqhdasnpm2k4ezqfls6ddlurh64lvhpcfmn6h08lledzvgltngggv4y6x4n3j4zvxg6rj32srdheaqxacefryrvj95nkjqhq8jgg3prtgq40rpzu",
 		},
+		{
+			// With a payment address and features.
+			newInvoice: func() (*Invoice, error) {
+				return NewInvoice(&chaincfg.MainNetParams,
+					testPaymentHash, time.Unix(1496314658, 0),
+					Amount(testMillisat25mBTC),
+					Description(testCoffeeBeans),
+					PaymentAddr(testPaymentAddr),
+					Features(lnwire.NewFeatureVector(
+						lnwire.NewRawFeatureVector(9, 15),
+						InvoiceFeatures,
+					)),
+				)
+			},
+			valid:          true,
+			encodedInvoice: "lnbtg25m1pvjluezpp5qqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqypqsp5zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3zygsdq5vdhkven9v5sxyetpdees9qypqsqljgvapzd63dy29efjs5sflrcp40j0jw8l0247dk3zgnutzztme08puh0ru8rwnl6y5p3xn6c86nqtwjmh7ldkj05q773excuruecjgspp4pxu3",
+		},
 		{
 			// On simnet
 			newInvoice: func() (*Invoice, error) {
@@ -864,6 +915,11 @@ func compareInvoices(expected, actual *Invoice) error {
 			*expected.PaymentHash, *actual.PaymentHash)
 	}
 
+	if !compareHashes(expected.PaymentAddr, actual.PaymentAddr) {
+		return fmt.Errorf("expected payment addr %x, got %x",
+			expected.PaymentAddr, actual.PaymentAddr)
+	}
+
 	if !reflect.DeepEqual(expected.Description, actual.Description) {
 		return fmt.Errorf("expected description \"%s\", got \"%s\"",
 			*expected.Description, *actual.Description)